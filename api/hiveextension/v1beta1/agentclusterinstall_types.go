@@ -205,6 +205,91 @@ type AgentClusterInstallSpec struct {
 	// Set to true to allow control plane nodes to be schedulable
 	// +optional
 	MastersSchedulable bool `json:"mastersSchedulable,omitempty"`
+
+	// HostRoleRules assign the role, the installation disk and the node labels of the agents whose role is
+	// auto-assign. The first rule matching an agent is applied.
+	// +optional
+	HostRoleRules []HostRoleRule `json:"hostRoleRules,omitempty"`
+
+	// HostStageTimeoutPolicies set the timeouts of the installation stages of the agents, and the remediations
+	// applied to the agents which stay in a stage longer than its timeout.
+	// +optional
+	HostStageTimeoutPolicies []HostStageTimeoutPolicy `json:"hostStageTimeoutPolicies,omitempty"`
+}
+
+// HostStageTimeoutPolicy sets the timeout of an installation stage of the agents and the remediation applied to the
+// agents stuck in the stage.
+type HostStageTimeoutPolicy struct {
+	// Stage is the installation stage of the agents, e.g. Rebooting or Configuring.
+	Stage string `json:"stage"`
+
+	// Timeout of the stage. The timeout configured in the service for the stage applies when it isn't set.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Remediation applied to the agents stuck in the stage. fail fails the installation of the agent, retry restarts
+	// the timeout of the stage, reboot reboots the agent via the BMC of its BareMetalHost, and pause waits for the
+	// agent to report progress again.
+	// +kubebuilder:validation:Enum=fail;retry;reboot;pause
+	Remediation string `json:"remediation"`
+
+	// MaxAttempts is the number of times the retry and reboot remediations are applied to an agent in the stage
+	// before its installation fails. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAttempts int64 `json:"maxAttempts,omitempty"`
+}
+
+// HostRoleRule assigns a role to the agents matching all of its criteria. A rule without criteria matches every agent.
+type HostRoleRule struct {
+	// Name identifies the rule in the events and the logs.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Role is the role assigned to the matching agents.
+	// +kubebuilder:validation:Enum=master;worker
+	Role string `json:"role"`
+
+	// HostnamePattern is a regular expression matching the hostname of the agent.
+	// +optional
+	HostnamePattern string `json:"hostnamePattern,omitempty"`
+
+	// MacAddresses matches the agents having an interface with one of the MAC addresses.
+	// +optional
+	MacAddresses []string `json:"macAddresses,omitempty"`
+
+	// SerialNumber matches the serial number of the system of the agent.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// BMCAddress matches the address of the baseboard management controller of the agent.
+	// +optional
+	BMCAddress string `json:"bmcAddress,omitempty"`
+
+	// MinCPUCores is the minimal number of CPU cores of the agent.
+	// +optional
+	MinCPUCores int64 `json:"minCPUCores,omitempty"`
+
+	// MinMemoryMiB is the minimal physical memory of the agent in MiB.
+	// +optional
+	MinMemoryMiB int64 `json:"minMemoryMiB,omitempty"`
+
+	// MinDiskSizeGB is the minimal size in GB of one of the disks of the agent eligible for installation.
+	// +optional
+	MinDiskSizeGB int64 `json:"minDiskSizeGB,omitempty"`
+
+	// NICVendor matches the agents having an interface of this vendor.
+	// +optional
+	NICVendor string `json:"nicVendor,omitempty"`
+
+	// InstallationDiskPattern is a regular expression selecting the installation disk of the matching agents by
+	// path, by-id or by-path name.
+	// +optional
+	InstallationDiskPattern string `json:"installationDiskPattern,omitempty"`
+
+	// NodeLabels are added to the node labels of the matching agents.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 }
 
 // IgnitionEndpoint stores the data to of the custom ignition endpoint.
@@ -283,6 +368,35 @@ type AgentClusterInstallStatus struct {
 	// ValidationsInfo is a JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)
 	// +optional
 	ValidationsInfo common.ValidationsStatus `json:"validationsInfo,omitempty"`
+
+	// HostStageRemediations are the remediations applied to the agents stuck in their current installation stage.
+	// +optional
+	HostStageRemediations []HostStageRemediation `json:"hostStageRemediations,omitempty"`
+}
+
+// HostStageRemediation is the remediation applied to an agent which stayed in an installation stage longer than the
+// timeout of the stage.
+type HostStageRemediation struct {
+	// HostID is the ID of the host of the agent.
+	HostID string `json:"hostID"`
+
+	// Hostname is the hostname of the agent.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// Stage is the installation stage the agent is stuck in.
+	Stage string `json:"stage"`
+
+	// Remediation is the last remediation applied to the agent.
+	Remediation string `json:"remediation"`
+
+	// Attempts is the number of remediations applied to the agent in the stage.
+	// +optional
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// LastRemediationTime is the time the last remediation was applied.
+	// +optional
+	LastRemediationTime *metav1.Time `json:"lastRemediationTime,omitempty"`
 }
 
 type DebugInfo struct {
@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/assisted-service/api/common"
 	"github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -153,6 +154,20 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = new(ExternalPlatformSpec)
 		**out = **in
 	}
+	if in.HostRoleRules != nil {
+		in, out := &in.HostRoleRules, &out.HostRoleRules
+		*out = make([]HostRoleRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostStageTimeoutPolicies != nil {
+		in, out := &in.HostStageTimeoutPolicies, &out.HostStageTimeoutPolicies
+		*out = make([]HostStageTimeoutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallSpec.
@@ -212,6 +227,13 @@ func (in *AgentClusterInstallStatus) DeepCopyInto(out *AgentClusterInstallStatus
 			(*out)[key] = outVal
 		}
 	}
+	if in.HostStageRemediations != nil {
+		in, out := &in.HostStageRemediations, &out.HostStageRemediations
+		*out = make([]HostStageRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallStatus.
@@ -339,6 +361,72 @@ func (in *ExternalPlatformSpec) DeepCopy() *ExternalPlatformSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostRoleRule) DeepCopyInto(out *HostRoleRule) {
+	*out = *in
+	if in.MacAddresses != nil {
+		in, out := &in.MacAddresses, &out.MacAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostRoleRule.
+func (in *HostRoleRule) DeepCopy() *HostRoleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HostRoleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostStageRemediation) DeepCopyInto(out *HostStageRemediation) {
+	*out = *in
+	if in.LastRemediationTime != nil {
+		in, out := &in.LastRemediationTime, &out.LastRemediationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStageRemediation.
+func (in *HostStageRemediation) DeepCopy() *HostStageRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(HostStageRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostStageTimeoutPolicy) DeepCopyInto(out *HostStageTimeoutPolicy) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostStageTimeoutPolicy.
+func (in *HostStageTimeoutPolicy) DeepCopy() *HostStageTimeoutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HostStageTimeoutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IgnitionEndpoint) DeepCopyInto(out *IgnitionEndpoint) {
 	*out = *in
@@ -198,6 +198,15 @@ type AgentSpec struct {
 	IgnitionEndpointHTTPHeaders map[string]string `json:"ignitionEndpointHTTPHeaders,omitempty"`
 	// NodeLabels are the labels to be applied on the node associated with this agent
 	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// Decommission requests the removal of the node of the installed agent from its cluster
+	// +optional
+	Decommission *DecommissionSpec `json:"decommission,omitempty"`
+}
+
+type DecommissionSpec struct {
+	// WipeDisks requests the agent to wipe the disks of the host once its node is removed
+	// +optional
+	WipeDisks bool `json:"wipeDisks,omitempty"`
 }
 
 type IgnitionEndpointTokenReference struct {
@@ -220,6 +229,10 @@ type HostProgressInfo struct {
 	StageStartTime *metav1.Time `json:"stageStartTime,omitempty"`
 	// host field: progress: stage_updated_at
 	StageUpdateTime *metav1.Time `json:"stageUpdateTime,omitempty"`
+	// Remediation applied because the current stage outlasted the timeout policy of the cluster
+	StageRemediation models.HostStageRemediation `json:"stageRemediation,omitempty"`
+	// Time at which the remediation of the current stage was last applied
+	StageRemediationTime *metav1.Time `json:"stageRemediationTime,omitempty"`
 }
 
 type HostNTPSources struct {
@@ -247,6 +260,14 @@ type AgentStatus struct {
 	// InstallationDiskID is the disk that will be used for the installation.
 	// +optional
 	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+
+	// DecommissionStage is the current stage of the decommission of the agent
+	// +optional
+	DecommissionStage string `json:"decommissionStage,omitempty"`
+
+	// DecommissionInfo is additional information about the current decommission stage
+	// +optional
+	DecommissionInfo string `json:"decommissionInfo,omitempty"`
 }
 
 type DebugInfo struct {
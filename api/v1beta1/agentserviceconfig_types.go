@@ -122,6 +122,34 @@ type AgentServiceConfigSpec struct {
 	// +optional
 	OSImageAdditionalParamsRef *corev1.LocalObjectReference `json:"OSImageAdditionalParamsRef,omitempty"`
 
+	// CSRApprovalPolicy defines additional rules the certificate signing requests of the day-2 hosts must pass to be
+	// approved automatically.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSR approval policy for day-2 hosts"
+	// +optional
+	CSRApprovalPolicy *CSRApprovalPolicy `json:"csrApprovalPolicy,omitempty"`
+}
+
+// CSRApprovalPolicy defines the rules, in addition to the match of the request with its agent, that the certificate
+// signing requests of the day-2 hosts must pass to be approved automatically. The requests failing a rule are left
+// for a manual approval.
+type CSRApprovalPolicy struct {
+	// HostIdentity binds the serving CSR of the node to the host: the system UUID reported by the node must be the ID
+	// of the agent, which the agent derives from the system UUID of the host, and the IP addresses of the CSR must be
+	// addresses reported by the host in its inventory. The client CSR is sent before the node exists and carries only
+	// the node name, which every policy matches with the hostname of the agent, so it isn't checked by this rule.
+	// +optional
+	HostIdentity bool `json:"hostIdentity,omitempty"`
+	// TPM requires the host to report a TPM 2.0 device in its inventory. The device isn't used to attest the host: the
+	// rule only checks the hardware reported by the agent.
+	// +optional
+	TPM bool `json:"tpm,omitempty"`
+	// ApprovalWindow limits the automatic approval to the given duration after the installation of the host.
+	// +optional
+	ApprovalWindow *metav1.Duration `json:"approvalWindow,omitempty"`
+	// ManualApprovalLabels are agent labels requiring a manual approval: the requests of the agents having any of
+	// these labels, with the same value, are never approved automatically.
+	// +optional
+	ManualApprovalLabels map[string]string `json:"manualApprovalLabels,omitempty"`
 }
 
 // ConditionType related to our reconcile loop in addition to all the reasons
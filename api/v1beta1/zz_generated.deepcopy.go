@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -283,6 +284,11 @@ func (in *AgentServiceConfigSpec) DeepCopyInto(out *AgentServiceConfigSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.CSRApprovalPolicy != nil {
+		in, out := &in.CSRApprovalPolicy, &out.CSRApprovalPolicy
+		*out = new(CSRApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentServiceConfigSpec.
@@ -344,6 +350,11 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Decommission != nil {
+		in, out := &in.Decommission, &out.Decommission
+		*out = new(DecommissionSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentSpec.
@@ -416,6 +427,33 @@ func (in *BootArtifacts) DeepCopy() *BootArtifacts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRApprovalPolicy) DeepCopyInto(out *CSRApprovalPolicy) {
+	*out = *in
+	if in.ApprovalWindow != nil {
+		in, out := &in.ApprovalWindow, &out.ApprovalWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ManualApprovalLabels != nil {
+		in, out := &in.ManualApprovalLabels, &out.ManualApprovalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRApprovalPolicy.
+func (in *CSRApprovalPolicy) DeepCopy() *CSRApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterReference) DeepCopyInto(out *ClusterReference) {
 	*out = *in
@@ -446,6 +484,21 @@ func (in *DebugInfo) DeepCopy() *DebugInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DecommissionSpec) DeepCopyInto(out *DecommissionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecommissionSpec.
+func (in *DecommissionSpec) DeepCopy() *DecommissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DecommissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostBoot) DeepCopyInto(out *HostBoot) {
 	*out = *in
@@ -646,6 +699,10 @@ func (in *HostProgressInfo) DeepCopyInto(out *HostProgressInfo) {
 		in, out := &in.StageUpdateTime, &out.StageUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.StageRemediationTime != nil {
+		in, out := &in.StageRemediationTime, &out.StageRemediationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProgressInfo.
@@ -13,12 +13,23 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/bmc"
+	"github.com/openshift/assisted-service/client/cluster_templates"
+	"github.com/openshift/assisted-service/client/dry_run"
 	"github.com/openshift/assisted-service/client/events"
+	"github.com/openshift/assisted-service/client/hardware_inventory"
+	"github.com/openshift/assisted-service/client/host_claims"
+	"github.com/openshift/assisted-service/client/installation_timeline"
 	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/client/log_search"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
+	"github.com/openshift/assisted-service/client/network_report"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/triage"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/watch"
+	"github.com/openshift/assisted-service/client/webhooks"
 )
 
 const (
@@ -63,22 +74,44 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.Bmc = bmc.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterTemplates = cluster_templates.New(transport, strfmt.Default, c.AuthInfo)
+	cli.DryRun = dry_run.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
+	cli.HardwareInventory = hardware_inventory.New(transport, strfmt.Default, c.AuthInfo)
+	cli.HostClaims = host_claims.New(transport, strfmt.Default, c.AuthInfo)
+	cli.InstallationTimeline = installation_timeline.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
+	cli.LogSearch = log_search.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
+	cli.NetworkReport = network_report.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Triage = triage.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Watch = watch.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Webhooks = webhooks.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
-	Events         *events.Client
-	Installer      *installer.Client
-	ManagedDomains *managed_domains.Client
-	Manifests      *manifests.Client
-	Operators      *operators.Client
-	Versions       *versions.Client
-	Transport      runtime.ClientTransport
+	Bmc                  *bmc.Client
+	ClusterTemplates     *cluster_templates.Client
+	DryRun               *dry_run.Client
+	Events               *events.Client
+	HardwareInventory    *hardware_inventory.Client
+	HostClaims           *host_claims.Client
+	InstallationTimeline *installation_timeline.Client
+	Installer            *installer.Client
+	LogSearch            *log_search.Client
+	ManagedDomains       *managed_domains.Client
+	Manifests            *manifests.Client
+	NetworkReport        *network_report.Client
+	Operators            *operators.Client
+	Triage               *triage.Client
+	Versions             *versions.Client
+	Watch                *watch.Client
+	Webhooks             *webhooks.Client
+	Transport            runtime.ClientTransport
 }
@@ -0,0 +1,290 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the bmc client
+type API interface {
+	/*
+	   V2BmcHostBootIso Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it.*/
+	V2BmcHostBootIso(ctx context.Context, params *V2BmcHostBootIsoParams) (*V2BmcHostBootIsoAccepted, error)
+	/*
+	   V2BmcHostEjectVirtualMedia Ejects the virtual media of a BMC host.*/
+	V2BmcHostEjectVirtualMedia(ctx context.Context, params *V2BmcHostEjectVirtualMediaParams) (*V2BmcHostEjectVirtualMediaAccepted, error)
+	/*
+	   V2BmcHostPowerAction Powers a BMC host on or off, or restarts it.*/
+	V2BmcHostPowerAction(ctx context.Context, params *V2BmcHostPowerActionParams) (*V2BmcHostPowerActionAccepted, error)
+	/*
+	   V2CreateBmcHost Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish.*/
+	V2CreateBmcHost(ctx context.Context, params *V2CreateBmcHostParams) (*V2CreateBmcHostCreated, error)
+	/*
+	   V2DeleteBmcHost Deletes a BMC host together with its credentials. The host itself isn't changed.*/
+	V2DeleteBmcHost(ctx context.Context, params *V2DeleteBmcHostParams) (*V2DeleteBmcHostNoContent, error)
+	/*
+	   V2GetBmcHost Retrieves a BMC host.*/
+	V2GetBmcHost(ctx context.Context, params *V2GetBmcHostParams) (*V2GetBmcHostOK, error)
+	/*
+	   V2GetBmcHostStatus Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC.*/
+	V2GetBmcHostStatus(ctx context.Context, params *V2GetBmcHostStatusParams) (*V2GetBmcHostStatusOK, error)
+	/*
+	   V2ListBmcHosts Lists the BMC hosts of the infra-env.*/
+	V2ListBmcHosts(ctx context.Context, params *V2ListBmcHostsParams) (*V2ListBmcHostsOK, error)
+	/*
+	   V2UpdateBmcHost Updates the address, the credentials or the host of a BMC host.*/
+	V2UpdateBmcHost(ctx context.Context, params *V2UpdateBmcHostParams) (*V2UpdateBmcHostOK, error)
+}
+
+// New creates a new bmc API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for bmc API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2BmcHostBootIso Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it.
+*/
+func (a *Client) V2BmcHostBootIso(ctx context.Context, params *V2BmcHostBootIsoParams) (*V2BmcHostBootIsoAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2BmcHostBootIso",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2BmcHostBootIsoReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2BmcHostBootIsoAccepted), nil
+
+}
+
+/*
+V2BmcHostEjectVirtualMedia Ejects the virtual media of a BMC host.
+*/
+func (a *Client) V2BmcHostEjectVirtualMedia(ctx context.Context, params *V2BmcHostEjectVirtualMediaParams) (*V2BmcHostEjectVirtualMediaAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2BmcHostEjectVirtualMedia",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2BmcHostEjectVirtualMediaReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2BmcHostEjectVirtualMediaAccepted), nil
+
+}
+
+/*
+V2BmcHostPowerAction Powers a BMC host on or off, or restarts it.
+*/
+func (a *Client) V2BmcHostPowerAction(ctx context.Context, params *V2BmcHostPowerActionParams) (*V2BmcHostPowerActionAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2BmcHostPowerAction",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2BmcHostPowerActionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2BmcHostPowerActionAccepted), nil
+
+}
+
+/*
+V2CreateBmcHost Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish.
+*/
+func (a *Client) V2CreateBmcHost(ctx context.Context, params *V2CreateBmcHostParams) (*V2CreateBmcHostCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateBmcHost",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateBmcHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateBmcHostCreated), nil
+
+}
+
+/*
+V2DeleteBmcHost Deletes a BMC host together with its credentials. The host itself isn't changed.
+*/
+func (a *Client) V2DeleteBmcHost(ctx context.Context, params *V2DeleteBmcHostParams) (*V2DeleteBmcHostNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteBmcHost",
+		Method:             "DELETE",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteBmcHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteBmcHostNoContent), nil
+
+}
+
+/*
+V2GetBmcHost Retrieves a BMC host.
+*/
+func (a *Client) V2GetBmcHost(ctx context.Context, params *V2GetBmcHostParams) (*V2GetBmcHostOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetBmcHost",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetBmcHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetBmcHostOK), nil
+
+}
+
+/*
+V2GetBmcHostStatus Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC.
+*/
+func (a *Client) V2GetBmcHostStatus(ctx context.Context, params *V2GetBmcHostStatusParams) (*V2GetBmcHostStatusOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetBmcHostStatus",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetBmcHostStatusReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetBmcHostStatusOK), nil
+
+}
+
+/*
+V2ListBmcHosts Lists the BMC hosts of the infra-env.
+*/
+func (a *Client) V2ListBmcHosts(ctx context.Context, params *V2ListBmcHostsParams) (*V2ListBmcHostsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListBmcHosts",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListBmcHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListBmcHostsOK), nil
+
+}
+
+/*
+V2UpdateBmcHost Updates the address, the credentials or the host of a BMC host.
+*/
+func (a *Client) V2UpdateBmcHost(ctx context.Context, params *V2UpdateBmcHostParams) (*V2UpdateBmcHostOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateBmcHost",
+		Method:             "PATCH",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateBmcHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateBmcHostOK), nil
+
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2BmcHostBootIsoParams creates a new V2BmcHostBootIsoParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2BmcHostBootIsoParams() *V2BmcHostBootIsoParams {
+	return &V2BmcHostBootIsoParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2BmcHostBootIsoParamsWithTimeout creates a new V2BmcHostBootIsoParams object
+// with the ability to set a timeout on a request.
+func NewV2BmcHostBootIsoParamsWithTimeout(timeout time.Duration) *V2BmcHostBootIsoParams {
+	return &V2BmcHostBootIsoParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2BmcHostBootIsoParamsWithContext creates a new V2BmcHostBootIsoParams object
+// with the ability to set a context for a request.
+func NewV2BmcHostBootIsoParamsWithContext(ctx context.Context) *V2BmcHostBootIsoParams {
+	return &V2BmcHostBootIsoParams{
+		Context: ctx,
+	}
+}
+
+// NewV2BmcHostBootIsoParamsWithHTTPClient creates a new V2BmcHostBootIsoParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2BmcHostBootIsoParamsWithHTTPClient(client *http.Client) *V2BmcHostBootIsoParams {
+	return &V2BmcHostBootIsoParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2BmcHostBootIsoParams contains all the parameters to send to the API endpoint
+
+	for the v2 bmc host boot iso operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2BmcHostBootIsoParams struct {
+
+	/* BmcHostID.
+
+	   The BMC host.
+
+	   Format: uuid
+	*/
+	BmcHostID strfmt.UUID
+
+	/* BootIsoParams.
+
+	   The ISO and whether to restart the host.
+	*/
+	BootIsoParams *models.BmcBootIsoParams
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 bmc host boot iso params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostBootIsoParams) WithDefaults() *V2BmcHostBootIsoParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 bmc host boot iso params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostBootIsoParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithTimeout(timeout time.Duration) *V2BmcHostBootIsoParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithContext(ctx context.Context) *V2BmcHostBootIsoParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithHTTPClient(client *http.Client) *V2BmcHostBootIsoParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBmcHostID adds the bmcHostID to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithBmcHostID(bmcHostID strfmt.UUID) *V2BmcHostBootIsoParams {
+	o.SetBmcHostID(bmcHostID)
+	return o
+}
+
+// SetBmcHostID adds the bmcHostId to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetBmcHostID(bmcHostID strfmt.UUID) {
+	o.BmcHostID = bmcHostID
+}
+
+// WithBootIsoParams adds the bootIsoParams to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithBootIsoParams(bootIsoParams *models.BmcBootIsoParams) *V2BmcHostBootIsoParams {
+	o.SetBootIsoParams(bootIsoParams)
+	return o
+}
+
+// SetBootIsoParams adds the bootIsoParams to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetBootIsoParams(bootIsoParams *models.BmcBootIsoParams) {
+	o.BootIsoParams = bootIsoParams
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2BmcHostBootIsoParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 bmc host boot iso params
+func (o *V2BmcHostBootIsoParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2BmcHostBootIsoParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param bmc_host_id
+	if err := r.SetPathParam("bmc_host_id", o.BmcHostID.String()); err != nil {
+		return err
+	}
+	if o.BootIsoParams != nil {
+		if err := r.SetBodyParam(o.BootIsoParams); err != nil {
+			return err
+		}
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
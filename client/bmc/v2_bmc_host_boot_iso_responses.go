@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostBootIsoReader is a Reader for the V2BmcHostBootIso structure.
+type V2BmcHostBootIsoReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2BmcHostBootIsoReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2BmcHostBootIsoAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2BmcHostBootIsoBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2BmcHostBootIsoUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2BmcHostBootIsoForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2BmcHostBootIsoNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2BmcHostBootIsoConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2BmcHostBootIsoInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2BmcHostBootIsoAccepted creates a V2BmcHostBootIsoAccepted with default headers values
+func NewV2BmcHostBootIsoAccepted() *V2BmcHostBootIsoAccepted {
+	return &V2BmcHostBootIsoAccepted{}
+}
+
+/*
+V2BmcHostBootIsoAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2BmcHostBootIsoAccepted struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso accepted response has a 2xx status code
+func (o *V2BmcHostBootIsoAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso accepted response has a 3xx status code
+func (o *V2BmcHostBootIsoAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso accepted response has a 4xx status code
+func (o *V2BmcHostBootIsoAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host boot iso accepted response has a 5xx status code
+func (o *V2BmcHostBootIsoAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso accepted response a status code equal to that given
+func (o *V2BmcHostBootIsoAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2BmcHostBootIsoAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoAccepted) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoBadRequest creates a V2BmcHostBootIsoBadRequest with default headers values
+func NewV2BmcHostBootIsoBadRequest() *V2BmcHostBootIsoBadRequest {
+	return &V2BmcHostBootIsoBadRequest{}
+}
+
+/*
+V2BmcHostBootIsoBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2BmcHostBootIsoBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso bad request response has a 2xx status code
+func (o *V2BmcHostBootIsoBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso bad request response has a 3xx status code
+func (o *V2BmcHostBootIsoBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso bad request response has a 4xx status code
+func (o *V2BmcHostBootIsoBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host boot iso bad request response has a 5xx status code
+func (o *V2BmcHostBootIsoBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso bad request response a status code equal to that given
+func (o *V2BmcHostBootIsoBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2BmcHostBootIsoBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoUnauthorized creates a V2BmcHostBootIsoUnauthorized with default headers values
+func NewV2BmcHostBootIsoUnauthorized() *V2BmcHostBootIsoUnauthorized {
+	return &V2BmcHostBootIsoUnauthorized{}
+}
+
+/*
+V2BmcHostBootIsoUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2BmcHostBootIsoUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso unauthorized response has a 2xx status code
+func (o *V2BmcHostBootIsoUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso unauthorized response has a 3xx status code
+func (o *V2BmcHostBootIsoUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso unauthorized response has a 4xx status code
+func (o *V2BmcHostBootIsoUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host boot iso unauthorized response has a 5xx status code
+func (o *V2BmcHostBootIsoUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso unauthorized response a status code equal to that given
+func (o *V2BmcHostBootIsoUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2BmcHostBootIsoUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoForbidden creates a V2BmcHostBootIsoForbidden with default headers values
+func NewV2BmcHostBootIsoForbidden() *V2BmcHostBootIsoForbidden {
+	return &V2BmcHostBootIsoForbidden{}
+}
+
+/*
+V2BmcHostBootIsoForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2BmcHostBootIsoForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso forbidden response has a 2xx status code
+func (o *V2BmcHostBootIsoForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso forbidden response has a 3xx status code
+func (o *V2BmcHostBootIsoForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso forbidden response has a 4xx status code
+func (o *V2BmcHostBootIsoForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host boot iso forbidden response has a 5xx status code
+func (o *V2BmcHostBootIsoForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso forbidden response a status code equal to that given
+func (o *V2BmcHostBootIsoForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2BmcHostBootIsoForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoNotFound creates a V2BmcHostBootIsoNotFound with default headers values
+func NewV2BmcHostBootIsoNotFound() *V2BmcHostBootIsoNotFound {
+	return &V2BmcHostBootIsoNotFound{}
+}
+
+/*
+V2BmcHostBootIsoNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2BmcHostBootIsoNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso not found response has a 2xx status code
+func (o *V2BmcHostBootIsoNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso not found response has a 3xx status code
+func (o *V2BmcHostBootIsoNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso not found response has a 4xx status code
+func (o *V2BmcHostBootIsoNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host boot iso not found response has a 5xx status code
+func (o *V2BmcHostBootIsoNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso not found response a status code equal to that given
+func (o *V2BmcHostBootIsoNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2BmcHostBootIsoNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoConflict creates a V2BmcHostBootIsoConflict with default headers values
+func NewV2BmcHostBootIsoConflict() *V2BmcHostBootIsoConflict {
+	return &V2BmcHostBootIsoConflict{}
+}
+
+/*
+V2BmcHostBootIsoConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2BmcHostBootIsoConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso conflict response has a 2xx status code
+func (o *V2BmcHostBootIsoConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso conflict response has a 3xx status code
+func (o *V2BmcHostBootIsoConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso conflict response has a 4xx status code
+func (o *V2BmcHostBootIsoConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host boot iso conflict response has a 5xx status code
+func (o *V2BmcHostBootIsoConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host boot iso conflict response a status code equal to that given
+func (o *V2BmcHostBootIsoConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2BmcHostBootIsoConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoConflict) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostBootIsoInternalServerError creates a V2BmcHostBootIsoInternalServerError with default headers values
+func NewV2BmcHostBootIsoInternalServerError() *V2BmcHostBootIsoInternalServerError {
+	return &V2BmcHostBootIsoInternalServerError{}
+}
+
+/*
+V2BmcHostBootIsoInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2BmcHostBootIsoInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host boot iso internal server error response has a 2xx status code
+func (o *V2BmcHostBootIsoInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host boot iso internal server error response has a 3xx status code
+func (o *V2BmcHostBootIsoInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host boot iso internal server error response has a 4xx status code
+func (o *V2BmcHostBootIsoInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host boot iso internal server error response has a 5xx status code
+func (o *V2BmcHostBootIsoInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 bmc host boot iso internal server error response a status code equal to that given
+func (o *V2BmcHostBootIsoInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2BmcHostBootIsoInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso][%d] v2BmcHostBootIsoInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostBootIsoInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostBootIsoInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2BmcHostEjectVirtualMediaParams creates a new V2BmcHostEjectVirtualMediaParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2BmcHostEjectVirtualMediaParams() *V2BmcHostEjectVirtualMediaParams {
+	return &V2BmcHostEjectVirtualMediaParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2BmcHostEjectVirtualMediaParamsWithTimeout creates a new V2BmcHostEjectVirtualMediaParams object
+// with the ability to set a timeout on a request.
+func NewV2BmcHostEjectVirtualMediaParamsWithTimeout(timeout time.Duration) *V2BmcHostEjectVirtualMediaParams {
+	return &V2BmcHostEjectVirtualMediaParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2BmcHostEjectVirtualMediaParamsWithContext creates a new V2BmcHostEjectVirtualMediaParams object
+// with the ability to set a context for a request.
+func NewV2BmcHostEjectVirtualMediaParamsWithContext(ctx context.Context) *V2BmcHostEjectVirtualMediaParams {
+	return &V2BmcHostEjectVirtualMediaParams{
+		Context: ctx,
+	}
+}
+
+// NewV2BmcHostEjectVirtualMediaParamsWithHTTPClient creates a new V2BmcHostEjectVirtualMediaParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2BmcHostEjectVirtualMediaParamsWithHTTPClient(client *http.Client) *V2BmcHostEjectVirtualMediaParams {
+	return &V2BmcHostEjectVirtualMediaParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2BmcHostEjectVirtualMediaParams contains all the parameters to send to the API endpoint
+
+	for the v2 bmc host eject virtual media operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2BmcHostEjectVirtualMediaParams struct {
+
+	/* BmcHostID.
+
+	   The BMC host.
+
+	   Format: uuid
+	*/
+	BmcHostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 bmc host eject virtual media params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostEjectVirtualMediaParams) WithDefaults() *V2BmcHostEjectVirtualMediaParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 bmc host eject virtual media params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostEjectVirtualMediaParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) WithTimeout(timeout time.Duration) *V2BmcHostEjectVirtualMediaParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) WithContext(ctx context.Context) *V2BmcHostEjectVirtualMediaParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) WithHTTPClient(client *http.Client) *V2BmcHostEjectVirtualMediaParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBmcHostID adds the bmcHostID to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) WithBmcHostID(bmcHostID strfmt.UUID) *V2BmcHostEjectVirtualMediaParams {
+	o.SetBmcHostID(bmcHostID)
+	return o
+}
+
+// SetBmcHostID adds the bmcHostId to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) SetBmcHostID(bmcHostID strfmt.UUID) {
+	o.BmcHostID = bmcHostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2BmcHostEjectVirtualMediaParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 bmc host eject virtual media params
+func (o *V2BmcHostEjectVirtualMediaParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2BmcHostEjectVirtualMediaParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param bmc_host_id
+	if err := r.SetPathParam("bmc_host_id", o.BmcHostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
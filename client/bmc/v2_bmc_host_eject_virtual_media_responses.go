@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostEjectVirtualMediaReader is a Reader for the V2BmcHostEjectVirtualMedia structure.
+type V2BmcHostEjectVirtualMediaReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2BmcHostEjectVirtualMediaReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2BmcHostEjectVirtualMediaAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2BmcHostEjectVirtualMediaUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2BmcHostEjectVirtualMediaForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2BmcHostEjectVirtualMediaNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2BmcHostEjectVirtualMediaInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2BmcHostEjectVirtualMediaAccepted creates a V2BmcHostEjectVirtualMediaAccepted with default headers values
+func NewV2BmcHostEjectVirtualMediaAccepted() *V2BmcHostEjectVirtualMediaAccepted {
+	return &V2BmcHostEjectVirtualMediaAccepted{}
+}
+
+/*
+V2BmcHostEjectVirtualMediaAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2BmcHostEjectVirtualMediaAccepted struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 bmc host eject virtual media accepted response has a 2xx status code
+func (o *V2BmcHostEjectVirtualMediaAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 bmc host eject virtual media accepted response has a 3xx status code
+func (o *V2BmcHostEjectVirtualMediaAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host eject virtual media accepted response has a 4xx status code
+func (o *V2BmcHostEjectVirtualMediaAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host eject virtual media accepted response has a 5xx status code
+func (o *V2BmcHostEjectVirtualMediaAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host eject virtual media accepted response a status code equal to that given
+func (o *V2BmcHostEjectVirtualMediaAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2BmcHostEjectVirtualMediaAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaAccepted) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2BmcHostEjectVirtualMediaAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostEjectVirtualMediaUnauthorized creates a V2BmcHostEjectVirtualMediaUnauthorized with default headers values
+func NewV2BmcHostEjectVirtualMediaUnauthorized() *V2BmcHostEjectVirtualMediaUnauthorized {
+	return &V2BmcHostEjectVirtualMediaUnauthorized{}
+}
+
+/*
+V2BmcHostEjectVirtualMediaUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2BmcHostEjectVirtualMediaUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host eject virtual media unauthorized response has a 2xx status code
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host eject virtual media unauthorized response has a 3xx status code
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host eject virtual media unauthorized response has a 4xx status code
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host eject virtual media unauthorized response has a 5xx status code
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host eject virtual media unauthorized response a status code equal to that given
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostEjectVirtualMediaForbidden creates a V2BmcHostEjectVirtualMediaForbidden with default headers values
+func NewV2BmcHostEjectVirtualMediaForbidden() *V2BmcHostEjectVirtualMediaForbidden {
+	return &V2BmcHostEjectVirtualMediaForbidden{}
+}
+
+/*
+V2BmcHostEjectVirtualMediaForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2BmcHostEjectVirtualMediaForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host eject virtual media forbidden response has a 2xx status code
+func (o *V2BmcHostEjectVirtualMediaForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host eject virtual media forbidden response has a 3xx status code
+func (o *V2BmcHostEjectVirtualMediaForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host eject virtual media forbidden response has a 4xx status code
+func (o *V2BmcHostEjectVirtualMediaForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host eject virtual media forbidden response has a 5xx status code
+func (o *V2BmcHostEjectVirtualMediaForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host eject virtual media forbidden response a status code equal to that given
+func (o *V2BmcHostEjectVirtualMediaForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2BmcHostEjectVirtualMediaForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostEjectVirtualMediaForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostEjectVirtualMediaNotFound creates a V2BmcHostEjectVirtualMediaNotFound with default headers values
+func NewV2BmcHostEjectVirtualMediaNotFound() *V2BmcHostEjectVirtualMediaNotFound {
+	return &V2BmcHostEjectVirtualMediaNotFound{}
+}
+
+/*
+V2BmcHostEjectVirtualMediaNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2BmcHostEjectVirtualMediaNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host eject virtual media not found response has a 2xx status code
+func (o *V2BmcHostEjectVirtualMediaNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host eject virtual media not found response has a 3xx status code
+func (o *V2BmcHostEjectVirtualMediaNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host eject virtual media not found response has a 4xx status code
+func (o *V2BmcHostEjectVirtualMediaNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host eject virtual media not found response has a 5xx status code
+func (o *V2BmcHostEjectVirtualMediaNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host eject virtual media not found response a status code equal to that given
+func (o *V2BmcHostEjectVirtualMediaNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2BmcHostEjectVirtualMediaNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostEjectVirtualMediaNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostEjectVirtualMediaInternalServerError creates a V2BmcHostEjectVirtualMediaInternalServerError with default headers values
+func NewV2BmcHostEjectVirtualMediaInternalServerError() *V2BmcHostEjectVirtualMediaInternalServerError {
+	return &V2BmcHostEjectVirtualMediaInternalServerError{}
+}
+
+/*
+V2BmcHostEjectVirtualMediaInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2BmcHostEjectVirtualMediaInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host eject virtual media internal server error response has a 2xx status code
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host eject virtual media internal server error response has a 3xx status code
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host eject virtual media internal server error response has a 4xx status code
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host eject virtual media internal server error response has a 5xx status code
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 bmc host eject virtual media internal server error response a status code equal to that given
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media][%d] v2BmcHostEjectVirtualMediaInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
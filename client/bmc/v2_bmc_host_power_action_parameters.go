@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2BmcHostPowerActionParams creates a new V2BmcHostPowerActionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2BmcHostPowerActionParams() *V2BmcHostPowerActionParams {
+	return &V2BmcHostPowerActionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2BmcHostPowerActionParamsWithTimeout creates a new V2BmcHostPowerActionParams object
+// with the ability to set a timeout on a request.
+func NewV2BmcHostPowerActionParamsWithTimeout(timeout time.Duration) *V2BmcHostPowerActionParams {
+	return &V2BmcHostPowerActionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2BmcHostPowerActionParamsWithContext creates a new V2BmcHostPowerActionParams object
+// with the ability to set a context for a request.
+func NewV2BmcHostPowerActionParamsWithContext(ctx context.Context) *V2BmcHostPowerActionParams {
+	return &V2BmcHostPowerActionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2BmcHostPowerActionParamsWithHTTPClient creates a new V2BmcHostPowerActionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2BmcHostPowerActionParamsWithHTTPClient(client *http.Client) *V2BmcHostPowerActionParams {
+	return &V2BmcHostPowerActionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2BmcHostPowerActionParams contains all the parameters to send to the API endpoint
+
+	for the v2 bmc host power action operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2BmcHostPowerActionParams struct {
+
+	/* BmcHostID.
+
+	   The BMC host.
+
+	   Format: uuid
+	*/
+	BmcHostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	/* PowerActionParams.
+
+	   The power action.
+	*/
+	PowerActionParams *models.BmcPowerActionParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 bmc host power action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostPowerActionParams) WithDefaults() *V2BmcHostPowerActionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 bmc host power action params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2BmcHostPowerActionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithTimeout(timeout time.Duration) *V2BmcHostPowerActionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithContext(ctx context.Context) *V2BmcHostPowerActionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithHTTPClient(client *http.Client) *V2BmcHostPowerActionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBmcHostID adds the bmcHostID to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithBmcHostID(bmcHostID strfmt.UUID) *V2BmcHostPowerActionParams {
+	o.SetBmcHostID(bmcHostID)
+	return o
+}
+
+// SetBmcHostID adds the bmcHostId to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetBmcHostID(bmcHostID strfmt.UUID) {
+	o.BmcHostID = bmcHostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2BmcHostPowerActionParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithPowerActionParams adds the powerActionParams to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) WithPowerActionParams(powerActionParams *models.BmcPowerActionParams) *V2BmcHostPowerActionParams {
+	o.SetPowerActionParams(powerActionParams)
+	return o
+}
+
+// SetPowerActionParams adds the powerActionParams to the v2 bmc host power action params
+func (o *V2BmcHostPowerActionParams) SetPowerActionParams(powerActionParams *models.BmcPowerActionParams) {
+	o.PowerActionParams = powerActionParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2BmcHostPowerActionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param bmc_host_id
+	if err := r.SetPathParam("bmc_host_id", o.BmcHostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+	if o.PowerActionParams != nil {
+		if err := r.SetBodyParam(o.PowerActionParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostPowerActionReader is a Reader for the V2BmcHostPowerAction structure.
+type V2BmcHostPowerActionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2BmcHostPowerActionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2BmcHostPowerActionAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2BmcHostPowerActionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2BmcHostPowerActionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2BmcHostPowerActionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2BmcHostPowerActionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2BmcHostPowerActionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2BmcHostPowerActionAccepted creates a V2BmcHostPowerActionAccepted with default headers values
+func NewV2BmcHostPowerActionAccepted() *V2BmcHostPowerActionAccepted {
+	return &V2BmcHostPowerActionAccepted{}
+}
+
+/*
+V2BmcHostPowerActionAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2BmcHostPowerActionAccepted struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 bmc host power action accepted response has a 2xx status code
+func (o *V2BmcHostPowerActionAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 bmc host power action accepted response has a 3xx status code
+func (o *V2BmcHostPowerActionAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action accepted response has a 4xx status code
+func (o *V2BmcHostPowerActionAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host power action accepted response has a 5xx status code
+func (o *V2BmcHostPowerActionAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host power action accepted response a status code equal to that given
+func (o *V2BmcHostPowerActionAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2BmcHostPowerActionAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionAccepted) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostPowerActionBadRequest creates a V2BmcHostPowerActionBadRequest with default headers values
+func NewV2BmcHostPowerActionBadRequest() *V2BmcHostPowerActionBadRequest {
+	return &V2BmcHostPowerActionBadRequest{}
+}
+
+/*
+V2BmcHostPowerActionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2BmcHostPowerActionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host power action bad request response has a 2xx status code
+func (o *V2BmcHostPowerActionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host power action bad request response has a 3xx status code
+func (o *V2BmcHostPowerActionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action bad request response has a 4xx status code
+func (o *V2BmcHostPowerActionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host power action bad request response has a 5xx status code
+func (o *V2BmcHostPowerActionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host power action bad request response a status code equal to that given
+func (o *V2BmcHostPowerActionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2BmcHostPowerActionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostPowerActionUnauthorized creates a V2BmcHostPowerActionUnauthorized with default headers values
+func NewV2BmcHostPowerActionUnauthorized() *V2BmcHostPowerActionUnauthorized {
+	return &V2BmcHostPowerActionUnauthorized{}
+}
+
+/*
+V2BmcHostPowerActionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2BmcHostPowerActionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host power action unauthorized response has a 2xx status code
+func (o *V2BmcHostPowerActionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host power action unauthorized response has a 3xx status code
+func (o *V2BmcHostPowerActionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action unauthorized response has a 4xx status code
+func (o *V2BmcHostPowerActionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host power action unauthorized response has a 5xx status code
+func (o *V2BmcHostPowerActionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host power action unauthorized response a status code equal to that given
+func (o *V2BmcHostPowerActionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2BmcHostPowerActionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostPowerActionForbidden creates a V2BmcHostPowerActionForbidden with default headers values
+func NewV2BmcHostPowerActionForbidden() *V2BmcHostPowerActionForbidden {
+	return &V2BmcHostPowerActionForbidden{}
+}
+
+/*
+V2BmcHostPowerActionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2BmcHostPowerActionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 bmc host power action forbidden response has a 2xx status code
+func (o *V2BmcHostPowerActionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host power action forbidden response has a 3xx status code
+func (o *V2BmcHostPowerActionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action forbidden response has a 4xx status code
+func (o *V2BmcHostPowerActionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host power action forbidden response has a 5xx status code
+func (o *V2BmcHostPowerActionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host power action forbidden response a status code equal to that given
+func (o *V2BmcHostPowerActionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2BmcHostPowerActionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostPowerActionNotFound creates a V2BmcHostPowerActionNotFound with default headers values
+func NewV2BmcHostPowerActionNotFound() *V2BmcHostPowerActionNotFound {
+	return &V2BmcHostPowerActionNotFound{}
+}
+
+/*
+V2BmcHostPowerActionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2BmcHostPowerActionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host power action not found response has a 2xx status code
+func (o *V2BmcHostPowerActionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host power action not found response has a 3xx status code
+func (o *V2BmcHostPowerActionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action not found response has a 4xx status code
+func (o *V2BmcHostPowerActionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 bmc host power action not found response has a 5xx status code
+func (o *V2BmcHostPowerActionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 bmc host power action not found response a status code equal to that given
+func (o *V2BmcHostPowerActionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2BmcHostPowerActionNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2BmcHostPowerActionInternalServerError creates a V2BmcHostPowerActionInternalServerError with default headers values
+func NewV2BmcHostPowerActionInternalServerError() *V2BmcHostPowerActionInternalServerError {
+	return &V2BmcHostPowerActionInternalServerError{}
+}
+
+/*
+V2BmcHostPowerActionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2BmcHostPowerActionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 bmc host power action internal server error response has a 2xx status code
+func (o *V2BmcHostPowerActionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 bmc host power action internal server error response has a 3xx status code
+func (o *V2BmcHostPowerActionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 bmc host power action internal server error response has a 4xx status code
+func (o *V2BmcHostPowerActionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 bmc host power action internal server error response has a 5xx status code
+func (o *V2BmcHostPowerActionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 bmc host power action internal server error response a status code equal to that given
+func (o *V2BmcHostPowerActionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2BmcHostPowerActionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power][%d] v2BmcHostPowerActionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2BmcHostPowerActionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2BmcHostPowerActionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2CreateBmcHostParams creates a new V2CreateBmcHostParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2CreateBmcHostParams() *V2CreateBmcHostParams {
+	return &V2CreateBmcHostParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2CreateBmcHostParamsWithTimeout creates a new V2CreateBmcHostParams object
+// with the ability to set a timeout on a request.
+func NewV2CreateBmcHostParamsWithTimeout(timeout time.Duration) *V2CreateBmcHostParams {
+	return &V2CreateBmcHostParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2CreateBmcHostParamsWithContext creates a new V2CreateBmcHostParams object
+// with the ability to set a context for a request.
+func NewV2CreateBmcHostParamsWithContext(ctx context.Context) *V2CreateBmcHostParams {
+	return &V2CreateBmcHostParams{
+		Context: ctx,
+	}
+}
+
+// NewV2CreateBmcHostParamsWithHTTPClient creates a new V2CreateBmcHostParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2CreateBmcHostParamsWithHTTPClient(client *http.Client) *V2CreateBmcHostParams {
+	return &V2CreateBmcHostParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2CreateBmcHostParams contains all the parameters to send to the API endpoint
+
+	for the v2 create bmc host operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2CreateBmcHostParams struct {
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	/* NewBmcHostParams.
+
+	   The address and the credentials of the BMC.
+	*/
+	NewBmcHostParams *models.BmcHostCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 create bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateBmcHostParams) WithDefaults() *V2CreateBmcHostParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 create bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateBmcHostParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) WithTimeout(timeout time.Duration) *V2CreateBmcHostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) WithContext(ctx context.Context) *V2CreateBmcHostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) WithHTTPClient(client *http.Client) *V2CreateBmcHostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2CreateBmcHostParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithNewBmcHostParams adds the newBmcHostParams to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) WithNewBmcHostParams(newBmcHostParams *models.BmcHostCreateParams) *V2CreateBmcHostParams {
+	o.SetNewBmcHostParams(newBmcHostParams)
+	return o
+}
+
+// SetNewBmcHostParams adds the newBmcHostParams to the v2 create bmc host params
+func (o *V2CreateBmcHostParams) SetNewBmcHostParams(newBmcHostParams *models.BmcHostCreateParams) {
+	o.NewBmcHostParams = newBmcHostParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2CreateBmcHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+	if o.NewBmcHostParams != nil {
+		if err := r.SetBodyParam(o.NewBmcHostParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateBmcHostReader is a Reader for the V2CreateBmcHost structure.
+type V2CreateBmcHostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CreateBmcHostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2CreateBmcHostCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2CreateBmcHostBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2CreateBmcHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CreateBmcHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2CreateBmcHostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CreateBmcHostInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CreateBmcHostCreated creates a V2CreateBmcHostCreated with default headers values
+func NewV2CreateBmcHostCreated() *V2CreateBmcHostCreated {
+	return &V2CreateBmcHostCreated{}
+}
+
+/*
+V2CreateBmcHostCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2CreateBmcHostCreated struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 create bmc host created response has a 2xx status code
+func (o *V2CreateBmcHostCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 create bmc host created response has a 3xx status code
+func (o *V2CreateBmcHostCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host created response has a 4xx status code
+func (o *V2CreateBmcHostCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create bmc host created response has a 5xx status code
+func (o *V2CreateBmcHostCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create bmc host created response a status code equal to that given
+func (o *V2CreateBmcHostCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2CreateBmcHostCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateBmcHostCreated) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateBmcHostCreated) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateBmcHostBadRequest creates a V2CreateBmcHostBadRequest with default headers values
+func NewV2CreateBmcHostBadRequest() *V2CreateBmcHostBadRequest {
+	return &V2CreateBmcHostBadRequest{}
+}
+
+/*
+V2CreateBmcHostBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2CreateBmcHostBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create bmc host bad request response has a 2xx status code
+func (o *V2CreateBmcHostBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create bmc host bad request response has a 3xx status code
+func (o *V2CreateBmcHostBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host bad request response has a 4xx status code
+func (o *V2CreateBmcHostBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create bmc host bad request response has a 5xx status code
+func (o *V2CreateBmcHostBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create bmc host bad request response a status code equal to that given
+func (o *V2CreateBmcHostBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2CreateBmcHostBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateBmcHostBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateBmcHostBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateBmcHostUnauthorized creates a V2CreateBmcHostUnauthorized with default headers values
+func NewV2CreateBmcHostUnauthorized() *V2CreateBmcHostUnauthorized {
+	return &V2CreateBmcHostUnauthorized{}
+}
+
+/*
+V2CreateBmcHostUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CreateBmcHostUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create bmc host unauthorized response has a 2xx status code
+func (o *V2CreateBmcHostUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create bmc host unauthorized response has a 3xx status code
+func (o *V2CreateBmcHostUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host unauthorized response has a 4xx status code
+func (o *V2CreateBmcHostUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create bmc host unauthorized response has a 5xx status code
+func (o *V2CreateBmcHostUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create bmc host unauthorized response a status code equal to that given
+func (o *V2CreateBmcHostUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2CreateBmcHostUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateBmcHostUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateBmcHostUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateBmcHostForbidden creates a V2CreateBmcHostForbidden with default headers values
+func NewV2CreateBmcHostForbidden() *V2CreateBmcHostForbidden {
+	return &V2CreateBmcHostForbidden{}
+}
+
+/*
+V2CreateBmcHostForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CreateBmcHostForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create bmc host forbidden response has a 2xx status code
+func (o *V2CreateBmcHostForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create bmc host forbidden response has a 3xx status code
+func (o *V2CreateBmcHostForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host forbidden response has a 4xx status code
+func (o *V2CreateBmcHostForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create bmc host forbidden response has a 5xx status code
+func (o *V2CreateBmcHostForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create bmc host forbidden response a status code equal to that given
+func (o *V2CreateBmcHostForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2CreateBmcHostForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateBmcHostForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateBmcHostForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateBmcHostNotFound creates a V2CreateBmcHostNotFound with default headers values
+func NewV2CreateBmcHostNotFound() *V2CreateBmcHostNotFound {
+	return &V2CreateBmcHostNotFound{}
+}
+
+/*
+V2CreateBmcHostNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2CreateBmcHostNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create bmc host not found response has a 2xx status code
+func (o *V2CreateBmcHostNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create bmc host not found response has a 3xx status code
+func (o *V2CreateBmcHostNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host not found response has a 4xx status code
+func (o *V2CreateBmcHostNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create bmc host not found response has a 5xx status code
+func (o *V2CreateBmcHostNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create bmc host not found response a status code equal to that given
+func (o *V2CreateBmcHostNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2CreateBmcHostNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2CreateBmcHostNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2CreateBmcHostNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateBmcHostInternalServerError creates a V2CreateBmcHostInternalServerError with default headers values
+func NewV2CreateBmcHostInternalServerError() *V2CreateBmcHostInternalServerError {
+	return &V2CreateBmcHostInternalServerError{}
+}
+
+/*
+V2CreateBmcHostInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CreateBmcHostInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create bmc host internal server error response has a 2xx status code
+func (o *V2CreateBmcHostInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create bmc host internal server error response has a 3xx status code
+func (o *V2CreateBmcHostInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create bmc host internal server error response has a 4xx status code
+func (o *V2CreateBmcHostInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create bmc host internal server error response has a 5xx status code
+func (o *V2CreateBmcHostInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 create bmc host internal server error response a status code equal to that given
+func (o *V2CreateBmcHostInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2CreateBmcHostInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateBmcHostInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2CreateBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateBmcHostInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateBmcHostInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
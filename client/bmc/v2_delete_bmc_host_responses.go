@@ -0,0 +1,362 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteBmcHostReader is a Reader for the V2DeleteBmcHost structure.
+type V2DeleteBmcHostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeleteBmcHostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeleteBmcHostNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeleteBmcHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeleteBmcHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeleteBmcHostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeleteBmcHostInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeleteBmcHostNoContent creates a V2DeleteBmcHostNoContent with default headers values
+func NewV2DeleteBmcHostNoContent() *V2DeleteBmcHostNoContent {
+	return &V2DeleteBmcHostNoContent{}
+}
+
+/*
+V2DeleteBmcHostNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeleteBmcHostNoContent struct {
+}
+
+// IsSuccess returns true when this v2 delete bmc host no content response has a 2xx status code
+func (o *V2DeleteBmcHostNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 delete bmc host no content response has a 3xx status code
+func (o *V2DeleteBmcHostNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete bmc host no content response has a 4xx status code
+func (o *V2DeleteBmcHostNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete bmc host no content response has a 5xx status code
+func (o *V2DeleteBmcHostNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete bmc host no content response a status code equal to that given
+func (o *V2DeleteBmcHostNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *V2DeleteBmcHostNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostNoContent ", 204)
+}
+
+func (o *V2DeleteBmcHostNoContent) String() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostNoContent ", 204)
+}
+
+func (o *V2DeleteBmcHostNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeleteBmcHostUnauthorized creates a V2DeleteBmcHostUnauthorized with default headers values
+func NewV2DeleteBmcHostUnauthorized() *V2DeleteBmcHostUnauthorized {
+	return &V2DeleteBmcHostUnauthorized{}
+}
+
+/*
+V2DeleteBmcHostUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeleteBmcHostUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete bmc host unauthorized response has a 2xx status code
+func (o *V2DeleteBmcHostUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete bmc host unauthorized response has a 3xx status code
+func (o *V2DeleteBmcHostUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete bmc host unauthorized response has a 4xx status code
+func (o *V2DeleteBmcHostUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete bmc host unauthorized response has a 5xx status code
+func (o *V2DeleteBmcHostUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete bmc host unauthorized response a status code equal to that given
+func (o *V2DeleteBmcHostUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DeleteBmcHostUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteBmcHostUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteBmcHostUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteBmcHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteBmcHostForbidden creates a V2DeleteBmcHostForbidden with default headers values
+func NewV2DeleteBmcHostForbidden() *V2DeleteBmcHostForbidden {
+	return &V2DeleteBmcHostForbidden{}
+}
+
+/*
+V2DeleteBmcHostForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeleteBmcHostForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete bmc host forbidden response has a 2xx status code
+func (o *V2DeleteBmcHostForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete bmc host forbidden response has a 3xx status code
+func (o *V2DeleteBmcHostForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete bmc host forbidden response has a 4xx status code
+func (o *V2DeleteBmcHostForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete bmc host forbidden response has a 5xx status code
+func (o *V2DeleteBmcHostForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete bmc host forbidden response a status code equal to that given
+func (o *V2DeleteBmcHostForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DeleteBmcHostForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteBmcHostForbidden) String() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteBmcHostForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteBmcHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteBmcHostNotFound creates a V2DeleteBmcHostNotFound with default headers values
+func NewV2DeleteBmcHostNotFound() *V2DeleteBmcHostNotFound {
+	return &V2DeleteBmcHostNotFound{}
+}
+
+/*
+V2DeleteBmcHostNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeleteBmcHostNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete bmc host not found response has a 2xx status code
+func (o *V2DeleteBmcHostNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete bmc host not found response has a 3xx status code
+func (o *V2DeleteBmcHostNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete bmc host not found response has a 4xx status code
+func (o *V2DeleteBmcHostNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete bmc host not found response has a 5xx status code
+func (o *V2DeleteBmcHostNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete bmc host not found response a status code equal to that given
+func (o *V2DeleteBmcHostNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DeleteBmcHostNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteBmcHostNotFound) String() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteBmcHostNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteBmcHostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteBmcHostInternalServerError creates a V2DeleteBmcHostInternalServerError with default headers values
+func NewV2DeleteBmcHostInternalServerError() *V2DeleteBmcHostInternalServerError {
+	return &V2DeleteBmcHostInternalServerError{}
+}
+
+/*
+V2DeleteBmcHostInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeleteBmcHostInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete bmc host internal server error response has a 2xx status code
+func (o *V2DeleteBmcHostInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete bmc host internal server error response has a 3xx status code
+func (o *V2DeleteBmcHostInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete bmc host internal server error response has a 4xx status code
+func (o *V2DeleteBmcHostInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete bmc host internal server error response has a 5xx status code
+func (o *V2DeleteBmcHostInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 delete bmc host internal server error response a status code equal to that given
+func (o *V2DeleteBmcHostInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DeleteBmcHostInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteBmcHostInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2DeleteBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteBmcHostInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteBmcHostInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
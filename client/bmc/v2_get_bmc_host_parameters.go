@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetBmcHostParams creates a new V2GetBmcHostParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetBmcHostParams() *V2GetBmcHostParams {
+	return &V2GetBmcHostParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetBmcHostParamsWithTimeout creates a new V2GetBmcHostParams object
+// with the ability to set a timeout on a request.
+func NewV2GetBmcHostParamsWithTimeout(timeout time.Duration) *V2GetBmcHostParams {
+	return &V2GetBmcHostParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetBmcHostParamsWithContext creates a new V2GetBmcHostParams object
+// with the ability to set a context for a request.
+func NewV2GetBmcHostParamsWithContext(ctx context.Context) *V2GetBmcHostParams {
+	return &V2GetBmcHostParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetBmcHostParamsWithHTTPClient creates a new V2GetBmcHostParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetBmcHostParamsWithHTTPClient(client *http.Client) *V2GetBmcHostParams {
+	return &V2GetBmcHostParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetBmcHostParams contains all the parameters to send to the API endpoint
+
+	for the v2 get bmc host operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetBmcHostParams struct {
+
+	/* BmcHostID.
+
+	   The BMC host.
+
+	   Format: uuid
+	*/
+	BmcHostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetBmcHostParams) WithDefaults() *V2GetBmcHostParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetBmcHostParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get bmc host params
+func (o *V2GetBmcHostParams) WithTimeout(timeout time.Duration) *V2GetBmcHostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get bmc host params
+func (o *V2GetBmcHostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get bmc host params
+func (o *V2GetBmcHostParams) WithContext(ctx context.Context) *V2GetBmcHostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get bmc host params
+func (o *V2GetBmcHostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get bmc host params
+func (o *V2GetBmcHostParams) WithHTTPClient(client *http.Client) *V2GetBmcHostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get bmc host params
+func (o *V2GetBmcHostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBmcHostID adds the bmcHostID to the v2 get bmc host params
+func (o *V2GetBmcHostParams) WithBmcHostID(bmcHostID strfmt.UUID) *V2GetBmcHostParams {
+	o.SetBmcHostID(bmcHostID)
+	return o
+}
+
+// SetBmcHostID adds the bmcHostId to the v2 get bmc host params
+func (o *V2GetBmcHostParams) SetBmcHostID(bmcHostID strfmt.UUID) {
+	o.BmcHostID = bmcHostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 get bmc host params
+func (o *V2GetBmcHostParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GetBmcHostParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 get bmc host params
+func (o *V2GetBmcHostParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetBmcHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param bmc_host_id
+	if err := r.SetPathParam("bmc_host_id", o.BmcHostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetBmcHostReader is a Reader for the V2GetBmcHost structure.
+type V2GetBmcHostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetBmcHostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetBmcHostOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetBmcHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetBmcHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetBmcHostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetBmcHostInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetBmcHostOK creates a V2GetBmcHostOK with default headers values
+func NewV2GetBmcHostOK() *V2GetBmcHostOK {
+	return &V2GetBmcHostOK{}
+}
+
+/*
+V2GetBmcHostOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetBmcHostOK struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 get bmc host o k response has a 2xx status code
+func (o *V2GetBmcHostOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get bmc host o k response has a 3xx status code
+func (o *V2GetBmcHostOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host o k response has a 4xx status code
+func (o *V2GetBmcHostOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get bmc host o k response has a 5xx status code
+func (o *V2GetBmcHostOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host o k response a status code equal to that given
+func (o *V2GetBmcHostOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetBmcHostOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetBmcHostOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetBmcHostOK) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostUnauthorized creates a V2GetBmcHostUnauthorized with default headers values
+func NewV2GetBmcHostUnauthorized() *V2GetBmcHostUnauthorized {
+	return &V2GetBmcHostUnauthorized{}
+}
+
+/*
+V2GetBmcHostUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetBmcHostUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get bmc host unauthorized response has a 2xx status code
+func (o *V2GetBmcHostUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host unauthorized response has a 3xx status code
+func (o *V2GetBmcHostUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host unauthorized response has a 4xx status code
+func (o *V2GetBmcHostUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host unauthorized response has a 5xx status code
+func (o *V2GetBmcHostUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host unauthorized response a status code equal to that given
+func (o *V2GetBmcHostUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetBmcHostUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetBmcHostUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetBmcHostUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostForbidden creates a V2GetBmcHostForbidden with default headers values
+func NewV2GetBmcHostForbidden() *V2GetBmcHostForbidden {
+	return &V2GetBmcHostForbidden{}
+}
+
+/*
+V2GetBmcHostForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetBmcHostForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get bmc host forbidden response has a 2xx status code
+func (o *V2GetBmcHostForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host forbidden response has a 3xx status code
+func (o *V2GetBmcHostForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host forbidden response has a 4xx status code
+func (o *V2GetBmcHostForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host forbidden response has a 5xx status code
+func (o *V2GetBmcHostForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host forbidden response a status code equal to that given
+func (o *V2GetBmcHostForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetBmcHostForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetBmcHostForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetBmcHostForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostNotFound creates a V2GetBmcHostNotFound with default headers values
+func NewV2GetBmcHostNotFound() *V2GetBmcHostNotFound {
+	return &V2GetBmcHostNotFound{}
+}
+
+/*
+V2GetBmcHostNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetBmcHostNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get bmc host not found response has a 2xx status code
+func (o *V2GetBmcHostNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host not found response has a 3xx status code
+func (o *V2GetBmcHostNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host not found response has a 4xx status code
+func (o *V2GetBmcHostNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host not found response has a 5xx status code
+func (o *V2GetBmcHostNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host not found response a status code equal to that given
+func (o *V2GetBmcHostNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetBmcHostNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetBmcHostNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetBmcHostNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostInternalServerError creates a V2GetBmcHostInternalServerError with default headers values
+func NewV2GetBmcHostInternalServerError() *V2GetBmcHostInternalServerError {
+	return &V2GetBmcHostInternalServerError{}
+}
+
+/*
+V2GetBmcHostInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetBmcHostInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get bmc host internal server error response has a 2xx status code
+func (o *V2GetBmcHostInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host internal server error response has a 3xx status code
+func (o *V2GetBmcHostInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host internal server error response has a 4xx status code
+func (o *V2GetBmcHostInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get bmc host internal server error response has a 5xx status code
+func (o *V2GetBmcHostInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get bmc host internal server error response a status code equal to that given
+func (o *V2GetBmcHostInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetBmcHostInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetBmcHostInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2GetBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetBmcHostInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
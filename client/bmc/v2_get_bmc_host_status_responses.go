@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetBmcHostStatusReader is a Reader for the V2GetBmcHostStatus structure.
+type V2GetBmcHostStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetBmcHostStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetBmcHostStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetBmcHostStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetBmcHostStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetBmcHostStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetBmcHostStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetBmcHostStatusOK creates a V2GetBmcHostStatusOK with default headers values
+func NewV2GetBmcHostStatusOK() *V2GetBmcHostStatusOK {
+	return &V2GetBmcHostStatusOK{}
+}
+
+/*
+V2GetBmcHostStatusOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetBmcHostStatusOK struct {
+	Payload *models.BmcHostStatus
+}
+
+// IsSuccess returns true when this v2 get bmc host status o k response has a 2xx status code
+func (o *V2GetBmcHostStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get bmc host status o k response has a 3xx status code
+func (o *V2GetBmcHostStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host status o k response has a 4xx status code
+func (o *V2GetBmcHostStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get bmc host status o k response has a 5xx status code
+func (o *V2GetBmcHostStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host status o k response a status code equal to that given
+func (o *V2GetBmcHostStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetBmcHostStatusOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusOK) GetPayload() *models.BmcHostStatus {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHostStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostStatusUnauthorized creates a V2GetBmcHostStatusUnauthorized with default headers values
+func NewV2GetBmcHostStatusUnauthorized() *V2GetBmcHostStatusUnauthorized {
+	return &V2GetBmcHostStatusUnauthorized{}
+}
+
+/*
+V2GetBmcHostStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetBmcHostStatusUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get bmc host status unauthorized response has a 2xx status code
+func (o *V2GetBmcHostStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host status unauthorized response has a 3xx status code
+func (o *V2GetBmcHostStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host status unauthorized response has a 4xx status code
+func (o *V2GetBmcHostStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host status unauthorized response has a 5xx status code
+func (o *V2GetBmcHostStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host status unauthorized response a status code equal to that given
+func (o *V2GetBmcHostStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetBmcHostStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostStatusForbidden creates a V2GetBmcHostStatusForbidden with default headers values
+func NewV2GetBmcHostStatusForbidden() *V2GetBmcHostStatusForbidden {
+	return &V2GetBmcHostStatusForbidden{}
+}
+
+/*
+V2GetBmcHostStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetBmcHostStatusForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get bmc host status forbidden response has a 2xx status code
+func (o *V2GetBmcHostStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host status forbidden response has a 3xx status code
+func (o *V2GetBmcHostStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host status forbidden response has a 4xx status code
+func (o *V2GetBmcHostStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host status forbidden response has a 5xx status code
+func (o *V2GetBmcHostStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host status forbidden response a status code equal to that given
+func (o *V2GetBmcHostStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetBmcHostStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostStatusNotFound creates a V2GetBmcHostStatusNotFound with default headers values
+func NewV2GetBmcHostStatusNotFound() *V2GetBmcHostStatusNotFound {
+	return &V2GetBmcHostStatusNotFound{}
+}
+
+/*
+V2GetBmcHostStatusNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetBmcHostStatusNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get bmc host status not found response has a 2xx status code
+func (o *V2GetBmcHostStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host status not found response has a 3xx status code
+func (o *V2GetBmcHostStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host status not found response has a 4xx status code
+func (o *V2GetBmcHostStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get bmc host status not found response has a 5xx status code
+func (o *V2GetBmcHostStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get bmc host status not found response a status code equal to that given
+func (o *V2GetBmcHostStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetBmcHostStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetBmcHostStatusInternalServerError creates a V2GetBmcHostStatusInternalServerError with default headers values
+func NewV2GetBmcHostStatusInternalServerError() *V2GetBmcHostStatusInternalServerError {
+	return &V2GetBmcHostStatusInternalServerError{}
+}
+
+/*
+V2GetBmcHostStatusInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetBmcHostStatusInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get bmc host status internal server error response has a 2xx status code
+func (o *V2GetBmcHostStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get bmc host status internal server error response has a 3xx status code
+func (o *V2GetBmcHostStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get bmc host status internal server error response has a 4xx status code
+func (o *V2GetBmcHostStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get bmc host status internal server error response has a 5xx status code
+func (o *V2GetBmcHostStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get bmc host status internal server error response a status code equal to that given
+func (o *V2GetBmcHostStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetBmcHostStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status][%d] v2GetBmcHostStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetBmcHostStatusInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetBmcHostStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
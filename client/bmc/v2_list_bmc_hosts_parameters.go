@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListBmcHostsParams creates a new V2ListBmcHostsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListBmcHostsParams() *V2ListBmcHostsParams {
+	return &V2ListBmcHostsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListBmcHostsParamsWithTimeout creates a new V2ListBmcHostsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListBmcHostsParamsWithTimeout(timeout time.Duration) *V2ListBmcHostsParams {
+	return &V2ListBmcHostsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListBmcHostsParamsWithContext creates a new V2ListBmcHostsParams object
+// with the ability to set a context for a request.
+func NewV2ListBmcHostsParamsWithContext(ctx context.Context) *V2ListBmcHostsParams {
+	return &V2ListBmcHostsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListBmcHostsParamsWithHTTPClient creates a new V2ListBmcHostsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListBmcHostsParamsWithHTTPClient(client *http.Client) *V2ListBmcHostsParams {
+	return &V2ListBmcHostsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListBmcHostsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list bmc hosts operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListBmcHostsParams struct {
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list bmc hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListBmcHostsParams) WithDefaults() *V2ListBmcHostsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list bmc hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListBmcHostsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) WithTimeout(timeout time.Duration) *V2ListBmcHostsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) WithContext(ctx context.Context) *V2ListBmcHostsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) WithHTTPClient(client *http.Client) *V2ListBmcHostsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListBmcHostsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list bmc hosts params
+func (o *V2ListBmcHostsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListBmcHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListBmcHostsReader is a Reader for the V2ListBmcHosts structure.
+type V2ListBmcHostsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListBmcHostsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListBmcHostsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListBmcHostsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListBmcHostsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListBmcHostsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListBmcHostsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListBmcHostsOK creates a V2ListBmcHostsOK with default headers values
+func NewV2ListBmcHostsOK() *V2ListBmcHostsOK {
+	return &V2ListBmcHostsOK{}
+}
+
+/*
+V2ListBmcHostsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListBmcHostsOK struct {
+	Payload models.BmcHostList
+}
+
+// IsSuccess returns true when this v2 list bmc hosts o k response has a 2xx status code
+func (o *V2ListBmcHostsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list bmc hosts o k response has a 3xx status code
+func (o *V2ListBmcHostsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bmc hosts o k response has a 4xx status code
+func (o *V2ListBmcHostsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list bmc hosts o k response has a 5xx status code
+func (o *V2ListBmcHostsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bmc hosts o k response a status code equal to that given
+func (o *V2ListBmcHostsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListBmcHostsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListBmcHostsOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListBmcHostsOK) GetPayload() models.BmcHostList {
+	return o.Payload
+}
+
+func (o *V2ListBmcHostsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBmcHostsUnauthorized creates a V2ListBmcHostsUnauthorized with default headers values
+func NewV2ListBmcHostsUnauthorized() *V2ListBmcHostsUnauthorized {
+	return &V2ListBmcHostsUnauthorized{}
+}
+
+/*
+V2ListBmcHostsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListBmcHostsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list bmc hosts unauthorized response has a 2xx status code
+func (o *V2ListBmcHostsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bmc hosts unauthorized response has a 3xx status code
+func (o *V2ListBmcHostsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bmc hosts unauthorized response has a 4xx status code
+func (o *V2ListBmcHostsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list bmc hosts unauthorized response has a 5xx status code
+func (o *V2ListBmcHostsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bmc hosts unauthorized response a status code equal to that given
+func (o *V2ListBmcHostsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListBmcHostsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListBmcHostsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListBmcHostsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListBmcHostsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBmcHostsForbidden creates a V2ListBmcHostsForbidden with default headers values
+func NewV2ListBmcHostsForbidden() *V2ListBmcHostsForbidden {
+	return &V2ListBmcHostsForbidden{}
+}
+
+/*
+V2ListBmcHostsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListBmcHostsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list bmc hosts forbidden response has a 2xx status code
+func (o *V2ListBmcHostsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bmc hosts forbidden response has a 3xx status code
+func (o *V2ListBmcHostsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bmc hosts forbidden response has a 4xx status code
+func (o *V2ListBmcHostsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list bmc hosts forbidden response has a 5xx status code
+func (o *V2ListBmcHostsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bmc hosts forbidden response a status code equal to that given
+func (o *V2ListBmcHostsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListBmcHostsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListBmcHostsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListBmcHostsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListBmcHostsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBmcHostsNotFound creates a V2ListBmcHostsNotFound with default headers values
+func NewV2ListBmcHostsNotFound() *V2ListBmcHostsNotFound {
+	return &V2ListBmcHostsNotFound{}
+}
+
+/*
+V2ListBmcHostsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListBmcHostsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list bmc hosts not found response has a 2xx status code
+func (o *V2ListBmcHostsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bmc hosts not found response has a 3xx status code
+func (o *V2ListBmcHostsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bmc hosts not found response has a 4xx status code
+func (o *V2ListBmcHostsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list bmc hosts not found response has a 5xx status code
+func (o *V2ListBmcHostsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bmc hosts not found response a status code equal to that given
+func (o *V2ListBmcHostsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListBmcHostsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListBmcHostsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListBmcHostsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListBmcHostsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBmcHostsInternalServerError creates a V2ListBmcHostsInternalServerError with default headers values
+func NewV2ListBmcHostsInternalServerError() *V2ListBmcHostsInternalServerError {
+	return &V2ListBmcHostsInternalServerError{}
+}
+
+/*
+V2ListBmcHostsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListBmcHostsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list bmc hosts internal server error response has a 2xx status code
+func (o *V2ListBmcHostsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bmc hosts internal server error response has a 3xx status code
+func (o *V2ListBmcHostsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bmc hosts internal server error response has a 4xx status code
+func (o *V2ListBmcHostsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list bmc hosts internal server error response has a 5xx status code
+func (o *V2ListBmcHostsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list bmc hosts internal server error response a status code equal to that given
+func (o *V2ListBmcHostsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListBmcHostsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListBmcHostsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/bmc-hosts][%d] v2ListBmcHostsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListBmcHostsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListBmcHostsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateBmcHostParams creates a new V2UpdateBmcHostParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateBmcHostParams() *V2UpdateBmcHostParams {
+	return &V2UpdateBmcHostParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateBmcHostParamsWithTimeout creates a new V2UpdateBmcHostParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateBmcHostParamsWithTimeout(timeout time.Duration) *V2UpdateBmcHostParams {
+	return &V2UpdateBmcHostParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateBmcHostParamsWithContext creates a new V2UpdateBmcHostParams object
+// with the ability to set a context for a request.
+func NewV2UpdateBmcHostParamsWithContext(ctx context.Context) *V2UpdateBmcHostParams {
+	return &V2UpdateBmcHostParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateBmcHostParamsWithHTTPClient creates a new V2UpdateBmcHostParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateBmcHostParamsWithHTTPClient(client *http.Client) *V2UpdateBmcHostParams {
+	return &V2UpdateBmcHostParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateBmcHostParams contains all the parameters to send to the API endpoint
+
+	for the v2 update bmc host operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateBmcHostParams struct {
+
+	/* BmcHostUpdateParams.
+
+	   The properties to update.
+	*/
+	BmcHostUpdateParams *models.BmcHostUpdateParams
+
+	/* BmcHostID.
+
+	   The BMC host.
+
+	   Format: uuid
+	*/
+	BmcHostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the BMC host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateBmcHostParams) WithDefaults() *V2UpdateBmcHostParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update bmc host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateBmcHostParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithTimeout(timeout time.Duration) *V2UpdateBmcHostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithContext(ctx context.Context) *V2UpdateBmcHostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithHTTPClient(client *http.Client) *V2UpdateBmcHostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBmcHostUpdateParams adds the bmcHostUpdateParams to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithBmcHostUpdateParams(bmcHostUpdateParams *models.BmcHostUpdateParams) *V2UpdateBmcHostParams {
+	o.SetBmcHostUpdateParams(bmcHostUpdateParams)
+	return o
+}
+
+// SetBmcHostUpdateParams adds the bmcHostUpdateParams to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetBmcHostUpdateParams(bmcHostUpdateParams *models.BmcHostUpdateParams) {
+	o.BmcHostUpdateParams = bmcHostUpdateParams
+}
+
+// WithBmcHostID adds the bmcHostID to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithBmcHostID(bmcHostID strfmt.UUID) *V2UpdateBmcHostParams {
+	o.SetBmcHostID(bmcHostID)
+	return o
+}
+
+// SetBmcHostID adds the bmcHostId to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetBmcHostID(bmcHostID strfmt.UUID) {
+	o.BmcHostID = bmcHostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2UpdateBmcHostParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 update bmc host params
+func (o *V2UpdateBmcHostParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateBmcHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.BmcHostUpdateParams != nil {
+		if err := r.SetBodyParam(o.BmcHostUpdateParams); err != nil {
+			return err
+		}
+	}
+
+	// path param bmc_host_id
+	if err := r.SetPathParam("bmc_host_id", o.BmcHostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateBmcHostReader is a Reader for the V2UpdateBmcHost structure.
+type V2UpdateBmcHostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateBmcHostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2UpdateBmcHostOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateBmcHostBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateBmcHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateBmcHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateBmcHostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateBmcHostInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateBmcHostOK creates a V2UpdateBmcHostOK with default headers values
+func NewV2UpdateBmcHostOK() *V2UpdateBmcHostOK {
+	return &V2UpdateBmcHostOK{}
+}
+
+/*
+V2UpdateBmcHostOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2UpdateBmcHostOK struct {
+	Payload *models.BmcHost
+}
+
+// IsSuccess returns true when this v2 update bmc host o k response has a 2xx status code
+func (o *V2UpdateBmcHostOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 update bmc host o k response has a 3xx status code
+func (o *V2UpdateBmcHostOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host o k response has a 4xx status code
+func (o *V2UpdateBmcHostOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update bmc host o k response has a 5xx status code
+func (o *V2UpdateBmcHostOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update bmc host o k response a status code equal to that given
+func (o *V2UpdateBmcHostOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2UpdateBmcHostOK) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateBmcHostOK) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateBmcHostOK) GetPayload() *models.BmcHost {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BmcHost)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateBmcHostBadRequest creates a V2UpdateBmcHostBadRequest with default headers values
+func NewV2UpdateBmcHostBadRequest() *V2UpdateBmcHostBadRequest {
+	return &V2UpdateBmcHostBadRequest{}
+}
+
+/*
+V2UpdateBmcHostBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateBmcHostBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update bmc host bad request response has a 2xx status code
+func (o *V2UpdateBmcHostBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update bmc host bad request response has a 3xx status code
+func (o *V2UpdateBmcHostBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host bad request response has a 4xx status code
+func (o *V2UpdateBmcHostBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update bmc host bad request response has a 5xx status code
+func (o *V2UpdateBmcHostBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update bmc host bad request response a status code equal to that given
+func (o *V2UpdateBmcHostBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2UpdateBmcHostBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateBmcHostBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateBmcHostBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateBmcHostUnauthorized creates a V2UpdateBmcHostUnauthorized with default headers values
+func NewV2UpdateBmcHostUnauthorized() *V2UpdateBmcHostUnauthorized {
+	return &V2UpdateBmcHostUnauthorized{}
+}
+
+/*
+V2UpdateBmcHostUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateBmcHostUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update bmc host unauthorized response has a 2xx status code
+func (o *V2UpdateBmcHostUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update bmc host unauthorized response has a 3xx status code
+func (o *V2UpdateBmcHostUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host unauthorized response has a 4xx status code
+func (o *V2UpdateBmcHostUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update bmc host unauthorized response has a 5xx status code
+func (o *V2UpdateBmcHostUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update bmc host unauthorized response a status code equal to that given
+func (o *V2UpdateBmcHostUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2UpdateBmcHostUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateBmcHostUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateBmcHostUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateBmcHostForbidden creates a V2UpdateBmcHostForbidden with default headers values
+func NewV2UpdateBmcHostForbidden() *V2UpdateBmcHostForbidden {
+	return &V2UpdateBmcHostForbidden{}
+}
+
+/*
+V2UpdateBmcHostForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateBmcHostForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update bmc host forbidden response has a 2xx status code
+func (o *V2UpdateBmcHostForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update bmc host forbidden response has a 3xx status code
+func (o *V2UpdateBmcHostForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host forbidden response has a 4xx status code
+func (o *V2UpdateBmcHostForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update bmc host forbidden response has a 5xx status code
+func (o *V2UpdateBmcHostForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update bmc host forbidden response a status code equal to that given
+func (o *V2UpdateBmcHostForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2UpdateBmcHostForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateBmcHostForbidden) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateBmcHostForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateBmcHostNotFound creates a V2UpdateBmcHostNotFound with default headers values
+func NewV2UpdateBmcHostNotFound() *V2UpdateBmcHostNotFound {
+	return &V2UpdateBmcHostNotFound{}
+}
+
+/*
+V2UpdateBmcHostNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateBmcHostNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update bmc host not found response has a 2xx status code
+func (o *V2UpdateBmcHostNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update bmc host not found response has a 3xx status code
+func (o *V2UpdateBmcHostNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host not found response has a 4xx status code
+func (o *V2UpdateBmcHostNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update bmc host not found response has a 5xx status code
+func (o *V2UpdateBmcHostNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update bmc host not found response a status code equal to that given
+func (o *V2UpdateBmcHostNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2UpdateBmcHostNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateBmcHostNotFound) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateBmcHostNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateBmcHostInternalServerError creates a V2UpdateBmcHostInternalServerError with default headers values
+func NewV2UpdateBmcHostInternalServerError() *V2UpdateBmcHostInternalServerError {
+	return &V2UpdateBmcHostInternalServerError{}
+}
+
+/*
+V2UpdateBmcHostInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateBmcHostInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update bmc host internal server error response has a 2xx status code
+func (o *V2UpdateBmcHostInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update bmc host internal server error response has a 3xx status code
+func (o *V2UpdateBmcHostInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update bmc host internal server error response has a 4xx status code
+func (o *V2UpdateBmcHostInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update bmc host internal server error response has a 5xx status code
+func (o *V2UpdateBmcHostInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update bmc host internal server error response a status code equal to that given
+func (o *V2UpdateBmcHostInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2UpdateBmcHostInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateBmcHostInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}][%d] v2UpdateBmcHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateBmcHostInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateBmcHostInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster templates client
+type API interface {
+	/*
+	   V2CreateClusterTemplate Creates a template from which clusters can be created repeatedly.*/
+	V2CreateClusterTemplate(ctx context.Context, params *V2CreateClusterTemplateParams) (*V2CreateClusterTemplateCreated, error)
+	/*
+	   V2DeleteClusterTemplate Deletes a cluster template. Clusters already created from it are not changed.*/
+	V2DeleteClusterTemplate(ctx context.Context, params *V2DeleteClusterTemplateParams) (*V2DeleteClusterTemplateNoContent, error)
+	/*
+	   V2GetClusterTemplate Retrieves the details of a cluster template.*/
+	V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error)
+	/*
+	   V2InstantiateClusterTemplate Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template.*/
+	V2InstantiateClusterTemplate(ctx context.Context, params *V2InstantiateClusterTemplateParams) (*V2InstantiateClusterTemplateCreated, error)
+	/*
+	   V2ListClusterTemplates Lists the cluster templates of the tenant.*/
+	V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error)
+	/*
+	   V2UpdateClusterTemplate Updates the description and the definition of a cluster template. Clusters already created from it are not changed.*/
+	V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateOK, error)
+}
+
+// New creates a new cluster templates API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster templates API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2CreateClusterTemplate Creates a template from which clusters can be created repeatedly.
+*/
+func (a *Client) V2CreateClusterTemplate(ctx context.Context, params *V2CreateClusterTemplateParams) (*V2CreateClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateClusterTemplate",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateClusterTemplateCreated), nil
+
+}
+
+/*
+V2DeleteClusterTemplate Deletes a cluster template. Clusters already created from it are not changed.
+*/
+func (a *Client) V2DeleteClusterTemplate(ctx context.Context, params *V2DeleteClusterTemplateParams) (*V2DeleteClusterTemplateNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteClusterTemplate",
+		Method:             "DELETE",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteClusterTemplateNoContent), nil
+
+}
+
+/*
+V2GetClusterTemplate Retrieves the details of a cluster template.
+*/
+func (a *Client) V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterTemplate",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterTemplateOK), nil
+
+}
+
+/*
+V2InstantiateClusterTemplate Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template.
+*/
+func (a *Client) V2InstantiateClusterTemplate(ctx context.Context, params *V2InstantiateClusterTemplateParams) (*V2InstantiateClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2InstantiateClusterTemplate",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}/actions/instantiate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2InstantiateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2InstantiateClusterTemplateCreated), nil
+
+}
+
+/*
+V2ListClusterTemplates Lists the cluster templates of the tenant.
+*/
+func (a *Client) V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterTemplates",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterTemplatesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterTemplatesOK), nil
+
+}
+
+/*
+V2UpdateClusterTemplate Updates the description and the definition of a cluster template. Clusters already created from it are not changed.
+*/
+func (a *Client) V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateClusterTemplate",
+		Method:             "PATCH",
+		PathPattern:        "/v2/cluster-templates/{cluster_template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateClusterTemplateOK), nil
+
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2CreateClusterTemplateParams creates a new V2CreateClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2CreateClusterTemplateParams() *V2CreateClusterTemplateParams {
+	return &V2CreateClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2CreateClusterTemplateParamsWithTimeout creates a new V2CreateClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2CreateClusterTemplateParamsWithTimeout(timeout time.Duration) *V2CreateClusterTemplateParams {
+	return &V2CreateClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2CreateClusterTemplateParamsWithContext creates a new V2CreateClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2CreateClusterTemplateParamsWithContext(ctx context.Context) *V2CreateClusterTemplateParams {
+	return &V2CreateClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2CreateClusterTemplateParamsWithHTTPClient creates a new V2CreateClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2CreateClusterTemplateParamsWithHTTPClient(client *http.Client) *V2CreateClusterTemplateParams {
+	return &V2CreateClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2CreateClusterTemplateParams contains all the parameters to send to the API endpoint
+
+	for the v2 create cluster template operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2CreateClusterTemplateParams struct {
+
+	/* NewClusterTemplateParams.
+
+	   The properties describing the new cluster template.
+	*/
+	NewClusterTemplateParams *models.ClusterTemplateCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 create cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateClusterTemplateParams) WithDefaults() *V2CreateClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 create cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) WithTimeout(timeout time.Duration) *V2CreateClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) WithContext(ctx context.Context) *V2CreateClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) WithHTTPClient(client *http.Client) *V2CreateClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewClusterTemplateParams adds the newClusterTemplateParams to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) WithNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) *V2CreateClusterTemplateParams {
+	o.SetNewClusterTemplateParams(newClusterTemplateParams)
+	return o
+}
+
+// SetNewClusterTemplateParams adds the newClusterTemplateParams to the v2 create cluster template params
+func (o *V2CreateClusterTemplateParams) SetNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) {
+	o.NewClusterTemplateParams = newClusterTemplateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2CreateClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewClusterTemplateParams != nil {
+		if err := r.SetBodyParam(o.NewClusterTemplateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
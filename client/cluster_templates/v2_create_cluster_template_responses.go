@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateClusterTemplateReader is a Reader for the V2CreateClusterTemplate structure.
+type V2CreateClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CreateClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2CreateClusterTemplateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2CreateClusterTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2CreateClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CreateClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CreateClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CreateClusterTemplateCreated creates a V2CreateClusterTemplateCreated with default headers values
+func NewV2CreateClusterTemplateCreated() *V2CreateClusterTemplateCreated {
+	return &V2CreateClusterTemplateCreated{}
+}
+
+/*
+V2CreateClusterTemplateCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2CreateClusterTemplateCreated struct {
+	Payload *models.ClusterTemplate
+}
+
+// IsSuccess returns true when this v2 create cluster template created response has a 2xx status code
+func (o *V2CreateClusterTemplateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 create cluster template created response has a 3xx status code
+func (o *V2CreateClusterTemplateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster template created response has a 4xx status code
+func (o *V2CreateClusterTemplateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create cluster template created response has a 5xx status code
+func (o *V2CreateClusterTemplateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster template created response a status code equal to that given
+func (o *V2CreateClusterTemplateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2CreateClusterTemplateCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateCreated) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateCreated) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *V2CreateClusterTemplateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterTemplateBadRequest creates a V2CreateClusterTemplateBadRequest with default headers values
+func NewV2CreateClusterTemplateBadRequest() *V2CreateClusterTemplateBadRequest {
+	return &V2CreateClusterTemplateBadRequest{}
+}
+
+/*
+V2CreateClusterTemplateBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2CreateClusterTemplateBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create cluster template bad request response has a 2xx status code
+func (o *V2CreateClusterTemplateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster template bad request response has a 3xx status code
+func (o *V2CreateClusterTemplateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster template bad request response has a 4xx status code
+func (o *V2CreateClusterTemplateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster template bad request response has a 5xx status code
+func (o *V2CreateClusterTemplateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster template bad request response a status code equal to that given
+func (o *V2CreateClusterTemplateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2CreateClusterTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateClusterTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterTemplateUnauthorized creates a V2CreateClusterTemplateUnauthorized with default headers values
+func NewV2CreateClusterTemplateUnauthorized() *V2CreateClusterTemplateUnauthorized {
+	return &V2CreateClusterTemplateUnauthorized{}
+}
+
+/*
+V2CreateClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CreateClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create cluster template unauthorized response has a 2xx status code
+func (o *V2CreateClusterTemplateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster template unauthorized response has a 3xx status code
+func (o *V2CreateClusterTemplateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster template unauthorized response has a 4xx status code
+func (o *V2CreateClusterTemplateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster template unauthorized response has a 5xx status code
+func (o *V2CreateClusterTemplateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster template unauthorized response a status code equal to that given
+func (o *V2CreateClusterTemplateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2CreateClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterTemplateForbidden creates a V2CreateClusterTemplateForbidden with default headers values
+func NewV2CreateClusterTemplateForbidden() *V2CreateClusterTemplateForbidden {
+	return &V2CreateClusterTemplateForbidden{}
+}
+
+/*
+V2CreateClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CreateClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create cluster template forbidden response has a 2xx status code
+func (o *V2CreateClusterTemplateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster template forbidden response has a 3xx status code
+func (o *V2CreateClusterTemplateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster template forbidden response has a 4xx status code
+func (o *V2CreateClusterTemplateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster template forbidden response has a 5xx status code
+func (o *V2CreateClusterTemplateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster template forbidden response a status code equal to that given
+func (o *V2CreateClusterTemplateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2CreateClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterTemplateInternalServerError creates a V2CreateClusterTemplateInternalServerError with default headers values
+func NewV2CreateClusterTemplateInternalServerError() *V2CreateClusterTemplateInternalServerError {
+	return &V2CreateClusterTemplateInternalServerError{}
+}
+
+/*
+V2CreateClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CreateClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create cluster template internal server error response has a 2xx status code
+func (o *V2CreateClusterTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster template internal server error response has a 3xx status code
+func (o *V2CreateClusterTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster template internal server error response has a 4xx status code
+func (o *V2CreateClusterTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create cluster template internal server error response has a 5xx status code
+func (o *V2CreateClusterTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 create cluster template internal server error response a status code equal to that given
+func (o *V2CreateClusterTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2CreateClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2CreateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
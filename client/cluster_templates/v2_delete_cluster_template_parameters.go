@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeleteClusterTemplateParams creates a new V2DeleteClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeleteClusterTemplateParams() *V2DeleteClusterTemplateParams {
+	return &V2DeleteClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeleteClusterTemplateParamsWithTimeout creates a new V2DeleteClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2DeleteClusterTemplateParamsWithTimeout(timeout time.Duration) *V2DeleteClusterTemplateParams {
+	return &V2DeleteClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeleteClusterTemplateParamsWithContext creates a new V2DeleteClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2DeleteClusterTemplateParamsWithContext(ctx context.Context) *V2DeleteClusterTemplateParams {
+	return &V2DeleteClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeleteClusterTemplateParamsWithHTTPClient creates a new V2DeleteClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeleteClusterTemplateParamsWithHTTPClient(client *http.Client) *V2DeleteClusterTemplateParams {
+	return &V2DeleteClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DeleteClusterTemplateParams contains all the parameters to send to the API endpoint
+
+	for the v2 delete cluster template operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DeleteClusterTemplateParams struct {
+
+	/* ClusterTemplateID.
+
+	   The cluster template to be deleted.
+
+	   Format: uuid
+	*/
+	ClusterTemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 delete cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeleteClusterTemplateParams) WithDefaults() *V2DeleteClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 delete cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeleteClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) WithTimeout(timeout time.Duration) *V2DeleteClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) WithContext(ctx context.Context) *V2DeleteClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) WithHTTPClient(client *http.Client) *V2DeleteClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterTemplateID adds the clusterTemplateID to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) WithClusterTemplateID(clusterTemplateID strfmt.UUID) *V2DeleteClusterTemplateParams {
+	o.SetClusterTemplateID(clusterTemplateID)
+	return o
+}
+
+// SetClusterTemplateID adds the clusterTemplateId to the v2 delete cluster template params
+func (o *V2DeleteClusterTemplateParams) SetClusterTemplateID(clusterTemplateID strfmt.UUID) {
+	o.ClusterTemplateID = clusterTemplateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeleteClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_template_id
+	if err := r.SetPathParam("cluster_template_id", o.ClusterTemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
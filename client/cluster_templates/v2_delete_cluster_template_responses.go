@@ -0,0 +1,362 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteClusterTemplateReader is a Reader for the V2DeleteClusterTemplate structure.
+type V2DeleteClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeleteClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeleteClusterTemplateNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeleteClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeleteClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeleteClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeleteClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeleteClusterTemplateNoContent creates a V2DeleteClusterTemplateNoContent with default headers values
+func NewV2DeleteClusterTemplateNoContent() *V2DeleteClusterTemplateNoContent {
+	return &V2DeleteClusterTemplateNoContent{}
+}
+
+/*
+V2DeleteClusterTemplateNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeleteClusterTemplateNoContent struct {
+}
+
+// IsSuccess returns true when this v2 delete cluster template no content response has a 2xx status code
+func (o *V2DeleteClusterTemplateNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 delete cluster template no content response has a 3xx status code
+func (o *V2DeleteClusterTemplateNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete cluster template no content response has a 4xx status code
+func (o *V2DeleteClusterTemplateNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete cluster template no content response has a 5xx status code
+func (o *V2DeleteClusterTemplateNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete cluster template no content response a status code equal to that given
+func (o *V2DeleteClusterTemplateNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *V2DeleteClusterTemplateNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateNoContent ", 204)
+}
+
+func (o *V2DeleteClusterTemplateNoContent) String() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateNoContent ", 204)
+}
+
+func (o *V2DeleteClusterTemplateNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeleteClusterTemplateUnauthorized creates a V2DeleteClusterTemplateUnauthorized with default headers values
+func NewV2DeleteClusterTemplateUnauthorized() *V2DeleteClusterTemplateUnauthorized {
+	return &V2DeleteClusterTemplateUnauthorized{}
+}
+
+/*
+V2DeleteClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeleteClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete cluster template unauthorized response has a 2xx status code
+func (o *V2DeleteClusterTemplateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete cluster template unauthorized response has a 3xx status code
+func (o *V2DeleteClusterTemplateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete cluster template unauthorized response has a 4xx status code
+func (o *V2DeleteClusterTemplateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete cluster template unauthorized response has a 5xx status code
+func (o *V2DeleteClusterTemplateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete cluster template unauthorized response a status code equal to that given
+func (o *V2DeleteClusterTemplateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DeleteClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteClusterTemplateForbidden creates a V2DeleteClusterTemplateForbidden with default headers values
+func NewV2DeleteClusterTemplateForbidden() *V2DeleteClusterTemplateForbidden {
+	return &V2DeleteClusterTemplateForbidden{}
+}
+
+/*
+V2DeleteClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeleteClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete cluster template forbidden response has a 2xx status code
+func (o *V2DeleteClusterTemplateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete cluster template forbidden response has a 3xx status code
+func (o *V2DeleteClusterTemplateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete cluster template forbidden response has a 4xx status code
+func (o *V2DeleteClusterTemplateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete cluster template forbidden response has a 5xx status code
+func (o *V2DeleteClusterTemplateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete cluster template forbidden response a status code equal to that given
+func (o *V2DeleteClusterTemplateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DeleteClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateForbidden) String() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteClusterTemplateNotFound creates a V2DeleteClusterTemplateNotFound with default headers values
+func NewV2DeleteClusterTemplateNotFound() *V2DeleteClusterTemplateNotFound {
+	return &V2DeleteClusterTemplateNotFound{}
+}
+
+/*
+V2DeleteClusterTemplateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeleteClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete cluster template not found response has a 2xx status code
+func (o *V2DeleteClusterTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete cluster template not found response has a 3xx status code
+func (o *V2DeleteClusterTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete cluster template not found response has a 4xx status code
+func (o *V2DeleteClusterTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete cluster template not found response has a 5xx status code
+func (o *V2DeleteClusterTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete cluster template not found response a status code equal to that given
+func (o *V2DeleteClusterTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DeleteClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateNotFound) String() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteClusterTemplateInternalServerError creates a V2DeleteClusterTemplateInternalServerError with default headers values
+func NewV2DeleteClusterTemplateInternalServerError() *V2DeleteClusterTemplateInternalServerError {
+	return &V2DeleteClusterTemplateInternalServerError{}
+}
+
+/*
+V2DeleteClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeleteClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete cluster template internal server error response has a 2xx status code
+func (o *V2DeleteClusterTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete cluster template internal server error response has a 3xx status code
+func (o *V2DeleteClusterTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete cluster template internal server error response has a 4xx status code
+func (o *V2DeleteClusterTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete cluster template internal server error response has a 5xx status code
+func (o *V2DeleteClusterTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 delete cluster template internal server error response a status code equal to that given
+func (o *V2DeleteClusterTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DeleteClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{cluster_template_id}][%d] v2DeleteClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterTemplateReader is a Reader for the V2GetClusterTemplate structure.
+type V2GetClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterTemplateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterTemplateOK creates a V2GetClusterTemplateOK with default headers values
+func NewV2GetClusterTemplateOK() *V2GetClusterTemplateOK {
+	return &V2GetClusterTemplateOK{}
+}
+
+/*
+V2GetClusterTemplateOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterTemplateOK struct {
+	Payload *models.ClusterTemplate
+}
+
+// IsSuccess returns true when this v2 get cluster template o k response has a 2xx status code
+func (o *V2GetClusterTemplateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster template o k response has a 3xx status code
+func (o *V2GetClusterTemplateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster template o k response has a 4xx status code
+func (o *V2GetClusterTemplateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster template o k response has a 5xx status code
+func (o *V2GetClusterTemplateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster template o k response a status code equal to that given
+func (o *V2GetClusterTemplateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterTemplateOK) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterTemplateOK) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterTemplateOK) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *V2GetClusterTemplateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterTemplateUnauthorized creates a V2GetClusterTemplateUnauthorized with default headers values
+func NewV2GetClusterTemplateUnauthorized() *V2GetClusterTemplateUnauthorized {
+	return &V2GetClusterTemplateUnauthorized{}
+}
+
+/*
+V2GetClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster template unauthorized response has a 2xx status code
+func (o *V2GetClusterTemplateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster template unauthorized response has a 3xx status code
+func (o *V2GetClusterTemplateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster template unauthorized response has a 4xx status code
+func (o *V2GetClusterTemplateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster template unauthorized response has a 5xx status code
+func (o *V2GetClusterTemplateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster template unauthorized response a status code equal to that given
+func (o *V2GetClusterTemplateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterTemplateUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterTemplateForbidden creates a V2GetClusterTemplateForbidden with default headers values
+func NewV2GetClusterTemplateForbidden() *V2GetClusterTemplateForbidden {
+	return &V2GetClusterTemplateForbidden{}
+}
+
+/*
+V2GetClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster template forbidden response has a 2xx status code
+func (o *V2GetClusterTemplateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster template forbidden response has a 3xx status code
+func (o *V2GetClusterTemplateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster template forbidden response has a 4xx status code
+func (o *V2GetClusterTemplateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster template forbidden response has a 5xx status code
+func (o *V2GetClusterTemplateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster template forbidden response a status code equal to that given
+func (o *V2GetClusterTemplateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterTemplateForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterTemplateNotFound creates a V2GetClusterTemplateNotFound with default headers values
+func NewV2GetClusterTemplateNotFound() *V2GetClusterTemplateNotFound {
+	return &V2GetClusterTemplateNotFound{}
+}
+
+/*
+V2GetClusterTemplateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster template not found response has a 2xx status code
+func (o *V2GetClusterTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster template not found response has a 3xx status code
+func (o *V2GetClusterTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster template not found response has a 4xx status code
+func (o *V2GetClusterTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster template not found response has a 5xx status code
+func (o *V2GetClusterTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster template not found response a status code equal to that given
+func (o *V2GetClusterTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterTemplateNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterTemplateInternalServerError creates a V2GetClusterTemplateInternalServerError with default headers values
+func NewV2GetClusterTemplateInternalServerError() *V2GetClusterTemplateInternalServerError {
+	return &V2GetClusterTemplateInternalServerError{}
+}
+
+/*
+V2GetClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster template internal server error response has a 2xx status code
+func (o *V2GetClusterTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster template internal server error response has a 3xx status code
+func (o *V2GetClusterTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster template internal server error response has a 4xx status code
+func (o *V2GetClusterTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster template internal server error response has a 5xx status code
+func (o *V2GetClusterTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster template internal server error response a status code equal to that given
+func (o *V2GetClusterTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-templates/{cluster_template_id}][%d] v2GetClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2InstantiateClusterTemplateParams creates a new V2InstantiateClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2InstantiateClusterTemplateParams() *V2InstantiateClusterTemplateParams {
+	return &V2InstantiateClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2InstantiateClusterTemplateParamsWithTimeout creates a new V2InstantiateClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2InstantiateClusterTemplateParamsWithTimeout(timeout time.Duration) *V2InstantiateClusterTemplateParams {
+	return &V2InstantiateClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2InstantiateClusterTemplateParamsWithContext creates a new V2InstantiateClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2InstantiateClusterTemplateParamsWithContext(ctx context.Context) *V2InstantiateClusterTemplateParams {
+	return &V2InstantiateClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2InstantiateClusterTemplateParamsWithHTTPClient creates a new V2InstantiateClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2InstantiateClusterTemplateParamsWithHTTPClient(client *http.Client) *V2InstantiateClusterTemplateParams {
+	return &V2InstantiateClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2InstantiateClusterTemplateParams contains all the parameters to send to the API endpoint
+
+	for the v2 instantiate cluster template operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2InstantiateClusterTemplateParams struct {
+
+	/* ClusterTemplateID.
+
+	   The cluster template to be instantiated.
+
+	   Format: uuid
+	*/
+	ClusterTemplateID strfmt.UUID
+
+	/* InstantiateParams.
+
+	   The properties of the new cluster which are not taken from the template.
+	*/
+	InstantiateParams *models.ClusterTemplateInstantiateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 instantiate cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InstantiateClusterTemplateParams) WithDefaults() *V2InstantiateClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 instantiate cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InstantiateClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) WithTimeout(timeout time.Duration) *V2InstantiateClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) WithContext(ctx context.Context) *V2InstantiateClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) WithHTTPClient(client *http.Client) *V2InstantiateClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterTemplateID adds the clusterTemplateID to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) WithClusterTemplateID(clusterTemplateID strfmt.UUID) *V2InstantiateClusterTemplateParams {
+	o.SetClusterTemplateID(clusterTemplateID)
+	return o
+}
+
+// SetClusterTemplateID adds the clusterTemplateId to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) SetClusterTemplateID(clusterTemplateID strfmt.UUID) {
+	o.ClusterTemplateID = clusterTemplateID
+}
+
+// WithInstantiateParams adds the instantiateParams to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) WithInstantiateParams(instantiateParams *models.ClusterTemplateInstantiateParams) *V2InstantiateClusterTemplateParams {
+	o.SetInstantiateParams(instantiateParams)
+	return o
+}
+
+// SetInstantiateParams adds the instantiateParams to the v2 instantiate cluster template params
+func (o *V2InstantiateClusterTemplateParams) SetInstantiateParams(instantiateParams *models.ClusterTemplateInstantiateParams) {
+	o.InstantiateParams = instantiateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2InstantiateClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_template_id
+	if err := r.SetPathParam("cluster_template_id", o.ClusterTemplateID.String()); err != nil {
+		return err
+	}
+	if o.InstantiateParams != nil {
+		if err := r.SetBodyParam(o.InstantiateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
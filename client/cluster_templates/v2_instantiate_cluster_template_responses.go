@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InstantiateClusterTemplateReader is a Reader for the V2InstantiateClusterTemplate structure.
+type V2InstantiateClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2InstantiateClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2InstantiateClusterTemplateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2InstantiateClusterTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2InstantiateClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2InstantiateClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2InstantiateClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2InstantiateClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2InstantiateClusterTemplateCreated creates a V2InstantiateClusterTemplateCreated with default headers values
+func NewV2InstantiateClusterTemplateCreated() *V2InstantiateClusterTemplateCreated {
+	return &V2InstantiateClusterTemplateCreated{}
+}
+
+/*
+V2InstantiateClusterTemplateCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2InstantiateClusterTemplateCreated struct {
+	Payload *models.ClusterTemplateInstance
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template created response has a 2xx status code
+func (o *V2InstantiateClusterTemplateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template created response has a 3xx status code
+func (o *V2InstantiateClusterTemplateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template created response has a 4xx status code
+func (o *V2InstantiateClusterTemplateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 instantiate cluster template created response has a 5xx status code
+func (o *V2InstantiateClusterTemplateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 instantiate cluster template created response a status code equal to that given
+func (o *V2InstantiateClusterTemplateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2InstantiateClusterTemplateCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateCreated) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateCreated) GetPayload() *models.ClusterTemplateInstance {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplateInstance)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstantiateClusterTemplateBadRequest creates a V2InstantiateClusterTemplateBadRequest with default headers values
+func NewV2InstantiateClusterTemplateBadRequest() *V2InstantiateClusterTemplateBadRequest {
+	return &V2InstantiateClusterTemplateBadRequest{}
+}
+
+/*
+V2InstantiateClusterTemplateBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2InstantiateClusterTemplateBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template bad request response has a 2xx status code
+func (o *V2InstantiateClusterTemplateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template bad request response has a 3xx status code
+func (o *V2InstantiateClusterTemplateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template bad request response has a 4xx status code
+func (o *V2InstantiateClusterTemplateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 instantiate cluster template bad request response has a 5xx status code
+func (o *V2InstantiateClusterTemplateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 instantiate cluster template bad request response a status code equal to that given
+func (o *V2InstantiateClusterTemplateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2InstantiateClusterTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstantiateClusterTemplateUnauthorized creates a V2InstantiateClusterTemplateUnauthorized with default headers values
+func NewV2InstantiateClusterTemplateUnauthorized() *V2InstantiateClusterTemplateUnauthorized {
+	return &V2InstantiateClusterTemplateUnauthorized{}
+}
+
+/*
+V2InstantiateClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2InstantiateClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template unauthorized response has a 2xx status code
+func (o *V2InstantiateClusterTemplateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template unauthorized response has a 3xx status code
+func (o *V2InstantiateClusterTemplateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template unauthorized response has a 4xx status code
+func (o *V2InstantiateClusterTemplateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 instantiate cluster template unauthorized response has a 5xx status code
+func (o *V2InstantiateClusterTemplateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 instantiate cluster template unauthorized response a status code equal to that given
+func (o *V2InstantiateClusterTemplateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2InstantiateClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstantiateClusterTemplateForbidden creates a V2InstantiateClusterTemplateForbidden with default headers values
+func NewV2InstantiateClusterTemplateForbidden() *V2InstantiateClusterTemplateForbidden {
+	return &V2InstantiateClusterTemplateForbidden{}
+}
+
+/*
+V2InstantiateClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2InstantiateClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template forbidden response has a 2xx status code
+func (o *V2InstantiateClusterTemplateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template forbidden response has a 3xx status code
+func (o *V2InstantiateClusterTemplateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template forbidden response has a 4xx status code
+func (o *V2InstantiateClusterTemplateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 instantiate cluster template forbidden response has a 5xx status code
+func (o *V2InstantiateClusterTemplateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 instantiate cluster template forbidden response a status code equal to that given
+func (o *V2InstantiateClusterTemplateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2InstantiateClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstantiateClusterTemplateNotFound creates a V2InstantiateClusterTemplateNotFound with default headers values
+func NewV2InstantiateClusterTemplateNotFound() *V2InstantiateClusterTemplateNotFound {
+	return &V2InstantiateClusterTemplateNotFound{}
+}
+
+/*
+V2InstantiateClusterTemplateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2InstantiateClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template not found response has a 2xx status code
+func (o *V2InstantiateClusterTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template not found response has a 3xx status code
+func (o *V2InstantiateClusterTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template not found response has a 4xx status code
+func (o *V2InstantiateClusterTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 instantiate cluster template not found response has a 5xx status code
+func (o *V2InstantiateClusterTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 instantiate cluster template not found response a status code equal to that given
+func (o *V2InstantiateClusterTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2InstantiateClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstantiateClusterTemplateInternalServerError creates a V2InstantiateClusterTemplateInternalServerError with default headers values
+func NewV2InstantiateClusterTemplateInternalServerError() *V2InstantiateClusterTemplateInternalServerError {
+	return &V2InstantiateClusterTemplateInternalServerError{}
+}
+
+/*
+V2InstantiateClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2InstantiateClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 instantiate cluster template internal server error response has a 2xx status code
+func (o *V2InstantiateClusterTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 instantiate cluster template internal server error response has a 3xx status code
+func (o *V2InstantiateClusterTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 instantiate cluster template internal server error response has a 4xx status code
+func (o *V2InstantiateClusterTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 instantiate cluster template internal server error response has a 5xx status code
+func (o *V2InstantiateClusterTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 instantiate cluster template internal server error response a status code equal to that given
+func (o *V2InstantiateClusterTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2InstantiateClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate][%d] v2InstantiateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InstantiateClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstantiateClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
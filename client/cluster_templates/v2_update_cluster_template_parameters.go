@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateClusterTemplateParams creates a new V2UpdateClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateClusterTemplateParams() *V2UpdateClusterTemplateParams {
+	return &V2UpdateClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateClusterTemplateParamsWithTimeout creates a new V2UpdateClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateClusterTemplateParamsWithTimeout(timeout time.Duration) *V2UpdateClusterTemplateParams {
+	return &V2UpdateClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateClusterTemplateParamsWithContext creates a new V2UpdateClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2UpdateClusterTemplateParamsWithContext(ctx context.Context) *V2UpdateClusterTemplateParams {
+	return &V2UpdateClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateClusterTemplateParamsWithHTTPClient creates a new V2UpdateClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateClusterTemplateParamsWithHTTPClient(client *http.Client) *V2UpdateClusterTemplateParams {
+	return &V2UpdateClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateClusterTemplateParams contains all the parameters to send to the API endpoint
+
+	for the v2 update cluster template operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateClusterTemplateParams struct {
+
+	/* ClusterTemplateUpdateParams.
+
+	   The properties to update.
+	*/
+	ClusterTemplateUpdateParams *models.ClusterTemplateUpdateParams
+
+	/* ClusterTemplateID.
+
+	   The cluster template to be updated.
+
+	   Format: uuid
+	*/
+	ClusterTemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateClusterTemplateParams) WithDefaults() *V2UpdateClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) WithTimeout(timeout time.Duration) *V2UpdateClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) WithContext(ctx context.Context) *V2UpdateClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) WithHTTPClient(client *http.Client) *V2UpdateClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterTemplateUpdateParams adds the clusterTemplateUpdateParams to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) WithClusterTemplateUpdateParams(clusterTemplateUpdateParams *models.ClusterTemplateUpdateParams) *V2UpdateClusterTemplateParams {
+	o.SetClusterTemplateUpdateParams(clusterTemplateUpdateParams)
+	return o
+}
+
+// SetClusterTemplateUpdateParams adds the clusterTemplateUpdateParams to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) SetClusterTemplateUpdateParams(clusterTemplateUpdateParams *models.ClusterTemplateUpdateParams) {
+	o.ClusterTemplateUpdateParams = clusterTemplateUpdateParams
+}
+
+// WithClusterTemplateID adds the clusterTemplateID to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) WithClusterTemplateID(clusterTemplateID strfmt.UUID) *V2UpdateClusterTemplateParams {
+	o.SetClusterTemplateID(clusterTemplateID)
+	return o
+}
+
+// SetClusterTemplateID adds the clusterTemplateId to the v2 update cluster template params
+func (o *V2UpdateClusterTemplateParams) SetClusterTemplateID(clusterTemplateID strfmt.UUID) {
+	o.ClusterTemplateID = clusterTemplateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.ClusterTemplateUpdateParams != nil {
+		if err := r.SetBodyParam(o.ClusterTemplateUpdateParams); err != nil {
+			return err
+		}
+	}
+
+	// path param cluster_template_id
+	if err := r.SetPathParam("cluster_template_id", o.ClusterTemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateClusterTemplateReader is a Reader for the V2UpdateClusterTemplate structure.
+type V2UpdateClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2UpdateClusterTemplateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateClusterTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateClusterTemplateOK creates a V2UpdateClusterTemplateOK with default headers values
+func NewV2UpdateClusterTemplateOK() *V2UpdateClusterTemplateOK {
+	return &V2UpdateClusterTemplateOK{}
+}
+
+/*
+V2UpdateClusterTemplateOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2UpdateClusterTemplateOK struct {
+	Payload *models.ClusterTemplate
+}
+
+// IsSuccess returns true when this v2 update cluster template o k response has a 2xx status code
+func (o *V2UpdateClusterTemplateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 update cluster template o k response has a 3xx status code
+func (o *V2UpdateClusterTemplateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template o k response has a 4xx status code
+func (o *V2UpdateClusterTemplateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update cluster template o k response has a 5xx status code
+func (o *V2UpdateClusterTemplateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster template o k response a status code equal to that given
+func (o *V2UpdateClusterTemplateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2UpdateClusterTemplateOK) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateOK) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateOK) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterTemplateBadRequest creates a V2UpdateClusterTemplateBadRequest with default headers values
+func NewV2UpdateClusterTemplateBadRequest() *V2UpdateClusterTemplateBadRequest {
+	return &V2UpdateClusterTemplateBadRequest{}
+}
+
+/*
+V2UpdateClusterTemplateBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateClusterTemplateBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster template bad request response has a 2xx status code
+func (o *V2UpdateClusterTemplateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster template bad request response has a 3xx status code
+func (o *V2UpdateClusterTemplateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template bad request response has a 4xx status code
+func (o *V2UpdateClusterTemplateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster template bad request response has a 5xx status code
+func (o *V2UpdateClusterTemplateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster template bad request response a status code equal to that given
+func (o *V2UpdateClusterTemplateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2UpdateClusterTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterTemplateUnauthorized creates a V2UpdateClusterTemplateUnauthorized with default headers values
+func NewV2UpdateClusterTemplateUnauthorized() *V2UpdateClusterTemplateUnauthorized {
+	return &V2UpdateClusterTemplateUnauthorized{}
+}
+
+/*
+V2UpdateClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update cluster template unauthorized response has a 2xx status code
+func (o *V2UpdateClusterTemplateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster template unauthorized response has a 3xx status code
+func (o *V2UpdateClusterTemplateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template unauthorized response has a 4xx status code
+func (o *V2UpdateClusterTemplateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster template unauthorized response has a 5xx status code
+func (o *V2UpdateClusterTemplateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster template unauthorized response a status code equal to that given
+func (o *V2UpdateClusterTemplateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2UpdateClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterTemplateForbidden creates a V2UpdateClusterTemplateForbidden with default headers values
+func NewV2UpdateClusterTemplateForbidden() *V2UpdateClusterTemplateForbidden {
+	return &V2UpdateClusterTemplateForbidden{}
+}
+
+/*
+V2UpdateClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update cluster template forbidden response has a 2xx status code
+func (o *V2UpdateClusterTemplateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster template forbidden response has a 3xx status code
+func (o *V2UpdateClusterTemplateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template forbidden response has a 4xx status code
+func (o *V2UpdateClusterTemplateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster template forbidden response has a 5xx status code
+func (o *V2UpdateClusterTemplateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster template forbidden response a status code equal to that given
+func (o *V2UpdateClusterTemplateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2UpdateClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateForbidden) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterTemplateNotFound creates a V2UpdateClusterTemplateNotFound with default headers values
+func NewV2UpdateClusterTemplateNotFound() *V2UpdateClusterTemplateNotFound {
+	return &V2UpdateClusterTemplateNotFound{}
+}
+
+/*
+V2UpdateClusterTemplateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster template not found response has a 2xx status code
+func (o *V2UpdateClusterTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster template not found response has a 3xx status code
+func (o *V2UpdateClusterTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template not found response has a 4xx status code
+func (o *V2UpdateClusterTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster template not found response has a 5xx status code
+func (o *V2UpdateClusterTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster template not found response a status code equal to that given
+func (o *V2UpdateClusterTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2UpdateClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateNotFound) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterTemplateInternalServerError creates a V2UpdateClusterTemplateInternalServerError with default headers values
+func NewV2UpdateClusterTemplateInternalServerError() *V2UpdateClusterTemplateInternalServerError {
+	return &V2UpdateClusterTemplateInternalServerError{}
+}
+
+/*
+V2UpdateClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster template internal server error response has a 2xx status code
+func (o *V2UpdateClusterTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster template internal server error response has a 3xx status code
+func (o *V2UpdateClusterTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster template internal server error response has a 4xx status code
+func (o *V2UpdateClusterTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update cluster template internal server error response has a 5xx status code
+func (o *V2UpdateClusterTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update cluster template internal server error response a status code equal to that given
+func (o *V2UpdateClusterTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2UpdateClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /v2/cluster-templates/{cluster_template_id}][%d] v2UpdateClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
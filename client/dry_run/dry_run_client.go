@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the dry run client
+type API interface {
+	/*
+	   V2DryRunGenerate Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate.*/
+	V2DryRunGenerate(ctx context.Context, params *V2DryRunGenerateParams) (*V2DryRunGenerateAccepted, error)
+	/*
+	   V2GetDryRunGenerate Retrieves the report of the last dry run of the cluster installation files generation.*/
+	V2GetDryRunGenerate(ctx context.Context, params *V2GetDryRunGenerateParams) (*V2GetDryRunGenerateOK, error)
+}
+
+// New creates a new dry run API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for dry run API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DryRunGenerate Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate.
+*/
+func (a *Client) V2DryRunGenerate(ctx context.Context, params *V2DryRunGenerateParams) (*V2DryRunGenerateAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DryRunGenerate",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/dry-run-generate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DryRunGenerateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DryRunGenerateAccepted), nil
+
+}
+
+/*
+V2GetDryRunGenerate Retrieves the report of the last dry run of the cluster installation files generation.
+*/
+func (a *Client) V2GetDryRunGenerate(ctx context.Context, params *V2GetDryRunGenerateParams) (*V2GetDryRunGenerateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetDryRunGenerate",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/dry-run-generate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetDryRunGenerateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetDryRunGenerateOK), nil
+
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DryRunGenerateParams creates a new V2DryRunGenerateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DryRunGenerateParams() *V2DryRunGenerateParams {
+	return &V2DryRunGenerateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DryRunGenerateParamsWithTimeout creates a new V2DryRunGenerateParams object
+// with the ability to set a timeout on a request.
+func NewV2DryRunGenerateParamsWithTimeout(timeout time.Duration) *V2DryRunGenerateParams {
+	return &V2DryRunGenerateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DryRunGenerateParamsWithContext creates a new V2DryRunGenerateParams object
+// with the ability to set a context for a request.
+func NewV2DryRunGenerateParamsWithContext(ctx context.Context) *V2DryRunGenerateParams {
+	return &V2DryRunGenerateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DryRunGenerateParamsWithHTTPClient creates a new V2DryRunGenerateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DryRunGenerateParamsWithHTTPClient(client *http.Client) *V2DryRunGenerateParams {
+	return &V2DryRunGenerateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DryRunGenerateParams contains all the parameters to send to the API endpoint
+
+	for the v2 dry run generate operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DryRunGenerateParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation files are generated.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 dry run generate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunGenerateParams) WithDefaults() *V2DryRunGenerateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 dry run generate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunGenerateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) WithTimeout(timeout time.Duration) *V2DryRunGenerateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) WithContext(ctx context.Context) *V2DryRunGenerateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) WithHTTPClient(client *http.Client) *V2DryRunGenerateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) WithClusterID(clusterID strfmt.UUID) *V2DryRunGenerateParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 dry run generate params
+func (o *V2DryRunGenerateParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DryRunGenerateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunGenerateReader is a Reader for the V2DryRunGenerate structure.
+type V2DryRunGenerateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DryRunGenerateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2DryRunGenerateAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DryRunGenerateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DryRunGenerateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DryRunGenerateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2DryRunGenerateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DryRunGenerateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DryRunGenerateAccepted creates a V2DryRunGenerateAccepted with default headers values
+func NewV2DryRunGenerateAccepted() *V2DryRunGenerateAccepted {
+	return &V2DryRunGenerateAccepted{}
+}
+
+/*
+V2DryRunGenerateAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2DryRunGenerateAccepted struct {
+	Payload *models.DryRunGenerateReport
+}
+
+// IsSuccess returns true when this v2 dry run generate accepted response has a 2xx status code
+func (o *V2DryRunGenerateAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 dry run generate accepted response has a 3xx status code
+func (o *V2DryRunGenerateAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate accepted response has a 4xx status code
+func (o *V2DryRunGenerateAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 dry run generate accepted response has a 5xx status code
+func (o *V2DryRunGenerateAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run generate accepted response a status code equal to that given
+func (o *V2DryRunGenerateAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2DryRunGenerateAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2DryRunGenerateAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2DryRunGenerateAccepted) GetPayload() *models.DryRunGenerateReport {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DryRunGenerateReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunGenerateUnauthorized creates a V2DryRunGenerateUnauthorized with default headers values
+func NewV2DryRunGenerateUnauthorized() *V2DryRunGenerateUnauthorized {
+	return &V2DryRunGenerateUnauthorized{}
+}
+
+/*
+V2DryRunGenerateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DryRunGenerateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 dry run generate unauthorized response has a 2xx status code
+func (o *V2DryRunGenerateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run generate unauthorized response has a 3xx status code
+func (o *V2DryRunGenerateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate unauthorized response has a 4xx status code
+func (o *V2DryRunGenerateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run generate unauthorized response has a 5xx status code
+func (o *V2DryRunGenerateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run generate unauthorized response a status code equal to that given
+func (o *V2DryRunGenerateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DryRunGenerateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DryRunGenerateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DryRunGenerateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunGenerateForbidden creates a V2DryRunGenerateForbidden with default headers values
+func NewV2DryRunGenerateForbidden() *V2DryRunGenerateForbidden {
+	return &V2DryRunGenerateForbidden{}
+}
+
+/*
+V2DryRunGenerateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DryRunGenerateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 dry run generate forbidden response has a 2xx status code
+func (o *V2DryRunGenerateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run generate forbidden response has a 3xx status code
+func (o *V2DryRunGenerateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate forbidden response has a 4xx status code
+func (o *V2DryRunGenerateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run generate forbidden response has a 5xx status code
+func (o *V2DryRunGenerateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run generate forbidden response a status code equal to that given
+func (o *V2DryRunGenerateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DryRunGenerateForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DryRunGenerateForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DryRunGenerateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunGenerateNotFound creates a V2DryRunGenerateNotFound with default headers values
+func NewV2DryRunGenerateNotFound() *V2DryRunGenerateNotFound {
+	return &V2DryRunGenerateNotFound{}
+}
+
+/*
+V2DryRunGenerateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DryRunGenerateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run generate not found response has a 2xx status code
+func (o *V2DryRunGenerateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run generate not found response has a 3xx status code
+func (o *V2DryRunGenerateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate not found response has a 4xx status code
+func (o *V2DryRunGenerateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run generate not found response has a 5xx status code
+func (o *V2DryRunGenerateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run generate not found response a status code equal to that given
+func (o *V2DryRunGenerateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DryRunGenerateNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DryRunGenerateNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DryRunGenerateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunGenerateConflict creates a V2DryRunGenerateConflict with default headers values
+func NewV2DryRunGenerateConflict() *V2DryRunGenerateConflict {
+	return &V2DryRunGenerateConflict{}
+}
+
+/*
+V2DryRunGenerateConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2DryRunGenerateConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run generate conflict response has a 2xx status code
+func (o *V2DryRunGenerateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run generate conflict response has a 3xx status code
+func (o *V2DryRunGenerateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate conflict response has a 4xx status code
+func (o *V2DryRunGenerateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 dry run generate conflict response has a 5xx status code
+func (o *V2DryRunGenerateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 dry run generate conflict response a status code equal to that given
+func (o *V2DryRunGenerateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2DryRunGenerateConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DryRunGenerateConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DryRunGenerateConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunGenerateInternalServerError creates a V2DryRunGenerateInternalServerError with default headers values
+func NewV2DryRunGenerateInternalServerError() *V2DryRunGenerateInternalServerError {
+	return &V2DryRunGenerateInternalServerError{}
+}
+
+/*
+V2DryRunGenerateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DryRunGenerateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 dry run generate internal server error response has a 2xx status code
+func (o *V2DryRunGenerateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 dry run generate internal server error response has a 3xx status code
+func (o *V2DryRunGenerateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 dry run generate internal server error response has a 4xx status code
+func (o *V2DryRunGenerateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 dry run generate internal server error response has a 5xx status code
+func (o *V2DryRunGenerateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 dry run generate internal server error response a status code equal to that given
+func (o *V2DryRunGenerateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DryRunGenerateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DryRunGenerateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run-generate][%d] v2DryRunGenerateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DryRunGenerateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunGenerateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
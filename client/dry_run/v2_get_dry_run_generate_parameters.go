@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetDryRunGenerateParams creates a new V2GetDryRunGenerateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetDryRunGenerateParams() *V2GetDryRunGenerateParams {
+	return &V2GetDryRunGenerateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetDryRunGenerateParamsWithTimeout creates a new V2GetDryRunGenerateParams object
+// with the ability to set a timeout on a request.
+func NewV2GetDryRunGenerateParamsWithTimeout(timeout time.Duration) *V2GetDryRunGenerateParams {
+	return &V2GetDryRunGenerateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetDryRunGenerateParamsWithContext creates a new V2GetDryRunGenerateParams object
+// with the ability to set a context for a request.
+func NewV2GetDryRunGenerateParamsWithContext(ctx context.Context) *V2GetDryRunGenerateParams {
+	return &V2GetDryRunGenerateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetDryRunGenerateParamsWithHTTPClient creates a new V2GetDryRunGenerateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetDryRunGenerateParamsWithHTTPClient(client *http.Client) *V2GetDryRunGenerateParams {
+	return &V2GetDryRunGenerateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetDryRunGenerateParams contains all the parameters to send to the API endpoint
+
+	for the v2 get dry run generate operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetDryRunGenerateParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose dry run report is retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get dry run generate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetDryRunGenerateParams) WithDefaults() *V2GetDryRunGenerateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get dry run generate params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetDryRunGenerateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) WithTimeout(timeout time.Duration) *V2GetDryRunGenerateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) WithContext(ctx context.Context) *V2GetDryRunGenerateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) WithHTTPClient(client *http.Client) *V2GetDryRunGenerateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) WithClusterID(clusterID strfmt.UUID) *V2GetDryRunGenerateParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get dry run generate params
+func (o *V2GetDryRunGenerateParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetDryRunGenerateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
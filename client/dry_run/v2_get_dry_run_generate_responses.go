@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetDryRunGenerateReader is a Reader for the V2GetDryRunGenerate structure.
+type V2GetDryRunGenerateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetDryRunGenerateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetDryRunGenerateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetDryRunGenerateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetDryRunGenerateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetDryRunGenerateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetDryRunGenerateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetDryRunGenerateOK creates a V2GetDryRunGenerateOK with default headers values
+func NewV2GetDryRunGenerateOK() *V2GetDryRunGenerateOK {
+	return &V2GetDryRunGenerateOK{}
+}
+
+/*
+V2GetDryRunGenerateOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetDryRunGenerateOK struct {
+	Payload *models.DryRunGenerateReport
+}
+
+// IsSuccess returns true when this v2 get dry run generate o k response has a 2xx status code
+func (o *V2GetDryRunGenerateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get dry run generate o k response has a 3xx status code
+func (o *V2GetDryRunGenerateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get dry run generate o k response has a 4xx status code
+func (o *V2GetDryRunGenerateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get dry run generate o k response has a 5xx status code
+func (o *V2GetDryRunGenerateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get dry run generate o k response a status code equal to that given
+func (o *V2GetDryRunGenerateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetDryRunGenerateOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateOK) GetPayload() *models.DryRunGenerateReport {
+	return o.Payload
+}
+
+func (o *V2GetDryRunGenerateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DryRunGenerateReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetDryRunGenerateUnauthorized creates a V2GetDryRunGenerateUnauthorized with default headers values
+func NewV2GetDryRunGenerateUnauthorized() *V2GetDryRunGenerateUnauthorized {
+	return &V2GetDryRunGenerateUnauthorized{}
+}
+
+/*
+V2GetDryRunGenerateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetDryRunGenerateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get dry run generate unauthorized response has a 2xx status code
+func (o *V2GetDryRunGenerateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get dry run generate unauthorized response has a 3xx status code
+func (o *V2GetDryRunGenerateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get dry run generate unauthorized response has a 4xx status code
+func (o *V2GetDryRunGenerateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get dry run generate unauthorized response has a 5xx status code
+func (o *V2GetDryRunGenerateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get dry run generate unauthorized response a status code equal to that given
+func (o *V2GetDryRunGenerateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetDryRunGenerateUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetDryRunGenerateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetDryRunGenerateForbidden creates a V2GetDryRunGenerateForbidden with default headers values
+func NewV2GetDryRunGenerateForbidden() *V2GetDryRunGenerateForbidden {
+	return &V2GetDryRunGenerateForbidden{}
+}
+
+/*
+V2GetDryRunGenerateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetDryRunGenerateForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get dry run generate forbidden response has a 2xx status code
+func (o *V2GetDryRunGenerateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get dry run generate forbidden response has a 3xx status code
+func (o *V2GetDryRunGenerateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get dry run generate forbidden response has a 4xx status code
+func (o *V2GetDryRunGenerateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get dry run generate forbidden response has a 5xx status code
+func (o *V2GetDryRunGenerateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get dry run generate forbidden response a status code equal to that given
+func (o *V2GetDryRunGenerateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetDryRunGenerateForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetDryRunGenerateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetDryRunGenerateNotFound creates a V2GetDryRunGenerateNotFound with default headers values
+func NewV2GetDryRunGenerateNotFound() *V2GetDryRunGenerateNotFound {
+	return &V2GetDryRunGenerateNotFound{}
+}
+
+/*
+V2GetDryRunGenerateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetDryRunGenerateNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get dry run generate not found response has a 2xx status code
+func (o *V2GetDryRunGenerateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get dry run generate not found response has a 3xx status code
+func (o *V2GetDryRunGenerateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get dry run generate not found response has a 4xx status code
+func (o *V2GetDryRunGenerateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get dry run generate not found response has a 5xx status code
+func (o *V2GetDryRunGenerateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get dry run generate not found response a status code equal to that given
+func (o *V2GetDryRunGenerateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetDryRunGenerateNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetDryRunGenerateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetDryRunGenerateInternalServerError creates a V2GetDryRunGenerateInternalServerError with default headers values
+func NewV2GetDryRunGenerateInternalServerError() *V2GetDryRunGenerateInternalServerError {
+	return &V2GetDryRunGenerateInternalServerError{}
+}
+
+/*
+V2GetDryRunGenerateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetDryRunGenerateInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get dry run generate internal server error response has a 2xx status code
+func (o *V2GetDryRunGenerateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get dry run generate internal server error response has a 3xx status code
+func (o *V2GetDryRunGenerateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get dry run generate internal server error response has a 4xx status code
+func (o *V2GetDryRunGenerateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get dry run generate internal server error response has a 5xx status code
+func (o *V2GetDryRunGenerateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get dry run generate internal server error response a status code equal to that given
+func (o *V2GetDryRunGenerateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetDryRunGenerateInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/dry-run-generate][%d] v2GetDryRunGenerateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetDryRunGenerateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetDryRunGenerateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the hardware inventory client
+type API interface {
+	/*
+	   V2ExportHostHardware Exports the hardware of all the hosts of the tenant matching the filters.*/
+	V2ExportHostHardware(ctx context.Context, params *V2ExportHostHardwareParams, writer io.Writer) (*V2ExportHostHardwareOK, error)
+	/*
+	   V2ListHostHardware Queries the hardware of the hosts of the tenant, as reported in their inventories.*/
+	V2ListHostHardware(ctx context.Context, params *V2ListHostHardwareParams) (*V2ListHostHardwareOK, error)
+}
+
+// New creates a new hardware inventory API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for hardware inventory API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ExportHostHardware Exports the hardware of all the hosts of the tenant matching the filters.
+*/
+func (a *Client) V2ExportHostHardware(ctx context.Context, params *V2ExportHostHardwareParams, writer io.Writer) (*V2ExportHostHardwareOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ExportHostHardware",
+		Method:             "GET",
+		PathPattern:        "/v2/hardware-inventory/export",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ExportHostHardwareReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ExportHostHardwareOK), nil
+
+}
+
+/*
+V2ListHostHardware Queries the hardware of the hosts of the tenant, as reported in their inventories.
+*/
+func (a *Client) V2ListHostHardware(ctx context.Context, params *V2ListHostHardwareParams) (*V2ListHostHardwareOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostHardware",
+		Method:             "GET",
+		PathPattern:        "/v2/hardware-inventory",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostHardwareReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostHardwareOK), nil
+
+}
@@ -0,0 +1,376 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ExportHostHardwareReader is a Reader for the V2ExportHostHardware structure.
+type V2ExportHostHardwareReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ExportHostHardwareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ExportHostHardwareOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ExportHostHardwareBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ExportHostHardwareUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ExportHostHardwareForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ExportHostHardwareInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ExportHostHardwareOK creates a V2ExportHostHardwareOK with default headers values
+func NewV2ExportHostHardwareOK(writer io.Writer) *V2ExportHostHardwareOK {
+	return &V2ExportHostHardwareOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2ExportHostHardwareOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ExportHostHardwareOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 export host hardware o k response has a 2xx status code
+func (o *V2ExportHostHardwareOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 export host hardware o k response has a 3xx status code
+func (o *V2ExportHostHardwareOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export host hardware o k response has a 4xx status code
+func (o *V2ExportHostHardwareOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 export host hardware o k response has a 5xx status code
+func (o *V2ExportHostHardwareOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export host hardware o k response a status code equal to that given
+func (o *V2ExportHostHardwareOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ExportHostHardwareOK) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ExportHostHardwareOK) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ExportHostHardwareOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2ExportHostHardwareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportHostHardwareBadRequest creates a V2ExportHostHardwareBadRequest with default headers values
+func NewV2ExportHostHardwareBadRequest() *V2ExportHostHardwareBadRequest {
+	return &V2ExportHostHardwareBadRequest{}
+}
+
+/*
+V2ExportHostHardwareBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ExportHostHardwareBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 export host hardware bad request response has a 2xx status code
+func (o *V2ExportHostHardwareBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export host hardware bad request response has a 3xx status code
+func (o *V2ExportHostHardwareBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export host hardware bad request response has a 4xx status code
+func (o *V2ExportHostHardwareBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export host hardware bad request response has a 5xx status code
+func (o *V2ExportHostHardwareBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export host hardware bad request response a status code equal to that given
+func (o *V2ExportHostHardwareBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ExportHostHardwareBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ExportHostHardwareBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ExportHostHardwareBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ExportHostHardwareBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportHostHardwareUnauthorized creates a V2ExportHostHardwareUnauthorized with default headers values
+func NewV2ExportHostHardwareUnauthorized() *V2ExportHostHardwareUnauthorized {
+	return &V2ExportHostHardwareUnauthorized{}
+}
+
+/*
+V2ExportHostHardwareUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ExportHostHardwareUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 export host hardware unauthorized response has a 2xx status code
+func (o *V2ExportHostHardwareUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export host hardware unauthorized response has a 3xx status code
+func (o *V2ExportHostHardwareUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export host hardware unauthorized response has a 4xx status code
+func (o *V2ExportHostHardwareUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export host hardware unauthorized response has a 5xx status code
+func (o *V2ExportHostHardwareUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export host hardware unauthorized response a status code equal to that given
+func (o *V2ExportHostHardwareUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ExportHostHardwareUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ExportHostHardwareUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ExportHostHardwareUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ExportHostHardwareUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportHostHardwareForbidden creates a V2ExportHostHardwareForbidden with default headers values
+func NewV2ExportHostHardwareForbidden() *V2ExportHostHardwareForbidden {
+	return &V2ExportHostHardwareForbidden{}
+}
+
+/*
+V2ExportHostHardwareForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ExportHostHardwareForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 export host hardware forbidden response has a 2xx status code
+func (o *V2ExportHostHardwareForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export host hardware forbidden response has a 3xx status code
+func (o *V2ExportHostHardwareForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export host hardware forbidden response has a 4xx status code
+func (o *V2ExportHostHardwareForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 export host hardware forbidden response has a 5xx status code
+func (o *V2ExportHostHardwareForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 export host hardware forbidden response a status code equal to that given
+func (o *V2ExportHostHardwareForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ExportHostHardwareForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ExportHostHardwareForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ExportHostHardwareForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ExportHostHardwareForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ExportHostHardwareInternalServerError creates a V2ExportHostHardwareInternalServerError with default headers values
+func NewV2ExportHostHardwareInternalServerError() *V2ExportHostHardwareInternalServerError {
+	return &V2ExportHostHardwareInternalServerError{}
+}
+
+/*
+V2ExportHostHardwareInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ExportHostHardwareInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 export host hardware internal server error response has a 2xx status code
+func (o *V2ExportHostHardwareInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 export host hardware internal server error response has a 3xx status code
+func (o *V2ExportHostHardwareInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 export host hardware internal server error response has a 4xx status code
+func (o *V2ExportHostHardwareInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 export host hardware internal server error response has a 5xx status code
+func (o *V2ExportHostHardwareInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 export host hardware internal server error response a status code equal to that given
+func (o *V2ExportHostHardwareInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ExportHostHardwareInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ExportHostHardwareInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory/export][%d] v2ExportHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ExportHostHardwareInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ExportHostHardwareInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,818 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2ListHostHardwareParams creates a new V2ListHostHardwareParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostHardwareParams() *V2ListHostHardwareParams {
+	return &V2ListHostHardwareParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostHardwareParamsWithTimeout creates a new V2ListHostHardwareParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostHardwareParamsWithTimeout(timeout time.Duration) *V2ListHostHardwareParams {
+	return &V2ListHostHardwareParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostHardwareParamsWithContext creates a new V2ListHostHardwareParams object
+// with the ability to set a context for a request.
+func NewV2ListHostHardwareParamsWithContext(ctx context.Context) *V2ListHostHardwareParams {
+	return &V2ListHostHardwareParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostHardwareParamsWithHTTPClient creates a new V2ListHostHardwareParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostHardwareParamsWithHTTPClient(client *http.Client) *V2ListHostHardwareParams {
+	return &V2ListHostHardwareParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListHostHardwareParams contains all the parameters to send to the API endpoint
+
+	for the v2 list host hardware operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListHostHardwareParams struct {
+
+	/* Bound.
+
+	   Only return the hosts bound to a cluster when true, or not bound to a cluster when false.
+	*/
+	Bound *bool
+
+	/* ClusterID.
+
+	   Only return the hosts bound to this cluster.
+
+	   Format: uuid
+	*/
+	ClusterID *strfmt.UUID
+
+	/* CPUArchitecture.
+
+	   Only return the hosts of this CPU architecture.
+	*/
+	CPUArchitecture *string
+
+	/* DiskTypes.
+
+	   Only return the hosts with disks of all these types.
+	*/
+	DiskTypes []string
+
+	/* InfraEnvID.
+
+	   Only return the hosts of this infra-env.
+
+	   Format: uuid
+	*/
+	InfraEnvID *strfmt.UUID
+
+	/* Limit.
+
+	   The maximal number of hosts returned.
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* MaxCPUCores.
+
+	   Only return the hosts with at most this number of CPU cores.
+	*/
+	MaxCPUCores *int64
+
+	/* MaxMemoryMib.
+
+	   Only return the hosts with at most this physical memory, in MiB.
+	*/
+	MaxMemoryMib *int64
+
+	/* MinCPUCores.
+
+	   Only return the hosts with at least this number of CPU cores.
+	*/
+	MinCPUCores *int64
+
+	/* MinDiskCount.
+
+	   Only return the hosts with at least this number of disks.
+	*/
+	MinDiskCount *int64
+
+	/* MinDiskSizeGb.
+
+	   Only return the hosts with a disk of at least this size, in GB.
+	*/
+	MinDiskSizeGb *int64
+
+	/* MinMemoryMib.
+
+	   Only return the hosts with at least this physical memory, in MiB.
+	*/
+	MinMemoryMib *int64
+
+	/* MinNicCount.
+
+	   Only return the hosts with at least this number of network interfaces.
+	*/
+	MinNicCount *int64
+
+	/* MinNicSpeedMbps.
+
+	   Only return the hosts with a network interface of at least this speed, in Mbps.
+	*/
+	MinNicSpeedMbps *int64
+
+	/* Offset.
+
+	   The number of matching hosts skipped before the returned ones.
+	*/
+	Offset *int64
+
+	/* Product.
+
+	   Only return the hosts of this system product name, case-sensitive.
+	*/
+	Product *string
+
+	/* Status.
+
+	   Only return the hosts in one of these states.
+	*/
+	Status []string
+
+	/* Vendor.
+
+	   Only return the hosts of this system manufacturer, case-sensitive.
+	*/
+	Vendor *string
+
+	/* Virtual.
+
+	   Only return the virtual hosts when true, or the physical hosts when false.
+	*/
+	Virtual *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host hardware params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostHardwareParams) WithDefaults() *V2ListHostHardwareParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host hardware params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostHardwareParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+
+		offsetDefault = int64(0)
+	)
+
+	val := V2ListHostHardwareParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithTimeout(timeout time.Duration) *V2ListHostHardwareParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithContext(ctx context.Context) *V2ListHostHardwareParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithHTTPClient(client *http.Client) *V2ListHostHardwareParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBound adds the bound to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithBound(bound *bool) *V2ListHostHardwareParams {
+	o.SetBound(bound)
+	return o
+}
+
+// SetBound adds the bound to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetBound(bound *bool) {
+	o.Bound = bound
+}
+
+// WithClusterID adds the clusterID to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithClusterID(clusterID *strfmt.UUID) *V2ListHostHardwareParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetClusterID(clusterID *strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithCPUArchitecture adds the cPUArchitecture to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithCPUArchitecture(cPUArchitecture *string) *V2ListHostHardwareParams {
+	o.SetCPUArchitecture(cPUArchitecture)
+	return o
+}
+
+// SetCPUArchitecture adds the cpuArchitecture to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetCPUArchitecture(cPUArchitecture *string) {
+	o.CPUArchitecture = cPUArchitecture
+}
+
+// WithDiskTypes adds the diskTypes to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithDiskTypes(diskTypes []string) *V2ListHostHardwareParams {
+	o.SetDiskTypes(diskTypes)
+	return o
+}
+
+// SetDiskTypes adds the diskTypes to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetDiskTypes(diskTypes []string) {
+	o.DiskTypes = diskTypes
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithInfraEnvID(infraEnvID *strfmt.UUID) *V2ListHostHardwareParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithLimit adds the limit to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithLimit(limit *int64) *V2ListHostHardwareParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxCPUCores adds the maxCPUCores to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMaxCPUCores(maxCPUCores *int64) *V2ListHostHardwareParams {
+	o.SetMaxCPUCores(maxCPUCores)
+	return o
+}
+
+// SetMaxCPUCores adds the maxCpuCores to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMaxCPUCores(maxCPUCores *int64) {
+	o.MaxCPUCores = maxCPUCores
+}
+
+// WithMaxMemoryMib adds the maxMemoryMib to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMaxMemoryMib(maxMemoryMib *int64) *V2ListHostHardwareParams {
+	o.SetMaxMemoryMib(maxMemoryMib)
+	return o
+}
+
+// SetMaxMemoryMib adds the maxMemoryMib to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMaxMemoryMib(maxMemoryMib *int64) {
+	o.MaxMemoryMib = maxMemoryMib
+}
+
+// WithMinCPUCores adds the minCPUCores to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinCPUCores(minCPUCores *int64) *V2ListHostHardwareParams {
+	o.SetMinCPUCores(minCPUCores)
+	return o
+}
+
+// SetMinCPUCores adds the minCpuCores to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinCPUCores(minCPUCores *int64) {
+	o.MinCPUCores = minCPUCores
+}
+
+// WithMinDiskCount adds the minDiskCount to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinDiskCount(minDiskCount *int64) *V2ListHostHardwareParams {
+	o.SetMinDiskCount(minDiskCount)
+	return o
+}
+
+// SetMinDiskCount adds the minDiskCount to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinDiskCount(minDiskCount *int64) {
+	o.MinDiskCount = minDiskCount
+}
+
+// WithMinDiskSizeGb adds the minDiskSizeGb to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinDiskSizeGb(minDiskSizeGb *int64) *V2ListHostHardwareParams {
+	o.SetMinDiskSizeGb(minDiskSizeGb)
+	return o
+}
+
+// SetMinDiskSizeGb adds the minDiskSizeGb to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinDiskSizeGb(minDiskSizeGb *int64) {
+	o.MinDiskSizeGb = minDiskSizeGb
+}
+
+// WithMinMemoryMib adds the minMemoryMib to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinMemoryMib(minMemoryMib *int64) *V2ListHostHardwareParams {
+	o.SetMinMemoryMib(minMemoryMib)
+	return o
+}
+
+// SetMinMemoryMib adds the minMemoryMib to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinMemoryMib(minMemoryMib *int64) {
+	o.MinMemoryMib = minMemoryMib
+}
+
+// WithMinNicCount adds the minNicCount to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinNicCount(minNicCount *int64) *V2ListHostHardwareParams {
+	o.SetMinNicCount(minNicCount)
+	return o
+}
+
+// SetMinNicCount adds the minNicCount to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinNicCount(minNicCount *int64) {
+	o.MinNicCount = minNicCount
+}
+
+// WithMinNicSpeedMbps adds the minNicSpeedMbps to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithMinNicSpeedMbps(minNicSpeedMbps *int64) *V2ListHostHardwareParams {
+	o.SetMinNicSpeedMbps(minNicSpeedMbps)
+	return o
+}
+
+// SetMinNicSpeedMbps adds the minNicSpeedMbps to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetMinNicSpeedMbps(minNicSpeedMbps *int64) {
+	o.MinNicSpeedMbps = minNicSpeedMbps
+}
+
+// WithOffset adds the offset to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithOffset(offset *int64) *V2ListHostHardwareParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithProduct adds the product to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithProduct(product *string) *V2ListHostHardwareParams {
+	o.SetProduct(product)
+	return o
+}
+
+// SetProduct adds the product to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetProduct(product *string) {
+	o.Product = product
+}
+
+// WithStatus adds the status to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithStatus(status []string) *V2ListHostHardwareParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetStatus(status []string) {
+	o.Status = status
+}
+
+// WithVendor adds the vendor to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithVendor(vendor *string) *V2ListHostHardwareParams {
+	o.SetVendor(vendor)
+	return o
+}
+
+// SetVendor adds the vendor to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetVendor(vendor *string) {
+	o.Vendor = vendor
+}
+
+// WithVirtual adds the virtual to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) WithVirtual(virtual *bool) *V2ListHostHardwareParams {
+	o.SetVirtual(virtual)
+	return o
+}
+
+// SetVirtual adds the virtual to the v2 list host hardware params
+func (o *V2ListHostHardwareParams) SetVirtual(virtual *bool) {
+	o.Virtual = virtual
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostHardwareParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Bound != nil {
+
+		// query param bound
+		var qrBound bool
+
+		if o.Bound != nil {
+			qrBound = *o.Bound
+		}
+		qBound := swag.FormatBool(qrBound)
+		if qBound != "" {
+
+			if err := r.SetQueryParam("bound", qBound); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID strfmt.UUID
+
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID.String()
+		if qClusterID != "" {
+
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CPUArchitecture != nil {
+
+		// query param cpu_architecture
+		var qrCPUArchitecture string
+
+		if o.CPUArchitecture != nil {
+			qrCPUArchitecture = *o.CPUArchitecture
+		}
+		qCPUArchitecture := qrCPUArchitecture
+		if qCPUArchitecture != "" {
+
+			if err := r.SetQueryParam("cpu_architecture", qCPUArchitecture); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.DiskTypes != nil {
+
+		// binding items for disk_types
+		joinedDiskTypes := o.bindParamDiskTypes(reg)
+
+		// query array param disk_types
+		if err := r.SetQueryParam("disk_types", joinedDiskTypes...); err != nil {
+			return err
+		}
+	}
+
+	if o.InfraEnvID != nil {
+
+		// query param infra_env_id
+		var qrInfraEnvID strfmt.UUID
+
+		if o.InfraEnvID != nil {
+			qrInfraEnvID = *o.InfraEnvID
+		}
+		qInfraEnvID := qrInfraEnvID.String()
+		if qInfraEnvID != "" {
+
+			if err := r.SetQueryParam("infra_env_id", qInfraEnvID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxCPUCores != nil {
+
+		// query param max_cpu_cores
+		var qrMaxCPUCores int64
+
+		if o.MaxCPUCores != nil {
+			qrMaxCPUCores = *o.MaxCPUCores
+		}
+		qMaxCPUCores := swag.FormatInt64(qrMaxCPUCores)
+		if qMaxCPUCores != "" {
+
+			if err := r.SetQueryParam("max_cpu_cores", qMaxCPUCores); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxMemoryMib != nil {
+
+		// query param max_memory_mib
+		var qrMaxMemoryMib int64
+
+		if o.MaxMemoryMib != nil {
+			qrMaxMemoryMib = *o.MaxMemoryMib
+		}
+		qMaxMemoryMib := swag.FormatInt64(qrMaxMemoryMib)
+		if qMaxMemoryMib != "" {
+
+			if err := r.SetQueryParam("max_memory_mib", qMaxMemoryMib); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinCPUCores != nil {
+
+		// query param min_cpu_cores
+		var qrMinCPUCores int64
+
+		if o.MinCPUCores != nil {
+			qrMinCPUCores = *o.MinCPUCores
+		}
+		qMinCPUCores := swag.FormatInt64(qrMinCPUCores)
+		if qMinCPUCores != "" {
+
+			if err := r.SetQueryParam("min_cpu_cores", qMinCPUCores); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinDiskCount != nil {
+
+		// query param min_disk_count
+		var qrMinDiskCount int64
+
+		if o.MinDiskCount != nil {
+			qrMinDiskCount = *o.MinDiskCount
+		}
+		qMinDiskCount := swag.FormatInt64(qrMinDiskCount)
+		if qMinDiskCount != "" {
+
+			if err := r.SetQueryParam("min_disk_count", qMinDiskCount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinDiskSizeGb != nil {
+
+		// query param min_disk_size_gb
+		var qrMinDiskSizeGb int64
+
+		if o.MinDiskSizeGb != nil {
+			qrMinDiskSizeGb = *o.MinDiskSizeGb
+		}
+		qMinDiskSizeGb := swag.FormatInt64(qrMinDiskSizeGb)
+		if qMinDiskSizeGb != "" {
+
+			if err := r.SetQueryParam("min_disk_size_gb", qMinDiskSizeGb); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinMemoryMib != nil {
+
+		// query param min_memory_mib
+		var qrMinMemoryMib int64
+
+		if o.MinMemoryMib != nil {
+			qrMinMemoryMib = *o.MinMemoryMib
+		}
+		qMinMemoryMib := swag.FormatInt64(qrMinMemoryMib)
+		if qMinMemoryMib != "" {
+
+			if err := r.SetQueryParam("min_memory_mib", qMinMemoryMib); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinNicCount != nil {
+
+		// query param min_nic_count
+		var qrMinNicCount int64
+
+		if o.MinNicCount != nil {
+			qrMinNicCount = *o.MinNicCount
+		}
+		qMinNicCount := swag.FormatInt64(qrMinNicCount)
+		if qMinNicCount != "" {
+
+			if err := r.SetQueryParam("min_nic_count", qMinNicCount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinNicSpeedMbps != nil {
+
+		// query param min_nic_speed_mbps
+		var qrMinNicSpeedMbps int64
+
+		if o.MinNicSpeedMbps != nil {
+			qrMinNicSpeedMbps = *o.MinNicSpeedMbps
+		}
+		qMinNicSpeedMbps := swag.FormatInt64(qrMinNicSpeedMbps)
+		if qMinNicSpeedMbps != "" {
+
+			if err := r.SetQueryParam("min_nic_speed_mbps", qMinNicSpeedMbps); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Product != nil {
+
+		// query param product
+		var qrProduct string
+
+		if o.Product != nil {
+			qrProduct = *o.Product
+		}
+		qProduct := qrProduct
+		if qProduct != "" {
+
+			if err := r.SetQueryParam("product", qProduct); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// binding items for status
+		joinedStatus := o.bindParamStatus(reg)
+
+		// query array param status
+		if err := r.SetQueryParam("status", joinedStatus...); err != nil {
+			return err
+		}
+	}
+
+	if o.Vendor != nil {
+
+		// query param vendor
+		var qrVendor string
+
+		if o.Vendor != nil {
+			qrVendor = *o.Vendor
+		}
+		qVendor := qrVendor
+		if qVendor != "" {
+
+			if err := r.SetQueryParam("vendor", qVendor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Virtual != nil {
+
+		// query param virtual
+		var qrVirtual bool
+
+		if o.Virtual != nil {
+			qrVirtual = *o.Virtual
+		}
+		qVirtual := swag.FormatBool(qrVirtual)
+		if qVirtual != "" {
+
+			if err := r.SetQueryParam("virtual", qVirtual); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamV2ListHostHardware binds the parameter disk_types
+func (o *V2ListHostHardwareParams) bindParamDiskTypes(formats strfmt.Registry) []string {
+	diskTypesIR := o.DiskTypes
+
+	var diskTypesIC []string
+	for _, diskTypesIIR := range diskTypesIR { // explode []string
+
+		diskTypesIIV := diskTypesIIR // string as string
+		diskTypesIC = append(diskTypesIC, diskTypesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	diskTypesIS := swag.JoinByFormat(diskTypesIC, "")
+
+	return diskTypesIS
+}
+
+// bindParamV2ListHostHardware binds the parameter status
+func (o *V2ListHostHardwareParams) bindParamStatus(formats strfmt.Registry) []string {
+	statusIR := o.Status
+
+	var statusIC []string
+	for _, statusIIR := range statusIR { // explode []string
+
+		statusIIV := statusIIR // string as string
+		statusIC = append(statusIC, statusIIV)
+	}
+
+	// items.CollectionFormat: ""
+	statusIS := swag.JoinByFormat(statusIC, "")
+
+	return statusIS
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostHardwareReader is a Reader for the V2ListHostHardware structure.
+type V2ListHostHardwareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostHardwareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostHardwareOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ListHostHardwareBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ListHostHardwareUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostHardwareForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostHardwareInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostHardwareOK creates a V2ListHostHardwareOK with default headers values
+func NewV2ListHostHardwareOK() *V2ListHostHardwareOK {
+	return &V2ListHostHardwareOK{}
+}
+
+/*
+V2ListHostHardwareOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostHardwareOK struct {
+	Payload *models.HostHardwareList
+}
+
+// IsSuccess returns true when this v2 list host hardware o k response has a 2xx status code
+func (o *V2ListHostHardwareOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list host hardware o k response has a 3xx status code
+func (o *V2ListHostHardwareOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware o k response has a 4xx status code
+func (o *V2ListHostHardwareOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host hardware o k response has a 5xx status code
+func (o *V2ListHostHardwareOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware o k response a status code equal to that given
+func (o *V2ListHostHardwareOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListHostHardwareOK) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostHardwareOK) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostHardwareOK) GetPayload() *models.HostHardwareList {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostHardwareList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareBadRequest creates a V2ListHostHardwareBadRequest with default headers values
+func NewV2ListHostHardwareBadRequest() *V2ListHostHardwareBadRequest {
+	return &V2ListHostHardwareBadRequest{}
+}
+
+/*
+V2ListHostHardwareBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListHostHardwareBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host hardware bad request response has a 2xx status code
+func (o *V2ListHostHardwareBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware bad request response has a 3xx status code
+func (o *V2ListHostHardwareBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware bad request response has a 4xx status code
+func (o *V2ListHostHardwareBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware bad request response has a 5xx status code
+func (o *V2ListHostHardwareBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware bad request response a status code equal to that given
+func (o *V2ListHostHardwareBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2ListHostHardwareBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListHostHardwareBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2ListHostHardwareBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareUnauthorized creates a V2ListHostHardwareUnauthorized with default headers values
+func NewV2ListHostHardwareUnauthorized() *V2ListHostHardwareUnauthorized {
+	return &V2ListHostHardwareUnauthorized{}
+}
+
+/*
+V2ListHostHardwareUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostHardwareUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host hardware unauthorized response has a 2xx status code
+func (o *V2ListHostHardwareUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware unauthorized response has a 3xx status code
+func (o *V2ListHostHardwareUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware unauthorized response has a 4xx status code
+func (o *V2ListHostHardwareUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware unauthorized response has a 5xx status code
+func (o *V2ListHostHardwareUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware unauthorized response a status code equal to that given
+func (o *V2ListHostHardwareUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListHostHardwareUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostHardwareUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostHardwareUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareForbidden creates a V2ListHostHardwareForbidden with default headers values
+func NewV2ListHostHardwareForbidden() *V2ListHostHardwareForbidden {
+	return &V2ListHostHardwareForbidden{}
+}
+
+/*
+V2ListHostHardwareForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostHardwareForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host hardware forbidden response has a 2xx status code
+func (o *V2ListHostHardwareForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware forbidden response has a 3xx status code
+func (o *V2ListHostHardwareForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware forbidden response has a 4xx status code
+func (o *V2ListHostHardwareForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host hardware forbidden response has a 5xx status code
+func (o *V2ListHostHardwareForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host hardware forbidden response a status code equal to that given
+func (o *V2ListHostHardwareForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListHostHardwareForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostHardwareForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostHardwareForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostHardwareInternalServerError creates a V2ListHostHardwareInternalServerError with default headers values
+func NewV2ListHostHardwareInternalServerError() *V2ListHostHardwareInternalServerError {
+	return &V2ListHostHardwareInternalServerError{}
+}
+
+/*
+V2ListHostHardwareInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostHardwareInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host hardware internal server error response has a 2xx status code
+func (o *V2ListHostHardwareInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host hardware internal server error response has a 3xx status code
+func (o *V2ListHostHardwareInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host hardware internal server error response has a 4xx status code
+func (o *V2ListHostHardwareInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host hardware internal server error response has a 5xx status code
+func (o *V2ListHostHardwareInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list host hardware internal server error response a status code equal to that given
+func (o *V2ListHostHardwareInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListHostHardwareInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostHardwareInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/hardware-inventory][%d] v2ListHostHardwareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostHardwareInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostHardwareInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
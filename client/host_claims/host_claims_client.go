@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the host claims client
+type API interface {
+	/*
+	   V2DeleteHostClaim Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster.*/
+	V2DeleteHostClaim(ctx context.Context, params *V2DeleteHostClaimParams) (*V2DeleteHostClaimNoContent, error)
+	/*
+	   V2GetHostClaim Retrieves the host claim of the cluster and the hosts bound by it.*/
+	V2GetHostClaim(ctx context.Context, params *V2GetHostClaimParams) (*V2GetHostClaimOK, error)
+	/*
+	   V2ListHostClaims Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler.*/
+	V2ListHostClaims(ctx context.Context, params *V2ListHostClaimsParams) (*V2ListHostClaimsOK, error)
+	/*
+	   V2SetHostClaim Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers.*/
+	V2SetHostClaim(ctx context.Context, params *V2SetHostClaimParams) (*V2SetHostClaimOK, error)
+}
+
+// New creates a new host claims API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for host claims API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeleteHostClaim Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster.
+*/
+func (a *Client) V2DeleteHostClaim(ctx context.Context, params *V2DeleteHostClaimParams) (*V2DeleteHostClaimNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteHostClaim",
+		Method:             "DELETE",
+		PathPattern:        "/v2/clusters/{cluster_id}/host-claim",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteHostClaimReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteHostClaimNoContent), nil
+
+}
+
+/*
+V2GetHostClaim Retrieves the host claim of the cluster and the hosts bound by it.
+*/
+func (a *Client) V2GetHostClaim(ctx context.Context, params *V2GetHostClaimParams) (*V2GetHostClaimOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetHostClaim",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/host-claim",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetHostClaimReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetHostClaimOK), nil
+
+}
+
+/*
+V2ListHostClaims Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler.
+*/
+func (a *Client) V2ListHostClaims(ctx context.Context, params *V2ListHostClaimsParams) (*V2ListHostClaimsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostClaims",
+		Method:             "GET",
+		PathPattern:        "/v2/host-claims",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostClaimsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostClaimsOK), nil
+
+}
+
+/*
+V2SetHostClaim Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers.
+*/
+func (a *Client) V2SetHostClaim(ctx context.Context, params *V2SetHostClaimParams) (*V2SetHostClaimOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2SetHostClaim",
+		Method:             "PUT",
+		PathPattern:        "/v2/clusters/{cluster_id}/host-claim",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2SetHostClaimReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2SetHostClaimOK), nil
+
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeleteHostClaimParams creates a new V2DeleteHostClaimParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeleteHostClaimParams() *V2DeleteHostClaimParams {
+	return &V2DeleteHostClaimParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeleteHostClaimParamsWithTimeout creates a new V2DeleteHostClaimParams object
+// with the ability to set a timeout on a request.
+func NewV2DeleteHostClaimParamsWithTimeout(timeout time.Duration) *V2DeleteHostClaimParams {
+	return &V2DeleteHostClaimParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeleteHostClaimParamsWithContext creates a new V2DeleteHostClaimParams object
+// with the ability to set a context for a request.
+func NewV2DeleteHostClaimParamsWithContext(ctx context.Context) *V2DeleteHostClaimParams {
+	return &V2DeleteHostClaimParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeleteHostClaimParamsWithHTTPClient creates a new V2DeleteHostClaimParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeleteHostClaimParamsWithHTTPClient(client *http.Client) *V2DeleteHostClaimParams {
+	return &V2DeleteHostClaimParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DeleteHostClaimParams contains all the parameters to send to the API endpoint
+
+	for the v2 delete host claim operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DeleteHostClaimParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose host claim is deleted.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 delete host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeleteHostClaimParams) WithDefaults() *V2DeleteHostClaimParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 delete host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeleteHostClaimParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) WithTimeout(timeout time.Duration) *V2DeleteHostClaimParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) WithContext(ctx context.Context) *V2DeleteHostClaimParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) WithHTTPClient(client *http.Client) *V2DeleteHostClaimParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) WithClusterID(clusterID strfmt.UUID) *V2DeleteHostClaimParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 delete host claim params
+func (o *V2DeleteHostClaimParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeleteHostClaimParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,362 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteHostClaimReader is a Reader for the V2DeleteHostClaim structure.
+type V2DeleteHostClaimReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeleteHostClaimReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeleteHostClaimNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeleteHostClaimUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeleteHostClaimForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeleteHostClaimNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeleteHostClaimInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeleteHostClaimNoContent creates a V2DeleteHostClaimNoContent with default headers values
+func NewV2DeleteHostClaimNoContent() *V2DeleteHostClaimNoContent {
+	return &V2DeleteHostClaimNoContent{}
+}
+
+/*
+V2DeleteHostClaimNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeleteHostClaimNoContent struct {
+}
+
+// IsSuccess returns true when this v2 delete host claim no content response has a 2xx status code
+func (o *V2DeleteHostClaimNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 delete host claim no content response has a 3xx status code
+func (o *V2DeleteHostClaimNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete host claim no content response has a 4xx status code
+func (o *V2DeleteHostClaimNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete host claim no content response has a 5xx status code
+func (o *V2DeleteHostClaimNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete host claim no content response a status code equal to that given
+func (o *V2DeleteHostClaimNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *V2DeleteHostClaimNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimNoContent ", 204)
+}
+
+func (o *V2DeleteHostClaimNoContent) String() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimNoContent ", 204)
+}
+
+func (o *V2DeleteHostClaimNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeleteHostClaimUnauthorized creates a V2DeleteHostClaimUnauthorized with default headers values
+func NewV2DeleteHostClaimUnauthorized() *V2DeleteHostClaimUnauthorized {
+	return &V2DeleteHostClaimUnauthorized{}
+}
+
+/*
+V2DeleteHostClaimUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeleteHostClaimUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete host claim unauthorized response has a 2xx status code
+func (o *V2DeleteHostClaimUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete host claim unauthorized response has a 3xx status code
+func (o *V2DeleteHostClaimUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete host claim unauthorized response has a 4xx status code
+func (o *V2DeleteHostClaimUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete host claim unauthorized response has a 5xx status code
+func (o *V2DeleteHostClaimUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete host claim unauthorized response a status code equal to that given
+func (o *V2DeleteHostClaimUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DeleteHostClaimUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteHostClaimUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeleteHostClaimUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteHostClaimUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteHostClaimForbidden creates a V2DeleteHostClaimForbidden with default headers values
+func NewV2DeleteHostClaimForbidden() *V2DeleteHostClaimForbidden {
+	return &V2DeleteHostClaimForbidden{}
+}
+
+/*
+V2DeleteHostClaimForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeleteHostClaimForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 delete host claim forbidden response has a 2xx status code
+func (o *V2DeleteHostClaimForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete host claim forbidden response has a 3xx status code
+func (o *V2DeleteHostClaimForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete host claim forbidden response has a 4xx status code
+func (o *V2DeleteHostClaimForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete host claim forbidden response has a 5xx status code
+func (o *V2DeleteHostClaimForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete host claim forbidden response a status code equal to that given
+func (o *V2DeleteHostClaimForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DeleteHostClaimForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteHostClaimForbidden) String() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeleteHostClaimForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeleteHostClaimForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteHostClaimNotFound creates a V2DeleteHostClaimNotFound with default headers values
+func NewV2DeleteHostClaimNotFound() *V2DeleteHostClaimNotFound {
+	return &V2DeleteHostClaimNotFound{}
+}
+
+/*
+V2DeleteHostClaimNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeleteHostClaimNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete host claim not found response has a 2xx status code
+func (o *V2DeleteHostClaimNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete host claim not found response has a 3xx status code
+func (o *V2DeleteHostClaimNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete host claim not found response has a 4xx status code
+func (o *V2DeleteHostClaimNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 delete host claim not found response has a 5xx status code
+func (o *V2DeleteHostClaimNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 delete host claim not found response a status code equal to that given
+func (o *V2DeleteHostClaimNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DeleteHostClaimNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteHostClaimNotFound) String() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeleteHostClaimNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteHostClaimNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeleteHostClaimInternalServerError creates a V2DeleteHostClaimInternalServerError with default headers values
+func NewV2DeleteHostClaimInternalServerError() *V2DeleteHostClaimInternalServerError {
+	return &V2DeleteHostClaimInternalServerError{}
+}
+
+/*
+V2DeleteHostClaimInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeleteHostClaimInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 delete host claim internal server error response has a 2xx status code
+func (o *V2DeleteHostClaimInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 delete host claim internal server error response has a 3xx status code
+func (o *V2DeleteHostClaimInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 delete host claim internal server error response has a 4xx status code
+func (o *V2DeleteHostClaimInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 delete host claim internal server error response has a 5xx status code
+func (o *V2DeleteHostClaimInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 delete host claim internal server error response a status code equal to that given
+func (o *V2DeleteHostClaimInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DeleteHostClaimInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteHostClaimInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /v2/clusters/{cluster_id}/host-claim][%d] v2DeleteHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeleteHostClaimInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeleteHostClaimInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetHostClaimParams creates a new V2GetHostClaimParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetHostClaimParams() *V2GetHostClaimParams {
+	return &V2GetHostClaimParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetHostClaimParamsWithTimeout creates a new V2GetHostClaimParams object
+// with the ability to set a timeout on a request.
+func NewV2GetHostClaimParamsWithTimeout(timeout time.Duration) *V2GetHostClaimParams {
+	return &V2GetHostClaimParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetHostClaimParamsWithContext creates a new V2GetHostClaimParams object
+// with the ability to set a context for a request.
+func NewV2GetHostClaimParamsWithContext(ctx context.Context) *V2GetHostClaimParams {
+	return &V2GetHostClaimParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetHostClaimParamsWithHTTPClient creates a new V2GetHostClaimParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetHostClaimParamsWithHTTPClient(client *http.Client) *V2GetHostClaimParams {
+	return &V2GetHostClaimParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetHostClaimParams contains all the parameters to send to the API endpoint
+
+	for the v2 get host claim operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetHostClaimParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose host claim is retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostClaimParams) WithDefaults() *V2GetHostClaimParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostClaimParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get host claim params
+func (o *V2GetHostClaimParams) WithTimeout(timeout time.Duration) *V2GetHostClaimParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get host claim params
+func (o *V2GetHostClaimParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get host claim params
+func (o *V2GetHostClaimParams) WithContext(ctx context.Context) *V2GetHostClaimParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get host claim params
+func (o *V2GetHostClaimParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get host claim params
+func (o *V2GetHostClaimParams) WithHTTPClient(client *http.Client) *V2GetHostClaimParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get host claim params
+func (o *V2GetHostClaimParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get host claim params
+func (o *V2GetHostClaimParams) WithClusterID(clusterID strfmt.UUID) *V2GetHostClaimParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get host claim params
+func (o *V2GetHostClaimParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetHostClaimParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
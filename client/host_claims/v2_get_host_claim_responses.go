@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostClaimReader is a Reader for the V2GetHostClaim structure.
+type V2GetHostClaimReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetHostClaimReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetHostClaimOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetHostClaimUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetHostClaimForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetHostClaimNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetHostClaimInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetHostClaimOK creates a V2GetHostClaimOK with default headers values
+func NewV2GetHostClaimOK() *V2GetHostClaimOK {
+	return &V2GetHostClaimOK{}
+}
+
+/*
+V2GetHostClaimOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetHostClaimOK struct {
+	Payload *models.HostClaim
+}
+
+// IsSuccess returns true when this v2 get host claim o k response has a 2xx status code
+func (o *V2GetHostClaimOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get host claim o k response has a 3xx status code
+func (o *V2GetHostClaimOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host claim o k response has a 4xx status code
+func (o *V2GetHostClaimOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get host claim o k response has a 5xx status code
+func (o *V2GetHostClaimOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host claim o k response a status code equal to that given
+func (o *V2GetHostClaimOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetHostClaimOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetHostClaimOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetHostClaimOK) GetPayload() *models.HostClaim {
+	return o.Payload
+}
+
+func (o *V2GetHostClaimOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostClaim)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostClaimUnauthorized creates a V2GetHostClaimUnauthorized with default headers values
+func NewV2GetHostClaimUnauthorized() *V2GetHostClaimUnauthorized {
+	return &V2GetHostClaimUnauthorized{}
+}
+
+/*
+V2GetHostClaimUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetHostClaimUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get host claim unauthorized response has a 2xx status code
+func (o *V2GetHostClaimUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host claim unauthorized response has a 3xx status code
+func (o *V2GetHostClaimUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host claim unauthorized response has a 4xx status code
+func (o *V2GetHostClaimUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host claim unauthorized response has a 5xx status code
+func (o *V2GetHostClaimUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host claim unauthorized response a status code equal to that given
+func (o *V2GetHostClaimUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetHostClaimUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetHostClaimUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetHostClaimUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostClaimUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostClaimForbidden creates a V2GetHostClaimForbidden with default headers values
+func NewV2GetHostClaimForbidden() *V2GetHostClaimForbidden {
+	return &V2GetHostClaimForbidden{}
+}
+
+/*
+V2GetHostClaimForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetHostClaimForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get host claim forbidden response has a 2xx status code
+func (o *V2GetHostClaimForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host claim forbidden response has a 3xx status code
+func (o *V2GetHostClaimForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host claim forbidden response has a 4xx status code
+func (o *V2GetHostClaimForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host claim forbidden response has a 5xx status code
+func (o *V2GetHostClaimForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host claim forbidden response a status code equal to that given
+func (o *V2GetHostClaimForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetHostClaimForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetHostClaimForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetHostClaimForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostClaimForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostClaimNotFound creates a V2GetHostClaimNotFound with default headers values
+func NewV2GetHostClaimNotFound() *V2GetHostClaimNotFound {
+	return &V2GetHostClaimNotFound{}
+}
+
+/*
+V2GetHostClaimNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetHostClaimNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host claim not found response has a 2xx status code
+func (o *V2GetHostClaimNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host claim not found response has a 3xx status code
+func (o *V2GetHostClaimNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host claim not found response has a 4xx status code
+func (o *V2GetHostClaimNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host claim not found response has a 5xx status code
+func (o *V2GetHostClaimNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host claim not found response a status code equal to that given
+func (o *V2GetHostClaimNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetHostClaimNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetHostClaimNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetHostClaimNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostClaimNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostClaimInternalServerError creates a V2GetHostClaimInternalServerError with default headers values
+func NewV2GetHostClaimInternalServerError() *V2GetHostClaimInternalServerError {
+	return &V2GetHostClaimInternalServerError{}
+}
+
+/*
+V2GetHostClaimInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetHostClaimInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host claim internal server error response has a 2xx status code
+func (o *V2GetHostClaimInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host claim internal server error response has a 3xx status code
+func (o *V2GetHostClaimInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host claim internal server error response has a 4xx status code
+func (o *V2GetHostClaimInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get host claim internal server error response has a 5xx status code
+func (o *V2GetHostClaimInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get host claim internal server error response a status code equal to that given
+func (o *V2GetHostClaimInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetHostClaimInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetHostClaimInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/host-claim][%d] v2GetHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetHostClaimInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostClaimInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListHostClaimsParams creates a new V2ListHostClaimsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostClaimsParams() *V2ListHostClaimsParams {
+	return &V2ListHostClaimsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostClaimsParamsWithTimeout creates a new V2ListHostClaimsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostClaimsParamsWithTimeout(timeout time.Duration) *V2ListHostClaimsParams {
+	return &V2ListHostClaimsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostClaimsParamsWithContext creates a new V2ListHostClaimsParams object
+// with the ability to set a context for a request.
+func NewV2ListHostClaimsParamsWithContext(ctx context.Context) *V2ListHostClaimsParams {
+	return &V2ListHostClaimsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostClaimsParamsWithHTTPClient creates a new V2ListHostClaimsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostClaimsParamsWithHTTPClient(client *http.Client) *V2ListHostClaimsParams {
+	return &V2ListHostClaimsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListHostClaimsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list host claims operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListHostClaimsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host claims params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostClaimsParams) WithDefaults() *V2ListHostClaimsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host claims params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostClaimsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list host claims params
+func (o *V2ListHostClaimsParams) WithTimeout(timeout time.Duration) *V2ListHostClaimsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host claims params
+func (o *V2ListHostClaimsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host claims params
+func (o *V2ListHostClaimsParams) WithContext(ctx context.Context) *V2ListHostClaimsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host claims params
+func (o *V2ListHostClaimsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host claims params
+func (o *V2ListHostClaimsParams) WithHTTPClient(client *http.Client) *V2ListHostClaimsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host claims params
+func (o *V2ListHostClaimsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostClaimsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostClaimsReader is a Reader for the V2ListHostClaims structure.
+type V2ListHostClaimsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostClaimsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostClaimsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostClaimsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostClaimsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostClaimsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostClaimsOK creates a V2ListHostClaimsOK with default headers values
+func NewV2ListHostClaimsOK() *V2ListHostClaimsOK {
+	return &V2ListHostClaimsOK{}
+}
+
+/*
+V2ListHostClaimsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostClaimsOK struct {
+	Payload models.HostClaimList
+}
+
+// IsSuccess returns true when this v2 list host claims o k response has a 2xx status code
+func (o *V2ListHostClaimsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list host claims o k response has a 3xx status code
+func (o *V2ListHostClaimsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host claims o k response has a 4xx status code
+func (o *V2ListHostClaimsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host claims o k response has a 5xx status code
+func (o *V2ListHostClaimsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host claims o k response a status code equal to that given
+func (o *V2ListHostClaimsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListHostClaimsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostClaimsOK) String() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostClaimsOK) GetPayload() models.HostClaimList {
+	return o.Payload
+}
+
+func (o *V2ListHostClaimsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostClaimsUnauthorized creates a V2ListHostClaimsUnauthorized with default headers values
+func NewV2ListHostClaimsUnauthorized() *V2ListHostClaimsUnauthorized {
+	return &V2ListHostClaimsUnauthorized{}
+}
+
+/*
+V2ListHostClaimsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostClaimsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host claims unauthorized response has a 2xx status code
+func (o *V2ListHostClaimsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host claims unauthorized response has a 3xx status code
+func (o *V2ListHostClaimsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host claims unauthorized response has a 4xx status code
+func (o *V2ListHostClaimsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host claims unauthorized response has a 5xx status code
+func (o *V2ListHostClaimsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host claims unauthorized response a status code equal to that given
+func (o *V2ListHostClaimsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListHostClaimsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostClaimsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostClaimsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostClaimsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostClaimsForbidden creates a V2ListHostClaimsForbidden with default headers values
+func NewV2ListHostClaimsForbidden() *V2ListHostClaimsForbidden {
+	return &V2ListHostClaimsForbidden{}
+}
+
+/*
+V2ListHostClaimsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostClaimsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host claims forbidden response has a 2xx status code
+func (o *V2ListHostClaimsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host claims forbidden response has a 3xx status code
+func (o *V2ListHostClaimsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host claims forbidden response has a 4xx status code
+func (o *V2ListHostClaimsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host claims forbidden response has a 5xx status code
+func (o *V2ListHostClaimsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host claims forbidden response a status code equal to that given
+func (o *V2ListHostClaimsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListHostClaimsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostClaimsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostClaimsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostClaimsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostClaimsInternalServerError creates a V2ListHostClaimsInternalServerError with default headers values
+func NewV2ListHostClaimsInternalServerError() *V2ListHostClaimsInternalServerError {
+	return &V2ListHostClaimsInternalServerError{}
+}
+
+/*
+V2ListHostClaimsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostClaimsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host claims internal server error response has a 2xx status code
+func (o *V2ListHostClaimsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host claims internal server error response has a 3xx status code
+func (o *V2ListHostClaimsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host claims internal server error response has a 4xx status code
+func (o *V2ListHostClaimsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host claims internal server error response has a 5xx status code
+func (o *V2ListHostClaimsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list host claims internal server error response a status code equal to that given
+func (o *V2ListHostClaimsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListHostClaimsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostClaimsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/host-claims][%d] v2ListHostClaimsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostClaimsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostClaimsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
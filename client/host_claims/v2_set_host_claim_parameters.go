@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2SetHostClaimParams creates a new V2SetHostClaimParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2SetHostClaimParams() *V2SetHostClaimParams {
+	return &V2SetHostClaimParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2SetHostClaimParamsWithTimeout creates a new V2SetHostClaimParams object
+// with the ability to set a timeout on a request.
+func NewV2SetHostClaimParamsWithTimeout(timeout time.Duration) *V2SetHostClaimParams {
+	return &V2SetHostClaimParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2SetHostClaimParamsWithContext creates a new V2SetHostClaimParams object
+// with the ability to set a context for a request.
+func NewV2SetHostClaimParamsWithContext(ctx context.Context) *V2SetHostClaimParams {
+	return &V2SetHostClaimParams{
+		Context: ctx,
+	}
+}
+
+// NewV2SetHostClaimParamsWithHTTPClient creates a new V2SetHostClaimParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2SetHostClaimParamsWithHTTPClient(client *http.Client) *V2SetHostClaimParams {
+	return &V2SetHostClaimParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2SetHostClaimParams contains all the parameters to send to the API endpoint
+
+	for the v2 set host claim operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2SetHostClaimParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose host claim is set.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* HostClaimSpec.
+
+	   The definition of the host claim.
+	*/
+	HostClaimSpec *models.HostClaimSpec
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 set host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SetHostClaimParams) WithDefaults() *V2SetHostClaimParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 set host claim params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SetHostClaimParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 set host claim params
+func (o *V2SetHostClaimParams) WithTimeout(timeout time.Duration) *V2SetHostClaimParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 set host claim params
+func (o *V2SetHostClaimParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 set host claim params
+func (o *V2SetHostClaimParams) WithContext(ctx context.Context) *V2SetHostClaimParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 set host claim params
+func (o *V2SetHostClaimParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 set host claim params
+func (o *V2SetHostClaimParams) WithHTTPClient(client *http.Client) *V2SetHostClaimParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 set host claim params
+func (o *V2SetHostClaimParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 set host claim params
+func (o *V2SetHostClaimParams) WithClusterID(clusterID strfmt.UUID) *V2SetHostClaimParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 set host claim params
+func (o *V2SetHostClaimParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostClaimSpec adds the hostClaimSpec to the v2 set host claim params
+func (o *V2SetHostClaimParams) WithHostClaimSpec(hostClaimSpec *models.HostClaimSpec) *V2SetHostClaimParams {
+	o.SetHostClaimSpec(hostClaimSpec)
+	return o
+}
+
+// SetHostClaimSpec adds the hostClaimSpec to the v2 set host claim params
+func (o *V2SetHostClaimParams) SetHostClaimSpec(hostClaimSpec *models.HostClaimSpec) {
+	o.HostClaimSpec = hostClaimSpec
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2SetHostClaimParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.HostClaimSpec != nil {
+		if err := r.SetBodyParam(o.HostClaimSpec); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
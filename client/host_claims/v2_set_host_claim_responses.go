@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SetHostClaimReader is a Reader for the V2SetHostClaim structure.
+type V2SetHostClaimReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2SetHostClaimReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2SetHostClaimOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2SetHostClaimBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2SetHostClaimUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2SetHostClaimForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2SetHostClaimNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2SetHostClaimConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2SetHostClaimInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2SetHostClaimOK creates a V2SetHostClaimOK with default headers values
+func NewV2SetHostClaimOK() *V2SetHostClaimOK {
+	return &V2SetHostClaimOK{}
+}
+
+/*
+V2SetHostClaimOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2SetHostClaimOK struct {
+	Payload *models.HostClaim
+}
+
+// IsSuccess returns true when this v2 set host claim o k response has a 2xx status code
+func (o *V2SetHostClaimOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 set host claim o k response has a 3xx status code
+func (o *V2SetHostClaimOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim o k response has a 4xx status code
+func (o *V2SetHostClaimOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 set host claim o k response has a 5xx status code
+func (o *V2SetHostClaimOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim o k response a status code equal to that given
+func (o *V2SetHostClaimOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2SetHostClaimOK) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SetHostClaimOK) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SetHostClaimOK) GetPayload() *models.HostClaim {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostClaim)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimBadRequest creates a V2SetHostClaimBadRequest with default headers values
+func NewV2SetHostClaimBadRequest() *V2SetHostClaimBadRequest {
+	return &V2SetHostClaimBadRequest{}
+}
+
+/*
+V2SetHostClaimBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2SetHostClaimBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 set host claim bad request response has a 2xx status code
+func (o *V2SetHostClaimBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim bad request response has a 3xx status code
+func (o *V2SetHostClaimBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim bad request response has a 4xx status code
+func (o *V2SetHostClaimBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 set host claim bad request response has a 5xx status code
+func (o *V2SetHostClaimBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim bad request response a status code equal to that given
+func (o *V2SetHostClaimBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2SetHostClaimBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SetHostClaimBadRequest) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SetHostClaimBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimUnauthorized creates a V2SetHostClaimUnauthorized with default headers values
+func NewV2SetHostClaimUnauthorized() *V2SetHostClaimUnauthorized {
+	return &V2SetHostClaimUnauthorized{}
+}
+
+/*
+V2SetHostClaimUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2SetHostClaimUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 set host claim unauthorized response has a 2xx status code
+func (o *V2SetHostClaimUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim unauthorized response has a 3xx status code
+func (o *V2SetHostClaimUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim unauthorized response has a 4xx status code
+func (o *V2SetHostClaimUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 set host claim unauthorized response has a 5xx status code
+func (o *V2SetHostClaimUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim unauthorized response a status code equal to that given
+func (o *V2SetHostClaimUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2SetHostClaimUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SetHostClaimUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SetHostClaimUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimForbidden creates a V2SetHostClaimForbidden with default headers values
+func NewV2SetHostClaimForbidden() *V2SetHostClaimForbidden {
+	return &V2SetHostClaimForbidden{}
+}
+
+/*
+V2SetHostClaimForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2SetHostClaimForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 set host claim forbidden response has a 2xx status code
+func (o *V2SetHostClaimForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim forbidden response has a 3xx status code
+func (o *V2SetHostClaimForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim forbidden response has a 4xx status code
+func (o *V2SetHostClaimForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 set host claim forbidden response has a 5xx status code
+func (o *V2SetHostClaimForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim forbidden response a status code equal to that given
+func (o *V2SetHostClaimForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2SetHostClaimForbidden) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SetHostClaimForbidden) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SetHostClaimForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimNotFound creates a V2SetHostClaimNotFound with default headers values
+func NewV2SetHostClaimNotFound() *V2SetHostClaimNotFound {
+	return &V2SetHostClaimNotFound{}
+}
+
+/*
+V2SetHostClaimNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2SetHostClaimNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 set host claim not found response has a 2xx status code
+func (o *V2SetHostClaimNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim not found response has a 3xx status code
+func (o *V2SetHostClaimNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim not found response has a 4xx status code
+func (o *V2SetHostClaimNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 set host claim not found response has a 5xx status code
+func (o *V2SetHostClaimNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim not found response a status code equal to that given
+func (o *V2SetHostClaimNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2SetHostClaimNotFound) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SetHostClaimNotFound) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SetHostClaimNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimConflict creates a V2SetHostClaimConflict with default headers values
+func NewV2SetHostClaimConflict() *V2SetHostClaimConflict {
+	return &V2SetHostClaimConflict{}
+}
+
+/*
+V2SetHostClaimConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2SetHostClaimConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 set host claim conflict response has a 2xx status code
+func (o *V2SetHostClaimConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim conflict response has a 3xx status code
+func (o *V2SetHostClaimConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim conflict response has a 4xx status code
+func (o *V2SetHostClaimConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 set host claim conflict response has a 5xx status code
+func (o *V2SetHostClaimConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 set host claim conflict response a status code equal to that given
+func (o *V2SetHostClaimConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2SetHostClaimConflict) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2SetHostClaimConflict) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2SetHostClaimConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SetHostClaimInternalServerError creates a V2SetHostClaimInternalServerError with default headers values
+func NewV2SetHostClaimInternalServerError() *V2SetHostClaimInternalServerError {
+	return &V2SetHostClaimInternalServerError{}
+}
+
+/*
+V2SetHostClaimInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2SetHostClaimInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 set host claim internal server error response has a 2xx status code
+func (o *V2SetHostClaimInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 set host claim internal server error response has a 3xx status code
+func (o *V2SetHostClaimInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 set host claim internal server error response has a 4xx status code
+func (o *V2SetHostClaimInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 set host claim internal server error response has a 5xx status code
+func (o *V2SetHostClaimInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 set host claim internal server error response a status code equal to that given
+func (o *V2SetHostClaimInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2SetHostClaimInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SetHostClaimInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/host-claim][%d] v2SetHostClaimInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SetHostClaimInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SetHostClaimInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the installation timeline client
+type API interface {
+	/*
+	   V2DownloadInstallationTimeline Downloads the installation timeline of the cluster.*/
+	V2DownloadInstallationTimeline(ctx context.Context, params *V2DownloadInstallationTimelineParams, writer io.Writer) (*V2DownloadInstallationTimelineOK, error)
+	/*
+	   V2GetInstallationTimeline Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation.*/
+	V2GetInstallationTimeline(ctx context.Context, params *V2GetInstallationTimelineParams) (*V2GetInstallationTimelineOK, error)
+}
+
+// New creates a new installation timeline API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for installation timeline API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DownloadInstallationTimeline Downloads the installation timeline of the cluster.
+*/
+func (a *Client) V2DownloadInstallationTimeline(ctx context.Context, params *V2DownloadInstallationTimelineParams, writer io.Writer) (*V2DownloadInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DownloadInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/installation-timeline/download",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadInstallationTimelineReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadInstallationTimelineOK), nil
+
+}
+
+/*
+V2GetInstallationTimeline Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation.
+*/
+func (a *Client) V2GetInstallationTimeline(ctx context.Context, params *V2GetInstallationTimelineParams) (*V2GetInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/installation-timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetInstallationTimelineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetInstallationTimelineOK), nil
+
+}
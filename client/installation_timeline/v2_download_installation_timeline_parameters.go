@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DownloadInstallationTimelineParams creates a new V2DownloadInstallationTimelineParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DownloadInstallationTimelineParams() *V2DownloadInstallationTimelineParams {
+	return &V2DownloadInstallationTimelineParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DownloadInstallationTimelineParamsWithTimeout creates a new V2DownloadInstallationTimelineParams object
+// with the ability to set a timeout on a request.
+func NewV2DownloadInstallationTimelineParamsWithTimeout(timeout time.Duration) *V2DownloadInstallationTimelineParams {
+	return &V2DownloadInstallationTimelineParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DownloadInstallationTimelineParamsWithContext creates a new V2DownloadInstallationTimelineParams object
+// with the ability to set a context for a request.
+func NewV2DownloadInstallationTimelineParamsWithContext(ctx context.Context) *V2DownloadInstallationTimelineParams {
+	return &V2DownloadInstallationTimelineParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DownloadInstallationTimelineParamsWithHTTPClient creates a new V2DownloadInstallationTimelineParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DownloadInstallationTimelineParamsWithHTTPClient(client *http.Client) *V2DownloadInstallationTimelineParams {
+	return &V2DownloadInstallationTimelineParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DownloadInstallationTimelineParams contains all the parameters to send to the API endpoint
+
+	for the v2 download installation timeline operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DownloadInstallationTimelineParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation is reported.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Format.
+
+	   The format of the downloaded timeline. The CSV format has a row per stage.
+
+	   Default: "json"
+	*/
+	Format *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 download installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadInstallationTimelineParams) WithDefaults() *V2DownloadInstallationTimelineParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 download installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadInstallationTimelineParams) SetDefaults() {
+	var (
+		formatDefault = string("json")
+	)
+
+	val := V2DownloadInstallationTimelineParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) WithTimeout(timeout time.Duration) *V2DownloadInstallationTimelineParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) WithContext(ctx context.Context) *V2DownloadInstallationTimelineParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) WithHTTPClient(client *http.Client) *V2DownloadInstallationTimelineParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) WithClusterID(clusterID strfmt.UUID) *V2DownloadInstallationTimelineParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithFormat adds the format to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) WithFormat(format *string) *V2DownloadInstallationTimelineParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the v2 download installation timeline params
+func (o *V2DownloadInstallationTimelineParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DownloadInstallationTimelineParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadInstallationTimelineReader is a Reader for the V2DownloadInstallationTimeline structure.
+type V2DownloadInstallationTimelineReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DownloadInstallationTimelineReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DownloadInstallationTimelineOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DownloadInstallationTimelineUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DownloadInstallationTimelineForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DownloadInstallationTimelineNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DownloadInstallationTimelineInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DownloadInstallationTimelineOK creates a V2DownloadInstallationTimelineOK with default headers values
+func NewV2DownloadInstallationTimelineOK(writer io.Writer) *V2DownloadInstallationTimelineOK {
+	return &V2DownloadInstallationTimelineOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2DownloadInstallationTimelineOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DownloadInstallationTimelineOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 download installation timeline o k response has a 2xx status code
+func (o *V2DownloadInstallationTimelineOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 download installation timeline o k response has a 3xx status code
+func (o *V2DownloadInstallationTimelineOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download installation timeline o k response has a 4xx status code
+func (o *V2DownloadInstallationTimelineOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download installation timeline o k response has a 5xx status code
+func (o *V2DownloadInstallationTimelineOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download installation timeline o k response a status code equal to that given
+func (o *V2DownloadInstallationTimelineOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2DownloadInstallationTimelineOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2DownloadInstallationTimelineOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInstallationTimelineUnauthorized creates a V2DownloadInstallationTimelineUnauthorized with default headers values
+func NewV2DownloadInstallationTimelineUnauthorized() *V2DownloadInstallationTimelineUnauthorized {
+	return &V2DownloadInstallationTimelineUnauthorized{}
+}
+
+/*
+V2DownloadInstallationTimelineUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DownloadInstallationTimelineUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download installation timeline unauthorized response has a 2xx status code
+func (o *V2DownloadInstallationTimelineUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download installation timeline unauthorized response has a 3xx status code
+func (o *V2DownloadInstallationTimelineUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download installation timeline unauthorized response has a 4xx status code
+func (o *V2DownloadInstallationTimelineUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download installation timeline unauthorized response has a 5xx status code
+func (o *V2DownloadInstallationTimelineUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download installation timeline unauthorized response a status code equal to that given
+func (o *V2DownloadInstallationTimelineUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DownloadInstallationTimelineUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadInstallationTimelineUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInstallationTimelineForbidden creates a V2DownloadInstallationTimelineForbidden with default headers values
+func NewV2DownloadInstallationTimelineForbidden() *V2DownloadInstallationTimelineForbidden {
+	return &V2DownloadInstallationTimelineForbidden{}
+}
+
+/*
+V2DownloadInstallationTimelineForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DownloadInstallationTimelineForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download installation timeline forbidden response has a 2xx status code
+func (o *V2DownloadInstallationTimelineForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download installation timeline forbidden response has a 3xx status code
+func (o *V2DownloadInstallationTimelineForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download installation timeline forbidden response has a 4xx status code
+func (o *V2DownloadInstallationTimelineForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download installation timeline forbidden response has a 5xx status code
+func (o *V2DownloadInstallationTimelineForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download installation timeline forbidden response a status code equal to that given
+func (o *V2DownloadInstallationTimelineForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DownloadInstallationTimelineForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadInstallationTimelineForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInstallationTimelineNotFound creates a V2DownloadInstallationTimelineNotFound with default headers values
+func NewV2DownloadInstallationTimelineNotFound() *V2DownloadInstallationTimelineNotFound {
+	return &V2DownloadInstallationTimelineNotFound{}
+}
+
+/*
+V2DownloadInstallationTimelineNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DownloadInstallationTimelineNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download installation timeline not found response has a 2xx status code
+func (o *V2DownloadInstallationTimelineNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download installation timeline not found response has a 3xx status code
+func (o *V2DownloadInstallationTimelineNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download installation timeline not found response has a 4xx status code
+func (o *V2DownloadInstallationTimelineNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download installation timeline not found response has a 5xx status code
+func (o *V2DownloadInstallationTimelineNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download installation timeline not found response a status code equal to that given
+func (o *V2DownloadInstallationTimelineNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DownloadInstallationTimelineNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadInstallationTimelineNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInstallationTimelineInternalServerError creates a V2DownloadInstallationTimelineInternalServerError with default headers values
+func NewV2DownloadInstallationTimelineInternalServerError() *V2DownloadInstallationTimelineInternalServerError {
+	return &V2DownloadInstallationTimelineInternalServerError{}
+}
+
+/*
+V2DownloadInstallationTimelineInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DownloadInstallationTimelineInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download installation timeline internal server error response has a 2xx status code
+func (o *V2DownloadInstallationTimelineInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download installation timeline internal server error response has a 3xx status code
+func (o *V2DownloadInstallationTimelineInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download installation timeline internal server error response has a 4xx status code
+func (o *V2DownloadInstallationTimelineInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download installation timeline internal server error response has a 5xx status code
+func (o *V2DownloadInstallationTimelineInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 download installation timeline internal server error response a status code equal to that given
+func (o *V2DownloadInstallationTimelineInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DownloadInstallationTimelineInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline/download][%d] v2DownloadInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadInstallationTimelineInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadInstallationTimelineInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetInstallationTimelineParams creates a new V2GetInstallationTimelineParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetInstallationTimelineParams() *V2GetInstallationTimelineParams {
+	return &V2GetInstallationTimelineParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetInstallationTimelineParamsWithTimeout creates a new V2GetInstallationTimelineParams object
+// with the ability to set a timeout on a request.
+func NewV2GetInstallationTimelineParamsWithTimeout(timeout time.Duration) *V2GetInstallationTimelineParams {
+	return &V2GetInstallationTimelineParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetInstallationTimelineParamsWithContext creates a new V2GetInstallationTimelineParams object
+// with the ability to set a context for a request.
+func NewV2GetInstallationTimelineParamsWithContext(ctx context.Context) *V2GetInstallationTimelineParams {
+	return &V2GetInstallationTimelineParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetInstallationTimelineParamsWithHTTPClient creates a new V2GetInstallationTimelineParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetInstallationTimelineParamsWithHTTPClient(client *http.Client) *V2GetInstallationTimelineParams {
+	return &V2GetInstallationTimelineParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetInstallationTimelineParams contains all the parameters to send to the API endpoint
+
+	for the v2 get installation timeline operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetInstallationTimelineParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation is reported.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInstallationTimelineParams) WithDefaults() *V2GetInstallationTimelineParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInstallationTimelineParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) WithTimeout(timeout time.Duration) *V2GetInstallationTimelineParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) WithContext(ctx context.Context) *V2GetInstallationTimelineParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) WithHTTPClient(client *http.Client) *V2GetInstallationTimelineParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) WithClusterID(clusterID strfmt.UUID) *V2GetInstallationTimelineParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get installation timeline params
+func (o *V2GetInstallationTimelineParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetInstallationTimelineParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInstallationTimelineReader is a Reader for the V2GetInstallationTimeline structure.
+type V2GetInstallationTimelineReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetInstallationTimelineReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetInstallationTimelineOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetInstallationTimelineUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetInstallationTimelineForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetInstallationTimelineNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetInstallationTimelineInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetInstallationTimelineOK creates a V2GetInstallationTimelineOK with default headers values
+func NewV2GetInstallationTimelineOK() *V2GetInstallationTimelineOK {
+	return &V2GetInstallationTimelineOK{}
+}
+
+/*
+V2GetInstallationTimelineOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetInstallationTimelineOK struct {
+	Payload *models.InstallationTimeline
+}
+
+// IsSuccess returns true when this v2 get installation timeline o k response has a 2xx status code
+func (o *V2GetInstallationTimelineOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get installation timeline o k response has a 3xx status code
+func (o *V2GetInstallationTimelineOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get installation timeline o k response has a 4xx status code
+func (o *V2GetInstallationTimelineOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get installation timeline o k response has a 5xx status code
+func (o *V2GetInstallationTimelineOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get installation timeline o k response a status code equal to that given
+func (o *V2GetInstallationTimelineOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetInstallationTimelineOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineOK) GetPayload() *models.InstallationTimeline {
+	return o.Payload
+}
+
+func (o *V2GetInstallationTimelineOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallationTimeline)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInstallationTimelineUnauthorized creates a V2GetInstallationTimelineUnauthorized with default headers values
+func NewV2GetInstallationTimelineUnauthorized() *V2GetInstallationTimelineUnauthorized {
+	return &V2GetInstallationTimelineUnauthorized{}
+}
+
+/*
+V2GetInstallationTimelineUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetInstallationTimelineUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get installation timeline unauthorized response has a 2xx status code
+func (o *V2GetInstallationTimelineUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get installation timeline unauthorized response has a 3xx status code
+func (o *V2GetInstallationTimelineUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get installation timeline unauthorized response has a 4xx status code
+func (o *V2GetInstallationTimelineUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get installation timeline unauthorized response has a 5xx status code
+func (o *V2GetInstallationTimelineUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get installation timeline unauthorized response a status code equal to that given
+func (o *V2GetInstallationTimelineUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetInstallationTimelineUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInstallationTimelineUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInstallationTimelineForbidden creates a V2GetInstallationTimelineForbidden with default headers values
+func NewV2GetInstallationTimelineForbidden() *V2GetInstallationTimelineForbidden {
+	return &V2GetInstallationTimelineForbidden{}
+}
+
+/*
+V2GetInstallationTimelineForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetInstallationTimelineForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get installation timeline forbidden response has a 2xx status code
+func (o *V2GetInstallationTimelineForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get installation timeline forbidden response has a 3xx status code
+func (o *V2GetInstallationTimelineForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get installation timeline forbidden response has a 4xx status code
+func (o *V2GetInstallationTimelineForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get installation timeline forbidden response has a 5xx status code
+func (o *V2GetInstallationTimelineForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get installation timeline forbidden response a status code equal to that given
+func (o *V2GetInstallationTimelineForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetInstallationTimelineForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInstallationTimelineForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInstallationTimelineNotFound creates a V2GetInstallationTimelineNotFound with default headers values
+func NewV2GetInstallationTimelineNotFound() *V2GetInstallationTimelineNotFound {
+	return &V2GetInstallationTimelineNotFound{}
+}
+
+/*
+V2GetInstallationTimelineNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetInstallationTimelineNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get installation timeline not found response has a 2xx status code
+func (o *V2GetInstallationTimelineNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get installation timeline not found response has a 3xx status code
+func (o *V2GetInstallationTimelineNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get installation timeline not found response has a 4xx status code
+func (o *V2GetInstallationTimelineNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get installation timeline not found response has a 5xx status code
+func (o *V2GetInstallationTimelineNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get installation timeline not found response a status code equal to that given
+func (o *V2GetInstallationTimelineNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetInstallationTimelineNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInstallationTimelineNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInstallationTimelineInternalServerError creates a V2GetInstallationTimelineInternalServerError with default headers values
+func NewV2GetInstallationTimelineInternalServerError() *V2GetInstallationTimelineInternalServerError {
+	return &V2GetInstallationTimelineInternalServerError{}
+}
+
+/*
+V2GetInstallationTimelineInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetInstallationTimelineInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get installation timeline internal server error response has a 2xx status code
+func (o *V2GetInstallationTimelineInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get installation timeline internal server error response has a 3xx status code
+func (o *V2GetInstallationTimelineInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get installation timeline internal server error response has a 4xx status code
+func (o *V2GetInstallationTimelineInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get installation timeline internal server error response has a 5xx status code
+func (o *V2GetInstallationTimelineInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get installation timeline internal server error response a status code equal to that given
+func (o *V2GetInstallationTimelineInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetInstallationTimelineInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInstallationTimelineInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInstallationTimelineInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -109,6 +109,9 @@ type API interface {
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
+	/*
+	   V2DecommissionHost Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events.*/
+	V2DecommissionHost(ctx context.Context, params *V2DecommissionHostParams) (*V2DecommissionHostAccepted, error)
 	/*
 	   V2DeregisterCluster Deletes an OpenShift cluster definition.*/
 	V2DeregisterCluster(ctx context.Context, params *V2DeregisterClusterParams) (*V2DeregisterClusterNoContent, error)
@@ -133,6 +136,9 @@ type API interface {
 	/*
 	   V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error*/
 	V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitionParams) (*V2GetHostIgnitionOK, error)
+	/*
+	   V2GetHostIgnitionPreview Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit.*/
+	V2GetHostIgnitionPreview(ctx context.Context, params *V2GetHostIgnitionPreviewParams) (*V2GetHostIgnitionPreviewOK, error)
 	/*
 	   V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.*/
 	V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnoredValidationsParams) (*V2GetIgnoredValidationsOK, error)
@@ -160,6 +166,9 @@ type API interface {
 	/*
 	   V2PostStepReply Posts the result of the operations from the host agent.*/
 	V2PostStepReply(ctx context.Context, params *V2PostStepReplyParams) (*V2PostStepReplyNoContent, error)
+	/*
+	   V2PreviewClusterInstallConfig Previews the install config resulting from install config overrides, without applying them.*/
+	V2PreviewClusterInstallConfig(ctx context.Context, params *V2PreviewClusterInstallConfigParams) (*V2PreviewClusterInstallConfigOK, error)
 	/*
 	   V2RegisterCluster Creates a new OpenShift cluster definition.*/
 	V2RegisterCluster(ctx context.Context, params *V2RegisterClusterParams) (*V2RegisterClusterCreated, error)
@@ -983,6 +992,31 @@ func (a *Client) V2CompleteInstallation(ctx context.Context, params *V2CompleteI
 
 }
 
+/*
+V2DecommissionHost Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events.
+*/
+func (a *Client) V2DecommissionHost(ctx context.Context, params *V2DecommissionHostParams) (*V2DecommissionHostAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DecommissionHost",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DecommissionHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DecommissionHostAccepted), nil
+
+}
+
 /*
 V2DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -1183,6 +1217,31 @@ func (a *Client) V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitio
 
 }
 
+/*
+V2GetHostIgnitionPreview Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit.
+*/
+func (a *Client) V2GetHostIgnitionPreview(ctx context.Context, params *V2GetHostIgnitionPreviewParams) (*V2GetHostIgnitionPreviewOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetHostIgnitionPreview",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetHostIgnitionPreviewReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetHostIgnitionPreviewOK), nil
+
+}
+
 /*
 V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.
 */
@@ -1408,6 +1467,31 @@ func (a *Client) V2PostStepReply(ctx context.Context, params *V2PostStepReplyPar
 
 }
 
+/*
+V2PreviewClusterInstallConfig Previews the install config resulting from install config overrides, without applying them.
+*/
+func (a *Client) V2PreviewClusterInstallConfig(ctx context.Context, params *V2PreviewClusterInstallConfigParams) (*V2PreviewClusterInstallConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2PreviewClusterInstallConfig",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/install-config/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2PreviewClusterInstallConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2PreviewClusterInstallConfigOK), nil
+
+}
+
 /*
 V2RegisterCluster Creates a new OpenShift cluster definition.
 */
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2DecommissionHostParams creates a new V2DecommissionHostParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DecommissionHostParams() *V2DecommissionHostParams {
+	return &V2DecommissionHostParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DecommissionHostParamsWithTimeout creates a new V2DecommissionHostParams object
+// with the ability to set a timeout on a request.
+func NewV2DecommissionHostParamsWithTimeout(timeout time.Duration) *V2DecommissionHostParams {
+	return &V2DecommissionHostParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DecommissionHostParamsWithContext creates a new V2DecommissionHostParams object
+// with the ability to set a context for a request.
+func NewV2DecommissionHostParamsWithContext(ctx context.Context) *V2DecommissionHostParams {
+	return &V2DecommissionHostParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DecommissionHostParamsWithHTTPClient creates a new V2DecommissionHostParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DecommissionHostParamsWithHTTPClient(client *http.Client) *V2DecommissionHostParams {
+	return &V2DecommissionHostParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DecommissionHostParams contains all the parameters to send to the API endpoint
+
+	for the v2 decommission host operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DecommissionHostParams struct {
+
+	/* ClusterID.
+
+	   The cluster of the host that is being decommissioned.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* DecommissionParams.
+
+	   The options of the decommission.
+	*/
+	DecommissionParams *models.DecommissionParams
+
+	/* HostID.
+
+	   The host that is being decommissioned.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 decommission host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DecommissionHostParams) WithDefaults() *V2DecommissionHostParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 decommission host params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DecommissionHostParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithTimeout(timeout time.Duration) *V2DecommissionHostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithContext(ctx context.Context) *V2DecommissionHostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithHTTPClient(client *http.Client) *V2DecommissionHostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithClusterID(clusterID strfmt.UUID) *V2DecommissionHostParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithDecommissionParams adds the decommissionParams to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithDecommissionParams(decommissionParams *models.DecommissionParams) *V2DecommissionHostParams {
+	o.SetDecommissionParams(decommissionParams)
+	return o
+}
+
+// SetDecommissionParams adds the decommissionParams to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetDecommissionParams(decommissionParams *models.DecommissionParams) {
+	o.DecommissionParams = decommissionParams
+}
+
+// WithHostID adds the hostID to the v2 decommission host params
+func (o *V2DecommissionHostParams) WithHostID(hostID strfmt.UUID) *V2DecommissionHostParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 decommission host params
+func (o *V2DecommissionHostParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DecommissionHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.DecommissionParams != nil {
+		if err := r.SetBodyParam(o.DecommissionParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
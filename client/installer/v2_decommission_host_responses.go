@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DecommissionHostReader is a Reader for the V2DecommissionHost structure.
+type V2DecommissionHostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DecommissionHostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2DecommissionHostAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2DecommissionHostBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2DecommissionHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DecommissionHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DecommissionHostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2DecommissionHostConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DecommissionHostInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DecommissionHostAccepted creates a V2DecommissionHostAccepted with default headers values
+func NewV2DecommissionHostAccepted() *V2DecommissionHostAccepted {
+	return &V2DecommissionHostAccepted{}
+}
+
+/*
+V2DecommissionHostAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2DecommissionHostAccepted struct {
+	Payload *models.Host
+}
+
+// IsSuccess returns true when this v2 decommission host accepted response has a 2xx status code
+func (o *V2DecommissionHostAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 decommission host accepted response has a 3xx status code
+func (o *V2DecommissionHostAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host accepted response has a 4xx status code
+func (o *V2DecommissionHostAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 decommission host accepted response has a 5xx status code
+func (o *V2DecommissionHostAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host accepted response a status code equal to that given
+func (o *V2DecommissionHostAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2DecommissionHostAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2DecommissionHostAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2DecommissionHostAccepted) GetPayload() *models.Host {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Host)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostBadRequest creates a V2DecommissionHostBadRequest with default headers values
+func NewV2DecommissionHostBadRequest() *V2DecommissionHostBadRequest {
+	return &V2DecommissionHostBadRequest{}
+}
+
+/*
+V2DecommissionHostBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2DecommissionHostBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 decommission host bad request response has a 2xx status code
+func (o *V2DecommissionHostBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host bad request response has a 3xx status code
+func (o *V2DecommissionHostBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host bad request response has a 4xx status code
+func (o *V2DecommissionHostBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 decommission host bad request response has a 5xx status code
+func (o *V2DecommissionHostBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host bad request response a status code equal to that given
+func (o *V2DecommissionHostBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2DecommissionHostBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2DecommissionHostBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2DecommissionHostBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostUnauthorized creates a V2DecommissionHostUnauthorized with default headers values
+func NewV2DecommissionHostUnauthorized() *V2DecommissionHostUnauthorized {
+	return &V2DecommissionHostUnauthorized{}
+}
+
+/*
+V2DecommissionHostUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DecommissionHostUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 decommission host unauthorized response has a 2xx status code
+func (o *V2DecommissionHostUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host unauthorized response has a 3xx status code
+func (o *V2DecommissionHostUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host unauthorized response has a 4xx status code
+func (o *V2DecommissionHostUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 decommission host unauthorized response has a 5xx status code
+func (o *V2DecommissionHostUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host unauthorized response a status code equal to that given
+func (o *V2DecommissionHostUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DecommissionHostUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DecommissionHostUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DecommissionHostUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostForbidden creates a V2DecommissionHostForbidden with default headers values
+func NewV2DecommissionHostForbidden() *V2DecommissionHostForbidden {
+	return &V2DecommissionHostForbidden{}
+}
+
+/*
+V2DecommissionHostForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DecommissionHostForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 decommission host forbidden response has a 2xx status code
+func (o *V2DecommissionHostForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host forbidden response has a 3xx status code
+func (o *V2DecommissionHostForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host forbidden response has a 4xx status code
+func (o *V2DecommissionHostForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 decommission host forbidden response has a 5xx status code
+func (o *V2DecommissionHostForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host forbidden response a status code equal to that given
+func (o *V2DecommissionHostForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DecommissionHostForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DecommissionHostForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DecommissionHostForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostNotFound creates a V2DecommissionHostNotFound with default headers values
+func NewV2DecommissionHostNotFound() *V2DecommissionHostNotFound {
+	return &V2DecommissionHostNotFound{}
+}
+
+/*
+V2DecommissionHostNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DecommissionHostNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 decommission host not found response has a 2xx status code
+func (o *V2DecommissionHostNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host not found response has a 3xx status code
+func (o *V2DecommissionHostNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host not found response has a 4xx status code
+func (o *V2DecommissionHostNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 decommission host not found response has a 5xx status code
+func (o *V2DecommissionHostNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host not found response a status code equal to that given
+func (o *V2DecommissionHostNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DecommissionHostNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DecommissionHostNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DecommissionHostNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostConflict creates a V2DecommissionHostConflict with default headers values
+func NewV2DecommissionHostConflict() *V2DecommissionHostConflict {
+	return &V2DecommissionHostConflict{}
+}
+
+/*
+V2DecommissionHostConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2DecommissionHostConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 decommission host conflict response has a 2xx status code
+func (o *V2DecommissionHostConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host conflict response has a 3xx status code
+func (o *V2DecommissionHostConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host conflict response has a 4xx status code
+func (o *V2DecommissionHostConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 decommission host conflict response has a 5xx status code
+func (o *V2DecommissionHostConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 decommission host conflict response a status code equal to that given
+func (o *V2DecommissionHostConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2DecommissionHostConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DecommissionHostConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2DecommissionHostConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DecommissionHostInternalServerError creates a V2DecommissionHostInternalServerError with default headers values
+func NewV2DecommissionHostInternalServerError() *V2DecommissionHostInternalServerError {
+	return &V2DecommissionHostInternalServerError{}
+}
+
+/*
+V2DecommissionHostInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DecommissionHostInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 decommission host internal server error response has a 2xx status code
+func (o *V2DecommissionHostInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 decommission host internal server error response has a 3xx status code
+func (o *V2DecommissionHostInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 decommission host internal server error response has a 4xx status code
+func (o *V2DecommissionHostInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 decommission host internal server error response has a 5xx status code
+func (o *V2DecommissionHostInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 decommission host internal server error response a status code equal to that given
+func (o *V2DecommissionHostInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DecommissionHostInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DecommissionHostInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission][%d] v2DecommissionHostInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DecommissionHostInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DecommissionHostInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
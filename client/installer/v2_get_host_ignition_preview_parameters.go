@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetHostIgnitionPreviewParams creates a new V2GetHostIgnitionPreviewParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetHostIgnitionPreviewParams() *V2GetHostIgnitionPreviewParams {
+	return &V2GetHostIgnitionPreviewParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetHostIgnitionPreviewParamsWithTimeout creates a new V2GetHostIgnitionPreviewParams object
+// with the ability to set a timeout on a request.
+func NewV2GetHostIgnitionPreviewParamsWithTimeout(timeout time.Duration) *V2GetHostIgnitionPreviewParams {
+	return &V2GetHostIgnitionPreviewParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetHostIgnitionPreviewParamsWithContext creates a new V2GetHostIgnitionPreviewParams object
+// with the ability to set a context for a request.
+func NewV2GetHostIgnitionPreviewParamsWithContext(ctx context.Context) *V2GetHostIgnitionPreviewParams {
+	return &V2GetHostIgnitionPreviewParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetHostIgnitionPreviewParamsWithHTTPClient creates a new V2GetHostIgnitionPreviewParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetHostIgnitionPreviewParamsWithHTTPClient(client *http.Client) *V2GetHostIgnitionPreviewParams {
+	return &V2GetHostIgnitionPreviewParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetHostIgnitionPreviewParams contains all the parameters to send to the API endpoint
+
+	for the v2 get host ignition preview operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetHostIgnitionPreviewParams struct {
+
+	/* HostID.
+
+	   The host whose ignition is previewed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose ignition is previewed.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get host ignition preview params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostIgnitionPreviewParams) WithDefaults() *V2GetHostIgnitionPreviewParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get host ignition preview params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostIgnitionPreviewParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) WithTimeout(timeout time.Duration) *V2GetHostIgnitionPreviewParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) WithContext(ctx context.Context) *V2GetHostIgnitionPreviewParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) WithHTTPClient(client *http.Client) *V2GetHostIgnitionPreviewParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostID adds the hostID to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) WithHostID(hostID strfmt.UUID) *V2GetHostIgnitionPreviewParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GetHostIgnitionPreviewParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 get host ignition preview params
+func (o *V2GetHostIgnitionPreviewParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetHostIgnitionPreviewParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostIgnitionPreviewReader is a Reader for the V2GetHostIgnitionPreview structure.
+type V2GetHostIgnitionPreviewReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetHostIgnitionPreviewReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetHostIgnitionPreviewOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetHostIgnitionPreviewUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetHostIgnitionPreviewForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetHostIgnitionPreviewNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetHostIgnitionPreviewMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2GetHostIgnitionPreviewConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetHostIgnitionPreviewInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetHostIgnitionPreviewOK creates a V2GetHostIgnitionPreviewOK with default headers values
+func NewV2GetHostIgnitionPreviewOK() *V2GetHostIgnitionPreviewOK {
+	return &V2GetHostIgnitionPreviewOK{}
+}
+
+/*
+V2GetHostIgnitionPreviewOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetHostIgnitionPreviewOK struct {
+	Payload *models.HostIgnitionPreview
+}
+
+// IsSuccess returns true when this v2 get host ignition preview o k response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get host ignition preview o k response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview o k response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get host ignition preview o k response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview o k response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetHostIgnitionPreviewOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewOK) GetPayload() *models.HostIgnitionPreview {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostIgnitionPreview)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewUnauthorized creates a V2GetHostIgnitionPreviewUnauthorized with default headers values
+func NewV2GetHostIgnitionPreviewUnauthorized() *V2GetHostIgnitionPreviewUnauthorized {
+	return &V2GetHostIgnitionPreviewUnauthorized{}
+}
+
+/*
+V2GetHostIgnitionPreviewUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetHostIgnitionPreviewUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get host ignition preview unauthorized response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview unauthorized response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview unauthorized response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host ignition preview unauthorized response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview unauthorized response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetHostIgnitionPreviewUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewForbidden creates a V2GetHostIgnitionPreviewForbidden with default headers values
+func NewV2GetHostIgnitionPreviewForbidden() *V2GetHostIgnitionPreviewForbidden {
+	return &V2GetHostIgnitionPreviewForbidden{}
+}
+
+/*
+V2GetHostIgnitionPreviewForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetHostIgnitionPreviewForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get host ignition preview forbidden response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview forbidden response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview forbidden response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host ignition preview forbidden response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview forbidden response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetHostIgnitionPreviewForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewNotFound creates a V2GetHostIgnitionPreviewNotFound with default headers values
+func NewV2GetHostIgnitionPreviewNotFound() *V2GetHostIgnitionPreviewNotFound {
+	return &V2GetHostIgnitionPreviewNotFound{}
+}
+
+/*
+V2GetHostIgnitionPreviewNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetHostIgnitionPreviewNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host ignition preview not found response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview not found response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview not found response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host ignition preview not found response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview not found response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetHostIgnitionPreviewNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewMethodNotAllowed creates a V2GetHostIgnitionPreviewMethodNotAllowed with default headers values
+func NewV2GetHostIgnitionPreviewMethodNotAllowed() *V2GetHostIgnitionPreviewMethodNotAllowed {
+	return &V2GetHostIgnitionPreviewMethodNotAllowed{}
+}
+
+/*
+V2GetHostIgnitionPreviewMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetHostIgnitionPreviewMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host ignition preview method not allowed response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview method not allowed response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview method not allowed response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host ignition preview method not allowed response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview method not allowed response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewConflict creates a V2GetHostIgnitionPreviewConflict with default headers values
+func NewV2GetHostIgnitionPreviewConflict() *V2GetHostIgnitionPreviewConflict {
+	return &V2GetHostIgnitionPreviewConflict{}
+}
+
+/*
+V2GetHostIgnitionPreviewConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2GetHostIgnitionPreviewConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host ignition preview conflict response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview conflict response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview conflict response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get host ignition preview conflict response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get host ignition preview conflict response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2GetHostIgnitionPreviewConflict) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewConflict) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostIgnitionPreviewInternalServerError creates a V2GetHostIgnitionPreviewInternalServerError with default headers values
+func NewV2GetHostIgnitionPreviewInternalServerError() *V2GetHostIgnitionPreviewInternalServerError {
+	return &V2GetHostIgnitionPreviewInternalServerError{}
+}
+
+/*
+V2GetHostIgnitionPreviewInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetHostIgnitionPreviewInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get host ignition preview internal server error response has a 2xx status code
+func (o *V2GetHostIgnitionPreviewInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get host ignition preview internal server error response has a 3xx status code
+func (o *V2GetHostIgnitionPreviewInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get host ignition preview internal server error response has a 4xx status code
+func (o *V2GetHostIgnitionPreviewInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get host ignition preview internal server error response has a 5xx status code
+func (o *V2GetHostIgnitionPreviewInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get host ignition preview internal server error response a status code equal to that given
+func (o *V2GetHostIgnitionPreviewInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetHostIgnitionPreviewInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview][%d] v2GetHostIgnitionPreviewInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetHostIgnitionPreviewInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostIgnitionPreviewInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2PreviewClusterInstallConfigParams creates a new V2PreviewClusterInstallConfigParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2PreviewClusterInstallConfigParams() *V2PreviewClusterInstallConfigParams {
+	return &V2PreviewClusterInstallConfigParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2PreviewClusterInstallConfigParamsWithTimeout creates a new V2PreviewClusterInstallConfigParams object
+// with the ability to set a timeout on a request.
+func NewV2PreviewClusterInstallConfigParamsWithTimeout(timeout time.Duration) *V2PreviewClusterInstallConfigParams {
+	return &V2PreviewClusterInstallConfigParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2PreviewClusterInstallConfigParamsWithContext creates a new V2PreviewClusterInstallConfigParams object
+// with the ability to set a context for a request.
+func NewV2PreviewClusterInstallConfigParamsWithContext(ctx context.Context) *V2PreviewClusterInstallConfigParams {
+	return &V2PreviewClusterInstallConfigParams{
+		Context: ctx,
+	}
+}
+
+// NewV2PreviewClusterInstallConfigParamsWithHTTPClient creates a new V2PreviewClusterInstallConfigParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2PreviewClusterInstallConfigParamsWithHTTPClient(client *http.Client) *V2PreviewClusterInstallConfigParams {
+	return &V2PreviewClusterInstallConfigParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2PreviewClusterInstallConfigParams contains all the parameters to send to the API endpoint
+
+	for the v2 preview cluster install config operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2PreviewClusterInstallConfigParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose install config is previewed.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* PreviewParams.
+
+	   The install config overrides to preview.
+	*/
+	PreviewParams *models.InstallConfigPreviewParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 preview cluster install config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2PreviewClusterInstallConfigParams) WithDefaults() *V2PreviewClusterInstallConfigParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 preview cluster install config params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2PreviewClusterInstallConfigParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) WithTimeout(timeout time.Duration) *V2PreviewClusterInstallConfigParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) WithContext(ctx context.Context) *V2PreviewClusterInstallConfigParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) WithHTTPClient(client *http.Client) *V2PreviewClusterInstallConfigParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) WithClusterID(clusterID strfmt.UUID) *V2PreviewClusterInstallConfigParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithPreviewParams adds the previewParams to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) WithPreviewParams(previewParams *models.InstallConfigPreviewParams) *V2PreviewClusterInstallConfigParams {
+	o.SetPreviewParams(previewParams)
+	return o
+}
+
+// SetPreviewParams adds the previewParams to the v2 preview cluster install config params
+func (o *V2PreviewClusterInstallConfigParams) SetPreviewParams(previewParams *models.InstallConfigPreviewParams) {
+	o.PreviewParams = previewParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2PreviewClusterInstallConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.PreviewParams != nil {
+		if err := r.SetBodyParam(o.PreviewParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
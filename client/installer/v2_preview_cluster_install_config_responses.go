@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2PreviewClusterInstallConfigReader is a Reader for the V2PreviewClusterInstallConfig structure.
+type V2PreviewClusterInstallConfigReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2PreviewClusterInstallConfigReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2PreviewClusterInstallConfigOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2PreviewClusterInstallConfigBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2PreviewClusterInstallConfigUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2PreviewClusterInstallConfigForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2PreviewClusterInstallConfigNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2PreviewClusterInstallConfigMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2PreviewClusterInstallConfigInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2PreviewClusterInstallConfigOK creates a V2PreviewClusterInstallConfigOK with default headers values
+func NewV2PreviewClusterInstallConfigOK() *V2PreviewClusterInstallConfigOK {
+	return &V2PreviewClusterInstallConfigOK{}
+}
+
+/*
+V2PreviewClusterInstallConfigOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2PreviewClusterInstallConfigOK struct {
+	Payload *models.InstallConfigPreview
+}
+
+// IsSuccess returns true when this v2 preview cluster install config o k response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 preview cluster install config o k response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config o k response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 preview cluster install config o k response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config o k response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2PreviewClusterInstallConfigOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigOK) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigOK  %+v", 200, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigOK) GetPayload() *models.InstallConfigPreview {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallConfigPreview)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigBadRequest creates a V2PreviewClusterInstallConfigBadRequest with default headers values
+func NewV2PreviewClusterInstallConfigBadRequest() *V2PreviewClusterInstallConfigBadRequest {
+	return &V2PreviewClusterInstallConfigBadRequest{}
+}
+
+/*
+V2PreviewClusterInstallConfigBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2PreviewClusterInstallConfigBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 preview cluster install config bad request response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config bad request response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config bad request response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 preview cluster install config bad request response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config bad request response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2PreviewClusterInstallConfigBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigUnauthorized creates a V2PreviewClusterInstallConfigUnauthorized with default headers values
+func NewV2PreviewClusterInstallConfigUnauthorized() *V2PreviewClusterInstallConfigUnauthorized {
+	return &V2PreviewClusterInstallConfigUnauthorized{}
+}
+
+/*
+V2PreviewClusterInstallConfigUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2PreviewClusterInstallConfigUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 preview cluster install config unauthorized response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config unauthorized response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config unauthorized response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 preview cluster install config unauthorized response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config unauthorized response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2PreviewClusterInstallConfigUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigForbidden creates a V2PreviewClusterInstallConfigForbidden with default headers values
+func NewV2PreviewClusterInstallConfigForbidden() *V2PreviewClusterInstallConfigForbidden {
+	return &V2PreviewClusterInstallConfigForbidden{}
+}
+
+/*
+V2PreviewClusterInstallConfigForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2PreviewClusterInstallConfigForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 preview cluster install config forbidden response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config forbidden response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config forbidden response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 preview cluster install config forbidden response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config forbidden response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2PreviewClusterInstallConfigForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigNotFound creates a V2PreviewClusterInstallConfigNotFound with default headers values
+func NewV2PreviewClusterInstallConfigNotFound() *V2PreviewClusterInstallConfigNotFound {
+	return &V2PreviewClusterInstallConfigNotFound{}
+}
+
+/*
+V2PreviewClusterInstallConfigNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2PreviewClusterInstallConfigNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 preview cluster install config not found response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config not found response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config not found response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 preview cluster install config not found response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config not found response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2PreviewClusterInstallConfigNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigMethodNotAllowed creates a V2PreviewClusterInstallConfigMethodNotAllowed with default headers values
+func NewV2PreviewClusterInstallConfigMethodNotAllowed() *V2PreviewClusterInstallConfigMethodNotAllowed {
+	return &V2PreviewClusterInstallConfigMethodNotAllowed{}
+}
+
+/*
+V2PreviewClusterInstallConfigMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2PreviewClusterInstallConfigMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 preview cluster install config method not allowed response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config method not allowed response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config method not allowed response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 preview cluster install config method not allowed response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 preview cluster install config method not allowed response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2PreviewClusterInstallConfigInternalServerError creates a V2PreviewClusterInstallConfigInternalServerError with default headers values
+func NewV2PreviewClusterInstallConfigInternalServerError() *V2PreviewClusterInstallConfigInternalServerError {
+	return &V2PreviewClusterInstallConfigInternalServerError{}
+}
+
+/*
+V2PreviewClusterInstallConfigInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2PreviewClusterInstallConfigInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 preview cluster install config internal server error response has a 2xx status code
+func (o *V2PreviewClusterInstallConfigInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 preview cluster install config internal server error response has a 3xx status code
+func (o *V2PreviewClusterInstallConfigInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 preview cluster install config internal server error response has a 4xx status code
+func (o *V2PreviewClusterInstallConfigInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 preview cluster install config internal server error response has a 5xx status code
+func (o *V2PreviewClusterInstallConfigInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 preview cluster install config internal server error response a status code equal to that given
+func (o *V2PreviewClusterInstallConfigInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2PreviewClusterInstallConfigInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/install-config/preview][%d] v2PreviewClusterInstallConfigInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2PreviewClusterInstallConfigInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2PreviewClusterInstallConfigInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
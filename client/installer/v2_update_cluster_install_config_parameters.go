@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewV2UpdateClusterInstallConfigParams creates a new V2UpdateClusterInstallConfigParams object,
@@ -61,6 +62,12 @@ V2UpdateClusterInstallConfigParams contains all the parameters to send to the AP
 */
 type V2UpdateClusterInstallConfigParams struct {
 
+	/* AllowServiceOwnedFields.
+
+	   Allow overriding the fields set by the service from the cluster, i.e. the networking and the platform, including the VIPs.
+	*/
+	AllowServiceOwnedFields *bool
+
 	/* ClusterID.
 
 	   The cluster whose install config is being updated.
@@ -92,7 +99,18 @@ func (o *V2UpdateClusterInstallConfigParams) WithDefaults() *V2UpdateClusterInst
 //
 // All values with no default are reset to their zero value.
 func (o *V2UpdateClusterInstallConfigParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		allowServiceOwnedFieldsDefault = bool(false)
+	)
+
+	val := V2UpdateClusterInstallConfigParams{
+		AllowServiceOwnedFields: &allowServiceOwnedFieldsDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the v2 update cluster install config params
@@ -128,6 +146,17 @@ func (o *V2UpdateClusterInstallConfigParams) SetHTTPClient(client *http.Client)
 	o.HTTPClient = client
 }
 
+// WithAllowServiceOwnedFields adds the allowServiceOwnedFields to the v2 update cluster install config params
+func (o *V2UpdateClusterInstallConfigParams) WithAllowServiceOwnedFields(allowServiceOwnedFields *bool) *V2UpdateClusterInstallConfigParams {
+	o.SetAllowServiceOwnedFields(allowServiceOwnedFields)
+	return o
+}
+
+// SetAllowServiceOwnedFields adds the allowServiceOwnedFields to the v2 update cluster install config params
+func (o *V2UpdateClusterInstallConfigParams) SetAllowServiceOwnedFields(allowServiceOwnedFields *bool) {
+	o.AllowServiceOwnedFields = allowServiceOwnedFields
+}
+
 // WithClusterID adds the clusterID to the v2 update cluster install config params
 func (o *V2UpdateClusterInstallConfigParams) WithClusterID(clusterID strfmt.UUID) *V2UpdateClusterInstallConfigParams {
 	o.SetClusterID(clusterID)
@@ -158,6 +187,23 @@ func (o *V2UpdateClusterInstallConfigParams) WriteToRequest(r runtime.ClientRequ
 	}
 	var res []error
 
+	if o.AllowServiceOwnedFields != nil {
+
+		// query param allow_service_owned_fields
+		var qrAllowServiceOwnedFields bool
+
+		if o.AllowServiceOwnedFields != nil {
+			qrAllowServiceOwnedFields = *o.AllowServiceOwnedFields
+		}
+		qAllowServiceOwnedFields := swag.FormatBool(qrAllowServiceOwnedFields)
+		if qAllowServiceOwnedFields != "" {
+
+			if err := r.SetQueryParam("allow_service_owned_fields", qAllowServiceOwnedFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
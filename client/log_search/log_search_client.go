@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the log search client
+type API interface {
+	/*
+	   V2SearchClusterLogs Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service.*/
+	V2SearchClusterLogs(ctx context.Context, params *V2SearchClusterLogsParams) (*V2SearchClusterLogsOK, error)
+}
+
+// New creates a new log search API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for log search API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2SearchClusterLogs Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service.
+*/
+func (a *Client) V2SearchClusterLogs(ctx context.Context, params *V2SearchClusterLogsParams) (*V2SearchClusterLogsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2SearchClusterLogs",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/logs/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2SearchClusterLogsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2SearchClusterLogsOK), nil
+
+}
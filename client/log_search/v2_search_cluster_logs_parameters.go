@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2SearchClusterLogsParams creates a new V2SearchClusterLogsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2SearchClusterLogsParams() *V2SearchClusterLogsParams {
+	return &V2SearchClusterLogsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2SearchClusterLogsParamsWithTimeout creates a new V2SearchClusterLogsParams object
+// with the ability to set a timeout on a request.
+func NewV2SearchClusterLogsParamsWithTimeout(timeout time.Duration) *V2SearchClusterLogsParams {
+	return &V2SearchClusterLogsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2SearchClusterLogsParamsWithContext creates a new V2SearchClusterLogsParams object
+// with the ability to set a context for a request.
+func NewV2SearchClusterLogsParamsWithContext(ctx context.Context) *V2SearchClusterLogsParams {
+	return &V2SearchClusterLogsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2SearchClusterLogsParamsWithHTTPClient creates a new V2SearchClusterLogsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2SearchClusterLogsParamsWithHTTPClient(client *http.Client) *V2SearchClusterLogsParams {
+	return &V2SearchClusterLogsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2SearchClusterLogsParams contains all the parameters to send to the API endpoint
+
+	for the v2 search cluster logs operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2SearchClusterLogsParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose logs are searched.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* ContextLines.
+
+	   The number of lines of the file returned before and after each matching line.
+	*/
+	ContextLines *int64
+
+	/* File.
+
+	   Only search the files whose path contains this text.
+	*/
+	File *string
+
+	/* HostID.
+
+	   Only search the logs of this host.
+
+	   Format: uuid
+	*/
+	HostID *strfmt.UUID
+
+	/* Limit.
+
+	   The maximal number of matching lines returned.
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* LogsType.
+
+	   Only search the logs of this type.
+	*/
+	LogsType *string
+
+	/* Q.
+
+	   The text searched in the lines of the logs, case-insensitive.
+	*/
+	Q string
+
+	/* Since.
+
+	   Only return the lines whose time is at or after this time.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Only return the lines whose time is at or before this time.
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 search cluster logs params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SearchClusterLogsParams) WithDefaults() *V2SearchClusterLogsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 search cluster logs params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SearchClusterLogsParams) SetDefaults() {
+	var (
+		contextLinesDefault = int64(0)
+
+		limitDefault = int64(100)
+	)
+
+	val := V2SearchClusterLogsParams{
+		ContextLines: &contextLinesDefault,
+		Limit:        &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithTimeout(timeout time.Duration) *V2SearchClusterLogsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithContext(ctx context.Context) *V2SearchClusterLogsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithHTTPClient(client *http.Client) *V2SearchClusterLogsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithClusterID(clusterID strfmt.UUID) *V2SearchClusterLogsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithContextLines adds the contextLines to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithContextLines(contextLines *int64) *V2SearchClusterLogsParams {
+	o.SetContextLines(contextLines)
+	return o
+}
+
+// SetContextLines adds the contextLines to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetContextLines(contextLines *int64) {
+	o.ContextLines = contextLines
+}
+
+// WithFile adds the file to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithFile(file *string) *V2SearchClusterLogsParams {
+	o.SetFile(file)
+	return o
+}
+
+// SetFile adds the file to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetFile(file *string) {
+	o.File = file
+}
+
+// WithHostID adds the hostID to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithHostID(hostID *strfmt.UUID) *V2SearchClusterLogsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetHostID(hostID *strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithLimit adds the limit to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithLimit(limit *int64) *V2SearchClusterLogsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithLogsType adds the logsType to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithLogsType(logsType *string) *V2SearchClusterLogsParams {
+	o.SetLogsType(logsType)
+	return o
+}
+
+// SetLogsType adds the logsType to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetLogsType(logsType *string) {
+	o.LogsType = logsType
+}
+
+// WithQ adds the q to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithQ(q string) *V2SearchClusterLogsParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetQ(q string) {
+	o.Q = q
+}
+
+// WithSince adds the since to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithSince(since *strfmt.DateTime) *V2SearchClusterLogsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) WithUntil(until *strfmt.DateTime) *V2SearchClusterLogsParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the v2 search cluster logs params
+func (o *V2SearchClusterLogsParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2SearchClusterLogsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.ContextLines != nil {
+
+		// query param context_lines
+		var qrContextLines int64
+
+		if o.ContextLines != nil {
+			qrContextLines = *o.ContextLines
+		}
+		qContextLines := swag.FormatInt64(qrContextLines)
+		if qContextLines != "" {
+
+			if err := r.SetQueryParam("context_lines", qContextLines); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.File != nil {
+
+		// query param file
+		var qrFile string
+
+		if o.File != nil {
+			qrFile = *o.File
+		}
+		qFile := qrFile
+		if qFile != "" {
+
+			if err := r.SetQueryParam("file", qFile); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.HostID != nil {
+
+		// query param host_id
+		var qrHostID strfmt.UUID
+
+		if o.HostID != nil {
+			qrHostID = *o.HostID
+		}
+		qHostID := qrHostID.String()
+		if qHostID != "" {
+
+			if err := r.SetQueryParam("host_id", qHostID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.LogsType != nil {
+
+		// query param logs_type
+		var qrLogsType string
+
+		if o.LogsType != nil {
+			qrLogsType = *o.LogsType
+		}
+		qLogsType := qrLogsType
+		if qLogsType != "" {
+
+			if err := r.SetQueryParam("logs_type", qLogsType); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param q
+	qrQ := o.Q
+	qQ := qrQ
+	if qQ != "" {
+
+		if err := r.SetQueryParam("q", qQ); err != nil {
+			return err
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SearchClusterLogsReader is a Reader for the V2SearchClusterLogs structure.
+type V2SearchClusterLogsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2SearchClusterLogsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2SearchClusterLogsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2SearchClusterLogsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2SearchClusterLogsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2SearchClusterLogsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2SearchClusterLogsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2SearchClusterLogsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2SearchClusterLogsOK creates a V2SearchClusterLogsOK with default headers values
+func NewV2SearchClusterLogsOK() *V2SearchClusterLogsOK {
+	return &V2SearchClusterLogsOK{}
+}
+
+/*
+V2SearchClusterLogsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2SearchClusterLogsOK struct {
+	Payload *models.LogSearchResult
+}
+
+// IsSuccess returns true when this v2 search cluster logs o k response has a 2xx status code
+func (o *V2SearchClusterLogsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 search cluster logs o k response has a 3xx status code
+func (o *V2SearchClusterLogsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs o k response has a 4xx status code
+func (o *V2SearchClusterLogsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 search cluster logs o k response has a 5xx status code
+func (o *V2SearchClusterLogsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 search cluster logs o k response a status code equal to that given
+func (o *V2SearchClusterLogsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2SearchClusterLogsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SearchClusterLogsOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SearchClusterLogsOK) GetPayload() *models.LogSearchResult {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.LogSearchResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SearchClusterLogsBadRequest creates a V2SearchClusterLogsBadRequest with default headers values
+func NewV2SearchClusterLogsBadRequest() *V2SearchClusterLogsBadRequest {
+	return &V2SearchClusterLogsBadRequest{}
+}
+
+/*
+V2SearchClusterLogsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2SearchClusterLogsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 search cluster logs bad request response has a 2xx status code
+func (o *V2SearchClusterLogsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 search cluster logs bad request response has a 3xx status code
+func (o *V2SearchClusterLogsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs bad request response has a 4xx status code
+func (o *V2SearchClusterLogsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 search cluster logs bad request response has a 5xx status code
+func (o *V2SearchClusterLogsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 search cluster logs bad request response a status code equal to that given
+func (o *V2SearchClusterLogsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2SearchClusterLogsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SearchClusterLogsBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SearchClusterLogsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SearchClusterLogsUnauthorized creates a V2SearchClusterLogsUnauthorized with default headers values
+func NewV2SearchClusterLogsUnauthorized() *V2SearchClusterLogsUnauthorized {
+	return &V2SearchClusterLogsUnauthorized{}
+}
+
+/*
+V2SearchClusterLogsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2SearchClusterLogsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 search cluster logs unauthorized response has a 2xx status code
+func (o *V2SearchClusterLogsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 search cluster logs unauthorized response has a 3xx status code
+func (o *V2SearchClusterLogsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs unauthorized response has a 4xx status code
+func (o *V2SearchClusterLogsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 search cluster logs unauthorized response has a 5xx status code
+func (o *V2SearchClusterLogsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 search cluster logs unauthorized response a status code equal to that given
+func (o *V2SearchClusterLogsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2SearchClusterLogsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SearchClusterLogsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SearchClusterLogsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SearchClusterLogsForbidden creates a V2SearchClusterLogsForbidden with default headers values
+func NewV2SearchClusterLogsForbidden() *V2SearchClusterLogsForbidden {
+	return &V2SearchClusterLogsForbidden{}
+}
+
+/*
+V2SearchClusterLogsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2SearchClusterLogsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 search cluster logs forbidden response has a 2xx status code
+func (o *V2SearchClusterLogsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 search cluster logs forbidden response has a 3xx status code
+func (o *V2SearchClusterLogsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs forbidden response has a 4xx status code
+func (o *V2SearchClusterLogsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 search cluster logs forbidden response has a 5xx status code
+func (o *V2SearchClusterLogsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 search cluster logs forbidden response a status code equal to that given
+func (o *V2SearchClusterLogsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2SearchClusterLogsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SearchClusterLogsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SearchClusterLogsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SearchClusterLogsNotFound creates a V2SearchClusterLogsNotFound with default headers values
+func NewV2SearchClusterLogsNotFound() *V2SearchClusterLogsNotFound {
+	return &V2SearchClusterLogsNotFound{}
+}
+
+/*
+V2SearchClusterLogsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2SearchClusterLogsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 search cluster logs not found response has a 2xx status code
+func (o *V2SearchClusterLogsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 search cluster logs not found response has a 3xx status code
+func (o *V2SearchClusterLogsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs not found response has a 4xx status code
+func (o *V2SearchClusterLogsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 search cluster logs not found response has a 5xx status code
+func (o *V2SearchClusterLogsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 search cluster logs not found response a status code equal to that given
+func (o *V2SearchClusterLogsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2SearchClusterLogsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SearchClusterLogsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SearchClusterLogsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SearchClusterLogsInternalServerError creates a V2SearchClusterLogsInternalServerError with default headers values
+func NewV2SearchClusterLogsInternalServerError() *V2SearchClusterLogsInternalServerError {
+	return &V2SearchClusterLogsInternalServerError{}
+}
+
+/*
+V2SearchClusterLogsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2SearchClusterLogsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 search cluster logs internal server error response has a 2xx status code
+func (o *V2SearchClusterLogsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 search cluster logs internal server error response has a 3xx status code
+func (o *V2SearchClusterLogsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 search cluster logs internal server error response has a 4xx status code
+func (o *V2SearchClusterLogsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 search cluster logs internal server error response has a 5xx status code
+func (o *V2SearchClusterLogsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 search cluster logs internal server error response a status code equal to that given
+func (o *V2SearchClusterLogsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2SearchClusterLogsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SearchClusterLogsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/logs/search][%d] v2SearchClusterLogsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SearchClusterLogsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SearchClusterLogsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the network report client
+type API interface {
+	/*
+	   V2DownloadNetworkReport Downloads the network report of the cluster, to be attached to support cases.*/
+	V2DownloadNetworkReport(ctx context.Context, params *V2DownloadNetworkReportParams, writer io.Writer) (*V2DownloadNetworkReportOK, error)
+	/*
+	   V2GetNetworkReport Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation.*/
+	V2GetNetworkReport(ctx context.Context, params *V2GetNetworkReportParams) (*V2GetNetworkReportOK, error)
+}
+
+// New creates a new network report API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for network report API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DownloadNetworkReport Downloads the network report of the cluster, to be attached to support cases.
+*/
+func (a *Client) V2DownloadNetworkReport(ctx context.Context, params *V2DownloadNetworkReportParams, writer io.Writer) (*V2DownloadNetworkReportOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DownloadNetworkReport",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/network-report/download",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadNetworkReportReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadNetworkReportOK), nil
+
+}
+
+/*
+V2GetNetworkReport Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation.
+*/
+func (a *Client) V2GetNetworkReport(ctx context.Context, params *V2GetNetworkReportParams) (*V2GetNetworkReportOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetNetworkReport",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/network-report",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetNetworkReportReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetNetworkReportOK), nil
+
+}
@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DownloadNetworkReportParams creates a new V2DownloadNetworkReportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DownloadNetworkReportParams() *V2DownloadNetworkReportParams {
+	return &V2DownloadNetworkReportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DownloadNetworkReportParamsWithTimeout creates a new V2DownloadNetworkReportParams object
+// with the ability to set a timeout on a request.
+func NewV2DownloadNetworkReportParamsWithTimeout(timeout time.Duration) *V2DownloadNetworkReportParams {
+	return &V2DownloadNetworkReportParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DownloadNetworkReportParamsWithContext creates a new V2DownloadNetworkReportParams object
+// with the ability to set a context for a request.
+func NewV2DownloadNetworkReportParamsWithContext(ctx context.Context) *V2DownloadNetworkReportParams {
+	return &V2DownloadNetworkReportParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DownloadNetworkReportParamsWithHTTPClient creates a new V2DownloadNetworkReportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DownloadNetworkReportParamsWithHTTPClient(client *http.Client) *V2DownloadNetworkReportParams {
+	return &V2DownloadNetworkReportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DownloadNetworkReportParams contains all the parameters to send to the API endpoint
+
+	for the v2 download network report operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DownloadNetworkReportParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose network is reported.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Format.
+
+	   The format of the downloaded report.
+
+	   Default: "html"
+	*/
+	Format *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 download network report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadNetworkReportParams) WithDefaults() *V2DownloadNetworkReportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 download network report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadNetworkReportParams) SetDefaults() {
+	var (
+		formatDefault = string("html")
+	)
+
+	val := V2DownloadNetworkReportParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) WithTimeout(timeout time.Duration) *V2DownloadNetworkReportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) WithContext(ctx context.Context) *V2DownloadNetworkReportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) WithHTTPClient(client *http.Client) *V2DownloadNetworkReportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) WithClusterID(clusterID strfmt.UUID) *V2DownloadNetworkReportParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithFormat adds the format to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) WithFormat(format *string) *V2DownloadNetworkReportParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the v2 download network report params
+func (o *V2DownloadNetworkReportParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DownloadNetworkReportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
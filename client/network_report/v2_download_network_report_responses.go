@@ -0,0 +1,376 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadNetworkReportReader is a Reader for the V2DownloadNetworkReport structure.
+type V2DownloadNetworkReportReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DownloadNetworkReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DownloadNetworkReportOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DownloadNetworkReportUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DownloadNetworkReportForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DownloadNetworkReportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DownloadNetworkReportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DownloadNetworkReportOK creates a V2DownloadNetworkReportOK with default headers values
+func NewV2DownloadNetworkReportOK(writer io.Writer) *V2DownloadNetworkReportOK {
+	return &V2DownloadNetworkReportOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2DownloadNetworkReportOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DownloadNetworkReportOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 download network report o k response has a 2xx status code
+func (o *V2DownloadNetworkReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 download network report o k response has a 3xx status code
+func (o *V2DownloadNetworkReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download network report o k response has a 4xx status code
+func (o *V2DownloadNetworkReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download network report o k response has a 5xx status code
+func (o *V2DownloadNetworkReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download network report o k response a status code equal to that given
+func (o *V2DownloadNetworkReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2DownloadNetworkReportOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2DownloadNetworkReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadNetworkReportUnauthorized creates a V2DownloadNetworkReportUnauthorized with default headers values
+func NewV2DownloadNetworkReportUnauthorized() *V2DownloadNetworkReportUnauthorized {
+	return &V2DownloadNetworkReportUnauthorized{}
+}
+
+/*
+V2DownloadNetworkReportUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DownloadNetworkReportUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download network report unauthorized response has a 2xx status code
+func (o *V2DownloadNetworkReportUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download network report unauthorized response has a 3xx status code
+func (o *V2DownloadNetworkReportUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download network report unauthorized response has a 4xx status code
+func (o *V2DownloadNetworkReportUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download network report unauthorized response has a 5xx status code
+func (o *V2DownloadNetworkReportUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download network report unauthorized response a status code equal to that given
+func (o *V2DownloadNetworkReportUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DownloadNetworkReportUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadNetworkReportUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadNetworkReportForbidden creates a V2DownloadNetworkReportForbidden with default headers values
+func NewV2DownloadNetworkReportForbidden() *V2DownloadNetworkReportForbidden {
+	return &V2DownloadNetworkReportForbidden{}
+}
+
+/*
+V2DownloadNetworkReportForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DownloadNetworkReportForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download network report forbidden response has a 2xx status code
+func (o *V2DownloadNetworkReportForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download network report forbidden response has a 3xx status code
+func (o *V2DownloadNetworkReportForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download network report forbidden response has a 4xx status code
+func (o *V2DownloadNetworkReportForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download network report forbidden response has a 5xx status code
+func (o *V2DownloadNetworkReportForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download network report forbidden response a status code equal to that given
+func (o *V2DownloadNetworkReportForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DownloadNetworkReportForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadNetworkReportForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadNetworkReportNotFound creates a V2DownloadNetworkReportNotFound with default headers values
+func NewV2DownloadNetworkReportNotFound() *V2DownloadNetworkReportNotFound {
+	return &V2DownloadNetworkReportNotFound{}
+}
+
+/*
+V2DownloadNetworkReportNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DownloadNetworkReportNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download network report not found response has a 2xx status code
+func (o *V2DownloadNetworkReportNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download network report not found response has a 3xx status code
+func (o *V2DownloadNetworkReportNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download network report not found response has a 4xx status code
+func (o *V2DownloadNetworkReportNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download network report not found response has a 5xx status code
+func (o *V2DownloadNetworkReportNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download network report not found response a status code equal to that given
+func (o *V2DownloadNetworkReportNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DownloadNetworkReportNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadNetworkReportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadNetworkReportInternalServerError creates a V2DownloadNetworkReportInternalServerError with default headers values
+func NewV2DownloadNetworkReportInternalServerError() *V2DownloadNetworkReportInternalServerError {
+	return &V2DownloadNetworkReportInternalServerError{}
+}
+
+/*
+V2DownloadNetworkReportInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DownloadNetworkReportInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download network report internal server error response has a 2xx status code
+func (o *V2DownloadNetworkReportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download network report internal server error response has a 3xx status code
+func (o *V2DownloadNetworkReportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download network report internal server error response has a 4xx status code
+func (o *V2DownloadNetworkReportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download network report internal server error response has a 5xx status code
+func (o *V2DownloadNetworkReportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 download network report internal server error response a status code equal to that given
+func (o *V2DownloadNetworkReportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DownloadNetworkReportInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report/download][%d] v2DownloadNetworkReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadNetworkReportInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadNetworkReportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetNetworkReportParams creates a new V2GetNetworkReportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetNetworkReportParams() *V2GetNetworkReportParams {
+	return &V2GetNetworkReportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetNetworkReportParamsWithTimeout creates a new V2GetNetworkReportParams object
+// with the ability to set a timeout on a request.
+func NewV2GetNetworkReportParamsWithTimeout(timeout time.Duration) *V2GetNetworkReportParams {
+	return &V2GetNetworkReportParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetNetworkReportParamsWithContext creates a new V2GetNetworkReportParams object
+// with the ability to set a context for a request.
+func NewV2GetNetworkReportParamsWithContext(ctx context.Context) *V2GetNetworkReportParams {
+	return &V2GetNetworkReportParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetNetworkReportParamsWithHTTPClient creates a new V2GetNetworkReportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetNetworkReportParamsWithHTTPClient(client *http.Client) *V2GetNetworkReportParams {
+	return &V2GetNetworkReportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetNetworkReportParams contains all the parameters to send to the API endpoint
+
+	for the v2 get network report operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetNetworkReportParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose network is reported.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get network report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetNetworkReportParams) WithDefaults() *V2GetNetworkReportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get network report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetNetworkReportParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get network report params
+func (o *V2GetNetworkReportParams) WithTimeout(timeout time.Duration) *V2GetNetworkReportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get network report params
+func (o *V2GetNetworkReportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get network report params
+func (o *V2GetNetworkReportParams) WithContext(ctx context.Context) *V2GetNetworkReportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get network report params
+func (o *V2GetNetworkReportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get network report params
+func (o *V2GetNetworkReportParams) WithHTTPClient(client *http.Client) *V2GetNetworkReportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get network report params
+func (o *V2GetNetworkReportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get network report params
+func (o *V2GetNetworkReportParams) WithClusterID(clusterID strfmt.UUID) *V2GetNetworkReportParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get network report params
+func (o *V2GetNetworkReportParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetNetworkReportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
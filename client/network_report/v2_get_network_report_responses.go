@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetNetworkReportReader is a Reader for the V2GetNetworkReport structure.
+type V2GetNetworkReportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetNetworkReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetNetworkReportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetNetworkReportUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetNetworkReportForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetNetworkReportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetNetworkReportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetNetworkReportOK creates a V2GetNetworkReportOK with default headers values
+func NewV2GetNetworkReportOK() *V2GetNetworkReportOK {
+	return &V2GetNetworkReportOK{}
+}
+
+/*
+V2GetNetworkReportOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetNetworkReportOK struct {
+	Payload *models.NetworkReport
+}
+
+// IsSuccess returns true when this v2 get network report o k response has a 2xx status code
+func (o *V2GetNetworkReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get network report o k response has a 3xx status code
+func (o *V2GetNetworkReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get network report o k response has a 4xx status code
+func (o *V2GetNetworkReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get network report o k response has a 5xx status code
+func (o *V2GetNetworkReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get network report o k response a status code equal to that given
+func (o *V2GetNetworkReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetNetworkReportOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetNetworkReportOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetNetworkReportOK) GetPayload() *models.NetworkReport {
+	return o.Payload
+}
+
+func (o *V2GetNetworkReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetNetworkReportUnauthorized creates a V2GetNetworkReportUnauthorized with default headers values
+func NewV2GetNetworkReportUnauthorized() *V2GetNetworkReportUnauthorized {
+	return &V2GetNetworkReportUnauthorized{}
+}
+
+/*
+V2GetNetworkReportUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetNetworkReportUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get network report unauthorized response has a 2xx status code
+func (o *V2GetNetworkReportUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get network report unauthorized response has a 3xx status code
+func (o *V2GetNetworkReportUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get network report unauthorized response has a 4xx status code
+func (o *V2GetNetworkReportUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get network report unauthorized response has a 5xx status code
+func (o *V2GetNetworkReportUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get network report unauthorized response a status code equal to that given
+func (o *V2GetNetworkReportUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetNetworkReportUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetNetworkReportUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetNetworkReportUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetNetworkReportUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetNetworkReportForbidden creates a V2GetNetworkReportForbidden with default headers values
+func NewV2GetNetworkReportForbidden() *V2GetNetworkReportForbidden {
+	return &V2GetNetworkReportForbidden{}
+}
+
+/*
+V2GetNetworkReportForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetNetworkReportForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get network report forbidden response has a 2xx status code
+func (o *V2GetNetworkReportForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get network report forbidden response has a 3xx status code
+func (o *V2GetNetworkReportForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get network report forbidden response has a 4xx status code
+func (o *V2GetNetworkReportForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get network report forbidden response has a 5xx status code
+func (o *V2GetNetworkReportForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get network report forbidden response a status code equal to that given
+func (o *V2GetNetworkReportForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetNetworkReportForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetNetworkReportForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetNetworkReportForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetNetworkReportForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetNetworkReportNotFound creates a V2GetNetworkReportNotFound with default headers values
+func NewV2GetNetworkReportNotFound() *V2GetNetworkReportNotFound {
+	return &V2GetNetworkReportNotFound{}
+}
+
+/*
+V2GetNetworkReportNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetNetworkReportNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get network report not found response has a 2xx status code
+func (o *V2GetNetworkReportNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get network report not found response has a 3xx status code
+func (o *V2GetNetworkReportNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get network report not found response has a 4xx status code
+func (o *V2GetNetworkReportNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get network report not found response has a 5xx status code
+func (o *V2GetNetworkReportNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get network report not found response a status code equal to that given
+func (o *V2GetNetworkReportNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetNetworkReportNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetNetworkReportNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetNetworkReportNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetNetworkReportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetNetworkReportInternalServerError creates a V2GetNetworkReportInternalServerError with default headers values
+func NewV2GetNetworkReportInternalServerError() *V2GetNetworkReportInternalServerError {
+	return &V2GetNetworkReportInternalServerError{}
+}
+
+/*
+V2GetNetworkReportInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetNetworkReportInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get network report internal server error response has a 2xx status code
+func (o *V2GetNetworkReportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get network report internal server error response has a 3xx status code
+func (o *V2GetNetworkReportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get network report internal server error response has a 4xx status code
+func (o *V2GetNetworkReportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get network report internal server error response has a 5xx status code
+func (o *V2GetNetworkReportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get network report internal server error response a status code equal to that given
+func (o *V2GetNetworkReportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetNetworkReportInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetNetworkReportInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/network-report][%d] v2GetNetworkReportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetNetworkReportInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetNetworkReportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package triage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the triage client
+type API interface {
+	/*
+	   V2ListClusterTriageFindings Lists the known failures found by the automatic triage of the logs uploaded for the cluster.*/
+	V2ListClusterTriageFindings(ctx context.Context, params *V2ListClusterTriageFindingsParams) (*V2ListClusterTriageFindingsOK, error)
+}
+
+// New creates a new triage API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for triage API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2ListClusterTriageFindings Lists the known failures found by the automatic triage of the logs uploaded for the cluster.
+*/
+func (a *Client) V2ListClusterTriageFindings(ctx context.Context, params *V2ListClusterTriageFindingsParams) (*V2ListClusterTriageFindingsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterTriageFindings",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/triage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterTriageFindingsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterTriageFindingsOK), nil
+
+}
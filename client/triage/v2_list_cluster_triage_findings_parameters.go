@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package triage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterTriageFindingsParams creates a new V2ListClusterTriageFindingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterTriageFindingsParams() *V2ListClusterTriageFindingsParams {
+	return &V2ListClusterTriageFindingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterTriageFindingsParamsWithTimeout creates a new V2ListClusterTriageFindingsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterTriageFindingsParamsWithTimeout(timeout time.Duration) *V2ListClusterTriageFindingsParams {
+	return &V2ListClusterTriageFindingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterTriageFindingsParamsWithContext creates a new V2ListClusterTriageFindingsParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterTriageFindingsParamsWithContext(ctx context.Context) *V2ListClusterTriageFindingsParams {
+	return &V2ListClusterTriageFindingsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterTriageFindingsParamsWithHTTPClient creates a new V2ListClusterTriageFindingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterTriageFindingsParamsWithHTTPClient(client *http.Client) *V2ListClusterTriageFindingsParams {
+	return &V2ListClusterTriageFindingsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListClusterTriageFindingsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list cluster triage findings operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListClusterTriageFindingsParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose findings are listed.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster triage findings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterTriageFindingsParams) WithDefaults() *V2ListClusterTriageFindingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster triage findings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterTriageFindingsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) WithTimeout(timeout time.Duration) *V2ListClusterTriageFindingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) WithContext(ctx context.Context) *V2ListClusterTriageFindingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) WithHTTPClient(client *http.Client) *V2ListClusterTriageFindingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) WithClusterID(clusterID strfmt.UUID) *V2ListClusterTriageFindingsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list cluster triage findings params
+func (o *V2ListClusterTriageFindingsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterTriageFindingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package triage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterTriageFindingsReader is a Reader for the V2ListClusterTriageFindings structure.
+type V2ListClusterTriageFindingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterTriageFindingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterTriageFindingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListClusterTriageFindingsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterTriageFindingsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListClusterTriageFindingsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterTriageFindingsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterTriageFindingsOK creates a V2ListClusterTriageFindingsOK with default headers values
+func NewV2ListClusterTriageFindingsOK() *V2ListClusterTriageFindingsOK {
+	return &V2ListClusterTriageFindingsOK{}
+}
+
+/*
+V2ListClusterTriageFindingsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterTriageFindingsOK struct {
+	Payload []*models.TriageFinding
+}
+
+// IsSuccess returns true when this v2 list cluster triage findings o k response has a 2xx status code
+func (o *V2ListClusterTriageFindingsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list cluster triage findings o k response has a 3xx status code
+func (o *V2ListClusterTriageFindingsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster triage findings o k response has a 4xx status code
+func (o *V2ListClusterTriageFindingsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster triage findings o k response has a 5xx status code
+func (o *V2ListClusterTriageFindingsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster triage findings o k response a status code equal to that given
+func (o *V2ListClusterTriageFindingsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListClusterTriageFindingsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsOK) GetPayload() []*models.TriageFinding {
+	return o.Payload
+}
+
+func (o *V2ListClusterTriageFindingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTriageFindingsUnauthorized creates a V2ListClusterTriageFindingsUnauthorized with default headers values
+func NewV2ListClusterTriageFindingsUnauthorized() *V2ListClusterTriageFindingsUnauthorized {
+	return &V2ListClusterTriageFindingsUnauthorized{}
+}
+
+/*
+V2ListClusterTriageFindingsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterTriageFindingsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster triage findings unauthorized response has a 2xx status code
+func (o *V2ListClusterTriageFindingsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster triage findings unauthorized response has a 3xx status code
+func (o *V2ListClusterTriageFindingsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster triage findings unauthorized response has a 4xx status code
+func (o *V2ListClusterTriageFindingsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster triage findings unauthorized response has a 5xx status code
+func (o *V2ListClusterTriageFindingsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster triage findings unauthorized response a status code equal to that given
+func (o *V2ListClusterTriageFindingsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListClusterTriageFindingsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterTriageFindingsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTriageFindingsForbidden creates a V2ListClusterTriageFindingsForbidden with default headers values
+func NewV2ListClusterTriageFindingsForbidden() *V2ListClusterTriageFindingsForbidden {
+	return &V2ListClusterTriageFindingsForbidden{}
+}
+
+/*
+V2ListClusterTriageFindingsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterTriageFindingsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster triage findings forbidden response has a 2xx status code
+func (o *V2ListClusterTriageFindingsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster triage findings forbidden response has a 3xx status code
+func (o *V2ListClusterTriageFindingsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster triage findings forbidden response has a 4xx status code
+func (o *V2ListClusterTriageFindingsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster triage findings forbidden response has a 5xx status code
+func (o *V2ListClusterTriageFindingsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster triage findings forbidden response a status code equal to that given
+func (o *V2ListClusterTriageFindingsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListClusterTriageFindingsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterTriageFindingsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTriageFindingsNotFound creates a V2ListClusterTriageFindingsNotFound with default headers values
+func NewV2ListClusterTriageFindingsNotFound() *V2ListClusterTriageFindingsNotFound {
+	return &V2ListClusterTriageFindingsNotFound{}
+}
+
+/*
+V2ListClusterTriageFindingsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListClusterTriageFindingsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster triage findings not found response has a 2xx status code
+func (o *V2ListClusterTriageFindingsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster triage findings not found response has a 3xx status code
+func (o *V2ListClusterTriageFindingsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster triage findings not found response has a 4xx status code
+func (o *V2ListClusterTriageFindingsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster triage findings not found response has a 5xx status code
+func (o *V2ListClusterTriageFindingsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster triage findings not found response a status code equal to that given
+func (o *V2ListClusterTriageFindingsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListClusterTriageFindingsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterTriageFindingsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterTriageFindingsInternalServerError creates a V2ListClusterTriageFindingsInternalServerError with default headers values
+func NewV2ListClusterTriageFindingsInternalServerError() *V2ListClusterTriageFindingsInternalServerError {
+	return &V2ListClusterTriageFindingsInternalServerError{}
+}
+
+/*
+V2ListClusterTriageFindingsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterTriageFindingsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster triage findings internal server error response has a 2xx status code
+func (o *V2ListClusterTriageFindingsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster triage findings internal server error response has a 3xx status code
+func (o *V2ListClusterTriageFindingsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster triage findings internal server error response has a 4xx status code
+func (o *V2ListClusterTriageFindingsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster triage findings internal server error response has a 5xx status code
+func (o *V2ListClusterTriageFindingsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list cluster triage findings internal server error response a status code equal to that given
+func (o *V2ListClusterTriageFindingsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListClusterTriageFindingsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/triage][%d] v2ListClusterTriageFindingsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterTriageFindingsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterTriageFindingsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2WatchClusterParams creates a new V2WatchClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2WatchClusterParams() *V2WatchClusterParams {
+	return &V2WatchClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2WatchClusterParamsWithTimeout creates a new V2WatchClusterParams object
+// with the ability to set a timeout on a request.
+func NewV2WatchClusterParamsWithTimeout(timeout time.Duration) *V2WatchClusterParams {
+	return &V2WatchClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2WatchClusterParamsWithContext creates a new V2WatchClusterParams object
+// with the ability to set a context for a request.
+func NewV2WatchClusterParamsWithContext(ctx context.Context) *V2WatchClusterParams {
+	return &V2WatchClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewV2WatchClusterParamsWithHTTPClient creates a new V2WatchClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2WatchClusterParamsWithHTTPClient(client *http.Client) *V2WatchClusterParams {
+	return &V2WatchClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2WatchClusterParams contains all the parameters to send to the API endpoint
+
+	for the v2 watch cluster operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2WatchClusterParams struct {
+
+	/* LastEventID.
+
+	   The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.
+	*/
+	LastEventID *string
+
+	/* ClusterID.
+
+	   The cluster to be watched.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Revision.
+
+	   Resume the stream after this revision. Only new changes are streamed if not set.
+
+	   Format: int64
+	*/
+	Revision *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 watch cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchClusterParams) WithDefaults() *V2WatchClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 watch cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithTimeout(timeout time.Duration) *V2WatchClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithContext(ctx context.Context) *V2WatchClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithHTTPClient(client *http.Client) *V2WatchClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithLastEventID(lastEventID *string) *V2WatchClusterParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetLastEventID(lastEventID *string) {
+	o.LastEventID = lastEventID
+}
+
+// WithClusterID adds the clusterID to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithClusterID(clusterID strfmt.UUID) *V2WatchClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithRevision adds the revision to the v2 watch cluster params
+func (o *V2WatchClusterParams) WithRevision(revision *int64) *V2WatchClusterParams {
+	o.SetRevision(revision)
+	return o
+}
+
+// SetRevision adds the revision to the v2 watch cluster params
+func (o *V2WatchClusterParams) SetRevision(revision *int64) {
+	o.Revision = revision
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2WatchClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", *o.LastEventID); err != nil {
+			return err
+		}
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.Revision != nil {
+
+		// query param revision
+		var qrRevision int64
+
+		if o.Revision != nil {
+			qrRevision = *o.Revision
+		}
+		qRevision := swag.FormatInt64(qrRevision)
+		if qRevision != "" {
+
+			if err := r.SetQueryParam("revision", qRevision); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
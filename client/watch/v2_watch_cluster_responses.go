@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchClusterReader is a Reader for the V2WatchCluster structure.
+type V2WatchClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2WatchClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2WatchClusterOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2WatchClusterBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2WatchClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2WatchClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2WatchClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2WatchClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2WatchClusterOK creates a V2WatchClusterOK with default headers values
+func NewV2WatchClusterOK() *V2WatchClusterOK {
+	return &V2WatchClusterOK{}
+}
+
+/*
+V2WatchClusterOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2WatchClusterOK struct {
+	Payload string
+}
+
+// IsSuccess returns true when this v2 watch cluster o k response has a 2xx status code
+func (o *V2WatchClusterOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 watch cluster o k response has a 3xx status code
+func (o *V2WatchClusterOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster o k response has a 4xx status code
+func (o *V2WatchClusterOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch cluster o k response has a 5xx status code
+func (o *V2WatchClusterOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch cluster o k response a status code equal to that given
+func (o *V2WatchClusterOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2WatchClusterOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchClusterOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchClusterOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *V2WatchClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchClusterBadRequest creates a V2WatchClusterBadRequest with default headers values
+func NewV2WatchClusterBadRequest() *V2WatchClusterBadRequest {
+	return &V2WatchClusterBadRequest{}
+}
+
+/*
+V2WatchClusterBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2WatchClusterBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch cluster bad request response has a 2xx status code
+func (o *V2WatchClusterBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch cluster bad request response has a 3xx status code
+func (o *V2WatchClusterBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster bad request response has a 4xx status code
+func (o *V2WatchClusterBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch cluster bad request response has a 5xx status code
+func (o *V2WatchClusterBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch cluster bad request response a status code equal to that given
+func (o *V2WatchClusterBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2WatchClusterBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchClusterBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchClusterBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchClusterBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchClusterUnauthorized creates a V2WatchClusterUnauthorized with default headers values
+func NewV2WatchClusterUnauthorized() *V2WatchClusterUnauthorized {
+	return &V2WatchClusterUnauthorized{}
+}
+
+/*
+V2WatchClusterUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2WatchClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch cluster unauthorized response has a 2xx status code
+func (o *V2WatchClusterUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch cluster unauthorized response has a 3xx status code
+func (o *V2WatchClusterUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster unauthorized response has a 4xx status code
+func (o *V2WatchClusterUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch cluster unauthorized response has a 5xx status code
+func (o *V2WatchClusterUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch cluster unauthorized response a status code equal to that given
+func (o *V2WatchClusterUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2WatchClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchClusterUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchClusterForbidden creates a V2WatchClusterForbidden with default headers values
+func NewV2WatchClusterForbidden() *V2WatchClusterForbidden {
+	return &V2WatchClusterForbidden{}
+}
+
+/*
+V2WatchClusterForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2WatchClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch cluster forbidden response has a 2xx status code
+func (o *V2WatchClusterForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch cluster forbidden response has a 3xx status code
+func (o *V2WatchClusterForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster forbidden response has a 4xx status code
+func (o *V2WatchClusterForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch cluster forbidden response has a 5xx status code
+func (o *V2WatchClusterForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch cluster forbidden response a status code equal to that given
+func (o *V2WatchClusterForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2WatchClusterForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchClusterForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchClusterNotFound creates a V2WatchClusterNotFound with default headers values
+func NewV2WatchClusterNotFound() *V2WatchClusterNotFound {
+	return &V2WatchClusterNotFound{}
+}
+
+/*
+V2WatchClusterNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2WatchClusterNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch cluster not found response has a 2xx status code
+func (o *V2WatchClusterNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch cluster not found response has a 3xx status code
+func (o *V2WatchClusterNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster not found response has a 4xx status code
+func (o *V2WatchClusterNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch cluster not found response has a 5xx status code
+func (o *V2WatchClusterNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch cluster not found response a status code equal to that given
+func (o *V2WatchClusterNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2WatchClusterNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchClusterNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchClusterInternalServerError creates a V2WatchClusterInternalServerError with default headers values
+func NewV2WatchClusterInternalServerError() *V2WatchClusterInternalServerError {
+	return &V2WatchClusterInternalServerError{}
+}
+
+/*
+V2WatchClusterInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2WatchClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch cluster internal server error response has a 2xx status code
+func (o *V2WatchClusterInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch cluster internal server error response has a 3xx status code
+func (o *V2WatchClusterInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch cluster internal server error response has a 4xx status code
+func (o *V2WatchClusterInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch cluster internal server error response has a 5xx status code
+func (o *V2WatchClusterInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 watch cluster internal server error response a status code equal to that given
+func (o *V2WatchClusterInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2WatchClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchClusterInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/watch][%d] v2WatchClusterInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2WatchInfraEnvParams creates a new V2WatchInfraEnvParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2WatchInfraEnvParams() *V2WatchInfraEnvParams {
+	return &V2WatchInfraEnvParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2WatchInfraEnvParamsWithTimeout creates a new V2WatchInfraEnvParams object
+// with the ability to set a timeout on a request.
+func NewV2WatchInfraEnvParamsWithTimeout(timeout time.Duration) *V2WatchInfraEnvParams {
+	return &V2WatchInfraEnvParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2WatchInfraEnvParamsWithContext creates a new V2WatchInfraEnvParams object
+// with the ability to set a context for a request.
+func NewV2WatchInfraEnvParamsWithContext(ctx context.Context) *V2WatchInfraEnvParams {
+	return &V2WatchInfraEnvParams{
+		Context: ctx,
+	}
+}
+
+// NewV2WatchInfraEnvParamsWithHTTPClient creates a new V2WatchInfraEnvParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2WatchInfraEnvParamsWithHTTPClient(client *http.Client) *V2WatchInfraEnvParams {
+	return &V2WatchInfraEnvParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2WatchInfraEnvParams contains all the parameters to send to the API endpoint
+
+	for the v2 watch infra env operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2WatchInfraEnvParams struct {
+
+	/* LastEventID.
+
+	   The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.
+	*/
+	LastEventID *string
+
+	/* InfraEnvID.
+
+	   The infra-env to be watched.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	/* Revision.
+
+	   Resume the stream after this revision. Only new changes are streamed if not set.
+
+	   Format: int64
+	*/
+	Revision *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 watch infra env params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchInfraEnvParams) WithDefaults() *V2WatchInfraEnvParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 watch infra env params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2WatchInfraEnvParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithTimeout(timeout time.Duration) *V2WatchInfraEnvParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithContext(ctx context.Context) *V2WatchInfraEnvParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithHTTPClient(client *http.Client) *V2WatchInfraEnvParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithLastEventID(lastEventID *string) *V2WatchInfraEnvParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetLastEventID(lastEventID *string) {
+	o.LastEventID = lastEventID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2WatchInfraEnvParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithRevision adds the revision to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) WithRevision(revision *int64) *V2WatchInfraEnvParams {
+	o.SetRevision(revision)
+	return o
+}
+
+// SetRevision adds the revision to the v2 watch infra env params
+func (o *V2WatchInfraEnvParams) SetRevision(revision *int64) {
+	o.Revision = revision
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2WatchInfraEnvParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", *o.LastEventID); err != nil {
+			return err
+		}
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if o.Revision != nil {
+
+		// query param revision
+		var qrRevision int64
+
+		if o.Revision != nil {
+			qrRevision = *o.Revision
+		}
+		qRevision := swag.FormatInt64(qrRevision)
+		if qRevision != "" {
+
+			if err := r.SetQueryParam("revision", qRevision); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchInfraEnvReader is a Reader for the V2WatchInfraEnv structure.
+type V2WatchInfraEnvReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2WatchInfraEnvReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2WatchInfraEnvOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2WatchInfraEnvBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2WatchInfraEnvUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2WatchInfraEnvForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2WatchInfraEnvNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2WatchInfraEnvInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2WatchInfraEnvOK creates a V2WatchInfraEnvOK with default headers values
+func NewV2WatchInfraEnvOK() *V2WatchInfraEnvOK {
+	return &V2WatchInfraEnvOK{}
+}
+
+/*
+V2WatchInfraEnvOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2WatchInfraEnvOK struct {
+	Payload string
+}
+
+// IsSuccess returns true when this v2 watch infra env o k response has a 2xx status code
+func (o *V2WatchInfraEnvOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 watch infra env o k response has a 3xx status code
+func (o *V2WatchInfraEnvOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env o k response has a 4xx status code
+func (o *V2WatchInfraEnvOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch infra env o k response has a 5xx status code
+func (o *V2WatchInfraEnvOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch infra env o k response a status code equal to that given
+func (o *V2WatchInfraEnvOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2WatchInfraEnvOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchInfraEnvOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvOK  %+v", 200, o.Payload)
+}
+
+func (o *V2WatchInfraEnvOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInfraEnvBadRequest creates a V2WatchInfraEnvBadRequest with default headers values
+func NewV2WatchInfraEnvBadRequest() *V2WatchInfraEnvBadRequest {
+	return &V2WatchInfraEnvBadRequest{}
+}
+
+/*
+V2WatchInfraEnvBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2WatchInfraEnvBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch infra env bad request response has a 2xx status code
+func (o *V2WatchInfraEnvBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch infra env bad request response has a 3xx status code
+func (o *V2WatchInfraEnvBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env bad request response has a 4xx status code
+func (o *V2WatchInfraEnvBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch infra env bad request response has a 5xx status code
+func (o *V2WatchInfraEnvBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch infra env bad request response a status code equal to that given
+func (o *V2WatchInfraEnvBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2WatchInfraEnvBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchInfraEnvBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2WatchInfraEnvBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInfraEnvUnauthorized creates a V2WatchInfraEnvUnauthorized with default headers values
+func NewV2WatchInfraEnvUnauthorized() *V2WatchInfraEnvUnauthorized {
+	return &V2WatchInfraEnvUnauthorized{}
+}
+
+/*
+V2WatchInfraEnvUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2WatchInfraEnvUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch infra env unauthorized response has a 2xx status code
+func (o *V2WatchInfraEnvUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch infra env unauthorized response has a 3xx status code
+func (o *V2WatchInfraEnvUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env unauthorized response has a 4xx status code
+func (o *V2WatchInfraEnvUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch infra env unauthorized response has a 5xx status code
+func (o *V2WatchInfraEnvUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch infra env unauthorized response a status code equal to that given
+func (o *V2WatchInfraEnvUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2WatchInfraEnvUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchInfraEnvUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2WatchInfraEnvUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInfraEnvForbidden creates a V2WatchInfraEnvForbidden with default headers values
+func NewV2WatchInfraEnvForbidden() *V2WatchInfraEnvForbidden {
+	return &V2WatchInfraEnvForbidden{}
+}
+
+/*
+V2WatchInfraEnvForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2WatchInfraEnvForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 watch infra env forbidden response has a 2xx status code
+func (o *V2WatchInfraEnvForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch infra env forbidden response has a 3xx status code
+func (o *V2WatchInfraEnvForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env forbidden response has a 4xx status code
+func (o *V2WatchInfraEnvForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch infra env forbidden response has a 5xx status code
+func (o *V2WatchInfraEnvForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch infra env forbidden response a status code equal to that given
+func (o *V2WatchInfraEnvForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2WatchInfraEnvForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchInfraEnvForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2WatchInfraEnvForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInfraEnvNotFound creates a V2WatchInfraEnvNotFound with default headers values
+func NewV2WatchInfraEnvNotFound() *V2WatchInfraEnvNotFound {
+	return &V2WatchInfraEnvNotFound{}
+}
+
+/*
+V2WatchInfraEnvNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2WatchInfraEnvNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch infra env not found response has a 2xx status code
+func (o *V2WatchInfraEnvNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch infra env not found response has a 3xx status code
+func (o *V2WatchInfraEnvNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env not found response has a 4xx status code
+func (o *V2WatchInfraEnvNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 watch infra env not found response has a 5xx status code
+func (o *V2WatchInfraEnvNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 watch infra env not found response a status code equal to that given
+func (o *V2WatchInfraEnvNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2WatchInfraEnvNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchInfraEnvNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2WatchInfraEnvNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2WatchInfraEnvInternalServerError creates a V2WatchInfraEnvInternalServerError with default headers values
+func NewV2WatchInfraEnvInternalServerError() *V2WatchInfraEnvInternalServerError {
+	return &V2WatchInfraEnvInternalServerError{}
+}
+
+/*
+V2WatchInfraEnvInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2WatchInfraEnvInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 watch infra env internal server error response has a 2xx status code
+func (o *V2WatchInfraEnvInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 watch infra env internal server error response has a 3xx status code
+func (o *V2WatchInfraEnvInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 watch infra env internal server error response has a 4xx status code
+func (o *V2WatchInfraEnvInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 watch infra env internal server error response has a 5xx status code
+func (o *V2WatchInfraEnvInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 watch infra env internal server error response a status code equal to that given
+func (o *V2WatchInfraEnvInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2WatchInfraEnvInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchInfraEnvInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/watch][%d] v2WatchInfraEnvInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2WatchInfraEnvInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2WatchInfraEnvInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
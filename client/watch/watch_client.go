@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the watch client
+type API interface {
+	/*
+	   V2WatchCluster Streams the changes of the cluster, its hosts and its events as server-sent events.*/
+	V2WatchCluster(ctx context.Context, params *V2WatchClusterParams) (*V2WatchClusterOK, error)
+	/*
+	   V2WatchInfraEnv Streams the changes of the infra-env, its hosts and its events as server-sent events.*/
+	V2WatchInfraEnv(ctx context.Context, params *V2WatchInfraEnvParams) (*V2WatchInfraEnvOK, error)
+}
+
+// New creates a new watch API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for watch API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2WatchCluster Streams the changes of the cluster, its hosts and its events as server-sent events.
+*/
+func (a *Client) V2WatchCluster(ctx context.Context, params *V2WatchClusterParams) (*V2WatchClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2WatchCluster",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2WatchClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2WatchClusterOK), nil
+
+}
+
+/*
+V2WatchInfraEnv Streams the changes of the infra-env, its hosts and its events as server-sent events.
+*/
+func (a *Client) V2WatchInfraEnv(ctx context.Context, params *V2WatchInfraEnvParams) (*V2WatchInfraEnvOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2WatchInfraEnv",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2WatchInfraEnvReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2WatchInfraEnvOK), nil
+
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeregisterWebhookSubscriptionParams creates a new V2DeregisterWebhookSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeregisterWebhookSubscriptionParams() *V2DeregisterWebhookSubscriptionParams {
+	return &V2DeregisterWebhookSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeregisterWebhookSubscriptionParamsWithTimeout creates a new V2DeregisterWebhookSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2DeregisterWebhookSubscriptionParamsWithTimeout(timeout time.Duration) *V2DeregisterWebhookSubscriptionParams {
+	return &V2DeregisterWebhookSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeregisterWebhookSubscriptionParamsWithContext creates a new V2DeregisterWebhookSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2DeregisterWebhookSubscriptionParamsWithContext(ctx context.Context) *V2DeregisterWebhookSubscriptionParams {
+	return &V2DeregisterWebhookSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeregisterWebhookSubscriptionParamsWithHTTPClient creates a new V2DeregisterWebhookSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeregisterWebhookSubscriptionParamsWithHTTPClient(client *http.Client) *V2DeregisterWebhookSubscriptionParams {
+	return &V2DeregisterWebhookSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DeregisterWebhookSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 deregister webhook subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DeregisterWebhookSubscriptionParams struct {
+
+	/* SubscriptionID.
+
+	   The webhook subscription to be deregistered.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 deregister webhook subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterWebhookSubscriptionParams) WithDefaults() *V2DeregisterWebhookSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 deregister webhook subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterWebhookSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) WithTimeout(timeout time.Duration) *V2DeregisterWebhookSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) WithContext(ctx context.Context) *V2DeregisterWebhookSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) WithHTTPClient(client *http.Client) *V2DeregisterWebhookSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2DeregisterWebhookSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 deregister webhook subscription params
+func (o *V2DeregisterWebhookSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeregisterWebhookSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
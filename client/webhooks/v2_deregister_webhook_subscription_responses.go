@@ -0,0 +1,362 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterWebhookSubscriptionReader is a Reader for the V2DeregisterWebhookSubscription structure.
+type V2DeregisterWebhookSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeregisterWebhookSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeregisterWebhookSubscriptionNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeregisterWebhookSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeregisterWebhookSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeregisterWebhookSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeregisterWebhookSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeregisterWebhookSubscriptionNoContent creates a V2DeregisterWebhookSubscriptionNoContent with default headers values
+func NewV2DeregisterWebhookSubscriptionNoContent() *V2DeregisterWebhookSubscriptionNoContent {
+	return &V2DeregisterWebhookSubscriptionNoContent{}
+}
+
+/*
+V2DeregisterWebhookSubscriptionNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeregisterWebhookSubscriptionNoContent struct {
+}
+
+// IsSuccess returns true when this v2 deregister webhook subscription no content response has a 2xx status code
+func (o *V2DeregisterWebhookSubscriptionNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 deregister webhook subscription no content response has a 3xx status code
+func (o *V2DeregisterWebhookSubscriptionNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister webhook subscription no content response has a 4xx status code
+func (o *V2DeregisterWebhookSubscriptionNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 deregister webhook subscription no content response has a 5xx status code
+func (o *V2DeregisterWebhookSubscriptionNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister webhook subscription no content response a status code equal to that given
+func (o *V2DeregisterWebhookSubscriptionNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *V2DeregisterWebhookSubscriptionNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionNoContent ", 204)
+}
+
+func (o *V2DeregisterWebhookSubscriptionNoContent) String() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionNoContent ", 204)
+}
+
+func (o *V2DeregisterWebhookSubscriptionNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeregisterWebhookSubscriptionUnauthorized creates a V2DeregisterWebhookSubscriptionUnauthorized with default headers values
+func NewV2DeregisterWebhookSubscriptionUnauthorized() *V2DeregisterWebhookSubscriptionUnauthorized {
+	return &V2DeregisterWebhookSubscriptionUnauthorized{}
+}
+
+/*
+V2DeregisterWebhookSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeregisterWebhookSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 deregister webhook subscription unauthorized response has a 2xx status code
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister webhook subscription unauthorized response has a 3xx status code
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister webhook subscription unauthorized response has a 4xx status code
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister webhook subscription unauthorized response has a 5xx status code
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister webhook subscription unauthorized response a status code equal to that given
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterWebhookSubscriptionForbidden creates a V2DeregisterWebhookSubscriptionForbidden with default headers values
+func NewV2DeregisterWebhookSubscriptionForbidden() *V2DeregisterWebhookSubscriptionForbidden {
+	return &V2DeregisterWebhookSubscriptionForbidden{}
+}
+
+/*
+V2DeregisterWebhookSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeregisterWebhookSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 deregister webhook subscription forbidden response has a 2xx status code
+func (o *V2DeregisterWebhookSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister webhook subscription forbidden response has a 3xx status code
+func (o *V2DeregisterWebhookSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister webhook subscription forbidden response has a 4xx status code
+func (o *V2DeregisterWebhookSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister webhook subscription forbidden response has a 5xx status code
+func (o *V2DeregisterWebhookSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister webhook subscription forbidden response a status code equal to that given
+func (o *V2DeregisterWebhookSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DeregisterWebhookSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterWebhookSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterWebhookSubscriptionNotFound creates a V2DeregisterWebhookSubscriptionNotFound with default headers values
+func NewV2DeregisterWebhookSubscriptionNotFound() *V2DeregisterWebhookSubscriptionNotFound {
+	return &V2DeregisterWebhookSubscriptionNotFound{}
+}
+
+/*
+V2DeregisterWebhookSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeregisterWebhookSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 deregister webhook subscription not found response has a 2xx status code
+func (o *V2DeregisterWebhookSubscriptionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister webhook subscription not found response has a 3xx status code
+func (o *V2DeregisterWebhookSubscriptionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister webhook subscription not found response has a 4xx status code
+func (o *V2DeregisterWebhookSubscriptionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 deregister webhook subscription not found response has a 5xx status code
+func (o *V2DeregisterWebhookSubscriptionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 deregister webhook subscription not found response a status code equal to that given
+func (o *V2DeregisterWebhookSubscriptionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DeregisterWebhookSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionNotFound) String() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterWebhookSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterWebhookSubscriptionInternalServerError creates a V2DeregisterWebhookSubscriptionInternalServerError with default headers values
+func NewV2DeregisterWebhookSubscriptionInternalServerError() *V2DeregisterWebhookSubscriptionInternalServerError {
+	return &V2DeregisterWebhookSubscriptionInternalServerError{}
+}
+
+/*
+V2DeregisterWebhookSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeregisterWebhookSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 deregister webhook subscription internal server error response has a 2xx status code
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 deregister webhook subscription internal server error response has a 3xx status code
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 deregister webhook subscription internal server error response has a 4xx status code
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 deregister webhook subscription internal server error response has a 5xx status code
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 deregister webhook subscription internal server error response a status code equal to that given
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /v2/webhook-subscriptions/{subscription_id}][%d] v2DeregisterWebhookSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListWebhookSubscriptionsParams creates a new V2ListWebhookSubscriptionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListWebhookSubscriptionsParams() *V2ListWebhookSubscriptionsParams {
+	return &V2ListWebhookSubscriptionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListWebhookSubscriptionsParamsWithTimeout creates a new V2ListWebhookSubscriptionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListWebhookSubscriptionsParamsWithTimeout(timeout time.Duration) *V2ListWebhookSubscriptionsParams {
+	return &V2ListWebhookSubscriptionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListWebhookSubscriptionsParamsWithContext creates a new V2ListWebhookSubscriptionsParams object
+// with the ability to set a context for a request.
+func NewV2ListWebhookSubscriptionsParamsWithContext(ctx context.Context) *V2ListWebhookSubscriptionsParams {
+	return &V2ListWebhookSubscriptionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListWebhookSubscriptionsParamsWithHTTPClient creates a new V2ListWebhookSubscriptionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListWebhookSubscriptionsParamsWithHTTPClient(client *http.Client) *V2ListWebhookSubscriptionsParams {
+	return &V2ListWebhookSubscriptionsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListWebhookSubscriptionsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list webhook subscriptions operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListWebhookSubscriptionsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list webhook subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListWebhookSubscriptionsParams) WithDefaults() *V2ListWebhookSubscriptionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list webhook subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListWebhookSubscriptionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) WithTimeout(timeout time.Duration) *V2ListWebhookSubscriptionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) WithContext(ctx context.Context) *V2ListWebhookSubscriptionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) WithHTTPClient(client *http.Client) *V2ListWebhookSubscriptionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list webhook subscriptions params
+func (o *V2ListWebhookSubscriptionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListWebhookSubscriptionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
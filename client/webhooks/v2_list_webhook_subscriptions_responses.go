@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListWebhookSubscriptionsReader is a Reader for the V2ListWebhookSubscriptions structure.
+type V2ListWebhookSubscriptionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListWebhookSubscriptionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListWebhookSubscriptionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListWebhookSubscriptionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListWebhookSubscriptionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListWebhookSubscriptionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListWebhookSubscriptionsOK creates a V2ListWebhookSubscriptionsOK with default headers values
+func NewV2ListWebhookSubscriptionsOK() *V2ListWebhookSubscriptionsOK {
+	return &V2ListWebhookSubscriptionsOK{}
+}
+
+/*
+V2ListWebhookSubscriptionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListWebhookSubscriptionsOK struct {
+	Payload models.WebhookSubscriptionList
+}
+
+// IsSuccess returns true when this v2 list webhook subscriptions o k response has a 2xx status code
+func (o *V2ListWebhookSubscriptionsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list webhook subscriptions o k response has a 3xx status code
+func (o *V2ListWebhookSubscriptionsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list webhook subscriptions o k response has a 4xx status code
+func (o *V2ListWebhookSubscriptionsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list webhook subscriptions o k response has a 5xx status code
+func (o *V2ListWebhookSubscriptionsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list webhook subscriptions o k response a status code equal to that given
+func (o *V2ListWebhookSubscriptionsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListWebhookSubscriptionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsOK) String() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsOK) GetPayload() models.WebhookSubscriptionList {
+	return o.Payload
+}
+
+func (o *V2ListWebhookSubscriptionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListWebhookSubscriptionsUnauthorized creates a V2ListWebhookSubscriptionsUnauthorized with default headers values
+func NewV2ListWebhookSubscriptionsUnauthorized() *V2ListWebhookSubscriptionsUnauthorized {
+	return &V2ListWebhookSubscriptionsUnauthorized{}
+}
+
+/*
+V2ListWebhookSubscriptionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListWebhookSubscriptionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list webhook subscriptions unauthorized response has a 2xx status code
+func (o *V2ListWebhookSubscriptionsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list webhook subscriptions unauthorized response has a 3xx status code
+func (o *V2ListWebhookSubscriptionsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list webhook subscriptions unauthorized response has a 4xx status code
+func (o *V2ListWebhookSubscriptionsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list webhook subscriptions unauthorized response has a 5xx status code
+func (o *V2ListWebhookSubscriptionsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list webhook subscriptions unauthorized response a status code equal to that given
+func (o *V2ListWebhookSubscriptionsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListWebhookSubscriptionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListWebhookSubscriptionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListWebhookSubscriptionsForbidden creates a V2ListWebhookSubscriptionsForbidden with default headers values
+func NewV2ListWebhookSubscriptionsForbidden() *V2ListWebhookSubscriptionsForbidden {
+	return &V2ListWebhookSubscriptionsForbidden{}
+}
+
+/*
+V2ListWebhookSubscriptionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListWebhookSubscriptionsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list webhook subscriptions forbidden response has a 2xx status code
+func (o *V2ListWebhookSubscriptionsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list webhook subscriptions forbidden response has a 3xx status code
+func (o *V2ListWebhookSubscriptionsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list webhook subscriptions forbidden response has a 4xx status code
+func (o *V2ListWebhookSubscriptionsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list webhook subscriptions forbidden response has a 5xx status code
+func (o *V2ListWebhookSubscriptionsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list webhook subscriptions forbidden response a status code equal to that given
+func (o *V2ListWebhookSubscriptionsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListWebhookSubscriptionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListWebhookSubscriptionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListWebhookSubscriptionsInternalServerError creates a V2ListWebhookSubscriptionsInternalServerError with default headers values
+func NewV2ListWebhookSubscriptionsInternalServerError() *V2ListWebhookSubscriptionsInternalServerError {
+	return &V2ListWebhookSubscriptionsInternalServerError{}
+}
+
+/*
+V2ListWebhookSubscriptionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListWebhookSubscriptionsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list webhook subscriptions internal server error response has a 2xx status code
+func (o *V2ListWebhookSubscriptionsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list webhook subscriptions internal server error response has a 3xx status code
+func (o *V2ListWebhookSubscriptionsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list webhook subscriptions internal server error response has a 4xx status code
+func (o *V2ListWebhookSubscriptionsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list webhook subscriptions internal server error response has a 5xx status code
+func (o *V2ListWebhookSubscriptionsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list webhook subscriptions internal server error response a status code equal to that given
+func (o *V2ListWebhookSubscriptionsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListWebhookSubscriptionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/webhook-subscriptions][%d] v2ListWebhookSubscriptionsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListWebhookSubscriptionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListWebhookSubscriptionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterWebhookSubscriptionParams creates a new V2RegisterWebhookSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RegisterWebhookSubscriptionParams() *V2RegisterWebhookSubscriptionParams {
+	return &V2RegisterWebhookSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RegisterWebhookSubscriptionParamsWithTimeout creates a new V2RegisterWebhookSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2RegisterWebhookSubscriptionParamsWithTimeout(timeout time.Duration) *V2RegisterWebhookSubscriptionParams {
+	return &V2RegisterWebhookSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RegisterWebhookSubscriptionParamsWithContext creates a new V2RegisterWebhookSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2RegisterWebhookSubscriptionParamsWithContext(ctx context.Context) *V2RegisterWebhookSubscriptionParams {
+	return &V2RegisterWebhookSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RegisterWebhookSubscriptionParamsWithHTTPClient creates a new V2RegisterWebhookSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RegisterWebhookSubscriptionParamsWithHTTPClient(client *http.Client) *V2RegisterWebhookSubscriptionParams {
+	return &V2RegisterWebhookSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2RegisterWebhookSubscriptionParams contains all the parameters to send to the API endpoint
+
+	for the v2 register webhook subscription operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2RegisterWebhookSubscriptionParams struct {
+
+	/* NewWebhookSubscriptionParams.
+
+	   The properties describing the new webhook subscription.
+	*/
+	NewWebhookSubscriptionParams *models.WebhookSubscriptionCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 register webhook subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterWebhookSubscriptionParams) WithDefaults() *V2RegisterWebhookSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 register webhook subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterWebhookSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) WithTimeout(timeout time.Duration) *V2RegisterWebhookSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) WithContext(ctx context.Context) *V2RegisterWebhookSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) WithHTTPClient(client *http.Client) *V2RegisterWebhookSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewWebhookSubscriptionParams adds the newWebhookSubscriptionParams to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) WithNewWebhookSubscriptionParams(newWebhookSubscriptionParams *models.WebhookSubscriptionCreateParams) *V2RegisterWebhookSubscriptionParams {
+	o.SetNewWebhookSubscriptionParams(newWebhookSubscriptionParams)
+	return o
+}
+
+// SetNewWebhookSubscriptionParams adds the newWebhookSubscriptionParams to the v2 register webhook subscription params
+func (o *V2RegisterWebhookSubscriptionParams) SetNewWebhookSubscriptionParams(newWebhookSubscriptionParams *models.WebhookSubscriptionCreateParams) {
+	o.NewWebhookSubscriptionParams = newWebhookSubscriptionParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RegisterWebhookSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewWebhookSubscriptionParams != nil {
+		if err := r.SetBodyParam(o.NewWebhookSubscriptionParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterWebhookSubscriptionReader is a Reader for the V2RegisterWebhookSubscription structure.
+type V2RegisterWebhookSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RegisterWebhookSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2RegisterWebhookSubscriptionCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RegisterWebhookSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RegisterWebhookSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RegisterWebhookSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RegisterWebhookSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RegisterWebhookSubscriptionCreated creates a V2RegisterWebhookSubscriptionCreated with default headers values
+func NewV2RegisterWebhookSubscriptionCreated() *V2RegisterWebhookSubscriptionCreated {
+	return &V2RegisterWebhookSubscriptionCreated{}
+}
+
+/*
+V2RegisterWebhookSubscriptionCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2RegisterWebhookSubscriptionCreated struct {
+	Payload *models.WebhookSubscription
+}
+
+// IsSuccess returns true when this v2 register webhook subscription created response has a 2xx status code
+func (o *V2RegisterWebhookSubscriptionCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 register webhook subscription created response has a 3xx status code
+func (o *V2RegisterWebhookSubscriptionCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register webhook subscription created response has a 4xx status code
+func (o *V2RegisterWebhookSubscriptionCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 register webhook subscription created response has a 5xx status code
+func (o *V2RegisterWebhookSubscriptionCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register webhook subscription created response a status code equal to that given
+func (o *V2RegisterWebhookSubscriptionCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2RegisterWebhookSubscriptionCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionCreated) String() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionCreated) GetPayload() *models.WebhookSubscription {
+	return o.Payload
+}
+
+func (o *V2RegisterWebhookSubscriptionCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.WebhookSubscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterWebhookSubscriptionBadRequest creates a V2RegisterWebhookSubscriptionBadRequest with default headers values
+func NewV2RegisterWebhookSubscriptionBadRequest() *V2RegisterWebhookSubscriptionBadRequest {
+	return &V2RegisterWebhookSubscriptionBadRequest{}
+}
+
+/*
+V2RegisterWebhookSubscriptionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RegisterWebhookSubscriptionBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register webhook subscription bad request response has a 2xx status code
+func (o *V2RegisterWebhookSubscriptionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register webhook subscription bad request response has a 3xx status code
+func (o *V2RegisterWebhookSubscriptionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register webhook subscription bad request response has a 4xx status code
+func (o *V2RegisterWebhookSubscriptionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register webhook subscription bad request response has a 5xx status code
+func (o *V2RegisterWebhookSubscriptionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register webhook subscription bad request response a status code equal to that given
+func (o *V2RegisterWebhookSubscriptionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2RegisterWebhookSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterWebhookSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterWebhookSubscriptionUnauthorized creates a V2RegisterWebhookSubscriptionUnauthorized with default headers values
+func NewV2RegisterWebhookSubscriptionUnauthorized() *V2RegisterWebhookSubscriptionUnauthorized {
+	return &V2RegisterWebhookSubscriptionUnauthorized{}
+}
+
+/*
+V2RegisterWebhookSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RegisterWebhookSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 register webhook subscription unauthorized response has a 2xx status code
+func (o *V2RegisterWebhookSubscriptionUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register webhook subscription unauthorized response has a 3xx status code
+func (o *V2RegisterWebhookSubscriptionUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register webhook subscription unauthorized response has a 4xx status code
+func (o *V2RegisterWebhookSubscriptionUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register webhook subscription unauthorized response has a 5xx status code
+func (o *V2RegisterWebhookSubscriptionUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register webhook subscription unauthorized response a status code equal to that given
+func (o *V2RegisterWebhookSubscriptionUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2RegisterWebhookSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterWebhookSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterWebhookSubscriptionForbidden creates a V2RegisterWebhookSubscriptionForbidden with default headers values
+func NewV2RegisterWebhookSubscriptionForbidden() *V2RegisterWebhookSubscriptionForbidden {
+	return &V2RegisterWebhookSubscriptionForbidden{}
+}
+
+/*
+V2RegisterWebhookSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RegisterWebhookSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 register webhook subscription forbidden response has a 2xx status code
+func (o *V2RegisterWebhookSubscriptionForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register webhook subscription forbidden response has a 3xx status code
+func (o *V2RegisterWebhookSubscriptionForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register webhook subscription forbidden response has a 4xx status code
+func (o *V2RegisterWebhookSubscriptionForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 register webhook subscription forbidden response has a 5xx status code
+func (o *V2RegisterWebhookSubscriptionForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 register webhook subscription forbidden response a status code equal to that given
+func (o *V2RegisterWebhookSubscriptionForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2RegisterWebhookSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterWebhookSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterWebhookSubscriptionInternalServerError creates a V2RegisterWebhookSubscriptionInternalServerError with default headers values
+func NewV2RegisterWebhookSubscriptionInternalServerError() *V2RegisterWebhookSubscriptionInternalServerError {
+	return &V2RegisterWebhookSubscriptionInternalServerError{}
+}
+
+/*
+V2RegisterWebhookSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RegisterWebhookSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 register webhook subscription internal server error response has a 2xx status code
+func (o *V2RegisterWebhookSubscriptionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 register webhook subscription internal server error response has a 3xx status code
+func (o *V2RegisterWebhookSubscriptionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 register webhook subscription internal server error response has a 4xx status code
+func (o *V2RegisterWebhookSubscriptionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 register webhook subscription internal server error response has a 5xx status code
+func (o *V2RegisterWebhookSubscriptionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 register webhook subscription internal server error response a status code equal to that given
+func (o *V2RegisterWebhookSubscriptionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2RegisterWebhookSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/webhook-subscriptions][%d] v2RegisterWebhookSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2RegisterWebhookSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterWebhookSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the webhooks client
+type API interface {
+	/*
+	   V2DeregisterWebhookSubscription Deletes a webhook subscription together with its pending deliveries.*/
+	V2DeregisterWebhookSubscription(ctx context.Context, params *V2DeregisterWebhookSubscriptionParams) (*V2DeregisterWebhookSubscriptionNoContent, error)
+	/*
+	   V2ListWebhookSubscriptions Lists the webhook subscriptions of the tenant.*/
+	V2ListWebhookSubscriptions(ctx context.Context, params *V2ListWebhookSubscriptionsParams) (*V2ListWebhookSubscriptionsOK, error)
+	/*
+	   V2RegisterWebhookSubscription Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant.*/
+	V2RegisterWebhookSubscription(ctx context.Context, params *V2RegisterWebhookSubscriptionParams) (*V2RegisterWebhookSubscriptionCreated, error)
+}
+
+// New creates a new webhooks API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for webhooks API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeregisterWebhookSubscription Deletes a webhook subscription together with its pending deliveries.
+*/
+func (a *Client) V2DeregisterWebhookSubscription(ctx context.Context, params *V2DeregisterWebhookSubscriptionParams) (*V2DeregisterWebhookSubscriptionNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterWebhookSubscription",
+		Method:             "DELETE",
+		PathPattern:        "/v2/webhook-subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterWebhookSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterWebhookSubscriptionNoContent), nil
+
+}
+
+/*
+V2ListWebhookSubscriptions Lists the webhook subscriptions of the tenant.
+*/
+func (a *Client) V2ListWebhookSubscriptions(ctx context.Context, params *V2ListWebhookSubscriptionsParams) (*V2ListWebhookSubscriptionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListWebhookSubscriptions",
+		Method:             "GET",
+		PathPattern:        "/v2/webhook-subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListWebhookSubscriptionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListWebhookSubscriptionsOK), nil
+
+}
+
+/*
+V2RegisterWebhookSubscription Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant.
+*/
+func (a *Client) V2RegisterWebhookSubscription(ctx context.Context, params *V2RegisterWebhookSubscriptionParams) (*V2RegisterWebhookSubscriptionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterWebhookSubscription",
+		Method:             "POST",
+		PathPattern:        "/v2/webhook-subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterWebhookSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterWebhookSubscriptionCreated), nil
+
+}
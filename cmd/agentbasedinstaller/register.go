@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 
+	"github.com/go-openapi/swag"
 	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/client"
@@ -107,8 +108,9 @@ func RegisterCluster(ctx context.Context, log *log.Logger, bmInventory *client.A
 	if installConfigOverrides, ok := annotations[controllers.InstallConfigOverrides]; ok {
 		var reJsonField = regexp.MustCompile(`(?i)"([^"]*(password)[^"]*)":\s*"(\\{2}|\\"|[^"])*"`)
 		updateInstallConfigParams := &installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           *clusterResult.Payload.ID,
-			InstallConfigParams: installConfigOverrides,
+			ClusterID:               *clusterResult.Payload.ID,
+			InstallConfigParams:     installConfigOverrides,
+			AllowServiceOwnedFields: swag.Bool(annotations[controllers.InstallConfigOverridesAllowServiceOwnedFields] == "true"),
 		}
 		_, updateClusterErr := bmInventory.Installer.V2UpdateClusterInstallConfig(ctx, updateInstallConfigParams)
 		if updateClusterErr != nil {
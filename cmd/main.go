@@ -9,6 +9,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -20,29 +21,39 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	metal3_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-image-service/pkg/servers"
+	"github.com/openshift/assisted-service/internal/bmc"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/internal/decommission"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
+	"github.com/openshift/assisted-service/internal/dryrun"
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardwareinventory"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
+	"github.com/openshift/assisted-service/internal/hostclaims"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/installercache"
 	internaljson "github.com/openshift/assisted-service/internal/json"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/migrations"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/networkreport"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
@@ -50,10 +61,15 @@ import (
 	"github.com/openshift/assisted-service/internal/releasesources"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/internal/storagetenant"
 	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/internal/timeline"
+	"github.com/openshift/assisted-service/internal/triage"
 	"github.com/openshift/assisted-service/internal/uploader"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/internal/watch"
+	"github.com/openshift/assisted-service/internal/webhooks"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/app"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -68,6 +84,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/shard"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/thread"
 	"github.com/openshift/assisted-service/restapi"
@@ -102,6 +119,8 @@ const (
 	deployment_type_ocp    = "ocp"
 	storage_filesystem     = "filesystem"
 	storage_s3             = "s3"
+	storage_azure          = "azure"
+	storage_gcs            = "gcs"
 	hostFSMountDir         = "/host"
 )
 
@@ -113,6 +132,8 @@ var Options struct {
 	DBConfig                             dbPkg.Config
 	HWValidatorConfig                    hardware.ValidatorCfg
 	GeneratorConfig                      generator.Config
+	InstallerCachePrefetchConfig         installercache.PrefetchConfig
+	InstallerCachePrefetchInterval       time.Duration `envconfig:"INSTALLER_CACHE_PREFETCH_INTERVAL" default:"1h"`
 	InstructionConfig                    hostcommands.InstructionConfig
 	OperatorsConfig                      operators.Options
 	GCConfig                             garbagecollector.Config
@@ -121,6 +142,10 @@ var Options struct {
 	ClusterStateMonitorInterval          time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	ClusterEventsUploaderInterval        time.Duration `envconfig:"CLUSTER_EVENTS_UPLOADER_INTERVAL" default:"15m"`
 	S3Config                             s3wrapper.Config
+	AzureStorageConfig                   s3wrapper.AzureConfig
+	GCSConfig                            s3wrapper.GCSConfig
+	StorageEncryptionConfig              s3wrapper.EncryptionConfig
+	StorageDedupConfig                   s3wrapper.DedupConfig
 	HostStateMonitorInterval             time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
 	Versions                             versions.Versions
 	OsImages                             string        `envconfig:"OS_IMAGES" default:""`
@@ -136,6 +161,10 @@ var Options struct {
 	HostConfig                           host.Config
 	LogConfig                            logconfig.Config
 	LeaderConfig                         leader.Config
+	MonitorShardConfig                   shard.Config
+	DecommissionConfig                   decommission.Config
+	LogSearchConfig                      logsearch.Config
+	TriageConfig                         triage.Config
 	ValidationsConfig                    validations.Config
 	ManifestsGeneratorConfig             network.Config
 	UploaderConfig                       uploader.Config
@@ -145,6 +174,8 @@ var Options struct {
 	DeletionWorkerInterval               time.Duration `envconfig:"DELETION_WORKER_INTERVAL" default:"1h"`
 	InfraEnvDeletionWorkerInterval       time.Duration `envconfig:"INFRAENV_DELETION_WORKER_INTERVAL" default:"1h"`
 	DeregisterWorkerInterval             time.Duration `envconfig:"DEREGISTER_WORKER_INTERVAL" default:"1h"`
+	LogIndexDeletionWorkerInterval       time.Duration `envconfig:"LOG_INDEX_DELETION_WORKER_INTERVAL" default:"1h"`
+	DedupPruneWorkerInterval             time.Duration `envconfig:"DEDUP_PRUNE_WORKER_INTERVAL" default:"1h"`
 	EnableDeletedUnregisteredGC          bool          `envconfig:"ENABLE_DELETE_UNREGISTER_GC" default:"true"`
 	EnableDeregisterInactiveGC           bool          `envconfig:"ENABLE_DEREGISTER_INACTIVE_GC" default:"true"`
 	ServeHTTPS                           bool          `envconfig:"SERVE_HTTPS" default:"false"`
@@ -155,13 +186,22 @@ var Options struct {
 	ConnMaxLifetime                      time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
 	FileSystemUsageThreshold             int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableNotificationStreaming          bool          `envconfig:"ENABLE_EVENT_STREAMING" default:"false"`
+	EnableWebhookNotifications           bool          `envconfig:"ENABLE_WEBHOOK_NOTIFICATIONS" default:"false"`
+	EnableResourceWatch                  bool          `envconfig:"ENABLE_RESOURCE_WATCH" default:"false"`
 	WorkDir                              string        `envconfig:"WORK_DIR" default:"/data/"`
+	DNSZoneFilesConfig                   dns.ZoneFilesConfig
 	LivenessValidationTimeout            time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	ApproveCsrsRequeueDuration           time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
+	CSRApprovalPolicy                    string        `envconfig:"CSR_APPROVAL_POLICY" default:""`
 	HTTPListenPort                       string        `envconfig:"HTTP_LISTEN_PORT" default:""`
 	AllowConvergedFlow                   bool          `envconfig:"ALLOW_CONVERGED_FLOW" default:"true"`
 	PreprovisioningImageControllerConfig controllers.PreprovisioningImageControllerConfig
 	BMACConfig                           controllers.BMACConfig
+	WebhooksConfig                       webhooks.Config
+	BmcConfig                            bmc.Config
+	HostClaimsConfig                     hostclaims.Config
+	WatchConfig                          watch.Config
+	DryRunConfig                         dryrun.Config
 
 	// Directory containing pre-generated TLS certs/keys for the ephemeral installer
 	ClusterTLSCertOverrideDir string `envconfig:"EPHEMERAL_INSTALLER_CLUSTER_TLS_CERTS_OVERRIDE_DIR" default:""`
@@ -263,7 +303,11 @@ func main() {
 	ctrlMgr, err := createControllerManager()
 	failOnError(err, "failed to create controller manager")
 
-	notificationStream := getNotificationStream(log)
+	var watchWriter *watch.Writer
+	if Options.EnableResourceWatch {
+		watchWriter = watch.NewWriter(db)
+	}
+	notificationStream := getNotificationStream(log, db, watchWriter)
 	defer notificationStream.Close()
 
 	usageManager := usage.NewManager(log, notificationStream)
@@ -298,6 +342,7 @@ func main() {
 		maxDuration(Options.InstructionConfig.DiskCheckTimeout, Options.InstructionConfig.ImageAvailabilityTimeout)+1*time.Minute)
 	var lead leader.ElectorInterface
 	var k8sClient *kubernetes.Clientset
+	var monitorMembership *shard.Membership
 	var startupLeader leader.ElectorInterface
 
 	mirrorRegistriesBuilder := mirrorregistries.New()
@@ -325,8 +370,11 @@ func main() {
 	installConfigBuilder := installcfg.NewInstallConfigBuilder(log.WithField("pkg", "installcfg"), mirrorRegistriesBuilder, providerRegistry)
 
 	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config,
-		Options.WorkDir, log, metricsManager, Options.FileSystemUsageThreshold)
+		&Options.AzureStorageConfig, &Options.GCSConfig, Options.WorkDir, log, metricsManager, Options.FileSystemUsageThreshold)
 	createS3Bucket(objectHandler, log)
+	objectHandler, err = s3wrapper.NewWrappedClient(objectHandler, &Options.StorageEncryptionConfig, &Options.StorageDedupConfig,
+		storagetenant.NewResolver(db), log.WithField("pkg", "s3wrapper"))
+	failOnError(err, "failed to create the storage encryption and deduplication")
 
 	manifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "manifests"), objectHandler, usageManager)
 	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, objectHandler, extracterHandler)
@@ -369,6 +417,14 @@ func main() {
 
 		failOnError(lead.StartLeaderElection(context.Background()), "Failed to start leader")
 
+		if Options.MonitorShardConfig.Enabled {
+			monitorMembership = shard.NewMembership(k8sClient, Options.MonitorShardConfig, "assisted-service-monitor", metricsManager,
+				log.WithField("pkg", "monitor-shard"))
+			failOnError(monitorMembership.Start(context.Background()), "Failed to join the monitor shard group")
+			Options.ClusterConfig.MonitorSharder = monitorMembership
+			Options.HostConfig.MonitorSharder = monitorMembership
+		}
+
 		ocpClient, err = k8sclient.NewK8SClient("", log)
 		failOnError(err, "Failed to create client for OCP")
 
@@ -398,13 +454,32 @@ func main() {
 	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, notificationStream, eventsHandler, hwValidator,
 		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead, operatorsManager, providerRegistry, Options.EnableKubeAPI, objectHandler, versionHandler,
 		Options.EnableSoftTimeouts)
-	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, log)
+	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, Options.DNSZoneFilesConfig, db, log)
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi, Options.ManifestsGeneratorConfig, db)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
 		notificationStream, eventsHandler, uploadClient, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager,
 		ocmClient, objectHandler, dnsApi, authHandler, manifestsApi, Options.EnableSoftTimeouts)
 	infraEnvApi := infraenv.NewManager(log.WithField("pkg", "host-state"), db, objectHandler)
 
+	// The secrets of the webhook subscriptions are encrypted with the keys of the BMC passwords
+	webhookCredentials, err := credentials.LoadCipher(Options.BmcConfig.CredentialsKeysFile)
+	failOnError(err, "Failed to load the credentials keys")
+	if Options.EnableWebhookNotifications {
+		webhookDispatcher := webhooks.NewDispatcher(log.WithField("pkg", "webhooks"), db, Options.WebhooksConfig, webhookCredentials)
+		webhookDispatcherThread := thread.New(
+			log.WithField("pkg", "webhook-dispatcher"), "Webhook Dispatcher", Options.WebhooksConfig.DispatchInterval, webhookDispatcher.DeliverPending)
+		webhookDispatcherThread.Start()
+		defer webhookDispatcherThread.Stop()
+	}
+
+	watchHandler := watch.NewHandler(log.WithField("pkg", "watch"), db, Options.WatchConfig, watchWriter)
+	if Options.EnableResourceWatch {
+		watchCleaner := thread.New(
+			log.WithField("pkg", "watch-cleaner"), "Resource Changes Cleaner", Options.WatchConfig.CleanupInterval, watchHandler.DeleteExpiredChanges)
+		watchCleaner.Start()
+		defer watchCleaner.Stop()
+	}
+
 	clusterEventsUploader := thread.New(
 		log.WithField("pkg", "cluster-events-uploader"), "Cluster Events Uploader", Options.ClusterEventsUploaderInterval, clusterApi.UploadEvents)
 	clusterEventsUploader.Start()
@@ -435,7 +510,31 @@ func main() {
 	failOnError(err, "failed to create valid bm config S3 endpoint URL from %s", Options.BMConfig.S3EndpointURL)
 	Options.BMConfig.S3EndpointURL = newUrl
 
-	generator := generator.New(log, objectHandler, Options.GeneratorConfig, Options.WorkDir, operatorsManager, providerRegistry, Options.ClusterTLSCertOverrideDir, authHandler)
+	// Dry runs store the files they generate apart from the ones of the clusters
+	dryRunObjectHandler := s3wrapper.NewOverlayClient(objectHandler, dryrun.StoragePrefix)
+	dryRunManifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "dry-run-manifests"), dryRunObjectHandler, usageManager)
+	dryRunOperatorsManager := operatorsManager.WithStorage(dryRunManifestsApi, dryRunObjectHandler)
+	dryRunRHRootCA := ""
+	if Options.BMConfig.InstallRHCa {
+		dryRunRHRootCA = ignition.RedhatRootCA
+	}
+	generator := generator.New(log, objectHandler, Options.GeneratorConfig, Options.WorkDir, operatorsManager, providerRegistry, Options.ClusterTLSCertOverrideDir, authHandler, metricsManager)
+	dryRunHandler := dryrun.NewHandler(log.WithField("pkg", "dry-run"), db, Options.DryRunConfig, dryRunObjectHandler, dryRunManifestsApi,
+		network.NewManifestsGenerator(dryRunManifestsApi, Options.ManifestsGeneratorConfig, db), dryRunOperatorsManager,
+		installConfigBuilder, versionHandler, generator.WithStorage(dryRunObjectHandler, filepath.Join(Options.WorkDir, "dry-run")), dryRunRHRootCA)
+
+	if Options.InstallerCachePrefetchConfig.Enabled && !Options.GeneratorConfig.DummyIgnition {
+		prefetcher := installercache.NewPrefetcher(Options.InstallerCachePrefetchConfig, log.WithField("pkg", "installercache"), db,
+			generator.InstallerCache(), releaseHandler, Options.GeneratorConfig.ReleaseImageMirror, lead)
+		installerCachePrefetchWorker := thread.New(
+			log.WithField("installercache", "Prefetch Worker"),
+			"Installer Cache Prefetch Worker",
+			Options.InstallerCachePrefetchInterval,
+			prefetcher.Prefetch)
+
+		installerCachePrefetchWorker.Start()
+		defer installerCachePrefetchWorker.Stop()
+	}
 	var crdUtils bminventory.CRDUtils
 	if ctrlMgr != nil {
 		crdUtils = controllers.NewCRDUtils(ctrlMgr.GetClient(), hostApi)
@@ -443,9 +542,20 @@ func main() {
 		crdUtils = controllers.NewDummyCRDUtils()
 	}
 
+	logSearchApi, err := logsearch.NewManager(Options.LogSearchConfig, log.WithField("pkg", "log-search"), db, objectHandler)
+	failOnError(err, "failed to create the log search manager")
+	if logSearchApi.Enabled() {
+		logIndexWorker := thread.New(
+			log.WithField("pkg", "log-search"), "Log Index Worker", Options.LogSearchConfig.IndexInterval, logSearchApi.IndexPending)
+		logIndexWorker.Start()
+		defer logIndexWorker.Stop()
+	}
+	triageApi, err := triage.NewManager(Options.TriageConfig, log.WithField("pkg", "triage"), db, eventsHandler, objectHandler)
+	failOnError(err, "failed to create the log triage manager")
+
 	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
 		gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"),
-			hostApi, clusterApi, infraEnvApi, objectHandler, lead)
+			hostApi, clusterApi, infraEnvApi, objectHandler, lead, logSearchApi)
 
 		// In operator-deployment, ClusterDeployment is responsible for managing the lifetime of the cluster resource.
 		if !Options.EnableKubeAPI && Options.EnableDeregisterInactiveGC {
@@ -468,6 +578,26 @@ func main() {
 
 			deletionWorker.Start()
 			defer deletionWorker.Stop()
+
+			logIndexDeletionWorker := thread.New(
+				log.WithField("garbagecollector", "Log Index Deletion Worker"),
+				"Log Index Deletion Worker",
+				Options.LogIndexDeletionWorkerInterval,
+				gc.DeleteExpiredLogIndexes)
+
+			logIndexDeletionWorker.Start()
+			defer logIndexDeletionWorker.Stop()
+
+			if Options.StorageDedupConfig.Enabled {
+				dedupPruneWorker := thread.New(
+					log.WithField("garbagecollector", "Dedup Prune Worker"),
+					"Dedup Prune Worker",
+					Options.DedupPruneWorkerInterval,
+					gc.PruneDeduplicatedObjects)
+
+				dedupPruneWorker.Start()
+				defer dedupPruneWorker.Stop()
+			}
 		}
 
 		//In operator-deployment, InfraEnv CR is responsible for managing the lifetime of the InfraEnv resource.
@@ -488,10 +618,23 @@ func main() {
 	serverInfo := servers.New(Options.HTTPListenPort, swag.StringValue(port), Options.HTTPSKeyFile, Options.HTTPSCertFile)
 	generateInsecureIPXEURLs := serverInfo.HTTP != nil
 
+	decommissionApi := decommission.NewManager(Options.DecommissionConfig, log.WithField("pkg", "decommission"), db, eventsHandler,
+		objectHandler, spoke_k8s_client.NewSpokeK8sClientFactory(log), &controllers.KubectlDrainer{}, lead)
+	decommissionMonitor := thread.New(
+		log.WithField("pkg", "decommission-monitor"), "Decommission Monitor", Options.DecommissionConfig.MonitorInterval, decommissionApi.Monitor)
+	decommissionMonitor.Start()
+	defer decommissionMonitor.Stop()
+
 	bm := bminventory.NewBareMetalInventory(db, notificationStream, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, osImages, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
-		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs)
+		Options.GCConfig, providerRegistry, decommissionApi, logSearchApi, triageApi, generateInsecureIPXEURLs)
+
+	hostClaimsScheduler := thread.New(
+		log.WithField("pkg", "host-claims-scheduler"), "Host Claims Scheduler", Options.HostClaimsConfig.SchedulingInterval,
+		hostclaims.NewScheduler(log.WithField("pkg", "host-claims"), db, eventsHandler, bm, lead).Schedule)
+	hostClaimsScheduler.Start()
+	defer hostClaimsScheduler.Stop()
 
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
@@ -511,23 +654,41 @@ func main() {
 	}
 
 	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+	webhooksHandler := webhooks.NewHandler(log.WithField("pkg", "webhooks"), db, authzHandler, Options.EnableWebhookNotifications,
+		Options.WebhooksConfig, webhookCredentials)
+	failOnError(webhooksHandler.EncryptPlaintextSecrets(context.Background()), "Failed to encrypt the secrets of the webhook subscriptions")
+	clusterTemplatesHandler := clustertemplates.NewHandler(log.WithField("pkg", "cluster-templates"), db, authzHandler, bm, manifestsApi)
+	bmcHandler, err := bmc.NewHandler(log.WithField("pkg", "bmc"), db, Options.BmcConfig)
+	failOnError(err, "Failed to create the BMC hosts handler")
+	failOnError(bmcHandler.EncryptPlaintextPasswords(context.Background()), "Failed to encrypt the passwords of the BMC hosts")
 	h, err := restapi.Handler(restapi.Config{
-		AuthAgentAuth:       authHandler.AuthAgentAuth,
-		AuthUserAuth:        authHandler.AuthUserAuth,
-		AuthURLAuth:         authHandler.AuthURLAuth,
-		AuthImageAuth:       authHandler.AuthImageAuth,
-		AuthImageURLAuth:    authHandler.AuthImageAuth,
-		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
-		Authorizer:          authzHandler.CreateAuthorizer(),
-		InstallerAPI:        bm,
-		EventsAPI:           events,
-		Logger:              log.Printf,
-		VersionsAPI:         versionsAPIHandler,
-		ManagedDomainsAPI:   domainHandler,
-		InnerMiddleware:     innerHandler(),
-		ManifestsAPI:        manifestsApi,
-		OperatorsAPI:        operatorsHandler,
-		JSONConsumer:        jsonConsumer,
+		AuthAgentAuth:           authHandler.AuthAgentAuth,
+		AuthUserAuth:            authHandler.AuthUserAuth,
+		AuthURLAuth:             authHandler.AuthURLAuth,
+		AuthImageAuth:           authHandler.AuthImageAuth,
+		AuthImageURLAuth:        authHandler.AuthImageAuth,
+		APIKeyAuthenticator:     authHandler.CreateAuthenticator(),
+		Authorizer:              authzHandler.CreateAuthorizer(),
+		InstallerAPI:            bm,
+		EventsAPI:               events,
+		Logger:                  log.Printf,
+		VersionsAPI:             versionsAPIHandler,
+		ManagedDomainsAPI:       domainHandler,
+		InnerMiddleware:         innerHandler(),
+		ManifestsAPI:            manifestsApi,
+		OperatorsAPI:            operatorsHandler,
+		WebhooksAPI:             webhooksHandler,
+		WatchAPI:                watchHandler,
+		DryRunAPI:               dryRunHandler,
+		ClusterTemplatesAPI:     clusterTemplatesHandler,
+		NetworkReportAPI:        networkreport.NewHandler(log.WithField("pkg", "network-report"), db, hwValidator),
+		InstallationTimelineAPI: timeline.NewHandler(log.WithField("pkg", "installation-timeline"), db, &Options.HostConfig),
+		LogSearchAPI:            logsearch.NewHandler(log.WithField("pkg", "log-search"), db, logSearchApi),
+		TriageAPI:               triage.NewHandler(log.WithField("pkg", "triage"), db),
+		BmcAPI:                  bmcHandler,
+		HardwareInventoryAPI:    hardwareinventory.NewHandler(log.WithField("pkg", "hardware-inventory"), db, authzHandler),
+		HostClaimsAPI:           hostclaims.NewHandler(log.WithField("pkg", "host-claims"), db, authzHandler),
+		JSONConsumer:            jsonConsumer,
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -596,6 +757,9 @@ func main() {
 				MirrorRegistriesConfigBuilder: mirrorregistries.New(),
 			}).SetupWithManager(ctrlMgr), "unable to create controller ClusterDeployment")
 
+			csrApprovalPolicy, err := controllers.ParseCSRApprovalPolicy(Options.CSRApprovalPolicy)
+			failOnError(err, "failed to parse the CSR approval policy")
+
 			failOnError((&controllers.AgentReconciler{
 				Client:                     ctrlMgr.GetClient(),
 				APIReader:                  ctrlMgr.GetAPIReader(),
@@ -610,6 +774,7 @@ func main() {
 				AgentContainerImage:        Options.BMConfig.AgentDockerImg,
 				HostFSMountDir:             hostFSMountDir,
 				EventSender:                eventsHandler,
+				CSRApprovalPolicy:          csrApprovalPolicy,
 			}).SetupWithManager(ctrlMgr), "unable to create controller Agent")
 
 			failOnError((&controllers.BMACReconciler{
@@ -673,6 +838,9 @@ func main() {
 	serverInfo.ListenAndServe()
 	<-stop
 	serverInfo.Shutdown()
+	if monitorMembership != nil {
+		monitorMembership.Leave()
+	}
 }
 
 func setupDB(log logrus.FieldLogger) *gorm.DB {
@@ -732,8 +900,8 @@ func createS3Bucket(objectHandler s3wrapper.API, log logrus.FieldLogger) {
 	}
 }
 
-func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, fsWorkDir string,
-	log logrus.FieldLogger, metricsAPI metrics.API, fsThreshold int) s3wrapper.API {
+func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, azureCfg *s3wrapper.AzureConfig,
+	gcsCfg *s3wrapper.GCSConfig, fsWorkDir string, log logrus.FieldLogger, metricsAPI metrics.API, fsThreshold int) s3wrapper.API {
 	var storageClient s3wrapper.API = nil
 	if storage != "" {
 		switch storage {
@@ -741,6 +909,18 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 			if storageClient = s3wrapper.NewS3Client(s3cfg, log); storageClient == nil { //nolint:staticcheck
 				log.Fatal("failed to create S3 client")
 			}
+		case storage_azure:
+			azureClient, err := s3wrapper.NewAzureClient(azureCfg, log)
+			if err != nil {
+				log.WithError(err).Fatal("failed to create Azure Blob Storage client")
+			}
+			storageClient = azureClient
+		case storage_gcs:
+			gcsClient, err := s3wrapper.NewGCSClient(gcsCfg, log)
+			if err != nil {
+				log.WithError(err).Fatal("failed to create Google Cloud Storage client")
+			}
+			storageClient = gcsClient
 		case storage_filesystem:
 			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, metricsAPI, fsThreshold)
 		default:
@@ -890,7 +1070,7 @@ func createVersionHandlers(
 	return versionsHandler, versionsAPIHandler, nil
 }
 
-func getNotificationStream(log *logrus.Logger) *stream.NotificationStream {
+func getNotificationStream(log *logrus.Logger, db *gorm.DB, watchWriter *watch.Writer) *stream.NotificationStream {
 	metadata := map[string]interface{}{
 		"versions": versions.GetListVersionsFromVersions(Options.Versions),
 	}
@@ -898,6 +1078,18 @@ func getNotificationStream(log *logrus.Logger) *stream.NotificationStream {
 	if err != nil {
 		log.WithError(err).Fatal("kafka writer failed to initialize")
 	}
+	writers := []stream.StreamWriter{writer}
+	if Options.EnableWebhookNotifications {
+		log.Info("Initializing event stream webhook writer")
+		writers = append(writers, webhooks.NewWriter(log.WithField("pkg", "webhooks"), db, Options.Auth.EnableOrgTenancy))
+	}
+	if watchWriter != nil {
+		log.Info("Initializing event stream watch writer")
+		writers = append(writers, watchWriter)
+	}
+	if len(writers) > 1 {
+		writer = stream.NewMultiWriter(writers...)
+	}
 	return stream.NewNotificationStream(writer, log, metadata)
 }
 
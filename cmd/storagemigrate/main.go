@@ -0,0 +1,98 @@
+/*
+See docs/dev/object-storage.md for details on how this tool is used.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/storagetenant"
+	dbPkg "github.com/openshift/assisted-service/pkg/db"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var Options struct {
+	Storage                  string `envconfig:"STORAGE" default:"s3"`
+	WorkDir                  string `envconfig:"WORK_DIR" default:"/data/"`
+	FileSystemUsageThreshold int    `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
+	S3Config                 s3wrapper.Config
+	AzureStorageConfig       s3wrapper.AzureConfig
+	GCSConfig                s3wrapper.GCSConfig
+	StorageEncryptionConfig  s3wrapper.EncryptionConfig
+	StorageDedupConfig       s3wrapper.DedupConfig
+	// The database gives the organizations owning the objects
+	DBConfig dbPkg.Config
+}
+
+func main() {
+	prefix := flag.String("prefix", "", "Migrate only the objects whose name starts with this prefix")
+	dryRun := flag.Bool("dry-run", false, "Report the objects to migrate without rewriting them")
+	flag.Parse()
+
+	log := logrus.New()
+	if err := envconfig.Process("", &Options); err != nil {
+		log.WithError(err).Fatal("failed to process the configuration")
+	}
+
+	var base s3wrapper.API
+	switch Options.Storage {
+	case "s3":
+		if base = s3wrapper.NewS3Client(&Options.S3Config, log); base == nil {
+			log.Fatal("failed to create S3 client")
+		}
+	case "azure":
+		azureClient, err := s3wrapper.NewAzureClient(&Options.AzureStorageConfig, log)
+		if err != nil {
+			log.WithError(err).Fatal("failed to create Azure Blob Storage client")
+		}
+		base = azureClient
+	case "gcs":
+		gcsClient, err := s3wrapper.NewGCSClient(&Options.GCSConfig, log)
+		if err != nil {
+			log.WithError(err).Fatal("failed to create Google Cloud Storage client")
+		}
+		base = gcsClient
+	case "filesystem":
+		metricsManager := metrics.NewMetricsManager(prometheus.NewRegistry(), nil)
+		base = s3wrapper.NewFSClient(Options.WorkDir, log, metricsManager, Options.FileSystemUsageThreshold)
+	default:
+		log.Fatalf("unsupported storage client: %s", Options.Storage)
+	}
+
+	dbConnectionStr := fmt.Sprintf("host=%s port=%s user=%s database=%s password=%s sslmode=disable",
+		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass)
+	db, err := gorm.Open(postgres.Open(dbConnectionStr), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		log.WithError(err).Fatal("failed to connect to the database")
+	}
+	defer common.CloseDB(db)
+
+	client, err := s3wrapper.NewWrappedClient(base, &Options.StorageEncryptionConfig, &Options.StorageDedupConfig,
+		storagetenant.NewResolver(db), log)
+	if err != nil {
+		log.WithError(err).Fatal("failed to create the storage encryption and deduplication")
+	}
+	if !Options.StorageEncryptionConfig.Enabled && !Options.StorageDedupConfig.Enabled {
+		log.Warn("Neither the storage encryption nor the deduplication is enabled, there is nothing to migrate")
+	}
+
+	result, err := s3wrapper.MigrateObjects(context.Background(), client, *prefix, *dryRun, log)
+	if err != nil {
+		log.WithError(err).Fatal("failed to migrate the objects")
+	}
+	log.Infof("Checked %d objects, migrated %d, failed %d", result.Checked, result.Migrated, result.Failed)
+	if result.Failed > 0 {
+		log.Fatalf("failed to migrate %d objects", result.Failed)
+	}
+}
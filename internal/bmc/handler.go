@@ -0,0 +1,500 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/netguard"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/bmc"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	ActionPowerPrefix       = "power-"
+	ActionBootIso           = "boot-iso"
+	ActionEjectVirtualMedia = "eject-virtual-media"
+	emptyUUID               = strfmt.UUID("00000000-0000-0000-0000-000000000000")
+)
+
+type Config struct {
+	RequestTimeout time.Duration `envconfig:"BMC_REQUEST_TIMEOUT" default:"30s"`
+	// The networks that the BMCs can't be on, in addition to the loopback, link-local and multicast ones, e.g. the
+	// service and cluster networks of the cluster running the service
+	RestrictedNetworks string `envconfig:"BMC_RESTRICTED_NETWORKS" default:"172.30.0.0/16,10.128.0.0/14"`
+	// Allows sending the credentials to the BMCs over http, e.g. to sushy-tools in development environments
+	AllowInsecureTransport bool `envconfig:"BMC_ALLOW_INSECURE_TRANSPORT" default:"false"`
+	// The keys encrypting the passwords of the BMC hosts and the secrets of the webhook subscriptions, in the format
+	// of the storage encryption keys file. The BMC hosts and the webhook subscriptions are disabled without them
+	CredentialsKeysFile string `envconfig:"BMC_CREDENTIALS_KEYS_FILE" default:""`
+}
+
+// powerActionResetTypes maps the power actions of the API to the Redfish reset types
+var powerActionResetTypes = map[string]string{
+	models.BmcPowerActionParamsActionOn:           ResetTypeOn,
+	models.BmcPowerActionParamsActionOff:          ResetTypeGracefulOff,
+	models.BmcPowerActionParamsActionForceOff:     ResetTypeForceOff,
+	models.BmcPowerActionParamsActionRestart:      ResetTypeGracefulRestart,
+	models.BmcPowerActionParamsActionForceRestart: ResetTypeForceRestart,
+}
+
+var _ restapi.BmcAPI = (*Handler)(nil)
+
+// NewHandler returns BMC hosts handler
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, cfg Config) (*Handler, error) {
+	networks, err := netguard.ParseCIDRs(cfg.RestrictedNetworks)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid BMC restricted networks")
+	}
+	// The BMCs are on the networks of the hosts, which are usually private
+	policy := netguard.Policy{AllowPrivate: true, Networks: networks}
+	h := &Handler{
+		log: log,
+		db:  db,
+		cfg: cfg,
+		validateHost: func(ctx context.Context, host string) error {
+			return policy.ValidateHost(ctx, netguard.LookupIP, host)
+		},
+		newClient: NewRedfishClientFunc(ClientOptions{
+			RequestTimeout:         cfg.RequestTimeout,
+			AllowInsecureTransport: cfg.AllowInsecureTransport,
+			Control:                policy.Control,
+		}),
+	}
+	if h.credentials, err = credentials.LoadCipher(cfg.CredentialsKeysFile); err != nil {
+		return nil, errors.Wrap(err, "failed to load the BMC credentials keys")
+	}
+	return h, nil
+}
+
+// Handler represents BMC hosts handler
+type Handler struct {
+	log          logrus.FieldLogger
+	db           *gorm.DB
+	cfg          Config
+	credentials  *credentials.Cipher
+	validateHost func(ctx context.Context, host string) error
+	newClient    NewClientFunc
+}
+
+// EncryptPlaintextPasswords encrypts the passwords of the BMC hosts that were stored in plaintext
+func (h *Handler) EncryptPlaintextPasswords(ctx context.Context) error {
+	if h.credentials == nil {
+		return nil
+	}
+	var bmcHosts []*common.BmcHost
+	if err := h.db.Where("password NOT LIKE ?", credentials.EncryptedPrefix+"%").Find(&bmcHosts).Error; err != nil {
+		return err
+	}
+	for _, bmcHost := range bmcHosts {
+		encrypted, err := h.credentials.Encrypt(ctx, bmcHost.ID.String(), bmcHost.Password)
+		if err != nil {
+			return err
+		}
+		err = h.db.Model(&common.BmcHost{}).Where("id = ? and password = ?", bmcHost.ID.String(), bmcHost.Password).
+			Update("password", encrypted).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to encrypt the password of BMC host %s", bmcHost.ID)
+		}
+	}
+	if len(bmcHosts) > 0 {
+		h.log.Infof("Encrypted the passwords of %d BMC hosts", len(bmcHosts))
+	}
+	return nil
+}
+
+func (h *Handler) V2CreateBmcHost(ctx context.Context, params operations.V2CreateBmcHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if _, err := common.GetInfraEnvFromDB(h.db, params.InfraEnvID); err != nil {
+		return common.GenerateErrorResponder(infraEnvError(params.InfraEnvID, err))
+	}
+
+	if err := h.checkEnabled(); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	createParams := params.NewBmcHostParams
+	if err := h.validateAddress(ctx, swag.StringValue(createParams.Address)); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	hostID := createParams.HostID
+	if hostID != nil && *hostID == emptyUUID {
+		hostID = nil
+	}
+	if err := h.validateInfraEnvHost(params.InfraEnvID, hostID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	password, err := h.credentials.Encrypt(ctx, id.String(), createParams.Password.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to encrypt the password of BMC host %s", id)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	now := time.Now()
+	bmcHost := &common.BmcHost{
+		BmcHost: models.BmcHost{
+			ID:                             &id,
+			InfraEnvID:                     &params.InfraEnvID,
+			Name:                           createParams.Name,
+			Address:                        createParams.Address,
+			Username:                       createParams.Username,
+			DisableCertificateVerification: swag.BoolValue(createParams.DisableCertificateVerification),
+			HostID:                         hostID,
+			CreatedAt:                      now,
+			UpdatedAt:                      now,
+		},
+		Password: password,
+	}
+	if err := h.db.Create(bmcHost).Error; err != nil {
+		log.WithError(err).Errorf("failed to create BMC host of infra-env %s", params.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Created BMC host %s of infra-env %s", id, params.InfraEnvID)
+	return operations.NewV2CreateBmcHostCreated().WithPayload(&bmcHost.BmcHost)
+}
+
+func (h *Handler) V2ListBmcHosts(ctx context.Context, params operations.V2ListBmcHostsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if _, err := common.GetInfraEnvFromDB(h.db, params.InfraEnvID); err != nil {
+		return common.GenerateErrorResponder(infraEnvError(params.InfraEnvID, err))
+	}
+
+	var bmcHosts []*common.BmcHost
+	if err := h.db.Where("infra_env_id = ?", params.InfraEnvID.String()).Order("created_at").Find(&bmcHosts).Error; err != nil {
+		log.WithError(err).Errorf("failed to list BMC hosts of infra-env %s", params.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	payload := models.BmcHostList{}
+	for _, bmcHost := range bmcHosts {
+		payload = append(payload, &bmcHost.BmcHost)
+	}
+	return operations.NewV2ListBmcHostsOK().WithPayload(payload)
+}
+
+func (h *Handler) V2GetBmcHost(ctx context.Context, params operations.V2GetBmcHostParams) middleware.Responder {
+	bmcHost, err := h.getBmcHost(h.db, params.InfraEnvID, params.BmcHostID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetBmcHostOK().WithPayload(&bmcHost.BmcHost)
+}
+
+func (h *Handler) V2UpdateBmcHost(ctx context.Context, params operations.V2UpdateBmcHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	updateParams := params.BmcHostUpdateParams
+
+	if updateParams.Password != nil {
+		if err := h.checkEnabled(); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+	if updateParams.Address != nil {
+		if err := h.validateAddress(ctx, *updateParams.Address); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
+	var bmcHost *common.BmcHost
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if bmcHost, err = h.getBmcHost(tx, params.InfraEnvID, params.BmcHostID); err != nil {
+			return err
+		}
+		if updateParams.Name != nil {
+			bmcHost.Name = *updateParams.Name
+		}
+		if updateParams.Address != nil {
+			bmcHost.Address = updateParams.Address
+		}
+		if updateParams.Username != nil {
+			bmcHost.Username = updateParams.Username
+		}
+		if updateParams.Password != nil {
+			if bmcHost.Password, err = h.credentials.Encrypt(ctx, params.BmcHostID.String(), updateParams.Password.String()); err != nil {
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+		}
+		if updateParams.DisableCertificateVerification != nil {
+			bmcHost.DisableCertificateVerification = *updateParams.DisableCertificateVerification
+		}
+		if updateParams.HostID != nil {
+			if *updateParams.HostID == emptyUUID {
+				bmcHost.HostID = nil
+			} else {
+				if err = h.validateInfraEnvHost(params.InfraEnvID, updateParams.HostID); err != nil {
+					return err
+				}
+				bmcHost.HostID = updateParams.HostID
+			}
+		}
+		bmcHost.UpdatedAt = time.Now()
+		if err = tx.Save(bmcHost).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to update BMC host %s", params.BmcHostID)
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2UpdateBmcHostOK().WithPayload(&bmcHost.BmcHost)
+}
+
+func (h *Handler) V2DeleteBmcHost(ctx context.Context, params operations.V2DeleteBmcHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	bmcHost, err := h.getBmcHost(h.db, params.InfraEnvID, params.BmcHostID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = h.db.Delete(bmcHost).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete BMC host %s", params.BmcHostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Deleted BMC host %s of infra-env %s", params.BmcHostID, params.InfraEnvID)
+	return operations.NewV2DeleteBmcHostNoContent()
+}
+
+func (h *Handler) V2GetBmcHostStatus(ctx context.Context, params operations.V2GetBmcHostStatusParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	bmcHost, err := h.getBmcHost(h.db, params.InfraEnvID, params.BmcHostID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	client, err := h.client(ctx, bmcHost)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	status, err := client.GetStatus(ctx)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the status of BMC host %s", params.BmcHostID)
+		return common.NewApiError(http.StatusInternalServerError,
+			errors.Errorf("failed to get the status from the BMC: %s", publicMessage(err)))
+	}
+	return operations.NewV2GetBmcHostStatusOK().WithPayload(status)
+}
+
+func (h *Handler) V2BmcHostPowerAction(ctx context.Context, params operations.V2BmcHostPowerActionParams) middleware.Responder {
+	action := swag.StringValue(params.PowerActionParams.Action)
+	resetType, ok := powerActionResetTypes[action]
+	if !ok {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("unsupported power action %s", action))
+	}
+	bmcHost, err := h.runAction(ctx, params.InfraEnvID, params.BmcHostID, ActionPowerPrefix+action,
+		func(client Client) error {
+			return client.Reset(ctx, resetType)
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2BmcHostPowerActionAccepted().WithPayload(&bmcHost.BmcHost)
+}
+
+func (h *Handler) V2BmcHostBootIso(ctx context.Context, params operations.V2BmcHostBootIsoParams) middleware.Responder {
+	infraEnv, err := common.GetInfraEnvFromDB(h.db, params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(infraEnvError(params.InfraEnvID, err))
+	}
+	image := infraEnv.DownloadURL
+	reboot := true
+	if params.BootIsoParams != nil {
+		if params.BootIsoParams.ImageURL != "" {
+			image = params.BootIsoParams.ImageURL
+		}
+		if params.BootIsoParams.Reboot != nil {
+			reboot = *params.BootIsoParams.Reboot
+		}
+	}
+	if image == "" {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("the discovery ISO of infra-env %s wasn't generated yet and no image URL was given", params.InfraEnvID))
+	}
+
+	bmcHost, err := h.runAction(ctx, params.InfraEnvID, params.BmcHostID, ActionBootIso,
+		func(client Client) error {
+			return bootIso(ctx, client, image, reboot)
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2BmcHostBootIsoAccepted().WithPayload(&bmcHost.BmcHost)
+}
+
+func (h *Handler) V2BmcHostEjectVirtualMedia(ctx context.Context, params operations.V2BmcHostEjectVirtualMediaParams) middleware.Responder {
+	bmcHost, err := h.runAction(ctx, params.InfraEnvID, params.BmcHostID, ActionEjectVirtualMedia,
+		func(client Client) error {
+			return client.EjectMedia(ctx)
+		})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2BmcHostEjectVirtualMediaAccepted().WithPayload(&bmcHost.BmcHost)
+}
+
+// bootIso inserts the image as virtual media and sets it as the next boot device, then powers the host on or
+// restarts it
+func bootIso(ctx context.Context, client Client, image string, reboot bool) error {
+	if err := client.InsertMedia(ctx, image); err != nil {
+		return errors.Wrap(err, "failed to insert the virtual media")
+	}
+	if err := client.SetOneTimeBoot(ctx, BootSourceCd); err != nil {
+		return errors.Wrap(err, "failed to set the one-time boot")
+	}
+	if !reboot {
+		return nil
+	}
+	status, err := client.GetStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the power state")
+	}
+	resetType := ResetTypeForceRestart
+	if swag.StringValue(status.PowerState) == PowerStateOff {
+		resetType = ResetTypeOn
+	}
+	if err = client.Reset(ctx, resetType); err != nil {
+		return errors.Wrapf(err, "failed to reset the host with %s", resetType)
+	}
+	return nil
+}
+
+// runAction runs an action against the BMC and records its result in the BMC host. The BMC errors are returned
+// as internal errors
+func (h *Handler) runAction(ctx context.Context, infraEnvID, bmcHostID strfmt.UUID, action string, run func(Client) error) (*common.BmcHost, error) {
+	log := logutil.FromContext(ctx, h.log)
+	bmcHost, err := h.getBmcHost(h.db, infraEnvID, bmcHostID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := h.client(ctx, bmcHost)
+	if err != nil {
+		return nil, err
+	}
+
+	actionErr := run(client)
+	bmcHost.LastAction = action
+	bmcHost.LastActionAt = time.Now()
+	bmcHost.LastActionError = ""
+	if actionErr != nil {
+		log.WithError(actionErr).Errorf("BMC action %s of BMC host %s failed", action, bmcHostID)
+		bmcHost.LastActionError = publicMessage(actionErr)
+	} else {
+		log.Infof("Ran BMC action %s of BMC host %s", action, bmcHostID)
+	}
+	updates := map[string]interface{}{
+		"last_action":       bmcHost.LastAction,
+		"last_action_at":    bmcHost.LastActionAt,
+		"last_action_error": bmcHost.LastActionError,
+	}
+	if err = h.db.Model(&common.BmcHost{}).Where("id = ?", bmcHostID.String()).Updates(updates).Error; err != nil {
+		log.WithError(err).Errorf("failed to record BMC action %s of BMC host %s", action, bmcHostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if actionErr != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Errorf("BMC action %s failed: %s", action, bmcHost.LastActionError))
+	}
+	return bmcHost, nil
+}
+
+// publicMessage returns the message of a BMC error returned to the users. The responses of the BMCs and the errors
+// of the connections to them are only logged, so that the BMC hosts can't be used to probe the networks of the service
+func publicMessage(err error) string {
+	var redfishErr *RedfishError
+	var requestErr *RequestError
+	switch {
+	case errors.As(err, &redfishErr):
+		return fmt.Sprintf("the BMC request failed with status %d", redfishErr.StatusCode)
+	case errors.As(err, &requestErr):
+		return "the BMC request failed, see the logs of the service"
+	default:
+		return err.Error()
+	}
+}
+
+func (h *Handler) checkEnabled() error {
+	if h.credentials == nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("BMC hosts are not enabled, the BMC credentials keys must be configured"))
+	}
+	return nil
+}
+
+// validateAddress checks that the address is a valid BMC address that isn't on a restricted network, and that the
+// credentials aren't sent over http unless it is allowed
+func (h *Handler) validateAddress(ctx context.Context, address string) error {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if parsed.IsInsecure() && !h.cfg.AllowInsecureTransport {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("BMC address %s must use https, the credentials can't be sent over http", address))
+	}
+	if err = h.validateHost(ctx, parsed.Host); err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "BMC address %s isn't allowed", address))
+	}
+	return nil
+}
+
+func (h *Handler) client(ctx context.Context, bmcHost *common.BmcHost) (Client, error) {
+	if err := h.checkEnabled(); err != nil {
+		return nil, err
+	}
+	password, err := h.credentials.Decrypt(ctx, bmcHost.ID.String(), bmcHost.Password)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	client, err := h.newClient(swag.StringValue(bmcHost.Address), swag.StringValue(bmcHost.Username), password,
+		bmcHost.DisableCertificateVerification)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return client, nil
+}
+
+func (h *Handler) getBmcHost(db *gorm.DB, infraEnvID, bmcHostID strfmt.UUID) (*common.BmcHost, error) {
+	var bmcHost common.BmcHost
+	err := db.Take(&bmcHost, "id = ? and infra_env_id = ?", bmcHostID.String(), infraEnvID.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("BMC host %s not found in infra-env %s", bmcHostID, infraEnvID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &bmcHost, nil
+}
+
+// validateInfraEnvHost checks that the host managed by the BMC belongs to the infra-env
+func (h *Handler) validateInfraEnvHost(infraEnvID strfmt.UUID, hostID *strfmt.UUID) error {
+	if hostID == nil {
+		return nil
+	}
+	if _, err := common.GetHostFromDB(h.db, infraEnvID.String(), hostID.String()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("host %s doesn't belong to infra-env %s", *hostID, infraEnvID))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+func infraEnvError(infraEnvID strfmt.UUID, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("infra-env %s not found", infraEnvID))
+	}
+	return common.NewApiError(http.StatusInternalServerError, err)
+}
@@ -0,0 +1,451 @@
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	ResetTypeOn              = "On"
+	ResetTypeGracefulOff     = "GracefulShutdown"
+	ResetTypeForceOff        = "ForceOff"
+	ResetTypeGracefulRestart = "GracefulRestart"
+	ResetTypeForceRestart    = "ForceRestart"
+
+	PowerStateOff = "Off"
+
+	BootSourceCd = "Cd"
+
+	systemsPath = "/redfish/v1/Systems"
+)
+
+// addressSchemes are the schemes of the BMC addresses, as in the BMC addresses of the BareMetalHosts. A scheme
+// can be suffixed with +http or +https to choose the transport, https being the default
+var addressSchemes = []string{"redfish", "redfish-virtualmedia", "idrac-virtualmedia"}
+
+// Address is a parsed BMC address
+type Address struct {
+	// Endpoint is the scheme and the host of the Redfish service
+	Endpoint string
+	// Host is the host name or the IP address of the Redfish service, without its port
+	Host string
+	// SystemPath is the path of the ComputerSystem of the host, empty when the service has a single system to
+	// discover
+	SystemPath string
+}
+
+// ParseAddress parses a BMC address such as redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/<id>, an
+// address without the path of the system being accepted for the Redfish services managing a single system
+func ParseAddress(address string) (*Address, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid BMC address %s", address)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("BMC address %s must contain a host", address)
+	}
+	if u.User != nil {
+		return nil, errors.Errorf("BMC address %s must not contain credentials", address)
+	}
+
+	transport := "https"
+	switch scheme := strings.SplitN(u.Scheme, "+", 2); {
+	case len(scheme) == 1 && (scheme[0] == "http" || scheme[0] == "https"):
+		transport = scheme[0]
+	case funk.ContainsString(addressSchemes, scheme[0]):
+		if len(scheme) == 2 {
+			if scheme[1] != "http" && scheme[1] != "https" {
+				return nil, errors.Errorf("unsupported transport %s of BMC address %s", scheme[1], address)
+			}
+			transport = scheme[1]
+		}
+	default:
+		return nil, errors.Errorf("unsupported scheme %s of BMC address %s, the supported schemes are %s, http and https",
+			u.Scheme, address, strings.Join(addressSchemes, ", "))
+	}
+
+	systemPath := strings.TrimSuffix(u.Path, "/")
+	if systemPath != "" && !strings.HasPrefix(systemPath, systemsPath+"/") {
+		return nil, errors.Errorf("the path of BMC address %s must be the path of a system under %s", address, systemsPath)
+	}
+	return &Address{Endpoint: fmt.Sprintf("%s://%s", transport, u.Host), Host: u.Hostname(), SystemPath: systemPath}, nil
+}
+
+// IsInsecure tells whether the Redfish service is reached over http
+func (a *Address) IsInsecure() bool {
+	return strings.HasPrefix(a.Endpoint, "http://")
+}
+
+// Client manages the power and the virtual media of a host through its BMC
+type Client interface {
+	GetStatus(ctx context.Context) (*models.BmcHostStatus, error)
+	Reset(ctx context.Context, resetType string) error
+	SetOneTimeBoot(ctx context.Context, target string) error
+	InsertMedia(ctx context.Context, image string) error
+	EjectMedia(ctx context.Context) error
+}
+
+// NewClientFunc creates the client of a BMC
+type NewClientFunc func(address, username, password string, disableCertificateVerification bool) (Client, error)
+
+// RedfishError is an error response of a Redfish service
+type RedfishError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *RedfishError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Redfish request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Redfish request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// RequestError is a Redfish request that failed without a response of the Redfish service, e.g. as the connection
+// failed or the response isn't valid
+type RequestError struct {
+	Method string
+	Path   string
+	Err    error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("Redfish request %s %s failed: %s", e.Method, e.Path, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+type odataID struct {
+	ID string `json:"@odata.id"`
+}
+
+type action struct {
+	Target string `json:"target"`
+}
+
+type collection struct {
+	Members []odataID `json:"Members"`
+}
+
+type computerSystem struct {
+	PowerState string `json:"PowerState"`
+	Boot       struct {
+		BootSourceOverrideTarget  string `json:"BootSourceOverrideTarget"`
+		BootSourceOverrideEnabled string `json:"BootSourceOverrideEnabled"`
+	} `json:"Boot"`
+	Actions struct {
+		Reset *action `json:"#ComputerSystem.Reset"`
+	} `json:"Actions"`
+	VirtualMedia *odataID `json:"VirtualMedia"`
+	Links        struct {
+		ManagedBy []odataID `json:"ManagedBy"`
+	} `json:"Links"`
+}
+
+type manager struct {
+	VirtualMedia *odataID `json:"VirtualMedia"`
+}
+
+type virtualMedia struct {
+	ID         string   `json:"@odata.id"`
+	MediaTypes []string `json:"MediaTypes"`
+	Image      string   `json:"Image"`
+	Inserted   bool     `json:"Inserted"`
+	Actions    struct {
+		InsertMedia *action `json:"#VirtualMedia.InsertMedia"`
+		EjectMedia  *action `json:"#VirtualMedia.EjectMedia"`
+	} `json:"Actions"`
+}
+
+// ClientOptions are the options of the Redfish clients
+type ClientOptions struct {
+	RequestTimeout time.Duration
+	// AllowInsecureTransport allows sending the credentials to the BMCs over http
+	AllowInsecureTransport bool
+	// Control is the net.Dialer Control of the connections to the BMCs, refusing the restricted addresses
+	Control func(network, address string, c syscall.RawConn) error
+}
+
+type redfishClient struct {
+	address  *Address
+	username string
+	password string
+	client   *http.Client
+}
+
+// NewRedfishClientFunc returns a NewClientFunc creating Redfish clients with the options
+func NewRedfishClientFunc(opts ClientOptions) NewClientFunc {
+	return func(address, username, password string, disableCertificateVerification bool) (Client, error) {
+		return NewRedfishClient(address, username, password, disableCertificateVerification, opts)
+	}
+}
+
+// NewRedfishClient returns a client of the Redfish service of the BMC address. The credentials aren't sent over http
+// unless the insecure transport is allowed, and the redirections of the BMC aren't followed
+func NewRedfishClient(address, username, password string, disableCertificateVerification bool, opts ClientOptions) (Client, error) {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.IsInsecure() && (username != "" || password != "") && !opts.AllowInsecureTransport {
+		return nil, errors.Errorf("the credentials of BMC address %s can't be sent over http", address)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if disableCertificateVerification {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+	if opts.Control != nil {
+		// No proxy is used, as it would hide the address that the request is sent to
+		dialer := &net.Dialer{Timeout: opts.RequestTimeout, Control: opts.Control}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+	return &redfishClient{
+		address:  parsed,
+		username: username,
+		password: password,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.RequestTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}, nil
+}
+
+// do sends a request to the Redfish service. The paths, which are read from the responses of the service, must be
+// absolute paths, so that the requests and the credentials are only sent to the endpoint of the BMC address
+func (c *redfishClient) do(ctx context.Context, method, path string, body, result interface{}) error {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return &RequestError{Method: method, Path: path, Err: errors.New("the path isn't an absolute path of the BMC")}
+	}
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address.Endpoint+path, reader)
+	if err != nil {
+		return &RequestError{Method: method, Path: path, Err: err}
+	}
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return &RequestError{Method: method, Path: path, Err: err}
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &RequestError{Method: method, Path: path, Err: errors.Wrap(err, "failed to read the response")}
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return newRedfishError(resp.StatusCode, content)
+	}
+	if result != nil {
+		if err = json.Unmarshal(content, result); err != nil {
+			return &RequestError{Method: method, Path: path, Err: errors.Wrap(err, "failed to parse the response")}
+		}
+	}
+	return nil
+}
+
+func newRedfishError(statusCode int, content []byte) *RedfishError {
+	var response struct {
+		Error struct {
+			Message      string `json:"message"`
+			ExtendedInfo []struct {
+				Message string `json:"Message"`
+			} `json:"@Message.ExtendedInfo"`
+		} `json:"error"`
+	}
+	redfishErr := &RedfishError{StatusCode: statusCode}
+	if json.Unmarshal(content, &response) == nil {
+		redfishErr.Message = response.Error.Message
+		if len(response.Error.ExtendedInfo) > 0 && response.Error.ExtendedInfo[0].Message != "" {
+			redfishErr.Message = response.Error.ExtendedInfo[0].Message
+		}
+	}
+	return redfishErr
+}
+
+// systemPath returns the path of the system of the address, or of the single system of the service
+func (c *redfishClient) systemPath(ctx context.Context) (string, error) {
+	if c.address.SystemPath != "" {
+		return c.address.SystemPath, nil
+	}
+	var systems collection
+	if err := c.do(ctx, http.MethodGet, systemsPath, nil, &systems); err != nil {
+		return "", err
+	}
+	if len(systems.Members) != 1 {
+		return "", errors.Errorf("the BMC manages %d systems, the path of the system must be part of the BMC address", len(systems.Members))
+	}
+	c.address.SystemPath = systems.Members[0].ID
+	return c.address.SystemPath, nil
+}
+
+func (c *redfishClient) getSystem(ctx context.Context) (string, *computerSystem, error) {
+	path, err := c.systemPath(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	var system computerSystem
+	if err = c.do(ctx, http.MethodGet, path, nil, &system); err != nil {
+		return "", nil, err
+	}
+	return path, &system, nil
+}
+
+// getVirtualMedia returns the CD or DVD virtual media of the system, linked from the system or from its manager
+func (c *redfishClient) getVirtualMedia(ctx context.Context, system *computerSystem) (*virtualMedia, error) {
+	collectionPath := ""
+	if system.VirtualMedia != nil {
+		collectionPath = system.VirtualMedia.ID
+	} else if len(system.Links.ManagedBy) > 0 {
+		var mgr manager
+		if err := c.do(ctx, http.MethodGet, system.Links.ManagedBy[0].ID, nil, &mgr); err != nil {
+			return nil, err
+		}
+		if mgr.VirtualMedia != nil {
+			collectionPath = mgr.VirtualMedia.ID
+		}
+	}
+	if collectionPath == "" {
+		return nil, errors.New("the BMC doesn't support virtual media")
+	}
+
+	var media collection
+	if err := c.do(ctx, http.MethodGet, collectionPath, nil, &media); err != nil {
+		return nil, err
+	}
+	for _, member := range media.Members {
+		var vm virtualMedia
+		if err := c.do(ctx, http.MethodGet, member.ID, nil, &vm); err != nil {
+			return nil, err
+		}
+		if funk.ContainsString(vm.MediaTypes, "CD") || funk.ContainsString(vm.MediaTypes, "DVD") {
+			if vm.ID == "" {
+				vm.ID = member.ID
+			}
+			return &vm, nil
+		}
+	}
+	return nil, errors.New("the BMC has no CD or DVD virtual media")
+}
+
+func (c *redfishClient) GetStatus(ctx context.Context) (*models.BmcHostStatus, error) {
+	_, system, err := c.getSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &models.BmcHostStatus{
+		PowerState:                swag.String(system.PowerState),
+		BootSourceOverrideTarget:  system.Boot.BootSourceOverrideTarget,
+		BootSourceOverrideEnabled: system.Boot.BootSourceOverrideEnabled,
+	}
+	// The virtual media are optional, the power state being reported for the BMCs without virtual media
+	if vm, err := c.getVirtualMedia(ctx, system); err == nil {
+		status.VirtualMediaImage = vm.Image
+		status.VirtualMediaInserted = vm.Inserted
+	}
+	return status, nil
+}
+
+func (c *redfishClient) Reset(ctx context.Context, resetType string) error {
+	path, system, err := c.getSystem(ctx)
+	if err != nil {
+		return err
+	}
+	target := path + "/Actions/ComputerSystem.Reset"
+	if system.Actions.Reset != nil && system.Actions.Reset.Target != "" {
+		target = system.Actions.Reset.Target
+	}
+	return c.do(ctx, http.MethodPost, target, map[string]string{"ResetType": resetType}, nil)
+}
+
+func (c *redfishClient) SetOneTimeBoot(ctx context.Context, target string) error {
+	path, err := c.systemPath(ctx)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{
+		"Boot": map[string]string{
+			"BootSourceOverrideTarget":  target,
+			"BootSourceOverrideEnabled": "Once",
+		},
+	}
+	return c.do(ctx, http.MethodPatch, path, body, nil)
+}
+
+func (c *redfishClient) InsertMedia(ctx context.Context, image string) error {
+	_, system, err := c.getSystem(ctx)
+	if err != nil {
+		return err
+	}
+	vm, err := c.getVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+	if vm.Inserted {
+		if err = c.eject(ctx, vm); err != nil {
+			return err
+		}
+	}
+	target := vm.ID + "/Actions/VirtualMedia.InsertMedia"
+	if vm.Actions.InsertMedia != nil && vm.Actions.InsertMedia.Target != "" {
+		target = vm.Actions.InsertMedia.Target
+	}
+	body := map[string]interface{}{"Image": image, "Inserted": true, "WriteProtected": true}
+	return c.do(ctx, http.MethodPost, target, body, nil)
+}
+
+func (c *redfishClient) EjectMedia(ctx context.Context) error {
+	_, system, err := c.getSystem(ctx)
+	if err != nil {
+		return err
+	}
+	vm, err := c.getVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+	if !vm.Inserted {
+		return nil
+	}
+	return c.eject(ctx, vm)
+}
+
+func (c *redfishClient) eject(ctx context.Context, vm *virtualMedia) error {
+	target := vm.ID + "/Actions/VirtualMedia.EjectMedia"
+	if vm.Actions.EjectMedia != nil && vm.Actions.EjectMedia.Target != "" {
+		target = vm.Actions.EjectMedia.Target
+	}
+	return c.do(ctx, http.MethodPost, target, map[string]interface{}{}, nil)
+}
@@ -10,7 +10,6 @@ import (
 	"io"
 	"net"
 	"net/http"
-	"runtime"
 	"strings"
 	"time"
 
@@ -25,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/decommission"
 	"github.com/openshift/assisted-service/internal/dns"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/featuresupport"
@@ -40,6 +40,7 @@ import (
 	installcfgdata "github.com/openshift/assisted-service/internal/installcfg"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
@@ -49,6 +50,7 @@ import (
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/internal/triage"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -153,8 +155,10 @@ type InstallerInternals interface {
 	InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error)
 	DeregisterClusterInternal(ctx context.Context, cluster *common.Cluster) error
 	V2DeregisterHostInternal(ctx context.Context, params installer.V2DeregisterHostParams, interactivity Interactivity) error
+	V2DecommissionHostInternal(ctx context.Context, params installer.V2DecommissionHostParams) (*common.Host, error)
 	GetCommonHostInternal(ctx context.Context, infraEnvId string, hostId string) (*common.Host, error)
 	UpdateHostApprovedInternal(ctx context.Context, infraEnvId string, hostId string, approved bool) error
+	UpdateHostBareMetalHostInternal(ctx context.Context, infraEnvId string, hostId string, bareMetalHost bool) error
 	V2UpdateHostInstallerArgsInternal(ctx context.Context, params installer.V2UpdateHostInstallerArgsParams) (*models.Host, error)
 	V2UpdateHostIgnitionInternal(ctx context.Context, params installer.V2UpdateHostIgnitionParams) (*models.Host, error)
 	GetCredentialsInternal(ctx context.Context, params installer.V2GetCredentialsParams) (*models.Credentials, error)
@@ -163,6 +167,7 @@ type InstallerInternals interface {
 	V2ImportClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, id *strfmt.UUID, params installer.V2ImportClusterParams) (*common.Cluster, error)
 	InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, infraEnvId strfmt.UUID, hostId strfmt.UUID) error
 	UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (*common.Cluster, error)
+	PreviewClusterInstallConfigInternal(ctx context.Context, params installer.V2PreviewClusterInstallConfigParams) (*models.InstallConfigPreview, error)
 	CancelInstallationInternal(ctx context.Context, params installer.V2CancelInstallationParams) (*common.Cluster, error)
 	TransformClusterToDay2Internal(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error)
 	GetClusterSupportedPlatformsInternal(ctx context.Context, params installer.GetClusterSupportedPlatformsParams) (*[]models.PlatformType, error)
@@ -216,6 +221,9 @@ type bareMetalInventory struct {
 	staticNetworkConfig  staticnetworkconfig.StaticNetworkConfig
 	gcConfig             garbagecollector.Config
 	providerRegistry     registry.ProviderRegistry
+	decommissionApi      decommission.API
+	logSearchApi         logsearch.API
+	triageApi            triage.API
 	insecureIPXEURLs     bool
 }
 
@@ -249,6 +257,9 @@ func NewBareMetalInventory(
 	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig,
 	gcConfig garbagecollector.Config,
 	providerRegistry registry.ProviderRegistry,
+	decommissionApi decommission.API,
+	logSearchApi logsearch.API,
+	triageApi triage.API,
 	insecureIPXEURLs bool,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
@@ -281,6 +292,9 @@ func NewBareMetalInventory(
 		staticNetworkConfig:  staticNetworkConfig,
 		gcConfig:             gcConfig,
 		providerRegistry:     providerRegistry,
+		decommissionApi:      decommissionApi,
+		logSearchApi:         logSearchApi,
+		triageApi:            triageApi,
 		insecureIPXEURLs:     insecureIPXEURLs,
 	}
 }
@@ -432,6 +446,22 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 		}
 	}
 
+	if err := hostutil.ValidateHostRoleRules(params.NewClusterParams.HostRoleRules); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err := hostutil.ValidateCustomHostValidations(params.NewClusterParams.CustomHostValidations); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err := ignition.ValidateOverrideLayers(params.NewClusterParams.IgnitionOverrideLayers, swag.StringValue(params.NewClusterParams.OpenshiftVersion)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err := hostutil.ValidateHostStageTimeoutPolicies(params.NewClusterParams.HostStageTimeoutPolicies); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if params.NewClusterParams.Platform != nil {
 		if err := validations.ValidateHighAvailabilityModeWithPlatform(params.NewClusterParams.HighAvailabilityMode, params.NewClusterParams.Platform); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
@@ -574,6 +604,26 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		b.log.WithError(err).Warningf("Failed to query if capability %s has org capability for cluster %s", ocm.SoftTimeoutsCapabilityName, id.String())
 	}
 
+	hostRoleRules, err := common.MarshalHostRoleRules(params.NewClusterParams.HostRoleRules)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	customHostValidations, err := common.MarshalCustomHostValidations(params.NewClusterParams.CustomHostValidations)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	hostStageTimeoutPolicies, err := common.MarshalHostStageTimeoutPolicies(params.NewClusterParams.HostStageTimeoutPolicies)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	ignitionOverrideLayers, err := common.MarshalIgnitionOverrideLayers(params.NewClusterParams.IgnitionOverrideLayers)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	if kubeKey == nil {
 		kubeKey = &types.NamespacedName{}
 	}
@@ -614,6 +664,10 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			CPUArchitecture:              cpuArchitecture,
 			IgnitionEndpoint:             params.NewClusterParams.IgnitionEndpoint,
 			Tags:                         swag.StringValue(params.NewClusterParams.Tags),
+			HostRoleRules:                hostRoleRules,
+			CustomHostValidations:        customHostValidations,
+			HostStageTimeoutPolicies:     hostStageTimeoutPolicies,
+			IgnitionOverrideLayers:       ignitionOverrideLayers,
 			OrgSoftTimeoutsEnabled:       orgSoftTimeoutsEnabled,
 		},
 		KubeKeyName:                 kubeKey.Name,
@@ -993,7 +1047,12 @@ func (b *bareMetalInventory) createAndUploadDay2NodeIgnition(ctx context.Context
 		caCert = cluster.IgnitionEndpoint.CaCertificate
 	}
 
-	fullIgnition, err := b.IgnitionBuilder.FormatSecondDayWorkerIgnitionFile(ignitionEndpointUrl, caCert, ignitionEndpointToken, ignitionEndpointHTTPHeaders, host)
+	overrideLayers, err := common.UnmarshalIgnitionOverrideLayers(cluster.IgnitionOverrideLayers)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to parse the ignition override layers of cluster %s", cluster.ID)
+	}
+
+	fullIgnition, err := b.IgnitionBuilder.FormatSecondDayWorkerIgnitionFile(ignitionEndpointUrl, caCert, ignitionEndpointToken, ignitionEndpointHTTPHeaders, host, overrideLayers)
 	if err != nil {
 		return errors.Wrapf(err, "Failed to create ignition string for cluster %s, host %s", cluster.ID, host.ID)
 	}
@@ -1633,6 +1692,12 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 	var err error
 	query := "id = ?"
 
+	if !swag.BoolValue(params.AllowServiceOwnedFields) {
+		if err = validateServiceOwnedOverrides(params.InstallConfigParams); err != nil {
+			return nil, err
+		}
+	}
+
 	err = b.db.Transaction(func(tx *gorm.DB) error {
 		if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
 			log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
@@ -1687,6 +1752,50 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 	return cluster, nil
 }
 
+// validateServiceOwnedOverrides rejects the overrides of the fields set by the service from the
+// cluster, such as the networks and the VIPs, as they bypass the validations of the cluster
+func validateServiceOwnedOverrides(overrides string) error {
+	paths, err := installcfgdata.ServiceOwnedOverrides(overrides)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid install config overrides"))
+	}
+	if len(paths) > 0 {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf(
+			"install config overrides of fields set by the service require allow_service_owned_fields: %s", strings.Join(paths, ", ")))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) PreviewClusterInstallConfigInternal(ctx context.Context, params installer.V2PreviewClusterInstallConfigParams) (*models.InstallConfigPreview, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfraenvs, err := b.getClusterInfraenvs(cluster)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get infraenvs for cluster %s", cluster.ID.String())
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("Failed to get infraenvs for cluster"))
+	}
+
+	// Without overrides the preview is of the overrides of the cluster
+	overrides := cluster.InstallConfigOverrides
+	if params.PreviewParams != nil && params.PreviewParams.InstallConfigParams != nil {
+		overrides = *params.PreviewParams.InstallConfigParams
+		if err = b.installConfigBuilder.ValidateInstallConfigPatch(cluster, clusterInfraenvs, overrides); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	preview, err := b.installConfigBuilder.PreviewInstallConfig(cluster, clusterInfraenvs, overrides)
+	if err != nil {
+		log.WithError(err).Errorf("failed to preview the install config of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return preview, nil
+}
+
 func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string, installConfigParams string, clusterID strfmt.UUID, db *gorm.DB) error {
 	usages, err := usage.Unmarshal(featureUsages)
 	if err != nil {
@@ -1740,7 +1849,7 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 	}
 
 	installerReleaseImageOverride := ""
-	if isBaremetalBinaryFromAnotherReleaseImageRequired(cluster.CPUArchitecture, cluster.OpenshiftVersion) {
+	if featuresupport.IsBaremetalBinaryFromAnotherReleaseImageRequired(cluster.CPUArchitecture, cluster.OpenshiftVersion) {
 		defaultArchImage, err := b.versionsHandler.GetReleaseImage(ctx, cluster.OpenshiftVersion, common.DefaultCPUArchitecture, cluster.PullSecret)
 		if err != nil {
 			msg := fmt.Sprintf("failed to get image for installer image override "+
@@ -2271,6 +2380,22 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		return err
 	}
 
+	if err = b.updateHostRoleRules(params, updates, log); err != nil {
+		return err
+	}
+
+	if err = b.updateCustomHostValidations(params, updates, log); err != nil {
+		return err
+	}
+
+	if err = b.updateHostStageTimeoutPolicies(params, updates, log); err != nil {
+		return err
+	}
+
+	if err = b.updateIgnitionOverrideLayers(params, cluster, updates, log); err != nil {
+		return err
+	}
+
 	if params.ClusterUpdateParams.PullSecret != nil {
 		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
 		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
@@ -2789,6 +2914,66 @@ func (b *bareMetalInventory) updateClusterTags(params installer.V2UpdateClusterP
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostRoleRules(params installer.V2UpdateClusterParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.HostRoleRules != nil {
+		if err := hostutil.ValidateHostRoleRules(params.ClusterUpdateParams.HostRoleRules); err != nil {
+			log.WithError(err).Error("invalid host role rules")
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		hostRoleRules, err := common.MarshalHostRoleRules(params.ClusterUpdateParams.HostRoleRules)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		updates["host_role_rules"] = hostRoleRules
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateCustomHostValidations(params installer.V2UpdateClusterParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.CustomHostValidations != nil {
+		if err := hostutil.ValidateCustomHostValidations(params.ClusterUpdateParams.CustomHostValidations); err != nil {
+			log.WithError(err).Error("invalid custom host validations")
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		customHostValidations, err := common.MarshalCustomHostValidations(params.ClusterUpdateParams.CustomHostValidations)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		updates["custom_host_validations"] = customHostValidations
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostStageTimeoutPolicies(params installer.V2UpdateClusterParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.HostStageTimeoutPolicies != nil {
+		if err := hostutil.ValidateHostStageTimeoutPolicies(params.ClusterUpdateParams.HostStageTimeoutPolicies); err != nil {
+			log.WithError(err).Error("invalid host stage timeout policies")
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		hostStageTimeoutPolicies, err := common.MarshalHostStageTimeoutPolicies(params.ClusterUpdateParams.HostStageTimeoutPolicies)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		updates["host_stage_timeout_policies"] = hostStageTimeoutPolicies
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateIgnitionOverrideLayers(params installer.V2UpdateClusterParams, cluster *common.Cluster, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.IgnitionOverrideLayers != nil {
+		if err := ignition.ValidateOverrideLayers(params.ClusterUpdateParams.IgnitionOverrideLayers, cluster.OpenshiftVersion); err != nil {
+			log.WithError(err).Error("invalid ignition override layers")
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		ignitionOverrideLayers, err := common.MarshalIgnitionOverrideLayers(params.ClusterUpdateParams.IgnitionOverrideLayers)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		updates["ignition_override_layers"] = ignitionOverrideLayers
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateClusterNetworkVMUsage(cluster *common.Cluster, updateParams *models.V2ClusterUpdateParams, usages map[string]models.Usage, log logrus.FieldLogger) {
 	platform := cluster.Platform
 	usageEnable := true
@@ -3278,6 +3463,9 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
+
+	case models.StepTypeWipeDisks:
+		return b.decommissionApi.HandleWipeDisksReply(ctx, h, params.Reply.Error)
 	}
 	return nil
 }
@@ -3521,6 +3709,10 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
 	case models.StepTypeVerifyVips:
 		err = b.HandleVerifyVipsResponse(ctx, &host, stepReply)
+	case models.StepTypeMtuPathCheck:
+		err = b.hostApi.UpdateMtuPathReport(ctx, &host, stepReply)
+	case models.StepTypeWipeDisks:
+		err = b.decommissionApi.HandleWipeDisksReply(ctx, &host, "")
 	}
 	return err
 }
@@ -3583,6 +3775,10 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.UpgradeAgentResponse{}, params.Reply.Output)
 	case models.StepTypeVerifyVips:
 		stepReply, err = filterReply(&models.VerifyVipsResponse{}, params.Reply.Output)
+	case models.StepTypeMtuPathCheck:
+		stepReply, err = filterReply(&models.MtuPathCheckResponse{}, params.Reply.Output)
+	case models.StepTypeWipeDisks:
+		stepReply, err = filterReply(&models.WipeDisksResponse{}, params.Reply.Output)
 	}
 
 	return stepReply, err
@@ -4029,6 +4225,46 @@ func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V
 	return installer.NewV2ResetHostOK().WithPayload(&host.Host)
 }
 
+func (b *bareMetalInventory) V2DecommissionHost(ctx context.Context, params installer.V2DecommissionHostParams) middleware.Responder {
+	host, err := b.V2DecommissionHostInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DecommissionHostAccepted().WithPayload(&host.Host)
+}
+
+func (b *bareMetalInventory) V2DecommissionHostInternal(ctx context.Context, params installer.V2DecommissionHostParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Decommissioning host %s of cluster %s", params.HostID, params.ClusterID)
+	host, err := common.GetClusterHostFromDB(b.db, params.ClusterID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithError(err).Errorf("host %s not found in cluster %s", params.HostID, params.ClusterID)
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		log.WithError(err).Errorf("failed to get host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	wipeDisks := params.DecommissionParams != nil && swag.BoolValue(params.DecommissionParams.WipeDisks)
+	if err = b.decommissionApi.DecommissionHost(ctx, &host.Host, wipeDisks); err != nil {
+		return nil, err
+	}
+
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	host, err = common.GetHostFromDB(b.db, host.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to get host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	b.customizeHost(&cluster.Cluster, &host.Host)
+	return host, nil
+}
+
 func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster common.Cluster) error {
 	return b.dnsApi.DeleteDNSRecordSets(ctx, &cluster)
 }
@@ -4169,6 +4405,18 @@ func (b *bareMetalInventory) UpdateHostApprovedInternal(ctx context.Context, inf
 	return nil
 }
 
+// UpdateHostBareMetalHostInternal records whether the agent of the host is provisioned by a BareMetalHost
+func (b *bareMetalInventory) UpdateHostBareMetalHostInternal(ctx context.Context, infraEnvId, hostId string, bareMetalHost bool) error {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Updating BareMetalHost to %t Host %s InfraEnv %s", bareMetalHost, hostId, infraEnvId)
+	err := b.db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", hostId, infraEnvId).Update("bare_metal_host", bareMetalHost).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to update 'bare_metal_host' in host: %s", hostId)
+		return err
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) getClusterInfraenvs(c *common.Cluster) ([]*common.InfraEnv, error) {
 	// Cluster hosts usually originate from the same infraenv, keep track
 	// of which ones we've already seen so we don't pull them twice
@@ -4599,6 +4847,12 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 			kernelArguments = swag.String(string(b))
 		}
 
+		var customHostValidations string
+		customHostValidations, err = common.MarshalCustomHostValidations(params.InfraenvCreateParams.CustomHostValidations)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+
 		infraEnv = common.InfraEnv{
 			Generated: false,
 			InfraEnv: models.InfraEnv{
@@ -4618,6 +4872,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 				CPUArchitecture:        params.InfraenvCreateParams.CPUArchitecture,
 				KernelArguments:        kernelArguments,
 				AdditionalTrustBundle:  params.InfraenvCreateParams.AdditionalTrustBundle,
+				CustomHostValidations:  customHostValidations,
 			},
 			KubeKeyNamespace: kubeKey.Namespace,
 			ImageTokenKey:    imageTokenKey,
@@ -4749,6 +5004,10 @@ func (b *bareMetalInventory) validateInfraEnvCreateParams(ctx context.Context, p
 		}
 	}
 
+	if err = hostutil.ValidateCustomHostValidations(params.InfraenvCreateParams.CustomHostValidations); err != nil {
+		return err
+	}
+
 	if err = b.validateInfraEnvIgnitionParams(ctx, params.InfraenvCreateParams.IgnitionConfigOverride); err != nil {
 		return err
 	}
@@ -4933,6 +5192,10 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 			}
 		}
 
+		if err = hostutil.ValidateCustomHostValidations(params.InfraEnvUpdateParams.CustomHostValidations); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
 		if err = b.validateKernelArguments(ctx, params.InfraEnvUpdateParams.KernelArguments); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
@@ -5101,6 +5364,20 @@ func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, param
 		}
 	}
 
+	// The custom host validations aren't part of the discovery image, changing them doesn't require to generate it again
+	if params.InfraEnvUpdateParams.CustomHostValidations != nil {
+		customHostValidations, err := common.MarshalCustomHostValidations(params.InfraEnvUpdateParams.CustomHostValidations)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if customHostValidations != infraEnv.CustomHostValidations {
+			dbReply := db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).Update("custom_host_validations", customHostValidations)
+			if dbReply.Error != nil {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(dbReply.Error, "failed to update the custom host validations of infraEnv: %s", params.InfraEnvID))
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -5358,6 +5635,100 @@ func (b *bareMetalInventory) V2GetHostIgnition(ctx context.Context, params insta
 	return installer.NewV2GetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
 }
 
+func (b *bareMetalInventory) V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	preview, err := b.GetHostIgnitionPreviewInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetHostIgnitionPreviewOK().WithPayload(preview)
+}
+
+// GetHostIgnitionPreviewInternal renders the ignition of the host with the ignition override layers of its cluster
+// and its own ignition config overrides, the full ignition for a day2 host and the pointer ignition otherwise
+func (b *bareMetalInventory) GetHostIgnitionPreviewInternal(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) (*models.HostIgnitionPreview, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host %s in infra env %s", params.HostID, params.InfraEnvID)
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+	if h.ClusterID == nil {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("host %s in infra env %s isn't bound to a cluster", params.HostID, params.InfraEnvID))
+	}
+
+	cluster, err := b.getCluster(ctx, h.ClusterID.String(), common.SkipEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+
+	overrideLayers, err := common.UnmarshalIgnitionOverrideLayers(cluster.IgnitionOverrideLayers)
+	if err != nil {
+		log.WithError(err).Errorf("failed to parse the ignition override layers of cluster %s", cluster.ID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var preview *models.HostIgnitionPreview
+	if hostutil.IsDay2Host(&h.Host) {
+		preview, err = b.previewDay2HostIgnition(cluster, h, overrideLayers)
+	} else {
+		preview, err = b.previewDay1HostIgnition(ctx, cluster, &h.Host, overrideLayers)
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to render the ignition preview of host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return preview, nil
+}
+
+func (b *bareMetalInventory) previewDay2HostIgnition(cluster *common.Cluster, host *common.Host, overrideLayers []*models.IgnitionOverrideLayer) (*models.HostIgnitionPreview, error) {
+	ignitionEndpointUrl, err := hostutil.GetIgnitionEndpoint(cluster, &host.Host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build ignition endpoint for host %s in cluster %s", host.ID, cluster.ID)
+	}
+	var caCert *string
+	if cluster.IgnitionEndpoint != nil {
+		caCert = cluster.IgnitionEndpoint.CaCertificate
+	}
+
+	// The ignition endpoint token isn't rendered in the preview, and the template is formatted without overrides
+	// for the layers to be applied on top of it
+	templateHost := host.Host
+	templateHost.IgnitionConfigOverrides = ""
+	template, err := b.IgnitionBuilder.FormatSecondDayWorkerIgnitionFile(ignitionEndpointUrl, caCert, "", host.IgnitionEndpointHTTPHeaders, &templateHost, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ignition.PreviewSecondDayHostIgnition(template, &host.Host, overrideLayers)
+}
+
+func (b *bareMetalInventory) previewDay1HostIgnition(ctx context.Context, cluster *common.Cluster, host *models.Host, overrideLayers []*models.IgnitionOverrideLayer) (*models.HostIgnitionPreview, error) {
+	roleIgnition := "worker.ign"
+	if role := common.GetEffectiveRole(host); role == models.HostRoleMaster || role == models.HostRoleBootstrap {
+		roleIgnition = "master.ign"
+	}
+	objectName := fmt.Sprintf("%s/%s", cluster.ID, roleIgnition)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before the installation files are generated the layers are rendered on top of an empty ignition
+	var base []byte
+	if exists {
+		reader, _, err := b.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		if base, err = io.ReadAll(reader); err != nil {
+			return nil, err
+		}
+	}
+	return ignition.PreviewHostPointerIgnition(base, cluster, host, overrideLayers)
+}
+
 func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var steps models.Steps
@@ -5515,7 +5886,8 @@ func (b *bareMetalInventory) V2UpdateHostInstallProgressInternal(ctx context.Con
 		}
 
 		log.Info(fmt.Sprintf("Host %s in cluster %s: %s", host.ID, host.ClusterID, event))
-		eventgen.SendHostInstallProgressUpdatedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID, hostutil.GetHostnameForMsg(&host.Host), event)
+		eventgen.SendHostInstallProgressUpdatedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID, hostutil.GetHostnameForMsg(&host.Host), event,
+			string(params.HostProgress.CurrentStage))
 		if stageChanged {
 			if err := b.clusterApi.UpdateInstallProgress(ctx, *host.ClusterID); err != nil {
 				log.WithError(err).Errorf("failed to update cluster %s progress", host.ClusterID)
@@ -5750,8 +6122,15 @@ func (b *bareMetalInventory) V2UpdateHostIgnitionInternal(ctx context.Context, p
 		}
 
 		if params.HostIgnitionParams.Config != "" {
-			_, err = ignition.ParseToLatest([]byte(params.HostIgnitionParams.Config))
-			if err != nil {
+			var openshiftVersion string
+			if h.ClusterID != nil {
+				var cluster *common.Cluster
+				if cluster, err = common.GetClusterFromDB(tx, *h.ClusterID, common.SkipEagerLoading); err != nil {
+					return common.NewApiError(http.StatusInternalServerError, err)
+				}
+				openshiftVersion = cluster.OpenshiftVersion
+			}
+			if err = ignition.ValidateIgnitionOverride(params.HostIgnitionParams.Config, openshiftVersion); err != nil {
 				log.WithError(err).Errorf("Failed to parse host ignition config patch %s", params.HostIgnitionParams)
 				return common.NewApiError(http.StatusBadRequest, err)
 			}
@@ -6361,17 +6740,6 @@ func (b *bareMetalInventory) GetKnownApprovedHosts(clusterId strfmt.UUID) ([]*co
 	return b.hostApi.GetKnownApprovedHosts(clusterId)
 }
 
-// In case cpu architecture is not x86_64, we should extract openshift-baremetal-installer
-// from x86_64 release image as there is no x86_64 openshift-baremetal-installer executable in arm image.
-// This flow does not affect the multiarch release images and is meant purely for using arm64 release image with the x86 hub.
-// Implementation of handling the multiarch images is done directly in the `oc` binary and relies on the fact that `oc adm release extract`
-// will automatically use the image matching the Hub's architecture.
-func isBaremetalBinaryFromAnotherReleaseImageRequired(cpuArchitecture, version string) bool {
-	return cpuArchitecture != common.MultiCPUArchitecture &&
-		cpuArchitecture != common.NormalizeCPUArchitecture(runtime.GOARCH) &&
-		featuresupport.IsFeatureAvailable(models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING, version, swag.String(models.ClusterCPUArchitectureArm64))
-}
-
 // updateMonitoredOperators checks the content of the installer configuration and updates the list
 // of monitored operators accordingly. For example, if the installer configuration uses the
 // capabilities mechanism to disable the console then the console operator is removed from the list
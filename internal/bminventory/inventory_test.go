@@ -42,6 +42,7 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/decommission"
 	"github.com/openshift/assisted-service/internal/dns"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
@@ -55,12 +56,14 @@ import (
 	"github.com/openshift/assisted-service/internal/infraenv"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	installcfg_builder "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/internal/triage"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -169,6 +172,9 @@ var (
 	mockInstallConfigBuilder          *installcfg_builder.MockInstallConfigBuilder
 	mockStaticNetworkConfig           *staticnetworkconfig.MockStaticNetworkConfig
 	mockProviderRegistry              *registry.MockProviderRegistry
+	mockDecommissionApi               *decommission.MockAPI
+	mockLogSearchApi                  *logsearch.MockAPI
+	mockTriageApi                     *triage.MockAPI
 	mockMirrorRegistriesConfigBuilder *mirrorregistries.MockMirrorRegistriesConfigBuilder
 	secondDayWorkerIgnition           = []byte(`{
 		"ignition": {
@@ -980,6 +986,98 @@ var _ = Describe("v2PostStepReply", func() {
 		})
 	})
 
+	Context("mtu path check", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		response := models.MtuPathCheckResponse{
+			Results: []*models.MtuPathResult{
+				{
+					RemoteHostID:    strfmt.UUID(uuid.New().String()),
+					RemoteIPAddress: "1.2.3.5",
+					OutgoingNic:     "eth0",
+					PathMtu:         1400,
+				},
+			},
+		}
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:         &hostId,
+				InfraEnvID: clusterId,
+				ClusterID:  &clusterId,
+				Status:     swag.String(models.HostStatusKnown),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeMtuPathCheck,
+				},
+			}
+		}
+
+		It("stores the report", func() {
+			b, err := json.Marshal(&response)
+			Expect(err).ToNot(HaveOccurred())
+			mockHostApi.EXPECT().UpdateMtuPathReport(ctx, gomock.Any(), string(b)).Return(nil)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(string(b)))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+		It("fails on a malformed report", func() {
+			reply := bm.V2PostStepReply(ctx, makeStepReply("not a report"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyBadRequest()))
+		})
+	})
+
+	Context("wipe disks", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:                &hostId,
+				InfraEnvID:        clusterId,
+				ClusterID:         &clusterId,
+				Status:            swag.String(models.HostStatusInstalled),
+				DecommissionStage: models.HostDecommissionStageWipingDisks,
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(exitCode int64, output, stepError string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					ExitCode: exitCode,
+					Output:   output,
+					Error:    stepError,
+					StepType: models.StepTypeWipeDisks,
+				},
+			}
+		}
+
+		It("completes the decommission", func() {
+			mockDecommissionApi.EXPECT().HandleWipeDisksReply(ctx, gomock.Any(), "").Return(nil)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(0, `{"wiped_disks":["/dev/sda"]}`, ""))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+		It("fails the decommission on a step error", func() {
+			mockDecommissionApi.EXPECT().HandleWipeDisksReply(ctx, gomock.Any(), "device busy").Return(nil)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(-1, "", "device busy"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+	})
+
 	Context("Dhcp allocation", func() {
 		var (
 			clusterId, hostId *strfmt.UUID
@@ -1837,6 +1935,19 @@ var _ = Describe("cluster", func() {
 				Expect(actual.Payload.HostNetworks).To(BeEmpty())
 			})
 
+			It("triage summary", func() {
+				mockDurationsSuccess()
+				for _, severity := range []string{models.TriageFindingSeverityCritical, models.TriageFindingSeverityWarning, models.TriageFindingSeverityWarning} {
+					Expect(db.Create(&models.TriageFinding{ID: strfmt.UUID(uuid.New().String()), ClusterID: clusterID, Severity: severity}).Error).ShouldNot(HaveOccurred())
+				}
+				Expect(db.Create(&models.TriageFinding{ID: strfmt.UUID(uuid.New().String()), ClusterID: strfmt.UUID(uuid.New().String()),
+					Severity: models.TriageFindingSeverityCritical}).Error).ShouldNot(HaveOccurred())
+				reply := bm.V2GetCluster(ctx, installer.V2GetClusterParams{ClusterID: clusterID})
+				actual, ok := reply.(*installer.V2GetClusterOK)
+				Expect(ok).To(BeTrue())
+				Expect(actual.Payload.Triage).To(Equal(&models.TriageSummary{FindingCount: 3, CriticalCount: 1}))
+			})
+
 			It("Unfamilliar ID", func() {
 				resp := bm.V2GetCluster(ctx, installer.V2GetClusterParams{ClusterID: "12345"})
 				Expect(resp).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusNotFound, errors.Errorf(""))))
@@ -3645,6 +3756,220 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update Host Role Rules", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindCluster),
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+					},
+					CPUArchitecture: common.DefaultCPUArchitecture,
+					HostRoleRules:   `[{"name":"masters","role":"master","hostname_pattern":"^master-"}]`,
+				}}
+				err := db.Create(cluster).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+			})
+
+			It("Update host role rules success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostRoleRules: []*models.HostRoleRule{
+							{Name: "storage", Role: swag.String(models.HostRoleRuleRoleWorker), MinDiskSizeGb: 960},
+						},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				rules, err := common.UnmarshalHostRoleRules(actual.Payload.HostRoleRules)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(HaveLen(1))
+				Expect(rules[0].Name).To(Equal("storage"))
+				Expect(rules[0].MinDiskSizeGb).To(BeEquivalentTo(960))
+			})
+
+			It("Delete host role rules", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostRoleRules: []*models.HostRoleRule{},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				Expect(actual.Payload.HostRoleRules).To(BeEmpty())
+			})
+
+			It("Update cluster with invalid host role rules", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostRoleRules: []*models.HostRoleRule{
+							{Name: "masters", Role: swag.String(models.HostRoleRuleRoleMaster), HostnamePattern: "master-("},
+						},
+					},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+				verifyApiErrorString(reply, http.StatusBadRequest, "Invalid hostname pattern of host role rule masters")
+			})
+		})
+
+		Context("Update Custom Host Validations", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindCluster),
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+					},
+					CPUArchitecture: common.DefaultCPUArchitecture,
+				}}
+				err := db.Create(cluster).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+			})
+
+			It("Update custom host validations success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						CustomHostValidations: []*models.CustomHostValidation{{
+							ID:          swag.String("custom-min-cpu"),
+							Language:    swag.String(models.CustomHostValidationLanguageCel),
+							Expression:  swag.String("inventory.cpu.count >= 16"),
+							Description: "The hosts must have at least 16 CPU cores",
+						}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				validations, err := common.UnmarshalCustomHostValidations(actual.Payload.CustomHostValidations)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(validations).To(HaveLen(1))
+				Expect(validations[0].Description).To(Equal("The hosts must have at least 16 CPU cores"))
+			})
+
+			It("Update cluster with invalid custom host validations", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						CustomHostValidations: []*models.CustomHostValidation{{
+							ID:         swag.String(string(models.HostValidationIDHasMinCPUCores)),
+							Language:   swag.String(models.CustomHostValidationLanguageCel),
+							Expression: swag.String("inventory.cpu.count >= 16"),
+						}},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "must start with custom-")
+			})
+
+			It("Update host stage timeout policies success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostStageTimeoutPolicies: []*models.HostStageTimeoutPolicy{{
+							Stage:          models.NewHostStage(models.HostStageRebooting),
+							TimeoutSeconds: 1800,
+							Remediation:    models.NewHostStageRemediation(models.HostStageRemediationReboot),
+							MaxAttempts:    2,
+						}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				policies, err := common.UnmarshalHostStageTimeoutPolicies(actual.Payload.HostStageTimeoutPolicies)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policies).To(HaveLen(1))
+				Expect(*policies[0].Remediation).To(Equal(models.HostStageRemediationReboot))
+			})
+
+			It("Update cluster with invalid host stage timeout policies", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostStageTimeoutPolicies: []*models.HostStageTimeoutPolicy{{
+							Stage:       models.NewHostStage(models.HostStageDone),
+							Remediation: models.NewHostStageRemediation(models.HostStageRemediationFail),
+						}},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "can't have a timeout policy")
+			})
+		})
+
+		Context("Update Ignition Override Layers", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindCluster),
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+					},
+					CPUArchitecture:        common.DefaultCPUArchitecture,
+					OpenshiftVersion:       "4.6",
+					IgnitionOverrideLayers: `[{"scope":"cluster","config":"{\"ignition\": {\"version\": \"3.1.0\"}}"}]`,
+				}}
+				err := db.Create(cluster).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+			})
+
+			It("Update ignition override layers success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						IgnitionOverrideLayers: []*models.IgnitionOverrideLayer{{
+							Scope:  swag.String(models.IgnitionOverrideLayerScopeRole),
+							Target: "worker",
+							Config: swag.String(`{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/etc/worker", "contents": {"source": "data:,worker"}}]}}`),
+						}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				layers, err := common.UnmarshalIgnitionOverrideLayers(actual.Payload.IgnitionOverrideLayers)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(layers).To(HaveLen(1))
+				Expect(layers[0].Target).To(Equal("worker"))
+			})
+
+			It("Delete ignition override layers with an empty list", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						IgnitionOverrideLayers: []*models.IgnitionOverrideLayer{},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(reply.(*installer.V2UpdateClusterCreated).Payload.IgnitionOverrideLayers).To(BeEmpty())
+			})
+
+			It("Update cluster with a layer of a spec version unsupported by the OpenShift version", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						IgnitionOverrideLayers: []*models.IgnitionOverrideLayer{{
+							Scope:  swag.String(models.IgnitionOverrideLayerScopeMachinePool),
+							Target: "infra",
+							Config: swag.String(`{"ignition": {"version": "3.2.0"}}`),
+						}},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "invalid ignition override layer machine-pool/infra")
+			})
+		})
+
 		Context("Update Network", func() {
 			var cluster *common.Cluster
 			BeforeEach(func() {
@@ -9011,6 +9336,41 @@ var _ = Describe("infraEnvs", func() {
 				Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
 				Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
 			})
+			It("Update custom host validations", func() {
+				var err error
+				mockInfraEnvUpdateSuccess()
+
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						CustomHostValidations: []*models.CustomHostValidation{{
+							ID:         swag.String("custom-min-cpu"),
+							Language:   swag.String(models.CustomHostValidationLanguageCel),
+							Expression: swag.String("inventory.cpu.count >= 16"),
+						}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				validations, err := common.UnmarshalCustomHostValidations(i.CustomHostValidations)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(validations).To(HaveLen(1))
+				Expect(swag.StringValue(validations[0].ID)).To(Equal("custom-min-cpu"))
+			})
+			It("Update custom host validations - invalid", func() {
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						CustomHostValidations: []*models.CustomHostValidation{{
+							ID:         swag.String("custom-min-cpu"),
+							Language:   swag.String(models.CustomHostValidationLanguageCel),
+							Expression: swag.String("inventory.cpu.count >="),
+						}},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "Invalid expression of custom host validation custom-min-cpu")
+			})
 			It("Update additional trust bundle - empty", func() {
 				var err error
 				mockInfraEnvUpdateSuccess()
@@ -11129,6 +11489,8 @@ var _ = Describe("Upload and Download logs test", func() {
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(1)
 		mockHostApi.EXPECT().SetUploadLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(1)
+		mockLogSearchApi.EXPECT().IndexLogs(gomock.Any(), logsearch.Source{ClusterID: clusterID, HostID: host.ID, LogsType: models.LogsTypeHost}, fileName).Times(1)
+		mockTriageApi.EXPECT().AnalyzeLogs(gomock.Any(), logsearch.Source{ClusterID: clusterID, HostID: host.ID, LogsType: models.LogsTypeHost}, fileName).Times(1)
 		reply := bm.V2UploadLogs(ctx, params)
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2UploadLogsNoContent()))
 	})
@@ -11179,6 +11541,8 @@ var _ = Describe("Upload and Download logs test", func() {
 		mockS3Client.EXPECT().UploadStream(gomock.Any(), gomock.Any(), fileName).Return(nil).Times(2)
 		mockClusterApi.EXPECT().SetUploadControllerLogsAt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 		mockClusterApi.EXPECT().UpdateLogsProgress(gomock.Any(), gomock.Any(), string(models.LogsStateCollecting)).Return(nil).Times(2)
+		mockLogSearchApi.EXPECT().IndexLogs(gomock.Any(), logsearch.Source{ClusterID: clusterID, LogsType: models.LogsTypeController}, fileName).Times(2)
+		mockTriageApi.EXPECT().AnalyzeLogs(gomock.Any(), logsearch.Source{ClusterID: clusterID, LogsType: models.LogsTypeController}, fileName).Times(2)
 		By("Upload cluster logs for the first time")
 		reply := bm.V2UploadLogs(ctx, params)
 		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2UploadLogsNoContent()))
@@ -11571,6 +11935,41 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 		verifyApiError(response, http.StatusBadRequest)
 	})
 
+	It("returns bad request when overriding service owned fields", func() {
+		override := `{"networking": {"networkType": "OVNKubernetes"}, "platform": {"baremetal": {"apiVIPs": ["1.2.3.4"]}}}`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		verifyApiErrorString(response, http.StatusBadRequest, "networking.networkType, platform.baremetal.apiVIPs[0]")
+
+		var updated common.Cluster
+		Expect(db.First(&updated, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(updated.InstallConfigOverrides).To(BeEmpty())
+	})
+
+	It("saves service owned fields when allowed", func() {
+		override := `{"networking": {"networkType": "OVNKubernetes"}}`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:               clusterID,
+			InstallConfigParams:     override,
+			AllowServiceOwnedFields: swag.Bool(true),
+		}
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
+			eventstest.WithClusterIdMatcher(params.ClusterID.String())))
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any(), params.InstallConfigParams).Return(nil).Times(1)
+		mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+		mockUsageReports()
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
+
+		var updated common.Cluster
+		Expect(db.First(&updated, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(updated.InstallConfigOverrides).To(Equal(override))
+	})
+
 	It("updates the install config overrides feature usage", func() {
 		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
 		params := installer.V2UpdateClusterInstallConfigParams{
@@ -11715,6 +12114,192 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 	})
 })
 
+var _ = Describe("PreviewClusterInstallConfig", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+		preview   *models.InstallConfigPreview
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:                     &clusterID,
+				OpenshiftVersion:       common.TestDefaultConfig.OpenShiftVersion,
+				InstallConfigOverrides: `{"fips": true}`,
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		preview = &models.InstallConfigPreview{
+			InstallConfig: swag.String("fips: true\n"),
+			Diff:          swag.String("+fips: true\n"),
+			ChangedFields: []string{"fips"},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("previews the overrides of the cluster without params", func() {
+		params := installer.V2PreviewClusterInstallConfigParams{ClusterID: clusterID}
+		mockInstallConfigBuilder.EXPECT().PreviewInstallConfig(gomock.Any(), gomock.Any(), `{"fips": true}`).Return(preview, nil).Times(1)
+		response := bm.V2PreviewClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2PreviewClusterInstallConfigOK{}))
+		Expect(response.(*installer.V2PreviewClusterInstallConfigOK).Payload).To(Equal(preview))
+	})
+
+	It("previews the given overrides without saving them", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+		params := installer.V2PreviewClusterInstallConfigParams{
+			ClusterID:     clusterID,
+			PreviewParams: &models.InstallConfigPreviewParams{InstallConfigParams: swag.String(override)},
+		}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any(), override).Return(nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().PreviewInstallConfig(gomock.Any(), gomock.Any(), override).Return(preview, nil).Times(1)
+		response := bm.V2PreviewClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2PreviewClusterInstallConfigOK{}))
+
+		var cluster common.Cluster
+		Expect(db.First(&cluster, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(cluster.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+	})
+
+	It("returns bad request when validation fails", func() {
+		override := `{"foo": "bar"}`
+		params := installer.V2PreviewClusterInstallConfigParams{
+			ClusterID:     clusterID,
+			PreviewParams: &models.InstallConfigPreviewParams{InstallConfigParams: swag.String(override)},
+		}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any(), override).Return(fmt.Errorf("foo: unknown field")).Times(1)
+		response := bm.V2PreviewClusterInstallConfig(ctx, params)
+		verifyApiErrorString(response, http.StatusBadRequest, "foo: unknown field")
+	})
+
+	It("returns not found with a non-existant cluster", func() {
+		params := installer.V2PreviewClusterInstallConfigParams{ClusterID: strfmt.UUID(uuid.New().String())}
+		response := bm.V2PreviewClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("returns internal server error when the preview fails", func() {
+		params := installer.V2PreviewClusterInstallConfigParams{ClusterID: clusterID}
+		mockInstallConfigBuilder.EXPECT().PreviewInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("some error")).Times(1)
+		response := bm.V2PreviewClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusInternalServerError)
+	})
+})
+
+var _ = Describe("V2GetHostIgnitionPreview", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				IgnitionOverrideLayers: `[{"scope":"cluster","config":"{\"ignition\": {\"version\": \"3.2.0\"}, \"storage\": {\"files\": [{\"path\": \"/etc/cluster\", \"contents\": {\"source\": \"data:,cluster\"}}]}}"},` +
+					`{"scope":"role","target":"master","config":"{\"ignition\": {\"version\": \"3.2.0\"}, \"systemd\": {\"units\": [{\"name\": \"master.service\", \"enabled\": true}]}}"}]`,
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		createInfraEnv(db, infraEnvID, clusterID)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	provenance := func(entries []*models.IgnitionProvenance) map[string]string {
+		ret := make(map[string]string)
+		for _, entry := range entries {
+			ret[swag.StringValue(entry.Name)] = swag.StringValue(entry.Layer)
+		}
+		return ret
+	}
+
+	getPreview := func() *models.HostIgnitionPreview {
+		response := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2GetHostIgnitionPreviewOK{}))
+		return response.(*installer.V2GetHostIgnitionPreviewOK).Payload
+	}
+
+	It("renders the layers on top of the generated pointer ignition of a day1 host", func() {
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID,
+			getInventoryStr("master-0", "bootMode", "1.2.3.4/24"), db)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/master.ign", clusterID)).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/master.ign", clusterID)).
+			Return(io.NopCloser(strings.NewReader(`{"ignition": {"version": "3.2.0", "config": {"merge": [{"source": "https://api-int:22623/config/master"}]}}}`)), int64(0), nil).Times(1)
+
+		preview := getPreview()
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseGenerated))
+		Expect(swag.StringValue(preview.IgnitionType)).To(Equal(models.HostIgnitionPreviewIgnitionTypePointer))
+		Expect(preview.Layers).To(Equal([]string{"cluster", "role/master"}))
+		Expect(provenance(preview.Files)).To(Equal(map[string]string{"/etc/hostname": ignition.OverrideLayerBase, "/etc/cluster": "cluster"}))
+		Expect(provenance(preview.Units)).To(Equal(map[string]string{"master.service": "role/master"}))
+	})
+
+	It("renders the layers on top of an empty ignition before the installation files are generated", func() {
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID,
+			getInventoryStr("worker-0", "bootMode", "1.2.3.4/24"), db)
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/worker.ign", clusterID)).Return(false, nil).Times(1)
+
+		preview := getPreview()
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseNone))
+		Expect(preview.Layers).To(Equal([]string{"cluster"}))
+		Expect(provenance(preview.Files)).To(Equal(map[string]string{"/etc/cluster": "cluster"}))
+	})
+
+	It("renders the full ignition of a day2 host without the ignition endpoint token", func() {
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindAddToExistingClusterHost, infraEnvID, clusterID,
+			getInventoryStr("worker-0", "bootMode", "1.2.3.4/24"), db)
+		Expect(db.Model(&common.Host{}).Where("id = ?", hostID).Update("ignition_endpoint_token", "secret").Error).ShouldNot(HaveOccurred())
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), "", gomock.Any(), gomock.Any(), nil).
+			Return([]byte(`{"ignition": {"version": "3.2.0"}}`), nil).Times(1)
+
+		preview := getPreview()
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseTemplate))
+		Expect(swag.StringValue(preview.IgnitionType)).To(Equal(models.HostIgnitionPreviewIgnitionTypeFull))
+		Expect(provenance(preview.Files)).To(Equal(map[string]string{"/etc/hostname": ignition.OverrideLayerBase, "/etc/cluster": "cluster"}))
+		Expect(swag.StringValue(preview.Ignition)).NotTo(ContainSubstring("secret"))
+	})
+
+	It("returns conflict for an unbound host", func() {
+		host := models.Host{ID: &hostID, InfraEnvID: infraEnvID, Kind: swag.String(models.HostKindHost), Status: swag.String(models.HostStatusKnownUnbound)}
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		response := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiErrorString(response, http.StatusConflict, "isn't bound to a cluster")
+	})
+
+	It("returns not found with a non-existant host", func() {
+		response := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("V2DownloadInfraEnvFiles", func() {
 	var (
 		bm           *bareMetalInventory
@@ -12733,6 +13318,83 @@ var _ = Describe("Reset Host test", func() {
 	})
 })
 
+var _ = Describe("Decommission Host test", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Kind:             swag.String(models.ClusterKindCluster),
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(models.ClusterStatusInstalled),
+		}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleWorker, models.HostStatusInstalled, models.HostKindHost, clusterID, clusterID, getInventoryStr("hostname0", "bootMode", "1.2.3.4/24", "10.11.50.90/16"), db)
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("decommissions the host", func() {
+		mockDecommissionApi.EXPECT().DecommissionHost(gomock.Any(), gomock.Any(), true).
+			DoAndReturn(func(ctx context.Context, h *models.Host, wipeDisks bool) error {
+				Expect(*h.ID).To(Equal(hostID))
+				return db.Model(h).Update("decommission_stage", models.HostDecommissionStageDraining).Error
+			}).Times(1)
+		mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		res := bm.V2DecommissionHost(ctx, installer.V2DecommissionHostParams{
+			ClusterID:          clusterID,
+			HostID:             hostID,
+			DecommissionParams: &models.DecommissionParams{WipeDisks: swag.Bool(true)},
+		})
+		Expect(res).Should(BeAssignableToTypeOf(installer.NewV2DecommissionHostAccepted()))
+		Expect(res.(*installer.V2DecommissionHostAccepted).Payload.DecommissionStage).To(Equal(models.HostDecommissionStageDraining))
+	})
+
+	It("doesn't wipe the disks by default", func() {
+		mockDecommissionApi.EXPECT().DecommissionHost(gomock.Any(), gomock.Any(), false).Return(nil).Times(1)
+		mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		res := bm.V2DecommissionHost(ctx, installer.V2DecommissionHostParams{
+			ClusterID: clusterID,
+			HostID:    hostID,
+		})
+		Expect(res).Should(BeAssignableToTypeOf(installer.NewV2DecommissionHostAccepted()))
+	})
+
+	It("host not found", func() {
+		res := bm.V2DecommissionHost(ctx, installer.V2DecommissionHostParams{
+			ClusterID: clusterID,
+			HostID:    strfmt.UUID(uuid.New().String()),
+		})
+		verifyApiError(res, http.StatusNotFound)
+	})
+
+	It("decommission rejected", func() {
+		mockDecommissionApi.EXPECT().DecommissionHost(gomock.Any(), gomock.Any(), false).
+			Return(common.NewApiError(http.StatusConflict, errors.New("host is already being decommissioned"))).Times(1)
+		res := bm.V2DecommissionHost(ctx, installer.V2DecommissionHostParams{
+			ClusterID: clusterID,
+			HostID:    hostID,
+		})
+		verifyApiError(res, http.StatusConflict)
+	})
+})
+
 var _ = Describe("Install Host test", func() {
 	var (
 		bm         *bareMetalInventory
@@ -12778,7 +13440,7 @@ var _ = Describe("Install Host test", func() {
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().Install(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
 		res := bm.V2InstallHost(ctx, params)
 		Expect(res).Should(BeAssignableToTypeOf(installer.NewV2InstallHostAccepted()))
 	})
@@ -12796,7 +13458,7 @@ var _ = Describe("Install Host test", func() {
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().Install(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile("http://example.com/worker", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile("http://example.com/worker", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
 		res := bm.V2InstallHost(ctx, params)
 		Expect(res).Should(BeAssignableToTypeOf(installer.NewV2InstallHostAccepted()))
 	})
@@ -12844,7 +13506,7 @@ var _ = Describe("Install Host test", func() {
 		mockHostApi.EXPECT().AutoAssignRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("some error")).Times(0)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("ign failure")).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("ign failure")).Times(1)
 		res := bm.V2InstallHost(ctx, params)
 		verifyApiError(res, http.StatusInternalServerError)
 	})
@@ -12859,7 +13521,7 @@ var _ = Describe("Install Host test", func() {
 		mockHostApi.EXPECT().AutoAssignRole(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("some error")).Times(1)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
 		res := bm.V2InstallHost(ctx, params)
 		verifyApiError(res, http.StatusInternalServerError)
 	})
@@ -12908,7 +13570,7 @@ var _ = Describe("InstallSingleDay2Host test", func() {
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().Install(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
 		res := bm.InstallSingleDay2HostInternal(ctx, clusterID, clusterID, hostId)
 		Expect(res).Should(BeNil())
 	})
@@ -12921,7 +13583,7 @@ var _ = Describe("InstallSingleDay2Host test", func() {
 		mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockHostApi.EXPECT().Install(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(expectedErrMsg)).Times(1)
 		mockS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
+		mockIgnitionBuilder.EXPECT().FormatSecondDayWorkerIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(secondDayWorkerIgnition, nil).Times(1)
 		res := bm.InstallSingleDay2HostInternal(ctx, clusterID, clusterID, hostId)
 		Expect(res.Error()).Should(Equal(expectedErrMsg))
 	})
@@ -14961,6 +15623,125 @@ var _ = Describe("TestRegisterCluster", func() {
 		})
 	})
 
+	Context("Host Role Rules", func() {
+		It("Register cluster with host role rules", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.HostRoleRules = []*models.HostRoleRule{
+				{Name: "masters", Role: swag.String(models.HostRoleRuleRoleMaster), HostnamePattern: "^master-"},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			rules, err := common.UnmarshalHostRoleRules(actual.Payload.HostRoleRules)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(Equal(params.HostRoleRules))
+		})
+
+		It("Register cluster with invalid host role rules", func() {
+			params := getDefaultClusterCreateParams()
+			params.HostRoleRules = []*models.HostRoleRule{
+				{Role: swag.String(models.HostRoleRuleRoleWorker), InstallationDiskPattern: "[nvme"},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+			verifyApiErrorString(reply, http.StatusBadRequest, "Invalid installation disk pattern of host role rule #1")
+		})
+	})
+
+	Context("Custom Host Validations", func() {
+		It("Register cluster with custom host validations", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.CustomHostValidations = []*models.CustomHostValidation{{
+				ID:         swag.String("custom-intel-nic"),
+				Language:   swag.String(models.CustomHostValidationLanguageJsonpath),
+				Expression: swag.String(`{.interfaces[?(@.vendor=="0x8086")]}`),
+			}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			validations, err := common.UnmarshalCustomHostValidations(actual.Payload.CustomHostValidations)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validations).To(Equal(params.CustomHostValidations))
+		})
+	})
+
+	Context("Host Stage Timeout Policies", func() {
+		It("Register cluster with host stage timeout policies", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.HostStageTimeoutPolicies = []*models.HostStageTimeoutPolicy{{
+				Stage:       models.NewHostStage(models.HostStageConfiguring),
+				Remediation: models.NewHostStageRemediation(models.HostStageRemediationPause),
+			}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			policies, err := common.UnmarshalHostStageTimeoutPolicies(actual.Payload.HostStageTimeoutPolicies)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies).To(Equal(params.HostStageTimeoutPolicies))
+		})
+
+		It("Register cluster with two policies of the same stage", func() {
+			params := getDefaultClusterCreateParams()
+			params.HostStageTimeoutPolicies = []*models.HostStageTimeoutPolicy{
+				{Stage: models.NewHostStage(models.HostStageConfiguring), Remediation: models.NewHostStageRemediation(models.HostStageRemediationPause)},
+				{Stage: models.NewHostStage(models.HostStageConfiguring), Remediation: models.NewHostStageRemediation(models.HostStageRemediationFail)},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "more than one timeout policy")
+		})
+	})
+
+	Context("Ignition Override Layers", func() {
+		It("Register cluster with ignition override layers", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.IgnitionOverrideLayers = []*models.IgnitionOverrideLayer{
+				{Scope: swag.String(models.IgnitionOverrideLayerScopeCluster), Config: swag.String(`{"ignition": {"version": "3.2.0"}}`)},
+				{Scope: swag.String(models.IgnitionOverrideLayerScopeRole), Target: "master", Config: swag.String(`{"ignition": {"version": "3.2.0"}}`)},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			layers, err := common.UnmarshalIgnitionOverrideLayers(actual.Payload.IgnitionOverrideLayers)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(layers).To(Equal(params.IgnitionOverrideLayers))
+		})
+
+		It("Register cluster with a role layer of an unknown role", func() {
+			params := getDefaultClusterCreateParams()
+			params.IgnitionOverrideLayers = []*models.IgnitionOverrideLayer{
+				{Scope: swag.String(models.IgnitionOverrideLayerScopeRole), Target: "storage", Config: swag.String(`{"ignition": {"version": "3.2.0"}}`)},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "the target of a role layer must be one of master, worker")
+		})
+	})
+
 	Context("Networking", func() {
 		var (
 			clusterNetworks = common.TestIPv4Networking.ClusterNetworks
@@ -15852,6 +16633,18 @@ var _ = Describe("V2UpdateHostIgnition", func() {
 		verifyApiError(response, http.StatusBadRequest)
 	})
 
+	It("returns bad request when provided a version unsupported by the OpenShift version of the cluster", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("openshift_version", "4.6").Error).ShouldNot(HaveOccurred())
+		override := `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
+		params := installer.V2UpdateHostIgnitionParams{
+			InfraEnvID:         infraEnvID,
+			HostID:             hostID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: override},
+		}
+		response := bm.V2UpdateHostIgnition(ctx, params)
+		verifyApiErrorString(response, http.StatusBadRequest, "ignition spec version 3.2.0 isn't supported by OpenShift 4.6")
+	})
+
 	It("sets the feature usage when given a valid ignition config override to the host", func() {
 		override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
 		params := installer.V2UpdateHostIgnitionParams{
@@ -17088,17 +17881,20 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockOperatorManager = operators.NewMockAPI(ctrl)
 	mockIgnitionBuilder = ignition.NewMockIgnitionBuilder(ctrl)
 	mockProviderRegistry = registry.NewMockProviderRegistry(ctrl)
+	mockDecommissionApi = decommission.NewMockAPI(ctrl)
+	mockLogSearchApi = logsearch.NewMockAPI(ctrl)
+	mockTriageApi = triage.NewMockAPI(ctrl)
 	mockInstallConfigBuilder = installcfg_builder.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
-	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
+	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, dns.ZoneFilesConfig{}, nil, common.GetTestLog())
 	gcConfig := garbagecollector.Config{DeregisterInactiveAfter: 20 * 24 * time.Hour}
 
 	bm := NewBareMetalInventory(db, mockStream, common.GetTestLog(), mockHostApi, mockClusterApi, mockInfraEnvApi, cfg,
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockOSImages, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder,
-		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true)
+		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, mockDecommissionApi, mockLogSearchApi, mockTriageApi, true)
 
 	bm.ImageServiceBaseURL = imageServiceBaseURL
 	bm.ServiceBaseURL = serviceBaseURL
@@ -21,6 +21,7 @@ import (
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/imageservice"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
@@ -81,9 +82,26 @@ func (b *bareMetalInventory) V2GetCluster(ctx context.Context, params installer.
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
+	// Only the single cluster gets the summary of its triage findings, which are listed by v2ListClusterTriageFindings
+	if c.Triage, err = b.getTriageSummary(*c.ID); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("failed to count the triage findings of cluster %s", c.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
 	return installer.NewV2GetClusterOK().WithPayload(&c.Cluster)
 }
 
+// getTriageSummary counts the known failures found by the triage of the logs uploaded for the cluster
+func (b *bareMetalInventory) getTriageSummary(clusterID strfmt.UUID) (*models.TriageSummary, error) {
+	var summary models.TriageSummary
+	err := b.db.Model(&models.TriageFinding{}).
+		Select("COUNT(*) AS finding_count, COUNT(*) FILTER (WHERE severity = ?) AS critical_count", models.TriageFindingSeverityCritical).
+		Where("cluster_id = ?", clusterID.String()).Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
 func (b *bareMetalInventory) V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
@@ -151,6 +169,14 @@ func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, p
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
 
+func (b *bareMetalInventory) V2PreviewClusterInstallConfig(ctx context.Context, params installer.V2PreviewClusterInstallConfigParams) middleware.Responder {
+	preview, err := b.PreviewClusterInstallConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2PreviewClusterInstallConfigOK().WithPayload(preview)
+}
+
 func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder {
 	cluster, err := b.InstallClusterInternal(ctx, params)
 	if err != nil {
@@ -478,6 +504,10 @@ func (b *bareMetalInventory) v2uploadLogs(ctx context.Context, params installer.
 		if err != nil {
 			return err
 		}
+		source := logsearch.Source{ClusterID: params.ClusterID, HostID: params.HostID, LogsType: models.LogsTypeHost}
+		fileName := b.getLogsFullName(params.ClusterID.String(), params.HostID.String())
+		b.logSearchApi.IndexLogs(ctx, source, fileName)
+		b.triageApi.AnalyzeLogs(ctx, source, fileName)
 
 		if params.LogsType == string(models.LogsTypeHost) {
 			eventgen.SendHostLogsUploadedEvent(ctx, b.eventsHandler, *params.HostID, dbHost.InfraEnvID, common.StrFmtUUIDPtr(params.ClusterID),
@@ -500,6 +530,9 @@ func (b *bareMetalInventory) v2uploadLogs(ctx context.Context, params installer.
 		log.WithError(err).Errorf("Failed to upload %s to s3", fileName)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	source := logsearch.Source{ClusterID: params.ClusterID, LogsType: models.LogsType(params.LogsType)}
+	b.logSearchApi.IndexLogs(ctx, source, fileName)
+	b.triageApi.AnalyzeLogs(ctx, source, fileName)
 	if params.LogsType == string(models.LogsTypeController) {
 		firstClusterLogCollectionEvent := false
 		if time.Time(currentCluster.ControllerLogsCollectedAt).Equal(time.Time{}) {
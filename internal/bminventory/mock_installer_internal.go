@@ -308,6 +308,21 @@ func (mr *MockInstallerInternalsMockRecorder) InstallSingleDay2HostInternal(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallSingleDay2HostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).InstallSingleDay2HostInternal), arg0, arg1, arg2, arg3)
 }
 
+// PreviewClusterInstallConfigInternal mocks base method.
+func (m *MockInstallerInternals) PreviewClusterInstallConfigInternal(arg0 context.Context, arg1 installer.V2PreviewClusterInstallConfigParams) (*models.InstallConfigPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewClusterInstallConfigInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.InstallConfigPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewClusterInstallConfigInternal indicates an expected call of PreviewClusterInstallConfigInternal.
+func (mr *MockInstallerInternalsMockRecorder) PreviewClusterInstallConfigInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewClusterInstallConfigInternal", reflect.TypeOf((*MockInstallerInternals)(nil).PreviewClusterInstallConfigInternal), arg0, arg1)
+}
+
 // RegisterClusterInternal mocks base method.
 func (m *MockInstallerInternals) RegisterClusterInternal(arg0 context.Context, arg1 *types.NamespacedName, arg2 installer.V2RegisterClusterParams) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +427,20 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateHostApprovedInternal(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostApprovedInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateHostApprovedInternal), arg0, arg1, arg2, arg3)
 }
 
+// UpdateHostBareMetalHostInternal mocks base method.
+func (m *MockInstallerInternals) UpdateHostBareMetalHostInternal(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHostBareMetalHostInternal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHostBareMetalHostInternal indicates an expected call of UpdateHostBareMetalHostInternal.
+func (mr *MockInstallerInternalsMockRecorder) UpdateHostBareMetalHostInternal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostBareMetalHostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateHostBareMetalHostInternal), arg0, arg1, arg2, arg3)
+}
+
 // UpdateInfraEnvInternal mocks base method.
 func (m *MockInstallerInternals) UpdateInfraEnvInternal(arg0 context.Context, arg1 installer.UpdateInfraEnvParams, arg2 *string) (*common.InfraEnv, error) {
 	m.ctrl.T.Helper()
@@ -427,6 +456,21 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateInfraEnvInternal(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnvInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateInfraEnvInternal), arg0, arg1, arg2)
 }
 
+// V2DecommissionHostInternal mocks base method.
+func (m *MockInstallerInternals) V2DecommissionHostInternal(arg0 context.Context, arg1 installer.V2DecommissionHostParams) (*common.Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DecommissionHostInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// V2DecommissionHostInternal indicates an expected call of V2DecommissionHostInternal.
+func (mr *MockInstallerInternalsMockRecorder) V2DecommissionHostInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DecommissionHostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).V2DecommissionHostInternal), arg0, arg1)
+}
+
 // V2DeregisterHostInternal mocks base method.
 func (m *MockInstallerInternals) V2DeregisterHostInternal(arg0 context.Context, arg1 installer.V2DeregisterHostParams, arg2 Interactivity) error {
 	m.ctrl.T.Helper()
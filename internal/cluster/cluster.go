@@ -41,6 +41,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/shard"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -148,6 +149,12 @@ type Config struct {
 	InstallationTimeout time.Duration `envconfig:"INSTALLATION_TIMEOUT" default:"24h"`
 	FinalizingTimeout   time.Duration `envconfig:"FINALIZING_TIMEOUT" default:"5h"`
 	MonitorBatchSize    int           `envconfig:"CLUSTER_MONITOR_BATCH_SIZE" default:"100"`
+	// EnableMtuPathCheck enables the path MTU validation, measured by the agents implementing the mtu-path-check step
+	EnableMtuPathCheck bool `envconfig:"ENABLE_MTU_PATH_CHECK" default:"false"`
+
+	// MonitorSharder partitions the monitored clusters between the replicas. When not set, the leader monitors all
+	// the clusters.
+	MonitorSharder shard.Sharder `ignored:"true"`
 }
 
 type Manager struct {
@@ -199,7 +206,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 		sm:                    NewClusterStateMachine(th),
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, cfg.EnableMtuPathCheck),
 		hostAPI:               hostAPI,
 		leaderElector:         leaderElector,
 		prevMonitorInvokedAt:  time.Now(),
@@ -570,11 +577,23 @@ func (m *Manager) initMonitorQueryGenerator() {
 			return dbWithCondition
 		}
 		m.monitorQueryGenerator = common.NewMonitorQueryGenerator(m.db, buildInitialQuery, m.MonitorBatchSize)
+		if m.MonitorSharder != nil {
+			m.monitorQueryGenerator.WithFilter(m.MonitorSharder.Owns)
+		}
 	}
 }
 
+// shouldMonitor tells whether this replica monitors the cluster, which is the case of the leader unless the
+// monitoring is sharded between the replicas
+func (m *Manager) shouldMonitor(clusterID *strfmt.UUID) bool {
+	if m.MonitorSharder != nil {
+		return m.MonitorSharder.Owns(clusterID.String())
+	}
+	return m.leaderElector.IsLeader()
+}
+
 func (m *Manager) ClusterMonitoring() {
-	if !m.leaderElector.IsLeader() {
+	if m.MonitorSharder == nil && !m.leaderElector.IsLeader() {
 		m.log.Debugf("Not a leader, exiting ClusterMonitoring")
 		return
 	}
@@ -614,9 +633,13 @@ func (m *Manager) ClusterMonitoring() {
 		}
 		m.log.Debugf("We are going to monitor %d, query is: %+v", len(clusters), query)
 		for _, cluster := range clusters {
-			if !m.leaderElector.IsLeader() {
-				m.log.Debugf("Not a leader, exiting ClusterMonitoring")
-				return
+			if !m.shouldMonitor(cluster.ID) {
+				if m.MonitorSharder == nil {
+					m.log.Debugf("Not a leader, exiting ClusterMonitoring")
+					return
+				}
+				// The shards were rebalanced since the query
+				continue
 			}
 			if !m.SkipMonitoring(cluster) {
 				monitored += 1
@@ -648,6 +671,9 @@ func (m *Manager) ClusterMonitoring() {
 	}
 	m.log.Debugf("Monitored %d clusters", monitored)
 	m.metricAPI.MonitoredClusterCount(monitored)
+	if m.MonitorSharder != nil {
+		m.metricAPI.MonitoredShardCount(m.MonitorSharder.Name(), "clusters", monitored)
+	}
 }
 
 func CanDownloadFiles(c *common.Cluster) (err error) {
@@ -1501,7 +1527,11 @@ func (m *Manager) ResetClusterFiles(ctx context.Context, c *common.Cluster, obje
 }
 
 func (m *Manager) DeleteClusterLogs(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
-	return m.deleteClusterFilesInFolder(ctx, c, "logs", objectHandler)
+	if err := m.deleteClusterFilesInFolder(ctx, c, "logs", objectHandler); err != nil {
+		return err
+	}
+	// The findings of the triage of the deleted logs are stale
+	return common.DeleteRecordsByClusterID(m.db, *c.ID, []interface{}{&models.TriageFinding{}})
 }
 
 func (m *Manager) deleteAllClusterFiles(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
@@ -1588,6 +1618,7 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
+			&models.TriageFinding{},
 		}
 		for _, model := range modelsToDelete {
 			if err := common.DeleteRecordsByClusterID(m.db.Unscoped(), *c.ID, []interface{}{model}); err != nil {
@@ -1614,31 +1645,36 @@ func (m *Manager) GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster
 }
 
 func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *common.Cluster) error {
-	log := logutil.FromContext(ctx, m.log)
-	if err := m.manifestsGeneratorAPI.AddChronyManifest(ctx, log, cluster); err != nil {
+	return GenerateAdditionalManifests(ctx, logutil.FromContext(ctx, m.log), cluster, m.manifestsGeneratorAPI, m.rp.operatorsAPI)
+}
+
+// GenerateAdditionalManifests adds the manifests that the service generates on top of the custom ones of the cluster
+func GenerateAdditionalManifests(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	manifestsGeneratorAPI network.ManifestsGeneratorAPI, operatorsAPI operators.API) error {
+	if err := manifestsGeneratorAPI.AddChronyManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add chrony manifest")
 	}
 
-	if common.IsSingleNodeCluster(cluster) && m.manifestsGeneratorAPI.IsSNODNSMasqEnabled() {
-		if err := m.manifestsGeneratorAPI.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
+	if common.IsSingleNodeCluster(cluster) && manifestsGeneratorAPI.IsSNODNSMasqEnabled() {
+		if err := manifestsGeneratorAPI.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add dnsmasq manifest")
 		}
 	}
 
-	if err := m.rp.operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
+	if err := operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
 		return errors.Wrap(err, "failed to add operator manifests")
 	}
-	if err := m.manifestsGeneratorAPI.AddTelemeterManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGeneratorAPI.AddTelemeterManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add telemeter manifest")
 	}
 
 	if common.AreMastersSchedulable(cluster) {
-		if err := m.manifestsGeneratorAPI.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
+		if err := manifestsGeneratorAPI.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add schedulable masters manifest")
 		}
 	}
 
-	if err := m.manifestsGeneratorAPI.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGeneratorAPI.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add disk encryption manifest")
 	}
 
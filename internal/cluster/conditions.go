@@ -13,12 +13,13 @@ type condition struct {
 }
 
 const (
-	VipDhcpAllocationSet         = conditionId("vip-dhcp-allocation-set")
-	AllHostsPreparedSuccessfully = conditionId("all-hosts-prepared-successfully")
-	UnPreparingtHostsExist       = conditionId("unpreparing-hosts-exist")
-	FailedPreparingtHostsExist   = conditionId("failed-preparing-hosts-exist")
-	ClusterPreparationSucceeded  = conditionId("cluster-preparation-succeeded")
-	ClusterPreparationFailed     = conditionId("cluster-preparation-failed")
+	VipDhcpAllocationSet              = conditionId("vip-dhcp-allocation-set")
+	AllHostsPreparedSuccessfully      = conditionId("all-hosts-prepared-successfully")
+	UnPreparingtHostsExist            = conditionId("unpreparing-hosts-exist")
+	FailedPreparingtHostsExist        = conditionId("failed-preparing-hosts-exist")
+	ClusterPreparationSucceeded       = conditionId("cluster-preparation-succeeded")
+	ClusterPreparationFailed          = conditionId("cluster-preparation-failed")
+	AllOperatorsRequirementsSatisfied = conditionId("all-operators-requirements-satisfied")
 )
 
 func (c conditionId) String() string {
@@ -68,7 +68,9 @@ func finalizingStageDefaultTimeout(stage models.FinalizingStage, log logrus.Fiel
 	return generalWaitTimeout
 }
 
-func finalizingStageTimeout(stage models.FinalizingStage, operators []*models.MonitoredOperator, log logrus.FieldLogger) time.Duration {
+// FinalizingStageTimeout returns the timeout of the finalizing stage. The stages waiting for the OLM operators last
+// at least the longest timeout of the operators.
+func FinalizingStageTimeout(stage models.FinalizingStage, operators []*models.MonitoredOperator, log logrus.FieldLogger) time.Duration {
 	timeout := finalizingStageDefaultTimeout(stage, log)
 	if funk.Contains([]models.FinalizingStage{models.FinalizingStageWaitingForOlmOperatorsCsvInitialization, models.FinalizingStageWaitingForOlmOperatorsCsv}, stage) {
 		timeoutSeconds := timeout.Seconds()
@@ -21,7 +21,7 @@ var _ = DescribeTable("finalizing stage timeouts",
 				_ = os.Unsetenv(envKey)
 			}()
 		}
-		Expect(finalizingStageTimeout(stage, operators, logrus.New())).To(Equal(expected))
+		Expect(FinalizingStageTimeout(stage, operators, logrus.New())).To(Equal(expected))
 	},
 	func() []TableEntry {
 		// Variables for test setup
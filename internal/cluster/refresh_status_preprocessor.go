@@ -36,10 +36,11 @@ type refreshPreprocessor struct {
 	operatorsAPI operators.API
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, enableMtuPathCheck bool) *refreshPreprocessor {
 	v := clusterValidator{
-		log:     log,
-		hostAPI: hostAPI,
+		log:                log,
+		hostAPI:            hostAPI,
+		enableMtuPathCheck: enableMtuPathCheck,
 	}
 
 	return &refreshPreprocessor{
@@ -121,6 +122,12 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 			}
 		}
 	}
+	stateMachineInput[AllOperatorsRequirementsSatisfied.String()] = true
+	for _, v := range validationsOutput[operatorsValidationsCategory] {
+		if !stateMachineInput[string(v.ID)] {
+			stateMachineInput[AllOperatorsRequirementsSatisfied.String()] = false
+		}
+	}
 	return stateMachineInput, validationsOutput, nil
 }
 
@@ -201,6 +208,10 @@ func newValidations(v *clusterValidator) []validation {
 			id:        NetworksSameAddressFamilies,
 			condition: v.isNetworksSameAddressFamilies,
 		},
+		{
+			id:        SufficientPathMtu,
+			condition: v.sufficientPathMtu,
+		},
 		{
 			id:        PlatformRequirementsSatisfied,
 			condition: v.platformRequirementsSatisfied,
@@ -43,6 +43,7 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			logrus.New(),
 			mockHostApi,
 			mockOperatorManager,
+			true,
 		)
 	})
 
@@ -89,6 +89,8 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
+			&models.DryRunGenerateReport{},
+			&models.HostClaim{},
 		}); err != nil {
 			return errors.Errorf("failed to delete cluster records %s", cluster.ID)
 		}
@@ -100,6 +102,10 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 		var infraEnv *common.InfraEnv
 		infraEnv, err = common.GetInfraEnvFromDB(tx, *cluster.ID)
 		if err == nil {
+			// The BMC hosts hold the BMC credentials of the hosts of the infraEnv, they are deleted with it
+			if err = tx.Where("infra_env_id = ?", infraEnv.ID.String()).Delete(&common.BmcHost{}).Error; err != nil {
+				return errors.Errorf("failed to delete the BMC hosts of infra-env %s", cluster.ID)
+			}
 			if err = tx.Delete(infraEnv).Error; err != nil {
 				return errors.Errorf("failed to delete infra-env %s", cluster.ID)
 			}
@@ -93,6 +93,11 @@ var _ = Describe("registrar", func() {
 		})
 
 		It("unregister a registered cluster", func() {
+			Expect(db.Create(&models.HostClaim{
+				ClusterID: cluster.ID,
+				Spec:      &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{strfmt.UUID(uuid.New().String())}},
+				Status:    swag.String(models.HostClaimStatusPending),
+			}).Error).ShouldNot(HaveOccurred())
 			updateErr = registerManager.DeregisterCluster(ctx, &cluster)
 			Expect(updateErr).Should(BeNil())
 
@@ -105,6 +110,27 @@ var _ = Describe("registrar", func() {
 			Expect(db.First(&models.ClusterNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
 			Expect(db.First(&models.ServiceNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
 			Expect(db.First(&models.MachineNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
+			Expect(db.First(&models.HostClaim{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
+		})
+
+		It("deletes the BMC hosts of the infra-env of the cluster", func() {
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: cluster.ID, ClusterID: *cluster.ID}}).Error).ShouldNot(HaveOccurred())
+			bmcHostID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.BmcHost{BmcHost: models.BmcHost{
+				ID:         &bmcHostID,
+				InfraEnvID: cluster.ID,
+				Address:    swag.String("redfish://192.168.111.1/redfish/v1/Systems/1"),
+				Username:   swag.String("admin"),
+			}}).Error).ShouldNot(HaveOccurred())
+
+			updateErr = registerManager.DeregisterCluster(ctx, &cluster)
+			Expect(updateErr).Should(BeNil())
+
+			var count int64
+			Expect(db.Model(&common.BmcHost{}).Where("infra_env_id = ?", cluster.ID.String()).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+			_, err := common.GetInfraEnvFromDB(db, *cluster.ID)
+			Expect(err).Should(HaveOccurred())
 		})
 
 		It("unregister a cluster in installing state", func() {
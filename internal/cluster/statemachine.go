@@ -108,8 +108,10 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(IsCnvRequirementsSatisfied),
 		If(IsLvmRequirementsSatisfied),
 		If(IsMceRequirementsSatisfied),
+		If(AllOperatorsRequirementsSatisfied),
 		If(isNetworkTypeValid),
 		If(NetworksSameAddressFamilies),
+		If(SufficientPathMtu),
 	)
 
 	// Refresh cluster status conditions - Non DHCP
@@ -648,7 +648,7 @@ func FinalizingStage(sCluster *stateCluster) interface{} {
 }
 
 func (th *transitionHandler) finalizingStageTimeoutMinutes(sCluster *stateCluster) int64 {
-	return int64(finalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.log).Minutes())
+	return int64(FinalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.log).Minutes())
 }
 
 func (th *transitionHandler) FinalizingStageTimeoutMinutes(sCluster *stateCluster) interface{} {
@@ -691,7 +691,7 @@ func (th *transitionHandler) IsFinalizingStageTimedOut(sw stateswitch.StateSwitc
 	if sCluster.cluster.Progress == nil || sCluster.cluster.Progress.FinalizingStage == "" {
 		return false, nil
 	}
-	timeout := finalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.log)
+	timeout := FinalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.log)
 	return time.Since(time.Time(sCluster.cluster.Progress.FinalizingStageStartedAt)) > timeout, nil
 }
 
@@ -1669,7 +1669,7 @@ var _ = Describe("RefreshCluster - preparing for install", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
-		dnsApi := dns.NewDNSHandler(nil, common.GetTestLog())
+		dnsApi := dns.NewDNSHandler(nil, dns.ZoneFilesConfig{}, nil, common.GetTestLog())
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db, testing.GetDummyNotificationStream(ctrl),
 			mockEvents, nil, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, dnsApi, nil, nil, false)
 
@@ -4414,7 +4414,7 @@ var _ = Describe("Single node", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
-		dnsApi := dns.NewDNSHandler(nil, common.GetTestLog())
+		dnsApi := dns.NewDNSHandler(nil, dns.ZoneFilesConfig{}, nil, common.GetTestLog())
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db, testing.GetDummyNotificationStream(ctrl),
 			mockEvents, nil, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, dnsApi, nil, nil, false)
 		hid1 = strfmt.UUID(uuid.New().String())
@@ -4840,7 +4840,7 @@ var _ = Describe("finalizing timeouts", func() {
 		})
 		for _, st := range finalizingStages {
 			stage := st
-			timeout := finalizingStageTimeout(stage, nil, logrus.New())
+			timeout := FinalizingStageTimeout(stage, nil, logrus.New())
 			Context(fmt.Sprintf("finalizing stage '%s' timeout expired", stage), func() {
 				if funk.Contains(nonFailingFinalizingStages, stage) {
 					It("should stay in same status and trigger soft timeout", func() {
@@ -4886,7 +4886,7 @@ var _ = Describe("finalizing timeouts", func() {
 		})
 		for _, st := range finalizingStages {
 			stage := st
-			timeout := finalizingStageTimeout(stage, nil, logrus.New())
+			timeout := FinalizingStageTimeout(stage, nil, logrus.New())
 			It(fmt.Sprintf("finalizing stage '%s' timeout expired", stage), func() {
 				cls := createCluster(models.ClusterStatusFinalizing, stage, time.Now(), time.Now().Add(-(timeout + time.Second)))
 				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any()).Times(1)
@@ -10,44 +10,50 @@ import (
 
 type ValidationID models.ClusterValidationID
 
+// operatorsValidationsCategory is the category of all the validations reported by the operators manager
+const operatorsValidationsCategory = "operators"
+
 const (
-	isClusterCidrDefined                = ValidationID(models.ClusterValidationIDClusterCidrDefined)
-	isServiceCidrDefined                = ValidationID(models.ClusterValidationIDServiceCidrDefined)
-	noCidrOverlapping                   = ValidationID(models.ClusterValidationIDNoCidrsOverlapping)
-	networkPrefixValid                  = ValidationID(models.ClusterValidationIDNetworkPrefixValid)
-	IsMachineCidrDefined                = ValidationID(models.ClusterValidationIDMachineCidrDefined)
-	IsMachineCidrEqualsToCalculatedCidr = ValidationID(models.ClusterValidationIDMachineCidrEqualsToCalculatedCidr)
-	NetworksSameAddressFamilies         = ValidationID(models.ClusterValidationIDNetworksSameAddressFamilies)
-	AreApiVipsDefined                   = ValidationID(models.ClusterValidationIDAPIVipsDefined)
-	AreApiVipsValid                     = ValidationID(models.ClusterValidationIDAPIVipsValid)
-	isNetworkTypeValid                  = ValidationID(models.ClusterValidationIDNetworkTypeValid)
-	AreIngressVipsDefined               = ValidationID(models.ClusterValidationIDIngressVipsDefined)
-	AreIngressVipsValid                 = ValidationID(models.ClusterValidationIDIngressVipsValid)
-	AllHostsAreReadyToInstall           = ValidationID(models.ClusterValidationIDAllHostsAreReadyToInstall)
-	SufficientMastersCount              = ValidationID(models.ClusterValidationIDSufficientMastersCount)
-	IsDNSDomainDefined                  = ValidationID(models.ClusterValidationIDDNSDomainDefined)
-	IsPullSecretSet                     = ValidationID(models.ClusterValidationIDPullSecretSet)
-	IsNtpServerConfigured               = ValidationID(models.ClusterValidationIDNtpServerConfigured)
-	IsOdfRequirementsSatisfied          = ValidationID(models.ClusterValidationIDOdfRequirementsSatisfied)
-	IsLsoRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLsoRequirementsSatisfied)
-	IsCnvRequirementsSatisfied          = ValidationID(models.ClusterValidationIDCnvRequirementsSatisfied)
-	IsLvmRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLvmRequirementsSatisfied)
-	IsMceRequirementsSatisfied          = ValidationID(models.ClusterValidationIDMceRequirementsSatisfied)
-	PlatformRequirementsSatisfied       = ValidationID(models.ClusterValidationIDPlatformRequirementsSatisfied)
+	isClusterCidrDefined                   = ValidationID(models.ClusterValidationIDClusterCidrDefined)
+	isServiceCidrDefined                   = ValidationID(models.ClusterValidationIDServiceCidrDefined)
+	noCidrOverlapping                      = ValidationID(models.ClusterValidationIDNoCidrsOverlapping)
+	networkPrefixValid                     = ValidationID(models.ClusterValidationIDNetworkPrefixValid)
+	IsMachineCidrDefined                   = ValidationID(models.ClusterValidationIDMachineCidrDefined)
+	IsMachineCidrEqualsToCalculatedCidr    = ValidationID(models.ClusterValidationIDMachineCidrEqualsToCalculatedCidr)
+	NetworksSameAddressFamilies            = ValidationID(models.ClusterValidationIDNetworksSameAddressFamilies)
+	AreApiVipsDefined                      = ValidationID(models.ClusterValidationIDAPIVipsDefined)
+	AreApiVipsValid                        = ValidationID(models.ClusterValidationIDAPIVipsValid)
+	isNetworkTypeValid                     = ValidationID(models.ClusterValidationIDNetworkTypeValid)
+	AreIngressVipsDefined                  = ValidationID(models.ClusterValidationIDIngressVipsDefined)
+	AreIngressVipsValid                    = ValidationID(models.ClusterValidationIDIngressVipsValid)
+	AllHostsAreReadyToInstall              = ValidationID(models.ClusterValidationIDAllHostsAreReadyToInstall)
+	SufficientMastersCount                 = ValidationID(models.ClusterValidationIDSufficientMastersCount)
+	IsDNSDomainDefined                     = ValidationID(models.ClusterValidationIDDNSDomainDefined)
+	IsPullSecretSet                        = ValidationID(models.ClusterValidationIDPullSecretSet)
+	IsNtpServerConfigured                  = ValidationID(models.ClusterValidationIDNtpServerConfigured)
+	IsOdfRequirementsSatisfied             = ValidationID(models.ClusterValidationIDOdfRequirementsSatisfied)
+	IsLsoRequirementsSatisfied             = ValidationID(models.ClusterValidationIDLsoRequirementsSatisfied)
+	IsCnvRequirementsSatisfied             = ValidationID(models.ClusterValidationIDCnvRequirementsSatisfied)
+	IsLvmRequirementsSatisfied             = ValidationID(models.ClusterValidationIDLvmRequirementsSatisfied)
+	IsMceRequirementsSatisfied             = ValidationID(models.ClusterValidationIDMceRequirementsSatisfied)
+	IsOperatorPluginsRequirementsSatisfied = ValidationID(models.ClusterValidationIDOperatorPluginsRequirementsSatisfied)
+	PlatformRequirementsSatisfied          = ValidationID(models.ClusterValidationIDPlatformRequirementsSatisfied)
+	SufficientPathMtu                      = ValidationID(models.ClusterValidationIDSufficientPathMtu)
 )
 
 func (v ValidationID) Category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, IsMachineCidrEqualsToCalculatedCidr, AreApiVipsDefined, AreApiVipsValid, AreIngressVipsDefined,
 		AreIngressVipsValid, isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid,
-		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies:
+		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies, SufficientPathMtu:
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
 	case IsPullSecretSet, PlatformRequirementsSatisfied:
 		return "configuration", nil
-	case IsOdfRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied, IsLvmRequirementsSatisfied, IsMceRequirementsSatisfied:
-		return "operators", nil
+	case IsOdfRequirementsSatisfied, IsLsoRequirementsSatisfied, IsCnvRequirementsSatisfied, IsLvmRequirementsSatisfied, IsMceRequirementsSatisfied,
+		IsOperatorPluginsRequirementsSatisfied:
+		return operatorsValidationsCategory, nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -79,8 +80,9 @@ func validationStatusToBool(v ValidationStatus) bool {
 }
 
 type clusterValidator struct {
-	log     logrus.FieldLogger
-	hostAPI host.API
+	log                logrus.FieldLogger
+	hostAPI            host.API
+	enableMtuPathCheck bool
 }
 
 func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) (ValidationStatus, string) {
@@ -574,6 +576,72 @@ func (v *clusterValidator) isNtpServerConfigured(c *clusterPreprocessContext) (V
 		"please configure an NTP server via DHCP or set clocks manually.", common.MaximumAllowedTimeDiffMinutes)
 }
 
+func (v *clusterValidator) sufficientPathMtu(c *clusterPreprocessContext) (ValidationStatus, string) {
+	if !v.enableMtuPathCheck {
+		return ValidationSuccess, "Path MTU checking is disabled"
+	}
+	// The cluster network is configured with a single MTU, derived from the highest MTU of the interfaces in the
+	// machine networks, so the lowest path MTU measured between any two hosts must carry it
+	var requiredMtu, lowestPathMtu int64
+	var measuredHosts []*models.Host
+	var unmeasuredHosts, unreachable []string
+	for _, h := range c.cluster.Hosts {
+		if h.Inventory == "" || funk.ContainsString([]string{models.HostStatusDiscovering, models.HostStatusDisconnected}, swag.StringValue(h.Status)) {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			v.log.WithError(err).Warnf("failed to unmarshal the inventory of host %s", h.ID.String())
+			continue
+		}
+		mtu := network.GetMachineNetworksMtu(inventory, c.cluster.MachineNetworks)
+		if mtu == 0 {
+			continue
+		}
+		if mtu > requiredMtu {
+			requiredMtu = mtu
+		}
+		if h.MtuPathReport == "" {
+			unmeasuredHosts = append(unmeasuredHosts, hostutil.GetHostnameForMsg(h))
+			continue
+		}
+		report, err := network.UnmarshalMtuPathReport(h.MtuPathReport)
+		if err != nil {
+			v.log.WithError(err).Warnf("failed to unmarshal the path MTU report of host %s", h.ID.String())
+			continue
+		}
+		measuredHosts = append(measuredHosts, h)
+		for _, result := range report.Results {
+			if result.PathMtu == 0 {
+				unreachable = append(unreachable, fmt.Sprintf("%s to %s", hostutil.GetHostnameForMsg(h), result.RemoteIPAddress))
+			} else if lowestPathMtu == 0 || result.PathMtu < lowestPathMtu {
+				lowestPathMtu = result.PathMtu
+			}
+		}
+	}
+	// The path MTU is measured between the hosts in the machine networks only
+	if len(measuredHosts)+len(unmeasuredHosts) < 2 {
+		return ValidationSuccess, "The path MTU between the hosts is sufficient for the cluster network."
+	}
+	if len(unreachable) > 0 {
+		return ValidationFailure, fmt.Sprintf("The path MTU couldn't be measured between some hosts of the cluster, which are unreachable: %s.",
+			strings.Join(unreachable, ", "))
+	}
+	if len(unmeasuredHosts) > 0 {
+		return ValidationPending, fmt.Sprintf("The path MTU between the hosts has not been measured yet by %s.", strings.Join(unmeasuredHosts, ", "))
+	}
+	overhead := network.GetMtuOverhead(c.cluster.NetworkType)
+	clusterNetworkMtu := network.GetClusterNetworkMtu(requiredMtu, c.cluster.NetworkType)
+	if pathClusterNetworkMtu := network.GetClusterNetworkMtu(lowestPathMtu, c.cluster.NetworkType); pathClusterNetworkMtu < clusterNetworkMtu {
+		return ValidationFailure, fmt.Sprintf("The lowest path MTU between the hosts of the cluster is %d, which leaves %d bytes to the cluster network "+
+			"once the %d bytes of encapsulation of the network type are added, lower than the MTU %d the cluster network would use with the MTU %d "+
+			"of the interfaces in the machine networks. Its packets would be dropped between some hosts. "+
+			"Align the MTU of the interfaces with the MTU supported by the network.",
+			lowestPathMtu, pathClusterNetworkMtu, overhead, clusterNetworkMtu, requiredMtu)
+	}
+	return ValidationSuccess, "The path MTU between the hosts is sufficient for the cluster network."
+}
+
 // skipNetworkHostPrefixCheck returns true if the hostPrefix should be ignored for non-OVN/SDN plugins.
 func (v *clusterValidator) skipNetworkHostPrefixCheck(c *clusterPreprocessContext) bool {
 	// list of known plugins that require hostPrefix to be set
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -555,6 +556,88 @@ var _ = Describe("Validator tests", func() {
 
 })
 
+var _ = Describe("sufficientPathMtu", func() {
+	var (
+		validator         clusterValidator
+		preprocessContext *clusterPreprocessContext
+	)
+
+	BeforeEach(func() {
+		validator = clusterValidator{log: logrus.New(), enableMtuPathCheck: true}
+		preprocessContext = &clusterPreprocessContext{}
+	})
+
+	makeHost := func(mtu int64, pathMtus ...int64) *models.Host {
+		hostID := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", Mtu: mtu, IPV4Addresses: []string{"1.2.3.4/24"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		host := &models.Host{ID: &hostID, Inventory: string(inventory)}
+		if len(pathMtus) > 0 {
+			response := models.MtuPathCheckResponse{}
+			for _, pathMtu := range pathMtus {
+				response.Results = append(response.Results, &models.MtuPathResult{OutgoingNic: "eth0", PathMtu: pathMtu})
+			}
+			report, err := json.Marshal(&response)
+			Expect(err).ToNot(HaveOccurred())
+			host.MtuPathReport = string(report)
+		}
+		return host
+	}
+
+	setHosts := func(hosts ...*models.Host) {
+		preprocessContext.cluster = &common.Cluster{Cluster: models.Cluster{
+			MachineNetworks: common.TestIPv4Networking.MachineNetworks,
+			NetworkType:     swag.String(models.ClusterNetworkTypeOVNKubernetes),
+			Hosts:           hosts,
+		}}
+	}
+
+	It("is pending when the path MTU has not been measured yet", func() {
+		setHosts(makeHost(9000, 9000), makeHost(9000))
+		status, message := validator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationPending))
+		Expect(message).To(HavePrefix("The path MTU between the hosts has not been measured yet by "))
+	})
+
+	It("succeeds when the path MTU check is disabled", func() {
+		setHosts(makeHost(9000, 9000), makeHost(9000))
+		disabledValidator := clusterValidator{log: logrus.New()}
+		status, message := disabledValidator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("Path MTU checking is disabled"))
+	})
+
+	It("succeeds when there is a single host in the machine networks", func() {
+		setHosts(makeHost(9000))
+		status, _ := validator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+
+	It("succeeds when the paths carry the MTU of the interfaces", func() {
+		setHosts(makeHost(9000, 9000), makeHost(9000, 9000, 9000))
+		status, message := validator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The path MTU between the hosts is sufficient for the cluster network."))
+	})
+
+	It("fails when a host is unreachable", func() {
+		setHosts(makeHost(9000, 9000), makeHost(9000, 9000, 0))
+		status, message := validator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(HavePrefix("The path MTU couldn't be measured between some hosts of the cluster, which are unreachable"))
+	})
+
+	It("fails when a path MTU is lower than the MTU of the interfaces", func() {
+		setHosts(makeHost(9000, 9000), makeHost(9000, 1500))
+		status, message := validator.sufficientPathMtu(preprocessContext)
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(ContainSubstring("The lowest path MTU between the hosts of the cluster is 1500, which leaves 1400 bytes to the cluster network"))
+		Expect(message).To(ContainSubstring("lower than the MTU 8900 the cluster network would use with the MTU 9000"))
+	})
+})
+
 var _ = Describe("Platform validations", func() {
 	var (
 		validator         clusterValidator
@@ -0,0 +1,302 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// InstallerInternals registers the clusters and the infra-envs created from the templates
+//
+//go:generate mockgen --build_flags=--mod=mod -package clustertemplates -destination mock_installer_internals.go . InstallerInternals
+type InstallerInternals interface {
+	RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.V2RegisterClusterParams) (*common.Cluster, error)
+	DeregisterClusterInternal(ctx context.Context, cluster *common.Cluster) error
+	RegisterInfraEnvInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error)
+	DeregisterInfraEnvInternal(ctx context.Context, params installer.DeregisterInfraEnvParams) error
+}
+
+var _ restapi.ClusterTemplatesAPI = (*Handler)(nil)
+
+// NewHandler returns the cluster templates handler
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, authzHandler auth.Authorizer, installerInternals InstallerInternals,
+	manifestsAPI manifestsapi.ManifestsAPI) *Handler {
+	return &Handler{
+		log:                log,
+		db:                 db,
+		authzHandler:       authzHandler,
+		installerInternals: installerInternals,
+		manifestsAPI:       manifestsAPI,
+	}
+}
+
+// Handler represents the cluster templates handler
+type Handler struct {
+	log                logrus.FieldLogger
+	db                 *gorm.DB
+	authzHandler       auth.Authorizer
+	installerInternals InstallerInternals
+	manifestsAPI       manifestsapi.ManifestsAPI
+}
+
+func (h *Handler) V2CreateClusterTemplate(ctx context.Context, params operations.V2CreateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	createParams := params.NewClusterTemplateParams
+	if err := validateSpec(createParams.Spec); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	now := time.Now()
+	template := &models.ClusterTemplate{
+		ID:          &id,
+		Name:        createParams.Name,
+		Description: createParams.Description,
+		Spec:        createParams.Spec,
+		UserName:    ocm.UserNameFromContext(ctx),
+		OrgID:       ocm.OrgIDFromContext(ctx),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := h.db.Create(template).Error; err != nil {
+		log.WithError(err).Error("failed to create cluster template")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Created cluster template %s with id %s", swag.StringValue(template.Name), id)
+	return operations.NewV2CreateClusterTemplateCreated().WithPayload(template)
+}
+
+func (h *Handler) V2ListClusterTemplates(ctx context.Context, params operations.V2ListClusterTemplatesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	var templates models.ClusterTemplateList
+	if err := h.authzHandler.OwnedBy(ctx, h.db).Order("created_at").Find(&templates).Error; err != nil {
+		log.WithError(err).Error("failed to list cluster templates")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2ListClusterTemplatesOK().WithPayload(templates)
+}
+
+func (h *Handler) V2GetClusterTemplate(ctx context.Context, params operations.V2GetClusterTemplateParams) middleware.Responder {
+	template, err := h.getTemplate(ctx, h.db, params.ClusterTemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetClusterTemplateOK().WithPayload(template)
+}
+
+func (h *Handler) V2UpdateClusterTemplate(ctx context.Context, params operations.V2UpdateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	updateParams := params.ClusterTemplateUpdateParams
+	if updateParams.Spec != nil {
+		if err := validateSpec(updateParams.Spec); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	var template *models.ClusterTemplate
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if template, err = h.getTemplate(ctx, tx, params.ClusterTemplateID); err != nil {
+			return err
+		}
+		if updateParams.Description != nil {
+			template.Description = *updateParams.Description
+		}
+		if updateParams.Spec != nil {
+			template.Spec = updateParams.Spec
+		}
+		template.UpdatedAt = time.Now()
+		if err = tx.Save(template).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to update cluster template %s", params.ClusterTemplateID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Updated cluster template %s", params.ClusterTemplateID)
+	return operations.NewV2UpdateClusterTemplateOK().WithPayload(template)
+}
+
+func (h *Handler) V2DeleteClusterTemplate(ctx context.Context, params operations.V2DeleteClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	template, err := h.getTemplate(ctx, h.db, params.ClusterTemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = h.db.Delete(template).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete cluster template %s", params.ClusterTemplateID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Deleted cluster template %s", params.ClusterTemplateID)
+	return operations.NewV2DeleteClusterTemplateNoContent()
+}
+
+func (h *Handler) V2InstantiateClusterTemplate(ctx context.Context, params operations.V2InstantiateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	template, err := h.getTemplate(ctx, h.db, params.ClusterTemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	instance, err := h.instantiate(ctx, template, params.InstantiateParams)
+	if err != nil {
+		log.WithError(err).Errorf("failed to instantiate cluster template %s", params.ClusterTemplateID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Created cluster %s and infra-env %s from cluster template %s", instance.Cluster.ID, instance.InfraEnv.ID, params.ClusterTemplateID)
+	return operations.NewV2InstantiateClusterTemplateCreated().WithPayload(instance)
+}
+
+// instantiate registers the cluster, its infra-env and its custom manifests. The cluster and the infra-env are
+// deregistered when any of the following steps fails, so that no partial instance is left behind.
+func (h *Handler) instantiate(ctx context.Context, template *models.ClusterTemplate,
+	instantiateParams *models.ClusterTemplateInstantiateParams) (*models.ClusterTemplateInstance, error) {
+	log := logutil.FromContext(ctx, h.log)
+	spec := template.Spec
+
+	cluster, err := h.installerInternals.RegisterClusterInternal(ctx, nil, installer.V2RegisterClusterParams{
+		NewClusterParams: clusterCreateParams(spec, instantiateParams),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infraEnv, err := h.installerInternals.RegisterInfraEnvInternal(ctx, nil, installer.RegisterInfraEnvParams{
+		InfraenvCreateParams: &models.InfraEnvCreateParams{
+			Name:                 swag.String(fmt.Sprintf("%s_infra-env", swag.StringValue(instantiateParams.Name))),
+			ClusterID:            cluster.ID,
+			OpenshiftVersion:     cluster.OpenshiftVersion,
+			CPUArchitecture:      cluster.CPUArchitecture,
+			PullSecret:           instantiateParams.PullSecret,
+			SSHAuthorizedKey:     swag.String(spec.SSHPublicKey),
+			AdditionalNtpSources: spec.AdditionalNtpSource,
+			ImageType:            spec.ImageType,
+		},
+	})
+	if err != nil {
+		h.deregister(ctx, cluster, nil)
+		return nil, err
+	}
+
+	for _, manifest := range spec.CustomManifests {
+		if _, err = h.manifestsAPI.CreateClusterManifestInternal(ctx, manifests.V2CreateClusterManifestParams{
+			ClusterID:            *cluster.ID,
+			CreateManifestParams: manifest,
+		}, true); err != nil {
+			log.WithError(err).Errorf("failed to create manifest %s of cluster %s", swag.StringValue(manifest.FileName), cluster.ID)
+			h.deregister(ctx, cluster, infraEnv)
+			return nil, err
+		}
+	}
+
+	return &models.ClusterTemplateInstance{
+		Cluster:  &cluster.Cluster,
+		InfraEnv: &infraEnv.InfraEnv,
+	}, nil
+}
+
+func (h *Handler) deregister(ctx context.Context, cluster *common.Cluster, infraEnv *common.InfraEnv) {
+	log := logutil.FromContext(ctx, h.log)
+	if infraEnv != nil {
+		if err := h.installerInternals.DeregisterInfraEnvInternal(ctx, installer.DeregisterInfraEnvParams{InfraEnvID: *infraEnv.ID}); err != nil {
+			log.WithError(err).Errorf("failed to deregister infra-env %s of partially created cluster %s", infraEnv.ID, cluster.ID)
+		}
+	}
+	if err := h.installerInternals.DeregisterClusterInternal(ctx, cluster); err != nil {
+		log.WithError(err).Errorf("failed to deregister partially created cluster %s", cluster.ID)
+	}
+}
+
+func (h *Handler) getTemplate(ctx context.Context, db *gorm.DB, id strfmt.UUID) (*models.ClusterTemplate, error) {
+	var template models.ClusterTemplate
+	if err := h.authzHandler.OwnedBy(ctx, db).Take(&template, "id = ?", id.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Cluster template %s not found", id))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &template, nil
+}
+
+// clusterCreateParams merges the definition of the template with the properties of the instance
+func clusterCreateParams(spec *models.ClusterTemplateSpec, instantiateParams *models.ClusterTemplateInstantiateParams) *models.ClusterCreateParams {
+	baseDNSDomain := spec.BaseDNSDomain
+	if instantiateParams.BaseDNSDomain != "" {
+		baseDNSDomain = instantiateParams.BaseDNSDomain
+	}
+	return &models.ClusterCreateParams{
+		Name:                  instantiateParams.Name,
+		PullSecret:            instantiateParams.PullSecret,
+		BaseDNSDomain:         baseDNSDomain,
+		APIVips:               instantiateParams.APIVips,
+		IngressVips:           instantiateParams.IngressVips,
+		OpenshiftVersion:      spec.OpenshiftVersion,
+		CPUArchitecture:       spec.CPUArchitecture,
+		HighAvailabilityMode:  spec.HighAvailabilityMode,
+		Platform:              spec.Platform,
+		NetworkType:           spec.NetworkType,
+		UserManagedNetworking: spec.UserManagedNetworking,
+		ClusterNetworks:       spec.ClusterNetworks,
+		ServiceNetworks:       spec.ServiceNetworks,
+		MachineNetworks:       spec.MachineNetworks,
+		SchedulableMasters:    spec.SchedulableMasters,
+		Hyperthreading:        spec.Hyperthreading,
+		AdditionalNtpSource:   spec.AdditionalNtpSource,
+		SSHPublicKey:          spec.SSHPublicKey,
+		OlmOperators:          spec.OlmOperators,
+		HostRoleRules:         spec.HostRoleRules,
+	}
+}
+
+// validateSpec checks what the API schema can't: the content of the custom manifests and the patterns of the
+// host role rules. The rest of the definition is validated when the clusters are created.
+func validateSpec(spec *models.ClusterTemplateSpec) error {
+	manifestPaths := make(map[string]bool)
+	for _, manifest := range spec.CustomManifests {
+		folder := models.CreateManifestParamsFolderManifests
+		if manifest.Folder != nil {
+			folder = *manifest.Folder
+		}
+		manifestPath := path.Join(folder, swag.StringValue(manifest.FileName))
+		if manifestPaths[manifestPath] {
+			return errors.Errorf("Manifest %s is defined more than once", manifestPath)
+		}
+		manifestPaths[manifestPath] = true
+		if _, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content)); err != nil {
+			return errors.Wrapf(err, "Manifest %s content is not base64 encoded", manifestPath)
+		}
+	}
+	return hostutil.ValidateHostRoleRules(spec.HostRoleRules)
+}
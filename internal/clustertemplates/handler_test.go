@@ -0,0 +1,264 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+var _ = Describe("Cluster templates handler", func() {
+	var (
+		db                     *gorm.DB
+		dbName                 string
+		ctrl                   *gomock.Controller
+		mockInstallerInternals *MockInstallerInternals
+		mockManifestsAPI       *manifestsapi.MockManifestsAPI
+		handler                *Handler
+		ctx                    context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstallerInternals = NewMockInstallerInternals(ctrl)
+		mockManifestsAPI = manifestsapi.NewMockManifestsAPI(ctrl)
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		handler = NewHandler(common.GetTestLog(), db, authzHandler, mockInstallerInternals, mockManifestsAPI)
+		ctx = userContext("jdoe", "org1")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	newSpec := func() *models.ClusterTemplateSpec {
+		return &models.ClusterTemplateSpec{
+			OpenshiftVersion: swag.String("4.14"),
+			CPUArchitecture:  common.X86CPUArchitecture,
+			BaseDNSDomain:    "example.com",
+			MachineNetworks:  []*models.MachineNetwork{{Cidr: "192.168.1.0/24"}},
+			SSHPublicKey:     "ssh-rsa AAAA",
+			OlmOperators:     []*models.OperatorCreateParams{{Name: "lvm"}},
+			CustomManifests: []*models.CreateManifestParams{{
+				Folder:   swag.String(models.CreateManifestParamsFolderOpenshift),
+				FileName: swag.String("custom.yaml"),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("kind: ConfigMap"))),
+			}},
+			HostRoleRules: []*models.HostRoleRule{{Name: "masters", Role: swag.String(models.HostRoleRuleRoleMaster), HostnamePattern: "^master-"}},
+		}
+	}
+
+	create := func(ctx context.Context, name string, spec *models.ClusterTemplateSpec) *models.ClusterTemplate {
+		response := handler.V2CreateClusterTemplate(ctx, operations.V2CreateClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{
+				Name: swag.String(name),
+				Spec: spec,
+			},
+		})
+		ExpectWithOffset(1, response).To(BeAssignableToTypeOf(operations.NewV2CreateClusterTemplateCreated()))
+		return response.(*operations.V2CreateClusterTemplateCreated).Payload
+	}
+
+	expectStatus := func(response interface{}, status int) {
+		ExpectWithOffset(1, response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		ExpectWithOffset(1, response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(status)))
+	}
+
+	Context("templates", func() {
+		It("creates a template owned by the caller", func() {
+			template := create(ctx, "edge", newSpec())
+			Expect(template.UserName).To(Equal("jdoe"))
+			Expect(template.OrgID).To(Equal("org1"))
+
+			response := handler.V2GetClusterTemplate(ctx, operations.V2GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+			Expect(response).To(BeAssignableToTypeOf(operations.NewV2GetClusterTemplateOK()))
+			stored := response.(*operations.V2GetClusterTemplateOK).Payload
+			Expect(stored.Spec).To(Equal(newSpec()))
+		})
+
+		It("rejects invalid definitions", func() {
+			spec := newSpec()
+			spec.CustomManifests = append(spec.CustomManifests, spec.CustomManifests[0])
+			response := handler.V2CreateClusterTemplate(ctx, operations.V2CreateClusterTemplateParams{
+				NewClusterTemplateParams: &models.ClusterTemplateCreateParams{Name: swag.String("edge"), Spec: spec},
+			})
+			expectStatus(response, http.StatusBadRequest)
+
+			spec = newSpec()
+			spec.HostRoleRules[0].HostnamePattern = "master-("
+			response = handler.V2CreateClusterTemplate(ctx, operations.V2CreateClusterTemplateParams{
+				NewClusterTemplateParams: &models.ClusterTemplateCreateParams{Name: swag.String("edge"), Spec: spec},
+			})
+			expectStatus(response, http.StatusBadRequest)
+		})
+
+		It("lists the templates of the tenant only", func() {
+			create(ctx, "edge", newSpec())
+			create(userContext("other", "org2"), "core", newSpec())
+
+			response := handler.V2ListClusterTemplates(ctx, operations.V2ListClusterTemplatesParams{})
+			Expect(response).To(BeAssignableToTypeOf(operations.NewV2ListClusterTemplatesOK()))
+			templates := response.(*operations.V2ListClusterTemplatesOK).Payload
+			Expect(templates).To(HaveLen(1))
+			Expect(*templates[0].Name).To(Equal("edge"))
+		})
+
+		It("updates the description and the definition", func() {
+			template := create(ctx, "edge", newSpec())
+			spec := newSpec()
+			spec.OpenshiftVersion = swag.String("4.15")
+
+			response := handler.V2UpdateClusterTemplate(ctx, operations.V2UpdateClusterTemplateParams{
+				ClusterTemplateID: *template.ID,
+				ClusterTemplateUpdateParams: &models.ClusterTemplateUpdateParams{
+					Description: swag.String("Edge sites"),
+					Spec:        spec,
+				},
+			})
+			Expect(response).To(BeAssignableToTypeOf(operations.NewV2UpdateClusterTemplateOK()))
+			updated := response.(*operations.V2UpdateClusterTemplateOK).Payload
+			Expect(updated.Description).To(Equal("Edge sites"))
+			Expect(*updated.Spec.OpenshiftVersion).To(Equal("4.15"))
+			Expect(time.Time(updated.UpdatedAt)).To(BeTemporally(">", time.Time(template.UpdatedAt)))
+		})
+
+		It("deletes templates of the tenant only", func() {
+			template := create(ctx, "edge", newSpec())
+
+			response := handler.V2DeleteClusterTemplate(userContext("other", "org2"), operations.V2DeleteClusterTemplateParams{ClusterTemplateID: *template.ID})
+			expectStatus(response, http.StatusNotFound)
+
+			response = handler.V2DeleteClusterTemplate(ctx, operations.V2DeleteClusterTemplateParams{ClusterTemplateID: *template.ID})
+			Expect(response).To(BeAssignableToTypeOf(operations.NewV2DeleteClusterTemplateNoContent()))
+			response = handler.V2GetClusterTemplate(ctx, operations.V2GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+			expectStatus(response, http.StatusNotFound)
+		})
+	})
+
+	Context("instantiate", func() {
+		var (
+			template   *models.ClusterTemplate
+			cluster    *common.Cluster
+			infraEnv   *common.InfraEnv
+			instParams *models.ClusterTemplateInstantiateParams
+		)
+
+		BeforeEach(func() {
+			template = create(ctx, "edge", newSpec())
+			clusterID := strfmt.UUID(uuid.New().String())
+			infraEnvID := strfmt.UUID(uuid.New().String())
+			cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OpenshiftVersion: "4.14", CPUArchitecture: common.X86CPUArchitecture}}
+			infraEnv = &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID}}
+			instParams = &models.ClusterTemplateInstantiateParams{
+				Name:        swag.String("site1"),
+				PullSecret:  swag.String("{}"),
+				APIVips:     []*models.APIVip{{IP: "192.168.1.100"}},
+				IngressVips: []*models.IngressVip{{IP: "192.168.1.101"}},
+			}
+		})
+
+		instantiate := func(id strfmt.UUID) interface{} {
+			return handler.V2InstantiateClusterTemplate(ctx, operations.V2InstantiateClusterTemplateParams{
+				ClusterTemplateID: id,
+				InstantiateParams: instParams,
+			})
+		}
+
+		It("creates the cluster, its infra-env and its manifests", func() {
+			instParams.BaseDNSDomain = "site1.example.com"
+			mockInstallerInternals.EXPECT().RegisterClusterInternal(gomock.Any(), nil, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *types.NamespacedName, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+					Expect(*params.NewClusterParams.Name).To(Equal("site1"))
+					Expect(*params.NewClusterParams.PullSecret).To(Equal("{}"))
+					Expect(params.NewClusterParams.BaseDNSDomain).To(Equal("site1.example.com"))
+					Expect(params.NewClusterParams.APIVips).To(Equal(instParams.APIVips))
+					Expect(params.NewClusterParams.IngressVips).To(Equal(instParams.IngressVips))
+					Expect(*params.NewClusterParams.OpenshiftVersion).To(Equal("4.14"))
+					Expect(params.NewClusterParams.MachineNetworks).To(Equal(template.Spec.MachineNetworks))
+					Expect(params.NewClusterParams.HostRoleRules).To(Equal(template.Spec.HostRoleRules))
+					Expect(params.NewClusterParams.OlmOperators).To(Equal(template.Spec.OlmOperators))
+					return cluster, nil
+				}).Times(1)
+			mockInstallerInternals.EXPECT().RegisterInfraEnvInternal(gomock.Any(), nil, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *types.NamespacedName, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error) {
+					Expect(*params.InfraenvCreateParams.Name).To(Equal("site1_infra-env"))
+					Expect(*params.InfraenvCreateParams.ClusterID).To(Equal(*cluster.ID))
+					Expect(*params.InfraenvCreateParams.SSHAuthorizedKey).To(Equal("ssh-rsa AAAA"))
+					return infraEnv, nil
+				}).Times(1)
+			mockManifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), manifests.V2CreateClusterManifestParams{
+				ClusterID:            *cluster.ID,
+				CreateManifestParams: template.Spec.CustomManifests[0],
+			}, true).Return(&models.Manifest{}, nil).Times(1)
+
+			response := instantiate(*template.ID)
+			Expect(response).To(BeAssignableToTypeOf(operations.NewV2InstantiateClusterTemplateCreated()))
+			instance := response.(*operations.V2InstantiateClusterTemplateCreated).Payload
+			Expect(instance.Cluster.ID).To(Equal(cluster.ID))
+			Expect(instance.InfraEnv.ID).To(Equal(infraEnv.ID))
+		})
+
+		It("uses the base domain of the template by default", func() {
+			mockInstallerInternals.EXPECT().RegisterClusterInternal(gomock.Any(), nil, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *types.NamespacedName, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+					Expect(params.NewClusterParams.BaseDNSDomain).To(Equal("example.com"))
+					return nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid VIPs"))
+				}).Times(1)
+
+			expectStatus(instantiate(*template.ID), http.StatusBadRequest)
+		})
+
+		It("deregisters the cluster and the infra-env when a manifest can't be created", func() {
+			mockInstallerInternals.EXPECT().RegisterClusterInternal(gomock.Any(), nil, gomock.Any()).Return(cluster, nil).Times(1)
+			mockInstallerInternals.EXPECT().RegisterInfraEnvInternal(gomock.Any(), nil, gomock.Any()).Return(infraEnv, nil).Times(1)
+			mockManifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), true).
+				Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid manifest"))).Times(1)
+			mockInstallerInternals.EXPECT().DeregisterInfraEnvInternal(gomock.Any(), installer.DeregisterInfraEnvParams{InfraEnvID: *infraEnv.ID}).Return(nil).Times(1)
+			mockInstallerInternals.EXPECT().DeregisterClusterInternal(gomock.Any(), cluster).Return(nil).Times(1)
+
+			expectStatus(instantiate(*template.ID), http.StatusBadRequest)
+		})
+
+		It("deregisters the cluster when the infra-env can't be created", func() {
+			mockInstallerInternals.EXPECT().RegisterClusterInternal(gomock.Any(), nil, gomock.Any()).Return(cluster, nil).Times(1)
+			mockInstallerInternals.EXPECT().RegisterInfraEnvInternal(gomock.Any(), nil, gomock.Any()).
+				Return(nil, common.NewApiError(http.StatusInternalServerError, errors.New("db failure"))).Times(1)
+			mockInstallerInternals.EXPECT().DeregisterClusterInternal(gomock.Any(), cluster).Return(nil).Times(1)
+
+			expectStatus(instantiate(*template.ID), http.StatusInternalServerError)
+		})
+
+		It("fails for templates of other tenants", func() {
+			ctx = userContext("other", "org2")
+			expectStatus(instantiate(*template.ID), http.StatusNotFound)
+		})
+	})
+})
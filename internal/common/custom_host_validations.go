@@ -0,0 +1,31 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+func MarshalCustomHostValidations(validations []*models.CustomHostValidation) (string, error) {
+	if len(validations) == 0 {
+		return "", nil
+	}
+
+	validationsJson, err := json.Marshal(validations)
+	if err != nil {
+		return "", err
+	}
+	return string(validationsJson), nil
+}
+
+func UnmarshalCustomHostValidations(validationsStr string) ([]*models.CustomHostValidation, error) {
+	var validations []*models.CustomHostValidation
+	if validationsStr == "" {
+		return validations, nil
+	}
+
+	if err := json.Unmarshal([]byte(validationsStr), &validations); err != nil {
+		return nil, err
+	}
+	return validations, nil
+}
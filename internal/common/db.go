@@ -139,6 +139,27 @@ type Host struct {
 
 	// Json formatted string of the additional HTTP headers when fetching the ignition.
 	IgnitionEndpointHTTPHeaders string `json:"ignition_endpoint_http_headers,omitempty" gorm:"type:TEXT"`
+
+	// The hardware of the host extracted from its inventory, updated together with the inventory
+	Hardware HostHardware `json:"-" gorm:"embedded;embeddedPrefix:hardware_"`
+
+	// The parameters of the decommission of the host, which is resumed from its stage by the leader
+	Decommission HostDecommission `json:"-" gorm:"embedded;embeddedPrefix:decommission_"`
+
+	// Json formatted list of the keys of the node labels set by the host role rules of the cluster, which are removed
+	// from the node labels once no rule sets them
+	RuleNodeLabels string `json:"-" gorm:"type:TEXT"`
+
+	// Whether the agent of the host is provisioned by a BareMetalHost, which can reboot the host through its BMC.
+	// Set by the agent controller.
+	BareMetalHost bool `json:"-"`
+}
+
+// HostDecommission holds the parameters of the decommission of a host, along with its decommission stage
+type HostDecommission struct {
+	NodeName       string
+	WipeDisks      bool
+	StageUpdatedAt time.Time
 }
 
 func (h *Host) GetClusterID() *strfmt.UUID {
@@ -211,6 +232,81 @@ func (i *InfraEnv) Payload() any {
 	return &i.InfraEnv
 }
 
+type WebhookSubscription struct {
+	models.WebhookSubscription
+
+	// Key used to sign the notifications delivered to the webhook. It is never returned by the API
+	Secret string `json:"-" gorm:"type:TEXT"`
+}
+
+// WebhookDelivery is a notification waiting in the outbox to be delivered to a webhook subscription
+type WebhookDelivery struct {
+	ID               uint        `gorm:"primaryKey"`
+	SubscriptionID   strfmt.UUID `gorm:"index"`
+	NotificationType string
+
+	// The JSON encoded notification envelope posted to the webhook
+	Body string `gorm:"type:TEXT"`
+
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"type:timestamp with time zone;index"`
+	LastError     string    `gorm:"type:TEXT"`
+	CreatedAt     time.Time `gorm:"type:timestamp with time zone"`
+}
+
+type BmcHost struct {
+	models.BmcHost
+
+	// Password of the BMC. It is never returned by the API
+	Password string `json:"-" gorm:"type:TEXT"`
+}
+
+// ResourceChange is a notification recorded for the watchers of the cluster and infra-env it belongs to.
+// Watchers resume from the revision of the last change they received.
+type ResourceChange struct {
+	Revision         int64        `gorm:"primaryKey;autoIncrement"`
+	ClusterID        *strfmt.UUID `gorm:"index"`
+	InfraEnvID       *strfmt.UUID `gorm:"index"`
+	NotificationType string
+
+	// The JSON encoded notification payload
+	Body string `gorm:"type:TEXT"`
+
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;index"`
+}
+
+// LogLine is a line of the logs uploaded for a cluster, indexed for log search
+type LogLine struct {
+	ID        uint         `gorm:"primaryKey"`
+	ClusterID strfmt.UUID  `gorm:"index"`
+	HostID    *strfmt.UUID `gorm:"index"`
+	LogsType  string
+
+	// Identifies the upload of the logs the line belongs to. The lines of the previous upload of the same logs are
+	// deleted once all the lines of the new upload are indexed.
+	UploadID string `gorm:"index"`
+
+	File      string `gorm:"type:TEXT"`
+	Line      int64
+	Timestamp *time.Time `gorm:"type:timestamp with time zone"`
+	Text      string     `gorm:"type:TEXT"`
+	CreatedAt time.Time  `gorm:"type:timestamp with time zone;index"`
+}
+
+// LogIndexJob is an upload of logs waiting to be indexed for log search
+type LogIndexJob struct {
+	ID         uint        `gorm:"primaryKey"`
+	ClusterID  strfmt.UUID `gorm:"index"`
+	HostID     *strfmt.UUID
+	LogsType   string
+	ObjectName string `gorm:"type:TEXT"`
+
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"type:timestamp with time zone;index"`
+	LastError     string    `gorm:"type:TEXT"`
+	CreatedAt     time.Time `gorm:"type:timestamp with time zone"`
+}
+
 type EagerLoadingState bool
 
 const (
@@ -257,6 +353,16 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.MachineNetwork{},
 		&models.APIVip{},
 		&models.IngressVip{},
+		&models.TriageFinding{},
+		&models.DryRunGenerateReport{},
+		&models.ClusterTemplate{},
+		&WebhookSubscription{},
+		&WebhookDelivery{},
+		&BmcHost{},
+		&models.HostClaim{},
+		&ResourceChange{},
+		&LogLine{},
+		&LogIndexJob{},
 	)
 }
 
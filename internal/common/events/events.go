@@ -5035,6 +5035,257 @@ func (e *ClusterLogsUploadedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_logs_triage_finding
+//
+type ClusterLogsTriageFindingEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    SourceName string
+    Title string
+    File string
+    Line int64
+}
+
+var ClusterLogsTriageFindingEventName string = "cluster_logs_triage_finding"
+
+func NewClusterLogsTriageFindingEvent(
+    clusterId strfmt.UUID,
+    sourceName string,
+    title string,
+    file string,
+    line int64,
+) *ClusterLogsTriageFindingEvent {
+    return &ClusterLogsTriageFindingEvent{
+        eventName: ClusterLogsTriageFindingEventName,
+        ClusterId: clusterId,
+        SourceName: sourceName,
+        Title: title,
+        File: file,
+        Line: line,
+    }
+}
+
+func SendClusterLogsTriageFindingEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceName string,
+    title string,
+    file string,
+    line int64,) {
+    ev := NewClusterLogsTriageFindingEvent(
+        clusterId,
+        sourceName,
+        title,
+        file,
+        line,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterLogsTriageFindingEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceName string,
+    title string,
+    file string,
+    line int64,
+    eventTime time.Time) {
+    ev := NewClusterLogsTriageFindingEvent(
+        clusterId,
+        sourceName,
+        title,
+        file,
+        line,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterLogsTriageFindingEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterLogsTriageFindingEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterLogsTriageFindingEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterLogsTriageFindingEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{source_name}", fmt.Sprint(e.SourceName),
+        "{title}", fmt.Sprint(e.Title),
+        "{file}", fmt.Sprint(e.File),
+        "{line}", fmt.Sprint(e.Line),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterLogsTriageFindingEvent) FormatMessage() string {
+    s := "Log triage found a known failure in the {source_name} logs: {title} ({file}, line {line})"
+    return e.format(&s)
+}
+
+//
+// Event cluster_host_claim_satisfied
+//
+type ClusterHostClaimSatisfiedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Masters int64
+    Workers int64
+}
+
+var ClusterHostClaimSatisfiedEventName string = "cluster_host_claim_satisfied"
+
+func NewClusterHostClaimSatisfiedEvent(
+    clusterId strfmt.UUID,
+    masters int64,
+    workers int64,
+) *ClusterHostClaimSatisfiedEvent {
+    return &ClusterHostClaimSatisfiedEvent{
+        eventName: ClusterHostClaimSatisfiedEventName,
+        ClusterId: clusterId,
+        Masters: masters,
+        Workers: workers,
+    }
+}
+
+func SendClusterHostClaimSatisfiedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    masters int64,
+    workers int64,) {
+    ev := NewClusterHostClaimSatisfiedEvent(
+        clusterId,
+        masters,
+        workers,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterHostClaimSatisfiedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    masters int64,
+    workers int64,
+    eventTime time.Time) {
+    ev := NewClusterHostClaimSatisfiedEvent(
+        clusterId,
+        masters,
+        workers,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterHostClaimSatisfiedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterHostClaimSatisfiedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterHostClaimSatisfiedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterHostClaimSatisfiedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{masters}", fmt.Sprint(e.Masters),
+        "{workers}", fmt.Sprint(e.Workers),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterHostClaimSatisfiedEvent) FormatMessage() string {
+    s := "Host claim satisfied: the cluster has {masters} masters and {workers} workers"
+    return e.format(&s)
+}
+
+//
+// Event cluster_host_claim_unsatisfied
+//
+type ClusterHostClaimUnsatisfiedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Reason string
+}
+
+var ClusterHostClaimUnsatisfiedEventName string = "cluster_host_claim_unsatisfied"
+
+func NewClusterHostClaimUnsatisfiedEvent(
+    clusterId strfmt.UUID,
+    reason string,
+) *ClusterHostClaimUnsatisfiedEvent {
+    return &ClusterHostClaimUnsatisfiedEvent{
+        eventName: ClusterHostClaimUnsatisfiedEventName,
+        ClusterId: clusterId,
+        Reason: reason,
+    }
+}
+
+func SendClusterHostClaimUnsatisfiedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    reason string,) {
+    ev := NewClusterHostClaimUnsatisfiedEvent(
+        clusterId,
+        reason,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterHostClaimUnsatisfiedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    reason string,
+    eventTime time.Time) {
+    ev := NewClusterHostClaimUnsatisfiedEvent(
+        clusterId,
+        reason,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterHostClaimUnsatisfiedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterHostClaimUnsatisfiedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterHostClaimUnsatisfiedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterHostClaimUnsatisfiedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{reason}", fmt.Sprint(e.Reason),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterHostClaimUnsatisfiedEvent) FormatMessage() string {
+    s := "Host claim pending: {reason}"
+    return e.format(&s)
+}
+
 //
 // Event host_approved_updated
 //
@@ -5697,6 +5948,7 @@ type HostInstallProgressUpdatedEvent struct {
     ClusterId *strfmt.UUID
     HostName string
     Event string
+    Stage string
 }
 
 var HostInstallProgressUpdatedEventName string = "host_install_progress_updated"
@@ -5707,6 +5959,7 @@ func NewHostInstallProgressUpdatedEvent(
     clusterId *strfmt.UUID,
     hostName string,
     event string,
+    stage string,
 ) *HostInstallProgressUpdatedEvent {
     return &HostInstallProgressUpdatedEvent{
         eventName: HostInstallProgressUpdatedEventName,
@@ -5715,6 +5968,7 @@ func NewHostInstallProgressUpdatedEvent(
         ClusterId: clusterId,
         HostName: hostName,
         Event: event,
+        Stage: stage,
     }
 }
 
@@ -5725,13 +5979,15 @@ func SendHostInstallProgressUpdatedEvent(
     infraEnvId strfmt.UUID,
     clusterId *strfmt.UUID,
     hostName string,
-    event string,) {
+    event string,
+    stage string,) {
     ev := NewHostInstallProgressUpdatedEvent(
         hostId,
         infraEnvId,
         clusterId,
         hostName,
         event,
+        stage,
     )
     eventsHandler.SendHostEvent(ctx, ev)
 }
@@ -5744,6 +6000,7 @@ func SendHostInstallProgressUpdatedEventAtTime(
     clusterId *strfmt.UUID,
     hostName string,
     event string,
+    stage string,
     eventTime time.Time) {
     ev := NewHostInstallProgressUpdatedEvent(
         hostId,
@@ -5751,6 +6008,7 @@ func SendHostInstallProgressUpdatedEventAtTime(
         clusterId,
         hostName,
         event,
+        stage,
     )
     eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
 }
@@ -5781,6 +6039,7 @@ func (e *HostInstallProgressUpdatedEvent) format(message *string) string {
         "{cluster_id}", fmt.Sprint(e.ClusterId),
         "{host_name}", fmt.Sprint(e.HostName),
         "{event}", fmt.Sprint(e.Event),
+        "{stage}", fmt.Sprint(e.Stage),
     )
     return r.Replace(*message)
 }
@@ -6067,6 +6326,117 @@ func (e *HostUnbindFailedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_claim_bound
+//
+type HostClaimBoundEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Role string
+    Reason string
+}
+
+var HostClaimBoundEventName string = "host_claim_bound"
+
+func NewHostClaimBoundEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    role string,
+    reason string,
+) *HostClaimBoundEvent {
+    return &HostClaimBoundEvent{
+        eventName: HostClaimBoundEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Role: role,
+        Reason: reason,
+    }
+}
+
+func SendHostClaimBoundEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    role string,
+    reason string,) {
+    ev := NewHostClaimBoundEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        role,
+        reason,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostClaimBoundEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    role string,
+    reason string,
+    eventTime time.Time) {
+    ev := NewHostClaimBoundEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        role,
+        reason,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostClaimBoundEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostClaimBoundEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostClaimBoundEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostClaimBoundEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+func (e *HostClaimBoundEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *HostClaimBoundEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{role}", fmt.Sprint(e.Role),
+        "{reason}", fmt.Sprint(e.Reason),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostClaimBoundEvent) FormatMessage() string {
+    s := "Host {host_name}: bound to cluster {cluster_id} as {role} by its host claim ({reason})"
+    return e.format(&s)
+}
+
 //
 // Event inactive_clusters_deregistered
 //
@@ -6774,3 +7144,859 @@ func (e *RebootsForNodeEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_decommission_started
+//
+type HostDecommissionStartedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    NodeName string
+}
+
+var HostDecommissionStartedEventName string = "host_decommission_started"
+
+func NewHostDecommissionStartedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+) *HostDecommissionStartedEvent {
+    return &HostDecommissionStartedEvent{
+        eventName: HostDecommissionStartedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        NodeName: nodeName,
+    }
+}
+
+func SendHostDecommissionStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,) {
+    ev := NewHostDecommissionStartedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDecommissionStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+    eventTime time.Time) {
+    ev := NewHostDecommissionStartedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDecommissionStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDecommissionStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDecommissionStartedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDecommissionStartedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDecommissionStartedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDecommissionStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{node_name}", fmt.Sprint(e.NodeName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDecommissionStartedEvent) FormatMessage() string {
+    s := "Host {host_name}: started the decommission of node {node_name}"
+    return e.format(&s)
+}
+
+//
+// Event host_node_drained
+//
+type HostNodeDrainedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    NodeName string
+}
+
+var HostNodeDrainedEventName string = "host_node_drained"
+
+func NewHostNodeDrainedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+) *HostNodeDrainedEvent {
+    return &HostNodeDrainedEvent{
+        eventName: HostNodeDrainedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        NodeName: nodeName,
+    }
+}
+
+func SendHostNodeDrainedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,) {
+    ev := NewHostNodeDrainedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostNodeDrainedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+    eventTime time.Time) {
+    ev := NewHostNodeDrainedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostNodeDrainedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostNodeDrainedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostNodeDrainedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostNodeDrainedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostNodeDrainedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostNodeDrainedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{node_name}", fmt.Sprint(e.NodeName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostNodeDrainedEvent) FormatMessage() string {
+    s := "Host {host_name}: drained node {node_name}"
+    return e.format(&s)
+}
+
+//
+// Event host_node_deleted
+//
+type HostNodeDeletedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    NodeName string
+}
+
+var HostNodeDeletedEventName string = "host_node_deleted"
+
+func NewHostNodeDeletedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+) *HostNodeDeletedEvent {
+    return &HostNodeDeletedEvent{
+        eventName: HostNodeDeletedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        NodeName: nodeName,
+    }
+}
+
+func SendHostNodeDeletedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,) {
+    ev := NewHostNodeDeletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostNodeDeletedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    nodeName string,
+    eventTime time.Time) {
+    ev := NewHostNodeDeletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        nodeName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostNodeDeletedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostNodeDeletedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostNodeDeletedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostNodeDeletedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostNodeDeletedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostNodeDeletedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{node_name}", fmt.Sprint(e.NodeName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostNodeDeletedEvent) FormatMessage() string {
+    s := "Host {host_name}: deleted node {node_name} from the cluster"
+    return e.format(&s)
+}
+
+//
+// Event host_decommission_completed
+//
+type HostDecommissionCompletedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+}
+
+var HostDecommissionCompletedEventName string = "host_decommission_completed"
+
+func NewHostDecommissionCompletedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+) *HostDecommissionCompletedEvent {
+    return &HostDecommissionCompletedEvent{
+        eventName: HostDecommissionCompletedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+    }
+}
+
+func SendHostDecommissionCompletedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,) {
+    ev := NewHostDecommissionCompletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDecommissionCompletedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    eventTime time.Time) {
+    ev := NewHostDecommissionCompletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDecommissionCompletedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDecommissionCompletedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDecommissionCompletedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDecommissionCompletedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDecommissionCompletedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDecommissionCompletedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDecommissionCompletedEvent) FormatMessage() string {
+    s := "Host {host_name}: decommissioned"
+    return e.format(&s)
+}
+
+//
+// Event host_decommission_failed
+//
+type HostDecommissionFailedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Error string
+}
+
+var HostDecommissionFailedEventName string = "host_decommission_failed"
+
+func NewHostDecommissionFailedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    error string,
+) *HostDecommissionFailedEvent {
+    return &HostDecommissionFailedEvent{
+        eventName: HostDecommissionFailedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Error: error,
+    }
+}
+
+func SendHostDecommissionFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    error string,) {
+    ev := NewHostDecommissionFailedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        error,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDecommissionFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    error string,
+    eventTime time.Time) {
+    ev := NewHostDecommissionFailedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        error,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDecommissionFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDecommissionFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *HostDecommissionFailedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDecommissionFailedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDecommissionFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDecommissionFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDecommissionFailedEvent) FormatMessage() string {
+    s := "Host {host_name}: failed to decommission: {error}"
+    return e.format(&s)
+}
+
+//
+// Event host_csr_approved
+//
+type HostCsrApprovedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    CsrName string
+}
+
+var HostCsrApprovedEventName string = "host_csr_approved"
+
+func NewHostCsrApprovedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,
+) *HostCsrApprovedEvent {
+    return &HostCsrApprovedEvent{
+        eventName: HostCsrApprovedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        CsrName: csrName,
+    }
+}
+
+func SendHostCsrApprovedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,) {
+    ev := NewHostCsrApprovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostCsrApprovedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,
+    eventTime time.Time) {
+    ev := NewHostCsrApprovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostCsrApprovedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostCsrApprovedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostCsrApprovedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostCsrApprovedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostCsrApprovedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostCsrApprovedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{csr_name}", fmt.Sprint(e.CsrName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostCsrApprovedEvent) FormatMessage() string {
+    s := "Host {host_name}: approved certificate signing request {csr_name}"
+    return e.format(&s)
+}
+
+//
+// Event host_csr_approval_denied
+//
+type HostCsrApprovalDeniedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    CsrName string
+    Rule string
+    Reason string
+}
+
+var HostCsrApprovalDeniedEventName string = "host_csr_approval_denied"
+
+func NewHostCsrApprovalDeniedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,
+    rule string,
+    reason string,
+) *HostCsrApprovalDeniedEvent {
+    return &HostCsrApprovalDeniedEvent{
+        eventName: HostCsrApprovalDeniedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        CsrName: csrName,
+        Rule: rule,
+        Reason: reason,
+    }
+}
+
+func SendHostCsrApprovalDeniedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,
+    rule string,
+    reason string,) {
+    ev := NewHostCsrApprovalDeniedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrName,
+        rule,
+        reason,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostCsrApprovalDeniedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrName string,
+    rule string,
+    reason string,
+    eventTime time.Time) {
+    ev := NewHostCsrApprovalDeniedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrName,
+        rule,
+        reason,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostCsrApprovalDeniedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostCsrApprovalDeniedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostCsrApprovalDeniedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostCsrApprovalDeniedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostCsrApprovalDeniedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostCsrApprovalDeniedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{csr_name}", fmt.Sprint(e.CsrName),
+        "{rule}", fmt.Sprint(e.Rule),
+        "{reason}", fmt.Sprint(e.Reason),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostCsrApprovalDeniedEvent) FormatMessage() string {
+    s := "Host {host_name}: certificate signing request {csr_name} was not approved automatically by rule {rule}: {reason}"
+    return e.format(&s)
+}
+
+//
+// Event host_stage_timeout_remediation
+//
+type HostStageTimeoutRemediationEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Stage string
+    Minutes int64
+    Remediation string
+    Attempt int64
+}
+
+var HostStageTimeoutRemediationEventName string = "host_stage_timeout_remediation"
+
+func NewHostStageTimeoutRemediationEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    stage string,
+    minutes int64,
+    remediation string,
+    attempt int64,
+) *HostStageTimeoutRemediationEvent {
+    return &HostStageTimeoutRemediationEvent{
+        eventName: HostStageTimeoutRemediationEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Stage: stage,
+        Minutes: minutes,
+        Remediation: remediation,
+        Attempt: attempt,
+    }
+}
+
+func SendHostStageTimeoutRemediationEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    stage string,
+    minutes int64,
+    remediation string,
+    attempt int64,) {
+    ev := NewHostStageTimeoutRemediationEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        stage,
+        minutes,
+        remediation,
+        attempt,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostStageTimeoutRemediationEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    stage string,
+    minutes int64,
+    remediation string,
+    attempt int64,
+    eventTime time.Time) {
+    ev := NewHostStageTimeoutRemediationEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        stage,
+        minutes,
+        remediation,
+        attempt,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostStageTimeoutRemediationEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostStageTimeoutRemediationEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostStageTimeoutRemediationEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostStageTimeoutRemediationEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostStageTimeoutRemediationEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostStageTimeoutRemediationEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{stage}", fmt.Sprint(e.Stage),
+        "{minutes}", fmt.Sprint(e.Minutes),
+        "{remediation}", fmt.Sprint(e.Remediation),
+        "{attempt}", fmt.Sprint(e.Attempt),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostStageTimeoutRemediationEvent) FormatMessage() string {
+    s := "Host {host_name}: host stage {stage} has been active more than the timeout of its policy ({minutes} minutes), applying the {remediation} remediation (attempt {attempt})"
+    return e.format(&s)
+}
+
@@ -0,0 +1,12 @@
+package events
+
+// The structured properties stored along with the messages of the events, for the consumers which need the values
+// of the events rather than their text (e.g. the installation timeline)
+
+func (e *HostInstallProgressUpdatedEvent) GetProperties() map[string]interface{} {
+	return map[string]interface{}{"stage": e.Stage}
+}
+
+func (e *ClusterFinalizingStageUpdatedEvent) GetProperties() map[string]interface{} {
+	return map[string]interface{}{"finalizing_stage": e.FinalizingStage}
+}
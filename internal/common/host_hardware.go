@@ -0,0 +1,124 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	DiskTypeNvme = "nvme"
+	DiskTypeSsd  = "ssd"
+	DiskTypeHdd  = "hdd"
+)
+
+// HostHardware is the hardware of a host extracted from its inventory into indexed columns of the hosts table, for
+// the hosts to be queried by their hardware without parsing their inventories
+type HostHardware struct {
+	Hostname         string
+	CPUArchitecture  string `gorm:"index"`
+	CPUModel         string
+	CPUCores         int64 `gorm:"index"`
+	MemoryBytes      int64 `gorm:"index"`
+	DiskCount        int64
+	DisksTotalBytes  int64
+	LargestDiskBytes int64 `gorm:"index"`
+	HasNvme          bool  `gorm:"index"`
+	HasSsd           bool  `gorm:"index"`
+	HasHdd           bool  `gorm:"index"`
+	NicCount         int64
+	MaxNicSpeedMbps  int64  `gorm:"index"`
+	Vendor           string `gorm:"index"`
+	Product          string `gorm:"index"`
+	Virtual          bool
+}
+
+// NewHostHardware extracts the hardware of a host from its inventory. The optical and floppy drives and the
+// installation media aren't counted as disks, and only the physical interfaces are counted as NICs.
+func NewHostHardware(inventory *models.Inventory) *HostHardware {
+	hardware := &HostHardware{}
+	if inventory == nil {
+		return hardware
+	}
+	hardware.Hostname = inventory.Hostname
+	if inventory.CPU != nil {
+		hardware.CPUArchitecture = inventory.CPU.Architecture
+		hardware.CPUModel = inventory.CPU.ModelName
+		hardware.CPUCores = inventory.CPU.Count
+	}
+	if inventory.Memory != nil {
+		hardware.MemoryBytes = inventory.Memory.PhysicalBytes
+	}
+	for _, disk := range inventory.Disks {
+		if disk == nil || disk.IsInstallationMedia || disk.DriveType == models.DriveTypeODD || disk.DriveType == models.DriveTypeFDD {
+			continue
+		}
+		hardware.DiskCount++
+		hardware.DisksTotalBytes += disk.SizeBytes
+		if disk.SizeBytes > hardware.LargestDiskBytes {
+			hardware.LargestDiskBytes = disk.SizeBytes
+		}
+		// The NVMe disks are also SSDs, as reported by their drive type
+		if strings.HasPrefix(disk.Name, "nvme") {
+			hardware.HasNvme = true
+		}
+		switch disk.DriveType {
+		case models.DriveTypeSSD:
+			hardware.HasSsd = true
+		case models.DriveTypeHDD:
+			hardware.HasHdd = true
+		}
+	}
+	for _, iface := range inventory.Interfaces {
+		if iface == nil || (iface.Type != "" && iface.Type != "physical") {
+			continue
+		}
+		hardware.NicCount++
+		if iface.SpeedMbps > hardware.MaxNicSpeedMbps {
+			hardware.MaxNicSpeedMbps = iface.SpeedMbps
+		}
+	}
+	if inventory.SystemVendor != nil {
+		hardware.Vendor = inventory.SystemVendor.Manufacturer
+		hardware.Product = inventory.SystemVendor.ProductName
+		hardware.Virtual = inventory.SystemVendor.Virtual
+	}
+	return hardware
+}
+
+// DiskTypes returns the types of the disks of the host
+func (h *HostHardware) DiskTypes() []string {
+	diskTypes := []string{}
+	if h.HasNvme {
+		diskTypes = append(diskTypes, DiskTypeNvme)
+	}
+	if h.HasSsd {
+		diskTypes = append(diskTypes, DiskTypeSsd)
+	}
+	if h.HasHdd {
+		diskTypes = append(diskTypes, DiskTypeHdd)
+	}
+	return diskTypes
+}
+
+// Columns returns the values of the hardware columns of the hosts table, to be updated with the inventory
+func (h *HostHardware) Columns() map[string]interface{} {
+	return map[string]interface{}{
+		"hardware_hostname":           h.Hostname,
+		"hardware_cpu_architecture":   h.CPUArchitecture,
+		"hardware_cpu_model":          h.CPUModel,
+		"hardware_cpu_cores":          h.CPUCores,
+		"hardware_memory_bytes":       h.MemoryBytes,
+		"hardware_disk_count":         h.DiskCount,
+		"hardware_disks_total_bytes":  h.DisksTotalBytes,
+		"hardware_largest_disk_bytes": h.LargestDiskBytes,
+		"hardware_has_nvme":           h.HasNvme,
+		"hardware_has_ssd":            h.HasSsd,
+		"hardware_has_hdd":            h.HasHdd,
+		"hardware_nic_count":          h.NicCount,
+		"hardware_max_nic_speed_mbps": h.MaxNicSpeedMbps,
+		"hardware_vendor":             h.Vendor,
+		"hardware_product":            h.Product,
+		"hardware_virtual":            h.Virtual,
+	}
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("NewHostHardware", func() {
+	It("extracts the hardware of the inventory", func() {
+		inventory := &models.Inventory{
+			Hostname: "worker-0",
+			CPU:      &models.CPU{Architecture: "x86_64", ModelName: "Intel Xeon", Count: 64},
+			Memory:   &models.Memory{PhysicalBytes: 549755813888, UsableBytes: 540000000000},
+			Disks: []*models.Disk{
+				{Name: "nvme0n1", DriveType: models.DriveTypeSSD, SizeBytes: 1600000000000},
+				{Name: "sda", DriveType: models.DriveTypeHDD, SizeBytes: 4000000000000},
+				{Name: "sr0", DriveType: models.DriveTypeODD, SizeBytes: 1000000000},
+				{Name: "sdb", DriveType: models.DriveTypeSSD, SizeBytes: 120000000000, IsInstallationMedia: true},
+			},
+			Interfaces: []*models.Interface{
+				{Name: "eno1", Type: "physical", SpeedMbps: 25000},
+				{Name: "eno2", SpeedMbps: 1000},
+				{Name: "bond0", Type: "bond", SpeedMbps: 50000},
+			},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc.", ProductName: "PowerEdge R750"},
+		}
+
+		hardware := NewHostHardware(inventory)
+		Expect(*hardware).To(Equal(HostHardware{
+			Hostname:         "worker-0",
+			CPUArchitecture:  "x86_64",
+			CPUModel:         "Intel Xeon",
+			CPUCores:         64,
+			MemoryBytes:      549755813888,
+			DiskCount:        2,
+			DisksTotalBytes:  5600000000000,
+			LargestDiskBytes: 4000000000000,
+			HasNvme:          true,
+			HasSsd:           true,
+			HasHdd:           true,
+			NicCount:         2,
+			MaxNicSpeedMbps:  25000,
+			Vendor:           "Dell Inc.",
+			Product:          "PowerEdge R750",
+		}))
+		Expect(hardware.DiskTypes()).To(Equal([]string{DiskTypeNvme, DiskTypeSsd, DiskTypeHdd}))
+	})
+
+	It("returns an empty hardware without inventory", func() {
+		hardware := NewHostHardware(nil)
+		Expect(*hardware).To(Equal(HostHardware{}))
+		Expect(hardware.DiskTypes()).To(BeEmpty())
+	})
+
+	It("returns a column per field", func() {
+		Expect((&HostHardware{}).Columns()).To(HaveLen(16))
+		Expect(NewHostHardware(&models.Inventory{CPU: &models.CPU{Count: 8}}).Columns()).To(HaveKeyWithValue("hardware_cpu_cores", int64(8)))
+	})
+})
+
+var _ = Describe("HostHardware columns", func() {
+	It("indexes the disk types", func() {
+		db, dbName := PrepareTestDB()
+		defer DeleteTestDB(db, dbName)
+		for _, column := range []string{"hardware_has_nvme", "hardware_has_ssd", "hardware_has_hdd"} {
+			Expect(db.Migrator().HasIndex(&Host{}, "idx_hosts_"+column)).To(BeTrue(), column)
+		}
+	})
+})
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+func MarshalHostRoleRules(rules []*models.HostRoleRule) (string, error) {
+	if len(rules) == 0 {
+		return "", nil
+	}
+
+	rulesJson, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	return string(rulesJson), nil
+}
+
+func UnmarshalHostRoleRules(rulesStr string) ([]*models.HostRoleRule, error) {
+	var rules []*models.HostRoleRule
+	if rulesStr == "" {
+		return rules, nil
+	}
+
+	if err := json.Unmarshal([]byte(rulesStr), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+func MarshalHostStageTimeoutPolicies(policies []*models.HostStageTimeoutPolicy) (string, error) {
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	policiesJson, err := json.Marshal(policies)
+	if err != nil {
+		return "", err
+	}
+	return string(policiesJson), nil
+}
+
+func UnmarshalHostStageTimeoutPolicies(policiesStr string) ([]*models.HostStageTimeoutPolicy, error) {
+	var policies []*models.HostStageTimeoutPolicy
+	if policiesStr == "" {
+		return policies, nil
+	}
+
+	if err := json.Unmarshal([]byte(policiesStr), &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+func MarshalIgnitionOverrideLayers(layers []*models.IgnitionOverrideLayer) (string, error) {
+	if len(layers) == 0 {
+		return "", nil
+	}
+
+	layersJson, err := json.Marshal(layers)
+	if err != nil {
+		return "", err
+	}
+	return string(layersJson), nil
+}
+
+func UnmarshalIgnitionOverrideLayers(layersStr string) ([]*models.IgnitionOverrideLayer, error) {
+	var layers []*models.IgnitionOverrideLayer
+	if layersStr == "" {
+		return layers, nil
+	}
+
+	if err := json.Unmarshal([]byte(layersStr), &layers); err != nil {
+		return nil, err
+	}
+	return layers, nil
+}
@@ -18,6 +18,22 @@ const (
 
 type MonitorInitialQueryBuilder func(db *gorm.DB) *gorm.DB
 
+// MonitorIDFilter selects the IDs of the clusters or infra-envs to monitor, e.g. the ones of the shard of the replica
+type MonitorIDFilter func(id string) bool
+
+func filterIDs(ids []string, filter MonitorIDFilter) []string {
+	if filter == nil {
+		return ids
+	}
+	var ret []string
+	for _, id := range ids {
+		if filter(id) {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
 type MonitorQuery interface {
 	Next() ([]*Cluster, error)
 }
@@ -77,6 +93,12 @@ type timedQuery struct {
 
 	// Max batch size (limit)
 	batchSize int
+
+	// Scan all the cluster ids instead of the updated ones, used for a full query with a filter
+	full bool
+
+	// Filter of the cluster ids
+	filter MonitorIDFilter
 }
 
 func min(i, j int) int {
@@ -97,9 +119,7 @@ func (t *timedQuery) Next() ([]*Cluster, error) {
 	for (!t.eof || t.offset < len(t.ids)) && len(clusters) == 0 {
 		if t.offset == len(t.ids) {
 			t.ids = nil
-			// Retrieve cluster ids that the related cluster or hosts have been updated after the timeToCompare
-			err = t.db.Raw("select distinct(cid) as id from (select id as cid from clusters where trigger_monitor_timestamp > ?  and clusters.id > ? union select cluster_id as cid from hosts where trigger_monitor_timestamp > ? and hosts.cluster_id > ?) as t order by id limit ?",
-				t.timeToCompare, t.lastId, t.timeToCompare, t.lastId, IdsQuerySize).Pluck("id", &t.ids).Error
+			err = t.idsQuery().Pluck("id", &t.ids).Error
 			if err != nil {
 				return clusters, err
 			}
@@ -109,6 +129,7 @@ func (t *timedQuery) Next() ([]*Cluster, error) {
 			if len(t.ids) > 0 {
 				t.lastId = t.ids[len(t.ids)-1]
 			}
+			t.ids = filterIDs(t.ids, t.filter)
 			t.offset = 0
 		}
 		for len(clusters) == 0 && t.offset < len(t.ids) {
@@ -125,12 +146,22 @@ func (t *timedQuery) Next() ([]*Cluster, error) {
 	return clusters, nil
 }
 
+func (t *timedQuery) idsQuery() *gorm.DB {
+	if t.full {
+		return t.db.Raw("select id from clusters where id > ? and deleted_at is null order by id limit ?", t.lastId, IdsQuerySize)
+	}
+	// Retrieve cluster ids that the related cluster or hosts have been updated after the timeToCompare
+	return t.db.Raw("select distinct(cid) as id from (select id as cid from clusters where trigger_monitor_timestamp > ?  and clusters.id > ? union select cluster_id as cid from hosts where trigger_monitor_timestamp > ? and hosts.cluster_id > ?) as t order by id limit ?",
+		t.timeToCompare, t.lastId, t.timeToCompare, t.lastId, IdsQuerySize)
+}
+
 type MonitorClusterQueryGenerator struct {
 	lastInvokeTime    time.Time
 	calls             int64
 	db                *gorm.DB
 	buildInitialQuery MonitorInitialQueryBuilder
 	batchSize         int
+	filter            MonitorIDFilter
 }
 
 func NewMonitorQueryGenerator(db *gorm.DB, buildInitialQuery MonitorInitialQueryBuilder, batchSize int) *MonitorClusterQueryGenerator {
@@ -144,6 +175,12 @@ func NewMonitorQueryGenerator(db *gorm.DB, buildInitialQuery MonitorInitialQuery
 	}
 }
 
+// WithFilter restricts the queries to the cluster ids selected by the filter
+func (m *MonitorClusterQueryGenerator) WithFilter(filter MonitorIDFilter) *MonitorClusterQueryGenerator {
+	m.filter = filter
+	return m
+}
+
 func timeForDuration(d time.Duration) time.Time {
 	return time.Now().Add(-d)
 }
@@ -156,6 +193,16 @@ func (m *MonitorClusterQueryGenerator) NewClusterQuery() MonitorQuery {
 	}()
 	if m.calls == 0 ||
 		m.lastInvokeTime.Minute()/5 != newInvokeTime.Minute()/5 {
+		if m.filter != nil {
+			// The ids need to be filtered before the clusters are loaded
+			return &timedQuery{
+				db:                m.db,
+				buildInitialQuery: m.buildInitialQuery,
+				batchSize:         m.batchSize,
+				full:              true,
+				filter:            m.filter,
+			}
+		}
 		return &fullQuery{
 			db:                m.db,
 			buildInitialQuery: m.buildInitialQuery,
@@ -169,6 +216,7 @@ func (m *MonitorClusterQueryGenerator) NewClusterQuery() MonitorQuery {
 			buildInitialQuery: m.buildInitialQuery,
 			timeToCompare:     timeForDuration(15 * time.Minute),
 			batchSize:         m.batchSize,
+			filter:            m.filter,
 		}
 	}
 	return &timedQuery{
@@ -176,6 +224,7 @@ func (m *MonitorClusterQueryGenerator) NewClusterQuery() MonitorQuery {
 		buildInitialQuery: m.buildInitialQuery,
 		timeToCompare:     timeForDuration(5 * time.Minute),
 		batchSize:         m.batchSize,
+		filter:            m.filter,
 	}
 }
 
@@ -233,6 +282,9 @@ type infraEnvQuery struct {
 
 	// Max batch size (limit)
 	batchSize int
+
+	// Filter of the infra-env ids
+	filter MonitorIDFilter
 }
 
 /*
@@ -260,6 +312,7 @@ func (f *infraEnvQuery) Next() ([]*InfraEnv, error) {
 			if len(f.ids) > 0 {
 				f.lastId = f.ids[len(f.ids)-1]
 			}
+			f.ids = filterIDs(f.ids, f.filter)
 			f.offset = 0
 		}
 		for len(infraEnvs) == 0 && f.offset < len(f.ids) {
@@ -281,6 +334,13 @@ type MonitorInfraEnvQueryGenerator struct {
 	calls          int64
 	db             *gorm.DB
 	batchSize      int
+	filter         MonitorIDFilter
+}
+
+// WithFilter restricts the queries to the infra-env ids selected by the filter
+func (m *MonitorInfraEnvQueryGenerator) WithFilter(filter MonitorIDFilter) *MonitorInfraEnvQueryGenerator {
+	m.filter = filter
+	return m
 }
 
 func (m *MonitorInfraEnvQueryGenerator) NewInfraEnvQuery() MonitorInfraEnvQuery {
@@ -296,6 +356,7 @@ func (m *MonitorInfraEnvQueryGenerator) NewInfraEnvQuery() MonitorInfraEnvQuery
 				db: m.db,
 			},
 			batchSize: m.batchSize,
+			filter:    m.filter,
 		}
 	}
 
@@ -306,6 +367,7 @@ func (m *MonitorInfraEnvQueryGenerator) NewInfraEnvQuery() MonitorInfraEnvQuery
 				timeToCompare: timeForDuration(15 * time.Minute),
 			},
 			batchSize: m.batchSize,
+			filter:    m.filter,
 		}
 	}
 	return &infraEnvQuery{
@@ -314,6 +376,7 @@ func (m *MonitorInfraEnvQueryGenerator) NewInfraEnvQuery() MonitorInfraEnvQuery
 			timeToCompare: timeForDuration(5 * time.Minute),
 		},
 		batchSize: m.batchSize,
+		filter:    m.filter,
 	}
 }
 
@@ -25,6 +25,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -39,6 +40,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
@@ -78,6 +80,10 @@ const (
 	AgentLabelClusterDeploymentNamespace = BaseLabelPrefix + "clusterdeployment-namespace"
 )
 
+// csrDenialRetention is the time a reported CSR denial is remembered, longer than the time a pending CSR is kept by
+// the cluster
+const csrDenialRetention = 24 * time.Hour
+
 // AgentReconciler reconciles a Agent object
 type AgentReconciler struct {
 	client.Client
@@ -94,6 +100,10 @@ type AgentReconciler struct {
 	HostFSMountDir             string
 	reclaimer                  *agentReclaimer
 	EventSender                eventsapi.Sender
+	CSRApprovalPolicy          *CSRApprovalPolicy
+	// csrDenials holds the CSRs whose denial by the approval policy was reported, so a pending CSR checked on
+	// every requeue is reported once
+	csrDenials sync.Map
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -215,6 +225,11 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 		return r.updateStatus(ctx, log, agent, origAgent, &h.Host, h.ClusterID, err, !IsUserError(err))
 	}
 
+	h, err = r.decommissionIfNeeded(ctx, log, agent, h)
+	if err != nil {
+		return r.updateStatus(ctx, log, agent, origAgent, &h.Host, h.ClusterID, err, !IsUserError(err))
+	}
+
 	err = r.updateInventoryAndLabels(log, ctx, &h.Host, agent)
 	if err != nil {
 		return r.updateStatus(ctx, log, agent, origAgent, &h.Host, h.ClusterID, err, true)
@@ -455,7 +470,8 @@ func (r *AgentReconciler) shouldApproveCSR(csr *certificatesv1.CertificateSignin
 	return validateNodeCsr(agent, csr, x509CSR)
 }
 
-func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator) {
+func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_k8s_client.SpokeK8sClient, agent *aiv1beta1.Agent, h *models.Host,
+	node *corev1.Node, validateNodeCsr nodeCsrValidator) {
 	csrList, err := clients.ListCsrs(ctx)
 	if err != nil {
 		r.Log.WithError(err).Errorf("Failed to get CSRs for agent %s/%s", agent.Namespace, agent.Name)
@@ -472,14 +488,42 @@ func (r *AgentReconciler) approveAIHostsCSRs(ctx context.Context, clients spoke_
 				}
 				continue
 			}
+			rule, err := r.CSRApprovalPolicy.evaluate(&csrApprovalRequest{agent: agent, host: h, node: node, csr: csr, now: time.Now()})
+			if err != nil {
+				r.reportCSRDenial(ctx, agent, h, csr, rule, err)
+				continue
+			}
 			if err = clients.ApproveCsr(ctx, csr); err != nil {
 				r.Log.WithError(err).Errorf("Failed to approve CSR %s for agent %s/%s", csr.Name, agent.Namespace, agent.Name)
 				continue
 			}
+			r.Log.Infof("Approved CSR %s for agent %s/%s", csr.Name, agent.Namespace, agent.Name)
+			events.SendHostCsrApprovedEvent(ctx, r.EventSender, *h.ID, h.InfraEnvID, h.ClusterID, hostutil.GetHostnameForMsg(h), csr.Name)
 		}
 	}
 }
 
+// reportCSRDenial logs and sends an event for a CSR left for a manual approval by the CSR approval policy, once per
+// CSR and rule
+func (r *AgentReconciler) reportCSRDenial(ctx context.Context, agent *aiv1beta1.Agent, h *models.Host, csr *certificatesv1.CertificateSigningRequest,
+	rule string, reason error) {
+	now := time.Now()
+	key := string(csr.UID) + "/" + rule
+	if _, reported := r.csrDenials.LoadOrStore(key, now); reported {
+		return
+	}
+	r.csrDenials.Range(func(k, v interface{}) bool {
+		if now.Sub(v.(time.Time)) > csrDenialRetention {
+			r.csrDenials.Delete(k)
+		}
+		return true
+	})
+	r.Log.WithError(reason).Warnf("CSR %s for agent %s/%s was not approved by rule %s of the CSR approval policy",
+		csr.Name, agent.Namespace, agent.Name, rule)
+	events.SendHostCsrApprovalDeniedEvent(ctx, r.EventSender, *h.ID, h.InfraEnvID, h.ClusterID, hostutil.GetHostnameForMsg(h),
+		csr.Name, rule, reason.Error())
+}
+
 func (r *AgentReconciler) spokeKubeClient(ctx context.Context, clusterRef *aiv1beta1.ClusterReference) (spoke_k8s_client.SpokeK8sClient, error) {
 	secret, err := spokeKubeconfigSecret(ctx, r.Log, r.Client, r.APIReader, clusterRef)
 	if err != nil {
@@ -516,7 +560,7 @@ func (r *AgentReconciler) notifyNumberOfReboots(ctx context.Context, h *models.H
 
 // Attempt to approve CSRs for agent. If already approved then the node will be marked as done
 // requeue means that approval will be attempted again
-func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1beta1.Agent, node *corev1.Node, client spoke_k8s_client.SpokeK8sClient) {
+func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1beta1.Agent, h *models.Host, node *corev1.Node, client spoke_k8s_client.SpokeK8sClient) {
 	r.Log.Infof("Approving CSRs for agent %s/%s", agent.Namespace, agent.Name)
 	var validateNodeCsr nodeCsrValidator
 
@@ -527,7 +571,7 @@ func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1bet
 	}
 
 	// Even if node is already ready, we try approving last time
-	r.approveAIHostsCSRs(ctx, client, agent, validateNodeCsr)
+	r.approveAIHostsCSRs(ctx, client, agent, h, node, validateNodeCsr)
 }
 
 func (r *AgentReconciler) bmhExists(ctx context.Context, agent *aiv1beta1.Agent) (bool, error) {
@@ -775,6 +819,12 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 		agent.Status.DebugInfo.State = swag.StringValue(h.Status)
 		agent.Status.DebugInfo.StateInfo = swag.StringValue(h.StatusInfo)
 		agent.Status.InstallationDiskID = h.InstallationDiskID
+		agent.Status.DecommissionStage = h.DecommissionStage
+		agent.Status.DecommissionInfo = h.DecommissionInfo
+		if funk.ContainsString(decommissionInProgressStages, h.DecommissionStage) {
+			// The decommission progresses in the background, so its stage is polled
+			ret = ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}
+		}
 
 		if h.ValidationsInfo != "" {
 			newValidationsInfo := ValidationsStatus{}
@@ -811,7 +861,7 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 					node = nil
 				}
 				if shouldAutoApproveCSRs {
-					r.tryApproveDay2CSRs(ctx, agent, node, spokeClient)
+					r.tryApproveDay2CSRs(ctx, agent, h, node, spokeClient)
 				}
 				if err = r.applyDay2NodeLabels(ctx, log, agent, node, spokeClient); err != nil {
 					log.WithError(err).Errorf("Failed to apply labels for day2 node %s/%s", agent.Namespace, agent.Name)
@@ -837,6 +887,12 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 			stageUpdateTime := metav1.NewTime(time.Time(h.Progress.StageUpdatedAt))
 			agent.Status.Progress.StageUpdateTime = &stageUpdateTime
 			agent.Status.Progress.ProgressStages = h.ProgressStages
+			agent.Status.Progress.StageRemediation = h.Progress.StageRemediation
+			agent.Status.Progress.StageRemediationTime = nil
+			if !time.Time(h.Progress.StageRemediationAt).IsZero() {
+				stageRemediationTime := metav1.NewTime(time.Time(h.Progress.StageRemediationAt))
+				agent.Status.Progress.StageRemediationTime = &stageRemediationTime
+			}
 		} else {
 			agent.Status.Progress = aiv1beta1.HostProgressInfo{}
 		}
@@ -1004,6 +1060,33 @@ func specSynced(agent *aiv1beta1.Agent, syncErr error, internal bool) {
 	})
 }
 
+var decommissionInProgressStages = []string{
+	models.HostDecommissionStageDraining,
+	models.HostDecommissionStageDeletingNode,
+	models.HostDecommissionStageWipingDisks,
+}
+
+// decommissionIfNeeded starts the decommission of the host once it is requested in the spec of the agent. A failed
+// decommission isn't started again by the controller, it can be retried with the REST API.
+func (r *AgentReconciler) decommissionIfNeeded(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) (*common.Host, error) {
+	if agent.Spec.Decommission == nil || h.ClusterID == nil || h.DecommissionStage != "" {
+		return h, nil
+	}
+	log.Infof("Decommissioning agent %s/%s", agent.Namespace, agent.Name)
+	host, err := r.Installer.V2DecommissionHostInternal(ctx, installer.V2DecommissionHostParams{
+		ClusterID: *h.ClusterID,
+		HostID:    *h.ID,
+		DecommissionParams: &models.DecommissionParams{
+			WipeDisks: swag.Bool(agent.Spec.Decommission.WipeDisks),
+		},
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to decommission agent %s/%s", agent.Namespace, agent.Name)
+		return h, err
+	}
+	return host, nil
+}
+
 func (r *AgentReconciler) updateInstallerArgs(ctx context.Context, log logrus.FieldLogger, host *common.Host, agent *aiv1beta1.Agent) error {
 
 	if agent.Spec.InstallerArgs == host.InstallerArgs {
@@ -1654,6 +1737,17 @@ func (r *AgentReconciler) updateIfNeeded(ctx context.Context, log logrus.FieldLo
 		}
 		log.Infof("Updated Agent Approve %s %s", agent.Name, agent.Namespace)
 	}
+	bareMetalHost, err := r.bmhExists(ctx, agent)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get the BareMetalHost of Agent")
+		return returnedHost, err
+	}
+	if internalHost.BareMetalHost != bareMetalHost {
+		if err = r.Installer.UpdateHostBareMetalHostInternal(ctx, internalHost.InfraEnvID.String(), agent.Name, bareMetalHost); err != nil {
+			log.WithError(err).Errorf("Failed to update the BareMetalHost of Agent")
+			return returnedHost, err
+		}
+	}
 	log.Debugf("Updated Agent spec %s %s", agent.Name, agent.Namespace)
 
 	return returnedHost, nil
@@ -22,6 +22,9 @@ import (
 	"github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
@@ -579,6 +582,7 @@ var _ = Describe("agent reconcile", func() {
 				host.SetLabels(labels)
 				bmh := newBMH("my-bmh", &bmh_v1alpha1.BareMetalHostSpec{})
 				Expect(c.Create(ctx, bmh)).ToNot(HaveOccurred())
+				mockInstallerInternal.EXPECT().UpdateHostBareMetalHostInternal(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(nil).AnyTimes()
 				aci := newAgentClusterInstall("test-cluster-aci", testNamespace, getDefaultAgentClusterInstallSpec("clusterDeployment-test"), clusterDeployment)
 				Expect(c.Create(ctx, aci)).To(BeNil())
 			})
@@ -916,6 +920,41 @@ var _ = Describe("agent reconcile", func() {
 		Expect(conditionsv1.FindStatusCondition(agent.Status.Conditions, v1beta1.SpecSyncedCondition).Status).To(Equal(corev1.ConditionTrue))
 	})
 
+	It("Agent update BareMetalHost", func() {
+		hostId := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:         &hostId,
+				InfraEnvID: infraEnvId,
+				ClusterID:  &sId,
+				Inventory:  common.GenerateTestDefaultInventory(),
+				Status:     swag.String(models.HostStatusKnown),
+				StatusInfo: swag.String("Some status info"),
+			},
+		}
+		backEndCluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &sId,
+			Hosts: []*models.Host{
+				&commonHost.Host,
+			}}}
+
+		bmh := newBMH("testBMH", &bmh_v1alpha1.BareMetalHostSpec{})
+		Expect(c.Create(ctx, bmh)).To(Succeed())
+		host := newAgent(hostId.String(), testNamespace, v1beta1.AgentSpec{ClusterDeploymentName: &v1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace}})
+		host.ObjectMeta.Labels = map[string]string{AGENT_BMH_LABEL: bmh.Name}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+		mockInstallerInternal.EXPECT().UpdateHostBareMetalHostInternal(gomock.Any(), infraEnvId.String(), hostId.String(), true).Return(nil)
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, "infraEnvName")
+		Expect(c.Create(ctx, host)).To(BeNil())
+		result, err := hr.Reconcile(ctx, newHostRequest(host))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
 	Context("host reclaim", func() {
 		var (
 			commonHost            *common.Host
@@ -1109,6 +1148,7 @@ var _ = Describe("agent reconcile", func() {
 			}
 			host.ObjectMeta.Labels[AGENT_BMH_LABEL] = bmh.Name
 			Expect(c.Update(ctx, host)).To(Succeed())
+			mockInstallerInternal.EXPECT().UpdateHostBareMetalHostInternal(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(nil).AnyTimes()
 			expectDBClusterWithKubeKeys()
 
 			mockInstallerInternal.EXPECT().UnbindHostInternal(gomock.Any(), gomock.Any(), false, bminventory.NonInteractive).Return(commonHost, nil)
@@ -1551,6 +1591,109 @@ var _ = Describe("agent reconcile", func() {
 		Expect(conditionsv1.FindStatusCondition(host.Status.Conditions, v1beta1.SpecSyncedCondition).Status).To(Equal(corev1.ConditionFalse))
 	})
 
+	It("Agent decommission", func() {
+		hostId := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:         &hostId,
+				ClusterID:  &sId,
+				InfraEnvID: infraEnvId,
+				Status:     swag.String(models.HostStatusInstalled),
+			},
+		}
+		backEndCluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &sId,
+			Hosts: []*models.Host{
+				&commonHost.Host,
+			}}}
+		key := types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      hostId.String(),
+		}
+
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, "infraEnvName")
+
+		host := newAgent(hostId.String(), testNamespace, v1beta1.AgentSpec{ClusterDeploymentName: &v1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace}})
+		host.Spec.Decommission = &v1beta1.DecommissionSpec{WipeDisks: true}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+		Expect(c.Create(ctx, host)).To(BeNil())
+
+		By("Reconcile with decommission, validate the decommission is started")
+		decommissionedHost := &common.Host{Host: commonHost.Host}
+		decommissionedHost.DecommissionStage = models.HostDecommissionStageDraining
+		decommissionedHost.DecommissionInfo = "Draining node"
+		mockInstallerInternal.EXPECT().V2DecommissionHostInternal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params installer.V2DecommissionHostParams) (*common.Host, error) {
+				Expect(params.ClusterID).To(Equal(sId))
+				Expect(params.HostID).To(Equal(hostId))
+				Expect(swag.BoolValue(params.DecommissionParams.WipeDisks)).To(BeTrue())
+				return decommissionedHost, nil
+			}).Times(1)
+		result, err := hr.Reconcile(ctx, newHostRequest(host))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
+		Expect(c.Get(ctx, key, host)).To(BeNil())
+		Expect(host.Status.DecommissionStage).To(Equal(models.HostDecommissionStageDraining))
+		Expect(host.Status.DecommissionInfo).To(Equal("Draining node"))
+
+		By("Reconcile during the decommission, validate it isn't started again")
+		commonHost.DecommissionStage = models.HostDecommissionStageCompleted
+		commonHost.DecommissionInfo = "Node removed"
+		result, err = hr.Reconcile(ctx, newHostRequest(host))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, key, host)).To(BeNil())
+		Expect(host.Status.DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+	})
+
+	It("Agent decommission rejected", func() {
+		hostId := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:         &hostId,
+				ClusterID:  &sId,
+				InfraEnvID: infraEnvId,
+				Status:     swag.String(models.HostStatusKnown),
+			},
+		}
+		backEndCluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &sId,
+			Hosts: []*models.Host{
+				&commonHost.Host,
+			}}}
+		key := types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      hostId.String(),
+		}
+
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, "infraEnvName")
+
+		host := newAgent(hostId.String(), testNamespace, v1beta1.AgentSpec{ClusterDeploymentName: &v1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace}})
+		host.Spec.Decommission = &v1beta1.DecommissionSpec{}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+		Expect(c.Create(ctx, host)).To(BeNil())
+
+		errString := "only installed hosts can be decommissioned"
+		mockInstallerInternal.EXPECT().V2DecommissionHostInternal(gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New(errString))).Times(1)
+		result, err := hr.Reconcile(ctx, newHostRequest(host))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, key, host)).To(BeNil())
+		expectedState := fmt.Sprintf("%s %s", v1beta1.InputErrorMsg, errString)
+		Expect(conditionsv1.FindStatusCondition(host.Status.Conditions, v1beta1.SpecSyncedCondition).Message).To(Equal(expectedState))
+		Expect(conditionsv1.FindStatusCondition(host.Status.Conditions, v1beta1.SpecSyncedCondition).Status).To(Equal(corev1.ConditionFalse))
+		Expect(host.Status.DecommissionStage).To(BeEmpty())
+	})
+
 	It("Agent update installer args valid cases", func() {
 		hostId := strfmt.UUID(uuid.New().String())
 		infraEnvId := strfmt.UUID(uuid.New().String())
@@ -2072,6 +2215,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		hostId                strfmt.UUID
 		mockInstallerInternal *bminventory.MockInstallerInternals
 		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockEvents            *eventsapi.MockHandler
 		commonHost            *common.Host
 	)
 	newAciWithUserManagedNetworkingNoSNO := func(name, namespace string) *hiveext.AgentClusterInstall {
@@ -2269,6 +2413,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
 		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		mockEvents = eventsapi.NewMockHandler(mockCtrl)
 		hr = &AgentReconciler{
 			Client:                     c,
 			Scheme:                     scheme.Scheme,
@@ -2277,6 +2422,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			APIReader:                  c,
 			SpokeK8sClientFactory:      mockClientFactory,
 			ApproveCsrsRequeueDuration: time.Minute,
+			EventSender:                mockEvents,
 		}
 		sId := strfmt.UUID(uuid.New().String())
 		hostId = strfmt.UUID(uuid.New().String())
@@ -2340,6 +2486,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		getNodeCount        int
 		isDay1Host          bool
 		bmhExists           bool
+		csrApprovalPolicy   *v1beta1.CSRApprovalPolicy
 	}{
 		{
 			name:                "Not day 2 host - do nothing",
@@ -2532,6 +2679,41 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			updateProgressStage: true,
 			getNodeCount:        1,
 		},
+		{
+			name:         "Do not auto approve CSR denied by the CSR approval policy",
+			createClient: true,
+			hostname:     CommonHostname,
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: CommonHostname,
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionFalse,
+						},
+					},
+					Addresses: []corev1.NodeAddress{
+						{
+							Type:    corev1.NodeInternalIP,
+							Address: "192.168.111.28",
+						},
+					},
+				},
+			},
+			csrs:            serverCsrs(),
+			approveExpected: false,
+			expectedResult: ctrl.Result{
+				RequeueAfter: time.Minute,
+			},
+			expectedStatus:      models.HostStatusInstalling,
+			expectedStage:       models.HostStageJoined,
+			clusterInstall:      newAciWithUserManagedNetworkingNoSNO("test-cluster-aci", testNamespace),
+			updateProgressStage: true,
+			getNodeCount:        1,
+			csrApprovalPolicy:   &v1beta1.CSRApprovalPolicy{TPM: true},
+		},
 		{
 			name:         "Auto approve CSR for Not ready matching node and UserManagedNetworking is true and BMH exists",
 			createClient: true,
@@ -2852,6 +3034,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 				Expect(c.Create(ctx, bmh)).To(Succeed())
 				host.ObjectMeta.Labels = make(map[string]string)
 				host.ObjectMeta.Labels[AGENT_BMH_LABEL] = bmh.Name
+				mockInstallerInternal.EXPECT().UpdateHostBareMetalHostInternal(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(nil).AnyTimes()
 			}
 			Expect(c.Create(ctx, host)).To(BeNil())
 			if t.createClient {
@@ -2863,8 +3046,17 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 				}
 				if t.approveExpected {
 					mockClient.EXPECT().ApproveCsr(gomock.Any(), gomock.Any()).Return(nil)
+					mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+						eventstest.WithNameMatcher(eventgen.HostCsrApprovedEventName),
+						eventstest.WithHostIdMatcher(hostId.String()))).Times(1)
 				}
 			}
+			if t.csrApprovalPolicy != nil {
+				hr.CSRApprovalPolicy = NewCSRApprovalPolicy(t.csrApprovalPolicy)
+				mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.HostCsrApprovalDeniedEventName),
+					eventstest.WithHostIdMatcher(hostId.String()))).Times(1)
+			}
 			hostRequest = newHostRequest(host)
 			result, err := hr.Reconcile(ctx, hostRequest)
 			if t.expectedError == nil {
@@ -1083,6 +1083,7 @@ func newAssistedCM(ctx context.Context, log logrus.FieldLogger, asc ASC) (client
 			"SELF_VERSION":           ServiceImage(),
 			"OS_IMAGES":              getOSImages(log, asc.spec),
 			"MUST_GATHER_IMAGES":     getMustGatherImages(log, asc.spec),
+			"CSR_APPROVAL_POLICY":    getCSRApprovalPolicy(log, asc.spec),
 			"ISO_IMAGE_TYPE":         "minimal-iso",
 			"S3_USE_SSL":             "false",
 			"LOG_LEVEL":              "info",
@@ -1942,6 +1943,22 @@ func getOSImages(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec)
 	return string(encodedOSImages)
 }
 
+// getCSRApprovalPolicy returns the value of CSR_APPROVAL_POLICY variable
+// to be stored in the service's ConfigMap, which is the csrApprovalPolicy
+// field of the AgentServiceConfig's Spec as a JSON string, or an empty
+// string when the field isn't set or can't be marshaled
+func getCSRApprovalPolicy(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec) string {
+	if spec.CSRApprovalPolicy == nil {
+		return ""
+	}
+	encodedPolicy, err := json.Marshal(spec.CSRApprovalPolicy)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Problem marshaling CSR approval policy (%v) to string", spec.CSRApprovalPolicy))
+		return ""
+	}
+	return string(encodedPolicy)
+}
+
 // exposeIPXEHTTPRoute returns true if spec.IPXEHTTPRoute is set to true
 func exposeIPXEHTTPRoute(spec *aiv1beta1.AgentServiceConfigSpec) bool {
 	switch spec.IPXEHTTPRoute {
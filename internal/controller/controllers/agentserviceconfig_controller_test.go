@@ -2159,6 +2159,11 @@ var _ = Describe("newAssistedCM", func() {
 		ascc = initASC(ascr, asc)
 		ensureNewAssistedConfigmapValue(ctx, log, ascc, "PUBLIC_CONTAINER_REGISTRIES", "quay.io,registry.svc.ci.openshift.org,registry.access.redhat.com,docker.io,example.com")
 	})
+	It("sets the CSR approval policy", func() {
+		ensureNewAssistedConfigmapValue(ctx, log, ascc, "CSR_APPROVAL_POLICY", "")
+		asc.Spec.CSRApprovalPolicy = &aiv1beta1.CSRApprovalPolicy{TPM: true}
+		ensureNewAssistedConfigmapValue(ctx, log, ascc, "CSR_APPROVAL_POLICY", `{"tpm":true}`)
+	})
 })
 
 var _ = Describe("getDeploymentData", func() {
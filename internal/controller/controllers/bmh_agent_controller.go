@@ -89,6 +89,8 @@ const (
 	BMH_FINALIZER_NAME                  = "bmac.agent-install.openshift.io/deprovision"
 	BMH_DELETE_ANNOTATION               = "bmac.agent-install.openshift.io/remove-agent-and-node-on-delete"
 	BMH_CLUSTER_REFERENCE               = "bmac.agent-install.openshift.io/cluster-reference"
+	BMH_REBOOT_ANNOTATION               = "reboot.metal3.io"
+	BMH_REMEDIATION_REBOOT_ANNOTATION   = "bmac.agent-install.openshift.io/remediation-reboot-time"
 	MACHINE_ROLE                        = "machine.openshift.io/cluster-api-machine-role"
 	MACHINE_TYPE                        = "machine.openshift.io/cluster-api-machine-type"
 	MCS_CERT_NAME                       = "ca.crt"
@@ -272,6 +274,15 @@ func (r *BMACReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	// The reboot remediation attaches the BMH again, so it is handled before the reconcile of the BMH which stops
+	// on the detached BMHs
+	if agent != nil {
+		result = r.reconcileAgentStageRemediation(log, bmh, agent)
+		if res := r.handleReconcileResult(ctx, log, result, bmh); res != nil {
+			return res.Result()
+		}
+	}
+
 	result = r.reconcileBMH(ctx, log, bmh, agent, infraEnv)
 	if res := r.handleReconcileResult(ctx, log, result, bmh); res != nil {
 		return res.Result()
@@ -606,6 +617,12 @@ func (r *BMACReconciler) ensureBMHDetached(log logrus.FieldLogger, bmh *bmh_v1al
 		bmh.ObjectMeta.Annotations = make(map[string]string)
 	}
 
+	// the BMO only reboots the hosts which are not detached
+	if _, rebooting := bmh.ObjectMeta.Annotations[BMH_REBOOT_ANNOTATION]; rebooting {
+		log.Debugf("Skipping adding detached annotation. BMH is rebooting")
+		return reconcileComplete{}
+	}
+
 	// it's possible this BMH doesn't have a matching agent when this is called in some cases
 	if agent != nil {
 		//check if we are in unbinding-pending-user-action status. If yes, we should not
@@ -631,6 +648,31 @@ func (r *BMACReconciler) ensureBMHDetached(log logrus.FieldLogger, bmh *bmh_v1al
 	return reconcileComplete{dirty: true, stop: true}
 }
 
+// reconcileAgentStageRemediation reboots the BMH when the agent was stuck in an installation stage and the timeout
+// policy of the stage requested a reboot. The time of the last reboot request is recorded in an annotation so that
+// each request reboots the host once. The BMH is attached again until the BMO reboots it.
+func (r *BMACReconciler) reconcileAgentStageRemediation(log logrus.FieldLogger, bmh *bmh_v1alpha1.BareMetalHost, agent *aiv1beta1.Agent) reconcileResult {
+	progress := agent.Status.Progress
+	if progress.StageRemediation != models.HostStageRemediationReboot || progress.StageRemediationTime == nil {
+		return reconcileComplete{}
+	}
+
+	requestTime := progress.StageRemediationTime.UTC().Format(time.RFC3339)
+	if bmh.GetAnnotations()[BMH_REMEDIATION_REBOOT_ANNOTATION] == requestTime {
+		if _, rebooting := bmh.GetAnnotations()[BMH_REBOOT_ANNOTATION]; rebooting {
+			// keep the BMH attached until the BMO rebooted it
+			return reconcileComplete{stop: true}
+		}
+		return reconcileComplete{}
+	}
+
+	log.Infof("Rebooting BMH, agent is stuck in installation stage %s", progress.CurrentStage)
+	removeBMHDetachedAnnotation(log, bmh)
+	setAnnotation(&bmh.ObjectMeta, BMH_REBOOT_ANNOTATION, "{\"force\": true}")
+	setAnnotation(&bmh.ObjectMeta, BMH_REMEDIATION_REBOOT_ANNOTATION, requestTime)
+	return reconcileComplete{dirty: true, stop: true}
+}
+
 // The detached annotation is added if the installation of the agent associated with
 // the host has reached stages Failed, Rebooting, or Joined
 func (r *BMACReconciler) addBMHDetachedAnnotationIfHostIsRebooting(log logrus.FieldLogger, bmh *bmh_v1alpha1.BareMetalHost, agent *aiv1beta1.Agent) reconcileResult {
@@ -1880,6 +1880,54 @@ var _ = Describe("bmac reconcile", func() {
 			})
 		})
 
+		Context("when Agent stage timeout remediation is reboot", func() {
+			var remediationTime metav1.Time
+
+			BeforeEach(func() {
+				agent.Status.Progress.CurrentStage = models.HostStageRebooting
+				Expect(c.Update(ctx, agent)).To(BeNil())
+
+				for range [3]int{} {
+					_, err := bmhr.Reconcile(ctx, newBMHRequest(host))
+					Expect(err).To(BeNil())
+				}
+
+				remediationTime = metav1.NewTime(time.Now().Truncate(time.Second))
+				Expect(c.Get(ctx, types.NamespacedName{Name: agent.Name, Namespace: testNamespace}, agent)).To(BeNil())
+				agent.Status.Progress.StageRemediation = models.HostStageRemediationReboot
+				agent.Status.Progress.StageRemediationTime = &remediationTime
+				Expect(c.Update(ctx, agent)).To(BeNil())
+			})
+
+			It("should attach and reboot the BMH once", func() {
+				for range [3]int{} {
+					result, err := bmhr.Reconcile(ctx, newBMHRequest(host))
+					Expect(err).To(BeNil())
+					Expect(result).To(Equal(ctrl.Result{}))
+				}
+
+				updatedHost := &bmh_v1alpha1.BareMetalHost{}
+				err := c.Get(ctx, types.NamespacedName{Name: host.Name, Namespace: testNamespace}, updatedHost)
+				Expect(err).To(BeNil())
+				Expect(updatedHost.ObjectMeta.Annotations).NotTo(HaveKey(BMH_DETACHED_ANNOTATION))
+				Expect(updatedHost.ObjectMeta.Annotations).To(HaveKey(BMH_REBOOT_ANNOTATION))
+				Expect(updatedHost.ObjectMeta.Annotations[BMH_REMEDIATION_REBOOT_ANNOTATION]).To(Equal(remediationTime.UTC().Format(time.RFC3339)))
+
+				By("detaching the BMH again after the BMO rebooted it")
+				delete(updatedHost.ObjectMeta.Annotations, BMH_REBOOT_ANNOTATION)
+				Expect(c.Update(ctx, updatedHost)).To(BeNil())
+
+				result, err := bmhr.Reconcile(ctx, newBMHRequest(host))
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal(ctrl.Result{}))
+
+				err = c.Get(ctx, types.NamespacedName{Name: host.Name, Namespace: testNamespace}, updatedHost)
+				Expect(err).To(BeNil())
+				Expect(updatedHost.ObjectMeta.Annotations).NotTo(HaveKey(BMH_REBOOT_ANNOTATION))
+				Expect(updatedHost.ObjectMeta.Annotations[BMH_DETACHED_ANNOTATION]).To(Equal("assisted-service-controller"))
+			})
+		})
+
 		Context("when Agent is unbound-pending-on-user-action", func() {
 			BeforeEach(func() {
 				agent.Status.Conditions = []conditionsv1.Condition{
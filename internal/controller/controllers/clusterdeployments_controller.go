@@ -42,6 +42,7 @@ import (
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -81,8 +82,10 @@ const (
 	adminPasswordSecretStringTemplate = "%s-admin-password"
 	adminKubeConfigStringTemplate     = "%s-admin-kubeconfig"
 	InstallConfigOverrides            = aiv1beta1.Group + "/install-config-overrides"
-	ClusterDeploymentFinalizerName    = "clusterdeployments." + aiv1beta1.Group + "/ai-deprovision"
-	AgentClusterInstallFinalizerName  = "agentclusterinstall." + aiv1beta1.Group + "/ai-deprovision"
+	// Set to "true" to allow the install config overrides of the fields set by the service, such as the networks and the VIPs
+	InstallConfigOverridesAllowServiceOwnedFields = aiv1beta1.Group + "/install-config-overrides-allow-service-owned-fields"
+	ClusterDeploymentFinalizerName                = "clusterdeployments." + aiv1beta1.Group + "/ai-deprovision"
+	AgentClusterInstallFinalizerName              = "agentclusterinstall." + aiv1beta1.Group + "/ai-deprovision"
 )
 
 const HighAvailabilityModeNone = "None"
@@ -1067,6 +1070,29 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(ctx context.Context,
 		update = true
 	}
 
+	hostRoleRules, err := common.UnmarshalHostRoleRules(cluster.HostRoleRules)
+	if err != nil {
+		return cluster, err
+	}
+	desiredHostRoleRules := hostRoleRulesEntriesToArray(clusterInstall.Spec.HostRoleRules)
+	if len(hostRoleRules) != len(desiredHostRoleRules) || (len(hostRoleRules) > 0 && !reflect.DeepEqual(hostRoleRules, desiredHostRoleRules)) {
+		// an empty list deletes the rules of the cluster
+		params.HostRoleRules = desiredHostRoleRules
+		update = true
+	}
+
+	hostStageTimeoutPolicies, err := common.UnmarshalHostStageTimeoutPolicies(cluster.HostStageTimeoutPolicies)
+	if err != nil {
+		return cluster, err
+	}
+	desiredHostStageTimeoutPolicies := hostStageTimeoutPoliciesEntriesToArray(clusterInstall.Spec.HostStageTimeoutPolicies)
+	if len(hostStageTimeoutPolicies) != len(desiredHostStageTimeoutPolicies) ||
+		(len(hostStageTimeoutPolicies) > 0 && !reflect.DeepEqual(hostStageTimeoutPolicies, desiredHostStageTimeoutPolicies)) {
+		// an empty list deletes the policies of the cluster
+		params.HostStageTimeoutPolicies = desiredHostStageTimeoutPolicies
+		update = true
+	}
+
 	if !update {
 		return cluster, nil
 	}
@@ -1137,8 +1163,9 @@ func (r *ClusterDeploymentsReconciler) updateInstallConfigOverrides(ctx context.
 	}
 	if update {
 		_, err := r.Installer.UpdateClusterInstallConfigInternal(ctx, installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           *cluster.ID,
-			InstallConfigParams: cluster.InstallConfigOverrides,
+			ClusterID:               *cluster.ID,
+			InstallConfigParams:     cluster.InstallConfigOverrides,
+			AllowServiceOwnedFields: swag.Bool(annotations[InstallConfigOverridesAllowServiceOwnedFields] == "true"),
 		})
 		if err != nil {
 			if IsUserError(err) {
@@ -1289,6 +1316,14 @@ func CreateClusterParams(clusterDeployment *hivev1.ClusterDeployment, clusterIns
 		SchedulableMasters:    swag.Bool(clusterInstall.Spec.MastersSchedulable),
 	}
 
+	if len(clusterInstall.Spec.HostRoleRules) > 0 {
+		clusterParams.HostRoleRules = hostRoleRulesEntriesToArray(clusterInstall.Spec.HostRoleRules)
+	}
+
+	if len(clusterInstall.Spec.HostStageTimeoutPolicies) > 0 {
+		clusterParams.HostStageTimeoutPolicies = hostStageTimeoutPoliciesEntriesToArray(clusterInstall.Spec.HostStageTimeoutPolicies)
+	}
+
 	if len(clusterInstall.Spec.Networking.ClusterNetwork) > 0 {
 		for _, net := range clusterInstall.Spec.Networking.ClusterNetwork {
 			clusterParams.ClusterNetworks = append(clusterParams.ClusterNetworks, &models.ClusterNetwork{
@@ -1739,6 +1774,7 @@ func (r *ClusterDeploymentsReconciler) updateStatus(ctx context.Context, log log
 			clusterInstall.Status.IngressVIPs = IngressVipsArrayToStrings(c.IngressVips)
 			clusterInstall.Status.UserManagedNetworking = c.UserManagedNetworking
 			clusterInstall.Status.PlatformType = getPlatformType(c.Platform)
+			clusterInstall.Status.HostStageRemediations = hostStageRemediations(c)
 			status := *c.Status
 			var err error
 			err = r.populateEventsURL(log, clusterInstall, c)
@@ -1795,6 +1831,26 @@ func (r *ClusterDeploymentsReconciler) updateStatus(ctx context.Context, log log
 	return ctrl.Result{}, nil
 }
 
+// hostStageRemediations returns the remediations applied to the hosts of the cluster stuck in their current stage
+func hostStageRemediations(c *common.Cluster) []hiveext.HostStageRemediation {
+	var remediations []hiveext.HostStageRemediation
+	for _, h := range c.Hosts {
+		if h.Progress == nil || h.Progress.StageRemediation == "" {
+			continue
+		}
+		remediationTime := metav1.NewTime(time.Time(h.Progress.StageRemediationAt))
+		remediations = append(remediations, hiveext.HostStageRemediation{
+			HostID:              h.ID.String(),
+			Hostname:            hostutil.GetHostnameForMsg(h),
+			Stage:               string(h.Progress.CurrentStage),
+			Remediation:         string(h.Progress.StageRemediation),
+			Attempts:            h.Progress.StageRemediationAttempts,
+			LastRemediationTime: &remediationTime,
+		})
+	}
+	return remediations
+}
+
 func (r *ClusterDeploymentsReconciler) populateEventsURL(log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall, c *common.Cluster) error {
 	if *c.Status != models.ClusterStatusInstalled {
 		if clusterInstall.Status.DebugInfo.EventsURL == "" {
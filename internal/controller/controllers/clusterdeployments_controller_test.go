@@ -2745,6 +2745,97 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterRequirementsMetCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("update host role rules", func() {
+			backEndCluster := getDefaultTestCluster()
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			updateReply := getDefaultTestCluster()
+
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams) {
+					Expect(param.ClusterUpdateParams.HostRoleRules).To(Equal([]*models.HostRoleRule{{
+						Name:                    "storage",
+						Role:                    swag.String(models.HostRoleRuleRoleWorker),
+						MinDiskSizeGb:           960,
+						InstallationDiskPattern: "nvme",
+						NodeLabels: []*models.NodeLabelParams{
+							{Key: swag.String("node-role.kubernetes.io/infra"), Value: swag.String("")},
+							{Key: swag.String("zone"), Value: swag.String("a")},
+						},
+					}}))
+				}).Return(updateReply, nil)
+
+			aci.Spec.HostRoleRules = []hiveext.HostRoleRule{{
+				Name:                    "storage",
+				Role:                    models.HostRoleRuleRoleWorker,
+				MinDiskSizeGB:           960,
+				InstallationDiskPattern: "nvme",
+				NodeLabels:              map[string]string{"zone": "a", "node-role.kubernetes.io/infra": ""},
+			}}
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterSyncedOkReason))
+		})
+
+		It("update host stage timeout policies", func() {
+			backEndCluster := getDefaultTestCluster()
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			updateReply := getDefaultTestCluster()
+
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams) {
+					Expect(param.ClusterUpdateParams.HostStageTimeoutPolicies).To(Equal([]*models.HostStageTimeoutPolicy{{
+						Stage:          models.NewHostStage(models.HostStageWaitingForIgnition),
+						TimeoutSeconds: 1800,
+						Remediation:    models.NewHostStageRemediation(models.HostStageRemediationReboot),
+						MaxAttempts:    2,
+					}}))
+				}).Return(updateReply, nil)
+
+			aci.Spec.HostStageTimeoutPolicies = []hiveext.HostStageTimeoutPolicy{{
+				Stage:       string(models.HostStageWaitingForIgnition),
+				Timeout:     &metav1.Duration{Duration: 30 * time.Minute},
+				Remediation: string(models.HostStageRemediationReboot),
+				MaxAttempts: 2,
+			}}
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterSyncedOkReason))
+		})
+
+		It("delete host role rules", func() {
+			backEndCluster := getDefaultTestCluster()
+			backEndCluster.HostRoleRules = `[{"name":"masters","role":"master","hostname_pattern":"^master-"}]`
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			updateReply := getDefaultTestCluster()
+
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams) {
+					Expect(param.ClusterUpdateParams.HostRoleRules).NotTo(BeNil())
+					Expect(param.ClusterUpdateParams.HostRoleRules).To(BeEmpty())
+				}).Return(updateReply, nil)
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
 		It("update disk encryption configuration", func() {
 			tangServersConfig := `[{"URL":"http://tang.example.com:7500","Thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`
 			backEndCluster := &common.Cluster{
@@ -3018,6 +3109,7 @@ var _ = Describe("cluster reconcile", func() {
 				Do(func(ctx context.Context, param installer.V2UpdateClusterInstallConfigParams) {
 					Expect(param.ClusterID).To(Equal(sId))
 					Expect(param.InstallConfigParams).To(Equal(installConfigOverrides))
+					Expect(swag.BoolValue(param.AllowServiceOwnedFields)).To(BeFalse())
 				}).Return(updateReply, nil)
 			// Add annotation
 			aci.ObjectMeta.SetAnnotations(map[string]string{InstallConfigOverrides: installConfigOverrides})
@@ -3028,6 +3120,55 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(result).To(Equal(ctrl.Result{}))
 		})
 
+		It("add install config overrides annotation allowing service owned fields", func() {
+			mockMirrorRegistries.EXPECT().IsMirrorRegistriesConfigured().AnyTimes().Return(false)
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Name:             clusterName,
+					OpenshiftVersion: "4.8",
+					ClusterNetworks:  clusterNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ClusterNetwork),
+					ServiceNetworks:  serviceNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ServiceNetwork),
+					NetworkType:      swag.String(models.ClusterNetworkTypeOpenShiftSDN),
+					Status:           swag.String(models.ClusterStatusInsufficient),
+					IngressVips:      []*models.IngressVip{{ClusterID: sId, IP: models.IP(defaultAgentClusterInstallSpec.IngressVIP)}},
+					APIVips:          []*models.APIVip{{ClusterID: sId, IP: models.IP(defaultAgentClusterInstallSpec.APIVIP)}},
+					BaseDNSDomain:    defaultClusterSpec.BaseDomain,
+					SSHPublicKey:     defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:   models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			installConfigOverrides := `{"networking": {"networkType": "OVNKubernetes"}}`
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                     &sId,
+					Status:                 swag.String(models.ClusterStatusInsufficient),
+					InstallConfigOverrides: installConfigOverrides,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterInstallConfigParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(param.InstallConfigParams).To(Equal(installConfigOverrides))
+					Expect(swag.BoolValue(param.AllowServiceOwnedFields)).To(BeTrue())
+				}).Return(updateReply, nil)
+			// Add annotation
+			aci.ObjectMeta.SetAnnotations(map[string]string{
+				InstallConfigOverrides:                        installConfigOverrides,
+				InstallConfigOverridesAllowServiceOwnedFields: "true",
+			})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
 		It("Remove existing install config overrides annotation", func() {
 			mockMirrorRegistries.EXPECT().IsMirrorRegistriesConfigured().AnyTimes().Return(false)
 			backEndCluster := &common.Cluster{
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/go-openapi/swag"
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	configv1 "github.com/openshift/api/config/v1"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
@@ -367,6 +368,50 @@ func IngressVipsEntriesToArray(entries []string) []*models.IngressVip {
 	}).([]*models.IngressVip)
 }
 
+func hostStageTimeoutPoliciesEntriesToArray(entries []hiveext.HostStageTimeoutPolicy) []*models.HostStageTimeoutPolicy {
+	return funk.Map(entries, func(entry hiveext.HostStageTimeoutPolicy) *models.HostStageTimeoutPolicy {
+		policy := &models.HostStageTimeoutPolicy{
+			Stage:       models.NewHostStage(models.HostStage(entry.Stage)),
+			Remediation: models.NewHostStageRemediation(models.HostStageRemediation(entry.Remediation)),
+			MaxAttempts: entry.MaxAttempts,
+		}
+		if entry.Timeout != nil {
+			policy.TimeoutSeconds = int64(entry.Timeout.Seconds())
+		}
+		return policy
+	}).([]*models.HostStageTimeoutPolicy)
+}
+
+func hostRoleRulesEntriesToArray(entries []hiveext.HostRoleRule) []*models.HostRoleRule {
+	return funk.Map(entries, func(entry hiveext.HostRoleRule) *models.HostRoleRule {
+		rule := &models.HostRoleRule{
+			Name:                    entry.Name,
+			Role:                    swag.String(entry.Role),
+			HostnamePattern:         entry.HostnamePattern,
+			SerialNumber:            entry.SerialNumber,
+			BmcAddress:              entry.BMCAddress,
+			MinCPUCores:             entry.MinCPUCores,
+			MinMemoryMib:            entry.MinMemoryMiB,
+			MinDiskSizeGb:           entry.MinDiskSizeGB,
+			NicVendor:               entry.NICVendor,
+			InstallationDiskPattern: entry.InstallationDiskPattern,
+		}
+		if len(entry.MacAddresses) > 0 {
+			rule.MacAddresses = entry.MacAddresses
+		}
+		// the labels are sorted for the rules to be compared with the ones of the cluster
+		keys := funk.Keys(entry.NodeLabels).([]string)
+		sort.Strings(keys)
+		for _, key := range keys {
+			rule.NodeLabels = append(rule.NodeLabels, &models.NodeLabelParams{
+				Key:   swag.String(key),
+				Value: swag.String(entry.NodeLabels[key]),
+			})
+		}
+		return rule
+	}).([]*models.HostRoleRule)
+}
+
 func signURL(urlString string, authType auth.AuthType, id string, keyType gencrypto.LocalJWTKeyType) (string, error) {
 	if authType != auth.TypeLocal {
 		return urlString, nil
@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	csrRuleManualApproval = "manual-approval"
+	csrRuleApprovalWindow = "approval-window"
+	csrRuleHostIdentity   = "host-identity"
+	csrRuleTPM            = "tpm"
+)
+
+// csrApprovalRequest is a CSR matching its agent, checked by the rules of the CSR approval policy
+type csrApprovalRequest struct {
+	agent *aiv1beta1.Agent
+	host  *models.Host
+	// node is the node of the agent, or nil for the client CSR sent before the node joins the cluster
+	node *corev1.Node
+	csr  *certificatesv1.CertificateSigningRequest
+	now  time.Time
+}
+
+// csrApprovalRule is a rule of the CSR approval policy. check returns the reason the CSR must be left for a manual
+// approval, or nil.
+type csrApprovalRule interface {
+	name() string
+	check(req *csrApprovalRequest) error
+}
+
+// CSRApprovalPolicy holds the rules, configured in the AgentServiceConfig, that the CSRs of the day-2 hosts must pass
+// to be approved automatically
+type CSRApprovalPolicy struct {
+	rules []csrApprovalRule
+}
+
+// NewCSRApprovalPolicy builds the rules of the policy. Without a configuration, every CSR matching its agent is
+// approved.
+func NewCSRApprovalPolicy(config *aiv1beta1.CSRApprovalPolicy) *CSRApprovalPolicy {
+	policy := &CSRApprovalPolicy{}
+	if config == nil {
+		return policy
+	}
+	if len(config.ManualApprovalLabels) > 0 {
+		policy.rules = append(policy.rules, &manualApprovalRule{labels: config.ManualApprovalLabels})
+	}
+	if config.ApprovalWindow != nil && config.ApprovalWindow.Duration > 0 {
+		policy.rules = append(policy.rules, &approvalWindowRule{window: config.ApprovalWindow.Duration})
+	}
+	if config.HostIdentity {
+		policy.rules = append(policy.rules, &hostIdentityRule{})
+	}
+	if config.TPM {
+		policy.rules = append(policy.rules, &tpmRule{})
+	}
+	return policy
+}
+
+// ParseCSRApprovalPolicy builds the policy from its JSON configuration, as set by the operator in the
+// CSR_APPROVAL_POLICY variable
+func ParseCSRApprovalPolicy(value string) (*CSRApprovalPolicy, error) {
+	if strings.TrimSpace(value) == "" {
+		return NewCSRApprovalPolicy(nil), nil
+	}
+	var config aiv1beta1.CSRApprovalPolicy
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the CSR approval policy")
+	}
+	return NewCSRApprovalPolicy(&config), nil
+}
+
+// evaluate returns the name of the first rule denying the automatic approval of the CSR and its reason
+func (p *CSRApprovalPolicy) evaluate(req *csrApprovalRequest) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	for _, rule := range p.rules {
+		if err := rule.check(req); err != nil {
+			return rule.name(), err
+		}
+	}
+	return "", nil
+}
+
+// manualApprovalRule leaves the CSRs of the agents having one of the labels for a manual approval
+type manualApprovalRule struct {
+	labels map[string]string
+}
+
+func (r *manualApprovalRule) name() string {
+	return csrRuleManualApproval
+}
+
+func (r *manualApprovalRule) check(req *csrApprovalRequest) error {
+	keys := funk.Keys(r.labels).([]string)
+	sort.Strings(keys)
+	for _, key := range keys {
+		if value, ok := req.agent.Labels[key]; ok && value == r.labels[key] {
+			return errors.Errorf("agent label %s=%s requires a manual approval", key, value)
+		}
+	}
+	return nil
+}
+
+// approvalWindowRule limits the approval to a duration after the installation of the host, which is the time its
+// status was last changed to installed or added-to-existing-cluster
+type approvalWindowRule struct {
+	window time.Duration
+}
+
+func (r *approvalWindowRule) name() string {
+	return csrRuleApprovalWindow
+}
+
+func (r *approvalWindowRule) check(req *csrApprovalRequest) error {
+	status := swag.StringValue(req.host.Status)
+	if status != models.HostStatusInstalled && status != models.HostStatusAddedToExistingCluster {
+		return nil
+	}
+	installedAt := time.Time(req.host.StatusUpdatedAt)
+	if installedAt.IsZero() {
+		return nil
+	}
+	if req.now.After(installedAt.Add(r.window)) {
+		return errors.Errorf("the approval window of %s after the installation at %s expired", r.window,
+			installedAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// hostIdentityRule binds the serving CSR of the node to the host: the node must report the system UUID of the host,
+// from which the agent derives its ID, and the IP addresses of the CSR must have been reported by the host in its
+// inventory. The client CSR is sent before the node exists and carries only the node name, already matched with the
+// hostname of the agent, so there is nothing more to check for it.
+type hostIdentityRule struct{}
+
+func (r *hostIdentityRule) name() string {
+	return csrRuleHostIdentity
+}
+
+func (r *hostIdentityRule) check(req *csrApprovalRequest) error {
+	if req.node == nil {
+		return nil
+	}
+	systemUUID := req.node.Status.NodeInfo.SystemUUID
+	if !strings.EqualFold(systemUUID, req.agent.Name) {
+		return errors.Errorf("the system UUID %s of node %s isn't the ID of the host %s", systemUUID, req.node.Name,
+			req.agent.Name)
+	}
+	x509CSR, err := getX509ParsedRequest(req.csr)
+	if err != nil {
+		return err
+	}
+	inventory, err := common.UnmarshalInventory(req.host.Inventory)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the inventory of the host")
+	}
+	hostIPs := getInventoryIPs(inventory)
+	for _, ip := range x509CSR.IPAddresses {
+		if !funk.ContainsString(hostIPs, ip.String()) {
+			return errors.Errorf("the IP address %s of the CSR wasn't reported by the host, which reported %v", ip,
+				hostIPs)
+		}
+	}
+	return nil
+}
+
+// getInventoryIPs returns the IP addresses of the interfaces of the inventory, without their prefix length
+func getInventoryIPs(inventory *models.Inventory) []string {
+	var ret []string
+	for _, intf := range inventory.Interfaces {
+		for _, cidr := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			if ip, _, err := net.ParseCIDR(cidr); err == nil {
+				ret = append(ret, ip.String())
+			}
+		}
+	}
+	return ret
+}
+
+// tpmRule requires the host to report a TPM 2.0 device
+type tpmRule struct{}
+
+func (r *tpmRule) name() string {
+	return csrRuleTPM
+}
+
+func (r *tpmRule) check(req *csrApprovalRequest) error {
+	inventory, err := common.UnmarshalInventory(req.host.Inventory)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the inventory of the host")
+	}
+	if inventory.TpmVersion != models.InventoryTpmVersionNr20 {
+		return errors.Errorf("the host reported TPM version %q instead of %s", inventory.TpmVersion,
+			models.InventoryTpmVersionNr20)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("CSR approval policy", func() {
+	const agentName = "1bd8c8a1-d8ea-4c3e-8bd5-2a2d5bb1a5e9"
+
+	var (
+		now time.Time
+		req *csrApprovalRequest
+	)
+
+	inventory := func(tpmVersion string) string {
+		b, err := json.Marshal(&models.Inventory{
+			Interfaces: []*models.Interface{{
+				IPV4Addresses: []string{"192.168.111.28/24"},
+				IPV6Addresses: []string{"fd00::28/64"},
+			}},
+			TpmVersion: tpmVersion,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	servingCSR := func(ips ...string) []byte {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: nodeUserPrefix + "worker-1", Organization: []string{nodeGroup}},
+			DNSNames: []string{"worker-1"},
+		}
+		for _, ip := range ips {
+			template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+		}
+		der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+		Expect(err).ToNot(HaveOccurred())
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		req = &csrApprovalRequest{
+			agent: &v1beta1.Agent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      agentName,
+					Namespace: testNamespace,
+					Labels:    map[string]string{"site": "edge"},
+				},
+			},
+			host: &models.Host{
+				Status:          swag.String(models.HostStatusAddedToExistingCluster),
+				StatusUpdatedAt: strfmt.DateTime(now.Add(-time.Hour)),
+				Inventory:       inventory(models.InventoryTpmVersionNr20),
+			},
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+				Status: corev1.NodeStatus{
+					NodeInfo: corev1.NodeSystemInfo{SystemUUID: "1BD8C8A1-D8EA-4C3E-8BD5-2A2D5BB1A5E9"},
+				},
+			},
+			csr: &certificatesv1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "csr-1"},
+				Spec:       certificatesv1.CertificateSigningRequestSpec{Request: servingCSR("192.168.111.28", "fd00::28")},
+			},
+			now: now,
+		}
+	})
+
+	expectDenied := func(policy *CSRApprovalPolicy, expectedRule string) {
+		rule, err := policy.evaluate(req)
+		Expect(err).To(HaveOccurred())
+		Expect(rule).To(Equal(expectedRule))
+	}
+
+	expectApproved := func(policy *CSRApprovalPolicy) {
+		rule, err := policy.evaluate(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rule).To(BeEmpty())
+	}
+
+	It("approves without a policy", func() {
+		var policy *CSRApprovalPolicy
+		expectApproved(policy)
+		expectApproved(NewCSRApprovalPolicy(nil))
+	})
+
+	It("approves a CSR passing every rule", func() {
+		expectApproved(NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{
+			HostIdentity:         true,
+			TPM:                  true,
+			ApprovalWindow:       &metav1.Duration{Duration: 2 * time.Hour},
+			ManualApprovalLabels: map[string]string{"site": "core"},
+		}))
+	})
+
+	Context("manual approval labels", func() {
+		It("denies an agent having the label", func() {
+			expectDenied(NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{
+				ManualApprovalLabels: map[string]string{"site": "edge"},
+			}), csrRuleManualApproval)
+		})
+
+		It("approves an agent having another value of the label", func() {
+			req.agent.Labels["site"] = "core"
+			expectApproved(NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{
+				ManualApprovalLabels: map[string]string{"site": "edge"},
+			}))
+		})
+	})
+
+	Context("approval window", func() {
+		var policy *CSRApprovalPolicy
+
+		BeforeEach(func() {
+			policy = NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{
+				ApprovalWindow: &metav1.Duration{Duration: 30 * time.Minute},
+			})
+		})
+
+		It("denies a CSR after the window", func() {
+			expectDenied(policy, csrRuleApprovalWindow)
+		})
+
+		It("approves a CSR within the window", func() {
+			req.host.StatusUpdatedAt = strfmt.DateTime(now.Add(-10 * time.Minute))
+			expectApproved(policy)
+		})
+
+		It("approves a CSR of a host still installing", func() {
+			req.host.Status = swag.String(models.HostStatusInstalling)
+			expectApproved(policy)
+		})
+	})
+
+	Context("host identity", func() {
+		var policy *CSRApprovalPolicy
+
+		BeforeEach(func() {
+			policy = NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{HostIdentity: true})
+		})
+
+		It("denies a node with another system UUID", func() {
+			req.node.Status.NodeInfo.SystemUUID = "a2c1e4b0-6d3f-4c4a-9a53-0f4f2b0f8c11"
+			expectDenied(policy, csrRuleHostIdentity)
+		})
+
+		It("denies a CSR with an IP address not reported by the host", func() {
+			req.csr.Spec.Request = servingCSR("192.168.111.28", "192.168.111.99")
+			expectDenied(policy, csrRuleHostIdentity)
+		})
+
+		It("denies a CSR that can't be parsed", func() {
+			req.csr.Spec.Request = []byte("not a CSR")
+			expectDenied(policy, csrRuleHostIdentity)
+		})
+
+		It("approves the client CSR, sent before the node exists", func() {
+			req.node = nil
+			req.csr.Spec.Request = nil
+			expectApproved(policy)
+		})
+	})
+
+	Context("TPM", func() {
+		It("denies a host without TPM 2.0", func() {
+			req.host.Inventory = inventory(models.InventoryTpmVersionNr12)
+			expectDenied(NewCSRApprovalPolicy(&v1beta1.CSRApprovalPolicy{TPM: true}), csrRuleTPM)
+		})
+	})
+
+	Context("ParseCSRApprovalPolicy", func() {
+		It("returns an empty policy for an empty value", func() {
+			policy, err := ParseCSRApprovalPolicy("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.rules).To(BeEmpty())
+		})
+
+		It("parses the rules", func() {
+			policy, err := ParseCSRApprovalPolicy(`{"tpm": true, "approvalWindow": "1h"}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.rules).To(HaveLen(2))
+		})
+
+		It("fails on an invalid value", func() {
+			_, err := ParseCSRApprovalPolicy("{")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
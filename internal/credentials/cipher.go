@@ -0,0 +1,118 @@
+package credentials
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+)
+
+// EncryptedPrefix marks the encrypted credentials, the credentials stored before they were encrypted being in
+// plaintext
+const EncryptedPrefix = "encrypted:v1:"
+
+type encryptedCredential struct {
+	Key        *s3wrapper.WrappedKey `json:"key"`
+	Nonce      []byte                `json:"nonce"`
+	Ciphertext []byte                `json:"ciphertext"`
+}
+
+// Cipher encrypts the credentials stored in the database, e.g. the passwords of the BMC hosts and the secrets of the
+// webhook subscriptions, with AES-256-GCM (envelope encryption). Each credential has a data key of its own, wrapped by
+// the KEK provider, and is authenticated with the ID of its owner, so that it can't be copied to another owner
+type Cipher struct {
+	provider s3wrapper.KeyProvider
+}
+
+func NewCipher(provider s3wrapper.KeyProvider) *Cipher {
+	return &Cipher{provider: provider}
+}
+
+// LoadCipher returns the cipher of the keys of keysFile, in the format of the storage encryption keys file, or nil
+// when keysFile is empty
+func LoadCipher(keysFile string) (*Cipher, error) {
+	if keysFile == "" {
+		return nil, nil
+	}
+	provider, err := s3wrapper.NewLocalKeyProvider(keysFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the credentials keys")
+	}
+	return NewCipher(provider), nil
+}
+
+func IsEncrypted(stored string) bool {
+	return strings.HasPrefix(stored, EncryptedPrefix)
+}
+
+func (c *Cipher) Encrypt(ctx context.Context, ownerID string, credential string) (string, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := c.provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to wrap the data key of the credential of %s", ownerID)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(&encryptedCredential{
+		Key:        wrapped,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, []byte(credential), []byte(ownerID)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return EncryptedPrefix + base64.StdEncoding.EncodeToString(encoded), nil
+}
+
+func (c *Cipher) Decrypt(ctx context.Context, ownerID string, stored string) (string, error) {
+	if !IsEncrypted(stored) {
+		return "", errors.Errorf("the credential of %s isn't encrypted", ownerID)
+	}
+	encoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, EncryptedPrefix))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid encrypted credential of %s", ownerID)
+	}
+	var encrypted encryptedCredential
+	if err = json.Unmarshal(encoded, &encrypted); err != nil || encrypted.Key == nil {
+		return "", errors.Errorf("invalid encrypted credential of %s", ownerID)
+	}
+	dataKey, err := c.provider.UnwrapKey(ctx, encrypted.Key)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	if len(encrypted.Nonce) != aead.NonceSize() {
+		return "", errors.Errorf("invalid encrypted credential of %s", ownerID)
+	}
+	credential, err := aead.Open(nil, encrypted.Nonce, encrypted.Ciphertext, []byte(ownerID))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt the credential of %s", ownerID)
+	}
+	return string(credential), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,87 @@
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// writeTestKeysFile writes a keys file with a random current key, to be removed by the caller
+func writeTestKeysFile() string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	file, err := os.CreateTemp("", "credentials-keys")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	defer file.Close()
+	_, err = fmt.Fprintf(file, "current_key: test\nkeys:\n  test: %s\n", base64.StdEncoding.EncodeToString(key))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return file.Name()
+}
+
+func newTestCipher() *Cipher {
+	keysFile := writeTestKeysFile()
+	defer os.Remove(keysFile)
+	c, err := LoadCipher(keysFile)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return c
+}
+
+var _ = Describe("Cipher", func() {
+	var (
+		c       *Cipher
+		ctx     = context.Background()
+		ownerID string
+	)
+
+	BeforeEach(func() {
+		c = newTestCipher()
+		ownerID = uuid.New().String()
+	})
+
+	It("encrypts and decrypts the credentials", func() {
+		encrypted, err := c.Encrypt(ctx, ownerID, "password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+		Expect(encrypted).NotTo(ContainSubstring("password"))
+
+		other, err := c.Encrypt(ctx, ownerID, "password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).NotTo(Equal(encrypted))
+
+		credential, err := c.Decrypt(ctx, ownerID, encrypted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(credential).To(Equal("password"))
+	})
+
+	It("doesn't decrypt the credential of another owner", func() {
+		encrypted, err := c.Encrypt(ctx, ownerID, "password")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.Decrypt(ctx, uuid.New().String(), encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects the plaintext and the invalid credentials", func() {
+		_, err := c.Decrypt(ctx, ownerID, "password")
+		Expect(err).To(MatchError(ContainSubstring("isn't encrypted")))
+		_, err = c.Decrypt(ctx, ownerID, EncryptedPrefix+"invalid")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("doesn't decrypt the credentials with other keys", func() {
+		encrypted, err := c.Encrypt(ctx, ownerID, "password")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newTestCipher().Decrypt(ctx, ownerID, encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("is disabled without keys file", func() {
+		Expect(LoadCipher("")).To(BeNil())
+	})
+})
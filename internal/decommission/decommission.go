@@ -0,0 +1,329 @@
+package decommission
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
+)
+
+type Config struct {
+	DrainTimeout     time.Duration `envconfig:"HOST_DECOMMISSION_DRAIN_TIMEOUT" default:"10m"`
+	WipeDisksTimeout time.Duration `envconfig:"HOST_DECOMMISSION_WIPE_DISKS_TIMEOUT" default:"30m"`
+	MonitorInterval  time.Duration `envconfig:"HOST_DECOMMISSION_MONITOR_INTERVAL" default:"30s"`
+	// The agent of a host is considered connected if it checked in within this time
+	MaxHostDisconnectionTime time.Duration `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=decommission -destination=mock_decommission_api.go . API
+type API interface {
+	// DecommissionHost starts the decommission of an installed host. The node of the host is drained and deleted in
+	// the background by the leader, then the disks of the host are wiped by its agent if requested.
+	DecommissionHost(ctx context.Context, host *models.Host, wipeDisks bool) error
+	// HandleWipeDisksReply completes the decommission of a host with the reply of its wipe-disks step
+	HandleWipeDisksReply(ctx context.Context, host *models.Host, stepError string) error
+	// Monitor resumes the decommissions in progress, and times out the wipe of the disks
+	Monitor()
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=decommission -destination=mock_drainer.go . Drainer
+type Drainer interface {
+	RunCordonOrUncordon(helper *drain.Helper, node *corev1.Node, desired bool) error
+	RunNodeDrain(helper *drain.Helper, nodeName string) error
+}
+
+// decommissionStatuses are the statuses of the hosts whose node is part of the cluster
+var decommissionStatuses = []string{
+	models.HostStatusInstalled,
+	models.HostStatusAddedToExistingCluster,
+}
+
+// inProgressStages are the decommission stages in which the decommission is carried out by the leader
+var inProgressStages = []string{
+	models.HostDecommissionStageDraining,
+	models.HostDecommissionStageDeletingNode,
+}
+
+type Manager struct {
+	Config
+	log                logrus.FieldLogger
+	db                 *gorm.DB
+	eventsHandler      eventsapi.Handler
+	objectHandler      s3wrapper.API
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	drainer            Drainer
+	leaderElector      leader.ElectorInterface
+	// running holds the IDs of the hosts whose decommission is carried out by this replica
+	running sync.Map
+}
+
+var _ API = &Manager{}
+
+func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, objectHandler s3wrapper.API,
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory, drainer Drainer, leaderElector leader.ElectorInterface) *Manager {
+	return &Manager{
+		Config:             cfg,
+		log:                log,
+		db:                 db,
+		eventsHandler:      eventsHandler,
+		objectHandler:      objectHandler,
+		spokeClientFactory: spokeClientFactory,
+		drainer:            drainer,
+		leaderElector:      leaderElector,
+	}
+}
+
+func (m *Manager) DecommissionHost(ctx context.Context, host *models.Host, wipeDisks bool) error {
+	log := logutil.FromContext(ctx, m.log)
+	if host.ClusterID == nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("host %s isn't bound to a cluster", host.ID))
+	}
+	if !funk.ContainsString(decommissionStatuses, swag.StringValue(host.Status)) {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s can't be decommissioned in status %s, only installed hosts can be decommissioned",
+			host.ID, swag.StringValue(host.Status)))
+	}
+	switch host.DecommissionStage {
+	case "", models.HostDecommissionStageFailed:
+	case models.HostDecommissionStageCompleted:
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is already decommissioned", host.ID))
+	default:
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is already being decommissioned", host.ID))
+	}
+	nodeName, err := hostutil.GetCurrentHostName(host)
+	if err != nil || nodeName == "" {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("failed to find the node name of host %s", host.ID))
+	}
+
+	// The stage is changed only if it wasn't changed since the host was read, so a host is decommissioned once. The
+	// decommission is then carried out by the leader, which resumes it from its stage after a restart.
+	reply := m.db.Model(&common.Host{}).
+		Where("id = ? and infra_env_id = ? and (decommission_stage is null or decommission_stage in (?))",
+			host.ID.String(), host.InfraEnvID.String(), []string{"", models.HostDecommissionStageFailed}).
+		Updates(map[string]interface{}{
+			"decommission_stage":            models.HostDecommissionStageDraining,
+			"decommission_info":             fmt.Sprintf("Draining node %s", nodeName),
+			"decommission_node_name":        nodeName,
+			"decommission_wipe_disks":       wipeDisks,
+			"decommission_stage_updated_at": time.Now(),
+		})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to start the decommission of host %s", host.ID)
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is already being decommissioned", host.ID))
+	}
+	log.Infof("Decommissioning host %s, node %s, wipe disks: %t", host.ID, nodeName, wipeDisks)
+	eventgen.SendHostDecommissionStartedEvent(ctx, m.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID,
+		hostutil.GetHostnameForMsg(host), nodeName)
+	return nil
+}
+
+func (m *Manager) Monitor() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := requestid.RequestIDLogger(m.log, requestid.FromContext(ctx))
+
+	var hosts []*common.Host
+	err := m.db.Where("decommission_stage in (?)", append([]string{models.HostDecommissionStageWipingDisks}, inProgressStages...)).
+		Find(&hosts).Error
+	if err != nil {
+		log.WithError(err).Error("failed to get the hosts being decommissioned")
+		return
+	}
+	for _, h := range hosts {
+		host := h
+		if host.DecommissionStage == models.HostDecommissionStageWipingDisks {
+			if time.Since(host.Decommission.StageUpdatedAt) > m.WipeDisksTimeout {
+				m.fail(ctx, log, &host.Host, errors.Errorf("the agent of the host didn't wipe its disks within %s", m.WipeDisksTimeout))
+			}
+			continue
+		}
+		// The drain of a node can take several monitoring intervals
+		if _, running := m.running.LoadOrStore(host.ID.String(), struct{}{}); running {
+			continue
+		}
+		go func() {
+			defer m.running.Delete(host.ID.String())
+			m.decommission(ctx, host)
+		}()
+	}
+}
+
+// decommission carries out the decommission of the host from its current stage
+func (m *Manager) decommission(ctx context.Context, host *common.Host) {
+	if host.Decommission.NodeName == "" {
+		host.Decommission.NodeName, _ = hostutil.GetCurrentHostName(&host.Host)
+	}
+	nodeName := host.Decommission.NodeName
+	log := logutil.FromContext(ctx, m.log).WithFields(logrus.Fields{
+		"host_id":    host.ID,
+		"cluster_id": host.ClusterID,
+		"node":       nodeName,
+	})
+	client, clientset, err := m.spokeClientFactory.ClientAndSetFromStorageKubeconfig(ctx, host.ClusterID, m.objectHandler)
+	if err != nil {
+		m.fail(ctx, log, &host.Host, errors.Wrapf(err, "failed to create the client of cluster %s", host.ClusterID))
+		return
+	}
+
+	if host.DecommissionStage == models.HostDecommissionStageDraining {
+		node, err := client.GetNode(ctx, nodeName)
+		if k8serrors.IsNotFound(err) {
+			log.Infof("Node %s doesn't exist, skipping its removal", nodeName)
+			m.finish(ctx, log, host)
+			return
+		}
+		if err != nil {
+			m.fail(ctx, log, &host.Host, errors.Wrapf(err, "failed to get node %s", nodeName))
+			return
+		}
+		if err = m.drainNode(ctx, log, clientset, node); err != nil {
+			m.fail(ctx, log, &host.Host, err)
+			return
+		}
+		eventgen.SendHostNodeDrainedEvent(ctx, m.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID,
+			hostutil.GetHostnameForMsg(&host.Host), nodeName)
+		if err = m.updateStage(&host.Host, models.HostDecommissionStageDeletingNode, fmt.Sprintf("Deleting node %s", nodeName)); err != nil {
+			log.WithError(err).Errorf("failed to update the decommission stage of host %s", host.ID)
+			return
+		}
+	}
+
+	if err = client.DeleteNode(ctx, nodeName); err != nil && !k8serrors.IsNotFound(err) {
+		m.fail(ctx, log, &host.Host, errors.Wrapf(err, "failed to delete node %s", nodeName))
+		return
+	}
+	eventgen.SendHostNodeDeletedEvent(ctx, m.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID,
+		hostutil.GetHostnameForMsg(&host.Host), nodeName)
+	m.finish(ctx, log, host)
+}
+
+// finish completes the decommission once the node is removed, or waits for the agent of the host to wipe its disks
+// if requested. The agent doesn't run on an installed host, so the disks are wiped only if the host was booted from
+// the discovery image again and its agent is connected.
+func (m *Manager) finish(ctx context.Context, log logrus.FieldLogger, host *common.Host) {
+	nodeName := host.Decommission.NodeName
+	if !host.Decommission.WipeDisks {
+		m.complete(ctx, log, &host.Host, fmt.Sprintf("Node %s was removed from the cluster", nodeName))
+		return
+	}
+	if time.Since(time.Time(host.CheckedInAt)) > m.MaxHostDisconnectionTime {
+		m.complete(ctx, log, &host.Host, fmt.Sprintf("Node %s was removed from the cluster. The disks weren't wiped, the agent of the host isn't connected", nodeName))
+		return
+	}
+	// The wipe-disks step is sent to the agent of the host in this stage, and its reply completes the decommission
+	if err := m.updateStage(&host.Host, models.HostDecommissionStageWipingDisks, "Waiting for the agent of the host to wipe its disks"); err != nil {
+		log.WithError(err).Errorf("failed to update the decommission stage of host %s", host.ID)
+	}
+}
+
+// drainNode cordons the node and evicts its pods, like the node drain of the BareMetalHost controller
+func (m *Manager) drainNode(ctx context.Context, log logrus.FieldLogger, clientset kubernetes.Interface, node *corev1.Node) error {
+	out := new(bytes.Buffer)
+	helper := &drain.Helper{
+		Client:              clientset,
+		Ctx:                 ctx,
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		DeleteEmptyDirData:  true,
+		GracePeriodSeconds:  -1,
+		Timeout:             m.DrainTimeout,
+		OnPodDeletedOrEvicted: func(pod *corev1.Pod, usingEviction bool) {
+			verbStr := "Deleted"
+			if usingEviction {
+				verbStr = "Evicted"
+			}
+			log.Infof("%s Pod %s/%s from Node %s", verbStr, pod.Namespace, pod.Name, node.Name)
+		},
+		Out:    out,
+		ErrOut: out,
+	}
+	if err := m.drainer.RunCordonOrUncordon(helper, node, true); err != nil {
+		log.WithError(err).Errorf("failed to cordon node %s: output: %s", node.Name, out)
+		return errors.Wrapf(err, "failed to cordon node %s", node.Name)
+	}
+	if err := m.drainer.RunNodeDrain(helper, node.Name); err != nil {
+		log.WithError(err).Errorf("failed to drain node %s: output: %s", node.Name, out)
+		return errors.Wrapf(err, "failed to drain node %s within %s", node.Name, m.DrainTimeout)
+	}
+	return nil
+}
+
+func (m *Manager) HandleWipeDisksReply(ctx context.Context, host *models.Host, stepError string) error {
+	log := logutil.FromContext(ctx, m.log)
+	if host.DecommissionStage != models.HostDecommissionStageWipingDisks {
+		log.Warnf("Ignoring the wipe-disks reply of host %s in decommission stage %s", host.ID, host.DecommissionStage)
+		return nil
+	}
+	if stepError != "" {
+		m.fail(ctx, log, host, errors.Errorf("failed to wipe the disks: %s", stepError))
+		return nil
+	}
+	m.complete(ctx, log, host, "The node was removed from the cluster and the disks were wiped")
+	return nil
+}
+
+// updateStage moves the decommission of the host to the next stage, unless its stage was changed meanwhile
+func (m *Manager) updateStage(host *models.Host, stage, info string) error {
+	reply := m.db.Model(&common.Host{}).
+		Where("id = ? and infra_env_id = ? and decommission_stage = ?", host.ID.String(), host.InfraEnvID.String(), host.DecommissionStage).
+		Updates(map[string]interface{}{
+			"decommission_stage":            stage,
+			"decommission_info":             info,
+			"decommission_stage_updated_at": time.Now(),
+		})
+	if reply.Error != nil {
+		return reply.Error
+	}
+	if reply.RowsAffected == 0 {
+		return errors.Errorf("the decommission stage of host %s was changed from %s", host.ID, host.DecommissionStage)
+	}
+	host.DecommissionStage = stage
+	host.DecommissionInfo = info
+	return nil
+}
+
+func (m *Manager) complete(ctx context.Context, log logrus.FieldLogger, host *models.Host, info string) {
+	if err := m.updateStage(host, models.HostDecommissionStageCompleted, info); err != nil {
+		log.WithError(err).Errorf("failed to complete the decommission of host %s", host.ID)
+		return
+	}
+	log.Infof("Decommissioned host %s", host.ID)
+	eventgen.SendHostDecommissionCompletedEvent(ctx, m.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID,
+		hostutil.GetHostnameForMsg(host))
+}
+
+func (m *Manager) fail(ctx context.Context, log logrus.FieldLogger, host *models.Host, reason error) {
+	log.WithError(reason).Errorf("failed to decommission host %s", host.ID)
+	if err := m.updateStage(host, models.HostDecommissionStageFailed, reason.Error()); err != nil {
+		log.WithError(err).Errorf("failed to update the decommission stage of host %s", host.ID)
+		return
+	}
+	eventgen.SendHostDecommissionFailedEvent(ctx, m.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID,
+		hostutil.GetHostnameForMsg(host), reason.Error())
+}
@@ -0,0 +1,282 @@
+package decommission
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("DecommissionHost", func() {
+	var (
+		ctx                    = context.Background()
+		db                     *gorm.DB
+		dbName                 string
+		ctrl                   *gomock.Controller
+		mockEvents             *eventsapi.MockHandler
+		mockS3Client           *s3wrapper.MockAPI
+		mockSpokeClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient        *spoke_k8s_client.MockSpokeK8sClient
+		mockDrainer            *MockDrainer
+		manager                *Manager
+		host                   *models.Host
+		done                   chan struct{}
+	)
+
+	const nodeName = "worker-1"
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockSpokeClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+		mockDrainer = NewMockDrainer(ctrl)
+		manager = NewManager(Config{DrainTimeout: time.Minute, WipeDisksTimeout: time.Minute, MaxHostDisconnectionTime: time.Minute},
+			common.GetTestLog(), db, mockEvents, mockS3Client, mockSpokeClientFactory, mockDrainer, &leader.DummyElector{})
+		done = make(chan struct{})
+
+		hostID := strfmt.UUID(uuid.New().String())
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		host = &models.Host{
+			ID:                &hostID,
+			InfraEnvID:        infraEnvID,
+			ClusterID:         &clusterID,
+			Status:            swag.String(models.HostStatusInstalled),
+			RequestedHostname: nodeName,
+		}
+		Expect(db.Create(host).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectEvent := func(name string) *gomock.Call {
+		return mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(name),
+			eventstest.WithHostIdMatcher(host.ID.String()),
+			eventstest.WithInfraEnvIdMatcher(host.InfraEnvID.String()),
+			eventstest.WithClusterIdMatcher(host.ClusterID.String()))).Times(1)
+	}
+
+	expectLastEvent := func(name string) {
+		expectEvent(name).Do(func(_ context.Context, _ eventsapi.HostEvent) { close(done) })
+	}
+
+	expectNodeRemoval := func() {
+		mockSpokeClientFactory.EXPECT().ClientAndSetFromStorageKubeconfig(gomock.Any(), host.ClusterID, mockS3Client).
+			Return(mockSpokeClient, nil, nil)
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), nodeName).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), nodeName).Return(nil)
+		mockSpokeClient.EXPECT().DeleteNode(gomock.Any(), nodeName).Return(nil)
+		expectEvent(eventgen.HostDecommissionStartedEventName)
+		expectEvent(eventgen.HostNodeDrainedEventName)
+		expectEvent(eventgen.HostNodeDeletedEventName)
+	}
+
+	getHost := func() *models.Host {
+		h, err := common.GetHostFromDB(db, host.InfraEnvID.String(), host.ID.String())
+		Expect(err).ToNot(HaveOccurred())
+		return &h.Host
+	}
+
+	waitForDecommission := func() {
+		Eventually(done, 10*time.Second).Should(BeClosed())
+	}
+
+	expectApiError := func(err error, statusCode int32) {
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(Equal(statusCode))
+	}
+
+	It("removes the node of the host", func() {
+		expectNodeRemoval()
+		expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+
+		Expect(manager.DecommissionHost(ctx, host, false)).To(Succeed())
+		manager.Monitor()
+		waitForDecommission()
+		h := getHost()
+		Expect(h.DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+		Expect(h.DecommissionInfo).To(ContainSubstring(nodeName))
+	})
+
+	It("completes without a node", func() {
+		mockSpokeClientFactory.EXPECT().ClientAndSetFromStorageKubeconfig(gomock.Any(), host.ClusterID, mockS3Client).
+			Return(mockSpokeClient, nil, nil)
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), nodeName).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, nodeName))
+		expectEvent(eventgen.HostDecommissionStartedEventName)
+		expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+
+		Expect(manager.DecommissionHost(ctx, host, false)).To(Succeed())
+		manager.Monitor()
+		waitForDecommission()
+		Expect(getHost().DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+	})
+
+	It("fails when the node can't be drained", func() {
+		mockSpokeClientFactory.EXPECT().ClientAndSetFromStorageKubeconfig(gomock.Any(), host.ClusterID, mockS3Client).
+			Return(mockSpokeClient, nil, nil)
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		mockSpokeClient.EXPECT().GetNode(gomock.Any(), nodeName).Return(node, nil)
+		mockDrainer.EXPECT().RunCordonOrUncordon(gomock.Any(), node, true).Return(nil)
+		mockDrainer.EXPECT().RunNodeDrain(gomock.Any(), nodeName).Return(errors.New("pods can't be evicted"))
+		expectEvent(eventgen.HostDecommissionStartedEventName)
+		expectLastEvent(eventgen.HostDecommissionFailedEventName)
+
+		Expect(manager.DecommissionHost(ctx, host, false)).To(Succeed())
+		manager.Monitor()
+		waitForDecommission()
+		h := getHost()
+		Expect(h.DecommissionStage).To(Equal(models.HostDecommissionStageFailed))
+		Expect(h.DecommissionInfo).To(ContainSubstring("pods can't be evicted"))
+	})
+
+	It("skips the wipe of the disks when the agent of the host isn't connected", func() {
+		expectNodeRemoval()
+		expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+
+		Expect(manager.DecommissionHost(ctx, host, true)).To(Succeed())
+		manager.Monitor()
+		waitForDecommission()
+		h := getHost()
+		Expect(h.DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+		Expect(h.DecommissionInfo).To(ContainSubstring("The disks weren't wiped"))
+	})
+
+	Context("wipe disks", func() {
+		BeforeEach(func() {
+			expectNodeRemoval()
+			Expect(db.Model(host).Update("checked_in_at", time.Now()).Error).ToNot(HaveOccurred())
+			Expect(manager.DecommissionHost(ctx, host, true)).To(Succeed())
+			manager.Monitor()
+			Eventually(func() string {
+				return getHost().DecommissionStage
+			}, 10*time.Second).Should(Equal(models.HostDecommissionStageWipingDisks))
+		})
+
+		It("completes on the reply of the agent", func() {
+			expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+			Expect(manager.HandleWipeDisksReply(ctx, getHost(), "")).To(Succeed())
+			waitForDecommission()
+			Expect(getHost().DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+		})
+
+		It("fails on the error of the agent", func() {
+			expectLastEvent(eventgen.HostDecommissionFailedEventName)
+			Expect(manager.HandleWipeDisksReply(ctx, getHost(), "device busy")).To(Succeed())
+			waitForDecommission()
+			h := getHost()
+			Expect(h.DecommissionStage).To(Equal(models.HostDecommissionStageFailed))
+			Expect(h.DecommissionInfo).To(ContainSubstring("device busy"))
+		})
+
+		It("fails when the agent doesn't wipe the disks in time", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", host.ID.String()).
+				Update("decommission_stage_updated_at", time.Now().Add(-time.Hour)).Error).ToNot(HaveOccurred())
+			expectLastEvent(eventgen.HostDecommissionFailedEventName)
+			manager.Monitor()
+			waitForDecommission()
+			h := getHost()
+			Expect(h.DecommissionStage).To(Equal(models.HostDecommissionStageFailed))
+			Expect(h.DecommissionInfo).To(ContainSubstring("didn't wipe its disks"))
+		})
+	})
+
+	It("resumes an interrupted decommission from its stage", func() {
+		Expect(db.Model(&common.Host{}).Where("id = ?", host.ID.String()).Updates(map[string]interface{}{
+			"decommission_stage":     models.HostDecommissionStageDeletingNode,
+			"decommission_node_name": nodeName,
+		}).Error).ToNot(HaveOccurred())
+		mockSpokeClientFactory.EXPECT().ClientAndSetFromStorageKubeconfig(gomock.Any(), host.ClusterID, mockS3Client).
+			Return(mockSpokeClient, nil, nil)
+		mockSpokeClient.EXPECT().DeleteNode(gomock.Any(), nodeName).Return(nil)
+		expectEvent(eventgen.HostNodeDeletedEventName)
+		expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+
+		manager.Monitor()
+		waitForDecommission()
+		Expect(getHost().DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+	})
+
+	It("leaves the decommissions to the leader", func() {
+		mockLeader := leader.NewMockElectorInterface(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(false)
+		manager.leaderElector = mockLeader
+		expectEvent(eventgen.HostDecommissionStartedEventName)
+
+		Expect(manager.DecommissionHost(ctx, host, false)).To(Succeed())
+		manager.Monitor()
+		Expect(getHost().DecommissionStage).To(Equal(models.HostDecommissionStageDraining))
+	})
+
+	It("ignores a wipe-disks reply out of the wiping-disks stage", func() {
+		Expect(manager.HandleWipeDisksReply(ctx, host, "")).To(Succeed())
+		Expect(getHost().DecommissionStage).To(BeEmpty())
+	})
+
+	It("retries a failed decommission", func() {
+		Expect(db.Model(host).Update("decommission_stage", models.HostDecommissionStageFailed).Error).ToNot(HaveOccurred())
+		expectNodeRemoval()
+		expectLastEvent(eventgen.HostDecommissionCompletedEventName)
+
+		Expect(manager.DecommissionHost(ctx, getHost(), false)).To(Succeed())
+		manager.Monitor()
+		waitForDecommission()
+		Expect(getHost().DecommissionStage).To(Equal(models.HostDecommissionStageCompleted))
+	})
+
+	It("rejects a host without a cluster", func() {
+		host.ClusterID = nil
+		expectApiError(manager.DecommissionHost(ctx, host, false), http.StatusBadRequest)
+	})
+
+	It("rejects a host which isn't installed", func() {
+		host.Status = swag.String(models.HostStatusKnown)
+		expectApiError(manager.DecommissionHost(ctx, host, false), http.StatusConflict)
+	})
+
+	It("rejects a host already being decommissioned", func() {
+		host.DecommissionStage = models.HostDecommissionStageDraining
+		expectApiError(manager.DecommissionHost(ctx, host, false), http.StatusConflict)
+	})
+
+	It("rejects a decommissioned host", func() {
+		host.DecommissionStage = models.HostDecommissionStageCompleted
+		expectApiError(manager.DecommissionHost(ctx, host, false), http.StatusConflict)
+	})
+
+	It("rejects a concurrent decommission", func() {
+		Expect(db.Model(host).Update("decommission_stage", models.HostDecommissionStageDeletingNode).Error).ToNot(HaveOccurred())
+		expectApiError(manager.DecommissionHost(ctx, host, false), http.StatusConflict)
+	})
+})
@@ -3,6 +3,7 @@ package dns
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/validations"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 const (
@@ -50,8 +52,17 @@ type DNSProviderFactory interface {
 	GetProvider(domain *DNSDomain) dnsproviders.Provider
 }
 
+// ZoneFilesConfig configures the zone files of the domains managed by the zonefile provider
+type ZoneFilesConfig struct {
+	Dir string `envconfig:"DNS_ZONE_FILES_DIR" default:"/data/dns-zones"`
+	// The addresses of the name server of the zones, published as the glue records of ns.<domain>
+	NameserverAddresses []string `envconfig:"DNS_ZONE_FILES_NAMESERVER_ADDRESSES"`
+}
+
 type defaultDNSProviderFactory struct {
-	log logrus.FieldLogger
+	log       logrus.FieldLogger
+	db        *gorm.DB
+	zoneFiles ZoneFilesConfig
 }
 
 type handler struct {
@@ -60,8 +71,10 @@ type handler struct {
 	providerFactory DNSProviderFactory
 }
 
-func NewDNSHandler(baseDNSDomains map[string]string, log logrus.FieldLogger) DNSApi {
-	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log})
+// NewDNSHandler returns a handler using the default DNS providers. The updates of the zone files of the domains managed
+// by the zonefile provider are serialized between the replicas of the service with a lock of the database.
+func NewDNSHandler(baseDNSDomains map[string]string, zoneFiles ZoneFilesConfig, db *gorm.DB, log logrus.FieldLogger) DNSApi {
+	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log: log, db: db, zoneFiles: zoneFiles})
 }
 
 func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.FieldLogger, providers DNSProviderFactory) DNSApi {
@@ -275,6 +288,11 @@ func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, r
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case "zonefile":
+		return f.zoneFile(domain, dnsproviders.RecordSet{
+			RecordSetType: recordType,
+			TTL:           60,
+		})
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
@@ -287,7 +305,20 @@ func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case "zonefile":
+		return f.zoneFile(domain, dnsproviders.RecordSet{})
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
 }
+
+// zoneFile returns the provider of the zone file of the domain, named after the domain ID in the zone files directory
+func (f *defaultDNSProviderFactory) zoneFile(domain *DNSDomain, recordSet dnsproviders.RecordSet) dnsproviders.Provider {
+	return ZoneFile{
+		RecordSet:           recordSet,
+		Path:                filepath.Join(f.zoneFiles.Dir, filepath.Base(domain.ID)),
+		Origin:              domain.Name,
+		NameserverAddresses: f.zoneFiles.NameserverAddresses,
+		DB:                  f.db,
+	}
+}
@@ -24,7 +24,7 @@ var _ = Describe("DNS tests", func() {
 
 	BeforeEach(func() {
 		baseDNSDomains = make(map[string]string)
-		dnsApi = NewDNSHandler(baseDNSDomains, logrus.New())
+		dnsApi = NewDNSHandler(baseDNSDomains, ZoneFilesConfig{}, nil, logrus.New())
 	})
 
 	It("get DNS domain success", func() {
@@ -298,11 +298,11 @@ var _ = Describe("Default DNS provider tests", func() {
 		domain = &DNSDomain{
 			Provider: "route53",
 		}
-		providers = &defaultDNSProviderFactory{logrus.New()}
+		providers = &defaultDNSProviderFactory{log: logrus.New()}
 	})
 
 	It("default provider is used when no provider factory specified", func() {
-		dns := NewDNSHandler(make(map[string]string), logrus.New())
+		dns := NewDNSHandler(make(map[string]string), ZoneFilesConfig{}, nil, logrus.New())
 		h, ok := dns.(*handler)
 		Expect(ok).To(BeTrue())
 		Expect(h.providerFactory).To(BeAssignableToTypeOf(providers))
@@ -323,6 +323,19 @@ var _ = Describe("Default DNS provider tests", func() {
 		Expect(ok).To(BeTrue())
 		Expect(r53.RecordSet.RecordSetType).To(Equal("A"))
 	})
+	It("zone file provider", func() {
+		providers = &defaultDNSProviderFactory{log: logrus.New(), zoneFiles: ZoneFilesConfig{
+			Dir:                 "/data/dns-zones",
+			NameserverAddresses: []string{"1.2.3.1"},
+		}}
+		p := providers.GetProviderByRecordType(&DNSDomain{Name: "example.com", ID: "example.com.zone", Provider: "zonefile"}, "A")
+		zoneFile, ok := p.(ZoneFile)
+		Expect(ok).To(BeTrue())
+		Expect(zoneFile.RecordSet.RecordSetType).To(Equal("A"))
+		Expect(zoneFile.Path).To(Equal("/data/dns-zones/example.com.zone"))
+		Expect(zoneFile.Origin).To(Equal("example.com"))
+		Expect(zoneFile.NameserverAddresses).To(Equal([]string{"1.2.3.1"}))
+	})
 })
 
 var _ = Describe("Base DNS domain validation", func() {
@@ -0,0 +1,275 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	zoneFileTTL     int64 = 60
+	zoneFileRefresh       = 3600
+	zoneFileRetry         = 600
+	zoneFileExpire        = 604800
+)
+
+// zoneFileLock serializes the updates of the zone files within the process, which are rewritten as a whole on every
+// change
+var zoneFileLock sync.Mutex
+
+// ZoneFile is a DNS provider keeping the records of a managed domain in an RFC 1035 zone file, for on-prem
+// deployments without a cloud DNS service. The file is owned by the service and rewritten on every change with an
+// incremented SOA serial, so an authoritative DNS server reading it (e.g. the file plugin of CoreDNS, or BIND) can
+// reload it.
+//
+// The replicas of the service must share the directory of the zone file. Their updates are serialized with an
+// advisory lock of the database, when DB is set.
+type ZoneFile struct {
+	RecordSet           dnsproviders.RecordSet
+	Path                string
+	Origin              string
+	NameserverAddresses []string
+	DB                  *gorm.DB
+}
+
+type zoneRecord struct {
+	name       string
+	ttl        int64
+	recordType string
+	value      string
+}
+
+func (r zoneRecord) String() string {
+	return fmt.Sprintf("%s\t%d\tIN\t%s\t%s", r.name, r.ttl, r.recordType, r.value)
+}
+
+type zone struct {
+	serial  int64
+	records []zoneRecord
+}
+
+func fqdn(name string) string {
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// CreateRecordSet creates a record set, and fails if a record set of the same name and type already exists
+func (z ZoneFile) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return z.update(func(records []zoneRecord, record zoneRecord) ([]zoneRecord, error) {
+		if z.find(records, record.name) != nil {
+			return nil, errors.Errorf("record set %s of type %s already exists", recordSetName, z.RecordSet.RecordSetType)
+		}
+		return append(records, record), nil
+	}, recordSetName, recordSetValue)
+}
+
+// UpdateRecordSet replaces the record set of the same name and type, or creates it
+func (z ZoneFile) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return z.update(func(records []zoneRecord, record zoneRecord) ([]zoneRecord, error) {
+		if existing := z.find(records, record.name); existing != nil {
+			*existing = record
+			return records, nil
+		}
+		return append(records, record), nil
+	}, recordSetName, recordSetValue)
+}
+
+// DeleteRecordSet deletes a record set, and fails if it doesn't exist
+func (z ZoneFile) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return z.update(func(records []zoneRecord, record zoneRecord) ([]zoneRecord, error) {
+		for i, r := range records {
+			if r.name == record.name && r.recordType == record.recordType && r.value == record.value {
+				return append(records[:i], records[i+1:]...), nil
+			}
+		}
+		return nil, errors.Errorf("record set %s of type %s with value %s doesn't exist", recordSetName, z.RecordSet.RecordSetType, recordSetValue)
+	}, recordSetName, recordSetValue)
+}
+
+// GetRecordSet returns the record set with the specified name, or an empty string if it doesn't exist
+func (z ZoneFile) GetRecordSet(recordSetName string) (string, error) {
+	zoneFileLock.Lock()
+	defer zoneFileLock.Unlock()
+	zone, err := z.read()
+	if err != nil {
+		return "", err
+	}
+	if record := z.find(zone.records, fqdn(recordSetName)); record != nil {
+		return record.String(), nil
+	}
+	return "", nil
+}
+
+// GetDomainName returns the origin of the zone
+func (z ZoneFile) GetDomainName() (string, error) {
+	return strings.TrimSuffix(z.Origin, "."), nil
+}
+
+func (z ZoneFile) find(records []zoneRecord, name string) *zoneRecord {
+	for i := range records {
+		if records[i].name == name && records[i].recordType == z.RecordSet.RecordSetType {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+// lockKey returns the key of the advisory lock of the zone file in the database
+func (z ZoneFile) lockKey() int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(filepath.Clean(z.Path)))
+	return int64(h.Sum64())
+}
+
+// locked runs f while holding the lock of the zone file, in the process and, when DB is set, in the database
+func (z ZoneFile) locked(f func() error) error {
+	zoneFileLock.Lock()
+	defer zoneFileLock.Unlock()
+	if z.DB == nil {
+		return f()
+	}
+	return z.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", z.lockKey()).Error; err != nil {
+			return errors.Wrapf(err, "failed to lock zone file %s", z.Path)
+		}
+		return f()
+	})
+}
+
+func (z ZoneFile) update(change func([]zoneRecord, zoneRecord) ([]zoneRecord, error), recordSetName, recordSetValue string) (string, error) {
+	ttl := z.RecordSet.TTL
+	if ttl == 0 {
+		ttl = zoneFileTTL
+	}
+	record := zoneRecord{name: fqdn(recordSetName), ttl: ttl, recordType: z.RecordSet.RecordSetType, value: recordSetValue}
+	err := z.locked(func() error {
+		zone, err := z.read()
+		if err != nil {
+			return err
+		}
+		if zone.records, err = change(zone.records, record); err != nil {
+			return err
+		}
+		zone.serial++
+		return z.write(zone)
+	})
+	if err != nil {
+		return "", err
+	}
+	return record.String(), nil
+}
+
+// glueRecords returns the address records of the name server of the zone
+func (z ZoneFile) glueRecords() ([]zoneRecord, error) {
+	if len(z.NameserverAddresses) == 0 {
+		return nil, errors.Errorf("the addresses of the name server of zone file %s are not configured", z.Path)
+	}
+	var ret []zoneRecord
+	for _, address := range z.NameserverAddresses {
+		ip := net.ParseIP(strings.TrimSpace(address))
+		if ip == nil {
+			return nil, errors.Errorf("invalid address %s of the name server of zone file %s", address, z.Path)
+		}
+		recordType := "AAAA"
+		if ip.To4() != nil {
+			recordType = "A"
+		}
+		ret = append(ret, zoneRecord{name: "ns." + fqdn(z.Origin), ttl: zoneFileTTL, recordType: recordType, value: ip.String()})
+	}
+	return ret, nil
+}
+
+// read parses the zone file as written by write. Only the SOA serial and the records are kept, the rest of the file
+// is generated again on every write.
+func (z ZoneFile) read() (*zone, error) {
+	ret := &zone{}
+	f, err := os.Open(z.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ret, nil
+		}
+		return nil, errors.Wrapf(err, "failed to open zone file %s", z.Path)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "$") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 5 || fields[2] != "IN" {
+			continue
+		}
+		switch fields[3] {
+		case "SOA":
+			if len(fields) > 6 {
+				if ret.serial, err = strconv.ParseInt(fields[6], 10, 64); err != nil {
+					return nil, errors.Wrapf(err, "invalid SOA serial in zone file %s", z.Path)
+				}
+			}
+		case "A", "AAAA":
+			// The glue records of the name server are generated again on every write
+			if fields[0] == "ns."+fqdn(z.Origin) {
+				continue
+			}
+			ttl, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid TTL of record %s in zone file %s", fields[0], z.Path)
+			}
+			ret.records = append(ret.records, zoneRecord{name: fields[0], ttl: ttl, recordType: fields[3], value: fields[4]})
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read zone file %s", z.Path)
+	}
+	return ret, nil
+}
+
+// write replaces the zone file atomically, so the DNS server never reads a partial zone
+func (z ZoneFile) write(zone *zone) error {
+	glueRecords, err := z.glueRecords()
+	if err != nil {
+		return err
+	}
+	origin := fqdn(z.Origin)
+	var b strings.Builder
+	fmt.Fprintf(&b, "; Managed by assisted-service, do not edit\n")
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(&b, "$TTL %d\n", zoneFileTTL)
+	fmt.Fprintf(&b, "%s\t%d\tIN\tSOA\tns.%s hostmaster.%s %d %d %d %d %d\n", origin, zoneFileTTL, origin, origin,
+		zone.serial, zoneFileRefresh, zoneFileRetry, zoneFileExpire, zoneFileTTL)
+	fmt.Fprintf(&b, "%s\t%d\tIN\tNS\tns.%s\n", origin, zoneFileTTL, origin)
+	for _, record := range append(glueRecords, zone.records...) {
+		fmt.Fprintln(&b, record.String())
+	}
+
+	if err := os.MkdirAll(filepath.Dir(z.Path), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create the directory of zone file %s", z.Path)
+	}
+	f, err := os.CreateTemp(filepath.Dir(z.Path), filepath.Base(z.Path)+".*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create zone file %s", z.Path)
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.WriteString(b.String()); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to write zone file %s", z.Path)
+	}
+	if err = f.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write zone file %s", z.Path)
+	}
+	if err = os.Chmod(f.Name(), 0o644); err != nil {
+		return errors.Wrapf(err, "failed to set the permissions of zone file %s", z.Path)
+	}
+	return errors.Wrapf(os.Rename(f.Name(), z.Path), "failed to replace zone file %s", z.Path)
+}
@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Zone file provider", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "zonefile")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "zones", "example.com.zone")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	provider := func(recordType string) ZoneFile {
+		return ZoneFile{
+			RecordSet:           dnsproviders.RecordSet{RecordSetType: recordType, TTL: 60},
+			Path:                path,
+			Origin:              "example.com",
+			NameserverAddresses: []string{"1.2.3.1", "1001:db8::1"},
+		}
+	}
+
+	readZone := func() string {
+		b, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	It("creates the zone file with the records", func() {
+		_, err := provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider("AAAA").CreateRecordSet("*.apps.test-cluster.example.com", "1001:db8::10")
+		Expect(err).ToNot(HaveOccurred())
+
+		content := readZone()
+		Expect(content).To(ContainSubstring("$ORIGIN example.com.\n"))
+		Expect(content).To(ContainSubstring("example.com.\t60\tIN\tSOA\tns.example.com. hostmaster.example.com. 2 "))
+		Expect(content).To(ContainSubstring("api.test-cluster.example.com.\t60\tIN\tA\t1.2.3.4\n"))
+		Expect(content).To(ContainSubstring("*.apps.test-cluster.example.com.\t60\tIN\tAAAA\t1001:db8::10\n"))
+	})
+
+	It("writes the glue records of the name server once", func() {
+		_, err := provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider("A").CreateRecordSet("api.other-cluster.example.com", "1.2.3.5")
+		Expect(err).ToNot(HaveOccurred())
+
+		content := readZone()
+		Expect(content).To(ContainSubstring("example.com.\t60\tIN\tNS\tns.example.com.\n"))
+		Expect(strings.Count(content, "ns.example.com.\t60\tIN\tA\t1.2.3.1\n")).To(Equal(1))
+		Expect(strings.Count(content, "ns.example.com.\t60\tIN\tAAAA\t1001:db8::1\n")).To(Equal(1))
+	})
+
+	It("fails to write the zone without the addresses of the name server", func() {
+		zoneFile := provider("A")
+		zoneFile.NameserverAddresses = nil
+		_, err := zoneFile.CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).To(HaveOccurred())
+		_, err = os.Stat(path)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("fails to create an existing record set", func() {
+		_, err := provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.5")
+		Expect(err).To(HaveOccurred())
+		_, err = provider("AAAA").CreateRecordSet("api.test-cluster.example.com", "1001:db8::10")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("updates a record set", func() {
+		_, err := provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider("A").UpdateRecordSet("api.test-cluster.example.com", "1.2.3.5")
+		Expect(err).ToNot(HaveOccurred())
+
+		content := readZone()
+		Expect(content).To(ContainSubstring("api.test-cluster.example.com.\t60\tIN\tA\t1.2.3.5\n"))
+		Expect(content).ToNot(ContainSubstring("1.2.3.4"))
+	})
+
+	It("gets and deletes a record set", func() {
+		_, err := provider("A").CreateRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+
+		record, err := provider("A").GetRecordSet("api.test-cluster.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record).ToNot(BeEmpty())
+		record, err = provider("AAAA").GetRecordSet("api.test-cluster.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record).To(BeEmpty())
+
+		_, err = provider("A").DeleteRecordSet("api.test-cluster.example.com", "1.2.3.5")
+		Expect(err).To(HaveOccurred())
+		_, err = provider("A").DeleteRecordSet("api.test-cluster.example.com", "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		record, err = provider("A").GetRecordSet("api.test-cluster.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record).To(BeEmpty())
+		Expect(readZone()).To(ContainSubstring("hostmaster.example.com. 2 "))
+	})
+
+	It("returns the origin as the domain name", func() {
+		name, err := ZoneFile{Origin: "example.com."}.GetDomainName()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("example.com"))
+	})
+})
@@ -0,0 +1,293 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	ctxparams "github.com/openshift/assisted-service/pkg/context"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/dry_run"
+	"github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoragePrefix is the prefix under which the files generated by the dry runs are stored
+const StoragePrefix = "dry-run"
+
+type Config struct {
+	// Timeout is the longest a dry run can take. A dry run still running after it, for example because the replica
+	// running it restarted, is reported as interrupted and can be started again.
+	Timeout time.Duration `envconfig:"DRY_RUN_TIMEOUT" default:"30m"`
+}
+
+// InstallConfigGenerator generates the ignition files of the cluster installation, like the generator of the
+// installation does
+//
+//go:generate mockgen --build_flags=--mod=mod -package dryrun -destination mock_install_config_generator.go . InstallConfigGenerator
+type InstallConfigGenerator interface {
+	GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string) error
+}
+
+var _ restapi.DryRunAPI = (*Handler)(nil)
+
+// NewHandler returns the dry run handler. The manifests API, the manifests generator, the operators API and the
+// generator must store their files with objectHandler, so that the files of the cluster are left untouched.
+// rhRootCA is added to the install config when it is not empty.
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, cfg Config, objectHandler *s3wrapper.OverlayClient, manifestsAPI manifestsapi.ManifestsAPI,
+	manifestsGenerator network.ManifestsGeneratorAPI, operatorsAPI operators.API, installConfigBuilder installcfg.InstallConfigBuilder,
+	versionsHandler versions.Handler, generator InstallConfigGenerator, rhRootCA string) *Handler {
+	return &Handler{
+		log:                  log,
+		db:                   db,
+		cfg:                  cfg,
+		objectHandler:        objectHandler,
+		manifestsAPI:         manifestsAPI,
+		manifestsGenerator:   manifestsGenerator,
+		operatorsAPI:         operatorsAPI,
+		installConfigBuilder: installConfigBuilder,
+		versionsHandler:      versionsHandler,
+		generator:            generator,
+		rhRootCA:             rhRootCA,
+	}
+}
+
+// Handler runs the generation of the installation files of clusters without changing them
+type Handler struct {
+	log                  logrus.FieldLogger
+	db                   *gorm.DB
+	cfg                  Config
+	objectHandler        *s3wrapper.OverlayClient
+	manifestsAPI         manifestsapi.ManifestsAPI
+	manifestsGenerator   network.ManifestsGeneratorAPI
+	operatorsAPI         operators.API
+	installConfigBuilder installcfg.InstallConfigBuilder
+	versionsHandler      versions.Handler
+	generator            InstallConfigGenerator
+	rhRootCA             string
+}
+
+func (h *Handler) V2DryRunGenerate(ctx context.Context, params operations.V2DryRunGenerateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	c, err := common.GetClusterFromDBWithHosts(h.db, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	report, err := h.start(c)
+	if err != nil {
+		log.WithError(err).Errorf("failed to start the dry run of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	// The dry run outlives the request, it is bounded by the timeout instead
+	runCtx, cancel := context.WithTimeout(ctxparams.Copy(ctx), h.cfg.Timeout)
+	go func() {
+		defer cancel()
+		h.run(runCtx, c, report)
+	}()
+	return operations.NewV2DryRunGenerateAccepted().WithPayload(report)
+}
+
+func (h *Handler) V2GetDryRunGenerate(ctx context.Context, params operations.V2GetDryRunGenerateParams) middleware.Responder {
+	var report models.DryRunGenerateReport
+	if err := h.db.Take(&report, "cluster_id = ?", params.ClusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("No dry run of cluster %s was started", params.ClusterID))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if h.timedOut(&report) {
+		report.Status = swag.String(models.DryRunGenerateReportStatusInterrupted)
+		report.StatusInfo = fmt.Sprintf("The dry run didn't complete within %s", h.cfg.Timeout)
+	}
+	return operations.NewV2GetDryRunGenerateOK().WithPayload(&report)
+}
+
+// start records the dry run of the cluster as running. The record is the lock of the dry runs of the cluster across
+// the replicas: it is only replaced when the previous dry run is over or timed out.
+func (h *Handler) start(c *common.Cluster) (*models.DryRunGenerateReport, error) {
+	now := time.Now()
+	report := &models.DryRunGenerateReport{
+		ClusterID:     c.ID,
+		StoragePrefix: swag.String(h.objectHandler.ObjectName(c.ID.String())),
+		Status:        swag.String(models.DryRunGenerateReportStatusRunning),
+		StartedAt:     strfmt.DateTime(now),
+		Failures:      []*models.DryRunGenerateFailure{},
+		Objects:       []string{},
+	}
+	result := h.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cluster_id"}},
+		Where: clause.Where{Exprs: []clause.Expression{clause.Expr{
+			SQL:  "dry_run_generate_reports.status <> ? OR dry_run_generate_reports.started_at < ?",
+			Vars: []interface{}{models.DryRunGenerateReportStatusRunning, now.Add(-h.cfg.Timeout)},
+		}}},
+		UpdateAll: true,
+	}).Create(report)
+	if result.Error != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("A dry run of cluster %s is already in progress", c.ID))
+	}
+	return report, nil
+}
+
+// run generates the files of the dry run and records its report, unless the dry run timed out and another one
+// was started meanwhile
+func (h *Handler) run(ctx context.Context, c *common.Cluster, report *models.DryRunGenerateReport) {
+	log := logutil.FromContext(ctx, h.log)
+	err := h.generate(ctx, c, report)
+	if err != nil {
+		log.WithError(err).Errorf("failed to run the dry run of cluster %s", c.ID)
+		report.Status = swag.String(models.DryRunGenerateReportStatusInterrupted)
+		report.StatusInfo = err.Error()
+	} else {
+		report.Status = swag.String(models.DryRunGenerateReportStatusCompleted)
+	}
+	report.CompletedAt = strfmt.DateTime(time.Now())
+
+	result := h.db.Model(&models.DryRunGenerateReport{}).
+		Where("cluster_id = ? AND started_at = ?", c.ID.String(), report.StartedAt).
+		Select("*").Updates(report)
+	if result.Error != nil {
+		log.WithError(result.Error).Errorf("failed to record the report of the dry run of cluster %s", c.ID)
+	} else if result.RowsAffected == 0 {
+		log.Warnf("the dry run of cluster %s was superseded by a newer one", c.ID)
+	}
+}
+
+func (h *Handler) timedOut(report *models.DryRunGenerateReport) bool {
+	return swag.StringValue(report.Status) == models.DryRunGenerateReportStatusRunning &&
+		time.Since(time.Time(report.StartedAt)) > h.cfg.Timeout
+}
+
+// generate runs the stages of the generation one after the other, going on after the failing ones unless the
+// following stages depend on them
+func (h *Handler) generate(ctx context.Context, c *common.Cluster, report *models.DryRunGenerateReport) error {
+	log := logutil.FromContext(ctx, h.log)
+	// Files of previous dry runs would otherwise be taken for generated ones
+	if err := h.objectHandler.DeleteOverlayObjectsByPrefix(ctx, c.ID.String()); err != nil {
+		return errors.Wrap(err, "failed to delete the files of the previous dry run")
+	}
+	fail := func(stage models.DryRunGenerateStage, fileName string, err error) {
+		log.WithError(err).Infof("dry run of cluster %s failed at stage %s", c.ID, stage)
+		report.Failures = append(report.Failures, &models.DryRunGenerateFailure{
+			Stage:    models.NewDryRunGenerateStage(stage),
+			FileName: fileName,
+			Message:  swag.String(err.Error()),
+		})
+	}
+
+	infraEnvs, err := common.GetInfraEnvsFromDBWhere(h.db, "cluster_id = ? OR id IN (?)", c.ID.String(), hostsInfraEnvIDs(c))
+	if err != nil {
+		return err
+	}
+	var infraEnvID strfmt.UUID
+	if len(infraEnvs) > 0 {
+		infraEnvID = *infraEnvs[0].ID
+	}
+	hosts, synthetic, err := dryRunHosts(c, infraEnvID)
+	if err != nil {
+		return err
+	}
+	dryRunCluster := *c
+	dryRunCluster.Hosts = hosts
+	report.SyntheticHostsCount = int64(synthetic)
+
+	if err = h.validateCustomManifests(ctx, c.ID, fail); err != nil {
+		fail(models.DryRunGenerateStageCustomManifests, "", err)
+	}
+
+	if err = cluster.GenerateAdditionalManifests(ctx, log, &dryRunCluster, h.manifestsGenerator, h.operatorsAPI); err != nil {
+		fail(models.DryRunGenerateStageAdditionalManifests, "", err)
+	}
+
+	cfg, releaseImage, installerReleaseImageOverride, err := h.installConfig(ctx, &dryRunCluster, infraEnvs)
+	if err != nil {
+		fail(models.DryRunGenerateStageInstallConfig, "", err)
+	} else if err = h.generator.GenerateInstallConfig(ctx, dryRunCluster, cfg, releaseImage, installerReleaseImageOverride); err != nil {
+		fail(models.DryRunGenerateStageIgnition, "", err)
+	}
+
+	objects, err := h.objectHandler.ListOverlayObjectsByPrefix(ctx, c.ID.String())
+	if err != nil {
+		return err
+	}
+	report.Objects = make([]string, 0, len(objects))
+	for _, object := range objects {
+		report.Objects = append(report.Objects, strings.TrimPrefix(object, c.ID.String()+"/"))
+	}
+	report.Succeeded = len(report.Failures) == 0
+	return nil
+}
+
+// validateCustomManifests reports the custom manifests whose content is not valid
+func (h *Handler) validateCustomManifests(ctx context.Context, clusterID *strfmt.UUID,
+	fail func(stage models.DryRunGenerateStage, fileName string, err error)) error {
+	customManifests, err := h.manifestsAPI.ListClusterManifestsInternal(ctx, manifests.V2ListClusterManifestsParams{
+		ClusterID: *clusterID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, manifest := range customManifests {
+		if err = h.manifestsAPI.ValidateClusterManifestInternal(ctx, *clusterID, manifest.Folder, manifest.FileName); err != nil {
+			fail(models.DryRunGenerateStageCustomManifests, path.Join(manifest.Folder, manifest.FileName), err)
+		}
+	}
+	return nil
+}
+
+// installConfig returns the install config of the cluster and the release images to generate its ignition files
+// with, the same way the installation does
+func (h *Handler) installConfig(ctx context.Context, c *common.Cluster, infraEnvs []*common.InfraEnv) ([]byte, string, string, error) {
+	cfg, err := h.installConfigBuilder.GetInstallConfig(c, infraEnvs, h.rhRootCA)
+	if err != nil {
+		return nil, "", "", errors.Wrapf(err, "failed to get install config for cluster %s", c.ID)
+	}
+
+	releaseImage, err := h.versionsHandler.GetReleaseImage(ctx, c.OpenshiftVersion, c.CPUArchitecture, c.PullSecret)
+	if err != nil {
+		return nil, "", "", errors.Wrapf(err, "failed to get the release image of openshift version %s", c.OpenshiftVersion)
+	}
+
+	installerReleaseImageOverride := ""
+	if featuresupport.IsBaremetalBinaryFromAnotherReleaseImageRequired(c.CPUArchitecture, c.OpenshiftVersion) {
+		defaultArchImage, err := h.versionsHandler.GetReleaseImage(ctx, c.OpenshiftVersion, common.DefaultCPUArchitecture, c.PullSecret)
+		if err != nil {
+			return nil, "", "", errors.Wrapf(err, "failed to get image for installer image override with openshift version %s and %s arch",
+				c.OpenshiftVersion, common.DefaultCPUArchitecture)
+		}
+		installerReleaseImageOverride = *defaultArchImage.URL
+	}
+	return cfg, *releaseImage.URL, installerReleaseImageOverride, nil
+}
+
+func hostsInfraEnvIDs(c *common.Cluster) []string {
+	ids := make([]string, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		ids = append(ids, h.InfraEnvID.String())
+	}
+	return ids
+}
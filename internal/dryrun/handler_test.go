@@ -0,0 +1,250 @@
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/constants"
+	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	operations "github.com/openshift/assisted-service/restapi/operations/dry_run"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Dry run handler", func() {
+	var (
+		ctx                    = context.Background()
+		db                     *gorm.DB
+		dbName                 string
+		ctrl                   *gomock.Controller
+		baseDir                string
+		base                   s3wrapper.API
+		overlay                *s3wrapper.OverlayClient
+		mockManifestsGenerator *network.MockManifestsGeneratorAPI
+		mockOperators          *operators.MockAPI
+		mockInstallConfig      *installcfg.MockInstallConfigBuilder
+		mockVersions           *versions.MockHandler
+		mockGenerator          *MockInstallConfigGenerator
+		handler                *Handler
+		cluster                *common.Cluster
+		releaseImage           = "quay.io/openshift-release-dev/ocp-release:4.14.0-x86_64"
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		var err error
+		baseDir, err = os.MkdirTemp("", "dry-run")
+		Expect(err).ToNot(HaveOccurred())
+		mockMetrics := metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		base = s3wrapper.NewFSClient(baseDir, common.GetTestLog(), mockMetrics, 80)
+		overlay = s3wrapper.NewOverlayClient(base, StoragePrefix)
+
+		mockManifestsGenerator = network.NewMockManifestsGeneratorAPI(ctrl)
+		mockManifestsGenerator.EXPECT().IsSNODNSMasqEnabled().Return(false).AnyTimes()
+		mockOperators = operators.NewMockAPI(ctrl)
+		mockInstallConfig = installcfg.NewMockInstallConfigBuilder(ctrl)
+		mockVersions = versions.NewMockHandler(ctrl)
+		mockGenerator = NewMockInstallConfigGenerator(ctrl)
+		manifestsAPI := manifests.NewManifestsAPI(db, common.GetTestLog(), overlay, usage.NewMockAPI(ctrl))
+		handler = NewHandler(common.GetTestLog(), db, Config{Timeout: time.Minute}, overlay, manifestsAPI, mockManifestsGenerator, mockOperators,
+			mockInstallConfig, mockVersions, mockGenerator, "")
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:                   &clusterID,
+			Status:               swag.String(models.ClusterStatusInsufficient),
+			OpenshiftVersion:     "4.14",
+			CPUArchitecture:      common.X86CPUArchitecture,
+			HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+		}}
+		Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+		common.DeleteTestDB(db, dbName)
+	})
+
+	upload := func(client s3wrapper.API, objectName string, content string) {
+		ExpectWithOffset(1, client.Upload(ctx, []byte(content), objectName)).To(Succeed())
+	}
+
+	uploadCustomManifest := func(fileName string, content string) {
+		path := filepath.Join(models.ManifestFolderOpenshift, fileName)
+		upload(base, manifests.GetManifestObjectName(*cluster.ID, path), content)
+		upload(base, manifests.GetManifestMetadataObjectName(*cluster.ID, path, constants.ManifestSourceUserSupplied), "")
+	}
+
+	download := func(objectName string) string {
+		reader, _, err := base.Download(ctx, objectName)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	mockAdditionalManifests := func(operatorsErr error) {
+		mockManifestsGenerator.EXPECT().AddChronyManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockManifestsGenerator.EXPECT().AddTelemeterManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockManifestsGenerator.EXPECT().AddSchedulableMastersManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockManifestsGenerator.EXPECT().AddDiskEncryptionManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockOperators.EXPECT().GenerateManifests(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, c *common.Cluster) error {
+			if operatorsErr != nil {
+				return operatorsErr
+			}
+			return overlay.Upload(ctx, []byte("{}"), filepath.Join(c.ID.String(), "custom_manifests.json"))
+		}).Times(1)
+	}
+
+	mockReleaseImage := func() {
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), "4.14", common.X86CPUArchitecture, gomock.Any()).
+			Return(&models.ReleaseImage{URL: swag.String(releaseImage)}, nil).AnyTimes()
+	}
+
+	getReport := func() *models.DryRunGenerateReport {
+		response := handler.V2GetDryRunGenerate(ctx, operations.V2GetDryRunGenerateParams{ClusterID: *cluster.ID})
+		ExpectWithOffset(1, response).To(BeAssignableToTypeOf(operations.NewV2GetDryRunGenerateOK()))
+		return response.(*operations.V2GetDryRunGenerateOK).Payload
+	}
+
+	dryRun := func() *models.DryRunGenerateReport {
+		response := handler.V2DryRunGenerate(ctx, operations.V2DryRunGenerateParams{ClusterID: *cluster.ID})
+		ExpectWithOffset(1, response).To(BeAssignableToTypeOf(operations.NewV2DryRunGenerateAccepted()))
+		ExpectWithOffset(1, *response.(*operations.V2DryRunGenerateAccepted).Payload.Status).To(Equal(models.DryRunGenerateReportStatusRunning))
+		EventuallyWithOffset(1, func() string {
+			return swag.StringValue(getReport().Status)
+		}).Should(Equal(models.DryRunGenerateReportStatusCompleted))
+		return getReport()
+	}
+
+	It("stores the generated files apart without changing the cluster", func() {
+		uploadCustomManifest("custom.yaml", "kind: ConfigMap")
+		upload(base, filepath.Join(cluster.ID.String(), "bootstrap.ign"), "installed")
+		mockAdditionalManifests(nil)
+		mockReleaseImage()
+		mockInstallConfig.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").DoAndReturn(
+			func(c *common.Cluster, _ []*common.InfraEnv, _ string) ([]byte, error) {
+				Expect(c.Hosts).To(HaveLen(3))
+				return []byte("install-config"), nil
+			}).Times(1)
+		mockGenerator.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), []byte("install-config"), releaseImage, "").DoAndReturn(
+			func(ctx context.Context, c common.Cluster, _ []byte, _, _ string) error {
+				return overlay.Upload(ctx, []byte("dry-run"), filepath.Join(c.ID.String(), "bootstrap.ign"))
+			}).Times(1)
+
+		report := dryRun()
+		Expect(report.Succeeded).To(BeTrue())
+		Expect(report.Failures).To(BeEmpty())
+		Expect(report.SyntheticHostsCount).To(Equal(int64(3)))
+		Expect(*report.StoragePrefix).To(Equal(filepath.Join(StoragePrefix, cluster.ID.String())))
+		Expect(report.Objects).To(Equal([]string{"bootstrap.ign", "custom_manifests.json"}))
+
+		Expect(download(filepath.Join(*report.StoragePrefix, "bootstrap.ign"))).To(Equal("dry-run"))
+		Expect(download(filepath.Join(cluster.ID.String(), "bootstrap.ign"))).To(Equal("installed"))
+		stored, err := common.GetClusterFromDBWithHosts(db, *cluster.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*stored.Status).To(Equal(models.ClusterStatusInsufficient))
+		Expect(stored.Hosts).To(BeEmpty())
+	})
+
+	It("reports every failure", func() {
+		uploadCustomManifest("valid.yaml", "kind: ConfigMap")
+		uploadCustomManifest("invalid.yaml", "not: valid: yaml")
+		mockAdditionalManifests(errors.New("operator failure"))
+		mockInstallConfig.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return(nil, errors.New("install config failure")).Times(1)
+
+		report := dryRun()
+		Expect(report.Succeeded).To(BeFalse())
+		Expect(report.Failures).To(HaveLen(3))
+		Expect(*report.Failures[0].Stage).To(Equal(models.DryRunGenerateStageCustomManifests))
+		Expect(report.Failures[0].FileName).To(Equal("openshift/invalid.yaml"))
+		Expect(*report.Failures[1].Stage).To(Equal(models.DryRunGenerateStageAdditionalManifests))
+		Expect(*report.Failures[1].Message).To(ContainSubstring("operator failure"))
+		Expect(*report.Failures[2].Stage).To(Equal(models.DryRunGenerateStageInstallConfig))
+		Expect(*report.Failures[2].Message).To(ContainSubstring("install config failure"))
+	})
+
+	It("deletes the files of the previous dry run", func() {
+		upload(overlay, filepath.Join(cluster.ID.String(), "worker-previous.ign"), "previous")
+		mockAdditionalManifests(nil)
+		mockReleaseImage()
+		mockInstallConfig.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return([]byte("install-config"), nil).Times(1)
+		mockGenerator.EXPECT().GenerateInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("installer failure")).Times(1)
+
+		report := dryRun()
+		Expect(report.Objects).To(Equal([]string{"custom_manifests.json"}))
+		Expect(report.Failures).To(HaveLen(1))
+		Expect(*report.Failures[0].Stage).To(Equal(models.DryRunGenerateStageIgnition))
+	})
+
+	It("fails for unknown clusters", func() {
+		response := handler.V2DryRunGenerate(ctx, operations.V2DryRunGenerateParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		Expect(response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("rejects concurrent dry runs of the same cluster", func() {
+		_, err := handler.start(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		response := handler.V2DryRunGenerate(ctx, operations.V2DryRunGenerateParams{ClusterID: *cluster.ID})
+		Expect(response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		Expect(*getReport().Status).To(Equal(models.DryRunGenerateReportStatusRunning))
+	})
+
+	It("takes over the dry runs which timed out", func() {
+		_, err := handler.start(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&models.DryRunGenerateReport{}).Where("cluster_id = ?", cluster.ID.String()).
+			Update("started_at", time.Now().Add(-2*time.Minute)).Error).ToNot(HaveOccurred())
+		report := getReport()
+		Expect(*report.Status).To(Equal(models.DryRunGenerateReportStatusInterrupted))
+		Expect(report.StatusInfo).To(ContainSubstring("didn't complete within 1m0s"))
+
+		report, err = handler.start(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Time(report.StartedAt)).To(BeTemporally("~", time.Now(), 10*time.Second))
+		Expect(*getReport().Status).To(Equal(models.DryRunGenerateReportStatusRunning))
+	})
+
+	It("doesn't overwrite the report of a newer dry run", func() {
+		report, err := handler.start(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&models.DryRunGenerateReport{}).Where("cluster_id = ?", cluster.ID.String()).
+			Update("started_at", time.Now().Add(time.Second)).Error).ToNot(HaveOccurred())
+		mockAdditionalManifests(nil)
+		mockInstallConfig.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), "").Return(nil, errors.New("install config failure")).Times(1)
+
+		handler.run(ctx, cluster, report)
+		Expect(*getReport().Status).To(Equal(models.DryRunGenerateReportStatusRunning))
+	})
+
+	It("fails to get the report of clusters without dry run", func() {
+		response := handler.V2GetDryRunGenerate(ctx, operations.V2GetDryRunGenerateParams{ClusterID: *cluster.ID})
+		Expect(response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+})
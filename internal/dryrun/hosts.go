@@ -0,0 +1,188 @@
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultMachineNetworkCidr is used for the addresses of the synthetic hosts of clusters without machine networks
+	defaultMachineNetworkCidr = "192.168.127.0/24"
+	// firstHostAddressOffset leaves the first addresses of the machine network to the gateway and the VIPs
+	firstHostAddressOffset = 10
+
+	syntheticDiskPath = "/dev/sda"
+	syntheticDiskID   = "/dev/disk/by-id/wwn-0x5000c500d1a0d1a0"
+)
+
+// dryRunHosts returns the hosts the installation files are generated for: the hosts of the cluster which sent
+// their inventory, completed with synthetic masters when they are not enough. It also returns the number of
+// synthetic hosts.
+func dryRunHosts(cluster *common.Cluster, infraEnvID strfmt.UUID) ([]*models.Host, int, error) {
+	requiredMasters := common.MinMasterHostsNeededForInstallation
+	if common.IsSingleNodeCluster(cluster) {
+		requiredMasters = common.AllowedNumberOfMasterHostsInNoneHaMode
+	}
+
+	hosts := make([]*models.Host, 0, len(cluster.Hosts))
+	masters := 0
+	for _, clusterHost := range cluster.Hosts {
+		if clusterHost.Inventory == "" {
+			continue
+		}
+		h := *clusterHost
+		h.Role = common.GetEffectiveRole(&h)
+		if h.Role == models.HostRoleAutoAssign {
+			h.Role = models.HostRoleWorker
+			if masters < requiredMasters {
+				h.Role = models.HostRoleMaster
+			}
+		}
+		if h.Role == models.HostRoleMaster {
+			masters++
+		}
+		hosts = append(hosts, &h)
+	}
+
+	synthetic := 0
+	for ; masters < requiredMasters; masters++ {
+		h, err := syntheticHost(cluster, infraEnvID, len(hosts), models.HostRoleMaster)
+		if err != nil {
+			return nil, 0, err
+		}
+		hosts = append(hosts, h)
+		synthetic++
+	}
+
+	setBootstrap(hosts)
+	return hosts, synthetic, nil
+}
+
+// setBootstrap makes sure that exactly one master is the bootstrap one
+func setBootstrap(hosts []*models.Host) {
+	var bootstrap *models.Host
+	for _, h := range hosts {
+		if h.Role != models.HostRoleMaster {
+			h.Bootstrap = false
+			continue
+		}
+		if bootstrap == nil && h.Bootstrap {
+			bootstrap = h
+			continue
+		}
+		h.Bootstrap = false
+	}
+	if bootstrap == nil {
+		for _, h := range hosts {
+			if h.Role == models.HostRoleMaster {
+				h.Bootstrap = true
+				return
+			}
+		}
+	}
+}
+
+func syntheticHost(cluster *common.Cluster, infraEnvID strfmt.UUID, index int, role models.HostRole) (*models.Host, error) {
+	cidr := defaultMachineNetworkCidr
+	if len(cluster.MachineNetworks) > 0 && cluster.MachineNetworks[0].Cidr != "" {
+		cidr = string(cluster.MachineNetworks[0].Cidr)
+	}
+	address, err := hostAddress(cidr, firstHostAddressOffset+index)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := fmt.Sprintf("dry-run-%s-%d", role, index)
+	nic := &models.Interface{
+		Name:       "eth0",
+		MacAddress: fmt.Sprintf("02:00:00:00:%02x:%02x", (index>>8)&0xff, index&0xff),
+		SpeedMbps:  10000,
+		HasCarrier: true,
+	}
+	if network.IsIPV4CIDR(address) {
+		nic.IPV4Addresses = []string{address}
+	} else {
+		nic.IPV6Addresses = []string{address}
+	}
+	inventory := models.Inventory{
+		Hostname: hostname,
+		CPU: &models.CPU{
+			Architecture: cluster.CPUArchitecture,
+			Count:        16,
+		},
+		Memory: &models.Memory{
+			PhysicalBytes: 64 * int64(1<<30),
+			UsableBytes:   64 * int64(1<<30),
+		},
+		Disks: []*models.Disk{{
+			ID:        syntheticDiskID,
+			ByID:      syntheticDiskID,
+			Name:      "sda",
+			Path:      syntheticDiskPath,
+			DriveType: models.DriveTypeSSD,
+			SizeBytes: 240 * int64(1<<30),
+			Bootable:  true,
+			InstallationEligibility: models.DiskInstallationEligibility{
+				Eligible: true,
+			},
+		}},
+		Interfaces: []*models.Interface{nic},
+		SystemVendor: &models.SystemVendor{
+			Manufacturer: "Dry run",
+			ProductName:  "Synthetic host",
+			Virtual:      true,
+		},
+	}
+	inventoryJSON, err := json.Marshal(&inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	return &models.Host{
+		ID:                   &id,
+		ClusterID:            cluster.ID,
+		InfraEnvID:           infraEnvID,
+		Kind:                 swag.String(models.HostKindHost),
+		Status:               swag.String(models.HostStatusKnown),
+		Role:                 role,
+		RequestedHostname:    hostname,
+		Inventory:            string(inventoryJSON),
+		InstallationDiskID:   syntheticDiskID,
+		InstallationDiskPath: syntheticDiskPath,
+	}, nil
+}
+
+// hostAddress returns the address at the given offset of the network, with the network prefix length
+func hostAddress(cidr string, offset int) (string, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid machine network %s", cidr)
+	}
+	base := ipNet.IP
+	if ip.To4() != nil {
+		base = base.To4()
+	}
+	address := new(big.Int).Add(new(big.Int).SetBytes(base), big.NewInt(int64(offset)))
+	bytes := address.Bytes()
+	if len(bytes) > len(base) {
+		return "", errors.Errorf("machine network %s is too small for the synthetic hosts", cidr)
+	}
+	hostIP := make(net.IP, len(base))
+	copy(hostIP[len(base)-len(bytes):], bytes)
+	if !ipNet.Contains(hostIP) {
+		return "", errors.Errorf("machine network %s is too small for the synthetic hosts", cidr)
+	}
+	ones, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", hostIP, ones), nil
+}
@@ -0,0 +1,119 @@
+package dryrun
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Dry run hosts", func() {
+	var (
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	newCluster := func(highAvailabilityMode string, hosts ...*models.Host) *common.Cluster {
+		return &common.Cluster{Cluster: models.Cluster{
+			ID:                   &clusterID,
+			HighAvailabilityMode: swag.String(highAvailabilityMode),
+			CPUArchitecture:      common.X86CPUArchitecture,
+			MachineNetworks:      []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}},
+			Hosts:                hosts,
+		}}
+	}
+
+	newHost := func(role, suggestedRole models.HostRole) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		return &models.Host{ID: &id, Role: role, SuggestedRole: suggestedRole, Inventory: common.GenerateTestDefaultInventory()}
+	}
+
+	countRoles := func(hosts []*models.Host) (masters, workers, bootstraps int) {
+		for _, h := range hosts {
+			switch h.Role {
+			case models.HostRoleMaster:
+				masters++
+			case models.HostRoleWorker:
+				workers++
+			}
+			if h.Bootstrap {
+				bootstraps++
+			}
+		}
+		return
+	}
+
+	It("synthesizes the masters of clusters without hosts", func() {
+		hosts, synthetic, err := dryRunHosts(newCluster(models.ClusterHighAvailabilityModeFull), infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synthetic).To(Equal(3))
+		masters, workers, bootstraps := countRoles(hosts)
+		Expect(masters).To(Equal(3))
+		Expect(workers).To(BeZero())
+		Expect(bootstraps).To(Equal(1))
+
+		for i, h := range hosts {
+			Expect(*h.ClusterID).To(Equal(clusterID))
+			Expect(h.InfraEnvID).To(Equal(infraEnvID))
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(h.Inventory), &inventory)).To(Succeed())
+			Expect(inventory.Interfaces[0].IPV4Addresses).To(Equal([]string{[]string{"10.0.0.10/24", "10.0.0.11/24", "10.0.0.12/24"}[i]}))
+			Expect(inventory.Disks[0].ID).To(Equal(h.InstallationDiskID))
+		}
+	})
+
+	It("synthesizes a single master for single node clusters", func() {
+		hosts, synthetic, err := dryRunHosts(newCluster(models.ClusterHighAvailabilityModeNone), infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synthetic).To(Equal(1))
+		Expect(hosts).To(HaveLen(1))
+		Expect(hosts[0].Bootstrap).To(BeTrue())
+	})
+
+	It("completes the hosts of the cluster without changing them", func() {
+		master := newHost(models.HostRoleMaster, "")
+		worker := newHost(models.HostRoleAutoAssign, models.HostRoleWorker)
+		autoAssigned := newHost(models.HostRoleAutoAssign, "")
+		notDiscovered := &models.Host{Role: models.HostRoleMaster}
+		cluster := newCluster(models.ClusterHighAvailabilityModeFull, master, worker, autoAssigned, notDiscovered)
+
+		hosts, synthetic, err := dryRunHosts(cluster, infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synthetic).To(Equal(1))
+		Expect(hosts).To(HaveLen(4))
+		masters, workers, bootstraps := countRoles(hosts)
+		Expect(masters).To(Equal(3))
+		Expect(workers).To(Equal(1))
+		Expect(bootstraps).To(Equal(1))
+		Expect(hosts[0].Bootstrap).To(BeTrue())
+		Expect(worker.Role).To(Equal(models.HostRoleAutoAssign))
+		Expect(master.Bootstrap).To(BeFalse())
+	})
+
+	It("uses IPv6 machine networks", func() {
+		cluster := newCluster(models.ClusterHighAvailabilityModeNone)
+		cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "fd2e:6f44:5dd8::/64"}}
+		hosts, _, err := dryRunHosts(cluster, infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(hosts[0].Inventory), &inventory)).To(Succeed())
+		Expect(inventory.Interfaces[0].IPV6Addresses).To(Equal([]string{"fd2e:6f44:5dd8::a/64"}))
+	})
+
+	It("fails when the machine network is too small", func() {
+		cluster := newCluster(models.ClusterHighAvailabilityModeFull)
+		cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.0.0/29"}}
+		_, _, err := dryRunHosts(cluster, infraEnvID)
+		Expect(err).To(HaveOccurred())
+	})
+})
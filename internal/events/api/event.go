@@ -72,3 +72,9 @@ type InfoEvent interface {
 	BaseEvent
 	GetInfo() string
 }
+
+// PropertiesEvent is implemented by the events which store structured properties along with their message, so that
+// they can be read without parsing the message
+type PropertiesEvent interface {
+	GetProperties() map[string]interface{}
+}
@@ -198,7 +198,8 @@ func (e *Events) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEv
 
 func (e *Events) SendClusterEventAtTime(ctx context.Context, event eventsapi.ClusterEvent, eventTime time.Time) {
 	cID := event.GetClusterId()
-	e.V2AddEvent(ctx, &cID, nil, nil, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+	e.V2AddEvent(ctx, &cID, nil, nil, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime,
+		eventProperties(event)...)
 }
 
 func (e *Events) SendHostEvent(ctx context.Context, event eventsapi.HostEvent) {
@@ -208,7 +209,8 @@ func (e *Events) SendHostEvent(ctx context.Context, event eventsapi.HostEvent) {
 func (e *Events) SendHostEventAtTime(ctx context.Context, event eventsapi.HostEvent, eventTime time.Time) {
 	hostID := event.GetHostId()
 	infraEnvID := event.GetInfraEnvId()
-	e.V2AddEvent(ctx, event.GetClusterId(), &hostID, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+	e.V2AddEvent(ctx, event.GetClusterId(), &hostID, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime,
+		eventProperties(event)...)
 }
 
 func (e *Events) SendInfraEnvEvent(ctx context.Context, event eventsapi.InfraEnvEvent) {
@@ -217,7 +219,16 @@ func (e *Events) SendInfraEnvEvent(ctx context.Context, event eventsapi.InfraEnv
 
 func (e *Events) SendInfraEnvEventAtTime(ctx context.Context, event eventsapi.InfraEnvEvent, eventTime time.Time) {
 	infraEnvID := event.GetInfraEnvId()
-	e.V2AddEvent(ctx, event.GetClusterId(), nil, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+	e.V2AddEvent(ctx, event.GetClusterId(), nil, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime,
+		eventProperties(event)...)
+}
+
+// eventProperties returns the structured properties of the event, if it has any
+func eventProperties(event eventsapi.BaseEvent) []interface{} {
+	if withProperties, ok := event.(eventsapi.PropertiesEvent); ok {
+		return []interface{}{withProperties.GetProperties()}
+	}
+	return nil
 }
 
 func (e *Events) V2AddEvent(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, name string, severity string, msg string, eventTime time.Time, props ...interface{}) {
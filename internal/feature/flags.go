@@ -11,4 +11,8 @@ type Flags struct {
 
 	// EnableSkipMcoReboot is a boolean flag to enable MCO reboot by assisted installer
 	EnableSkipMcoReboot bool `envconfig:"ENABLE_SKIP_MCO_REBOOT" default:"true"`
+
+	// EnableMtuPathCheck is a boolean flag to enable the mtu-path-check step and the path MTU validations. It is
+	// disabled by default as the step requires an agent implementing it
+	EnableMtuPathCheck bool `envconfig:"ENABLE_MTU_PATH_CHECK" default:"false"`
 }
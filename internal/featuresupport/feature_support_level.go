@@ -2,6 +2,7 @@ package featuresupport
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
@@ -117,6 +118,17 @@ func IsFeatureAvailable(featureId models.FeatureSupportLevelID, openshiftVersion
 	return GetSupportLevel(featureId, filters) != models.SupportLevelUnavailable
 }
 
+// In case cpu architecture is not x86_64, we should extract openshift-baremetal-installer
+// from x86_64 release image as there is no x86_64 openshift-baremetal-installer executable in arm image.
+// This flow does not affect the multiarch release images and is meant purely for using arm64 release image with the x86 hub.
+// Implementation of handling the multiarch images is done directly in the `oc` binary and relies on the fact that `oc adm release extract`
+// will automatically use the image matching the Hub's architecture.
+func IsBaremetalBinaryFromAnotherReleaseImageRequired(cpuArchitecture, version string) bool {
+	return cpuArchitecture != common.MultiCPUArchitecture &&
+		cpuArchitecture != common.NormalizeCPUArchitecture(runtime.GOARCH) &&
+		IsFeatureAvailable(models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING, version, swag.String(models.ClusterCPUArchitectureArm64))
+}
+
 func isFeatureCompatible(openshiftVersion string, feature SupportLevelFeature, features ...SupportLevelFeature) *SupportLevelFeature {
 	incompatibilities := feature.getIncompatibleFeatures(openshiftVersion)
 	if incompatibilities != nil {
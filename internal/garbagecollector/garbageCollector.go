@@ -8,6 +8,7 @@ import (
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/infraenv"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
@@ -20,6 +21,7 @@ type Config struct {
 	InfraenvDeleteInactiveAfter time.Duration `envconfig:"INFRAENV_DELETED_INACTIVE_AFTER" default:"480h"` // 20d
 	MaxGCClustersPerInterval    int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
 	MaxGCInfraEnvsPerInterval   int           `envconfig:"MAX_GC_INFRAENVS_PER_INTERVAL" default:"100"`
+	LogIndexDeleteAfter         time.Duration `envconfig:"LOG_INDEX_DELETE_AFTER" default:"168h"` // 7d
 }
 
 func NewGarbageCollectors(
@@ -31,6 +33,7 @@ func NewGarbageCollectors(
 	infraEnvApi infraenv.API,
 	objectHandler s3wrapper.API,
 	leaderElector leader.Leader,
+	logSearchApi logsearch.API,
 
 ) *garbageCollector {
 	return &garbageCollector{
@@ -42,6 +45,7 @@ func NewGarbageCollectors(
 		infraEnvApi:   infraEnvApi,
 		objectHandler: objectHandler,
 		leaderElector: leaderElector,
+		logSearchApi:  logSearchApi,
 	}
 }
 
@@ -54,6 +58,7 @@ type garbageCollector struct {
 	infraEnvApi   infraenv.API
 	objectHandler s3wrapper.API
 	leaderElector leader.Leader
+	logSearchApi  logsearch.API
 }
 
 func (g garbageCollector) DeregisterInactiveClusters() {
@@ -106,3 +111,29 @@ func (g garbageCollector) DeleteOrphans() {
 		g.log.WithError(err).Errorf("Failed to delete orphan hosts")
 	}
 }
+
+func (g garbageCollector) DeleteExpiredLogIndexes() {
+	if !g.leaderElector.IsLeader() || !g.logSearchApi.Enabled() {
+		return
+	}
+	olderThan := time.Now().Add(-g.Config.LogIndexDeleteAfter)
+	g.log.Debugf("Deleting the log indexes created before %s", olderThan)
+	if err := g.logSearchApi.DeleteExpiredIndexes(context.Background(), olderThan); err != nil {
+		g.log.WithError(err).Errorf("Failed to delete expired log indexes")
+	}
+}
+
+type blobPruner interface {
+	PruneBlobs(ctx context.Context) error
+}
+
+func (g garbageCollector) PruneDeduplicatedObjects() {
+	pruner, ok := g.objectHandler.(blobPruner)
+	if !g.leaderElector.IsLeader() || !ok {
+		return
+	}
+	g.log.Debugf("Pruning the deduplicated contents without references")
+	if err := pruner.PruneBlobs(context.Background()); err != nil {
+		g.log.WithError(err).Errorf("Failed to prune deduplicated contents")
+	}
+}
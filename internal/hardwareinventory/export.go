@@ -0,0 +1,139 @@
+package hardwareinventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+var csvHeader = []string{
+	"host_id", "infra_env_id", "cluster_id", "hostname", "status", "role", "cpu_architecture", "cpu_model", "cpu_cores",
+	"memory_bytes", "disk_count", "disks_total_bytes", "largest_disk_bytes", "disk_types", "nic_count",
+	"max_nic_speed_mbps", "vendor", "product", "virtual",
+}
+
+// csvFormulaPrefixes are the first characters of the cells that the spreadsheets evaluate as formulas
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// Exporter writes the hardware of the hosts to an export a host at a time, so that the exports of whole fleets
+// aren't held in memory
+type Exporter interface {
+	Write(host *models.HostHardware) error
+	// Close completes the export, without closing the underlying writer
+	Close() error
+}
+
+type csvExporter struct {
+	writer *csv.Writer
+}
+
+// NewCSVExporter returns an exporter rendering the hardware of the hosts as CSV, with a row per host. The disk
+// types of a host are separated by semicolons.
+func NewCSVExporter(w io.Writer) (Exporter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return &csvExporter{writer: writer}, nil
+}
+
+func (e *csvExporter) Write(host *models.HostHardware) error {
+	clusterID := ""
+	if host.ClusterID != nil {
+		clusterID = host.ClusterID.String()
+	}
+	return e.writer.Write([]string{
+		host.HostID.String(),
+		host.InfraEnvID.String(),
+		clusterID,
+		csvText(host.Hostname),
+		csvText(host.Status),
+		csvText(string(host.Role)),
+		csvText(host.CPUArchitecture),
+		csvText(host.CPUModel),
+		strconv.FormatInt(host.CPUCores, 10),
+		strconv.FormatInt(host.MemoryBytes, 10),
+		strconv.FormatInt(host.DiskCount, 10),
+		strconv.FormatInt(host.DisksTotalBytes, 10),
+		strconv.FormatInt(host.LargestDiskBytes, 10),
+		csvText(strings.Join(host.DiskTypes, ";")),
+		strconv.FormatInt(host.NicCount, 10),
+		strconv.FormatInt(host.MaxNicSpeedMbps, 10),
+		csvText(host.Vendor),
+		csvText(host.Product),
+		strconv.FormatBool(host.Virtual),
+	})
+}
+
+func (e *csvExporter) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// csvText escapes the text reported by the hosts, e.g. their hostname, prefixing it with a quote when a spreadsheet
+// would evaluate it as a formula
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+type jsonExporter struct {
+	writer io.Writer
+	count  int
+}
+
+// NewJSONExporter returns an exporter rendering the hardware of the hosts as a JSON array
+func NewJSONExporter(w io.Writer) Exporter {
+	return &jsonExporter{writer: w}
+}
+
+func (e *jsonExporter) Write(host *models.HostHardware) error {
+	encoded, err := json.Marshal(host)
+	if err != nil {
+		return err
+	}
+	separator := ",\n  "
+	if e.count == 0 {
+		separator = "[\n  "
+	}
+	e.count++
+	if _, err = io.WriteString(e.writer, separator); err != nil {
+		return err
+	}
+	_, err = e.writer.Write(encoded)
+	return err
+}
+
+func (e *jsonExporter) Close() error {
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.writer, end)
+	return err
+}
+
+// RenderCSV renders the hardware of the hosts as CSV, see NewCSVExporter
+func RenderCSV(hosts []*models.HostHardware) ([]byte, error) {
+	var buffer bytes.Buffer
+	exporter, err := NewCSVExporter(&buffer)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range hosts {
+		if err = exporter.Write(host); err != nil {
+			return nil, err
+		}
+	}
+	if err = exporter.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
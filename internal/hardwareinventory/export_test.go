@@ -0,0 +1,126 @@
+package hardwareinventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("RenderCSV", func() {
+	It("renders a row per host", func() {
+		hostID := strfmt.UUID("1a2b3c4d-0000-0000-0000-000000000001")
+		infraEnvID := strfmt.UUID("1a2b3c4d-0000-0000-0000-000000000002")
+		content, err := RenderCSV([]*models.HostHardware{{
+			HostID:           &hostID,
+			InfraEnvID:       &infraEnvID,
+			Hostname:         "worker-0",
+			Status:           models.HostStatusKnownUnbound,
+			Role:             models.HostRoleAutoAssign,
+			CPUArchitecture:  "x86_64",
+			CPUModel:         "Intel Xeon, 2.1GHz",
+			CPUCores:         64,
+			MemoryBytes:      549755813888,
+			DiskCount:        2,
+			DisksTotalBytes:  5600000000000,
+			LargestDiskBytes: 4000000000000,
+			DiskTypes:        []string{common.DiskTypeNvme, common.DiskTypeSsd},
+			NicCount:         2,
+			MaxNicSpeedMbps:  25000,
+			Vendor:           "Dell Inc.",
+			Product:          "PowerEdge R750",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		rows, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows).To(Equal([][]string{csvHeader, {
+			hostID.String(), infraEnvID.String(), "", "worker-0", "known-unbound", "auto-assign", "x86_64",
+			"Intel Xeon, 2.1GHz", "64", "549755813888", "2", "5600000000000", "4000000000000", "nvme;ssd", "2", "25000",
+			"Dell Inc.", "PowerEdge R750", "false",
+		}}))
+	})
+
+	It("renders the header without hosts", func() {
+		content, err := RenderCSV(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal(strings.Join(csvHeader, ",") + "\n"))
+	})
+
+	It("escapes the text evaluated as formulas by the spreadsheets", func() {
+		hostID := strfmt.UUID("1a2b3c4d-0000-0000-0000-000000000001")
+		infraEnvID := strfmt.UUID("1a2b3c4d-0000-0000-0000-000000000002")
+		content, err := RenderCSV([]*models.HostHardware{{
+			HostID:     &hostID,
+			InfraEnvID: &infraEnvID,
+			Hostname:   "=HYPERLINK(\"http://example.com\")",
+			CPUModel:   "+1",
+			Vendor:     "-2+3",
+			Product:    "@SUM(A1:A2)",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		rows, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows[1][3]).To(Equal("'=HYPERLINK(\"http://example.com\")"))
+		Expect(rows[1][7]).To(Equal("'+1"))
+		Expect(rows[1][16]).To(Equal("'-2+3"))
+		Expect(rows[1][17]).To(Equal("'@SUM(A1:A2)"))
+	})
+})
+
+var _ = Describe("NewJSONExporter", func() {
+	render := func(hosts ...*models.HostHardware) []byte {
+		var buffer bytes.Buffer
+		exporter := NewJSONExporter(&buffer)
+		for _, host := range hosts {
+			Expect(exporter.Write(host)).To(Succeed())
+		}
+		Expect(exporter.Close()).To(Succeed())
+		return buffer.Bytes()
+	}
+
+	It("renders an array of hosts", func() {
+		content := render(&models.HostHardware{Hostname: "worker-0"}, &models.HostHardware{Hostname: "worker-1"})
+		var hosts []*models.HostHardware
+		Expect(json.Unmarshal(content, &hosts)).To(Succeed())
+		Expect(hosts).To(HaveLen(2))
+		Expect(hosts[1].Hostname).To(Equal("worker-1"))
+	})
+
+	It("renders an empty array without hosts", func() {
+		Expect(string(render())).To(Equal("[]\n"))
+	})
+})
+
+var _ = Describe("Filter", func() {
+	It("accepts consistent filters", func() {
+		filter := &Filter{
+			MinCPUCores:  swag.Int64(8),
+			MaxCPUCores:  swag.Int64(8),
+			MinMemoryMib: swag.Int64(1024),
+			Bound:        swag.Bool(true),
+			DiskTypes:    []string{common.DiskTypeNvme, common.DiskTypeHdd},
+		}
+		Expect(filter.Validate()).To(Succeed())
+	})
+
+	It("rejects inconsistent filters", func() {
+		clusterID := strfmt.UUID("1a2b3c4d-0000-0000-0000-000000000003")
+		for _, filter := range []*Filter{
+			{MinCPUCores: swag.Int64(16), MaxCPUCores: swag.Int64(8)},
+			{MinMemoryMib: swag.Int64(2048), MaxMemoryMib: swag.Int64(1024)},
+			{ClusterID: &clusterID, Bound: swag.Bool(false)},
+			{DiskTypes: []string{"tape"}},
+		} {
+			Expect(filter.Validate()).NotTo(Succeed())
+		}
+	})
+})
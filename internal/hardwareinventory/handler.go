@@ -0,0 +1,196 @@
+package hardwareinventory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/hardware_inventory"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is the number of hosts read from the database at a time by the exports
+const exportBatchSize = 500
+
+var _ restapi.HardwareInventoryAPI = (*Handler)(nil)
+
+// NewHandler returns the hardware inventory handler
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, authzHandler auth.Authorizer) *Handler {
+	return &Handler{
+		log:          log,
+		db:           db,
+		authzHandler: authzHandler,
+	}
+}
+
+// Handler queries the hardware of the hosts of the tenant
+type Handler struct {
+	log          logrus.FieldLogger
+	db           *gorm.DB
+	authzHandler auth.Authorizer
+}
+
+func (h *Handler) V2ListHostHardware(ctx context.Context, params operations.V2ListHostHardwareParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	filter := &Filter{
+		InfraEnvID:      params.InfraEnvID,
+		ClusterID:       params.ClusterID,
+		Bound:           params.Bound,
+		Status:          params.Status,
+		CPUArchitecture: params.CPUArchitecture,
+		MinCPUCores:     params.MinCPUCores,
+		MaxCPUCores:     params.MaxCPUCores,
+		MinMemoryMib:    params.MinMemoryMib,
+		MaxMemoryMib:    params.MaxMemoryMib,
+		DiskTypes:       params.DiskTypes,
+		MinDiskCount:    params.MinDiskCount,
+		MinDiskSizeGb:   params.MinDiskSizeGb,
+		MinNicCount:     params.MinNicCount,
+		MinNicSpeedMbps: params.MinNicSpeedMbps,
+		Vendor:          params.Vendor,
+		Product:         params.Product,
+		Virtual:         params.Virtual,
+	}
+	if err := filter.Validate(); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var total int64
+	if err := h.query(ctx, filter).Count(&total).Error; err != nil {
+		log.WithError(err).Error("failed to count the hosts matching the hardware query")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	var hosts []*common.Host
+	err := h.query(ctx, filter).Select(hostColumns()).Order("created_at, id").
+		Limit(int(swag.Int64Value(params.Limit))).Offset(int(swag.Int64Value(params.Offset))).Find(&hosts).Error
+	if err != nil {
+		log.WithError(err).Error("failed to query the hardware of the hosts")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	payload := &models.HostHardwareList{Total: swag.Int64(total), Hosts: make([]*models.HostHardware, 0, len(hosts))}
+	for _, host := range hosts {
+		payload.Hosts = append(payload.Hosts, hostHardware(host))
+	}
+	return operations.NewV2ListHostHardwareOK().WithPayload(payload)
+}
+
+func (h *Handler) V2ExportHostHardware(ctx context.Context, params operations.V2ExportHostHardwareParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	filter := &Filter{
+		InfraEnvID:      params.InfraEnvID,
+		ClusterID:       params.ClusterID,
+		Bound:           params.Bound,
+		Status:          params.Status,
+		CPUArchitecture: params.CPUArchitecture,
+		MinCPUCores:     params.MinCPUCores,
+		MaxCPUCores:     params.MaxCPUCores,
+		MinMemoryMib:    params.MinMemoryMib,
+		MaxMemoryMib:    params.MaxMemoryMib,
+		DiskTypes:       params.DiskTypes,
+		MinDiskCount:    params.MinDiskCount,
+		MinDiskSizeGb:   params.MinDiskSizeGb,
+		MinNicCount:     params.MinNicCount,
+		MinNicSpeedMbps: params.MinNicSpeedMbps,
+		Vendor:          params.Vendor,
+		Product:         params.Product,
+		Virtual:         params.Virtual,
+	}
+	if err := filter.Validate(); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	// The first batch is read before the response is sent, so that the failures of the query are reported
+	hosts, err := h.exportBatch(ctx, filter, nil)
+	if err != nil {
+		log.WithError(err).Error("failed to query the hardware of the hosts")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	format := swag.StringValue(params.Format)
+	if format != "json" {
+		format = "csv"
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		err := h.export(ctx, filter, format, hosts, writer)
+		if err != nil {
+			log.WithError(err).Error("failed to export the hardware of the hosts")
+		}
+		writer.CloseWithError(err)
+	}()
+	return filemiddleware.NewResponder(
+		operations.NewV2ExportHostHardwareOK().WithPayload(reader),
+		fmt.Sprintf("hardware-inventory-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format),
+		0,
+		nil,
+	)
+}
+
+// export writes the hardware of the hosts matching the filter, starting with the first batch of hosts that was
+// already read. The hosts are read by batches, so that the exports of whole fleets aren't held in memory.
+func (h *Handler) export(ctx context.Context, filter *Filter, format string, hosts []*common.Host, w io.Writer) error {
+	var exporter Exporter
+	if format == "json" {
+		exporter = NewJSONExporter(w)
+	} else {
+		var err error
+		if exporter, err = NewCSVExporter(w); err != nil {
+			return err
+		}
+	}
+	for len(hosts) > 0 {
+		for _, host := range hosts {
+			if err := exporter.Write(hostHardware(host)); err != nil {
+				return err
+			}
+		}
+		if len(hosts) < exportBatchSize {
+			break
+		}
+		var err error
+		if hosts, err = h.exportBatch(ctx, filter, hosts[len(hosts)-1]); err != nil {
+			return err
+		}
+	}
+	return exporter.Close()
+}
+
+// exportBatch reads the batch of hosts following the last host of the previous batch, if any
+func (h *Handler) exportBatch(ctx context.Context, filter *Filter, last *common.Host) ([]*common.Host, error) {
+	query := h.query(ctx, filter).Select(hostColumns()).Order("created_at, id").Limit(exportBatchSize)
+	if last != nil {
+		query = query.Where("(created_at, id) > (?, ?)", last.CreatedAt, last.ID.String())
+	}
+	var hosts []*common.Host
+	if err := query.Find(&hosts).Error; err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// query returns the query of the hosts of the infra-envs of the tenant matching the filter
+func (h *Handler) query(ctx context.Context, filter *Filter) *gorm.DB {
+	infraEnvs := h.authzHandler.OwnedBy(ctx, h.db.Model(&common.InfraEnv{})).Select("id")
+	return filter.Apply(h.db.Model(&common.Host{}).Where("infra_env_id IN (?)", infraEnvs))
+}
+
+// hostColumns are the columns of the hosts reported by the queries, which don't include the inventory
+func hostColumns() []string {
+	columns := []string{"id", "infra_env_id", "cluster_id", "requested_hostname", "status", "role", "created_at"}
+	for column := range (&common.HostHardware{}).Columns() {
+		columns = append(columns, column)
+	}
+	return columns
+}
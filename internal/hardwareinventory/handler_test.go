@@ -0,0 +1,205 @@
+package hardwareinventory
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/hardware_inventory"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+var _ = Describe("Hardware inventory handler", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		handler    *Handler
+		ctx        context.Context
+		infraEnvID strfmt.UUID
+		clusterID  strfmt.UUID
+		bigHostID  strfmt.UUID
+		boundID    strfmt.UUID
+		smallID    strfmt.UUID
+	)
+
+	createInfraEnv := func(orgID string) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &id, OrgID: orgID, UserName: "jdoe"}}).Error).ToNot(HaveOccurred())
+		return id
+	}
+
+	createHost := func(infraEnvID strfmt.UUID, clusterID *strfmt.UUID, status string, inventory *models.Inventory) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		host := &common.Host{
+			Host: models.Host{
+				ID:         &id,
+				InfraEnvID: infraEnvID,
+				ClusterID:  clusterID,
+				Status:     swag.String(status),
+				Role:       models.HostRoleAutoAssign,
+			},
+			Hardware: *common.NewHostHardware(inventory),
+		}
+		Expect(db.Create(host).Error).ToNot(HaveOccurred())
+		return id
+	}
+
+	inventory := func(memoryGib int64, disks ...*models.Disk) *models.Inventory {
+		return &models.Inventory{
+			Hostname:     "host",
+			CPU:          &models.CPU{Architecture: "x86_64", Count: 32},
+			Memory:       &models.Memory{PhysicalBytes: conversions.GibToBytes(memoryGib)},
+			Disks:        disks,
+			Interfaces:   []*models.Interface{{Name: "eno1", Type: "physical", SpeedMbps: 25000}},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc.", ProductName: "PowerEdge R750"},
+		}
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		handler = NewHandler(common.GetTestLog(), db, auth.NewAuthzHandler(cfg, nil, logrus.New(), db))
+		ctx = userContext("jdoe", "org1")
+
+		infraEnvID = createInfraEnv("org1")
+		clusterID = strfmt.UUID(uuid.New().String())
+		nvme := &models.Disk{Name: "nvme0n1", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GbToBytes(1600)}
+		hdd := &models.Disk{Name: "sda", DriveType: models.DriveTypeHDD, SizeBytes: conversions.GbToBytes(4000)}
+		bigHostID = createHost(infraEnvID, nil, models.HostStatusKnownUnbound, inventory(768, nvme, hdd))
+		boundID = createHost(infraEnvID, &clusterID, models.HostStatusKnown, inventory(768, nvme))
+		smallID = createHost(infraEnvID, nil, models.HostStatusKnownUnbound, inventory(64, hdd))
+		createHost(createInfraEnv("org2"), nil, models.HostStatusKnownUnbound, inventory(768, nvme))
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	list := func(params operations.V2ListHostHardwareParams) *models.HostHardwareList {
+		if params.Limit == nil {
+			params.Limit = swag.Int64(100)
+		}
+		response := handler.V2ListHostHardware(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2ListHostHardwareOK()))
+		return response.(*operations.V2ListHostHardwareOK).Payload
+	}
+
+	hostIDs := func(result *models.HostHardwareList) []strfmt.UUID {
+		var ids []strfmt.UUID
+		for _, host := range result.Hosts {
+			ids = append(ids, *host.HostID)
+		}
+		return ids
+	}
+
+	It("lists the hosts of the tenant", func() {
+		result := list(operations.V2ListHostHardwareParams{})
+		Expect(*result.Total).To(BeEquivalentTo(3))
+		Expect(hostIDs(result)).To(ConsistOf(bigHostID, boundID, smallID))
+	})
+
+	It("returns the hardware of the hosts", func() {
+		result := list(operations.V2ListHostHardwareParams{ClusterID: &clusterID})
+		Expect(result.Hosts).To(HaveLen(1))
+		host := result.Hosts[0]
+		Expect(host.Hostname).To(Equal("host"))
+		Expect(*host.ClusterID).To(Equal(clusterID))
+		Expect(host.Status).To(Equal(models.HostStatusKnown))
+		Expect(host.MemoryBytes).To(Equal(conversions.GibToBytes(768)))
+		Expect(host.DiskTypes).To(Equal([]string{common.DiskTypeNvme, common.DiskTypeSsd}))
+		Expect(host.MaxNicSpeedMbps).To(BeEquivalentTo(25000))
+		Expect(host.Vendor).To(Equal("Dell Inc."))
+	})
+
+	It("queries the unbound hosts by memory and disk type", func() {
+		result := list(operations.V2ListHostHardwareParams{
+			Bound:        swag.Bool(false),
+			MinMemoryMib: swag.Int64(512 * 1024),
+			DiskTypes:    []string{common.DiskTypeNvme},
+		})
+		Expect(hostIDs(result)).To(Equal([]strfmt.UUID{bigHostID}))
+	})
+
+	It("queries the hosts by state, disk size and vendor", func() {
+		result := list(operations.V2ListHostHardwareParams{
+			Status:        []string{models.HostStatusKnownUnbound},
+			MinDiskSizeGb: swag.Int64(2000),
+			Vendor:        swag.String("Dell Inc."),
+		})
+		Expect(hostIDs(result)).To(ConsistOf(bigHostID, smallID))
+
+		result = list(operations.V2ListHostHardwareParams{Vendor: swag.String("HPE")})
+		Expect(result.Hosts).To(BeEmpty())
+	})
+
+	It("paginates the hosts", func() {
+		result := list(operations.V2ListHostHardwareParams{Limit: swag.Int64(2), Offset: swag.Int64(2)})
+		Expect(*result.Total).To(BeEquivalentTo(3))
+		Expect(result.Hosts).To(HaveLen(1))
+	})
+
+	It("rejects inconsistent filters", func() {
+		response := handler.V2ListHostHardware(ctx, operations.V2ListHostHardwareParams{
+			MinMemoryMib: swag.Int64(2048),
+			MaxMemoryMib: swag.Int64(1024),
+		})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("exports the hosts as CSV", func() {
+		response := handler.V2ExportHostHardware(ctx, operations.V2ExportHostHardwareParams{
+			Bound:  swag.Bool(false),
+			Format: swag.String("csv"),
+		})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Disposition")).To(ContainSubstring(".csv"))
+
+		rows, err := csv.NewReader(recorder.Body).ReadAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rows).To(HaveLen(3))
+		Expect(rows[0]).To(Equal(csvHeader))
+	})
+
+	It("exports the hosts as JSON", func() {
+		response := handler.V2ExportHostHardware(ctx, operations.V2ExportHostHardwareParams{
+			DiskTypes: []string{common.DiskTypeHdd},
+			Format:    swag.String("json"),
+		})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var hosts []*models.HostHardware
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &hosts)).To(Succeed())
+		Expect(hosts).To(HaveLen(2))
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,148 @@
+package hardwareinventory
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// diskTypeColumns are the columns of the hosts telling whether they have disks of each type
+var diskTypeColumns = map[string]string{
+	common.DiskTypeNvme: "hardware_has_nvme",
+	common.DiskTypeSsd:  "hardware_has_ssd",
+	common.DiskTypeHdd:  "hardware_has_hdd",
+}
+
+// Filter selects the hosts by their state and by their hardware. The hardware filters only use the indexed
+// hardware columns of the hosts, never their inventories.
+type Filter struct {
+	InfraEnvID      *strfmt.UUID
+	ClusterID       *strfmt.UUID
+	Bound           *bool
+	Status          []string
+	CPUArchitecture *string
+	MinCPUCores     *int64
+	MaxCPUCores     *int64
+	MinMemoryMib    *int64
+	MaxMemoryMib    *int64
+	DiskTypes       []string
+	MinDiskCount    *int64
+	MinDiskSizeGb   *int64
+	MinNicCount     *int64
+	MinNicSpeedMbps *int64
+	Vendor          *string
+	Product         *string
+	Virtual         *bool
+}
+
+// Validate checks the ranges and the disk types of the filter
+func (f *Filter) Validate() error {
+	if f.MinCPUCores != nil && f.MaxCPUCores != nil && *f.MinCPUCores > *f.MaxCPUCores {
+		return errors.Errorf("min_cpu_cores %d is greater than max_cpu_cores %d", *f.MinCPUCores, *f.MaxCPUCores)
+	}
+	if f.MinMemoryMib != nil && f.MaxMemoryMib != nil && *f.MinMemoryMib > *f.MaxMemoryMib {
+		return errors.Errorf("min_memory_mib %d is greater than max_memory_mib %d", *f.MinMemoryMib, *f.MaxMemoryMib)
+	}
+	if f.ClusterID != nil && f.Bound != nil && !*f.Bound {
+		return errors.New("the hosts of a cluster are bound, cluster_id can't be combined with bound=false")
+	}
+	for _, diskType := range f.DiskTypes {
+		if _, ok := diskTypeColumns[diskType]; !ok {
+			return errors.Errorf("unsupported disk type %s", diskType)
+		}
+	}
+	return nil
+}
+
+// Apply limits the query of the hosts to the hosts matching the filter
+func (f *Filter) Apply(db *gorm.DB) *gorm.DB {
+	if f.InfraEnvID != nil {
+		db = db.Where("infra_env_id = ?", f.InfraEnvID.String())
+	}
+	if f.ClusterID != nil {
+		db = db.Where("cluster_id = ?", f.ClusterID.String())
+	}
+	if f.Bound != nil {
+		if *f.Bound {
+			db = db.Where("cluster_id IS NOT NULL")
+		} else {
+			db = db.Where("cluster_id IS NULL")
+		}
+	}
+	if len(f.Status) > 0 {
+		db = db.Where("status IN (?)", f.Status)
+	}
+	if f.CPUArchitecture != nil {
+		db = db.Where("hardware_cpu_architecture = ?", *f.CPUArchitecture)
+	}
+	if f.MinCPUCores != nil {
+		db = db.Where("hardware_cpu_cores >= ?", *f.MinCPUCores)
+	}
+	if f.MaxCPUCores != nil {
+		db = db.Where("hardware_cpu_cores <= ?", *f.MaxCPUCores)
+	}
+	if f.MinMemoryMib != nil {
+		db = db.Where("hardware_memory_bytes >= ?", conversions.MibToBytes(*f.MinMemoryMib))
+	}
+	if f.MaxMemoryMib != nil {
+		db = db.Where("hardware_memory_bytes <= ?", conversions.MibToBytes(*f.MaxMemoryMib))
+	}
+	for _, diskType := range f.DiskTypes {
+		db = db.Where(diskTypeColumns[diskType]+" = ?", true)
+	}
+	if f.MinDiskCount != nil {
+		db = db.Where("hardware_disk_count >= ?", *f.MinDiskCount)
+	}
+	if f.MinDiskSizeGb != nil {
+		db = db.Where("hardware_largest_disk_bytes >= ?", conversions.GbToBytes(*f.MinDiskSizeGb))
+	}
+	if f.MinNicCount != nil {
+		db = db.Where("hardware_nic_count >= ?", *f.MinNicCount)
+	}
+	if f.MinNicSpeedMbps != nil {
+		db = db.Where("hardware_max_nic_speed_mbps >= ?", *f.MinNicSpeedMbps)
+	}
+	if f.Vendor != nil {
+		db = db.Where("hardware_vendor = ?", *f.Vendor)
+	}
+	if f.Product != nil {
+		db = db.Where("hardware_product = ?", *f.Product)
+	}
+	if f.Virtual != nil {
+		db = db.Where("hardware_virtual = ?", *f.Virtual)
+	}
+	return db
+}
+
+// hostHardware returns the hardware of the host as returned by the API
+func hostHardware(host *common.Host) *models.HostHardware {
+	hostname := host.RequestedHostname
+	if hostname == "" {
+		hostname = host.Hardware.Hostname
+	}
+	return &models.HostHardware{
+		HostID:           host.ID,
+		InfraEnvID:       common.StrFmtUUIDPtr(host.InfraEnvID),
+		ClusterID:        host.ClusterID,
+		Hostname:         hostname,
+		Status:           swag.StringValue(host.Status),
+		Role:             host.Role,
+		CPUArchitecture:  host.Hardware.CPUArchitecture,
+		CPUModel:         host.Hardware.CPUModel,
+		CPUCores:         host.Hardware.CPUCores,
+		MemoryBytes:      host.Hardware.MemoryBytes,
+		DiskCount:        host.Hardware.DiskCount,
+		DisksTotalBytes:  host.Hardware.DisksTotalBytes,
+		LargestDiskBytes: host.Hardware.LargestDiskBytes,
+		DiskTypes:        host.Hardware.DiskTypes(),
+		NicCount:         host.Hardware.NicCount,
+		MaxNicSpeedMbps:  host.Hardware.MaxNicSpeedMbps,
+		Vendor:           host.Hardware.Vendor,
+		Product:          host.Hardware.Product,
+		Virtual:          host.Hardware.Virtual,
+	}
+}
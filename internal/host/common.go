@@ -49,6 +49,10 @@ const (
 	statusInfoUnbinding                                            = "Host is waiting to be unbound from the cluster"
 	statusInfoRebootingDay2                                        = "Host has rebooted and no further updates will be posted. Please check console for progress and to possibly approve pending CSRs"
 	statusInfoRebootingForReclaim                                  = "Host is rebooting into the discovery image"
+	statusInfoStageTimeoutRemediationFail                          = "Host failed to install because its installation stage $STAGE took longer than the timeout of its policy $MAX_TIME"
+	statusInfoStageTimeoutRemediationRetry                         = "Host installation stage $STAGE took longer than the timeout of its policy $MAX_TIME, sending the installation to the host again"
+	statusInfoStageTimeoutRemediationReboot                        = "Host installation stage $STAGE took longer than the timeout of its policy $MAX_TIME, rebooting the host"
+	statusInfoStageTimeoutRemediationPause                         = "Host installation stage $STAGE took longer than the timeout of its policy $MAX_TIME. The installation will resume when the host reports progress"
 )
 
 var BootstrapStages = [...]models.HostStage{
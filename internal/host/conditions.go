@@ -1,6 +1,8 @@
 package host
 
 import (
+	"time"
+
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
@@ -26,6 +28,14 @@ const (
 	HostStageTimedOut                    = conditionId("host-stage-timed-out")
 	SoftTimeoutsEnabled                  = conditionId("soft-timeouts-enabled")
 	ConnectionTimedOut                   = conditionId("connection-timed-out")
+	AllOperatorsRequirementsSatisfied    = conditionId("all-operators-requirements-satisfied")
+	AllCustomValidationsSucceeded        = conditionId("all-custom-validations-succeeded")
+	HostStageTimeoutPolicyDefined        = conditionId("host-stage-timeout-policy-defined")
+	HostStageTimeoutPolicyExpired        = conditionId("host-stage-timeout-policy-expired")
+	HostStageRemediationFail             = conditionId("host-stage-remediation-fail")
+	HostStageRemediationRetry            = conditionId("host-stage-remediation-retry")
+	HostStageRemediationReboot           = conditionId("host-stage-remediation-reboot")
+	HostStageRemediationPause            = conditionId("host-stage-remediation-pause")
 )
 
 func (c conditionId) String() string {
@@ -107,3 +117,60 @@ func (v *validator) softTimeoutsEnabled(c *validationContext) bool {
 func (v *validator) connectionTimedOut(c *validationContext) bool {
 	return c.host.ConnectionTimedOut
 }
+
+// hostStageTimeoutPolicy returns the timeout policy of the cluster for the current stage of the host, nil when there
+// is none
+func (v *validator) hostStageTimeoutPolicy(c *validationContext) *models.HostStageTimeoutPolicy {
+	if c.infraEnv != nil || c.cluster == nil || c.cluster.HostStageTimeoutPolicies == "" || c.host.Progress == nil {
+		return nil
+	}
+	policies, err := common.UnmarshalHostStageTimeoutPolicies(c.cluster.HostStageTimeoutPolicies)
+	if err != nil {
+		v.log.WithError(err).Warnf("failed to unmarshal the host stage timeout policies of cluster %s", c.cluster.ID.String())
+		return nil
+	}
+	return hostutil.GetHostStageTimeoutPolicy(policies, c.host.Progress.CurrentStage)
+}
+
+func (v *validator) isHostStageTimeoutPolicyDefined(c *validationContext) bool {
+	return v.hostStageTimeoutPolicy(c) != nil
+}
+
+func (v *validator) isHostStageTimeoutPolicyExpired(c *validationContext) bool {
+	policy := v.hostStageTimeoutPolicy(c)
+	if policy == nil {
+		return false
+	}
+	timeout := hostutil.GetHostStageTimeout(policy, v.hostStageTimeout(c.host.Progress.CurrentStage))
+	return time.Since(time.Time(c.host.Progress.StageUpdatedAt)) > timeout
+}
+
+// isHostStageRemediation returns a condition which is true when the timeout policy of the current stage expired and
+// the given remediation applies to the host. The hosts can only be rebooted via the BMC of their BareMetalHost.
+func (v *validator) isHostStageRemediation(remediation models.HostStageRemediation) func(c *validationContext) bool {
+	return func(c *validationContext) bool {
+		if !v.isHostStageTimeoutPolicyExpired(c) {
+			return false
+		}
+		return hostutil.GetHostStageRemediation(v.hostStageTimeoutPolicy(c), c.host.Progress.StageRemediationAttempts,
+			v.hasBareMetalHost(c)) == remediation
+	}
+}
+
+// hasBareMetalHost returns whether the agent of the host is provisioned by a BareMetalHost, as recorded by the agent
+// controller
+func (v *validator) hasBareMetalHost(c *validationContext) bool {
+	if !c.kubeApiEnabled {
+		return false
+	}
+	if c.bareMetalHost == nil {
+		var host common.Host
+		if err := c.db.Select("bare_metal_host").Take(&host, "id = ? and infra_env_id = ?", c.host.ID.String(),
+			c.host.InfraEnvID.String()).Error; err != nil {
+			v.log.WithError(err).Warnf("failed to get the BareMetalHost of host %s", c.host.ID.String())
+			return false
+		}
+		c.bareMetalHost = &host.BareMetalHost
+	}
+	return *c.bareMetalHost
+}
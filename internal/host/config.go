@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/shard"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +26,10 @@ type Config struct {
 	MaxHostDisconnectionTime time.Duration           `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
 	EnableVirtualInterfaces  bool                    `envconfig:"ENABLE_VIRTUAL_INTERFACES" default:"false"`
 
+	// MonitorSharder partitions the clusters and infra-envs whose hosts are monitored between the replicas. When
+	// not set, the leader monitors all the hosts.
+	MonitorSharder shard.Sharder `ignored:"true"`
+
 	// hostStageTimeouts contains the values of the host stage timeouts. Don't use this
 	// directly, use the HostStageTimeout method instead.
 	hostStageTimeouts map[models.HostStage]time.Duration `ignored:"true"`
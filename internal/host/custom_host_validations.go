@@ -0,0 +1,77 @@
+package host
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// customValidationsCategory is the category of the validations defined by the user on the cluster or the infra-env
+const customValidationsCategory = "custom"
+
+// getCustomHostValidations returns the custom validations of the infra-env and of the cluster of the host. A validation
+// of the cluster replaces the validation of the infra-env with the same ID.
+func (r *refreshPreprocessor) getCustomHostValidations(c *validationContext) ([]*models.CustomHostValidation, error) {
+	var infraEnvValidations string
+	if c.infraEnv != nil {
+		infraEnvValidations = c.infraEnv.CustomHostValidations
+	} else if c.db != nil {
+		var infraEnv common.InfraEnv
+		if err := c.db.Select("id", "custom_host_validations").Where("id = ?", c.host.InfraEnvID.String()).
+			Limit(1).Find(&infraEnv).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to get the custom host validations of infra-env %s", c.host.InfraEnvID)
+		}
+		infraEnvValidations = infraEnv.CustomHostValidations
+	}
+	validations, err := common.UnmarshalCustomHostValidations(infraEnvValidations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to deserialize the custom host validations of infra-env %s", c.host.InfraEnvID)
+	}
+	if c.cluster == nil {
+		return validations, nil
+	}
+
+	clusterValidations, err := common.UnmarshalCustomHostValidations(c.cluster.CustomHostValidations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to deserialize the custom host validations of cluster %s", c.cluster.ID)
+	}
+	ret := make([]*models.CustomHostValidation, 0, len(validations)+len(clusterValidations))
+	for _, validation := range validations {
+		if !hasCustomHostValidation(clusterValidations, swag.StringValue(validation.ID)) {
+			ret = append(ret, validation)
+		}
+	}
+	return append(ret, clusterValidations...), nil
+}
+
+func hasCustomHostValidation(validations []*models.CustomHostValidation, id string) bool {
+	for _, validation := range validations {
+		if swag.StringValue(validation.ID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *refreshPreprocessor) validateCustomHostValidation(c *validationContext, validation *models.CustomHostValidation) (ValidationStatus, string) {
+	id := swag.StringValue(validation.ID)
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	passed, err := hostutil.EvaluateCustomHostValidation(validation, c.inventory, common.GetEffectiveRole(c.host))
+	if err != nil {
+		r.log.WithError(err).Warnf("failed to evaluate custom validation %s of host %s", id, c.host.ID)
+		return ValidationError, fmt.Sprintf("Failed to evaluate custom validation %s: %s", id, err.Error())
+	}
+	if passed {
+		return ValidationSuccess, fmt.Sprintf("Custom validation %s passed", id)
+	}
+	if validation.Description != "" {
+		return ValidationFailure, fmt.Sprintf("Custom validation %s failed: %s", id, validation.Description)
+	}
+	return ValidationFailure, fmt.Sprintf("Custom validation %s failed", id)
+}
@@ -79,6 +79,7 @@ type API interface {
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateMtuPathReport(ctx context.Context, h *models.Host, mtuPathReport string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -166,7 +167,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, notificationStream stream.N
 		hwValidator:         hwValidator,
 		eventsHandler:       eventsHandler,
 		sm:                  sm,
-		rp:                  newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry, versionHandler),
+		rp:                  newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry, versionHandler, config.HostStageTimeout),
 		metricApi:           metricApi,
 		Config:              *config,
 		leaderElector:       leaderElector,
@@ -417,6 +418,9 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		"installation_disk_id":   installationDiskID,
 		"disks_to_be_formatted":  disksToBeFormatted,
 	}
+	for column, value := range common.NewHostHardware(inventory).Columns() {
+		updates[column] = value
+	}
 	return m.updateHostAndNotify(ctx, db, h, updates).Error
 }
 
@@ -432,6 +436,11 @@ func (m *Manager) refreshRoleInternal(ctx context.Context, h *models.Host, db *g
 	//update suggested role, if not yet set
 	var suggestedRole models.HostRole
 	var err error
+	//the host role rules of the cluster take precedence over the
+	//role selected from the hardware of the host
+	if applied, ruleErr := m.applyHostRoleRules(ctx, h, db); ruleErr != nil || applied {
+		return ruleErr
+	}
 	if m.Config.EnableAutoAssign || forceRefresh {
 		//because of possible hw changes, or new host being registered
 		//suggested role should be calculated periodically even if the
@@ -604,6 +613,13 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 	}
 
 	var extra []interface{}
+	if previousProgress != nil && previousProgress.StageRemediation == models.HostStageRemediationRetry &&
+		funk.Contains(hostutil.RetriableHostStages, progress.CurrentStage) {
+		// The installer runs again after the retry remediation, whose attempts are kept until the host reboots
+		extra = append(extra, "progress_stage_remediation", previousProgress.StageRemediation,
+			"progress_stage_remediation_attempts", previousProgress.StageRemediationAttempts,
+			"progress_stage_remediation_at", previousProgress.StageRemediationAt)
+	}
 	if progress.CurrentStage != models.HostStageFailed {
 		isSno := hostutil.IsSingleNode(m.log, m.db, h)
 
@@ -671,12 +687,14 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 func (m *Manager) UpdateHostProgress(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, infraEnvId strfmt.UUID, hostId strfmt.UUID,
 	srcStatus string, srcStage models.HostStage, newStage models.HostStage, progressInfo string, extra ...interface{}) error {
 
-	extra = append(append(make([]interface{}, 0), "progress_current_stage", newStage, "progress_progress_info", progressInfo,
-		"progress_stage_updated_at", strfmt.DateTime(time.Now())), extra...)
+	updates := []interface{}{"progress_current_stage", newStage, "progress_progress_info", progressInfo,
+		"progress_stage_updated_at", strfmt.DateTime(time.Now())}
 
 	if newStage != srcStage {
-		extra = append(extra, "progress_stage_started_at", strfmt.DateTime(time.Now()), "progress_stage_timed_out", false)
+		updates = append(updates, "progress_stage_started_at", strfmt.DateTime(time.Now()), "progress_stage_timed_out", false,
+			"progress_stage_remediation", "", "progress_stage_remediation_attempts", 0, "progress_stage_remediation_at", strfmt.DateTime(time.Time{}))
 	}
+	extra = append(updates, extra...)
 
 	var host *common.Host
 	var err error
@@ -756,6 +774,17 @@ func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Ho
 	return nil
 }
 
+func (m *Manager) UpdateMtuPathReport(ctx context.Context, h *models.Host, mtuPathReport string) error {
+	if h.MtuPathReport != mtuPathReport {
+		updates := map[string]interface{}{"mtu_path_report": mtuPathReport}
+
+		if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
+			return errors.Wrapf(err, "failed to set mtu_path_report to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error {
 	cdb := m.db
 	if db != nil {
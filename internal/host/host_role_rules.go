@@ -0,0 +1,189 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// applyHostRoleRules applies the first host role rule of the cluster that matches the host. It returns whether a rule
+// matched, in which case the role of the host must not be selected from its hardware.
+func (m *Manager) applyHostRoleRules(ctx context.Context, h *models.Host, db *gorm.DB) (bool, error) {
+	log := logutil.FromContext(ctx, m.log)
+	if h.ClusterID == nil || hostutil.IsDay2Host(h) || !funk.ContainsString(hostStatusesBeforeInstallation[:], swag.StringValue(h.Status)) {
+		return false, nil
+	}
+
+	var cluster common.Cluster
+	if err := db.Select("id", "host_role_rules", "high_availability_mode").Where("id = ?", h.ClusterID.String()).Limit(1).Find(&cluster).Error; err != nil {
+		return false, err
+	}
+	rules, err := common.UnmarshalHostRoleRules(cluster.HostRoleRules)
+	if err != nil {
+		return false, err
+	}
+	var (
+		rule          *models.HostRoleRule
+		eligibleDisks []*models.Disk
+	)
+	if h.Role == models.HostRoleAutoAssign && h.Inventory != "" && len(rules) > 0 {
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			return false, err
+		}
+		eligibleDisks = m.hwValidator.ListEligibleDisks(inventory)
+		if rule, err = m.matchHostRoleRule(ctx, &cluster, rules, h, inventory, eligibleDisks, db); err != nil {
+			return false, err
+		}
+	}
+	if rule == nil {
+		// the labels set by a rule that doesn't match anymore are removed
+		return false, m.updateRuleNodeLabels(ctx, h, nil, db)
+	}
+
+	role := models.HostRole(swag.StringValue(rule.Role))
+	if h.SuggestedRole != role {
+		if err = updateRole(m.log, h, h.Role, role, db, string(h.Role)); err != nil {
+			return true, err
+		}
+		h.SuggestedRole = role
+		log.Infof("host role rule %s suggests role %s for host %s", rule.Name, role, h.ID)
+		eventgen.SendHostRoleUpdatedEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, hostutil.GetHostnameForMsg(h), string(role))
+	}
+
+	if disk := hostutil.GetHostRoleRuleInstallationDisk(rule, eligibleDisks); disk != nil && common.GetDeviceIdentifier(disk) != h.InstallationDiskID {
+		h.InstallationDiskPath = common.GetDeviceFullName(disk)
+		h.InstallationDiskID = common.GetDeviceIdentifier(disk)
+		updates := map[string]interface{}{
+			"installation_disk_path":    h.InstallationDiskPath,
+			"installation_disk_id":      h.InstallationDiskID,
+			"trigger_monitor_timestamp": time.Now(),
+		}
+		if err = m.updateHostAndNotify(ctx, db, h, updates).Error; err != nil {
+			return true, err
+		}
+		log.Infof("host role rule %s selects installation disk %s for host %s", rule.Name, h.InstallationDiskPath, h.ID)
+	}
+	return true, m.updateRuleNodeLabels(ctx, h, rule.NodeLabels, db)
+}
+
+// matchHostRoleRule returns the first rule matching the host, skipping the rules assigning the master role once the
+// other hosts of the cluster complete its control plane
+func (m *Manager) matchHostRoleRule(ctx context.Context, cluster *common.Cluster, rules []*models.HostRoleRule, h *models.Host,
+	inventory *models.Inventory, eligibleDisks []*models.Disk, db *gorm.DB) (*models.HostRoleRule, error) {
+	rule := hostutil.MatchHostRoleRule(rules, h, inventory, eligibleDisks)
+	if rule == nil || swag.StringValue(rule.Role) != models.HostRoleRuleRoleMaster {
+		return rule, nil
+	}
+
+	// same count as the role selected from the hardware, see selectRole
+	var masters []string
+	if err := db.Model(&models.Host{}).Where("cluster_id = ? and id != ? and (role = ? or suggested_role = ?)",
+		h.ClusterID, h.ID, models.HostRoleMaster, models.HostRoleMaster).Pluck("id", &masters).Error; err != nil {
+		return nil, err
+	}
+	expectedMasters := common.MinMasterHostsNeededForInstallation
+	if common.IsSingleNodeCluster(cluster) {
+		expectedMasters = common.AllowedNumberOfMasterHostsInNoneHaMode
+	}
+	if len(masters) < expectedMasters {
+		return rule, nil
+	}
+
+	logutil.FromContext(ctx, m.log).Infof("cluster %s already has %d masters, skipping the master host role rules for host %s",
+		h.ClusterID, len(masters), h.ID)
+	nonMasterRules := funk.Filter(rules, func(r *models.HostRoleRule) bool {
+		return swag.StringValue(r.Role) != models.HostRoleRuleRoleMaster
+	}).([]*models.HostRoleRule)
+	return hostutil.MatchHostRoleRule(nonMasterRules, h, inventory, eligibleDisks), nil
+}
+
+// updateRuleNodeLabels replaces the node labels set by the rules on the host with the given labels of the matching
+// rule. The keys of the labels set by the rules are stored with the host, so they are removed once no rule sets them.
+func (m *Manager) updateRuleNodeLabels(ctx context.Context, h *models.Host, ruleLabels []*models.NodeLabelParams, db *gorm.DB) error {
+	if len(ruleLabels) == 0 && h.NodeLabels == "" {
+		return nil
+	}
+	var host common.Host
+	if err := db.Select("rule_node_labels").Take(&host, "id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).Error; err != nil {
+		return err
+	}
+	nodeLabels, ruleNodeLabels, err := mergeNodeLabels(h.NodeLabels, host.RuleNodeLabels, ruleLabels)
+	if err != nil {
+		return err
+	}
+	if nodeLabels == h.NodeLabels && ruleNodeLabels == host.RuleNodeLabels {
+		return nil
+	}
+	h.NodeLabels = nodeLabels
+	updates := map[string]interface{}{
+		"node_labels":               nodeLabels,
+		"rule_node_labels":          ruleNodeLabels,
+		"trigger_monitor_timestamp": time.Now(),
+	}
+	if err = m.updateHostAndNotify(ctx, db, h, updates).Error; err != nil {
+		return err
+	}
+	logutil.FromContext(ctx, m.log).Infof("host role rules set the node labels %s of host %s", ruleNodeLabels, h.ID)
+	return nil
+}
+
+// mergeNodeLabels removes the labels previously set by the rules from the labels of the host and adds the labels of
+// the rule, the values of the rule taking precedence. It returns the merged labels and the keys of the rule labels.
+func mergeNodeLabels(nodeLabelsStr, ruleNodeLabelsStr string, ruleLabels []*models.NodeLabelParams) (string, string, error) {
+	nodeLabels := make(map[string]string)
+	if nodeLabelsStr != "" {
+		if err := json.Unmarshal([]byte(nodeLabelsStr), &nodeLabels); err != nil {
+			return "", "", err
+		}
+	}
+	var previousKeys []string
+	if ruleNodeLabelsStr != "" {
+		if err := json.Unmarshal([]byte(ruleNodeLabelsStr), &previousKeys); err != nil {
+			return "", "", err
+		}
+	}
+	merged := make(map[string]string, len(nodeLabels)+len(ruleLabels))
+	for key, value := range nodeLabels {
+		if !funk.ContainsString(previousKeys, key) {
+			merged[key] = value
+		}
+	}
+	keys := make([]string, 0, len(ruleLabels))
+	for _, label := range ruleLabels {
+		key := swag.StringValue(label.Key)
+		merged[key] = swag.StringValue(label.Value)
+		if !funk.ContainsString(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	mergedStr := nodeLabelsStr
+	if !reflect.DeepEqual(merged, nodeLabels) {
+		mergedJson, err := json.Marshal(merged)
+		if err != nil {
+			return "", "", err
+		}
+		mergedStr = string(mergedJson)
+	}
+	if len(keys) == 0 {
+		return mergedStr, "", nil
+	}
+	keysJson, err := json.Marshal(keys)
+	if err != nil {
+		return "", "", err
+	}
+	return mergedStr, string(keysJson), nil
+}
@@ -1425,6 +1425,18 @@ var _ = Describe("UpdateInventory", func() {
 			Expect(h.InstallationDiskID).To(Equal(""))
 		})
 
+		It("extracts the hardware of the inventory", func() {
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{})
+
+			Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.Hardware.CPUArchitecture).To(Equal(models.ClusterCPUArchitectureX8664))
+			Expect(h.Hardware.DiskCount).To(BeEquivalentTo(1))
+			Expect(h.Hardware.LargestDiskBytes).To(Equal(common.TestDefaultConfig.Disks.SizeBytes))
+			Expect(h.Hardware.NicCount).To(BeEquivalentTo(1))
+		})
+
 		It("Upgrade installation_disk_id after getting new inventory", func() {
 			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(
 				[]*models.Disk{{Name: diskName}},
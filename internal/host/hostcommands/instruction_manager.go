@@ -50,6 +50,7 @@ type InstructionManager struct {
 	addHostsClusterToSteps        stateToStepsMap
 	poolHostToSteps               stateToStepsMap
 	disabledStepsMap              map[models.StepType]bool
+	decommissionToSteps           stateToStepsMap
 	upgradeAgentCmd               CommandGetter
 	eventsHandler                 eventsapi.Sender
 }
@@ -96,6 +97,8 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	downloadBootArtifactsCmd := NewDownloadBootArtifactsCmd(log, instructionConfig.ImageServiceBaseURL, instructionConfig.AuthType, osImages, db, instructionConfig.ImageExpirationTime, instructionConfig.HostFSMountDir)
 	rebootForReclaimCmd := NewRebootForReclaimCmd(log, instructionConfig.HostFSMountDir)
 	verifyVipsCmd := newVerifyVipsCmd(log, db)
+	mtuPathCheckCmd := NewMtuPathCheckCmd(log, db, instructionConfig.EnableMtuPathCheck)
+	wipeDisksCmd := NewWipeDisksCmd(log)
 
 	return &InstructionManager{
 		log:              log,
@@ -103,11 +106,11 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 		config:           instructionConfig,
 		disabledStepsMap: generateDisabledStepsMap(log, instructionConfig.DisabledSteps),
 		installingClusterStateToSteps: stateToStepsMap{
-			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mtuPathCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mtuPathCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDisconnected:             {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDiscovering:              {[]CommandGetter{inventoryCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, mtuPathCheckCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstalling:               {[]CommandGetter{installCmd, dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstallingInProgress:     {[]CommandGetter{dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue}, //TODO inventory step here is a temporary solution until format command is moved to a different state
 			models.HostStatusPreparingForInstallation: {[]CommandGetter{dhcpAllocateCmd, diskPerfCheckCmd, imageAvailabilityCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
@@ -141,6 +144,9 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 			models.HostStatusReclaiming:                 {[]CommandGetter{downloadBootArtifactsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusReclaimingRebooting:        {[]CommandGetter{rebootForReclaimCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionExit},
 		},
+		decommissionToSteps: stateToStepsMap{
+			models.HostDecommissionStageWipingDisks: {[]CommandGetter{wipeDisksCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+		},
 		upgradeAgentCmd: upgradeAgentCmd,
 		eventsHandler:   eventsHandler,
 	}
@@ -168,9 +174,15 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 		stateToSteps = i.poolHostToSteps
 	}
 
+	cmdsMap, ok := stateToSteps[hostStatus]
+	// The steps of a host being decommissioned don't depend on its status, which is the status of an installed host
+	if decommissionCmdsMap, found := i.decommissionToSteps[host.DecommissionStage]; found {
+		cmdsMap, ok = decommissionCmdsMap, found
+	}
+
 	// default value for states with not step defined
 	returnSteps.PostStepAction = swag.String(models.StepsPostStepActionContinue)
-	if cmdsMap, ok := stateToSteps[hostStatus]; ok {
+	if ok {
 		//need to add the step id
 		returnSteps.NextInstructionSeconds = cmdsMap.NextStepInSec
 		returnSteps.PostStepAction = swag.String(cmdsMap.PostStepAction)
@@ -186,6 +186,15 @@ var _ = Describe("instruction_manager", func() {
 			It("binding", func() {
 				checkStep(models.HostStatusBinding, nil)
 			})
+			It("installed", func() {
+				checkStep(models.HostStatusInstalled, nil)
+			})
+			It("installed wiping disks for decommission", func() {
+				Expect(db.Model(&host).Update("decommission_stage", models.HostDecommissionStageWipingDisks).Error).ShouldNot(HaveOccurred())
+				checkStep(models.HostStatusInstalled, []models.StepType{
+					models.StepTypeWipeDisks,
+				})
+			})
 		})
 	})
 
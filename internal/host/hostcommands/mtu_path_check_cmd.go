@@ -0,0 +1,114 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+type mtuPathCheckCmd struct {
+	baseCmd
+	db      *gorm.DB
+	enabled bool
+}
+
+func NewMtuPathCheckCmd(log logrus.FieldLogger, db *gorm.DB, enabled bool) *mtuPathCheckCmd {
+	return &mtuPathCheckCmd{
+		baseCmd: baseCmd{log: log},
+		db:      db,
+		enabled: enabled,
+	}
+}
+
+// getRemoteHosts returns the addresses in the machine networks of the other hosts of the cluster
+func (c *mtuPathCheckCmd) getRemoteHosts(host *models.Host, hosts []*models.Host, machineNetworks []*models.MachineNetwork) ([]*models.MtuPathCheckRemoteHost, error) {
+	var ipNets []*net.IPNet
+	for _, machineNetwork := range machineNetworks {
+		_, ipNet, err := net.ParseCIDR(string(machineNetwork.Cidr))
+		if err != nil {
+			return nil, err
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+
+	var ret []*models.MtuPathCheckRemoteHost
+	for _, h := range hosts {
+		// Like the connectivity check, skip the hosts which don't have an inventory or aren't connected
+		if h.ID.String() == host.ID.String() || h.Inventory == "" ||
+			funk.ContainsString([]string{models.HostStatusDiscovering, models.HostStatusDisconnected}, swag.StringValue(h.Status)) {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			return nil, err
+		}
+		var addresses []string
+		for _, intf := range inventory.Interfaces {
+			for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+				ip := net.ParseIP(strings.Split(address, "/")[0])
+				if ip == nil {
+					continue
+				}
+				for _, ipNet := range ipNets {
+					if ipNet.Contains(ip) {
+						addresses = append(addresses, ip.String())
+						break
+					}
+				}
+			}
+		}
+		if len(addresses) > 0 {
+			ret = append(ret, &models.MtuPathCheckRemoteHost{HostID: h.ID, IPAddresses: addresses})
+		}
+	}
+	return ret, nil
+}
+
+func (c *mtuPathCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	// The step is sent only when enabled, as the released agents don't implement it
+	if !c.enabled || host.ClusterID == nil {
+		return nil, nil
+	}
+	var machineNetworks []*models.MachineNetwork
+	if err := c.db.Find(&machineNetworks, "cluster_id = ?", host.ClusterID.String()).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to get the machine networks of cluster %s", host.ClusterID)
+		return nil, err
+	}
+	// The path MTU is probed between the addresses of the machine networks only, where the cluster network runs
+	if len(machineNetworks) == 0 {
+		return nil, nil
+	}
+	var hosts []*models.Host
+	if err := c.db.Select("id", "inventory", "status").Find(&hosts, "cluster_id = ?", host.ClusterID.String()).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to get list of hosts for cluster %s", host.ClusterID)
+		return nil, err
+	}
+	remoteHosts, err := c.getRemoteHosts(host, hosts, machineNetworks)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the remote hosts of the path MTU check of host %s cluster %s", host.ID, host.ClusterID)
+		return nil, err
+	}
+	// Skip this step in case there is no hosts to check
+	if len(remoteHosts) == 0 {
+		return nil, nil
+	}
+	request, err := json.Marshal(&models.MtuPathCheckRequest{RemoteHosts: remoteHosts})
+	if err != nil {
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeMtuPathCheck,
+		Args: []string{
+			string(request),
+		},
+	}
+	return []*models.Step{step}, nil
+}
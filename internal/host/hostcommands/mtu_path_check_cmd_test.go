@@ -0,0 +1,101 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("mtu_path_check", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var mCmd *mtuPathCheckCmd
+	var id, clusterId, infraEnvId strfmt.UUID
+	var dbName string
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		mCmd = NewMtuPathCheckCmd(common.GetTestLog(), db, true)
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvId, clusterId, models.HostStatusKnown)
+		host.Inventory = common.GenerateTestDefaultInventory()
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	createCluster := func(machineNetworks ...*models.MachineNetwork) {
+		cluster := hostutil.GenerateTestClusterWithMachineNetworks(clusterId, machineNetworks)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+	}
+
+	createOtherHost := func(status string, inventory string) strfmt.UUID {
+		otherId := strfmt.UUID(uuid.New().String())
+		otherHost := hostutil.GenerateTestHost(otherId, infraEnvId, clusterId, status)
+		otherHost.Inventory = inventory
+		Expect(db.Create(&otherHost).Error).ShouldNot(HaveOccurred())
+		return otherId
+	}
+
+	otherInventory := func(addresses ...string) string {
+		b, err := json.Marshal(&models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", IPV4Addresses: addresses}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	It("skips a host without machine networks", func() {
+		createCluster()
+		createOtherHost(models.HostStatusKnown, otherInventory("1.2.3.5/24"))
+		stepReply, stepErr := mCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("skips a host without other hosts in the machine networks", func() {
+		createCluster(&models.MachineNetwork{Cidr: "1.2.3.0/24", ClusterID: clusterId})
+		createOtherHost(models.HostStatusKnown, otherInventory("10.0.0.5/24"))
+		createOtherHost(models.HostStatusDisconnected, otherInventory("1.2.3.6/24"))
+		stepReply, stepErr := mCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("probes the addresses of the other hosts in the machine networks", func() {
+		createCluster(&models.MachineNetwork{Cidr: "1.2.3.0/24", ClusterID: clusterId})
+		otherId := createOtherHost(models.HostStatusInsufficient, otherInventory("1.2.3.5/24", "10.0.0.5/24"))
+		stepReply, stepErr := mCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeMtuPathCheck))
+		Expect(stepReply[0].Args).To(HaveLen(1))
+		var request models.MtuPathCheckRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &request)).To(Succeed())
+		Expect(request.RemoteHosts).To(HaveLen(1))
+		Expect(*request.RemoteHosts[0].HostID).To(Equal(otherId))
+		Expect(request.RemoteHosts[0].IPAddresses).To(Equal([]string{"1.2.3.5"}))
+	})
+
+	It("skips every host when the path MTU check is disabled", func() {
+		createCluster(&models.MachineNetwork{Cidr: "1.2.3.0/24", ClusterID: clusterId})
+		createOtherHost(models.HostStatusKnown, otherInventory("1.2.3.5/24"))
+		stepReply, stepErr := NewMtuPathCheckCmd(common.GetTestLog(), db, false).GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+})
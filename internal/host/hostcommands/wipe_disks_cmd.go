@@ -0,0 +1,59 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// wipeDisksDriveTypes are the types of the local disks of the host. Remote disks (e.g. iSCSI, FC or multipath) may be
+// shared with other hosts, so they aren't wiped.
+var wipeDisksDriveTypes = []models.DriveType{
+	models.DriveTypeHDD,
+	models.DriveTypeSSD,
+	models.DriveTypeRAID,
+}
+
+type wipeDisksCmd struct {
+	baseCmd
+}
+
+func NewWipeDisksCmd(log logrus.FieldLogger) *wipeDisksCmd {
+	return &wipeDisksCmd{
+		baseCmd: baseCmd{log: log},
+	}
+}
+
+func (c *wipeDisksCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if host.Inventory == "" {
+		return nil, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the inventory of host %s", host.ID)
+		return nil, err
+	}
+	request := models.WipeDisksRequest{Disks: []string{}}
+	for _, disk := range inventory.Disks {
+		if disk.Removable || disk.IsInstallationMedia || !funk.Contains(wipeDisksDriveTypes, disk.DriveType) {
+			continue
+		}
+		request.Disks = append(request.Disks, disk.Path)
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal WipeDisksRequest: %w", err)
+	}
+	step := &models.Step{
+		StepType: models.StepTypeWipeDisks,
+		Args: []string{
+			string(requestBytes),
+		},
+	}
+	return []*models.Step{step}, nil
+}
@@ -0,0 +1,59 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("wipe_disks_cmd.GetSteps", func() {
+	var (
+		ctx          = context.Background()
+		host         models.Host
+		wipeDisksCmd *wipeDisksCmd
+	)
+
+	BeforeEach(func() {
+		wipeDisksCmd = NewWipeDisksCmd(common.GetTestLog())
+		host = hostutil.GenerateTestHostWithInfraEnv(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()),
+			models.HostStatusInstalled, models.HostRoleWorker)
+	})
+
+	It("requests to wipe the local disks", func() {
+		inventory := models.Inventory{
+			Disks: []*models.Disk{
+				{Path: "/dev/sda", DriveType: models.DriveTypeHDD},
+				{Path: "/dev/nvme0n1", DriveType: models.DriveTypeSSD},
+				{Path: "/dev/sdb", DriveType: models.DriveTypeHDD, Removable: true},
+				{Path: "/dev/sr0", DriveType: models.DriveTypeODD, IsInstallationMedia: true},
+				{Path: "/dev/sdc", DriveType: models.DriveTypeISCSI},
+				{Path: "/dev/dm-0", DriveType: models.DriveTypeMultipath},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host.Inventory = string(b)
+
+		steps, err := wipeDisksCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeWipeDisks))
+		var request models.WipeDisksRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.Disks).To(Equal([]string{"/dev/sda", "/dev/nvme0n1"}))
+	})
+
+	It("doesn't return a step without an inventory", func() {
+		host.Inventory = ""
+		steps, err := wipeDisksCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+})
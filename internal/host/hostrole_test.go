@@ -139,4 +139,125 @@ var _ = Describe("Suggested-Role on Refresh", func() {
 			Expect(resultHost.SuggestedRole).To(Equal(t.suggested_role))
 		})
 	}
+
+	Context("host role rules", func() {
+		createHost := func(hostname string, rules ...*models.HostRoleRule) models.Host {
+			cluster = hostutil.GenerateTestCluster(clusterId)
+			rulesStr, err := common.MarshalHostRoleRules(rules)
+			Expect(err).ToNot(HaveOccurred())
+			cluster.HostRoleRules = rulesStr
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+			h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), infraEnvId, clusterId, models.HostStatusKnown)
+			h.Inventory = hostutil.GenerateMasterInventoryWithHostname(hostname)
+			h.Role = models.HostRoleAutoAssign
+			h.SuggestedRole = models.HostRoleAutoAssign
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			return h
+		}
+
+		getHost := func(id *strfmt.UUID) *models.Host {
+			var h models.Host
+			Expect(db.Take(&h, "id = ?", id.String()).Error).ToNot(HaveOccurred())
+			return &h
+		}
+
+		It("applies the first matching rule instead of the hardware based selection", func() {
+			host = createHost("storage-0",
+				&models.HostRoleRule{Name: "masters", Role: swag.String(models.HostRoleRuleRoleMaster), HostnamePattern: "^master-"},
+				&models.HostRoleRule{
+					Name:                    "storage",
+					Role:                    swag.String(models.HostRoleRuleRoleWorker),
+					HostnamePattern:         "^storage-",
+					SerialNumber:            "3534",
+					InstallationDiskPattern: "by-id/test-disk",
+					NodeLabels:              []*models.NodeLabelParams{{Key: swag.String("node-role.kubernetes.io/storage"), Value: swag.String("")}},
+				},
+				&models.HostRoleRule{Name: "others", Role: swag.String(models.HostRoleRuleRoleMaster)})
+			host.NodeLabels = `{"zone":"a"}`
+			Expect(db.Model(&host).Update("node_labels", host.NodeLabels).Error).ToNot(HaveOccurred())
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRoleUpdatedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()),
+			)).Times(1)
+
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+
+			resultHost := getHost(host.ID)
+			Expect(resultHost.SuggestedRole).To(Equal(models.HostRoleWorker))
+			Expect(resultHost.Role).To(Equal(models.HostRoleAutoAssign))
+			Expect(resultHost.InstallationDiskID).To(Equal("/dev/disk/by-id/test-disk-id"))
+			Expect(resultHost.NodeLabels).To(MatchJSON(`{"zone":"a","node-role.kubernetes.io/storage":""}`))
+		})
+
+		It("selects the role from the hardware when no rule matches", func() {
+			host = createHost("worker-0",
+				&models.HostRoleRule{Role: swag.String(models.HostRoleRuleRoleWorker), MinCPUCores: 64})
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRoleUpdatedEventName),
+			)).Times(1)
+
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).SuggestedRole).To(Equal(models.HostRoleMaster))
+		})
+
+		It("skips the master rules once the control plane is complete", func() {
+			host = createHost("storage-0",
+				&models.HostRoleRule{Name: "masters", Role: swag.String(models.HostRoleRuleRoleMaster)},
+				&models.HostRoleRule{Name: "workers", Role: swag.String(models.HostRoleRuleRoleWorker)})
+			for i := 0; i < common.MinMasterHostsNeededForInstallation; i++ {
+				master := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), infraEnvId, clusterId, models.HostStatusKnown)
+				master.Role = models.HostRoleMaster
+				Expect(db.Create(&master).Error).ShouldNot(HaveOccurred())
+			}
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRoleUpdatedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()),
+			)).Times(1)
+
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).SuggestedRole).To(Equal(models.HostRoleWorker))
+		})
+
+		It("removes the labels of the rules that don't match anymore", func() {
+			storageRule := &models.HostRoleRule{
+				Name:       "storage",
+				Role:       swag.String(models.HostRoleRuleRoleWorker),
+				NodeLabels: []*models.NodeLabelParams{{Key: swag.String("node-role.kubernetes.io/storage"), Value: swag.String("")}},
+			}
+			host = createHost("storage-0", storageRule)
+			host.NodeLabels = `{"zone":"a"}`
+			Expect(db.Model(&host).Update("node_labels", host.NodeLabels).Error).ToNot(HaveOccurred())
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRoleUpdatedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()),
+			)).Times(1)
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).NodeLabels).To(MatchJSON(`{"zone":"a","node-role.kubernetes.io/storage":""}`))
+
+			By("replacing the labels of the rule")
+			storageRule.NodeLabels = []*models.NodeLabelParams{{Key: swag.String("node-role.kubernetes.io/infra"), Value: swag.String("")}}
+			rulesStr, err := common.MarshalHostRoleRules([]*models.HostRoleRule{storageRule})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&cluster).Update("host_role_rules", rulesStr).Error).ToNot(HaveOccurred())
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).NodeLabels).To(MatchJSON(`{"zone":"a","node-role.kubernetes.io/infra":""}`))
+
+			By("setting the role of the host")
+			host.Role = models.HostRoleWorker
+			Expect(db.Model(&host).Update("role", host.Role).Error).ToNot(HaveOccurred())
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).NodeLabels).To(MatchJSON(`{"zone":"a"}`))
+		})
+
+		It("ignores the rules for hosts with a role", func() {
+			host = createHost("storage-0", &models.HostRoleRule{Role: swag.String(models.HostRoleRuleRoleWorker)})
+			host.Role = models.HostRoleMaster
+			Expect(db.Model(&host).Update("role", host.Role).Error).ToNot(HaveOccurred())
+
+			Expect(hapi.RefreshRole(ctx, &host, db)).To(Succeed())
+			Expect(getHost(host.ID).SuggestedRole).To(Equal(models.HostRoleAutoAssign))
+		})
+	})
 })
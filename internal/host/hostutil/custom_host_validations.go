@@ -0,0 +1,171 @@
+package hostutil
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/google/cel-go/cel"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// CustomHostValidationIDPrefix is the prefix of the IDs of the custom validations, which keeps them apart from the
+	// built-in validations and from the conditions of the host state machine
+	CustomHostValidationIDPrefix = "custom-"
+
+	// celCostLimit bounds the cost of the evaluation of a CEL expression, which is evaluated on every host refresh.
+	// The expressions iterating over the inventory lists cost a few hundreds.
+	celCostLimit = 100000
+	// celEvalTimeout bounds the duration of the evaluation of a CEL expression, checked every
+	// celInterruptCheckFrequency iterations of the comprehensions
+	celEvalTimeout             = 100 * time.Millisecond
+	celInterruptCheckFrequency = 100
+	celProgramsCacheSize       = 1024
+)
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	// celPrograms caches the compiled CEL programs by expression, they are evaluated on every host refresh
+	celPrograms = lru.New(celProgramsCacheSize)
+)
+
+func getCelEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("inventory", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("role", cel.StringType),
+			cel.CrossTypeNumericComparisons(true),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+func compileCelProgram(expression string) (cel.Program, error) {
+	if program, ok := celPrograms.Get(expression); ok {
+		return program.(cel.Program), nil
+	}
+	env, err := getCelEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("the expression evaluates to %s instead of bool", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit), cel.InterruptCheckFrequency(celInterruptCheckFrequency))
+	if err != nil {
+		return nil, err
+	}
+	celPrograms.Add(expression, program)
+	return program, nil
+}
+
+func parseJSONPath(id, expression string) (*jsonpath.JSONPath, error) {
+	// accept the relaxed syntax of kubectl, without the braces
+	if !strings.HasPrefix(expression, "{") {
+		expression = "{" + expression + "}"
+	}
+	path := jsonpath.New(id).AllowMissingKeys(true)
+	if err := path.Parse(expression); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+// ValidateCustomHostValidations checks that the IDs of the validations are unique and start with
+// CustomHostValidationIDPrefix, and that their expressions compile
+func ValidateCustomHostValidations(validations []*models.CustomHostValidation) error {
+	ids := make(map[string]bool)
+	for _, validation := range validations {
+		id := swag.StringValue(validation.ID)
+		if ids[id] {
+			return errors.Errorf("Custom host validation %s is defined more than once", id)
+		}
+		ids[id] = true
+		if !strings.HasPrefix(id, CustomHostValidationIDPrefix) {
+			return errors.Errorf("The ID of custom host validation %s must start with %s", id, CustomHostValidationIDPrefix)
+		}
+		var err error
+		switch swag.StringValue(validation.Language) {
+		case models.CustomHostValidationLanguageCel:
+			_, err = compileCelProgram(swag.StringValue(validation.Expression))
+		case models.CustomHostValidationLanguageJsonpath:
+			_, err = parseJSONPath(id, swag.StringValue(validation.Expression))
+		default:
+			err = errors.Errorf("unsupported language %s", swag.StringValue(validation.Language))
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Invalid expression of custom host validation %s", id)
+		}
+	}
+	return nil
+}
+
+// EvaluateCustomHostValidation returns whether the inventory of a host with the given role passes the validation
+func EvaluateCustomHostValidation(validation *models.CustomHostValidation, inventory *models.Inventory, role models.HostRole) (bool, error) {
+	// the expressions are evaluated over the JSON representation of the inventory, which is the one documented by the API
+	inventoryJson, err := json.Marshal(inventory)
+	if err != nil {
+		return false, err
+	}
+	var data map[string]interface{}
+	if err = json.Unmarshal(inventoryJson, &data); err != nil {
+		return false, err
+	}
+
+	switch swag.StringValue(validation.Language) {
+	case models.CustomHostValidationLanguageCel:
+		program, err := compileCelProgram(swag.StringValue(validation.Expression))
+		if err != nil {
+			return false, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), celEvalTimeout)
+		defer cancel()
+		out, _, err := program.ContextEval(ctx, map[string]interface{}{"inventory": data, "role": string(role)})
+		if err != nil {
+			return false, err
+		}
+		passed, ok := out.Value().(bool)
+		if !ok {
+			return false, errors.Errorf("the expression evaluated to %v instead of a bool", out.Value())
+		}
+		return passed, nil
+	case models.CustomHostValidationLanguageJsonpath:
+		path, err := parseJSONPath(swag.StringValue(validation.ID), swag.StringValue(validation.Expression))
+		if err != nil {
+			return false, err
+		}
+		results, err := path.FindResults(data)
+		if err != nil {
+			return false, err
+		}
+		selected := false
+		for _, values := range results {
+			for _, value := range values {
+				if value.Kind() == reflect.Interface {
+					value = value.Elem()
+				}
+				if value.IsValid() && value.Kind() == reflect.Bool && !value.Bool() {
+					return false, nil
+				}
+				selected = true
+			}
+		}
+		return selected, nil
+	}
+	return false, errors.Errorf("unsupported language %s", swag.StringValue(validation.Language))
+}
@@ -0,0 +1,91 @@
+package hostutil
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+var _ = Describe("Custom host validations", func() {
+	var inventory *models.Inventory
+
+	BeforeEach(func() {
+		inventory = &models.Inventory{
+			CPU:          &models.CPU{Count: 16},
+			Memory:       &models.Memory{PhysicalBytes: conversions.GibToBytes(64)},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc.", Virtual: false},
+			Interfaces: []*models.Interface{
+				{Name: "eth0", Vendor: "0x8086"},
+				{Name: "eth1", Vendor: "0x15b3"},
+			},
+		}
+	})
+
+	validation := func(language, expression string) *models.CustomHostValidation {
+		return &models.CustomHostValidation{
+			ID:         swag.String("custom-site-requirement"),
+			Language:   swag.String(language),
+			Expression: swag.String(expression),
+		}
+	}
+
+	DescribeTable("evaluation",
+		func(language, expression string, role models.HostRole, passes bool) {
+			v := validation(language, expression)
+			Expect(ValidateCustomHostValidations([]*models.CustomHostValidation{v})).To(Succeed())
+			passed, err := EvaluateCustomHostValidation(v, inventory, role)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(passed).To(Equal(passes))
+		},
+		Entry("CEL number comparison", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 16", models.HostRoleWorker, true),
+		Entry("CEL failing number comparison", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 32", models.HostRoleWorker, false),
+		Entry("CEL list macro", models.CustomHostValidationLanguageCel, `inventory.interfaces.exists(i, i.vendor == "0x15b3")`, models.HostRoleWorker, true),
+		Entry("CEL role", models.CustomHostValidationLanguageCel, `role != "master" || inventory.cpu.count >= 32`, models.HostRoleMaster, false),
+		Entry("CEL role of a worker", models.CustomHostValidationLanguageCel, `role != "master" || inventory.cpu.count >= 32`, models.HostRoleWorker, true),
+		Entry("JSONPath filter", models.CustomHostValidationLanguageJsonpath, `{.interfaces[?(@.vendor=="0x8086")].name}`, models.HostRoleWorker, true),
+		Entry("JSONPath filter without match", models.CustomHostValidationLanguageJsonpath, `{.interfaces[?(@.vendor=="0x14e4")].name}`, models.HostRoleWorker, false),
+		Entry("JSONPath without braces", models.CustomHostValidationLanguageJsonpath, `.system_vendor.manufacturer`, models.HostRoleWorker, true),
+		Entry("JSONPath missing key", models.CustomHostValidationLanguageJsonpath, `{.bmc_v6address}`, models.HostRoleWorker, false),
+		Entry("JSONPath false value", models.CustomHostValidationLanguageJsonpath, `{.interfaces[?(@.name=="eth0")].has_carrier}`, models.HostRoleWorker, false),
+	)
+
+	It("reports the evaluation errors", func() {
+		_, err := EvaluateCustomHostValidation(validation(models.CustomHostValidationLanguageCel, "inventory.bios.version == '2.1'"), inventory, models.HostRoleWorker)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("invalid validations",
+		func(validations []*models.CustomHostValidation, message string) {
+			Expect(ValidateCustomHostValidations(validations)).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("CEL syntax error", []*models.CustomHostValidation{validation(models.CustomHostValidationLanguageCel, "inventory.cpu.count >=")},
+			"Invalid expression of custom host validation custom-site-requirement"),
+		Entry("CEL expression not evaluating to a bool", []*models.CustomHostValidation{validation(models.CustomHostValidationLanguageCel, "role")},
+			"instead of bool"),
+		Entry("JSONPath syntax error", []*models.CustomHostValidation{validation(models.CustomHostValidationLanguageJsonpath, "{.interfaces[?(@.vendor==]}")},
+			"Invalid expression of custom host validation custom-site-requirement"),
+		Entry("duplicate ID", []*models.CustomHostValidation{
+			validation(models.CustomHostValidationLanguageCel, "true"),
+			validation(models.CustomHostValidationLanguageCel, "false"),
+		}, "is defined more than once"),
+		Entry("built-in ID", []*models.CustomHostValidation{{
+			ID:         swag.String(string(models.HostValidationIDHasMinCPUCores)),
+			Language:   swag.String(models.CustomHostValidationLanguageCel),
+			Expression: swag.String("true"),
+		}}, "must start with custom-"),
+	)
+
+	It("bounds the cost of the CEL expressions", func() {
+		digits := "[0, 1, 2, 3, 4, 5, 6, 7, 8, 9]"
+		expression := fmt.Sprintf("%[1]s.all(a, %[1]s.all(b, %[1]s.all(c, %[1]s.all(d, %[1]s.all(e, a + b + c + d + e >= 0)))))", digits)
+		v := validation(models.CustomHostValidationLanguageCel, expression)
+		Expect(ValidateCustomHostValidations([]*models.CustomHostValidation{v})).To(Succeed())
+		_, err := EvaluateCustomHostValidation(v, inventory, models.HostRoleWorker)
+		Expect(err).To(MatchError(ContainSubstring("cost limit exceeded")))
+	})
+})
@@ -0,0 +1,129 @@
+package hostutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+)
+
+// ValidateHostRoleRules checks the regular expressions of the rules, which the API schema can't
+func ValidateHostRoleRules(rules []*models.HostRoleRule) error {
+	for i, rule := range rules {
+		if _, err := regexp.Compile(rule.HostnamePattern); err != nil {
+			return errors.Wrapf(err, "Invalid hostname pattern of host role rule %s", hostRoleRuleName(rule, i))
+		}
+		if _, err := regexp.Compile(rule.InstallationDiskPattern); err != nil {
+			return errors.Wrapf(err, "Invalid installation disk pattern of host role rule %s", hostRoleRuleName(rule, i))
+		}
+	}
+	return nil
+}
+
+// MatchHostRoleRule returns the first rule whose criteria are all met by the host, nil when there is none.
+// eligibleDisks are the disks of the inventory which are eligible for installation.
+func MatchHostRoleRule(rules []*models.HostRoleRule, host *models.Host, inventory *models.Inventory,
+	eligibleDisks []*models.Disk) *models.HostRoleRule {
+	for _, rule := range rules {
+		if hostMatchesRule(rule, host, inventory, eligibleDisks) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// GetHostRoleRuleInstallationDisk returns the first eligible disk matching the installation disk pattern of the
+// rule, nil when the rule has no pattern or no disk matches it
+func GetHostRoleRuleInstallationDisk(rule *models.HostRoleRule, eligibleDisks []*models.Disk) *models.Disk {
+	if rule.InstallationDiskPattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(rule.InstallationDiskPattern)
+	if err != nil {
+		return nil
+	}
+	for _, disk := range eligibleDisks {
+		for _, name := range []string{disk.Path, disk.ID, disk.ByID, disk.ByPath} {
+			if name != "" && pattern.MatchString(name) {
+				return disk
+			}
+		}
+	}
+	return nil
+}
+
+func hostMatchesRule(rule *models.HostRoleRule, host *models.Host, inventory *models.Inventory, eligibleDisks []*models.Disk) bool {
+	if rule.HostnamePattern != "" {
+		pattern, err := regexp.Compile(rule.HostnamePattern)
+		if err != nil {
+			return false
+		}
+		hostname, err := GetCurrentHostName(host)
+		if err != nil || !pattern.MatchString(hostname) {
+			return false
+		}
+	}
+	if len(rule.MacAddresses) > 0 && !hasInterface(inventory, func(nic *models.Interface) bool {
+		for _, macAddress := range rule.MacAddresses {
+			if normalizeMacAddress(macAddress) == normalizeMacAddress(nic.MacAddress) {
+				return true
+			}
+		}
+		return false
+	}) {
+		return false
+	}
+	if rule.SerialNumber != "" && (inventory.SystemVendor == nil || inventory.SystemVendor.SerialNumber != rule.SerialNumber) {
+		return false
+	}
+	if rule.BmcAddress != "" && inventory.BmcAddress != rule.BmcAddress {
+		return false
+	}
+	if rule.MinCPUCores > 0 && (inventory.CPU == nil || inventory.CPU.Count < rule.MinCPUCores) {
+		return false
+	}
+	if rule.MinMemoryMib > 0 && (inventory.Memory == nil || inventory.Memory.PhysicalBytes < conversions.MibToBytes(rule.MinMemoryMib)) {
+		return false
+	}
+	if rule.MinDiskSizeGb > 0 && !hasDiskOfSize(eligibleDisks, conversions.GbToBytes(rule.MinDiskSizeGb)) {
+		return false
+	}
+	if rule.NicVendor != "" && !hasInterface(inventory, func(nic *models.Interface) bool {
+		return strings.EqualFold(nic.Vendor, rule.NicVendor)
+	}) {
+		return false
+	}
+	return true
+}
+
+func hasInterface(inventory *models.Inventory, matches func(nic *models.Interface) bool) bool {
+	for _, nic := range inventory.Interfaces {
+		if matches(nic) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDiskOfSize(disks []*models.Disk, sizeBytes int64) bool {
+	for _, disk := range disks {
+		if disk.SizeBytes >= sizeBytes {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeMacAddress(macAddress string) string {
+	return strings.ToLower(strings.ReplaceAll(macAddress, "-", ":"))
+}
+
+func hostRoleRuleName(rule *models.HostRoleRule, index int) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
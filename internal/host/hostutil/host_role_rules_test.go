@@ -0,0 +1,103 @@
+package hostutil
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+var _ = Describe("Host role rules", func() {
+	var (
+		host      *models.Host
+		inventory *models.Inventory
+		disks     []*models.Disk
+	)
+
+	BeforeEach(func() {
+		disks = []*models.Disk{
+			{ID: "/dev/disk/by-id/wwn-0x1", Path: "/dev/sda", ByPath: "/dev/disk/by-path/pci-0000:00:1f.2-ata-1", SizeBytes: conversions.GbToBytes(120)},
+			{ID: "/dev/disk/by-id/nvme-0x2", Path: "/dev/nvme0n1", SizeBytes: conversions.GbToBytes(960)},
+		}
+		inventory = &models.Inventory{
+			Hostname:     "storage-0.example.com",
+			BmcAddress:   "10.0.0.5",
+			CPU:          &models.CPU{Count: 16},
+			Memory:       &models.Memory{PhysicalBytes: conversions.GibToBytes(64)},
+			SystemVendor: &models.SystemVendor{SerialNumber: "SN-1234"},
+			Interfaces: []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc", Vendor: "0x8086"},
+			},
+			Disks: disks,
+		}
+		host = &models.Host{RequestedHostname: "storage-0.example.com"}
+	})
+
+	rule := func(modify func(rule *models.HostRoleRule)) *models.HostRoleRule {
+		r := &models.HostRoleRule{Role: swag.String(models.HostRoleRuleRoleWorker)}
+		modify(r)
+		return r
+	}
+
+	DescribeTable("matching criteria",
+		func(modify func(rule *models.HostRoleRule), matches bool) {
+			r := rule(modify)
+			if matches {
+				Expect(MatchHostRoleRule([]*models.HostRoleRule{r}, host, inventory, disks)).To(Equal(r))
+			} else {
+				Expect(MatchHostRoleRule([]*models.HostRoleRule{r}, host, inventory, disks)).To(BeNil())
+			}
+		},
+		Entry("no criteria", func(r *models.HostRoleRule) {}, true),
+		Entry("hostname", func(r *models.HostRoleRule) { r.HostnamePattern = "^storage-[0-9]+" }, true),
+		Entry("other hostname", func(r *models.HostRoleRule) { r.HostnamePattern = "^master-" }, false),
+		Entry("MAC address", func(r *models.HostRoleRule) { r.MacAddresses = []string{"52-54-00-AA-BB-CC"} }, true),
+		Entry("other MAC address", func(r *models.HostRoleRule) { r.MacAddresses = []string{"52:54:00:aa:bb:cd"} }, false),
+		Entry("serial number", func(r *models.HostRoleRule) { r.SerialNumber = "SN-1234" }, true),
+		Entry("other serial number", func(r *models.HostRoleRule) { r.SerialNumber = "SN-1235" }, false),
+		Entry("BMC address", func(r *models.HostRoleRule) { r.BmcAddress = "10.0.0.5" }, true),
+		Entry("other BMC address", func(r *models.HostRoleRule) { r.BmcAddress = "10.0.0.6" }, false),
+		Entry("enough CPU cores", func(r *models.HostRoleRule) { r.MinCPUCores = 16 }, true),
+		Entry("not enough CPU cores", func(r *models.HostRoleRule) { r.MinCPUCores = 32 }, false),
+		Entry("enough memory", func(r *models.HostRoleRule) { r.MinMemoryMib = 65536 }, true),
+		Entry("not enough memory", func(r *models.HostRoleRule) { r.MinMemoryMib = 131072 }, false),
+		Entry("large enough disk", func(r *models.HostRoleRule) { r.MinDiskSizeGb = 960 }, true),
+		Entry("no large enough disk", func(r *models.HostRoleRule) { r.MinDiskSizeGb = 1920 }, false),
+		Entry("NIC vendor", func(r *models.HostRoleRule) { r.NicVendor = "0X8086" }, true),
+		Entry("other NIC vendor", func(r *models.HostRoleRule) { r.NicVendor = "0x15b3" }, false),
+		Entry("all criteria", func(r *models.HostRoleRule) {
+			r.HostnamePattern = "^storage-"
+			r.SerialNumber = "SN-1234"
+			r.MinCPUCores = 8
+		}, true),
+		Entry("one criterion not met", func(r *models.HostRoleRule) {
+			r.HostnamePattern = "^storage-"
+			r.SerialNumber = "SN-1235"
+		}, false),
+	)
+
+	It("returns the first matching rule", func() {
+		first := rule(func(r *models.HostRoleRule) { r.HostnamePattern = "^master-" })
+		second := rule(func(r *models.HostRoleRule) { r.HostnamePattern = "^storage-" })
+		third := rule(func(r *models.HostRoleRule) {})
+		Expect(MatchHostRoleRule([]*models.HostRoleRule{first, second, third}, host, inventory, disks)).To(Equal(second))
+	})
+
+	It("selects the first eligible disk matching the installation disk pattern", func() {
+		Expect(GetHostRoleRuleInstallationDisk(rule(func(r *models.HostRoleRule) { r.InstallationDiskPattern = "nvme" }), disks)).To(Equal(disks[1]))
+		Expect(GetHostRoleRuleInstallationDisk(rule(func(r *models.HostRoleRule) { r.InstallationDiskPattern = "by-path/pci-" }), disks)).To(Equal(disks[0]))
+		Expect(GetHostRoleRuleInstallationDisk(rule(func(r *models.HostRoleRule) { r.InstallationDiskPattern = "^/dev/vda$" }), disks)).To(BeNil())
+		Expect(GetHostRoleRuleInstallationDisk(rule(func(r *models.HostRoleRule) {}), disks)).To(BeNil())
+	})
+
+	It("rejects invalid patterns", func() {
+		Expect(ValidateHostRoleRules([]*models.HostRoleRule{rule(func(r *models.HostRoleRule) {
+			r.HostnamePattern = "^storage-"
+			r.InstallationDiskPattern = "nvme"
+		})})).To(Succeed())
+		Expect(ValidateHostRoleRules([]*models.HostRoleRule{rule(func(r *models.HostRoleRule) { r.HostnamePattern = "storage-(" })})).ToNot(Succeed())
+		Expect(ValidateHostRoleRules([]*models.HostRoleRule{rule(func(r *models.HostRoleRule) { r.InstallationDiskPattern = "[nvme" })})).ToNot(Succeed())
+	})
+})
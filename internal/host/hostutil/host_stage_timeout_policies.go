@@ -0,0 +1,87 @@
+package hostutil
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// stages which end the installation of a host, and can't time out
+var finalHostStages = []models.HostStage{models.HostStageDone, models.HostStageFailed}
+
+// RetriableHostStages are the stages run by the installer before the host reboots into the installed disk. The retry
+// remediation sends the installation to the host again, so it only applies to these stages.
+var RetriableHostStages = []models.HostStage{
+	models.HostStageStartingInstallation, models.HostStageInstalling, models.HostStageWritingImageToDisk,
+	models.HostStageWaitingForControlPlane, models.HostStageWaitingForBootkube, models.HostStageWaitingForController,
+}
+
+// ValidateHostStageTimeoutPolicies checks that each stage has at most one policy, and that the stages can time out
+func ValidateHostStageTimeoutPolicies(policies []*models.HostStageTimeoutPolicy) error {
+	stages := make(map[models.HostStage]bool)
+	for _, policy := range policies {
+		if err := policy.Validate(strfmt.Default); err != nil {
+			return err
+		}
+		stage := *policy.Stage
+		if stages[stage] {
+			return errors.Errorf("Host stage %s has more than one timeout policy", stage)
+		}
+		stages[stage] = true
+		if funk.Contains(finalHostStages, stage) {
+			return errors.Errorf("Host stage %s can't have a timeout policy", stage)
+		}
+		if *policy.Remediation == models.HostStageRemediationRetry && !funk.Contains(RetriableHostStages, stage) {
+			return errors.Errorf("Host stage %s can't be retried, it follows the reboot of the host", stage)
+		}
+	}
+	return nil
+}
+
+// GetHostStageTimeoutPolicy returns the policy of the stage, nil when there is none
+func GetHostStageTimeoutPolicy(policies []*models.HostStageTimeoutPolicy, stage models.HostStage) *models.HostStageTimeoutPolicy {
+	for _, policy := range policies {
+		if policy.Stage != nil && *policy.Stage == stage {
+			return policy
+		}
+	}
+	return nil
+}
+
+// GetHostStageRemediation returns the remediation applied to a host whose stage timed out after attempts remediations
+// of the policy. The retry and reboot remediations fall back to failure once the attempts of the policy are exhausted,
+// retry falls back to failure in the stages which can't be retried, and reboot falls back to failure when the host
+// can't be rebooted.
+func GetHostStageRemediation(policy *models.HostStageTimeoutPolicy, attempts int64, canReboot bool) models.HostStageRemediation {
+	remediation := *policy.Remediation
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	switch remediation {
+	case models.HostStageRemediationReboot:
+		if !canReboot {
+			return models.HostStageRemediationFail
+		}
+		fallthrough
+	case models.HostStageRemediationRetry:
+		if remediation == models.HostStageRemediationRetry && !funk.Contains(RetriableHostStages, *policy.Stage) {
+			return models.HostStageRemediationFail
+		}
+		if attempts >= maxAttempts {
+			return models.HostStageRemediationFail
+		}
+	}
+	return remediation
+}
+
+// GetHostStageTimeout returns the timeout of the policy, defaultTimeout when the policy doesn't set one
+func GetHostStageTimeout(policy *models.HostStageTimeoutPolicy, defaultTimeout time.Duration) time.Duration {
+	if policy.TimeoutSeconds > 0 {
+		return time.Duration(policy.TimeoutSeconds) * time.Second
+	}
+	return defaultTimeout
+}
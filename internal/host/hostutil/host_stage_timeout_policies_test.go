@@ -0,0 +1,85 @@
+package hostutil
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+func hostStageTimeoutPolicy(stage models.HostStage, remediation models.HostStageRemediation, maxAttempts int64) *models.HostStageTimeoutPolicy {
+	return &models.HostStageTimeoutPolicy{Stage: &stage, Remediation: &remediation, MaxAttempts: maxAttempts}
+}
+
+var _ = Describe("Host stage timeout policies", func() {
+	It("accepts a policy per stage", func() {
+		Expect(ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediationReboot, 2),
+			hostStageTimeoutPolicy(models.HostStageConfiguring, models.HostStageRemediationPause, 0),
+		})).To(Succeed())
+	})
+
+	It("rejects two policies of the same stage", func() {
+		err := ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediationReboot, 2),
+			hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediationFail, 0),
+		})
+		Expect(err).To(MatchError(ContainSubstring("more than one timeout policy")))
+	})
+
+	It("rejects a policy of a final stage", func() {
+		err := ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageDone, models.HostStageRemediationFail, 0),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects an unknown remediation", func() {
+		err := ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediation("power-cycle"), 0),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects the retry of a stage following the reboot", func() {
+		Expect(ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageWritingImageToDisk, models.HostStageRemediationRetry, 2),
+		})).To(Succeed())
+		err := ValidateHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageConfiguring, models.HostStageRemediationRetry, 2),
+		})
+		Expect(err).To(MatchError(ContainSubstring("can't be retried")))
+	})
+
+	It("finds the policy of a stage", func() {
+		policies := []*models.HostStageTimeoutPolicy{
+			hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediationReboot, 2),
+		}
+		Expect(GetHostStageTimeoutPolicy(policies, models.HostStageRebooting)).To(Equal(policies[0]))
+		Expect(GetHostStageTimeoutPolicy(policies, models.HostStageConfiguring)).To(BeNil())
+	})
+
+	It("uses the default timeout when the policy doesn't set one", func() {
+		policy := hostStageTimeoutPolicy(models.HostStageRebooting, models.HostStageRemediationReboot, 2)
+		Expect(GetHostStageTimeout(policy, time.Hour)).To(Equal(time.Hour))
+		policy.TimeoutSeconds = 600
+		Expect(GetHostStageTimeout(policy, time.Hour)).To(Equal(10 * time.Minute))
+	})
+
+	DescribeTable("remediation",
+		func(stage models.HostStage, remediation models.HostStageRemediation, maxAttempts, attempts int64, canReboot bool, expected models.HostStageRemediation) {
+			policy := hostStageTimeoutPolicy(stage, remediation, maxAttempts)
+			Expect(GetHostStageRemediation(policy, attempts, canReboot)).To(Equal(expected))
+		},
+		Entry("fail", models.HostStageRebooting, models.HostStageRemediationFail, int64(0), int64(0), true, models.HostStageRemediationFail),
+		Entry("first retry", models.HostStageInstalling, models.HostStageRemediationRetry, int64(0), int64(0), true, models.HostStageRemediationRetry),
+		Entry("retries exhausted", models.HostStageInstalling, models.HostStageRemediationRetry, int64(2), int64(2), true, models.HostStageRemediationFail),
+		Entry("retry after the reboot", models.HostStageRebooting, models.HostStageRemediationRetry, int64(2), int64(0), true, models.HostStageRemediationFail),
+		Entry("reboot", models.HostStageRebooting, models.HostStageRemediationReboot, int64(2), int64(1), true, models.HostStageRemediationReboot),
+		Entry("reboots exhausted", models.HostStageRebooting, models.HostStageRemediationReboot, int64(2), int64(2), true, models.HostStageRemediationFail),
+		Entry("reboot not supported", models.HostStageRebooting, models.HostStageRemediationReboot, int64(2), int64(0), false, models.HostStageRemediationFail),
+		Entry("pause after remediations", models.HostStageRebooting, models.HostStageRemediationPause, int64(1), int64(3), true, models.HostStageRemediationPause),
+	)
+})
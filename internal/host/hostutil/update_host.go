@@ -85,6 +85,24 @@ func UpdateHostStageTimeout(ctx context.Context, log logrus.FieldLogger, db *gor
 	return host, nil
 }
 
+// UpdateHostStageTimeoutRemediation records the remediation applied to a host whose stage timed out, with the status
+// the remediation moves the host to
+func UpdateHostStageTimeoutRemediation(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, stream stream.Notifier, infraEnvId strfmt.UUID, hostId strfmt.UUID,
+	srcStatus string, newStatus string, statusInfo string, stage models.HostStage, remediation models.HostStageRemediation, attempt int64, maxDurationMinutes int64, extra ...interface{}) (*common.Host, error) {
+	extra = append(append(make([]interface{}, 0), "progress_stage_remediation", remediation,
+		"progress_stage_remediation_attempts", attempt, "progress_stage_remediation_at", strfmt.DateTime(time.Now())), extra...)
+
+	host, err := UpdateHostStatus(ctx, log, db, eventsHandler, stream, infraEnvId, hostId, srcStatus, newStatus, statusInfo, extra...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to apply the %s remediation to host %s belonging to infra-env %s",
+			remediation, hostId, infraEnvId)
+	}
+
+	eventgen.SendHostStageTimeoutRemediationEvent(ctx, eventsHandler, hostId, infraEnvId, host.ClusterID, GetHostnameForMsg(&host.Host),
+		string(stage), maxDurationMinutes, string(remediation), attempt)
+	return host, nil
+}
+
 func UpdateHostAndNotify(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, stream stream.Notifier, infraEnvId strfmt.UUID,
 	hostId strfmt.UUID, srcStatus string, extra ...interface{}) (*common.Host, error) {
 	host, err := UpdateHost(log, db, infraEnvId, hostId, srcStatus, extra...)
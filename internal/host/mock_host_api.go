@@ -733,6 +733,20 @@ func (mr *MockAPIMockRecorder) UpdateMediaConnected(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMediaConnected", reflect.TypeOf((*MockAPI)(nil).UpdateMediaConnected), arg0, arg1)
 }
 
+// UpdateMtuPathReport mocks base method.
+func (m *MockAPI) UpdateMtuPathReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMtuPathReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMtuPathReport indicates an expected call of UpdateMtuPathReport.
+func (mr *MockAPIMockRecorder) UpdateMtuPathReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMtuPathReport", reflect.TypeOf((*MockAPI)(nil).UpdateMtuPathReport), arg0, arg1, arg2)
+}
+
 // UpdateNTP mocks base method.
 func (m *MockAPI) UpdateNTP(arg0 context.Context, arg1 *models.Host, arg2 []*models.NtpSource, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 
 	stateswitch "github.com/filanov/stateswitch"
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
 )
 
 // MockTransitionHandler is a mock of TransitionHandler interface.
@@ -297,6 +298,20 @@ func (mr *MockTransitionHandlerMockRecorder) PostHostStageTimeout(reason interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostHostStageTimeout", reflect.TypeOf((*MockTransitionHandler)(nil).PostHostStageTimeout), reason)
 }
 
+// PostHostStageTimeoutRemediation mocks base method.
+func (m *MockTransitionHandler) PostHostStageTimeoutRemediation(remediation models.HostStageRemediation, reason string) stateswitch.PostTransition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostHostStageTimeoutRemediation", remediation, reason)
+	ret0, _ := ret[0].(stateswitch.PostTransition)
+	return ret0
+}
+
+// PostHostStageTimeoutRemediation indicates an expected call of PostHostStageTimeoutRemediation.
+func (mr *MockTransitionHandlerMockRecorder) PostHostStageTimeoutRemediation(remediation, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostHostStageTimeoutRemediation", reflect.TypeOf((*MockTransitionHandler)(nil).PostHostStageTimeoutRemediation), remediation, reason)
+}
+
 // PostInstallHost mocks base method.
 func (m *MockTransitionHandler) PostInstallHost(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sort"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -48,10 +49,25 @@ func (m *Manager) initMonitoringQueryGenerator() {
 			return dbWithCondition
 		}
 		m.monitorClusterQueryGenerator = common.NewMonitorQueryGenerator(m.db, buildInitialQuery, m.Config.MonitorBatchSize)
+		if m.Config.MonitorSharder != nil {
+			m.monitorClusterQueryGenerator.WithFilter(m.Config.MonitorSharder.Owns)
+		}
 	}
 	if m.monitorInfraEnvQueryGenerator == nil {
 		m.monitorInfraEnvQueryGenerator = common.NewInfraEnvMonitorQueryGenerator(m.db, m.Config.MonitorBatchSize)
+		if m.Config.MonitorSharder != nil {
+			m.monitorInfraEnvQueryGenerator.WithFilter(m.Config.MonitorSharder.Owns)
+		}
+	}
+}
+
+// shouldMonitor tells whether this replica monitors the hosts of the cluster or infra-env, which is the case of the
+// leader unless the monitoring is sharded between the replicas
+func (m *Manager) shouldMonitor(id *strfmt.UUID) bool {
+	if m.Config.MonitorSharder != nil {
+		return m.Config.MonitorSharder.Owns(id.String())
 	}
+	return m.leaderElector.IsLeader()
 }
 
 func SortHosts(hosts []*models.Host) ([]*models.Host, bool) {
@@ -156,9 +172,13 @@ func (m *Manager) clusterHostMonitoring() int64 {
 			sortedHosts, canRefreshRoles := SortHosts(c.Hosts)
 
 			for _, host := range sortedHosts {
-				if !m.leaderElector.IsLeader() {
-					m.log.Debugf("Not a leader, exiting cluster HostMonitoring")
-					return monitored
+				if !m.shouldMonitor(c.ID) {
+					if m.Config.MonitorSharder == nil {
+						m.log.Debugf("Not a leader, exiting cluster HostMonitoring")
+						return monitored
+					}
+					// The shards were rebalanced since the query
+					break
 				}
 				if !m.SkipMonitoring(host) {
 					monitored += 1
@@ -216,9 +236,13 @@ func (m *Manager) infraEnvHostMonitoring() int64 {
 		for _, i := range infraEnvs {
 			inventoryCache := make(InventoryCache)
 			for _, host := range i.Hosts {
-				if !m.leaderElector.IsLeader() {
-					m.log.Debugf("Not a leader, exiting infra-env HostMonitoring")
-					return monitored
+				if !m.shouldMonitor(i.ID) {
+					if m.Config.MonitorSharder == nil {
+						m.log.Debugf("Not a leader, exiting infra-env HostMonitoring")
+						return monitored
+					}
+					// The shards were rebalanced since the query
+					break
 				}
 				if funk.ContainsString(monitorStates, swag.StringValue(host.Status)) {
 					monitored += 1
@@ -235,7 +259,7 @@ func (m *Manager) infraEnvHostMonitoring() int64 {
 
 func (m *Manager) HostMonitoring() {
 	var monitored int64
-	if !m.leaderElector.IsLeader() {
+	if m.Config.MonitorSharder == nil && !m.leaderElector.IsLeader() {
 		m.log.Debugf("Not a leader, exiting HostMonitoring")
 		return
 	}
@@ -244,4 +268,7 @@ func (m *Manager) HostMonitoring() {
 	monitored += m.clusterHostMonitoring()
 	monitored += m.infraEnvHostMonitoring()
 	m.metricApi.MonitoredHostsCount(monitored)
+	if m.Config.MonitorSharder != nil {
+		m.metricApi.MonitoredShardCount(m.Config.MonitorSharder.Name(), "hosts", monitored)
+	}
 }
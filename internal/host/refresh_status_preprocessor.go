@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/provider/registry"
@@ -43,7 +46,7 @@ type refreshPreprocessor struct {
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator,
 	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, providerRegistry registry.ProviderRegistry,
-	versionHandler versions.Handler) *refreshPreprocessor {
+	versionHandler versions.Handler, hostStageTimeout func(models.HostStage) time.Duration) *refreshPreprocessor {
 	v := &validator{
 		log:              log,
 		hwValidatorCfg:   hwValidatorCfg,
@@ -51,6 +54,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		operatorsAPI:     operatorsApi,
 		providerRegistry: providerRegistry,
 		versionHandler:   versionHandler,
+		hostStageTimeout: hostStageTimeout,
 	}
 	return &refreshPreprocessor{
 		log:                     log,
@@ -141,6 +145,27 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 			sortByValidationResultID(validationsOutput[category])
 		}
 	}
+	customValidations, err := r.getCustomHostValidations(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, validation := range customValidations {
+		id := validationID(swag.StringValue(validation.ID))
+		// A custom validation must never replace a condition of the state machine
+		if _, exists := conditions[id.String()]; exists || !strings.HasPrefix(id.String(), hostutil.CustomHostValidationIDPrefix) {
+			r.log.Warnf("ignoring custom validation %s of host %s, its ID is reserved", id, c.host.ID)
+			continue
+		}
+		status, message := r.validateCustomHostValidation(c, validation)
+		conditions[id.String()] = status == ValidationSuccess
+		validationsOutput[customValidationsCategory] = append(validationsOutput[customValidationsCategory], ValidationResult{
+			ID:      id,
+			Status:  status,
+			Message: message,
+		})
+	}
+	sortByValidationResultID(validationsOutput[customValidationsCategory])
+
 	for _, currentResult := range validationsOutput {
 		for _, v := range currentResult {
 			if common.ShouldIgnoreValidation(ignoredValidations, string(v.ID), common.NonIgnorableHostValidations) {
@@ -149,6 +174,18 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 			}
 		}
 	}
+	conditions[AllOperatorsRequirementsSatisfied.String()] = true
+	for _, v := range validationsOutput[operatorsValidationsCategory] {
+		if !conditions[string(v.ID)] {
+			conditions[AllOperatorsRequirementsSatisfied.String()] = false
+		}
+	}
+	conditions[AllCustomValidationsSucceeded.String()] = true
+	for _, v := range validationsOutput[customValidationsCategory] {
+		if !conditions[string(v.ID)] {
+			conditions[AllCustomValidationsSucceeded.String()] = false
+		}
+	}
 	return conditions, validationsOutput, nil
 }
 
@@ -310,6 +347,10 @@ func newValidations(v *validator) []validation {
 			id:        NoIPCollisionsInNetwork,
 			condition: v.noIPCollisionsInNetwork,
 		},
+		{
+			id:        HasSufficientPathMtu,
+			condition: v.sufficientPathMtu,
+		},
 	}
 }
 
@@ -359,6 +400,30 @@ func newConditions(v *validator) []condition {
 			id: ConnectionTimedOut,
 			fn: v.connectionTimedOut,
 		},
+		{
+			id: HostStageTimeoutPolicyDefined,
+			fn: v.isHostStageTimeoutPolicyDefined,
+		},
+		{
+			id: HostStageTimeoutPolicyExpired,
+			fn: v.isHostStageTimeoutPolicyExpired,
+		},
+		{
+			id: HostStageRemediationFail,
+			fn: v.isHostStageRemediation(models.HostStageRemediationFail),
+		},
+		{
+			id: HostStageRemediationRetry,
+			fn: v.isHostStageRemediation(models.HostStageRemediationRetry),
+		},
+		{
+			id: HostStageRemediationReboot,
+			fn: v.isHostStageRemediation(models.HostStageRemediationReboot),
+		},
+		{
+			id: HostStageRemediationPause,
+			fn: v.isHostStageRemediation(models.HostStageRemediationPause),
+		},
 	}
 	return ret
 }
@@ -77,6 +77,7 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			disabledHostValidations,
 			mockProviderRegistry,
 			mockVersions,
+			defaultConfig.HostStageTimeout,
 		)
 	})
 
@@ -216,4 +217,98 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			}
 		})
 	})
+
+	Context("Custom validations", func() {
+		var validationContext *validationContext
+
+		customValidation := func(id, language, expression string) *models.CustomHostValidation {
+			return &models.CustomHostValidation{
+				ID:          swag.String(id),
+				Language:    swag.String(language),
+				Expression:  swag.String(expression),
+				Description: "site requirement",
+			}
+		}
+
+		setCustomValidations := func(target *string, validations ...*models.CustomHostValidation) {
+			validationsStr, err := common.MarshalCustomHostValidations(validations)
+			Expect(err).ToNot(HaveOccurred())
+			*target = validationsStr
+		}
+
+		BeforeEach(func() {
+			createCluster()
+			mockFailAllValidations()
+			host.Inventory = hostutil.GenerateMasterInventory()
+		})
+
+		AfterEach(func() {
+			deleteCluster()
+		})
+
+		preprocess := func() (map[string]bool, ValidationsStatus) {
+			var err error
+			validationContext, err = newValidationContext(ctx, host, cluster, nil, db, inventoryCache, mockHardwareValidator, false, mockS3WrapperAPI, false)
+			Expect(err).ToNot(HaveOccurred())
+			conditions, validations, err := preprocessor.preprocess(ctx, validationContext)
+			Expect(err).ToNot(HaveOccurred())
+			return conditions, validations
+		}
+
+		It("reports the custom validations of the cluster and of the infra-env", func() {
+			setCustomValidations(&cluster.CustomHostValidations,
+				customValidation("custom-min-cpu", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 8"),
+				customValidation("custom-second-nic", models.CustomHostValidationLanguageJsonpath, `{.interfaces[?(@.name=="eth1")].name}`))
+			setCustomValidations(&infraEnv.CustomHostValidations,
+				customValidation("custom-min-cpu", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 64"),
+				customValidation("custom-serial", models.CustomHostValidationLanguageCel, `inventory.system_vendor.serial_number == "3534"`))
+			Expect(db.Save(infraEnv).Error).ToNot(HaveOccurred())
+
+			conditions, validations := preprocess()
+			Expect(validations[customValidationsCategory]).To(Equal(ValidationResults{
+				{ID: "custom-min-cpu", Status: ValidationSuccess, Message: "Custom validation custom-min-cpu passed"},
+				{ID: "custom-second-nic", Status: ValidationFailure, Message: "Custom validation custom-second-nic failed: site requirement"},
+				{ID: "custom-serial", Status: ValidationSuccess, Message: "Custom validation custom-serial passed"},
+			}))
+			Expect(conditions["custom-second-nic"]).To(BeFalse())
+			Expect(conditions[AllCustomValidationsSucceeded.String()]).To(BeFalse())
+		})
+
+		It("succeeds when all the custom validations pass", func() {
+			setCustomValidations(&cluster.CustomHostValidations,
+				customValidation("custom-min-cpu", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 8"))
+
+			conditions, _ := preprocess()
+			Expect(conditions["custom-min-cpu"]).To(BeTrue())
+			Expect(conditions[AllCustomValidationsSucceeded.String()]).To(BeTrue())
+		})
+
+		It("succeeds when the failing custom validations are ignored", func() {
+			setCustomValidations(&cluster.CustomHostValidations,
+				customValidation("custom-min-cpu", models.CustomHostValidationLanguageCel, "inventory.cpu.count >= 64"))
+			cluster.IgnoredHostValidations = `["custom-min-cpu"]`
+
+			conditions, _ := preprocess()
+			Expect(conditions[AllCustomValidationsSucceeded.String()]).To(BeTrue())
+		})
+
+		It("reports the evaluation errors", func() {
+			setCustomValidations(&cluster.CustomHostValidations,
+				customValidation("custom-bios", models.CustomHostValidationLanguageCel, `inventory.bios.version == "2.1"`))
+
+			conditions, validations := preprocess()
+			Expect(validations[customValidationsCategory]).To(HaveLen(1))
+			Expect(validations[customValidationsCategory][0].Status).To(Equal(ValidationError))
+			Expect(conditions[AllCustomValidationsSucceeded.String()]).To(BeFalse())
+		})
+
+		It("ignores the custom validations replacing a condition", func() {
+			setCustomValidations(&cluster.CustomHostValidations,
+				customValidation(SoftTimeoutsEnabled.String(), models.CustomHostValidationLanguageCel, "true"),
+				customValidation(string(models.HostValidationIDHasMinCPUCores), models.CustomHostValidationLanguageCel, "true"))
+
+			_, validations := preprocess()
+			Expect(validations[customValidationsCategory]).To(BeEmpty())
+		})
+	})
 })
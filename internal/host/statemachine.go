@@ -770,6 +770,76 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 			},
 		})
 	}
+	// Host stage timeout policy transitions. When the cluster has a timeout policy for the current stage of the host,
+	// the policy replaces the timeouts of the service, including the wrong boot order handling below, and its
+	// remediation applies when it expires
+
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition: stateswitch.And(
+			If(HostStageTimeoutPolicyDefined),
+			stateswitch.Not(If(HostStageTimeoutPolicyExpired))),
+		DestinationState: stateswitch.State(models.HostStatusInstallingInProgress),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Stay installing while the timeout policy of the stage did not expire",
+			Description: "When the cluster has a timeout policy for the current stage of the host, the timeouts of the service don't apply to the stage",
+		},
+	})
+
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition:        If(HostStageRemediationFail),
+		DestinationState: stateswitch.State(models.HostStatusError),
+		PostTransition:   th.PostHostStageTimeoutRemediation(models.HostStageRemediationFail, statusInfoStageTimeoutRemediationFail),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Move to error when the timeout policy of the stage expires with the fail remediation",
+			Description: "The fail remediation also applies when the retry and reboot remediations exhausted their attempts, and when the host can't be rebooted",
+		},
+	})
+
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition:        If(HostStageRemediationRetry),
+		DestinationState: stateswitch.State(models.HostStatusInstalling),
+		PostTransition:   th.PostHostStageTimeoutRemediation(models.HostStageRemediationRetry, statusInfoStageTimeoutRemediationRetry),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Send the installation to the host again when the timeout policy of the stage expires with the retry remediation",
+			Description: "The host moves back to installing, so that the agent gets the installation command again and runs the installer from its first stage. Only the stages before the reboot of the host can be retried",
+		},
+	})
+
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition:        If(HostStageRemediationReboot),
+		DestinationState: stateswitch.State(models.HostStatusInstallingInProgress),
+		PostTransition:   th.PostHostStageTimeoutRemediation(models.HostStageRemediationReboot, statusInfoStageTimeoutRemediationReboot),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Request a reboot of the host when the timeout policy of the stage expires with the reboot remediation",
+			Description: "The reboot is recorded in the progress of the host, and the host is rebooted via the BMC of its BareMetalHost. The timeout of the stage restarts",
+		},
+	})
+
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition:        If(HostStageRemediationPause),
+		DestinationState: stateswitch.State(models.HostStatusInstallingPendingUserAction),
+		PostTransition:   th.PostHostStageTimeoutRemediation(models.HostStageRemediationPause, statusInfoStageTimeoutRemediationPause),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Wait for user action when the timeout policy of the stage expires with the pause remediation",
+			Description: "The host moves back to installing-in-progress when it reports progress again",
+		},
+	})
+
 	shouldIgnoreInstallationProgressTimeout := stateswitch.And(If(StageInWrongBootStages), If(ClusterPendingUserAction))
 
 	sm.AddTransitionRule(stateswitch.TransitionRule{
@@ -937,6 +1007,8 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(AreCnvRequirementsSatisfied),
 		If(AreLvmRequirementsSatisfied),
 		If(AreMceRequirementsSatisfied),
+		If(AllOperatorsRequirementsSatisfied),
+		If(AllCustomValidationsSucceeded),
 		If(HasSufficientNetworkLatencyRequirementForRole),
 		If(HasSufficientPacketLossRequirementForRole),
 		If(HasDefaultRoute),
@@ -952,6 +1024,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(NoSkipInstallationDisk),
 		If(NoSkipMissingDisk),
 		If(NoIPCollisionsInNetwork),
+		If(HasSufficientPathMtu),
 		/*
 					 * MGMT-15213: The release domain is not resolved correctly when there is a mirror or proxy.  In this case
 					 * validation might fail, but the installation may succeed.
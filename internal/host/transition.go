@@ -54,6 +54,7 @@ type TransitionHandler interface {
 	PostReclaim(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error
 	PostRefreshHost(reason string) stateswitch.PostTransition
 	PostHostStageTimeout(reason string) stateswitch.PostTransition
+	PostHostStageTimeoutRemediation(remediation models.HostStageRemediation, reason string) stateswitch.PostTransition
 	PostRefreshHostDisconnection(statusInfo string, connectionTimedOut bool) stateswitch.PostTransition
 	PostRefreshHostRefreshStageUpdateTime(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error
 	PostRefreshLogsProgress(progress string) stateswitch.PostTransition
@@ -70,14 +71,24 @@ type TransitionHandler interface {
 
 var resetLogsField = []interface{}{"logs_info", "", "logs_started_at", strfmt.DateTime(time.Time{}), "logs_collected_at", strfmt.DateTime(time.Time{})}
 var resetProgressFields = []interface{}{"progress_current_stage", "", "progress_installation_percentage", 0,
-	"progress_progress_info", "", "progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Time{})}
+	"progress_progress_info", "", "progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Time{}),
+	"progress_stage_remediation", "", "progress_stage_remediation_attempts", 0, "progress_stage_remediation_at", strfmt.DateTime(time.Time{})}
 
-var resetFields = append(resetProgressFields, "inventory", "", "bootstrap", false, "images_status", "")
+var resetFields = append(append(resetProgressFields, "inventory", "", "bootstrap", false, "images_status", ""), resetHardwareFields()...)
 var restFieldsOnUnbind = append(append(resetProgressFields, resetLogsField...), "cluster_id", nil, "kind", swag.String(models.HostKindHost), "connectivity", "", "domain_name_resolutions", "",
 	"free_addresses", "", "images_status", "", "installation_disk_id", "", "installation_disk_path", "", "machine_config_pool_name", "",
 	"role", "auto-assign", "api_vip_connectivity", "", "suggested_role", "", "images_status", "",
 	"stage_started_at", strfmt.DateTime(time.Time{}), "stage_updated_at", strfmt.DateTime(time.Time{}))
 
+// resetHardwareFields clears the hardware extracted from the inventory together with the inventory
+func resetHardwareFields() []interface{} {
+	var fields []interface{}
+	for column, value := range (&common.HostHardware{}).Columns() {
+		fields = append(fields, column, value)
+	}
+	return fields
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // RegisterHost
 ////////////////////////////////////////////////////////////////////////////
@@ -792,6 +803,54 @@ func (th *transitionHandler) PostHostStageTimeout(reason string) stateswitch.Pos
 	return ret
 }
 
+// PostHostStageTimeoutRemediation returns a post transition function recording the remediation applied to a host
+// whose stage outlasted the timeout policy of the cluster
+func (th *transitionHandler) PostHostStageTimeoutRemediation(remediation models.HostStageRemediation, reason string) stateswitch.PostTransition {
+	ret := func(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+		// Not using reason directly to avoid closures issue.
+		template := reason
+		sHost, ok := sw.(*stateHost)
+		if !ok {
+			return errors.New("PostHostStageTimeoutRemediation incompatible type of StateSwitch")
+		}
+		params, ok := args.(*TransitionArgsRefreshHost)
+		if !ok {
+			return errors.New("PostHostStageTimeoutRemediation invalid argument")
+		}
+		cluster, err := common.GetClusterFromDB(params.db, *sHost.host.ClusterID, common.SkipEagerLoading)
+		if err != nil {
+			return err
+		}
+		policies, err := common.UnmarshalHostStageTimeoutPolicies(cluster.HostStageTimeoutPolicies)
+		if err != nil {
+			return errors.Wrapf(err, "failed to unmarshal the host stage timeout policies of cluster %s", cluster.ID.String())
+		}
+		stage := sHost.host.Progress.CurrentStage
+		policy := hostutil.GetHostStageTimeoutPolicy(policies, stage)
+		if policy == nil {
+			return errors.Errorf("cluster %s has no timeout policy for host stage %s", cluster.ID.String(), stage)
+		}
+
+		timeout := hostutil.GetHostStageTimeout(policy, th.config.HostStageTimeout(stage))
+		statusInfo := th.replaceMacros(strings.Replace(template, "$MAX_TIME", timeout.String(), 1), sHost, params)
+		var extra []interface{}
+		switch remediation {
+		case models.HostStageRemediationRetry:
+			// the installer runs again from its first stage, which can't follow the current stage
+			extra = append(extra, "progress_current_stage", "", "progress_progress_info", "",
+				"progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Now()))
+		case models.HostStageRemediationReboot:
+			// restart the timeout of the stage
+			extra = append(extra, "progress_stage_updated_at", strfmt.DateTime(time.Now()))
+		}
+		_, err = hostutil.UpdateHostStageTimeoutRemediation(params.ctx, logutil.FromContext(params.ctx, th.log), params.db,
+			th.eventsHandler, th.stream, sHost.host.InfraEnvID, *sHost.host.ID, sHost.srcState, swag.StringValue(sHost.host.Status),
+			statusInfo, stage, remediation, sHost.host.Progress.StageRemediationAttempts+1, int64(timeout.Minutes()), extra...)
+		return err
+	}
+	return ret
+}
+
 func (th *transitionHandler) PostRefreshHostDisconnection(statusInfo string, connectionTimedOut bool) stateswitch.PostTransition {
 	return func(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
 		sHost, ok := sw.(*stateHost)
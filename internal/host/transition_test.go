@@ -6555,6 +6555,7 @@ var allValidationIDs = []validationID{
 	NoSkipInstallationDisk,
 	NoSkipMissingDisk,
 	NoIPCollisionsInNetwork,
+	HasSufficientPathMtu,
 	IsReleaseDomainNameResolvedCorrectly,
 }
 
@@ -6567,6 +6568,8 @@ var allConditions = []conditionId{
 	StageInWrongBootStages,
 	ClusterInError,
 	SuccessfulContainerImageAvailability,
+	AllOperatorsRequirementsSatisfied,
+	AllCustomValidationsSucceeded,
 }
 
 var knownStateConditions map[string]bool
@@ -6578,6 +6581,8 @@ func initKnownStateConditions() {
 	}
 
 	knownStateConditions[string(ValidRoleForInstallation)] = true
+	knownStateConditions[string(AllOperatorsRequirementsSatisfied)] = true
+	knownStateConditions[string(AllCustomValidationsSucceeded)] = true
 }
 
 func init() {
@@ -6638,6 +6643,9 @@ var _ = Describe("State machine test - refresh transition", func() {
 		mockTransitionHandler.EXPECT().PostHostStageTimeout(gomock.Any()).Return(
 			func(_ stateswitch.StateSwitch, _ stateswitch.TransitionArgs) error { return nil },
 		).AnyTimes()
+		mockTransitionHandler.EXPECT().PostHostStageTimeoutRemediation(gomock.Any(), gomock.Any()).Return(
+			func(_ stateswitch.StateSwitch, _ stateswitch.TransitionArgs) error { return nil },
+		).AnyTimes()
 		mockTransitionHandler.EXPECT().PostRefreshHostDisconnection(gomock.Any(), gomock.Any()).Return(
 			func(_ stateswitch.StateSwitch, _ stateswitch.TransitionArgs) error { return nil },
 		).AnyTimes()
@@ -6684,6 +6692,18 @@ var _ = Describe("State machine test - refresh transition", func() {
 			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
 		})
 
+		It("Moves from known to insufficient when a custom validation fails", func() {
+			refreshHostArgs.conditions[string(AllCustomValidationsSucceeded)] = false
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInsufficient))
+
+			refreshHostArgs.conditions[string(AllCustomValidationsSucceeded)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
+		})
+
 		It("Moves from known to insufficient when disk skip validations fail - no skip missing disk", func() {
 			refreshHostArgs.conditions[string(NoSkipMissingDisk)] = false
 
@@ -6708,6 +6728,76 @@ var _ = Describe("State machine test - refresh transition", func() {
 			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
 			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
 		})
+
+		It("Moves from known to insufficient when the path MTU validation fails", func() {
+			refreshHostArgs.conditions[string(HasSufficientPathMtu)] = false
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInsufficient))
+
+			refreshHostArgs.conditions[string(HasSufficientPathMtu)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
+		})
+	})
+
+	Context("Host in installing-in-progress state with a stage timeout policy", func() {
+		remediationConditions := []conditionId{
+			HostStageRemediationFail,
+			HostStageRemediationRetry,
+			HostStageRemediationReboot,
+			HostStageRemediationPause,
+		}
+
+		BeforeEach(func() {
+			testState = newTestState(models.HostStatusInstallingInProgress)
+			mockTransitionHandler.EXPECT().IsDay2Host(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+			conditions := make(map[string]bool)
+			for _, condition := range append(allConditions, SoftTimeoutsEnabled, ConnectionTimedOut, HostStageTimeoutPolicyExpired) {
+				conditions[string(condition)] = false
+			}
+			for _, condition := range remediationConditions {
+				conditions[string(condition)] = false
+			}
+			conditions[string(HostStageTimeoutPolicyDefined)] = true
+			refreshHostArgs = initializeRefreshHostArgs(allValidationIDs, ValidationSuccess, conditions)
+		})
+
+		It("stays installing while the policy did not expire", func() {
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInstallingInProgress))
+		})
+
+		It("applies the policy instead of ignoring the timeout of a wrong boot order", func() {
+			refreshHostArgs.conditions[string(StageInWrongBootStages)] = true
+			refreshHostArgs.conditions[string(ClusterPendingUserAction)] = true
+			refreshHostArgs.conditions[string(HostStageTimeoutPolicyExpired)] = true
+			refreshHostArgs.conditions[string(HostStageRemediationFail)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusError))
+		})
+
+		for _, t := range []struct {
+			condition   conditionId
+			destination string
+		}{
+			{condition: HostStageRemediationFail, destination: models.HostStatusError},
+			{condition: HostStageRemediationRetry, destination: models.HostStatusInstalling},
+			{condition: HostStageRemediationReboot, destination: models.HostStatusInstallingInProgress},
+			{condition: HostStageRemediationPause, destination: models.HostStatusInstallingPendingUserAction},
+		} {
+			t := t
+			It(fmt.Sprintf("moves to %s when the policy expires with condition %s", t.destination, t.condition), func() {
+				refreshHostArgs.conditions[string(HostStageTimeoutPolicyExpired)] = true
+				refreshHostArgs.conditions[string(t.condition)] = true
+
+				Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+				Expect(string(testState.State())).To(Equal(t.destination))
+			})
+		}
 	})
 
 })
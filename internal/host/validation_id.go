@@ -51,8 +51,13 @@ const (
 	NoSkipInstallationDisk                         = validationID(models.HostValidationIDNoSkipInstallationDisk)
 	NoSkipMissingDisk                              = validationID(models.HostValidationIDNoSkipMissingDisk)
 	NoIPCollisionsInNetwork                        = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
+	HasSufficientPathMtu                           = validationID(models.HostValidationIDSufficientPathMtu)
+	AreOperatorPluginsRequirementsSatisfied        = validationID(models.HostValidationIDOperatorPluginsRequirementsSatisfied)
 )
 
+// operatorsValidationsCategory is the category of all the validations reported by the operators manager
+const operatorsValidationsCategory = "operators"
+
 func (v validationID) category() (string, error) {
 	switch v {
 	case IsConnected,
@@ -74,7 +79,8 @@ func (v validationID) category() (string, error) {
 		IsDNSWildcardNotConfigured,
 		NonOverlappingSubnets,
 		IsReleaseDomainNameResolvedCorrectly,
-		NoIPCollisionsInNetwork:
+		NoIPCollisionsInNetwork,
+		HasSufficientPathMtu:
 		return "network", nil
 	case HasInventory,
 		HasMinCPUCores,
@@ -96,8 +102,9 @@ func (v validationID) category() (string, error) {
 		AreOdfRequirementsSatisfied,
 		AreCnvRequirementsSatisfied,
 		AreLvmRequirementsSatisfied,
-		AreMceRequirementsSatisfied:
-		return "operators", nil
+		AreMceRequirementsSatisfied,
+		AreOperatorPluginsRequirementsSatisfied:
+		return operatorsValidationsCategory, nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
 }
@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	ignition_types "github.com/coreos/ignition/v2/config/v3_2/types"
 	"github.com/go-openapi/strfmt"
@@ -21,6 +22,7 @@ import (
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/feature"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -2212,6 +2214,182 @@ var _ = Describe("Validations test", func() {
 		})
 	})
 
+	Context("Host stage timeout policy conditions", func() {
+
+		hostValidator := validator{
+			log:              common.GetTestLog(),
+			hostStageTimeout: func(models.HostStage) time.Duration { return time.Hour },
+		}
+
+		newContext := func(stageUpdatedAt time.Time, attempts int64, policies ...*models.HostStageTimeoutPolicy) *validationContext {
+			policiesStr, err := common.MarshalHostStageTimeoutPolicies(policies)
+			Expect(err).ToNot(HaveOccurred())
+			clusterId := strfmt.UUID(uuid.New().String())
+			return &validationContext{
+				host: &models.Host{Progress: &models.HostProgressInfo{
+					CurrentStage:             models.HostStageRebooting,
+					StageUpdatedAt:           strfmt.DateTime(stageUpdatedAt),
+					StageRemediationAttempts: attempts,
+				}},
+				cluster:        &common.Cluster{Cluster: models.Cluster{ID: &clusterId, HostStageTimeoutPolicies: policiesStr}},
+				kubeApiEnabled: true,
+				bareMetalHost:  swag.Bool(true),
+			}
+		}
+
+		policy := func(stage models.HostStage, remediation models.HostStageRemediation, timeoutSeconds int64) *models.HostStageTimeoutPolicy {
+			return &models.HostStageTimeoutPolicy{Stage: &stage, Remediation: &remediation, TimeoutSeconds: timeoutSeconds, MaxAttempts: 2}
+		}
+
+		It("ignores the policies of the other stages", func() {
+			c := newContext(time.Now().Add(-2*time.Hour), 0, policy(models.HostStageConfiguring, models.HostStageRemediationRetry, 0))
+			Expect(hostValidator.isHostStageTimeoutPolicyDefined(c)).To(BeFalse())
+			Expect(hostValidator.isHostStageTimeoutPolicyExpired(c)).To(BeFalse())
+		})
+
+		It("uses the timeout of the policy", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 0, policy(models.HostStageRebooting, models.HostStageRemediationReboot, 600))
+			Expect(hostValidator.isHostStageTimeoutPolicyDefined(c)).To(BeTrue())
+			Expect(hostValidator.isHostStageTimeoutPolicyExpired(c)).To(BeTrue())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationReboot)(c)).To(BeTrue())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationFail)(c)).To(BeFalse())
+		})
+
+		It("uses the timeout of the service when the policy doesn't set one", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 0, policy(models.HostStageRebooting, models.HostStageRemediationReboot, 0))
+			Expect(hostValidator.isHostStageTimeoutPolicyExpired(c)).To(BeFalse())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationReboot)(c)).To(BeFalse())
+		})
+
+		It("fails the host once the attempts are exhausted", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 2, policy(models.HostStageRebooting, models.HostStageRemediationReboot, 600))
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationReboot)(c)).To(BeFalse())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationFail)(c)).To(BeTrue())
+		})
+
+		It("fails the host instead of retrying a stage following the reboot", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 0, policy(models.HostStageRebooting, models.HostStageRemediationRetry, 600))
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationRetry)(c)).To(BeFalse())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationFail)(c)).To(BeTrue())
+		})
+
+		It("fails the host instead of rebooting it without a BareMetalHost", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 0, policy(models.HostStageRebooting, models.HostStageRemediationReboot, 600))
+			c.bareMetalHost = swag.Bool(false)
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationReboot)(c)).To(BeFalse())
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationFail)(c)).To(BeTrue())
+		})
+
+		It("fails the host instead of rebooting it without the kube API", func() {
+			c := newContext(time.Now().Add(-15*time.Minute), 0, policy(models.HostStageRebooting, models.HostStageRemediationReboot, 600))
+			c.kubeApiEnabled = false
+			Expect(hostValidator.isHostStageRemediation(models.HostStageRemediationFail)(c)).To(BeTrue())
+		})
+	})
+
+	Context("SufficientPathMtu", func() {
+
+		hostValidator := validator{log: common.GetTestLog(), hwValidatorCfg: &hardware.ValidatorCfg{Flags: feature.Flags{EnableMtuPathCheck: true}}}
+
+		validate := func(clusterHosts int, results ...*models.MtuPathResult) (ValidationStatus, string) {
+			hostId := strfmt.UUID(uuid.New().String())
+			host := &models.Host{ID: &hostId, Kind: swag.String(models.HostKindHost)}
+			if results != nil {
+				b, err := json.Marshal(&models.MtuPathCheckResponse{Results: results})
+				Expect(err).ToNot(HaveOccurred())
+				host.MtuPathReport = string(b)
+			}
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				MachineNetworks: common.TestIPv4Networking.MachineNetworks,
+				NetworkType:     swag.String(models.ClusterNetworkTypeOVNKubernetes),
+				Hosts:           []*models.Host{host},
+			}}
+			inventory, err := json.Marshal(&models.Inventory{
+				Interfaces: []*models.Interface{{Name: "eth0", Mtu: 9000, IPV4Addresses: []string{"1.2.3.5/24"}}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			for i := 1; i < clusterHosts; i++ {
+				remoteHostId := strfmt.UUID(uuid.New().String())
+				cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &remoteHostId, Status: swag.String(models.HostStatusKnown), Inventory: string(inventory)})
+			}
+			return hostValidator.sufficientPathMtu(&validationContext{
+				host:      host,
+				cluster:   cluster,
+				inventory: &models.Inventory{Interfaces: []*models.Interface{{Name: "eth0", Mtu: 9000}}},
+			})
+		}
+
+		It("is pending when the path MTU has not been measured yet", func() {
+			status, message := validate(3)
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("The path MTU has not been measured yet."))
+		})
+
+		It("succeeds when the path MTU check is disabled", func() {
+			hostId := strfmt.UUID(uuid.New().String())
+			disabledValidator := validator{log: common.GetTestLog(), hwValidatorCfg: &hardware.ValidatorCfg{}}
+			status, message := disabledValidator.sufficientPathMtu(&validationContext{
+				host:      &models.Host{ID: &hostId},
+				cluster:   &common.Cluster{},
+				inventory: &models.Inventory{},
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Path MTU checking is disabled"))
+		})
+
+		It("succeeds when there is no other host in the machine networks to measure the path MTU to", func() {
+			hostId := strfmt.UUID(uuid.New().String())
+			remoteHostId := strfmt.UUID(uuid.New().String())
+			status, _ := hostValidator.sufficientPathMtu(&validationContext{
+				host:      &models.Host{ID: &hostId},
+				cluster:   &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{{ID: &hostId}, {ID: &remoteHostId, Inventory: "{}"}}}},
+				inventory: &models.Inventory{},
+			})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("succeeds for a single host cluster", func() {
+			status, _ := validate(1, &models.MtuPathResult{OutgoingNic: "eth0", PathMtu: 1500})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("succeeds when the path carries the interface MTU", func() {
+			status, message := validate(3,
+				&models.MtuPathResult{OutgoingNic: "eth0", PathMtu: 9000},
+				&models.MtuPathResult{OutgoingNic: "eth0", PathMtu: 9000})
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The path MTU requirement has been satisfied."))
+		})
+
+		It("fails when a remote host is unreachable", func() {
+			remoteHostId := strfmt.UUID(uuid.New().String())
+			status, message := validate(3,
+				&models.MtuPathResult{OutgoingNic: "eth0", PathMtu: 9000},
+				&models.MtuPathResult{RemoteHostID: remoteHostId, RemoteIPAddress: "1.2.3.5", OutgoingNic: "eth0", Error: "unreachable"})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring(fmt.Sprintf("host %s (1.2.3.5) is unreachable through interface eth0: unreachable", remoteHostId)))
+		})
+
+		It("fails when the path MTU is lower than the interface MTU", func() {
+			remoteHostId := strfmt.UUID(uuid.New().String())
+			status, message := validate(3, &models.MtuPathResult{RemoteHostID: remoteHostId, RemoteIPAddress: "1.2.3.5", OutgoingNic: "eth0", PathMtu: 1500})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring(fmt.Sprintf("the path MTU to host %s (1.2.3.5) through interface eth0 is 1500, leaving 1400 bytes "+
+				"to the cluster network instead of the 8900 bytes of the interface MTU 9000", remoteHostId)))
+			Expect(message).To(ContainSubstring("adds 100 bytes"))
+		})
+
+		It("fails on a malformed report", func() {
+			hostId := strfmt.UUID(uuid.New().String())
+			status, _ := hostValidator.sufficientPathMtu(&validationContext{
+				host:      &models.Host{ID: &hostId, MtuPathReport: "not a report"},
+				cluster:   &common.Cluster{Cluster: models.Cluster{Hosts: []*models.Host{{}, {}}}},
+				inventory: &models.Inventory{},
+			})
+			Expect(status).To(Equal(ValidationError))
+		})
+	})
+
 	Context("Has Min Valid Disks", func() {
 		var (
 			host    models.Host
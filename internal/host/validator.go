@@ -96,6 +96,7 @@ type validationContext struct {
 	minRAMMibRequirement    int64
 	kubeApiEnabled          bool
 	softTimeoutsEnabled     bool
+	bareMetalHost           *bool // loaded on demand by hasBareMetalHost
 	objectHandler           s3wrapper.API
 	ctx                     context.Context
 }
@@ -286,6 +287,7 @@ type validator struct {
 	operatorsAPI     operators.API
 	providerRegistry registry.ProviderRegistry
 	versionHandler   versions.Handler
+	hostStageTimeout func(models.HostStage) time.Duration
 }
 
 func (v *validator) isMediaConnected(c *validationContext) (ValidationStatus, string) {
@@ -1804,6 +1806,80 @@ func (v *validator) noIPCollisionsInNetwork(c *validationContext) (ValidationSta
 	return ValidationSuccess, fmt.Sprintf("No IP collisions were detected by host %s", c.host.ID)
 }
 
+// hasMtuPathRemoteHosts returns true when the path MTU check step is sent to the host, that is when another host of the
+// cluster has an address in the machine networks
+func (v *validator) hasMtuPathRemoteHosts(c *validationContext) bool {
+	for _, h := range c.cluster.Hosts {
+		if h.ID.String() == c.host.ID.String() || h.Inventory == "" ||
+			funk.ContainsString([]string{models.HostStatusDiscovering, models.HostStatusDisconnected}, swag.StringValue(h.Status)) {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			continue
+		}
+		if network.GetMachineNetworksMtu(inventory, c.cluster.MachineNetworks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) sufficientPathMtu(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if !v.hwValidatorCfg.EnableMtuPathCheck {
+		return ValidationSuccess, "Path MTU checking is disabled"
+	}
+	if c.inventory == nil {
+		return ValidationPending, "The inventory is not available yet."
+	}
+	if len(c.cluster.Hosts) == 1 || hostutil.IsDay2Host(c.host) {
+		return ValidationSuccess, "The path MTU requirement has been satisfied."
+	}
+	if c.host.MtuPathReport == "" {
+		// Without machine networks, or other hosts in them, the path MTU isn't measured
+		if !v.hasMtuPathRemoteHosts(c) {
+			return ValidationSuccess, "The path MTU requirement has been satisfied."
+		}
+		return ValidationPending, "The path MTU has not been measured yet."
+	}
+	report, err := network.UnmarshalMtuPathReport(c.host.MtuPathReport)
+	if err != nil {
+		message := "Unable to unmarshal the path MTU report of the host"
+		v.log.WithError(err).Errorf("%s %s", message, c.host.ID)
+		return ValidationError, message
+	}
+
+	// The pods use the MTU of the interface minus the encapsulation of the network type, and the encapsulated
+	// packets must reach the other hosts without fragmentation, so the MTU left to the cluster network on every path
+	// must not be lower than the one of the interface
+	overhead := network.GetMtuOverhead(c.cluster.NetworkType)
+	var failures []string
+	for _, result := range report.Results {
+		if result.PathMtu == 0 {
+			failures = append(failures, fmt.Sprintf("host %s (%s) is unreachable through interface %s: %s",
+				result.RemoteHostID, result.RemoteIPAddress, result.OutgoingNic, result.Error))
+			continue
+		}
+		interfaceMtu := network.GetInterfaceMtu(c.inventory, result.OutgoingNic)
+		clusterNetworkMtu := network.GetClusterNetworkMtu(interfaceMtu, c.cluster.NetworkType)
+		if pathClusterNetworkMtu := network.GetClusterNetworkMtu(result.PathMtu, c.cluster.NetworkType); pathClusterNetworkMtu < clusterNetworkMtu {
+			failures = append(failures, fmt.Sprintf("the path MTU to host %s (%s) through interface %s is %d, leaving %d bytes to the cluster network "+
+				"instead of the %d bytes of the interface MTU %d", result.RemoteHostID, result.RemoteIPAddress, result.OutgoingNic, result.PathMtu,
+				pathClusterNetworkMtu, clusterNetworkMtu, interfaceMtu))
+		}
+	}
+	if len(failures) > 0 {
+		return ValidationFailure, fmt.Sprintf("The path MTU is insufficient for the cluster network: %s. The cluster network adds %d bytes of "+
+			"encapsulation to the packets and the pods use the MTU of the interface minus %d bytes, so packets of the interface MTU must "+
+			"reach the other hosts without fragmentation. Lower the MTU of the interface or raise the MTU of the network devices on the path.",
+			strings.Join(failures, "; "), overhead, overhead)
+	}
+	return ValidationSuccess, "The path MTU requirement has been satisfied."
+}
+
 func (v *validator) inventoryHasIP(inventory *models.Inventory, ipAddress string) (bool, error) {
 	ip := net.ParseIP(ipAddress)
 	if ip != nil {
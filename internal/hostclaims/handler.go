@@ -0,0 +1,229 @@
+package hostclaims
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/host_claims"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// clusterStatusesBeforeInstallation are the states of the clusters whose claims are served by the scheduler
+var clusterStatusesBeforeInstallation = []string{
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPendingForInput,
+}
+
+var _ restapi.HostClaimsAPI = (*Handler)(nil)
+
+// NewHandler returns the host claims handler
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, authzHandler auth.Authorizer) *Handler {
+	return &Handler{
+		log:          log,
+		db:           db,
+		authzHandler: authzHandler,
+	}
+}
+
+// Handler represents the host claims handler
+type Handler struct {
+	log          logrus.FieldLogger
+	db           *gorm.DB
+	authzHandler auth.Authorizer
+}
+
+func (h *Handler) V2GetHostClaim(ctx context.Context, params operations.V2GetHostClaimParams) middleware.Responder {
+	claim, err := getHostClaim(h.db, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetHostClaimOK().WithPayload(claim)
+}
+
+func (h *Handler) V2SetHostClaim(ctx context.Context, params operations.V2SetHostClaimParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	spec := params.HostClaimSpec
+
+	cluster, err := common.GetClusterFromDB(h.db, params.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(clusterError(params.ClusterID, err))
+	}
+	if !funk.ContainsString(clusterStatusesBeforeInstallation, swag.StringValue(cluster.Status)) {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s is in %s state, hosts can only be claimed in one of %s states",
+			params.ClusterID, swag.StringValue(cluster.Status), clusterStatusesBeforeInstallation))
+	}
+
+	var claim *models.HostClaim
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err = h.validateSpec(ctx, tx, cluster, spec); err != nil {
+			return err
+		}
+		now := time.Now()
+		claim = &models.HostClaim{}
+		if err = tx.Take(claim, "cluster_id = ?", params.ClusterID.String()).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+			claim = &models.HostClaim{
+				ClusterID: cluster.ID,
+				UserName:  cluster.UserName,
+				OrgID:     cluster.OrgID,
+				Status:    swag.String(models.HostClaimStatusPending),
+				CreatedAt: now,
+			}
+		} else if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		claim.Spec = spec
+		claim.UpdatedAt = now
+		if swag.BoolValue(spec.Paused) {
+			claim.Status = swag.String(models.HostClaimStatusPaused)
+		} else if swag.StringValue(claim.Status) == models.HostClaimStatusPaused {
+			claim.Status = swag.String(models.HostClaimStatusPending)
+		}
+		if err = tx.Save(claim).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to set the host claim of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Set the host claim of cluster %s", params.ClusterID)
+	return operations.NewV2SetHostClaimOK().WithPayload(claim)
+}
+
+func (h *Handler) V2DeleteHostClaim(ctx context.Context, params operations.V2DeleteHostClaimParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	claim, err := getHostClaim(h.db, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = h.db.Delete(claim).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete the host claim of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Deleted the host claim of cluster %s", params.ClusterID)
+	return operations.NewV2DeleteHostClaimNoContent()
+}
+
+func (h *Handler) V2ListHostClaims(ctx context.Context, params operations.V2ListHostClaimsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	var claims models.HostClaimList
+	if err := h.authzHandler.OwnedBy(ctx, h.db).Order("created_at").Find(&claims).Error; err != nil {
+		log.WithError(err).Error("failed to list host claims")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	sortClaims(claims)
+	return operations.NewV2ListHostClaimsOK().WithPayload(claims)
+}
+
+// validateSpec checks that the hosts of the infra-envs of the claim can be bound to the cluster, and that the
+// reserved hosts belong to these infra-envs and aren't reserved by another claim
+func (h *Handler) validateSpec(ctx context.Context, db *gorm.DB, cluster *common.Cluster, spec *models.HostClaimSpec) error {
+	if spec.Masters == nil && spec.Workers == nil {
+		return common.NewApiError(http.StatusBadRequest, errors.New("the host claim requests neither masters nor workers"))
+	}
+	if spec.Masters != nil && swag.Int64Value(spec.Masters.Count) > 0 && swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("cluster %s is a day-2 cluster, masters can't be claimed", cluster.ID))
+	}
+	for _, role := range []*models.HostClaimRole{spec.Masters, spec.Workers} {
+		if role == nil {
+			continue
+		}
+		if err := selectorFilter(role.Selector).Validate(); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	infraEnvIDs := uniqueIDs(spec.InfraEnvIds)
+	var infraEnvs []*common.InfraEnv
+	if err := h.authzHandler.OwnedBy(ctx, db).Select("id", "cluster_id", "cpu_architecture").
+		Where("id IN (?)", infraEnvIDs).Find(&infraEnvs).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	found := make(map[strfmt.UUID]*common.InfraEnv)
+	for _, infraEnv := range infraEnvs {
+		found[*infraEnv.ID] = infraEnv
+	}
+	for _, id := range infraEnvIDs {
+		infraEnv, ok := found[id]
+		if !ok {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("infra-env %s not found", id))
+		}
+		if infraEnv.ClusterID != "" {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("infra-env %s is bound to cluster %s, its hosts can't be claimed", id, infraEnv.ClusterID))
+		}
+		if cluster.CPUArchitecture != "" && cluster.CPUArchitecture != common.MultiCPUArchitecture && cluster.CPUArchitecture != infraEnv.CPUArchitecture {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("the CPU architecture of infra-env %s (%s) doesn't match the cluster (%s)",
+				id, infraEnv.CPUArchitecture, cluster.CPUArchitecture))
+		}
+	}
+
+	reservedIDs := uniqueIDs(spec.ReservedHostIds)
+	if len(reservedIDs) == 0 {
+		return nil
+	}
+	var count int64
+	if err := db.Model(&common.Host{}).Where("id IN (?) AND infra_env_id IN (?)", reservedIDs, infraEnvIDs).Count(&count).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if count != int64(len(reservedIDs)) {
+		return common.NewApiError(http.StatusBadRequest, errors.New("the reserved hosts must be hosts of the infra-envs of the claim"))
+	}
+	reservations, err := loadReservations(db)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	for _, id := range reservedIDs {
+		if clusterID, ok := reservations[id]; ok && clusterID != *cluster.ID {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is already reserved for cluster %s", id, clusterID))
+		}
+	}
+	return nil
+}
+
+func getHostClaim(db *gorm.DB, clusterID strfmt.UUID) (*models.HostClaim, error) {
+	var claim models.HostClaim
+	if err := db.Take(&claim, "cluster_id = ?", clusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s has no host claim", clusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &claim, nil
+}
+
+func clusterError(clusterID strfmt.UUID, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s not found", clusterID))
+	}
+	return common.NewApiError(http.StatusInternalServerError, err)
+}
+
+func uniqueIDs(ids []strfmt.UUID) []strfmt.UUID {
+	var unique []strfmt.UUID
+	seen := make(map[strfmt.UUID]bool)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
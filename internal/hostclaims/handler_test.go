@@ -0,0 +1,219 @@
+package hostclaims
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/host_claims"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+func createCluster(db *gorm.DB, orgID, status string) strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	cluster := &common.Cluster{Cluster: models.Cluster{
+		ID:              &id,
+		Kind:            swag.String(models.ClusterKindCluster),
+		Status:          swag.String(status),
+		CPUArchitecture: common.X86CPUArchitecture,
+		OrgID:           orgID,
+		UserName:        "jdoe",
+	}}
+	Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+	return id
+}
+
+func createInfraEnv(db *gorm.DB, orgID string) strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &id, CPUArchitecture: common.X86CPUArchitecture, OrgID: orgID, UserName: "jdoe"}}
+	Expect(db.Create(infraEnv).Error).ToNot(HaveOccurred())
+	return id
+}
+
+func createHost(db *gorm.DB, infraEnvID strfmt.UUID, cpuCores int64, memoryGib int64, disks ...*models.Disk) strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	host := &common.Host{
+		Host: models.Host{
+			ID:         &id,
+			InfraEnvID: infraEnvID,
+			Status:     swag.String(models.HostStatusKnownUnbound),
+			Role:       models.HostRoleAutoAssign,
+		},
+		Hardware: *common.NewHostHardware(&models.Inventory{
+			Hostname: "host-" + id.String()[:8],
+			CPU:      &models.CPU{Architecture: common.X86CPUArchitecture, Count: cpuCores},
+			Memory:   &models.Memory{PhysicalBytes: conversions.GibToBytes(memoryGib)},
+			Disks:    disks,
+		}),
+	}
+	Expect(db.Create(host).Error).ToNot(HaveOccurred())
+	return id
+}
+
+var _ = Describe("Host claims handler", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		handler    *Handler
+		ctx        context.Context
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		handler = NewHandler(common.GetTestLog(), db, auth.NewAuthzHandler(cfg, nil, logrus.New(), db))
+		ctx = userContext("jdoe", "org1")
+		clusterID = createCluster(db, "org1", models.ClusterStatusInsufficient)
+		infraEnvID = createInfraEnv(db, "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	spec := func(infraEnvIDs ...strfmt.UUID) *models.HostClaimSpec {
+		return &models.HostClaimSpec{
+			InfraEnvIds: infraEnvIDs,
+			Masters:     &models.HostClaimRole{Count: swag.Int64(3), Selector: &models.HostSelector{MinCPUCores: swag.Int64(16)}},
+			Workers:     &models.HostClaimRole{Count: swag.Int64(2), Selector: &models.HostSelector{DiskTypes: []string{common.DiskTypeNvme}}},
+		}
+	}
+
+	set := func(clusterID strfmt.UUID, spec *models.HostClaimSpec) middleware.Responder {
+		return handler.V2SetHostClaim(ctx, operations.V2SetHostClaimParams{ClusterID: clusterID, HostClaimSpec: spec})
+	}
+
+	It("sets and gets the host claim of a cluster", func() {
+		response := set(clusterID, spec(infraEnvID))
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		created := response.(*operations.V2SetHostClaimOK).Payload
+		Expect(*created.ClusterID).To(Equal(clusterID))
+		Expect(*created.Status).To(Equal(models.HostClaimStatusPending))
+		Expect(created.OrgID).To(Equal("org1"))
+
+		response = handler.V2GetHostClaim(ctx, operations.V2GetHostClaimParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2GetHostClaimOK()))
+		claim := response.(*operations.V2GetHostClaimOK).Payload
+		Expect(claim.Spec.InfraEnvIds).To(Equal([]strfmt.UUID{infraEnvID}))
+		Expect(*claim.Spec.Masters.Count).To(BeEquivalentTo(3))
+		Expect(claim.Spec.Workers.Selector.DiskTypes).To(Equal([]string{common.DiskTypeNvme}))
+	})
+
+	It("replaces the host claim of a cluster", func() {
+		Expect(set(clusterID, spec(infraEnvID))).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		time.Sleep(time.Millisecond)
+
+		updated := spec(infraEnvID)
+		updated.Workers.Count = swag.Int64(5)
+		updated.Paused = swag.Bool(true)
+		response := set(clusterID, updated)
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		claim := response.(*operations.V2SetHostClaimOK).Payload
+		Expect(*claim.Spec.Workers.Count).To(BeEquivalentTo(5))
+		Expect(*claim.Status).To(Equal(models.HostClaimStatusPaused))
+		Expect(claim.UpdatedAt).To(BeTemporally(">", claim.CreatedAt))
+
+		var count int64
+		Expect(db.Model(&models.HostClaim{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeEquivalentTo(1))
+	})
+
+	It("deletes the host claim of a cluster", func() {
+		Expect(set(clusterID, spec(infraEnvID))).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		response := handler.V2DeleteHostClaim(ctx, operations.V2DeleteHostClaimParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2DeleteHostClaimNoContent()))
+		verifyApiError(handler.V2GetHostClaim(ctx, operations.V2GetHostClaimParams{ClusterID: clusterID}), http.StatusNotFound)
+		verifyApiError(handler.V2DeleteHostClaim(ctx, operations.V2DeleteHostClaimParams{ClusterID: clusterID}), http.StatusNotFound)
+	})
+
+	It("lists the host claims of the tenant by priority", func() {
+		lowPriority := spec(infraEnvID)
+		Expect(set(clusterID, lowPriority)).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		highPriorityClusterID := createCluster(db, "org1", models.ClusterStatusReady)
+		highPriority := spec(infraEnvID)
+		highPriority.Priority = 10
+		Expect(set(highPriorityClusterID, highPriority)).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		Expect(db.Create(&models.HostClaim{
+			ClusterID: common.StrFmtUUIDPtr(createCluster(db, "org2", models.ClusterStatusReady)),
+			Spec:      spec(createInfraEnv(db, "org2")),
+			Status:    swag.String(models.HostClaimStatusPending),
+			OrgID:     "org2",
+		}).Error).ToNot(HaveOccurred())
+
+		response := handler.V2ListHostClaims(ctx, operations.V2ListHostClaimsParams{})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2ListHostClaimsOK()))
+		claims := response.(*operations.V2ListHostClaimsOK).Payload
+		Expect(claims).To(HaveLen(2))
+		Expect(*claims[0].ClusterID).To(Equal(highPriorityClusterID))
+		Expect(*claims[1].ClusterID).To(Equal(clusterID))
+	})
+
+	It("rejects the claims of a cluster being installed", func() {
+		verifyApiError(set(createCluster(db, "org1", models.ClusterStatusInstalling), spec(infraEnvID)), http.StatusConflict)
+	})
+
+	It("rejects the claims of a missing cluster", func() {
+		verifyApiError(set(strfmt.UUID(uuid.New().String()), spec(infraEnvID)), http.StatusNotFound)
+	})
+
+	It("rejects invalid claims", func() {
+		noRole := spec(infraEnvID)
+		noRole.Masters = nil
+		noRole.Workers = nil
+		verifyApiError(set(clusterID, noRole), http.StatusBadRequest)
+
+		invalidSelector := spec(infraEnvID)
+		invalidSelector.Masters.Selector = &models.HostSelector{MinMemoryMib: swag.Int64(2048), MaxMemoryMib: swag.Int64(1024)}
+		verifyApiError(set(clusterID, invalidSelector), http.StatusBadRequest)
+
+		verifyApiError(set(clusterID, spec(createInfraEnv(db, "org2"))), http.StatusBadRequest)
+
+		boundInfraEnvID := createInfraEnv(db, "org1")
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", boundInfraEnvID.String()).Update("cluster_id", clusterID).Error).ToNot(HaveOccurred())
+		verifyApiError(set(clusterID, spec(boundInfraEnvID)), http.StatusBadRequest)
+
+		armInfraEnvID := createInfraEnv(db, "org1")
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", armInfraEnvID.String()).Update("cpu_architecture", common.ARM64CPUArchitecture).Error).ToNot(HaveOccurred())
+		verifyApiError(set(clusterID, spec(armInfraEnvID)), http.StatusBadRequest)
+
+		foreignHost := spec(infraEnvID)
+		foreignHost.ReservedHostIds = []strfmt.UUID{createHost(db, createInfraEnv(db, "org1"), 8, 32)}
+		verifyApiError(set(clusterID, foreignHost), http.StatusBadRequest)
+	})
+
+	It("rejects the hosts reserved by another claim", func() {
+		hostID := createHost(db, infraEnvID, 8, 32)
+		reserving := spec(infraEnvID)
+		reserving.ReservedHostIds = []strfmt.UUID{hostID}
+		Expect(set(clusterID, reserving)).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+		Expect(set(clusterID, reserving)).To(BeAssignableToTypeOf(operations.NewV2SetHostClaimOK()))
+
+		verifyApiError(set(createCluster(db, "org1", models.ClusterStatusReady), reserving), http.StatusConflict)
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,397 @@
+package hostclaims
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/hardwareinventory"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	SchedulingInterval time.Duration `envconfig:"HOST_CLAIMS_SCHEDULING_INTERVAL" default:"30s"`
+}
+
+// InstallerInternals binds the claimed hosts to the clusters and sets their role, and unbinds them when their role
+// can't be set
+//
+//go:generate mockgen --build_flags=--mod=mod -package hostclaims -destination mock_installer_internals.go . InstallerInternals
+type InstallerInternals interface {
+	BindHostInternal(ctx context.Context, params installer.BindHostParams) (*common.Host, error)
+	UnbindHostInternal(ctx context.Context, params installer.UnbindHostParams, reclaimHost bool, interactivity bminventory.Interactivity) (*common.Host, error)
+	V2UpdateHostInternal(ctx context.Context, params installer.V2UpdateHostParams, interactivity bminventory.Interactivity) (*common.Host, error)
+}
+
+// Scheduler binds the unbound hosts matching the host claims to their clusters
+type Scheduler struct {
+	log                logrus.FieldLogger
+	db                 *gorm.DB
+	eventsHandler      eventsapi.Handler
+	installerInternals InstallerInternals
+	leaderElector      leader.ElectorInterface
+}
+
+func NewScheduler(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, installerInternals InstallerInternals,
+	leaderElector leader.ElectorInterface) *Scheduler {
+	return &Scheduler{
+		log:                log,
+		db:                 db,
+		eventsHandler:      eventsHandler,
+		installerInternals: installerInternals,
+		leaderElector:      leaderElector,
+	}
+}
+
+// Schedule serves the host claims by decreasing priority, the claims with the same priority in the order they were
+// created. Each claim takes the hosts it misses before the next claim is served, so the claims with a lower priority
+// only get the hosts left by the others.
+func (s *Scheduler) Schedule() {
+	if !s.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := requestid.RequestIDLogger(s.log, requestid.FromContext(ctx))
+
+	var claims []*models.HostClaim
+	if err := s.db.Order("created_at").Find(&claims).Error; err != nil {
+		log.WithError(err).Error("failed to load the host claims")
+		return
+	}
+	sortClaims(claims)
+	reservations := reservationsOf(claims)
+	for _, claim := range claims {
+		if err := s.serve(ctx, claim, reservations); err != nil {
+			log.WithError(err).Errorf("failed to serve the host claim of cluster %s", claim.ClusterID)
+		}
+	}
+}
+
+// serve binds to the cluster of the claim the hosts it misses, and records the hosts the cluster has. The claims of
+// the clusters which aren't waiting for their installation anymore are left as they are.
+func (s *Scheduler) serve(ctx context.Context, claim *models.HostClaim, reservations map[strfmt.UUID]strfmt.UUID) error {
+	cluster, err := common.GetClusterFromDB(s.db, *claim.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !funk.ContainsString(clusterStatusesBeforeInstallation, swag.StringValue(cluster.Status)) {
+		return nil
+	}
+
+	infraEnvIDs, err := s.eligibleInfraEnvs(ctx, cluster, claim)
+	if err != nil {
+		return err
+	}
+	// The hosts of the cluster which are waiting for their role to be assigned are pending hosts of the claim. They
+	// are counted for the masters first, as the first hosts of a cluster are assigned the master role.
+	var unassigned int64
+	err = s.db.Model(&common.Host{}).
+		Where("cluster_id = ? AND role = ? AND (suggested_role IS NULL OR suggested_role IN (?))",
+			claim.ClusterID.String(), models.HostRoleAutoAssign, []models.HostRole{"", models.HostRoleAutoAssign}).
+		Count(&unassigned).Error
+	if err != nil {
+		return err
+	}
+
+	paused := swag.BoolValue(claim.Spec.Paused)
+	boundMasters, missingMasters, err := s.serveRole(ctx, claim, infraEnvIDs, models.HostRoleMaster, claim.Spec.Masters, paused, reservations, &unassigned)
+	if err != nil {
+		return err
+	}
+	boundWorkers, missingWorkers, err := s.serveRole(ctx, claim, infraEnvIDs, models.HostRoleWorker, claim.Spec.Workers, paused, reservations, &unassigned)
+	if err != nil {
+		return err
+	}
+
+	status := models.HostClaimStatusSatisfied
+	var statusInfo string
+	switch {
+	case paused:
+		status = models.HostClaimStatusPaused
+	case missingMasters != "" || missingWorkers != "":
+		status = models.HostClaimStatusPending
+		statusInfo = joinNonEmpty(missingMasters, missingWorkers)
+	}
+	if status != swag.StringValue(claim.Status) || statusInfo != claim.StatusInfo {
+		switch status {
+		case models.HostClaimStatusSatisfied:
+			eventgen.SendClusterHostClaimSatisfiedEvent(ctx, s.eventsHandler, *claim.ClusterID, boundMasters, boundWorkers)
+		case models.HostClaimStatusPending:
+			eventgen.SendClusterHostClaimUnsatisfiedEvent(ctx, s.eventsHandler, *claim.ClusterID, statusInfo)
+		}
+	}
+
+	return s.db.Model(&models.HostClaim{}).Where("cluster_id = ?", claim.ClusterID.String()).Updates(map[string]interface{}{
+		"status":        status,
+		"status_info":   statusInfo,
+		"bound_masters": boundMasters,
+		"bound_workers": boundWorkers,
+		"scheduled_at":  time.Now(),
+	}).Error
+}
+
+// eligibleInfraEnvs returns the infra-envs of the claim that the hosts can still be taken from. They were validated
+// when the claim was created, but their owner or their CPU architecture may not match the cluster anymore.
+func (s *Scheduler) eligibleInfraEnvs(ctx context.Context, cluster *common.Cluster, claim *models.HostClaim) ([]strfmt.UUID, error) {
+	log := logutil.FromContext(ctx, s.log)
+	db := s.db.Model(&common.InfraEnv{}).Where("id IN (?)", claim.Spec.InfraEnvIds)
+	if cluster.OrgID != "" {
+		db = db.Where("org_id = ?", cluster.OrgID)
+	} else {
+		db = db.Where("user_name = ?", cluster.UserName)
+	}
+	if cluster.CPUArchitecture != "" && cluster.CPUArchitecture != common.MultiCPUArchitecture {
+		db = db.Where("cpu_architecture = ?", cluster.CPUArchitecture)
+	}
+	var ids []strfmt.UUID
+	if err := db.Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	if len(ids) < len(claim.Spec.InfraEnvIds) {
+		log.Warnf("%d of the %d infra-envs of the host claim of cluster %s don't match the owner or the CPU architecture of the cluster, their hosts aren't claimed",
+			len(claim.Spec.InfraEnvIds)-len(ids), len(claim.Spec.InfraEnvIds), claim.ClusterID)
+	}
+	return ids, nil
+}
+
+// serveRole binds the hosts of the role the cluster misses. The hosts of the cluster with the role, or suggested the
+// role while it is auto-assigned, are counted as bound, and the missing hosts are first taken from the unassigned
+// hosts of the cluster, which are pending. It returns the number of hosts bound with the role and, when some are
+// still missing or pending, the reason.
+func (s *Scheduler) serveRole(ctx context.Context, claim *models.HostClaim, infraEnvIDs []strfmt.UUID, role models.HostRole,
+	request *models.HostClaimRole, paused bool, reservations map[strfmt.UUID]strfmt.UUID, unassigned *int64) (int64, string, error) {
+	log := logutil.FromContext(ctx, s.log)
+	var bound int64
+	err := s.db.Model(&common.Host{}).
+		Where("cluster_id = ? AND (role = ? OR (role = ? AND suggested_role = ?))", claim.ClusterID.String(), role, models.HostRoleAutoAssign, role).
+		Count(&bound).Error
+	if err != nil {
+		return 0, "", err
+	}
+	if request == nil || paused || bound >= swag.Int64Value(request.Count) {
+		return bound, "", nil
+	}
+
+	requested := swag.Int64Value(request.Count)
+
+	pending := requested - bound
+	if pending > *unassigned {
+		pending = *unassigned
+	}
+	*unassigned -= pending
+	if missing := requested - bound - pending; missing > 0 && len(infraEnvIDs) > 0 {
+		candidates, err := s.candidates(claim, infraEnvIDs, request.Selector, missing, reservations)
+		if err != nil {
+			return 0, "", err
+		}
+		for _, candidate := range candidates {
+			if err = s.bind(ctx, claim, candidate, role); err != nil {
+				log.WithError(err).Warnf("failed to bind host %s to cluster %s as %s", candidate.host.ID, claim.ClusterID, role)
+				continue
+			}
+			bound++
+		}
+	}
+
+	switch missing := requested - bound - pending; {
+	case missing > 0:
+		return bound, fmt.Sprintf("%d of the %d %ss are missing, no other unbound host of the infra-envs of the claim matches the %s selector",
+			missing, requested, role, role), nil
+	case pending > 0:
+		return bound, fmt.Sprintf("%d of the %d %ss are waiting for their role to be assigned", pending, requested, role), nil
+	default:
+		return bound, "", nil
+	}
+}
+
+// candidate is an unbound host the scheduler selected for a claim, and why
+type candidate struct {
+	host   *common.Host
+	reason string
+}
+
+// candidates selects up to limit unbound hosts matching the selector. The hosts reserved for the cluster come first,
+// then the smallest hosts, so that the larger hosts are left for the claims which need them. The hosts reserved for
+// other clusters are never selected.
+func (s *Scheduler) candidates(claim *models.HostClaim, infraEnvIDs []strfmt.UUID, selector *models.HostSelector, limit int64,
+	reservations map[strfmt.UUID]strfmt.UUID) ([]*candidate, error) {
+	var reservedForCluster, reservedForOthers []strfmt.UUID
+	for hostID, clusterID := range reservations {
+		if clusterID == *claim.ClusterID {
+			reservedForCluster = append(reservedForCluster, hostID)
+		} else {
+			reservedForOthers = append(reservedForOthers, hostID)
+		}
+	}
+	query := func() *gorm.DB {
+		db := selectorFilter(selector).Apply(s.db.Model(&common.Host{})).
+			Select("id", "infra_env_id", "requested_hostname", "hardware_hostname").
+			Where("cluster_id IS NULL AND status = ? AND infra_env_id IN (?)", models.HostStatusKnownUnbound, infraEnvIDs).
+			Order("hardware_cpu_cores, hardware_memory_bytes, created_at, id")
+		if len(reservedForOthers) > 0 {
+			db = db.Where("id NOT IN (?)", reservedForOthers)
+		}
+		return db
+	}
+
+	var candidates []*candidate
+	var hosts []*common.Host
+	if len(reservedForCluster) > 0 {
+		if err := query().Where("id IN (?)", reservedForCluster).Limit(int(limit)).Find(&hosts).Error; err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			candidates = append(candidates, &candidate{
+				host:   host,
+				reason: fmt.Sprintf("reserved for the cluster, claim priority %d", claim.Spec.Priority),
+			})
+		}
+	}
+	if remaining := limit - int64(len(candidates)); remaining > 0 {
+		hosts = nil
+		db := query()
+		if len(reservedForCluster) > 0 {
+			db = db.Where("id NOT IN (?)", reservedForCluster)
+		}
+		if err := db.Limit(int(remaining)).Find(&hosts).Error; err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			candidates = append(candidates, &candidate{
+				host:   host,
+				reason: fmt.Sprintf("smallest unbound host of infra-env %s matching the selector, claim priority %d", host.InfraEnvID, claim.Spec.Priority),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// bind binds the host to the cluster of the claim and sets its role. The host is unbound when its role can't be set,
+// so that it isn't left in the cluster without the role it was claimed for.
+func (s *Scheduler) bind(ctx context.Context, claim *models.HostClaim, c *candidate, role models.HostRole) error {
+	host := c.host
+	_, err := s.installerInternals.BindHostInternal(ctx, installer.BindHostParams{
+		HostID:         *host.ID,
+		InfraEnvID:     host.InfraEnvID,
+		BindHostParams: &models.BindHostParams{ClusterID: claim.ClusterID},
+	})
+	if err != nil {
+		eventgen.SendHostBindFailedEvent(ctx, s.eventsHandler, *host.ID, host.InfraEnvID, claim.ClusterID, err.Error())
+		return err
+	}
+	_, err = s.installerInternals.V2UpdateHostInternal(ctx, installer.V2UpdateHostParams{
+		HostID:           *host.ID,
+		InfraEnvID:       host.InfraEnvID,
+		HostUpdateParams: &models.HostUpdateParams{HostRole: swag.String(string(role))},
+	}, bminventory.NonInteractive)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to set the role of host %s", host.ID)
+		_, unbindErr := s.installerInternals.UnbindHostInternal(ctx, installer.UnbindHostParams{
+			HostID:     *host.ID,
+			InfraEnvID: host.InfraEnvID,
+		}, false, bminventory.NonInteractive)
+		if unbindErr != nil {
+			eventgen.SendHostUnbindFailedEvent(ctx, s.eventsHandler, *host.ID, host.InfraEnvID, unbindErr.Error())
+			err = errors.Wrapf(err, "failed to unbind the host (%s)", unbindErr)
+		}
+		eventgen.SendHostBindFailedEvent(ctx, s.eventsHandler, *host.ID, host.InfraEnvID, claim.ClusterID, err.Error())
+		return err
+	}
+	eventgen.SendHostClaimBoundEvent(ctx, s.eventsHandler, *host.ID, host.InfraEnvID, claim.ClusterID, hostName(host), string(role), c.reason)
+	return nil
+}
+
+// sortClaims sorts the claims in the order they are served, the claims being sorted by creation
+func sortClaims(claims []*models.HostClaim) {
+	sort.SliceStable(claims, func(i, j int) bool {
+		return claims[i].Spec.Priority > claims[j].Spec.Priority
+	})
+}
+
+// reservationsOf returns the clusters the hosts are reserved for by the claims
+func reservationsOf(claims []*models.HostClaim) map[strfmt.UUID]strfmt.UUID {
+	reservations := make(map[strfmt.UUID]strfmt.UUID)
+	for _, claim := range claims {
+		for _, hostID := range claim.Spec.ReservedHostIds {
+			reservations[hostID] = *claim.ClusterID
+		}
+	}
+	return reservations
+}
+
+func loadReservations(db *gorm.DB) (map[strfmt.UUID]strfmt.UUID, error) {
+	var claims []*models.HostClaim
+	if err := db.Select("cluster_id", "spec").Find(&claims).Error; err != nil {
+		return nil, err
+	}
+	return reservationsOf(claims), nil
+}
+
+// selectorFilter returns the hardware inventory filter of the hosts matching the selector
+func selectorFilter(selector *models.HostSelector) *hardwareinventory.Filter {
+	if selector == nil {
+		return &hardwareinventory.Filter{}
+	}
+	filter := &hardwareinventory.Filter{
+		MinCPUCores:     selector.MinCPUCores,
+		MaxCPUCores:     selector.MaxCPUCores,
+		MinMemoryMib:    selector.MinMemoryMib,
+		MaxMemoryMib:    selector.MaxMemoryMib,
+		DiskTypes:       selector.DiskTypes,
+		MinDiskCount:    selector.MinDiskCount,
+		MinDiskSizeGb:   selector.MinDiskSizeGb,
+		MinNicCount:     selector.MinNicCount,
+		MinNicSpeedMbps: selector.MinNicSpeedMbps,
+		Virtual:         selector.Virtual,
+	}
+	if selector.CPUArchitecture != "" {
+		filter.CPUArchitecture = swag.String(selector.CPUArchitecture)
+	}
+	if selector.Vendor != "" {
+		filter.Vendor = swag.String(selector.Vendor)
+	}
+	if selector.Product != "" {
+		filter.Product = swag.String(selector.Product)
+	}
+	return filter
+}
+
+func hostName(host *common.Host) string {
+	switch {
+	case host.RequestedHostname != "":
+		return host.RequestedHostname
+	case host.Hardware.Hostname != "":
+		return host.Hardware.Hostname
+	default:
+		return host.ID.String()
+	}
+}
+
+func joinNonEmpty(values ...string) string {
+	var nonEmpty []string
+	for _, value := range values {
+		if value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+	return strings.Join(nonEmpty, "; ")
+}
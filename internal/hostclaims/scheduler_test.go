@@ -0,0 +1,319 @@
+package hostclaims
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Host claims scheduler", func() {
+	var (
+		db                 *gorm.DB
+		dbName             string
+		ctrl               *gomock.Controller
+		mockEvents         *eventsapi.MockHandler
+		mockInstaller      *MockInstallerInternals
+		mockLeader         *leader.MockElectorInterface
+		scheduler          *Scheduler
+		clusterID          strfmt.UUID
+		infraEnvID         strfmt.UUID
+		nvme               *models.Disk
+		hdd                *models.Disk
+		createdAt          time.Time
+		boundHosts         map[strfmt.UUID]strfmt.UUID
+		boundRoles         map[strfmt.UUID]models.HostRole
+		unsatisfiedReasons []string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockInstaller = NewMockInstallerInternals(ctrl)
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+		scheduler = NewScheduler(common.GetTestLog(), db, mockEvents, mockInstaller, mockLeader)
+		clusterID = createCluster(db, "org1", models.ClusterStatusInsufficient)
+		infraEnvID = createInfraEnv(db, "org1")
+		nvme = &models.Disk{Name: "nvme0n1", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GbToBytes(1600)}
+		hdd = &models.Disk{Name: "sda", DriveType: models.DriveTypeHDD, SizeBytes: conversions.GbToBytes(4000)}
+		createdAt = time.Now()
+		boundHosts = make(map[strfmt.UUID]strfmt.UUID)
+		boundRoles = make(map[strfmt.UUID]models.HostRole)
+		unsatisfiedReasons = nil
+
+		mockInstaller.EXPECT().BindHostInternal(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params installer.BindHostParams) (*common.Host, error) {
+				Expect(db.Model(&common.Host{}).Where("id = ?", params.HostID.String()).Updates(map[string]interface{}{
+					"cluster_id": params.BindHostParams.ClusterID,
+					"status":     models.HostStatusBinding,
+				}).Error).ToNot(HaveOccurred())
+				boundHosts[params.HostID] = *params.BindHostParams.ClusterID
+				return common.GetHostFromDB(db, params.InfraEnvID.String(), params.HostID.String())
+			}).AnyTimes()
+		mockInstaller.EXPECT().V2UpdateHostInternal(gomock.Any(), gomock.Any(), bminventory.NonInteractive).DoAndReturn(
+			func(_ context.Context, params installer.V2UpdateHostParams, _ bminventory.Interactivity) (*common.Host, error) {
+				role := models.HostRole(swag.StringValue(params.HostUpdateParams.HostRole))
+				Expect(db.Model(&common.Host{}).Where("id = ?", params.HostID.String()).Update("role", role).Error).ToNot(HaveOccurred())
+				boundRoles[params.HostID] = role
+				return common.GetHostFromDB(db, params.InfraEnvID.String(), params.HostID.String())
+			}).AnyTimes()
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostClaimBoundEventName))).AnyTimes()
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterHostClaimSatisfiedEventName))).AnyTimes()
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterHostClaimUnsatisfiedEventName))).Do(
+			func(_ context.Context, event eventsapi.ClusterEvent) {
+				unsatisfiedReasons = append(unsatisfiedReasons, event.FormatMessage())
+			}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createClaim := func(clusterID strfmt.UUID, spec *models.HostClaimSpec) {
+		// The claims are created a millisecond apart so that their order of creation is deterministic
+		createdAt = createdAt.Add(time.Millisecond)
+		Expect(db.Create(&models.HostClaim{
+			ClusterID: common.StrFmtUUIDPtr(clusterID),
+			Spec:      spec,
+			Status:    swag.String(models.HostClaimStatusPending),
+			CreatedAt: createdAt,
+		}).Error).ToNot(HaveOccurred())
+	}
+
+	getClaim := func(clusterID strfmt.UUID) *models.HostClaim {
+		claim, err := getHostClaim(db, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		return claim
+	}
+
+	workers := func(count int64, selector *models.HostSelector) *models.HostClaimRole {
+		return &models.HostClaimRole{Count: swag.Int64(count), Selector: selector}
+	}
+
+	It("binds the smallest matching hosts with their roles", func() {
+		small := createHost(db, infraEnvID, 16, 64, nvme)
+		medium := createHost(db, infraEnvID, 32, 128, nvme)
+		large := createHost(db, infraEnvID, 64, 512, nvme)
+		worker := createHost(db, infraEnvID, 8, 32, hdd)
+		createHost(db, infraEnvID, 4, 16, hdd)
+		createClaim(clusterID, &models.HostClaimSpec{
+			InfraEnvIds: []strfmt.UUID{infraEnvID},
+			Masters:     &models.HostClaimRole{Count: swag.Int64(2), Selector: &models.HostSelector{DiskTypes: []string{common.DiskTypeNvme}}},
+			Workers:     workers(1, &models.HostSelector{MinCPUCores: swag.Int64(8)}),
+		})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(Equal(map[strfmt.UUID]strfmt.UUID{small: clusterID, medium: clusterID, worker: clusterID}))
+		Expect(boundRoles).To(Equal(map[strfmt.UUID]models.HostRole{
+			small: models.HostRoleMaster, medium: models.HostRoleMaster, worker: models.HostRoleWorker,
+		}))
+		Expect(boundHosts).ToNot(HaveKey(large))
+		claim := getClaim(clusterID)
+		Expect(*claim.Status).To(Equal(models.HostClaimStatusSatisfied))
+		Expect(claim.BoundMasters).To(BeEquivalentTo(2))
+		Expect(claim.BoundWorkers).To(BeEquivalentTo(1))
+		Expect(claim.ScheduledAt).ToNot(BeZero())
+
+		By("not binding more hosts once the claim is satisfied")
+		scheduler.Schedule()
+		Expect(boundHosts).To(HaveLen(3))
+	})
+
+	It("counts the hosts the cluster already has", func() {
+		existing := createHost(db, infraEnvID, 8, 32)
+		Expect(db.Model(&common.Host{}).Where("id = ?", existing.String()).Updates(map[string]interface{}{
+			"cluster_id": clusterID, "role": models.HostRoleWorker, "status": models.HostStatusKnown,
+		}).Error).ToNot(HaveOccurred())
+		createHost(db, infraEnvID, 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil)})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+		Expect(*getClaim(clusterID).Status).To(Equal(models.HostClaimStatusSatisfied))
+	})
+
+	It("serves the claims by priority", func() {
+		host := createHost(db, infraEnvID, 16, 64, nvme)
+		lowPriorityClusterID := createCluster(db, "org1", models.ClusterStatusInsufficient)
+		createClaim(lowPriorityClusterID, &models.HostClaimSpec{
+			InfraEnvIds: []strfmt.UUID{infraEnvID},
+			Workers:     workers(1, &models.HostSelector{DiskTypes: []string{common.DiskTypeNvme}}),
+		})
+		createClaim(clusterID, &models.HostClaimSpec{
+			InfraEnvIds: []strfmt.UUID{infraEnvID},
+			Workers:     workers(1, &models.HostSelector{DiskTypes: []string{common.DiskTypeNvme}}),
+			Priority:    10,
+		})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(Equal(map[strfmt.UUID]strfmt.UUID{host: clusterID}))
+		Expect(*getClaim(clusterID).Status).To(Equal(models.HostClaimStatusSatisfied))
+		lowPriorityClaim := getClaim(lowPriorityClusterID)
+		Expect(*lowPriorityClaim.Status).To(Equal(models.HostClaimStatusPending))
+		Expect(lowPriorityClaim.StatusInfo).To(Equal("1 of the 1 workers are missing, no other unbound host of the infra-envs of the claim matches the worker selector"))
+		Expect(unsatisfiedReasons).To(HaveLen(1))
+
+		By("not repeating the event while the claim stays pending")
+		scheduler.Schedule()
+		Expect(unsatisfiedReasons).To(HaveLen(1))
+	})
+
+	It("binds the reserved hosts first and only to their cluster", func() {
+		small := createHost(db, infraEnvID, 8, 32)
+		large := createHost(db, infraEnvID, 64, 512)
+		reserved := createHost(db, infraEnvID, 32, 128)
+		otherClusterID := createCluster(db, "org1", models.ClusterStatusInsufficient)
+		createClaim(clusterID, &models.HostClaimSpec{
+			InfraEnvIds: []strfmt.UUID{infraEnvID},
+			Workers:     workers(2, nil),
+			Priority:    10,
+		})
+		createClaim(otherClusterID, &models.HostClaimSpec{
+			InfraEnvIds:     []strfmt.UUID{infraEnvID},
+			Workers:         workers(1, nil),
+			ReservedHostIds: []strfmt.UUID{reserved},
+		})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(Equal(map[strfmt.UUID]strfmt.UUID{small: clusterID, large: clusterID, reserved: otherClusterID}))
+	})
+
+	It("doesn't bind hosts to the paused claims", func() {
+		createHost(db, infraEnvID, 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil), Paused: swag.Bool(true)})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+		Expect(*getClaim(clusterID).Status).To(Equal(models.HostClaimStatusPaused))
+	})
+
+	It("doesn't bind hosts to the clusters being installed", func() {
+		createHost(db, infraEnvID, 8, 32)
+		installingClusterID := createCluster(db, "org1", models.ClusterStatusInstalling)
+		createClaim(installingClusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil)})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+		Expect(*getClaim(installingClusterID).Status).To(Equal(models.HostClaimStatusPending))
+	})
+
+	It("only takes the hosts of the infra-envs of the claim", func() {
+		createHost(db, createInfraEnv(db, "org1"), 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil)})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+	})
+
+	It("doesn't take the hosts of the infra-envs of another owner or CPU architecture", func() {
+		createHost(db, createInfraEnv(db, "org2"), 8, 32)
+		armInfraEnvID := createInfraEnv(db, "org1")
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", armInfraEnvID.String()).
+			Update("cpu_architecture", common.ARM64CPUArchitecture).Error).ToNot(HaveOccurred())
+		createHost(db, armInfraEnvID, 8, 32)
+		var infraEnvIDs []strfmt.UUID
+		Expect(db.Model(&common.InfraEnv{}).Where("id != ?", infraEnvID.String()).Pluck("id", &infraEnvIDs).Error).ToNot(HaveOccurred())
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: infraEnvIDs, Workers: workers(2, nil)})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+		Expect(*getClaim(clusterID).Status).To(Equal(models.HostClaimStatusPending))
+	})
+
+	It("counts the hosts of the cluster waiting for their role as pending", func() {
+		suggested := createHost(db, infraEnvID, 8, 32)
+		unassigned := createHost(db, infraEnvID, 8, 32)
+		for hostID, suggestedRole := range map[strfmt.UUID]models.HostRole{suggested: models.HostRoleMaster, unassigned: models.HostRoleAutoAssign} {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Updates(map[string]interface{}{
+				"cluster_id": clusterID, "suggested_role": suggestedRole, "status": models.HostStatusKnown,
+			}).Error).ToNot(HaveOccurred())
+		}
+		master := createHost(db, infraEnvID, 8, 32)
+		createHost(db, infraEnvID, 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{
+			InfraEnvIds: []strfmt.UUID{infraEnvID},
+			Masters:     &models.HostClaimRole{Count: swag.Int64(3)},
+		})
+
+		scheduler.Schedule()
+
+		Expect(boundHosts).To(Equal(map[strfmt.UUID]strfmt.UUID{master: clusterID}))
+		claim := getClaim(clusterID)
+		Expect(*claim.Status).To(Equal(models.HostClaimStatusPending))
+		Expect(claim.StatusInfo).To(Equal("1 of the 3 masters are waiting for their role to be assigned"))
+		Expect(claim.BoundMasters).To(BeEquivalentTo(2))
+	})
+
+	It("unbinds the host and reports the failure when its role can't be set", func() {
+		host := createHost(db, infraEnvID, 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil)})
+		failingInstaller := NewMockInstallerInternals(ctrl)
+		failingInstaller.EXPECT().BindHostInternal(gomock.Any(), gomock.Any()).Return(&common.Host{}, nil)
+		failingInstaller.EXPECT().V2UpdateHostInternal(gomock.Any(), gomock.Any(), bminventory.NonInteractive).
+			Return(nil, errors.New("invalid role"))
+		failingInstaller.EXPECT().UnbindHostInternal(gomock.Any(), installer.UnbindHostParams{HostID: host, InfraEnvID: infraEnvID},
+			false, bminventory.NonInteractive).Return(&common.Host{}, nil)
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostBindFailedEventName),
+			eventstest.WithHostIdMatcher(host.String()))).Times(1)
+
+		NewScheduler(common.GetTestLog(), db, mockEvents, failingInstaller, mockLeader).Schedule()
+
+		Expect(*getClaim(clusterID).Status).To(Equal(models.HostClaimStatusPending))
+	})
+
+	It("does nothing when not the leader", func() {
+		notLeader := leader.NewMockElectorInterface(ctrl)
+		notLeader.EXPECT().IsLeader().Return(false).AnyTimes()
+		createHost(db, infraEnvID, 8, 32)
+		createClaim(clusterID, &models.HostClaimSpec{InfraEnvIds: []strfmt.UUID{infraEnvID}, Workers: workers(1, nil)})
+
+		NewScheduler(common.GetTestLog(), db, mockEvents, mockInstaller, notLeader).Schedule()
+
+		Expect(boundHosts).To(BeEmpty())
+	})
+})
+
+var _ = Describe("sortClaims", func() {
+	It("sorts the claims by decreasing priority and keeps the order of creation", func() {
+		claim := func(clusterID string, priority int64) *models.HostClaim {
+			return &models.HostClaim{ClusterID: common.StrFmtUUIDPtr(strfmt.UUID(clusterID)), Spec: &models.HostClaimSpec{Priority: priority}}
+		}
+		claims := []*models.HostClaim{claim("a", 0), claim("b", 5), claim("c", 0), claim("d", 5), claim("e", -1)}
+		sortClaims(claims)
+		var order []strfmt.UUID
+		for _, c := range claims {
+			order = append(order, *c.ClusterID)
+		}
+		Expect(order).To(Equal([]strfmt.UUID{"b", "d", "a", "c", "e"}))
+	})
+})
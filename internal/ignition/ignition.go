@@ -214,7 +214,8 @@ type Generator interface {
 //go:generate mockgen -source=ignition.go -package=ignition -destination=mock_ignition.go
 type IgnitionBuilder interface {
 	FormatDiscoveryIgnitionFile(ctx context.Context, infraEnv *common.InfraEnv, cfg IgnitionConfig, safeForLogs bool, authType auth.AuthType, overrideDiscoveryISOType string) (string, error)
-	FormatSecondDayWorkerIgnitionFile(url string, caCert *string, bearerToken, ignitionEndpointHTTPHeaders string, host *models.Host) ([]byte, error)
+	FormatSecondDayWorkerIgnitionFile(url string, caCert *string, bearerToken, ignitionEndpointHTTPHeaders string, host *models.Host,
+		overrideLayers []*models.IgnitionOverrideLayer) ([]byte, error)
 }
 
 type installerGenerator struct {
@@ -224,7 +225,6 @@ type installerGenerator struct {
 	cluster                       *common.Cluster
 	releaseImage                  string
 	releaseImageMirror            string
-	installerDir                  string
 	serviceCACert                 string
 	encodedDhcpFileContents       string
 	s3Client                      s3wrapper.API
@@ -280,9 +280,9 @@ func NewBuilder(log logrus.FieldLogger, staticNetworkConfig staticnetworkconfig.
 }
 
 // NewGenerator returns a generator that can generate ignition files
-func NewGenerator(serviceBaseURL string, workDir string, installerDir string, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
+func NewGenerator(serviceBaseURL string, workDir string, installerCache *installercache.Installers, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
 	serviceCACert string, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, operatorsApi operators.API,
-	providerRegistry registry.ProviderRegistry, installerReleaseImageOverride, clusterTLSCertOverrideDir string, storageCapacityLimit int64) Generator {
+	providerRegistry registry.ProviderRegistry, installerReleaseImageOverride, clusterTLSCertOverrideDir string) Generator {
 	return &installerGenerator{
 		cluster:                       cluster,
 		log:                           log,
@@ -290,7 +290,6 @@ func NewGenerator(serviceBaseURL string, workDir string, installerDir string, cl
 		releaseImage:                  releaseImage,
 		releaseImageMirror:            releaseImageMirror,
 		workDir:                       workDir,
-		installerDir:                  installerDir,
 		serviceCACert:                 serviceCACert,
 		s3Client:                      s3Client,
 		enableMetal3Provisioning:      true,
@@ -299,7 +298,7 @@ func NewGenerator(serviceBaseURL string, workDir string, installerDir string, cl
 		providerRegistry:              providerRegistry,
 		installerReleaseImageOverride: installerReleaseImageOverride,
 		clusterTLSCertOverrideDir:     clusterTLSCertOverrideDir,
-		installerCache:                installercache.New(installerDir, storageCapacityLimit, log),
+		installerCache:                installerCache,
 	}
 }
 
@@ -330,7 +329,7 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	ocRelease := oc.NewRelease(&executer.CommonExecuter{}, oc.Config{
 		MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay}, mirrorRegistriesBuilder)
 
-	release, err := g.installerCache.Get(g.installerReleaseImageOverride, g.releaseImageMirror,
+	release, err := g.installerCache.Get(ctx, g.installerReleaseImageOverride, g.releaseImageMirror,
 		g.cluster.PullSecret, ocRelease, g.cluster.OpenshiftVersion)
 	if err != nil {
 		return errors.Wrap(err, "failed to get installer path")
@@ -1471,7 +1470,7 @@ func (g *installerGenerator) clusterHasMCP(poolName string, clusterId *strfmt.UU
 	return false, nil
 }
 
-func (g *installerGenerator) updatePointerIgnitionMCP(poolName string, ignitionStr string) (string, error) {
+func updatePointerIgnitionMCP(poolName string, ignitionStr string) (string, error) {
 	config, err := ParseToLatest([]byte(ignitionStr))
 	if err != nil {
 		return "", err
@@ -1501,7 +1500,7 @@ func (g *installerGenerator) modifyPointerIgnitionMCP(poolName string, ignitionS
 		return "", err
 	}
 	if mcpExists {
-		ret, err = g.updatePointerIgnitionMCP(poolName, ignitionStr)
+		ret, err = updatePointerIgnitionMCP(poolName, ignitionStr)
 		if err != nil {
 			g.log.WithError(err).Errorf("failed to update pointer ignition for pool %s", poolName)
 			return "", err
@@ -1511,38 +1510,49 @@ func (g *installerGenerator) modifyPointerIgnitionMCP(poolName string, ignitionS
 	return "", errors.Errorf("machine config pool %s was not found", poolName)
 }
 
-func (g *installerGenerator) writeSingleHostFile(host *models.Host, baseFile string, workDir string) error {
-	config, err := parseIgnitionFile(filepath.Join(workDir, baseFile))
-	if err != nil {
-		return err
-	}
-
+// setHostPointerIgnitionFiles sets the files specific to the host in the pointer ignition of its role
+func setHostPointerIgnitionFiles(config *config_latest_types.Config, cluster *common.Cluster, host *models.Host) error {
 	hostname, err := hostutil.GetCurrentHostName(host)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
 	}
 
 	setFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420, true)
-	if common.IsSingleNodeCluster(g.cluster) {
-		machineCidr := g.cluster.MachineNetworks[0]
+	if common.IsSingleNodeCluster(cluster) {
+		machineCidr := cluster.MachineNetworks[0]
 		ip, _, errP := net.ParseCIDR(string(machineCidr.Cidr))
 		if errP != nil {
 			return errors.Wrapf(errP, "Failed to parse machine cidr for node ip hint content")
 		}
 		setFileInIgnition(config, nodeIpHintFile, fmt.Sprintf("data:,KUBELET_NODEIP_HINT=%s", ip), false, 420, true)
 	}
+	return nil
+}
+
+func (g *installerGenerator) writeSingleHostFile(host *models.Host, baseFile string, workDir string) error {
+	config, err := parseIgnitionFile(filepath.Join(workDir, baseFile))
+	if err != nil {
+		return err
+	}
+
+	if err = setHostPointerIgnitionFiles(config, g.cluster, host); err != nil {
+		return err
+	}
 
 	configBytes, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
-	if host.IgnitionConfigOverrides != "" {
-		merged, mergeErr := MergeIgnitionConfig(configBytes, []byte(host.IgnitionConfigOverrides))
-		if mergeErr != nil {
-			return errors.Wrapf(mergeErr, "failed to apply ignition config overrides for host %s", host.ID)
+	clusterLayers, err := common.UnmarshalIgnitionOverrideLayers(g.cluster.IgnitionOverrideLayers)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the ignition override layers of cluster %s", g.cluster.ID)
+	}
+	if layers := HostOverrideLayers(clusterLayers, host); len(layers) > 0 {
+		configBytes, _, err = ApplyOverrideLayers(configBytes, layers)
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply ignition config overrides for host %s", host.ID)
 		}
-		configBytes = []byte(merged)
 	}
 
 	if host.Role == models.HostRoleWorker && host.MachineConfigPoolName != "" {
@@ -1906,7 +1916,8 @@ func (ib *ignitionBuilder) prepareStaticNetworkConfigForIgnition(ctx context.Con
 	return filesList, nil
 }
 
-func (ib *ignitionBuilder) FormatSecondDayWorkerIgnitionFile(url string, caCert *string, bearerToken, ignitionEndpointHTTPHeaders string, host *models.Host) ([]byte, error) {
+func (ib *ignitionBuilder) FormatSecondDayWorkerIgnitionFile(url string, caCert *string, bearerToken, ignitionEndpointHTTPHeaders string, host *models.Host,
+	overrideLayers []*models.IgnitionOverrideLayer) ([]byte, error) {
 	var ignitionParams = map[string]interface{}{
 		// https://github.com/openshift/machine-config-operator/blob/master/docs/MachineConfigServer.md#endpoint
 		"SOURCE":  url,
@@ -1938,18 +1949,18 @@ func (ib *ignitionBuilder) FormatSecondDayWorkerIgnitionFile(url string, caCert
 		return nil, err
 	}
 
-	overrides := buf.String()
-	if host.IgnitionConfigOverrides != "" {
+	overrides := buf.Bytes()
+	if layers := HostOverrideLayers(overrideLayers, host); len(layers) > 0 {
 		var err error
-		overrides, err = MergeIgnitionConfig(buf.Bytes(), []byte(host.IgnitionConfigOverrides))
+		overrides, _, err = ApplyOverrideLayers(buf.Bytes(), layers)
 		if err != nil {
 			return []byte(""), errors.Wrapf(err, "Failed to apply ignition override for host %s", host.ID)
 		}
-		ib.log.Infof("Applied ignition override for host %s", host.ID)
-		ib.log.Debugf("Ignition override for day2 host %s: %s", host.ID, overrides)
+		ib.log.Infof("Applied %d ignition override layers for host %s", len(layers), host.ID)
+		ib.log.Debugf("Ignition override for day2 host %s: %s", host.ID, string(overrides))
 	}
 
-	res, err := SetHostnameForNodeIgnition([]byte(overrides), host)
+	res, err := SetHostnameForNodeIgnition(overrides, host)
 	if err != nil {
 		return []byte(""), errors.Wrapf(err, "Failed to set hostname in ignition for host %s", host.ID)
 	}
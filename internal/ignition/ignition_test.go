@@ -26,6 +26,8 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/installercache"
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -45,7 +47,7 @@ import (
 var (
 	cluster              *common.Cluster
 	hostInventory        string
-	installerCacheDir    string
+	installerCache       *installercache.Installers
 	log                  = logrus.New()
 	workDir              string
 	mockOperatorManager  operators.API
@@ -58,7 +60,6 @@ var _ = BeforeEach(func() {
 	var err error
 	workDir, err = os.MkdirTemp("", "assisted-install-test-")
 	Expect(err).NotTo(HaveOccurred())
-	installerCacheDir = filepath.Join(workDir, "installercache")
 
 	// create simple cluster
 	clusterID := strfmt.UUID(uuid.New().String())
@@ -78,6 +79,7 @@ var _ = BeforeEach(func() {
 	ctrl = gomock.NewController(GinkgoT())
 	mockOperatorManager = operators.NewMockAPI(ctrl)
 	mockProviderRegistry = registry.NewMockProviderRegistry(ctrl)
+	installerCache = installercache.New(filepath.Join(workDir, "installercache"), 5, log, nil, metrics.NewMockAPI(ctrl))
 })
 
 var _ = AfterEach(func() {
@@ -138,8 +140,8 @@ var _ = Describe("Bootstrap Ignition Update", func() {
 			},
 		}
 		db, dbName = common.PrepareTestDB()
-		g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", mockS3Client, log,
-			mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+		g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", mockS3Client, log,
+			mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 		err = g.updateBootstrap(context.Background(), examplePath)
 
@@ -292,8 +294,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 
 	Describe("update ignitions", func() {
 		It("with ca cert file", func() {
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", caCertPath, "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", caCertPath, "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
@@ -315,8 +317,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(file.Path).To(Equal(common.HostCACertPath))
 		})
 		It("with no ca cert file", func() {
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.updateIgnitions()
 			Expect(err).NotTo(HaveOccurred())
@@ -334,8 +336,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(workerConfig.Storage.Files).To(HaveLen(0))
 		})
 		It("with service ips", func() {
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.UpdateEtcHosts("10.10.10.1,10.10.10.2")
 			Expect(err).NotTo(HaveOccurred())
@@ -357,8 +359,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			Expect(file.Path).To(Equal("/etc/hosts"))
 		})
 		It("with no service ips", func() {
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.UpdateEtcHosts("")
 			Expect(err).NotTo(HaveOccurred())
@@ -387,8 +389,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 		})
 		Context("DHCP generation", func() {
 			It("Definitions only", func() {
-				g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-					mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+				g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+					mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 				g.encodedDhcpFileContents = "data:,abc"
 				err := g.updateIgnitions()
@@ -406,8 +408,8 @@ SV4bRR9i0uf+xQ/oYRvugQ25Q7EahO5hJIWRf4aULbk36Zpw3++v2KFnF26zqwB6
 			})
 		})
 		It("Definitions+leases", func() {
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			g.encodedDhcpFileContents = "data:,abc"
 			cluster.ApiVipLease = "api"
@@ -544,8 +546,8 @@ var _ = Describe("createHostIgnitions", func() {
 				host.ID = &id
 			}
 
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.createHostIgnitions()
 			Expect(err).NotTo(HaveOccurred())
@@ -599,8 +601,8 @@ var _ = Describe("createHostIgnitions", func() {
 				host.ID = &id
 			}
 
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.createHostIgnitions()
 			Expect(err).NotTo(HaveOccurred())
@@ -644,8 +646,8 @@ var _ = Describe("createHostIgnitions", func() {
 				host.ID = &id
 			}
 
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 			err := g.createHostIgnitions()
 			Expect(err).NotTo(HaveOccurred())
@@ -678,8 +680,8 @@ var _ = Describe("createHostIgnitions", func() {
 			IgnitionConfigOverrides: `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`,
 		}}
 
-		g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-			mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+		g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+			mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 
 		err := g.createHostIgnitions()
 		Expect(err).NotTo(HaveOccurred())
@@ -750,8 +752,8 @@ spec:
 				MachineConfigPoolName: "infra",
 			}}
 
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", mockS3Client, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", mockS3Client, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 			mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return([]string{"mcp.yaml"}, nil)
 			mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return(nil, nil)
 			mockS3Client.EXPECT().Download(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader(mcp)), int64(0), nil)
@@ -777,8 +779,8 @@ spec:
 				MachineConfigPoolName: "infra",
 			}}
 
-			g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", mockS3Client, log,
-				mockOperatorManager, mockProviderRegistry, "", "", 5).(*installerGenerator)
+			g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", mockS3Client, log,
+				mockOperatorManager, mockProviderRegistry, "", "").(*installerGenerator)
 			mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return([]string{"mcp.yaml"}, nil)
 			mockS3Client.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return(nil, nil)
 			mockS3Client.EXPECT().Download(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader(mc)), int64(0), nil)
@@ -1497,7 +1499,7 @@ var _ = Describe("IgnitionBuilder", func() {
 		}}
 		serviceBaseURL := "http://10.56.20.70:7878"
 
-		text, err := builder.FormatSecondDayWorkerIgnitionFile(serviceBaseURL, nil, "", "", cluster.Hosts[0])
+		text, err := builder.FormatSecondDayWorkerIgnitionFile(serviceBaseURL, nil, "", "", cluster.Hosts[0], nil)
 
 		Expect(err).Should(BeNil())
 		Expect(text).Should(ContainSubstring("/tmp/example"))
@@ -1797,7 +1799,7 @@ var _ = Describe("FormatSecondDayWorkerIgnitionFile", func() {
 	Context("test custom ignition endpoint", func() {
 
 		It("are rendered properly without ca cert and token", func() {
-			ign, err := builder.FormatSecondDayWorkerIgnitionFile("http://url.com", nil, "", "", mockHost)
+			ign, err := builder.FormatSecondDayWorkerIgnitionFile("http://url.com", nil, "", "", mockHost, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			ignConfig, _, err := config_31.Parse(ign)
@@ -1809,7 +1811,7 @@ var _ = Describe("FormatSecondDayWorkerIgnitionFile", func() {
 
 		It("are rendered properly with token", func() {
 			token := "xyzabc123"
-			ign, err := builder.FormatSecondDayWorkerIgnitionFile("http://url.com", nil, token, "", mockHost)
+			ign, err := builder.FormatSecondDayWorkerIgnitionFile("http://url.com", nil, token, "", mockHost, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			ignConfig, _, err := config_31.Parse(ign)
@@ -1826,7 +1828,7 @@ var _ = Describe("FormatSecondDayWorkerIgnitionFile", func() {
 				"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
 				"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----"
 			encodedCa := base64.StdEncoding.EncodeToString([]byte(ca))
-			ign, err := builder.FormatSecondDayWorkerIgnitionFile("https://url.com", &encodedCa, "", "", mockHost)
+			ign, err := builder.FormatSecondDayWorkerIgnitionFile("https://url.com", &encodedCa, "", "", mockHost, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			ignConfig, _, err := config_31.Parse(ign)
@@ -1843,7 +1845,7 @@ var _ = Describe("FormatSecondDayWorkerIgnitionFile", func() {
 				"aEA8gNEmV+rb7h1v0r3EwDQYJKoZIhvcNAQELBQAwYTELMAkGA1UEBhMCaXMxCzAJBgNVBAgMAmRk" +
 				"2lyDI6UR3Fbz4pVVAxGXnVhBExjBE=\n-----END CERTIFICATE-----"
 			encodedCa := base64.StdEncoding.EncodeToString([]byte(ca))
-			ign, err := builder.FormatSecondDayWorkerIgnitionFile("https://url.com", &encodedCa, token, "", mockHost)
+			ign, err := builder.FormatSecondDayWorkerIgnitionFile("https://url.com", &encodedCa, token, "", mockHost, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 
@@ -1886,8 +1888,8 @@ var _ = Describe("Import Cluster TLS Certs for ephemeral installer", func() {
 	})
 
 	It("copies the tls cert files", func() {
-		g := NewGenerator("", workDir, installerCacheDir, cluster, "", "", "", "", nil, log,
-			mockOperatorManager, mockProviderRegistry, "", certDir, 5).(*installerGenerator)
+		g := NewGenerator("", workDir, installerCache, cluster, "", "", "", "", nil, log,
+			mockOperatorManager, mockProviderRegistry, "", certDir).(*installerGenerator)
 
 		err := g.importClusterTLSCerts(context.Background())
 		Expect(err).NotTo(HaveOccurred())
@@ -2424,7 +2426,7 @@ var _ = Describe("Bare metal host generation", func() {
 			generator := NewGenerator(
 				"",
 				workDir,
-				installerCacheDir,
+				installerCache,
 				cluster,
 				"",
 				"",
@@ -2436,7 +2438,6 @@ var _ = Describe("Bare metal host generation", func() {
 				mockProviderRegistry,
 				"",
 				"",
-				5,
 			).(*installerGenerator)
 
 			// The default host inventory used by these tests has two NICs, each with
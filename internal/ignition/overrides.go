@@ -0,0 +1,292 @@
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	config_31 "github.com/coreos/ignition/v2/config/v3_1"
+	config_latest "github.com/coreos/ignition/v2/config/v3_2"
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/coreos/vcontext/report"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// OverrideLayerBase is the provenance of the files and units of the ignition the layers are applied to
+	OverrideLayerBase = "base"
+	// OverrideLayerHost is the layer of the ignition config overrides of the host
+	OverrideLayerHost = "host"
+)
+
+// overrideLayerScopes are the scopes of the layers of a cluster in the order they are applied
+var overrideLayerScopes = []string{
+	models.IgnitionOverrideLayerScopeCluster,
+	models.IgnitionOverrideLayerScopeRole,
+	models.IgnitionOverrideLayerScopeMachinePool,
+}
+
+var overrideLayerRoles = []string{string(models.HostRoleMaster), string(models.HostRoleWorker)}
+
+// OverrideLayer is an ignition config merged into the ignition of a host
+type OverrideLayer struct {
+	Name   string
+	Config string
+}
+
+// Provenance maps the files and the systemd units of an ignition to the layer which set them last
+type Provenance struct {
+	Files map[string]string
+	Units map[string]string
+}
+
+func newProvenance() *Provenance {
+	return &Provenance{Files: make(map[string]string), Units: make(map[string]string)}
+}
+
+func (p *Provenance) record(config *config_latest_types.Config, layer string) {
+	for _, file := range config.Storage.Files {
+		p.Files[file.Path] = layer
+	}
+	for _, unit := range config.Systemd.Units {
+		p.Units[unit.Name] = layer
+	}
+}
+
+func overrideLayerName(layer *models.IgnitionOverrideLayer) string {
+	if layer.Target == "" {
+		return swag.StringValue(layer.Scope)
+	}
+	return swag.StringValue(layer.Scope) + "/" + layer.Target
+}
+
+// HostOverrideLayers returns the layers applied to the ignition of the host in order: the cluster layers, the
+// layers of the role of the host, the layers of its machine config pool and the ignition config overrides of the host
+func HostOverrideLayers(clusterLayers []*models.IgnitionOverrideLayer, host *models.Host) []OverrideLayer {
+	role := string(common.GetEffectiveRole(host))
+	var layers []OverrideLayer
+	for _, scope := range overrideLayerScopes {
+		for _, layer := range clusterLayers {
+			if swag.StringValue(layer.Scope) != scope {
+				continue
+			}
+			if scope == models.IgnitionOverrideLayerScopeRole && layer.Target != role {
+				continue
+			}
+			if scope == models.IgnitionOverrideLayerScopeMachinePool &&
+				(host.MachineConfigPoolName == "" || layer.Target != host.MachineConfigPoolName) {
+				continue
+			}
+			layers = append(layers, OverrideLayer{Name: overrideLayerName(layer), Config: swag.StringValue(layer.Config)})
+		}
+	}
+	if host.IgnitionConfigOverrides != "" {
+		layers = append(layers, OverrideLayer{Name: OverrideLayerHost, Config: host.IgnitionConfigOverrides})
+	}
+	return layers
+}
+
+// ApplyOverrideLayers merges the layers into the base ignition in order with the merge semantics of ignition, a
+// file or a unit of a layer replacing the one of the same path or name of the previous layers
+func ApplyOverrideLayers(base []byte, layers []OverrideLayer) ([]byte, *Provenance, error) {
+	baseConfig, err := ParseToLatest(base)
+	if err != nil {
+		return nil, nil, err
+	}
+	provenance := newProvenance()
+	provenance.record(baseConfig, OverrideLayerBase)
+
+	merged := base
+	for _, layer := range layers {
+		layerConfig, err := ParseToLatest([]byte(layer.Config))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse ignition override layer %s", layer.Name)
+		}
+		result, err := MergeIgnitionConfig(merged, []byte(layer.Config))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to apply ignition override layer %s", layer.Name)
+		}
+		merged = []byte(result)
+		provenance.record(layerConfig, layer.Name)
+	}
+	return merged, provenance, nil
+}
+
+// PreviewHostPointerIgnition renders the pointer ignition of a day1 host from the ignition of its role as written
+// by the installer generator, roleIgnition being nil when the installation files weren't generated yet
+func PreviewHostPointerIgnition(roleIgnition []byte, cluster *common.Cluster, host *models.Host,
+	clusterLayers []*models.IgnitionOverrideLayer) (*models.HostIgnitionPreview, error) {
+	baseType := models.HostIgnitionPreviewBaseGenerated
+	base := roleIgnition
+	if base == nil {
+		baseType = models.HostIgnitionPreviewBaseNone
+		base = EmptyIgnition(cluster.OpenshiftVersion)
+	} else {
+		config, err := ParseToLatest(base)
+		if err != nil {
+			return nil, err
+		}
+		if err = setHostPointerIgnitionFiles(config, cluster, host); err != nil {
+			return nil, err
+		}
+		if base, err = json.Marshal(config); err != nil {
+			return nil, err
+		}
+	}
+
+	layers := HostOverrideLayers(clusterLayers, host)
+	merged, provenance, err := ApplyOverrideLayers(base, layers)
+	if err != nil {
+		return nil, err
+	}
+	if baseType == models.HostIgnitionPreviewBaseGenerated && host.Role == models.HostRoleWorker && host.MachineConfigPoolName != "" {
+		pointer, err := updatePointerIgnitionMCP(host.MachineConfigPoolName, string(merged))
+		if err != nil {
+			return nil, err
+		}
+		merged = []byte(pointer)
+	}
+	return newHostIgnitionPreview(merged, provenance, baseType, models.HostIgnitionPreviewIgnitionTypePointer, layers), nil
+}
+
+// PreviewSecondDayHostIgnition renders the full ignition of a day2 host from the node ignition template formatted
+// for the host without overrides, setting the hostname last as FormatSecondDayWorkerIgnitionFile does
+func PreviewSecondDayHostIgnition(template []byte, host *models.Host,
+	clusterLayers []*models.IgnitionOverrideLayer) (*models.HostIgnitionPreview, error) {
+	layers := HostOverrideLayers(clusterLayers, host)
+	merged, provenance, err := ApplyOverrideLayers(template, layers)
+	if err != nil {
+		return nil, err
+	}
+	if merged, err = SetHostnameForNodeIgnition(merged, host); err != nil {
+		return nil, err
+	}
+	provenance.Files["/etc/hostname"] = OverrideLayerBase
+	return newHostIgnitionPreview(merged, provenance, models.HostIgnitionPreviewBaseTemplate, models.HostIgnitionPreviewIgnitionTypeFull, layers), nil
+}
+
+func newHostIgnitionPreview(ignition []byte, provenance *Provenance, baseType, ignitionType string, layers []OverrideLayer) *models.HostIgnitionPreview {
+	preview := &models.HostIgnitionPreview{
+		Ignition:     swag.String(string(ignition)),
+		IgnitionType: swag.String(ignitionType),
+		Base:         swag.String(baseType),
+		Layers:       []string{},
+		Files:        provenanceEntries(provenance.Files),
+		Units:        provenanceEntries(provenance.Units),
+	}
+	for _, layer := range layers {
+		preview.Layers = append(preview.Layers, layer.Name)
+	}
+	return preview
+}
+
+func provenanceEntries(provenance map[string]string) []*models.IgnitionProvenance {
+	names := funk.Keys(provenance).([]string)
+	sort.Strings(names)
+	entries := make([]*models.IgnitionProvenance, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, &models.IgnitionProvenance{Name: swag.String(name), Layer: swag.String(provenance[name])})
+	}
+	return entries
+}
+
+// EmptyIgnition returns an ignition without content of the latest spec version supported by the OpenShift version
+func EmptyIgnition(openshiftVersion string) []byte {
+	return []byte(fmt.Sprintf(`{"ignition": {"version": "%s"}}`, maxIgnitionVersion(openshiftVersion)))
+}
+
+// maxIgnitionVersion returns the latest ignition spec version supported by the OpenShift version, OpenShift 4.6
+// supporting only ignition up to 3.1.0
+func maxIgnitionVersion(openshiftVersion string) string {
+	if openshiftVersion != "" {
+		if isOld, err := common.BaseVersionLessThan("4.7", openshiftVersion); err == nil && isOld {
+			return "3.1.0"
+		}
+	}
+	return "3.2.0"
+}
+
+// ValidateIgnitionOverride checks an ignition config against the ignition spec version it declares, which must be
+// supported by the OpenShift version of the cluster when it is known
+func ValidateIgnitionOverride(config string, openshiftVersion string) error {
+	var header struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal([]byte(config), &header); err != nil {
+		return errors.Wrap(err, "failed to parse ignition config")
+	}
+
+	var rpt report.Report
+	var err error
+	switch header.Ignition.Version {
+	case "3.1.0":
+		_, rpt, err = config_31.Parse([]byte(config))
+	case "3.2.0":
+		_, rpt, err = config_latest.Parse([]byte(config))
+	default:
+		return errors.Errorf("unsupported ignition spec version %q, the supported versions are 3.1.0 and 3.2.0", header.Ignition.Version)
+	}
+	if rpt.IsFatal() {
+		return errors.Errorf("invalid ignition config: %s", strings.TrimSpace(rpt.String()))
+	}
+	if err != nil {
+		return errors.Wrap(err, "invalid ignition config")
+	}
+
+	maxVersion := maxIgnitionVersion(openshiftVersion)
+	supported, err := common.VersionGreaterOrEqual(maxVersion, header.Ignition.Version)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ignition spec version %q", header.Ignition.Version)
+	}
+	if !supported {
+		return errors.Errorf("ignition spec version %s isn't supported by OpenShift %s, the latest supported version is %s",
+			header.Ignition.Version, openshiftVersion, maxVersion)
+	}
+	return nil
+}
+
+// ValidateOverrideLayers checks the scopes and the targets of the layers, and their ignition configs against the
+// ignition spec versions supported by the OpenShift version of the cluster
+func ValidateOverrideLayers(layers []*models.IgnitionOverrideLayer, openshiftVersion string) error {
+	names := make(map[string]bool)
+	for i, layer := range layers {
+		if layer == nil {
+			return errors.Errorf("ignition override layer %d is empty", i)
+		}
+		switch swag.StringValue(layer.Scope) {
+		case models.IgnitionOverrideLayerScopeCluster:
+			if layer.Target != "" {
+				return errors.Errorf("ignition override layer %d: a cluster layer has no target, got %q", i, layer.Target)
+			}
+		case models.IgnitionOverrideLayerScopeRole:
+			if !funk.ContainsString(overrideLayerRoles, layer.Target) {
+				return errors.Errorf("ignition override layer %d: the target of a role layer must be one of %s, got %q",
+					i, strings.Join(overrideLayerRoles, ", "), layer.Target)
+			}
+		case models.IgnitionOverrideLayerScopeMachinePool:
+			if layer.Target == "" {
+				return errors.Errorf("ignition override layer %d: the target of a machine-pool layer must be the name of a machine config pool", i)
+			}
+		default:
+			return errors.Errorf("ignition override layer %d: unsupported scope %q", i, swag.StringValue(layer.Scope))
+		}
+
+		name := overrideLayerName(layer)
+		if names[name] {
+			return errors.Errorf("ignition override layer %s is defined more than once", name)
+		}
+		names[name] = true
+
+		if err := ValidateIgnitionOverride(swag.StringValue(layer.Config), openshiftVersion); err != nil {
+			return errors.Wrapf(err, "invalid ignition override layer %s", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package ignition
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+func ignitionWithFile(version, path, contents string) string {
+	return fmt.Sprintf(`{"ignition": {"version": "%s"}, "storage": {"files": [{"path": "%s", "contents": {"source": "data:,%s"}}]}}`,
+		version, path, contents)
+}
+
+func ignitionWithUnit(name string) string {
+	return fmt.Sprintf(`{"ignition": {"version": "3.2.0"}, "systemd": {"units": [{"name": "%s", "enabled": true, "contents": "[Unit]"}]}}`, name)
+}
+
+func overrideLayer(scope, target, config string) *models.IgnitionOverrideLayer {
+	return &models.IgnitionOverrideLayer{Scope: swag.String(scope), Target: target, Config: swag.String(config)}
+}
+
+func provenanceOf(entries []*models.IgnitionProvenance) map[string]string {
+	ret := make(map[string]string)
+	for _, entry := range entries {
+		ret[swag.StringValue(entry.Name)] = swag.StringValue(entry.Layer)
+	}
+	return ret
+}
+
+var _ = Describe("ValidateIgnitionOverride", func() {
+	It("accepts the supported spec versions", func() {
+		Expect(ValidateIgnitionOverride(ignitionWithFile("3.1.0", "/etc/foo", "foo"), "4.6")).To(Succeed())
+		Expect(ValidateIgnitionOverride(ignitionWithFile("3.2.0", "/etc/foo", "foo"), "4.14")).To(Succeed())
+		Expect(ValidateIgnitionOverride(ignitionWithFile("3.2.0", "/etc/foo", "foo"), "")).To(Succeed())
+	})
+
+	It("rejects an unsupported spec version", func() {
+		Expect(ValidateIgnitionOverride(`{"ignition": {"version": "3.0.0"}}`, "")).To(MatchError(ContainSubstring(`unsupported ignition spec version "3.0.0"`)))
+		Expect(ValidateIgnitionOverride(`{"storage": {}}`, "")).To(MatchError(ContainSubstring(`unsupported ignition spec version ""`)))
+	})
+
+	It("rejects a spec version unsupported by the OpenShift version", func() {
+		err := ValidateIgnitionOverride(ignitionWithFile("3.2.0", "/etc/foo", "foo"), "4.6.16")
+		Expect(err).To(MatchError(ContainSubstring("ignition spec version 3.2.0 isn't supported by OpenShift 4.6.16")))
+	})
+
+	It("rejects an invalid config", func() {
+		Expect(ValidateIgnitionOverride(`{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "relative"}]}}`, "")).NotTo(Succeed())
+		Expect(ValidateIgnitionOverride(`{"ignition":`, "")).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("ValidateOverrideLayers", func() {
+	config := ignitionWithFile("3.2.0", "/etc/foo", "foo")
+
+	It("accepts valid layers", func() {
+		Expect(ValidateOverrideLayers([]*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeCluster, "", config),
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "master", config),
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "worker", config),
+			overrideLayer(models.IgnitionOverrideLayerScopeMachinePool, "infra", config),
+		}, "4.14")).To(Succeed())
+	})
+
+	DescribeTable("rejects invalid layers",
+		func(layers []*models.IgnitionOverrideLayer, message string) {
+			Expect(ValidateOverrideLayers(layers, "4.14")).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("cluster layer with a target", []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeCluster, "worker", config),
+		}, "a cluster layer has no target"),
+		Entry("role layer with an unknown role", []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "bootstrap", config),
+		}, "the target of a role layer must be one of master, worker"),
+		Entry("machine-pool layer without a target", []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeMachinePool, "", config),
+		}, "the target of a machine-pool layer must be the name of a machine config pool"),
+		Entry("unknown scope", []*models.IgnitionOverrideLayer{
+			overrideLayer("host", "", config),
+		}, `unsupported scope "host"`),
+		Entry("duplicate layer", []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "worker", config),
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "worker", config),
+		}, "ignition override layer role/worker is defined more than once"),
+		Entry("invalid config", []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeMachinePool, "infra", `{"ignition": {"version": "3.0.0"}}`),
+		}, "invalid ignition override layer machine-pool/infra"),
+	)
+})
+
+var _ = Describe("HostOverrideLayers", func() {
+	clusterLayers := []*models.IgnitionOverrideLayer{
+		overrideLayer(models.IgnitionOverrideLayerScopeMachinePool, "infra", "infra"),
+		overrideLayer(models.IgnitionOverrideLayerScopeRole, "worker", "worker"),
+		overrideLayer(models.IgnitionOverrideLayerScopeRole, "master", "master"),
+		overrideLayer(models.IgnitionOverrideLayerScopeCluster, "", "cluster"),
+	}
+
+	It("orders the layers from the cluster to the host", func() {
+		host := &models.Host{Role: models.HostRoleWorker, MachineConfigPoolName: "infra", IgnitionConfigOverrides: "host"}
+		Expect(HostOverrideLayers(clusterLayers, host)).To(Equal([]OverrideLayer{
+			{Name: "cluster", Config: "cluster"},
+			{Name: "role/worker", Config: "worker"},
+			{Name: "machine-pool/infra", Config: "infra"},
+			{Name: OverrideLayerHost, Config: "host"},
+		}))
+	})
+
+	It("selects the layers of the effective role", func() {
+		host := &models.Host{Role: models.HostRoleAutoAssign, SuggestedRole: models.HostRoleMaster}
+		Expect(HostOverrideLayers(clusterLayers, host)).To(Equal([]OverrideLayer{
+			{Name: "cluster", Config: "cluster"},
+			{Name: "role/master", Config: "master"},
+		}))
+	})
+
+	It("returns nothing without layers", func() {
+		Expect(HostOverrideLayers(nil, &models.Host{Role: models.HostRoleWorker})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ApplyOverrideLayers", func() {
+	base := []byte(ignitionWithFile("3.2.0", "/etc/base", "base"))
+
+	It("returns the base without layers", func() {
+		merged, provenance, err := ApplyOverrideLayers(base, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged).To(Equal(base))
+		Expect(provenance.Files).To(Equal(map[string]string{"/etc/base": OverrideLayerBase}))
+	})
+
+	It("lets the later layers replace the files of the previous ones", func() {
+		merged, provenance, err := ApplyOverrideLayers(base, []OverrideLayer{
+			{Name: "cluster", Config: ignitionWithFile("3.1.0", "/etc/foo", "cluster")},
+			{Name: "role/worker", Config: ignitionWithUnit("foo.service")},
+			{Name: OverrideLayerHost, Config: ignitionWithFile("3.2.0", "/etc/foo", "host")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provenance.Files).To(Equal(map[string]string{"/etc/base": OverrideLayerBase, "/etc/foo": OverrideLayerHost}))
+		Expect(provenance.Units).To(Equal(map[string]string{"foo.service": "role/worker"}))
+
+		config, err := ParseToLatest(merged)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Storage.Files).To(HaveLen(2))
+		for _, file := range config.Storage.Files {
+			if file.Path == "/etc/foo" {
+				Expect(swag.StringValue(file.Contents.Source)).To(Equal("data:,host"))
+			}
+		}
+		Expect(config.Systemd.Units).To(HaveLen(1))
+	})
+
+	It("names the layer failing to parse", func() {
+		_, _, err := ApplyOverrideLayers(base, []OverrideLayer{{Name: "role/master", Config: `{"ignition": {"version": "3.0.0"}}`}})
+		Expect(err).To(MatchError(ContainSubstring("ignition override layer role/master")))
+	})
+})
+
+var _ = Describe("PreviewHostPointerIgnition", func() {
+	var (
+		cluster *common.Cluster
+		host    *models.Host
+		layers  []*models.IgnitionOverrideLayer
+	)
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OpenshiftVersion: "4.14"}}
+		host = &models.Host{ID: &hostID, ClusterID: &clusterID, Role: models.HostRoleWorker, RequestedHostname: "worker-0",
+			Inventory: hostInventory}
+		layers = []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeCluster, "", ignitionWithFile("3.2.0", "/etc/cluster", "cluster")),
+			overrideLayer(models.IgnitionOverrideLayerScopeRole, "worker", ignitionWithUnit("worker.service")),
+		}
+	})
+
+	It("renders the layers on top of the generated pointer ignition", func() {
+		roleIgnition := []byte(`{"ignition": {"version": "3.2.0", "config": {"merge": [{"source": "https://192.168.126.100:22623/config/worker"}]}}}`)
+		host.MachineConfigPoolName = "infra"
+		host.IgnitionConfigOverrides = ignitionWithFile("3.2.0", "/etc/cluster", "host")
+
+		preview, err := PreviewHostPointerIgnition(roleIgnition, cluster, host, layers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseGenerated))
+		Expect(swag.StringValue(preview.IgnitionType)).To(Equal(models.HostIgnitionPreviewIgnitionTypePointer))
+		Expect(preview.Layers).To(Equal([]string{"cluster", "role/worker", OverrideLayerHost}))
+		Expect(provenanceOf(preview.Files)).To(Equal(map[string]string{"/etc/hostname": OverrideLayerBase, "/etc/cluster": OverrideLayerHost}))
+		Expect(provenanceOf(preview.Units)).To(Equal(map[string]string{"worker.service": "role/worker"}))
+
+		config, err := ParseToLatest([]byte(swag.StringValue(preview.Ignition)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(config.Ignition.Config.Merge[0].Source)).To(Equal("https://192.168.126.100:22623/config/infra"))
+	})
+
+	It("renders the layers on top of an empty ignition before the installation files are generated", func() {
+		cluster.OpenshiftVersion = "4.6"
+		host.Role = models.HostRoleMaster
+		preview, err := PreviewHostPointerIgnition(nil, cluster, host, layers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseNone))
+		Expect(preview.Layers).To(Equal([]string{"cluster"}))
+		Expect(provenanceOf(preview.Files)).To(Equal(map[string]string{"/etc/cluster": "cluster"}))
+		Expect(preview.Units).To(BeEmpty())
+	})
+})
+
+var _ = Describe("PreviewSecondDayHostIgnition", func() {
+	It("keeps the hostname of the host over the layers", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		host := &models.Host{ID: &hostID, Role: models.HostRoleWorker, RequestedHostname: "day2-worker", Inventory: hostInventory}
+		template, err := SetHostnameForNodeIgnition([]byte(`{"ignition": {"version": "3.2.0"}}`), host)
+		Expect(err).NotTo(HaveOccurred())
+		layers := []*models.IgnitionOverrideLayer{
+			overrideLayer(models.IgnitionOverrideLayerScopeCluster, "", ignitionWithFile("3.2.0", "/etc/hostname", "layer")),
+		}
+
+		preview, err := PreviewSecondDayHostIgnition(template, host, layers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(preview.Base)).To(Equal(models.HostIgnitionPreviewBaseTemplate))
+		Expect(swag.StringValue(preview.IgnitionType)).To(Equal(models.HostIgnitionPreviewIgnitionTypeFull))
+		Expect(provenanceOf(preview.Files)).To(Equal(map[string]string{"/etc/hostname": OverrideLayerBase}))
+		Expect(swag.StringValue(preview.Ignition)).To(ContainSubstring("data:,day2-worker"))
+	})
+})
@@ -88,7 +88,14 @@ func (m Manager) DeregisterInfraEnv(ctx context.Context, infraEnvId strfmt.UUID)
 		return err
 	}
 
-	if err = m.db.Delete(infraEnv).Error; err != nil {
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		// The BMC hosts hold the BMC credentials of the hosts of the infraEnv, they are deleted with it
+		if err := tx.Where("infra_env_id = ?", infraEnvId.String()).Delete(&common.BmcHost{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(infraEnv).Error
+	})
+	if err != nil {
 		log.WithError(err).Errorf("failed to deregister infraEnv %s", infraEnvId)
 		return err
 	}
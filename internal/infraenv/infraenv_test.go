@@ -52,6 +52,21 @@ var _ = Describe("DeregisterInfraEnv", func() {
 		Expect(errors.Is(err, gorm.ErrRecordNotFound)).Should(Equal(true))
 	})
 
+	It("deletes the BMC hosts of the infraEnv", func() {
+		bmcHostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.BmcHost{BmcHost: models.BmcHost{
+			ID:         &bmcHostID,
+			InfraEnvID: infraEnv.ID,
+			Address:    swag.String("redfish://192.168.111.1/redfish/v1/Systems/1"),
+			Username:   swag.String("admin"),
+		}, Password: "password"}).Error).ShouldNot(HaveOccurred())
+
+		Expect(state.DeregisterInfraEnv(ctx, *infraEnv.ID)).ShouldNot(HaveOccurred())
+		var count int64
+		Expect(db.Model(&common.BmcHost{}).Where("infra_env_id = ?", infraEnv.ID.String()).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).Should(BeZero())
+	})
+
 	AfterEach(func() {
 		ctrl.Finish()
 		common.DeleteTestDB(db, dbName)
@@ -11,8 +11,10 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
+	"sigs.k8s.io/yaml"
 )
 
 const minimalOpenShiftVersionForImageDigestSupport = "4.14.0-0.0"
@@ -21,6 +23,7 @@ const minimalOpenShiftVersionForImageDigestSupport = "4.14.0-0.0"
 type InstallConfigBuilder interface {
 	GetInstallConfig(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, rhRootCA string) ([]byte, error)
 	ValidateInstallConfigPatch(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, patch string) error
+	PreviewInstallConfig(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, overrides string) (*models.InstallConfigPreview, error)
 }
 
 type installConfigBuilder struct {
@@ -187,12 +190,27 @@ func (i *installConfigBuilder) applyConfigOverrides(overrides string, cfg *insta
 		return nil
 	}
 
-	overrideDecoder := json.NewDecoder(strings.NewReader(overrides))
+	// Reports every invalid field by its path, the decoder below stops at the first one
+	if err := installcfg.ValidateOverrides(overrides); err != nil {
+		return err
+	}
+	known, additionalFields, err := installcfg.SplitOverrides(overrides)
+	if err != nil {
+		return err
+	}
+
+	overrideDecoder := json.NewDecoder(strings.NewReader(known))
 	overrideDecoder.DisallowUnknownFields()
 
 	if err := overrideDecoder.Decode(cfg); err != nil {
 		return err
 	}
+	for name, value := range additionalFields {
+		if cfg.AdditionalFields == nil {
+			cfg.AdditionalFields = make(map[string]json.RawMessage)
+		}
+		cfg.AdditionalFields[name] = value
+	}
 
 	return nil
 }
@@ -243,6 +261,57 @@ func (i *installConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluste
 	return config.Validate()
 }
 
+// PreviewInstallConfig returns the install config of the cluster with the overrides
+// applied, along with its differences to the install config generated without overrides
+func (i *installConfigBuilder) PreviewInstallConfig(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, overrides string) (*models.InstallConfigPreview, error) {
+	baselineCluster := *cluster
+	baselineCluster.InstallConfigOverrides = ""
+	baseline, err := i.GetInstallConfig(&baselineCluster, clusterInfraenvs, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the install config without overrides: %w", err)
+	}
+	mergedCluster := *cluster
+	mergedCluster.InstallConfigOverrides = overrides
+	merged, err := i.GetInstallConfig(&mergedCluster, clusterInfraenvs, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the install config with overrides: %w", err)
+	}
+
+	baselineYAML, err := yaml.JSONToYAML(baseline)
+	if err != nil {
+		return nil, err
+	}
+	mergedYAML, err := yaml.JSONToYAML(merged)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(baselineYAML)),
+		B:        difflib.SplitLines(string(mergedYAML)),
+		FromFile: "generated",
+		ToFile:   "overridden",
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+	changedFields, err := installcfg.ChangedFields(baseline, merged)
+	if err != nil {
+		return nil, err
+	}
+	serviceOwnedFields, err := installcfg.ServiceOwnedOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.InstallConfigPreview{
+		InstallConfig:      swag.String(string(mergedYAML)),
+		Diff:               swag.String(diff),
+		ChangedFields:      changedFields,
+		ServiceOwnedFields: serviceOwnedFields,
+	}, nil
+}
+
 func (i *installConfigBuilder) getHypethreadingConfiguration(cluster *common.Cluster, machineType string) string {
 	switch cluster.Hyperthreading {
 	case models.ClusterHyperthreadingAll:
@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -775,6 +776,21 @@ var _ = Describe("ValidateInstallConfigPatch", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 
+	It("Accepts the top level fields that the service doesn't model", func() {
+		s := `{"apiVersion": "v3", "publish": "Internal", "credentialsMode": "Manual", "additionalTrustBundlePolicy": "Always"}`
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		err := installConfig.ValidateInstallConfigPatch(cluster, clusterInfraenvs, s)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("Fails with the path of every invalid field", func() {
+		s := `{"apiVersion": "v3", "controlPlane": {"replicas": "3", "hyperthreadin": "Disabled"}}`
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		err := installConfig.ValidateInstallConfigPatch(cluster, clusterInfraenvs, s)
+		Expect(err).To(MatchError(ContainSubstring("controlPlane.hyperthreadin: unknown field")))
+		Expect(err).To(MatchError(ContainSubstring("controlPlane.replicas: expected integer, got string")))
+	})
+
 	It("Fails with an invalid cert", func() {
 		s := `{"additionalTrustBundle":  "-----BEGIN CERTIFICATE-----\nMIIFozCCA4ugAwIBAgIUVlT4eKQQ43HN31jQzsez+iEmpw8wDQYJKoZIhvcNAQEL\nBQAwYTELMAkGA1UEBhMCQUExFTATBgNVBAcMDERlZmF1bHQgQ2l0eTEcMBoGA1UE\nCgwTRGVmYXVsdCBDb21wYW55IEx0ZDEdMBsGA1UEAwwUcmVnaXN0cnkuZXhhbXBs\nZS5jb20wHhcNMjAxMDI3MTI0OTEwWhcNMjExMDI3MTI0OTEwWjBhMQswCQYDVQQG\nEwJBQTEVMBMGA1UEBwwMRGVmYXVsdCBDaXR5MRwwGgYDVQQKDBNEZWZhdWx0IENv\nbXBhbnkgTHRkMR0wGwYDVQQDDBRyZWdpc3RyeS5leGFtcGxlLmNvbTCCAiIwDQYJ\nKoZIhvcNAQEBBQADggIPADCCAgoCggIBAKm/wEl5B6lDOwYtkOxoLHQySA5RySEU\nkEMoGxBtGewLjLRMS9zp5pgYNcRenOTfUeyx6n4vE+lLn6p4laSig6QGDK0mmPl/\nt8OVZGBNE/dOZEoGe3I+gQux0oErhzjNxrf1EGfeBRVVuSqmgQnFaeLq2mGsbb5+\nyz114seD7u0Vb6OIX5sA+ytvr+jV3HK0jf5H9AHvSnNzF0UE+S7CHTJSDqQNUPxp\n8rAtfOvWyndDJBBmA0fdnDRYNtUqKcj/YBSntuZAmSJ0Woq9NrE+H3e61kvF0AP8\nHz21FSD/GqCn97Q8Mh8uTKx8jas2XBLyWdi0OCIV+a4jTadez1zPCWT+zgD5rHAk\np5RyXgkRU3guJydNMlpRPsGur3pUM4Q3zQfArZ+OxTkU/SLZbBmAVMPDI2pwL6qE\n2F8So4JdysH1MiwtYDYVIxKChrpBtTVunIe+Jyl/w8a3xR77r++3MFauobGLpeCL\nptbSz0aFZIIIwoLw2JVaWe7BWryjk8fDYrlPkLWqgQ956lcZppqiUzvEVv3p7wC2\nmfWkXJBGZZ0CZcYUoEE7zQ5T0RHLXqf0lSMf8I1SPzBF+Wl6G2gUOaZtYT5s0LA5\nid+gSDtKqyDH1HwPGO0eQB1LGeXOCLBA3cgmxYXtIMLfds0LgcJF+vRV3868abpD\n+yVMxGQRzRZFAgMBAAGjUzBRMB0GA1UdDgQWBBTUHUuivG1L6rTHS9v8KHTtOVpL\ncjAfBgNVHSMEGDAWgBTUHUuivG1L6rTHS9v8KHTtOVpLcjAPBgNVHRMBAf8EBTAD\nAQH/MA0GCSqGSIb3DQEBCwUAA4ICAQAFTmSriXnTJ/9cbO2lJmH7OFcrgKWdsycU\ngc9aeLSpnlYPuMjRHgXpq0X5iZzJaOXu8WKmbxTItxfd7MD/9rsaDMo7uDs6cZhC\nsdpWDVzZlP1PRcy1uT3+g12QmMmt89WBtauKEMukI3mOlx6y1VzPj9Vw5gfBKYjS\nh2NJPSVzgkLlLTOsY6bHesXVWrHVtCS5fUiE2xNkE6hXS0hZWYZlzLwn55wIrchx\nB3G++mPnNL3SbH62lXyWcrc1M/+gNl3F3jSd5WfxZQVllZ9vK1DnBKDisTUax5fR\nqK/D7vgkvHJa0USzGhcYV3DEdbgP/COgWrpbA0TTFcasWWYQdBk+2EUPcWKAh0JB\nVgql3o0pmyzfqQtuRRMC4D6Ip6y6IE2opK2c7ipXT4iEyPqr4uk4IeVFXghCYW92\nkCI+FyRJgbSu9ZuIug8AUlea7UOLTC4mxAayXvTwA6bNlGoSLmojgQHG7GlGj+E8\n57AHM2sD9Qi1VYyLuMVhJB3DzlQKtEFuvZsvi/rSIGqT8UfNbxk7OCtxceyzECqW\n2ptIv7tDhQeAGqkGqhTj1WdH+16+QZpsfmkwt5+hAaOeZfQ/nOCP7CGwbl4nYc3X\narDiqhVUXlv84/7XrOyoDJo3AVGidq902h6MYenX9T//XYbWkUK7nkvYMVoxu/Ek\nx/aT+8yOHQ==\n", "imageDigestSources": [{"mirrors": ["registry.example.com:5000/ocp4"], "source": "quay.io/openshift-release-dev/ocp-release"}, {"mirrors": ["registry.example.com:5000/ocp4"], "source": "quay.io/openshift-release-dev/ocp-release-nightly"}, {"mirrors": ["registry.example.com:5000/ocp4"], "source": "quay.io/openshift-release-dev/ocp-v4.0-art-dev"}]}`
 		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
@@ -795,6 +811,70 @@ var _ = Describe("ValidateInstallConfigPatch", func() {
 	})
 })
 
+var _ = Describe("PreviewInstallConfig", func() {
+	var (
+		cluster          *common.Cluster
+		installConfig    *installConfigBuilder
+		clusterInfraenvs []*common.InfraEnv
+	)
+	BeforeEach(func() {
+		id := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:                     &id,
+			OpenshiftVersion:       "4.6",
+			BaseDNSDomain:          "example.com",
+			APIVips:                []*models.APIVip{{IP: "102.345.34.34", ClusterID: id}},
+			IngressVips:            []*models.IngressVip{{IP: "376.5.56.6", ClusterID: id}},
+			ImageInfo:              &models.ImageInfo{},
+			Platform:               &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeBaremetal)},
+			InstallConfigOverrides: `{"fips": true}`,
+		}}
+		clusterInfraenvs = []*common.InfraEnv{}
+		installConfig = createInstallConfigBuilder()
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(4)
+	})
+
+	It("returns the merged install config and its differences to the generated one", func() {
+		preview, err := installConfig.PreviewInstallConfig(cluster, clusterInfraenvs, `{"controlPlane": {"hyperthreading": "Enabled"}, "capabilities": {"baselineCapabilitySet": "None"}}`)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var result installcfg.InstallerConfigBaremetal
+		Expect(yaml.Unmarshal([]byte(*preview.InstallConfig), &result)).To(Succeed())
+		Expect(result.ControlPlane.Hyperthreading).To(Equal("Enabled"))
+		Expect(result.FIPS).To(BeFalse())
+		Expect(*preview.Diff).To(ContainSubstring("--- generated"))
+		Expect(*preview.Diff).To(ContainSubstring("+++ overridden"))
+		Expect(*preview.Diff).To(ContainSubstring("+  baselineCapabilitySet: None"))
+		Expect(preview.ChangedFields).To(Equal([]string{"capabilities.baselineCapabilitySet", "controlPlane.hyperthreading"}))
+		Expect(preview.ServiceOwnedFields).To(BeEmpty())
+	})
+
+	It("returns the overridden service owned fields", func() {
+		preview, err := installConfig.PreviewInstallConfig(cluster, clusterInfraenvs, `{"networking": {"networkType": "OVNKubernetes"}}`)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(preview.ChangedFields).To(ContainElement("networking.networkType"))
+		Expect(preview.ServiceOwnedFields).To(Equal([]string{"networking.networkType"}))
+	})
+
+	It("passes the top level fields that the service doesn't model to the installer", func() {
+		preview, err := installConfig.PreviewInstallConfig(cluster, clusterInfraenvs, `{"publish": "Internal", "credentialsMode": "Manual", "fips": true}`)
+		Expect(err).ShouldNot(HaveOccurred())
+		var result map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(*preview.InstallConfig), &result)).To(Succeed())
+		Expect(result["publish"]).To(Equal("Internal"))
+		Expect(result["credentialsMode"]).To(Equal("Manual"))
+		Expect(result["fips"]).To(Equal(true))
+		Expect(preview.ChangedFields).To(Equal([]string{"credentialsMode", "fips", "publish"}))
+	})
+
+	It("returns an empty diff without overrides", func() {
+		preview, err := installConfig.PreviewInstallConfig(cluster, clusterInfraenvs, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*preview.Diff).To(BeEmpty())
+		Expect(preview.ChangedFields).To(BeEmpty())
+	})
+})
+
 // asserts credential values against vsphereInstallConfigOverrides
 func assertVSphereCredentials(result installcfg.InstallerConfigBaremetal) {
 	Expect(result.Platform.Vsphere.VCenters[0].Server).Should(Equal("vcenter.openshift.com"))
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 )
 
 // MockInstallConfigBuilder is a mock of InstallConfigBuilder interface.
@@ -49,6 +50,21 @@ func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfig(cluster, cluste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfig), cluster, clusterInfraenvs, rhRootCA)
 }
 
+// PreviewInstallConfig mocks base method.
+func (m *MockInstallConfigBuilder) PreviewInstallConfig(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, overrides string) (*models.InstallConfigPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewInstallConfig", cluster, clusterInfraenvs, overrides)
+	ret0, _ := ret[0].(*models.InstallConfigPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewInstallConfig indicates an expected call of PreviewInstallConfig.
+func (mr *MockInstallConfigBuilderMockRecorder) PreviewInstallConfig(cluster, clusterInfraenvs, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).PreviewInstallConfig), cluster, clusterInfraenvs, overrides)
+}
+
 // ValidateInstallConfigPatch mocks base method.
 func (m *MockInstallConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, clusterInfraenvs []*common.InfraEnv, patch string) error {
 	m.ctrl.T.Helper()
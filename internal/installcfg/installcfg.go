@@ -1,6 +1,8 @@
 package installcfg
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/strfmt"
 	configv1 "github.com/openshift/api/config/v1"
 	cluster_validations "github.com/openshift/assisted-service/internal/cluster/validations"
@@ -246,6 +248,25 @@ type InstallerConfigBaremetal struct {
 	ImageDigestSources            []ImageDigestSource  `json:"imageDigestSources,omitempty"`
 	Capabilities                  *Capabilities        `json:"capabilities,omitempty"`
 	FeatureSet                    configv1.FeatureSet  `json:"featureSet,omitempty"`
+	// The overrides of the top level fields that the service doesn't model, passed to the installer as is
+	AdditionalFields map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON adds the additional fields to the fields known to the service
+func (c InstallerConfigBaremetal) MarshalJSON() ([]byte, error) {
+	type installerConfig InstallerConfigBaremetal
+	data, err := json.Marshal(installerConfig(c))
+	if err != nil || len(c.AdditionalFields) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range c.AdditionalFields {
+		fields[name] = value
+	}
+	return json.Marshal(fields)
 }
 
 func (c *InstallerConfigBaremetal) Validate() error {
@@ -0,0 +1,408 @@
+package installcfg
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// serviceOwnedFields are the top level fields of the install config set by the
+// service from the cluster, the VIPs being part of the platform
+var serviceOwnedFields = []string{"networking", "platform"}
+
+// installerOnlyFields are the top level fields of the install config that the
+// service doesn't model, passed to the installer as is and validated by it only.
+// The other unknown fields are rejected, so that a typo isn't silently ignored
+var installerOnlyFields = map[string]struct{}{
+	"additionalTrustBundlePolicy": {},
+	"credentialsMode":             {},
+	"featureGates":                {},
+	"operatorPublishingStrategy":  {},
+	"publish":                     {},
+}
+
+// OverrideError is an invalid field of install config overrides
+type OverrideError struct {
+	Path    string
+	Message string
+}
+
+func (e OverrideError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// OverrideErrors are all the invalid fields of install config overrides
+type OverrideErrors []OverrideError
+
+func (e OverrideErrors) Error() string {
+	messages := make([]string, len(e))
+	for i := range e {
+		messages[i] = e[i].Error()
+	}
+	return "invalid install config overrides: " + strings.Join(messages, "; ")
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// ValidateOverrides checks install config overrides against the fields of
+// InstallerConfigBaremetal and installerOnlyFields, returning the path of every
+// unknown field and of every value of the wrong type, e.g.
+// networking.clusterNetwork[0].hostPrefix
+func ValidateOverrides(overrides string) error {
+	if overrides == "" {
+		return nil
+	}
+	value, err := decodeOverrides(overrides)
+	if err != nil {
+		return OverrideErrors{{Message: err.Error()}}
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return OverrideErrors{{Message: "the overrides must be a JSON object"}}
+	}
+	fields := jsonFields(reflect.TypeOf(InstallerConfigBaremetal{}))
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs OverrideErrors
+	for _, key := range keys {
+		fieldType, found := lookupField(fields, key)
+		if !found {
+			if _, installerOnly := installerOnlyFields[key]; !installerOnly {
+				errs = append(errs, OverrideError{Path: key, Message: "unknown field"})
+			}
+			continue
+		}
+		validateOverrideValue(key, object[key], fieldType, &errs)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// SplitOverrides returns the overrides of the fields of InstallerConfigBaremetal,
+// and the overrides of installerOnlyFields to pass to the installer as is
+func SplitOverrides(overrides string) (string, map[string]json.RawMessage, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(overrides), &object); err != nil {
+		return "", nil, err
+	}
+	installerOnly := make(map[string]json.RawMessage)
+	for key, value := range object {
+		if _, found := installerOnlyFields[key]; found {
+			installerOnly[key] = value
+			delete(object, key)
+		}
+	}
+	if len(installerOnly) == 0 {
+		return overrides, nil, nil
+	}
+	known, err := json.Marshal(object)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(known), installerOnly, nil
+}
+
+// ServiceOwnedOverrides returns the paths of the overridden fields set by the
+// service from the cluster
+func ServiceOwnedOverrides(overrides string) ([]string, error) {
+	if overrides == "" {
+		return nil, nil
+	}
+	value, err := decodeOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	var paths []string
+	for key, fieldValue := range object {
+		for _, owned := range serviceOwnedFields {
+			if strings.EqualFold(key, owned) {
+				paths = append(paths, leafPaths(key, fieldValue)...)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// ChangedFields returns the paths of the fields differing between two install configs in JSON
+func ChangedFields(baseline, merged []byte) ([]string, error) {
+	baselineValue, err := decodeOverrides(string(baseline))
+	if err != nil {
+		return nil, err
+	}
+	mergedValue, err := decodeOverrides(string(merged))
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	changedFields("", baselineValue, mergedValue, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func decodeOverrides(overrides string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(overrides))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON object")
+	}
+	return value, nil
+}
+
+func fieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func indexPath(parent string, index int) string {
+	return parent + "[" + strconv.Itoa(index) + "]"
+}
+
+// jsonFields returns the fields of a struct by their JSON name, as decoded by encoding/json
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(field.Type) {
+				fields[embeddedName] = embeddedType
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookupField matches the JSON name exactly first and then case-insensitively, as encoding/json does
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
+
+func validateOverrideValue(path string, value interface{}, t reflect.Type, errs *OverrideErrors) {
+	// null leaves the field unchanged
+	if value == nil {
+		return
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) || t.Implements(jsonUnmarshalerType) {
+		data, err := json.Marshal(value)
+		if err == nil {
+			err = json.Unmarshal(data, reflect.New(t).Interface())
+		}
+		if err != nil {
+			*errs = append(*errs, OverrideError{Path: path, Message: err.Error()})
+		}
+		return
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		s, ok := value.(string)
+		if !ok {
+			*errs = append(*errs, OverrideError{Path: path, Message: fmt.Sprintf("expected string, got %s", jsonType(value))})
+			return
+		}
+		if err := reflect.New(t).Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			*errs = append(*errs, OverrideError{Path: path, Message: err.Error()})
+		}
+		return
+	}
+
+	unexpected := func(expected string) {
+		*errs = append(*errs, OverrideError{Path: path, Message: fmt.Sprintf("expected %s, got %s", expected, jsonType(value))})
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		validateOverrideValue(path, value, t.Elem(), errs)
+	case reflect.Interface:
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			unexpected("object")
+			return
+		}
+		fields := jsonFields(t)
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldType, found := lookupField(fields, key)
+			if !found {
+				*errs = append(*errs, OverrideError{Path: fieldPath(path, key), Message: "unknown field"})
+				continue
+			}
+			validateOverrideValue(fieldPath(path, key), object[key], fieldType, errs)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			unexpected("object")
+			return
+		}
+		for key, item := range object {
+			validateOverrideValue(fieldPath(path, key), item, t.Elem(), errs)
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if _, ok := value.(string); !ok {
+				unexpected("base64 string")
+			}
+			return
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			unexpected("array")
+			return
+		}
+		for i, item := range items {
+			validateOverrideValue(indexPath(path, i), item, t.Elem(), errs)
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			unexpected("string")
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			unexpected("boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := value.(json.Number)
+		if !ok {
+			unexpected("integer")
+			return
+		}
+		if _, err := strconv.ParseInt(number.String(), 10, t.Bits()); err != nil {
+			*errs = append(*errs, OverrideError{Path: path, Message: fmt.Sprintf("expected %d bits integer, got %s", t.Bits(), number.String())})
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := value.(json.Number)
+		if !ok {
+			unexpected("integer")
+			return
+		}
+		if _, err := strconv.ParseUint(number.String(), 10, t.Bits()); err != nil {
+			*errs = append(*errs, OverrideError{Path: path, Message: fmt.Sprintf("expected %d bits unsigned integer, got %s", t.Bits(), number.String())})
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(json.Number); !ok {
+			unexpected("number")
+		}
+	}
+}
+
+// leafPaths returns the paths of the values set in value, an empty object or
+// array setting nothing
+func leafPaths(path string, value interface{}) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var paths []string
+		for key, item := range v {
+			paths = append(paths, leafPaths(fieldPath(path, key), item)...)
+		}
+		return paths
+	case []interface{}:
+		if len(v) == 0 {
+			return []string{path}
+		}
+		var paths []string
+		for i, item := range v {
+			paths = append(paths, leafPaths(indexPath(path, i), item)...)
+		}
+		return paths
+	}
+	return []string{path}
+}
+
+func changedFields(path string, baseline, merged interface{}, paths *[]string) {
+	baselineObject, baselineIsObject := baseline.(map[string]interface{})
+	mergedObject, mergedIsObject := merged.(map[string]interface{})
+	if baselineIsObject && mergedIsObject {
+		for key, baselineItem := range baselineObject {
+			if mergedItem, ok := mergedObject[key]; ok {
+				changedFields(fieldPath(path, key), baselineItem, mergedItem, paths)
+			} else {
+				*paths = append(*paths, leafPaths(fieldPath(path, key), baselineItem)...)
+			}
+		}
+		for key, mergedItem := range mergedObject {
+			if _, ok := baselineObject[key]; !ok {
+				*paths = append(*paths, leafPaths(fieldPath(path, key), mergedItem)...)
+			}
+		}
+		return
+	}
+	baselineArray, baselineIsArray := baseline.([]interface{})
+	mergedArray, mergedIsArray := merged.([]interface{})
+	if baselineIsArray && mergedIsArray && len(baselineArray) == len(mergedArray) {
+		for i := range baselineArray {
+			changedFields(indexPath(path, i), baselineArray[i], mergedArray[i], paths)
+		}
+		return
+	}
+	baselineData, _ := json.Marshal(baseline)
+	mergedData, _ := json.Marshal(merged)
+	if !bytes.Equal(baselineData, mergedData) {
+		*paths = append(*paths, path)
+	}
+}
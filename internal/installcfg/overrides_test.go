@@ -0,0 +1,144 @@
+package installcfg
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateOverrides", func() {
+	It("accepts empty overrides", func() {
+		Expect(ValidateOverrides("")).To(Succeed())
+		Expect(ValidateOverrides("{}")).To(Succeed())
+	})
+
+	It("accepts valid overrides", func() {
+		overrides := `{"fips": true, "controlPlane": {"hyperthreading": "Disabled", "replicas": 3},
+			"networking": {"clusterNetwork": [{"cidr": "10.128.0.0/14", "hostPrefix": 23}]},
+			"platform": {"vsphere": {"vcenters": [{"server": "vcenter.example.com", "password": "secret"}]}},
+			"capabilities": {"additionalEnabledCapabilities": ["baremetal"]}, "additionalTrustBundle": null}`
+		Expect(ValidateOverrides(overrides)).To(Succeed())
+	})
+
+	It("matches the field names case-insensitively", func() {
+		Expect(ValidateOverrides(`{"ControlPlane": {"Hyperthreading": "Disabled"}}`)).To(Succeed())
+	})
+
+	It("accepts the fields without JSON tags by their Go name", func() {
+		Expect(ValidateOverrides(`{"platform": {"external": {"PlatformName": "oci"}}}`)).To(Succeed())
+	})
+
+	It("reports the path of an unknown field", func() {
+		err := ValidateOverrides(`{"controlPlane": {"hyperthreadin": "Disabled"}}`)
+		Expect(err).To(MatchError(ContainSubstring("controlPlane.hyperthreadin: unknown field")))
+	})
+
+	It("reports the path of a value of the wrong type", func() {
+		err := ValidateOverrides(`{"networking": {"clusterNetwork": [{"cidr": "10.128.0.0/14", "hostPrefix": "23"}]}}`)
+		Expect(err).To(MatchError(ContainSubstring("networking.clusterNetwork[0].hostPrefix: expected integer, got string")))
+	})
+
+	It("reports a fractional number for an integer", func() {
+		err := ValidateOverrides(`{"networking": {"clusterNetwork": [{"hostPrefix": 23.5}]}}`)
+		Expect(err).To(MatchError(ContainSubstring("networking.clusterNetwork[0].hostPrefix: expected 64 bits integer, got 23.5")))
+	})
+
+	It("reports all the invalid fields", func() {
+		err := ValidateOverrides(`{"foo": "bar", "fips": "yes", "compute": {"name": "worker"}}`)
+		Expect(err).To(HaveOccurred())
+		errs, ok := err.(OverrideErrors)
+		Expect(ok).To(BeTrue())
+		Expect(errs).To(ConsistOf(
+			OverrideError{Path: "compute", Message: "expected array, got object"},
+			OverrideError{Path: "fips", Message: "expected boolean, got string"},
+			OverrideError{Path: "foo", Message: "unknown field"},
+		))
+	})
+
+	It("accepts the top level fields that the service doesn't model", func() {
+		Expect(ValidateOverrides(`{"publish": "Internal", "fips": true, "credentialsMode": "Manual",
+			"additionalTrustBundlePolicy": "Always"}`)).To(Succeed())
+	})
+
+	It("rejects a misspelled top level field", func() {
+		err := ValidateOverrides(`{"baseDomian": "example.com"}`)
+		Expect(err).To(MatchError(ContainSubstring("baseDomian: unknown field")))
+	})
+
+	It("rejects invalid JSON", func() {
+		Expect(ValidateOverrides(`{"fips": true`)).NotTo(Succeed())
+		Expect(ValidateOverrides(`["fips"]`)).To(MatchError(ContainSubstring("must be a JSON object")))
+	})
+})
+
+var _ = Describe("SplitOverrides", func() {
+	It("separates the top level fields that the service doesn't model", func() {
+		known, unknown, err := SplitOverrides(`{"publish": "Internal", "FIPS": true, "metadata": {"foo": "bar"}}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(known).To(MatchJSON(`{"FIPS": true, "metadata": {"foo": "bar"}}`))
+		Expect(unknown).To(HaveLen(1))
+		Expect(string(unknown["publish"])).To(Equal(`"Internal"`))
+	})
+
+	It("keeps the overrides of the fields of the service only as is", func() {
+		known, unknown, err := SplitOverrides(`{"fips": true}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(known).To(Equal(`{"fips": true}`))
+		Expect(unknown).To(BeEmpty())
+	})
+})
+
+var _ = Describe("InstallerConfigBaremetal", func() {
+	It("marshals the additional fields with the known fields", func() {
+		cfg := InstallerConfigBaremetal{APIVersion: "v1", AdditionalFields: map[string]json.RawMessage{"publish": json.RawMessage(`"Internal"`)}}
+		data, err := json.Marshal(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		var result map[string]interface{}
+		Expect(json.Unmarshal(data, &result)).To(Succeed())
+		Expect(result["apiVersion"]).To(Equal("v1"))
+		Expect(result["publish"]).To(Equal("Internal"))
+	})
+})
+
+var _ = Describe("ServiceOwnedOverrides", func() {
+	It("returns nothing without service owned fields", func() {
+		paths, err := ServiceOwnedOverrides(`{"controlPlane": {"hyperthreading": "Disabled"}}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("returns the paths of the overridden service owned fields", func() {
+		paths, err := ServiceOwnedOverrides(`{"fips": true, "networking": {"networkType": "OVNKubernetes",
+			"machineNetwork": [{"cidr": "192.168.1.0/24"}]}, "Platform": {"baremetal": {"apiVIPs": ["192.168.1.5"]}}}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(Equal([]string{
+			"Platform.baremetal.apiVIPs[0]",
+			"networking.machineNetwork[0].cidr",
+			"networking.networkType",
+		}))
+	})
+})
+
+var _ = Describe("ChangedFields", func() {
+	It("returns the paths of the changed fields", func() {
+		baseline := []byte(`{"fips": false, "controlPlane": {"hyperthreading": "Enabled", "replicas": 3}, "compute": [{"name": "worker"}]}`)
+		merged := []byte(`{"fips": true, "controlPlane": {"hyperthreading": "Disabled", "replicas": 3}, "compute": [{"name": "worker"}],
+			"capabilities": {"baselineCapabilitySet": "None"}}`)
+		paths, err := ChangedFields(baseline, merged)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(Equal([]string{"capabilities.baselineCapabilitySet", "controlPlane.hyperthreading", "fips"}))
+	})
+
+	It("returns nothing for identical install configs", func() {
+		paths, err := ChangedFields([]byte(`{"fips": true}`), []byte(`{"fips": true}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+})
+
+func TestInstallcfg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "installcfg overrides tests")
+}
@@ -1,6 +1,7 @@
 package installercache
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -9,7 +10,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -19,9 +22,19 @@ var (
 	CacheLimitThreshold               = 0.8
 )
 
+const (
+	getReleaseHit       = "hit"
+	getReleaseSharedHit = "shared_hit"
+	getReleaseMiss      = "miss"
+	// The binaries are prefetched to temporary directories of the cache directory, skipped by the eviction
+	prefetchDirPrefix = "prefetch_"
+)
+
 // Installers implements a thread safe LRU cache for ocp install binaries
 // on the pod's ephermal file system. The number of binaries stored is
-// limited by the storageCapacity parameter.
+// limited by the storageCapacity parameter. When a shared storage is set,
+// the extracted binaries are also stored in it so that the other replicas,
+// and this one after a restart, don't extract the same release again.
 type Installers struct {
 	sync.Mutex
 	log logrus.FieldLogger
@@ -29,6 +42,9 @@ type Installers struct {
 	storageCapacity int64
 	// parent directory of the binary cache
 	cacheDir string
+	// object storage shared by the replicas, nil if the cache isn't shared
+	sharedStorage s3wrapper.API
+	metricsAPI    metrics.API
 }
 
 type fileInfo struct {
@@ -51,19 +67,22 @@ func (rl *Release) Release() {
 	}
 }
 
-// New constructs an installer cache with a given storage capacity
-func New(cacheDir string, storageCapacity int64, log logrus.FieldLogger) *Installers {
+// New constructs an installer cache with a given storage capacity. sharedStorage
+// may be nil to keep the binaries on the pod only.
+func New(cacheDir string, storageCapacity int64, log logrus.FieldLogger, sharedStorage s3wrapper.API, metricsAPI metrics.API) *Installers {
 	return &Installers{
 		log:             log,
 		storageCapacity: storageCapacity,
 		cacheDir:        cacheDir,
+		sharedStorage:   sharedStorage,
+		metricsAPI:      metricsAPI,
 	}
 }
 
 // Get returns the path to an openshift-baremetal-install binary extracted from
 // the referenced release image. Tries the mirror release image first if it's set. It is safe for concurrent use. A cache of
 // binaries is maintained to reduce re-downloading of the same release.
-func (i *Installers) Get(releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string) (*Release, error) {
+func (i *Installers) Get(ctx context.Context, releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string) (*Release, error) {
 	i.Lock()
 	defer i.Unlock()
 
@@ -82,10 +101,18 @@ func (i *Installers) Get(releaseID, releaseIDMirror, pullSecret string, ocReleas
 		//evict older files if necessary
 		i.evict()
 
-		//extract the binary
-		_, err = ocRelease.Extract(i.log, releaseID, releaseIDMirror, i.cacheDir, pullSecret, ocpVersion)
-		if err != nil {
-			return &Release{}, err
+		if i.downloadShared(ctx, releaseImageLocation, binary, path) {
+			i.metricsAPI.InstallerCacheGetRelease(getReleaseSharedHit)
+		} else {
+			//extract the binary
+			start := time.Now()
+			_, err = ocRelease.Extract(i.log, releaseID, releaseIDMirror, i.cacheDir, pullSecret, ocpVersion)
+			i.metricsAPI.InstallerCacheGetRelease(getReleaseMiss)
+			if err != nil {
+				return &Release{}, err
+			}
+			i.metricsAPI.InstallerCacheExtractionDuration(time.Since(start))
+			i.uploadShared(ctx, releaseImageLocation, binary, path)
 		}
 	} else {
 		//update the file mtime to signal it was recently used
@@ -93,6 +120,7 @@ func (i *Installers) Get(releaseID, releaseIDMirror, pullSecret string, ocReleas
 		if err != nil {
 			return &Release{}, errors.Wrap(err, fmt.Sprintf("Failed to update release binary %s", path))
 		}
+		i.metricsAPI.InstallerCacheGetRelease(getReleaseHit)
 	}
 	// return a new hard link to the binary file
 	// the caller should delete the hard link when
@@ -129,6 +157,9 @@ func (i *Installers) evict() {
 			return err
 		}
 
+		if info.IsDir() && strings.HasPrefix(info.Name(), prefetchDirPrefix) {
+			return filepath.SkipDir
+		}
 		if !info.Mode().IsRegular() {
 			return nil
 		}
@@ -175,6 +206,11 @@ func (i *Installers) evict() {
 	}
 }
 
+// budget returns the size of the binaries kept by the cache, 0 when it is unlimited
+func (i *Installers) budget() int64 {
+	return int64(float64(i.storageCapacity) * CacheLimitThreshold)
+}
+
 func (i *Installers) evictFile(filePath string) error {
 	i.log.Infof("evicting binary file %s due to storage pressure", filePath)
 	err := os.Remove(filePath)
@@ -1,6 +1,7 @@
 package installercache
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,7 +10,10 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -17,6 +21,7 @@ var _ = Describe("installer cache", func() {
 	var (
 		ctrl        *gomock.Controller
 		mockRelease *oc.MockRelease
+		mockMetrics *metrics.MockAPI
 		manager     *Installers
 		cacheDir    string
 	)
@@ -26,13 +31,16 @@ var _ = Describe("installer cache", func() {
 
 		ctrl = gomock.NewController(GinkgoT())
 		mockRelease = oc.NewMockRelease(ctrl)
+		mockMetrics = metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().InstallerCacheGetRelease(gomock.Any()).AnyTimes()
+		mockMetrics.EXPECT().InstallerCacheExtractionDuration(gomock.Any()).AnyTimes()
 
 		var err error
 		cacheDir, err = os.MkdirTemp("/tmp", "cacheDir")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(os.Mkdir(filepath.Join(cacheDir, "quay.io"), 0755)).To(Succeed())
 		Expect(os.Mkdir(filepath.Join(filepath.Join(cacheDir, "quay.io"), "release-dev"), 0755)).To(Succeed())
-		manager = New(cacheDir, 12, logrus.New())
+		manager = New(cacheDir, 12, logrus.New(), nil, mockMetrics)
 	})
 
 	AfterEach(func() {
@@ -52,7 +60,7 @@ var _ = Describe("installer cache", func() {
 				err := os.WriteFile(fname, []byte("abcde"), 0600)
 				return "", err
 			})
-		l, err := manager.Get(releaseID, "mirror", "pull-secret", mockRelease, version)
+		l, err := manager.Get(context.Background(), releaseID, "mirror", "pull-secret", mockRelease, version)
 		Expect(err).ShouldNot(HaveOccurred())
 
 		time.Sleep(1 * time.Second)
@@ -129,7 +137,7 @@ var _ = Describe("installer cache", func() {
 				err := os.WriteFile(fname, []byte("abcde"), 0600)
 				return "", err
 			})
-		_, err := manager.Get(releaseID, releaseMirrorID, "pull-secret", mockRelease, version)
+		_, err := manager.Get(context.Background(), releaseID, releaseMirrorID, "pull-secret", mockRelease, version)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
@@ -149,9 +157,158 @@ var _ = Describe("installer cache", func() {
 				err := os.WriteFile(fname, []byte("abcde"), 0600)
 				return "", err
 			})
-		_, err := manager.Get(releaseID, releaseMirrorID, "pull-secret", mockRelease, version)
+		_, err := manager.Get(context.Background(), releaseID, releaseMirrorID, "pull-secret", mockRelease, version)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
+
+	Context("shared between replicas", func() {
+		var (
+			ctx           = context.Background()
+			storageDir    string
+			replicaDirs   []string
+			sharedStorage s3wrapper.API
+			releaseID     = "quay.io/release-dev:4.10"
+			version       = "4.10.0"
+		)
+
+		BeforeEach(func() {
+			var err error
+			storageDir, err = os.MkdirTemp("", "sharedStorage")
+			Expect(err).NotTo(HaveOccurred())
+			mockMetrics = metrics.NewMockAPI(ctrl)
+			mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+			mockMetrics.EXPECT().InstallerCacheExtractionDuration(gomock.Any()).AnyTimes()
+			sharedStorage = s3wrapper.NewFSClient(storageDir, logrus.New(), mockMetrics, 80)
+			manager = New(cacheDir, 0, logrus.New(), sharedStorage, mockMetrics)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(storageDir)
+			for _, dir := range replicaDirs {
+				os.RemoveAll(dir)
+			}
+			replicaDirs = nil
+		})
+
+		// newReplica returns the cache of another replica sharing the storage
+		newReplica := func() (*Installers, string) {
+			dir, err := os.MkdirTemp("", "replicaCacheDir")
+			Expect(err).NotTo(HaveOccurred())
+			replicaDirs = append(replicaDirs, dir)
+			return New(dir, 0, logrus.New(), sharedStorage, mockMetrics), dir
+		}
+
+		expectBinaryPath := func(dir string) string {
+			workdir := filepath.Join(dir, releaseID)
+			fname := filepath.Join(workdir, "openshift-install")
+			mockRelease.EXPECT().GetReleaseBinaryPath(releaseID, dir, version).
+				Return(workdir, "openshift-install", fname, nil).AnyTimes()
+			return fname
+		}
+
+		expectExtract := func(dir, content string) {
+			mockRelease.EXPECT().Extract(gomock.Any(), releaseID, "", dir, gomock.Any(), version).
+				DoAndReturn(func(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, version string) (string, error) {
+					fname := filepath.Join(cacheDir, releaseID, "openshift-install")
+					Expect(os.MkdirAll(filepath.Dir(fname), 0755)).To(Succeed())
+					return fname, os.WriteFile(fname, []byte(content), 0755)
+				})
+		}
+
+		It("extracts each release once", func() {
+			expectBinaryPath(cacheDir)
+			expectExtract(cacheDir, "installer")
+			mockMetrics.EXPECT().InstallerCacheGetRelease(getReleaseMiss).Times(1)
+			_, err := manager.Get(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("downloading the binary in another replica")
+			replica, replicaDir := newReplica()
+			fname := expectBinaryPath(replicaDir)
+			mockMetrics.EXPECT().InstallerCacheGetRelease(getReleaseSharedHit).Times(1)
+			release, err := replica.Get(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+			content, err := os.ReadFile(release.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("installer"))
+			info, err := os.Stat(fname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+			By("storing the binaries by content")
+			binaries, err := sharedStorage.ListObjectsByPrefix(ctx, sharedBinariesPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binaries).To(HaveLen(1))
+		})
+
+		It("extracts the release again when the shared binary is corrupted", func() {
+			expectBinaryPath(cacheDir)
+			expectExtract(cacheDir, "installer")
+			mockMetrics.EXPECT().InstallerCacheGetRelease(getReleaseMiss).Times(2)
+			_, err := manager.Get(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+			binaries, err := sharedStorage.ListObjectsByPrefix(ctx, sharedBinariesPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(binaries).To(HaveLen(1))
+			Expect(sharedStorage.Upload(ctx, []byte("corrupted"), binaries[0])).To(Succeed())
+
+			replica, replicaDir := newReplica()
+			expectBinaryPath(replicaDir)
+			expectExtract(replicaDir, "installer")
+			release, err := replica.Get(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+			content, err := os.ReadFile(release.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("installer"))
+		})
+
+		It("prefetches the releases missing from the shared storage only", func() {
+			fname := expectBinaryPath(cacheDir)
+			mockRelease.EXPECT().GetReleaseBinaryPath(releaseID, gomock.Any(), version).
+				DoAndReturn(func(releaseImage, dir, version string) (string, string, string, error) {
+					return filepath.Join(dir, releaseID), "openshift-install", filepath.Join(dir, releaseID, "openshift-install"), nil
+				})
+			mockRelease.EXPECT().Extract(gomock.Any(), releaseID, "", gomock.Any(), gomock.Any(), version).
+				DoAndReturn(func(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, dir string, pullSecret string, version string) (string, error) {
+					By("extracting without holding the lock of the cache")
+					Expect(manager.TryLock()).To(BeTrue())
+					manager.Unlock()
+					Expect(filepath.Dir(dir)).To(Equal(cacheDir))
+					extracted := filepath.Join(dir, releaseID, "openshift-install")
+					Expect(os.MkdirAll(filepath.Dir(extracted), 0755)).To(Succeed())
+					return extracted, os.WriteFile(extracted, []byte("installer"), 0755)
+				})
+			mockMetrics.EXPECT().InstallerCacheGetRelease(getReleaseMiss).Times(1)
+			size, err := manager.Prefetch(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(Equal(int64(len("installer"))))
+			content, err := os.ReadFile(fname)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("installer"))
+			entries, err := os.ReadDir(cacheDir)
+			Expect(err).NotTo(HaveOccurred())
+			for _, entry := range entries {
+				Expect(entry.Name()).ToNot(HavePrefix(prefetchDirPrefix))
+			}
+
+			replica, replicaDir := newReplica()
+			expectBinaryPath(replicaDir)
+			size, err = replica.Prefetch(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(Equal(int64(len("installer"))))
+			_, err = os.Stat(filepath.Join(replicaDir, releaseID, "openshift-install"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("records a miss when the extraction fails", func() {
+			expectBinaryPath(cacheDir)
+			mockRelease.EXPECT().Extract(gomock.Any(), releaseID, "", cacheDir, gomock.Any(), version).
+				Return("", errors.New("failed to pull the release image"))
+			mockMetrics.EXPECT().InstallerCacheGetRelease(getReleaseMiss).Times(1)
+			_, err := manager.Get(ctx, releaseID, "", "pull-secret", mockRelease, version)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 func TestInstallerCache(t *testing.T) {
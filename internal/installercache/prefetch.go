@@ -0,0 +1,129 @@
+package installercache
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// anonymousPullSecret is used to pull the public release images when no pull secret is configured
+const anonymousPullSecret = `{"auths":{}}`
+
+type PrefetchConfig struct {
+	Enabled bool `envconfig:"INSTALLER_CACHE_PREFETCH_ENABLED" default:"false"`
+	// Number of the release images used by the most clusters to prefetch, as long as their binaries fit in the cache
+	MostUsedReleases int `envconfig:"INSTALLER_CACHE_PREFETCH_MOST_USED_RELEASES" default:"5"`
+	// Only the clusters created within this period are counted for the most used release images
+	MostUsedWithin time.Duration `envconfig:"INSTALLER_CACHE_PREFETCH_MOST_USED_WITHIN" default:"720h"` // 30d
+	// File of the pull secret used to extract the binaries, the release images are pulled anonymously without it
+	PullSecretFile string `envconfig:"INSTALLER_CACHE_PREFETCH_PULL_SECRET_FILE" default:""`
+}
+
+type prefetchTarget struct {
+	releaseImage string
+	ocpVersion   string
+}
+
+// Prefetcher warms the installer cache with the binaries of the release images
+// used by the most clusters
+type Prefetcher struct {
+	cfg                PrefetchConfig
+	log                logrus.FieldLogger
+	db                 *gorm.DB
+	installers         *Installers
+	ocRelease          oc.Release
+	releaseImageMirror string
+	leaderElector      leader.Leader
+}
+
+func NewPrefetcher(cfg PrefetchConfig, log logrus.FieldLogger, db *gorm.DB, installers *Installers, ocRelease oc.Release,
+	releaseImageMirror string, leaderElector leader.Leader) *Prefetcher {
+	return &Prefetcher{
+		cfg:                cfg,
+		log:                log,
+		db:                 db,
+		installers:         installers,
+		ocRelease:          ocRelease,
+		releaseImageMirror: releaseImageMirror,
+		leaderElector:      leaderElector,
+	}
+}
+
+// Prefetch extracts the binaries missing from the cache. A shared cache is warmed
+// by the leader only, a cache on the pod is warmed by each replica.
+func (p *Prefetcher) Prefetch() {
+	if p.installers.IsShared() && !p.leaderElector.IsLeader() {
+		return
+	}
+	ctx := context.Background()
+	pullSecret := anonymousPullSecret
+	if p.cfg.PullSecretFile != "" {
+		data, err := os.ReadFile(p.cfg.PullSecretFile)
+		if err != nil {
+			p.log.WithError(err).Errorf("Failed to read installer cache prefetch pull secret %s", p.cfg.PullSecretFile)
+			return
+		}
+		pullSecret = string(data)
+	}
+	targets, err := p.targets()
+	if err != nil {
+		p.log.WithError(err).Error("Failed to list the release images to prefetch")
+		return
+	}
+	// The binaries prefetched beyond the budget of the cache would evict the binaries prefetched before
+	budget := p.installers.budget()
+	var total, largest int64
+	for _, target := range targets {
+		if budget > 0 && total+largest > budget {
+			p.log.Infof("The installer cache is full, not prefetching the installer of release image %s and the next ones", target.releaseImage)
+			return
+		}
+		size, err := p.installers.Prefetch(ctx, target.releaseImage, p.releaseImageMirror, pullSecret, p.ocRelease, target.ocpVersion)
+		if err != nil {
+			p.log.WithError(err).Warnf("Failed to prefetch the installer of release image %s", target.releaseImage)
+			continue
+		}
+		total += size
+		if size > largest {
+			largest = size
+		}
+	}
+}
+
+// targets returns the release images used by the most clusters recently, the most used first
+func (p *Prefetcher) targets() ([]prefetchTarget, error) {
+	if p.cfg.MostUsedReleases <= 0 {
+		return nil, nil
+	}
+	var mostUsed []struct {
+		OcpReleaseImage  string
+		OpenshiftVersion string
+	}
+	err := p.db.Model(&common.Cluster{}).
+		Select("ocp_release_image, openshift_version").
+		Where("ocp_release_image <> '' AND openshift_version <> '' AND created_at > ?", time.Now().Add(-p.cfg.MostUsedWithin)).
+		Group("ocp_release_image, openshift_version").
+		Order("count(*) DESC, ocp_release_image").
+		Limit(p.cfg.MostUsedReleases).
+		Scan(&mostUsed).Error
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]prefetchTarget, 0, len(mostUsed))
+	seen := make(map[string]bool)
+	for _, release := range mostUsed {
+		if seen[release.OcpReleaseImage] {
+			continue
+		}
+		seen[release.OcpReleaseImage] = true
+		targets = append(targets, prefetchTarget{releaseImage: release.OcpReleaseImage, ocpVersion: release.OpenshiftVersion})
+	}
+	return targets, nil
+}
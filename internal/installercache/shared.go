@@ -0,0 +1,240 @@
+package installercache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/oc"
+	"github.com/pkg/errors"
+)
+
+const (
+	sharedPrefix = "installer-cache/"
+	// binaries/<sha256 of the binary>, shared by the releases with the same binary
+	sharedBinariesPrefix = sharedPrefix + "binaries/"
+	// releases/<sha256 of the release image and of the binary name>, pointing to the binary
+	sharedReleasesPrefix = sharedPrefix + "releases/"
+)
+
+// sharedRelease is the entry of a release in the shared storage
+type sharedRelease struct {
+	ReleaseImage string `json:"release_image"`
+	Binary       string `json:"binary"`
+	SHA256       string `json:"sha256"`
+	Size         int64  `json:"size"`
+}
+
+func sharedReleaseObjectName(releaseImage, binary string) string {
+	digest := sha256.Sum256([]byte(releaseImage + "\n" + binary))
+	return sharedReleasesPrefix + hex.EncodeToString(digest[:])
+}
+
+func sharedBinaryObjectName(digest string) string {
+	return sharedBinariesPrefix + digest
+}
+
+// downloadShared copies the binary of the release from the shared storage to path,
+// returns false if the cache isn't shared, the binary wasn't stored yet or it
+// failed to be downloaded
+func (i *Installers) downloadShared(ctx context.Context, releaseImage, binary, path string) bool {
+	if i.sharedStorage == nil {
+		return false
+	}
+	log := i.log.WithField("release", releaseImage)
+	entry, err := i.getSharedRelease(ctx, releaseImage, binary)
+	if err != nil {
+		if _, ok := err.(common.NotFound); !ok {
+			log.WithError(err).Warnf("Failed to get %s binary from the shared installer cache", binary)
+		}
+		return false
+	}
+	if err = i.downloadSharedBinary(ctx, entry, path); err != nil {
+		log.WithError(err).Warnf("Failed to download %s binary from the shared installer cache", binary)
+		return false
+	}
+	log.Infof("Downloaded %s binary from the shared installer cache to %s", binary, path)
+	return true
+}
+
+func (i *Installers) getSharedRelease(ctx context.Context, releaseImage, binary string) (*sharedRelease, error) {
+	reader, _, err := i.sharedStorage.Download(ctx, sharedReleaseObjectName(releaseImage, binary))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	var entry sharedRelease
+	if err = json.NewDecoder(reader).Decode(&entry); err != nil {
+		return nil, errors.Wrap(err, "failed to decode shared installer cache entry")
+	}
+	if entry.ReleaseImage != releaseImage || entry.Binary != binary {
+		return nil, errors.Errorf("shared installer cache entry is for %s binary of %s", entry.Binary, entry.ReleaseImage)
+	}
+	return &entry, nil
+}
+
+// downloadSharedBinary writes the binary to a temporary file verified against the
+// content hash of the entry before moving it to path, so that an interrupted or
+// corrupted download is never used
+func (i *Installers) downloadSharedBinary(ctx context.Context, entry *sharedRelease, path string) error {
+	reader, _, err := i.sharedStorage.Download(ctx, sharedBinaryObjectName(entry.SHA256))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "download_"+entry.Binary)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), reader)
+	if err != nil {
+		return err
+	}
+	if digest := hex.EncodeToString(hash.Sum(nil)); digest != entry.SHA256 || size != entry.Size {
+		return errors.Errorf("downloaded binary doesn't match the content hash %s (%d bytes), got %s (%d bytes)",
+			entry.SHA256, entry.Size, digest, size)
+	}
+	if err = file.Chmod(0755); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// uploadShared stores the binary extracted to path in the shared storage. The
+// failures are only logged as the binary is still cached on the pod.
+func (i *Installers) uploadShared(ctx context.Context, releaseImage, binary, path string) {
+	if i.sharedStorage == nil {
+		return
+	}
+	log := i.log.WithField("release", releaseImage)
+	if err := i.uploadSharedBinary(ctx, releaseImage, binary, path); err != nil {
+		log.WithError(err).Warnf("Failed to upload %s binary to the shared installer cache", binary)
+		return
+	}
+	log.Infof("Uploaded %s binary to the shared installer cache", binary)
+}
+
+func (i *Installers) uploadSharedBinary(ctx context.Context, releaseImage, binary, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+	entry := sharedRelease{
+		ReleaseImage: releaseImage,
+		Binary:       binary,
+		SHA256:       hex.EncodeToString(hash.Sum(nil)),
+		Size:         size,
+	}
+
+	// The binaries are stored by content, the binary may have been stored for another release already
+	binaryObjectName := sharedBinaryObjectName(entry.SHA256)
+	exists, err := i.sharedStorage.DoesObjectExist(ctx, binaryObjectName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err = i.sharedStorage.UploadFile(ctx, path, binaryObjectName); err != nil {
+			return err
+		}
+	}
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	// The entry is stored last so that it never points to a missing binary
+	return i.sharedStorage.Upload(ctx, data, sharedReleaseObjectName(releaseImage, binary))
+}
+
+// Prefetch makes sure that the binary of the release is cached: in the shared
+// storage when the cache is shared, and on the pod otherwise. The binary is
+// extracted to a directory of its own without holding the lock of the cache, so
+// that the installations aren't blocked meanwhile. Returns the size of the binary.
+func (i *Installers) Prefetch(ctx context.Context, releaseID, releaseIDMirror, pullSecret string, ocRelease oc.Release, ocpVersion string) (int64, error) {
+	releaseImageLocation := releaseID
+	if releaseIDMirror != "" {
+		releaseImageLocation = releaseIDMirror
+	}
+	_, binary, path, err := ocRelease.GetReleaseBinaryPath(releaseImageLocation, i.cacheDir, ocpVersion)
+	if err != nil {
+		return 0, err
+	}
+	if i.sharedStorage != nil {
+		entry, err := i.getSharedRelease(ctx, releaseImageLocation, binary)
+		if err == nil {
+			return entry.Size, nil
+		}
+		if _, ok := err.(common.NotFound); !ok {
+			return 0, err
+		}
+	} else if info, err := os.Stat(path); err == nil {
+		return info.Size(), nil
+	}
+
+	if err = os.MkdirAll(i.cacheDir, 0755); err != nil {
+		return 0, err
+	}
+	extractDir, err := os.MkdirTemp(i.cacheDir, prefetchDirPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(extractDir)
+	_, _, extractedPath, err := ocRelease.GetReleaseBinaryPath(releaseImageLocation, extractDir, ocpVersion)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	_, err = ocRelease.Extract(i.log, releaseID, releaseIDMirror, extractDir, pullSecret, ocpVersion)
+	i.metricsAPI.InstallerCacheGetRelease(getReleaseMiss)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to prefetch the installer of %s", releaseID)
+	}
+	i.metricsAPI.InstallerCacheExtractionDuration(time.Since(start))
+	info, err := os.Stat(extractedPath)
+	if err != nil {
+		return 0, err
+	}
+	i.uploadShared(ctx, releaseImageLocation, binary, extractedPath)
+
+	i.Lock()
+	defer i.Unlock()
+	if _, err = os.Stat(path); err == nil {
+		// Extracted by an installation meanwhile
+		return info.Size(), nil
+	}
+	i.evict()
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, err
+	}
+	if err = os.Rename(extractedPath, path); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// IsShared returns true if the binaries are shared by the replicas
+func (i *Installers) IsShared() bool {
+	return i.sharedStorage != nil
+}
@@ -0,0 +1,190 @@
+package logsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const dbBatchSize = 1000
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// DBStore stores the indexed lines in the log_lines table
+type DBStore struct {
+	db *gorm.DB
+}
+
+var _ Store = &DBStore{}
+
+func NewDBStore(db *gorm.DB) *DBStore {
+	return &DBStore{db: db}
+}
+
+// CreateTextIndex creates the trigram index of the text of the lines, and the pg_trgm extension it requires. Only a
+// superuser can create the extension before PostgreSQL 13, the searches scanning the lines of the cluster without the
+// index.
+func (s *DBStore) CreateTextIndex(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return errors.Wrap(err, "failed to create the pg_trgm extension")
+	}
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_log_lines_text_trgm ON log_lines USING gin (text gin_trgm_ops)").Error; err != nil {
+		return errors.Wrap(err, "failed to create the trigram index of the log lines")
+	}
+	return nil
+}
+
+func (s *DBStore) NewWriter(ctx context.Context, source Source, uploadID string) (Writer, error) {
+	return &dbWriter{
+		db:        s.db.WithContext(ctx),
+		source:    source,
+		uploadID:  uploadID,
+		indexedAt: time.Now(),
+	}, nil
+}
+
+func (s *DBStore) Search(ctx context.Context, query *Query) ([]*models.LogSearchMatch, bool, error) {
+	db := s.db.WithContext(ctx)
+	// The substring search uses the trigram index of the text when it exists (see CreateTextIndex)
+	tx := db.Where("cluster_id = ? AND text ILIKE ?", query.ClusterID.String(), contains(query.Text))
+	if query.HostID != nil {
+		tx = tx.Where("host_id = ?", query.HostID.String())
+	}
+	if query.LogsType != "" {
+		tx = tx.Where("logs_type = ?", string(query.LogsType))
+	}
+	if query.File != "" {
+		tx = tx.Where("file LIKE ?", contains(query.File))
+	}
+	if query.Since != nil {
+		tx = tx.Where("timestamp >= ?", *query.Since)
+	}
+	if query.Until != nil {
+		tx = tx.Where("timestamp <= ?", *query.Until)
+	}
+	if !query.IndexedAfter.IsZero() {
+		tx = tx.Where("created_at >= ?", query.IndexedAfter)
+	}
+
+	var lines []*common.LogLine
+	if err := tx.Order("logs_type, host_id, file, line").Limit(query.Limit + 1).Find(&lines).Error; err != nil {
+		return nil, false, errors.Wrapf(err, "failed to search the logs of cluster %s", query.ClusterID)
+	}
+	truncated := len(lines) > query.Limit
+	if truncated {
+		lines = lines[:query.Limit]
+	}
+
+	matches := make([]*models.LogSearchMatch, 0, len(lines))
+	for _, line := range lines {
+		match := newMatch(line.HostID, models.LogsType(line.LogsType),
+			&Line{File: line.File, Number: line.Line, Timestamp: line.Timestamp, Text: line.Text})
+		if query.Context > 0 {
+			if err := s.addContext(db, match, line, query.Context); err != nil {
+				return nil, false, err
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches, truncated, nil
+}
+
+func (s *DBStore) addContext(db *gorm.DB, match *models.LogSearchMatch, line *common.LogLine, context int) error {
+	var lines []*common.LogLine
+	err := db.Select("line", "text").
+		Where("cluster_id = ? AND upload_id = ? AND file = ? AND line BETWEEN ? AND ? AND line <> ?",
+			line.ClusterID.String(), line.UploadID, line.File, line.Line-int64(context), line.Line+int64(context), line.Line).
+		Order("line").Find(&lines).Error
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the lines around line %d of %s", line.Line, line.File)
+	}
+	for _, l := range lines {
+		if l.Line < line.Line {
+			match.ContextBefore = append(match.ContextBefore, l.Text)
+		} else {
+			match.ContextAfter = append(match.ContextAfter, l.Text)
+		}
+	}
+	return nil
+}
+
+func (s *DBStore) DeleteExpired(ctx context.Context, olderThan time.Time) error {
+	db := s.db.WithContext(ctx)
+	clusters := db.Unscoped().Model(&common.Cluster{}).Select("id")
+	if err := db.Where("created_at < ? OR cluster_id NOT IN (?)", olderThan, clusters).Delete(&common.LogLine{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete the expired log lines")
+	}
+	return nil
+}
+
+func contains(text string) string {
+	return fmt.Sprintf("%%%s%%", likeEscaper.Replace(text))
+}
+
+type dbWriter struct {
+	db        *gorm.DB
+	source    Source
+	uploadID  string
+	indexedAt time.Time
+	batch     []*common.LogLine
+}
+
+func (w *dbWriter) Write(line *Line) error {
+	w.batch = append(w.batch, &common.LogLine{
+		ClusterID: w.source.ClusterID,
+		HostID:    w.source.HostID,
+		LogsType:  string(w.source.LogsType),
+		UploadID:  w.uploadID,
+		File:      line.File,
+		Line:      line.Number,
+		Timestamp: line.Timestamp,
+		Text:      line.Text,
+		CreatedAt: w.indexedAt,
+	})
+	if len(w.batch) < dbBatchSize {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *dbWriter) flush() error {
+	if len(w.batch) == 0 {
+		return nil
+	}
+	if err := w.db.Create(&w.batch).Error; err != nil {
+		return errors.Wrapf(err, "failed to store the lines of the %s logs", w.source.name())
+	}
+	w.batch = w.batch[:0]
+	return nil
+}
+
+func (w *dbWriter) sourceLines() *gorm.DB {
+	tx := w.db.Where("cluster_id = ? AND logs_type = ?", w.source.ClusterID.String(), string(w.source.LogsType))
+	if w.source.HostID != nil {
+		return tx.Where("host_id = ?", w.source.HostID.String())
+	}
+	return tx.Where("host_id IS NULL")
+}
+
+func (w *dbWriter) Commit() error {
+	if err := w.flush(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.sourceLines().Where("upload_id <> ?", w.uploadID).Delete(&common.LogLine{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete the previous lines of the %s logs", w.source.name())
+	}
+	return nil
+}
+
+func (w *dbWriter) Abort() {
+	w.batch = nil
+	w.db.Where("upload_id = ?", w.uploadID).Delete(&common.LogLine{})
+}
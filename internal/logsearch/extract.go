@@ -0,0 +1,212 @@
+package logsearch
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// maxArchiveDepth bounds the nesting of the archives in the uploaded logs, e.g. the logs of the controller include
+// the archives of the must-gather
+const maxArchiveDepth = 3
+
+var errTooManyLines = errors.New("too many lines")
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+
+	// logrus text format, e.g. time="2023-05-04T10:15:00Z" level=info msg="..."
+	logrusTimestamp = regexp.MustCompile(`^time="([^"]+)"`)
+	// ISO 8601, with a T or a space between the date and the time, e.g. 2023-05-04 10:15:00.123+00:00
+	isoTimestamp = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})[T ](\d{2}:\d{2}:\d{2})(?:[.,](\d{1,9}))?(Z|[+-]\d{2}:?\d{2})?`)
+	// syslog and journal short format, e.g. May 04 10:15:00
+	syslogTimestamp = regexp.MustCompile(`^[A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}`)
+	// klog header, e.g. I0504 10:15:00.123456
+	klogTimestamp = regexp.MustCompile(`^[IWEF](\d{4} \d{2}:\d{2}:\d{2}\.\d{6})`)
+)
+
+// WalkLines calls fn with each line of the text files of the tarball read by r. The nested tarballs and the gzipped
+// files are read as well. now completes the times of the lines which don't tell their year.
+func WalkLines(r io.Reader, maxLineLength int, now time.Time, fn func(line *Line) error) error {
+	w := &lineWalker{maxLineLength: maxLineLength, now: now, fn: fn}
+	return w.walkArchive(r, "", 0)
+}
+
+type lineWalker struct {
+	maxLineLength int
+	now           time.Time
+	fn            func(line *Line) error
+}
+
+func (w *lineWalker) walkArchive(r io.Reader, prefix string, depth int) error {
+	r, err := decompress(r)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress %s", archiveName(prefix))
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", archiveName(prefix))
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err = w.walkFile(tr, path.Join(prefix, path.Clean("/" + header.Name)[1:]), depth); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *lineWalker) walkFile(r io.Reader, name string, depth int) error {
+	for _, extension := range []string{".tar.gz", ".tgz", ".tar"} {
+		if strings.HasSuffix(name, extension) {
+			if depth >= maxArchiveDepth {
+				return nil
+			}
+			return w.walkArchive(r, strings.TrimSuffix(name, extension), depth+1)
+		}
+	}
+	if strings.HasSuffix(name, ".gz") {
+		gzipReader, err := gzip.NewReader(r)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decompress %s", name)
+		}
+		defer gzipReader.Close()
+		return w.walkText(gzipReader, strings.TrimSuffix(name, ".gz"))
+	}
+	return w.walkText(r, name)
+}
+
+func (w *lineWalker) walkText(r io.Reader, name string) error {
+	reader := bufio.NewReader(r)
+	if head, _ := reader.Peek(512); bytes.IndexByte(head, 0) >= 0 {
+		// binary file
+		return nil
+	}
+
+	var (
+		number    int64
+		timestamp *time.Time
+	)
+	for {
+		text, err := readLine(reader, w.maxLineLength)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", name)
+		}
+		number++
+		if t := parseTimestamp(text, w.now); t != nil {
+			timestamp = t
+		}
+		if err = w.fn(&Line{File: name, Number: number, Timestamp: timestamp, Text: text}); err != nil {
+			return err
+		}
+	}
+}
+
+// readLine returns the next line of r, truncated to maxLength bytes and made valid UTF-8
+func readLine(r *bufio.Reader, maxLength int) (string, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := r.ReadLine()
+		if err != nil {
+			return "", err
+		}
+		if room := maxLength - len(line); room > 0 {
+			if len(chunk) > room {
+				chunk = chunk[:room]
+			}
+			line = append(line, chunk...)
+		}
+		if !isPrefix {
+			break
+		}
+	}
+	text := string(bytes.ReplaceAll(line, []byte{0}, nil))
+	if !utf8.ValidString(text) {
+		text = strings.ToValidUTF8(text, string(utf8.RuneError))
+	}
+	return text, nil
+}
+
+func decompress(r io.Reader) (io.Reader, error) {
+	reader := bufio.NewReader(r)
+	if head, _ := reader.Peek(len(gzipMagic)); !bytes.Equal(head, gzipMagic) {
+		return reader, nil
+	}
+	return gzip.NewReader(reader)
+}
+
+func archiveName(prefix string) string {
+	if prefix == "" {
+		return "the uploaded logs"
+	}
+	return prefix
+}
+
+// parseTimestamp returns the time at the beginning of the line, nil when there is none
+func parseTimestamp(text string, now time.Time) *time.Time {
+	text = strings.TrimLeft(text, " \t[")
+	if m := logrusTimestamp.FindStringSubmatch(text); m != nil {
+		text = m[1]
+	}
+
+	if m := isoTimestamp.FindStringSubmatch(text); m != nil {
+		value := m[1] + "T" + m[2]
+		if m[3] != "" {
+			value += "." + m[3]
+		}
+		switch zone := m[4]; {
+		case zone == "":
+			value += "Z"
+		case len(zone) == 5:
+			value += zone[:3] + ":" + zone[3:]
+		default:
+			value += zone
+		}
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			t = t.UTC()
+			return &t
+		}
+		return nil
+	}
+
+	if m := syslogTimestamp.FindString(text); m != "" {
+		if t, err := time.Parse(time.Stamp, m); err == nil {
+			return withYear(t, now)
+		}
+		return nil
+	}
+
+	if m := klogTimestamp.FindStringSubmatch(text); m != nil {
+		if t, err := time.Parse("0102 15:04:05.000000", m[1]); err == nil {
+			return withYear(t, now)
+		}
+	}
+	return nil
+}
+
+// withYear sets the year of t to the one of now, or to the previous one when t would be in the future
+func withYear(t time.Time, now time.Time) *time.Time {
+	now = now.UTC()
+	t = time.Date(now.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return &t
+}
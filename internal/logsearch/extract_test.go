@@ -0,0 +1,148 @@
+package logsearch
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type testFile struct {
+	name    string
+	content []byte
+}
+
+func tarball(compress bool, files ...testFile) []byte {
+	var buf bytes.Buffer
+	var tw *tar.Writer
+	var gw *gzip.Writer
+	if compress {
+		gw = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gw)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+	for _, f := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0600, Size: int64(len(f.content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(f.content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	if gw != nil {
+		Expect(gw.Close()).To(Succeed())
+	}
+	return buf.Bytes()
+}
+
+func gzipped(content []byte) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(content)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func collectLines(data []byte, maxLineLength int) []*Line {
+	var lines []*Line
+	err := WalkLines(bytes.NewReader(data), maxLineLength, time.Now(), func(line *Line) error {
+		lines = append(lines, line)
+		return nil
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return lines
+}
+
+var _ = Describe("WalkLines", func() {
+	It("reads the text files of the nested archives", func() {
+		data := tarball(true,
+			testFile{name: "logs/agent.logs", content: []byte("first\nsecond\n")},
+			testFile{name: "logs/journal.gz", content: gzipped([]byte("gzipped\n"))},
+			testFile{name: "logs/must-gather.tar.gz", content: tarball(true,
+				testFile{name: "./namespaces/pod.log", content: []byte("nested")})},
+			testFile{name: "logs/binary", content: []byte("bin\x00ary\n")},
+		)
+		lines := collectLines(data, 100)
+		Expect(lines).To(HaveLen(4))
+		Expect(*lines[0]).To(Equal(Line{File: "logs/agent.logs", Number: 1, Text: "first"}))
+		Expect(*lines[1]).To(Equal(Line{File: "logs/agent.logs", Number: 2, Text: "second"}))
+		Expect(*lines[2]).To(Equal(Line{File: "logs/journal", Number: 1, Text: "gzipped"}))
+		Expect(*lines[3]).To(Equal(Line{File: "logs/must-gather/namespaces/pod.log", Number: 1, Text: "nested"}))
+	})
+
+	It("reads an uncompressed tarball", func() {
+		lines := collectLines(tarball(false, testFile{name: "a.log", content: []byte("line")}), 100)
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0].File).To(Equal("a.log"))
+	})
+
+	It("stops at the maximal archive depth", func() {
+		data := tarball(false, testFile{name: "a.log", content: []byte("top")})
+		for i := 0; i <= maxArchiveDepth; i++ {
+			data = tarball(true, testFile{name: "nested.tar.gz", content: data})
+		}
+		Expect(collectLines(data, 100)).To(BeEmpty())
+	})
+
+	It("truncates the long lines", func() {
+		lines := collectLines(tarball(true, testFile{name: "a.log", content: []byte(strings.Repeat("x", 100) + "\nshort")}), 10)
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0].Text).To(Equal(strings.Repeat("x", 10)))
+		Expect(lines[1].Text).To(Equal("short"))
+	})
+
+	It("inherits the timestamp of the previous line", func() {
+		lines := collectLines(tarball(true, testFile{name: "a.log",
+			content: []byte("no time\n2023-05-04T10:15:00Z first\n  continued\n")}), 100)
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0].Timestamp).To(BeNil())
+		expected := time.Date(2023, 5, 4, 10, 15, 0, 0, time.UTC)
+		Expect(*lines[1].Timestamp).To(Equal(expected))
+		Expect(*lines[2].Timestamp).To(Equal(expected))
+	})
+
+	It("returns the errors of the callback", func() {
+		err := WalkLines(bytes.NewReader(tarball(true, testFile{name: "a.log", content: []byte("line")})), 100, time.Now(),
+			func(*Line) error { return errTooManyLines })
+		Expect(err).To(Equal(errTooManyLines))
+	})
+
+	It("fails on a corrupted tarball", func() {
+		err := WalkLines(bytes.NewReader([]byte("not a tarball at all")), 100, time.Now(), func(*Line) error { return nil })
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("parseTimestamp", func() {
+	now := time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	DescribeTable("parses the time at the beginning of the line",
+		func(text string, expected *time.Time) {
+			t := parseTimestamp(text, now)
+			if expected == nil {
+				Expect(t).To(BeNil())
+			} else {
+				Expect(t).ToNot(BeNil())
+				Expect(*t).To(Equal(*expected))
+			}
+		},
+		Entry("logrus", `time="2023-05-04T10:15:00Z" level=info msg="hello"`, timePtr(2023, 5, 4, 10, 15, 0, 0)),
+		Entry("ISO with zone", "2023-05-04T12:15:00.5+02:00 hello", timePtr(2023, 5, 4, 10, 15, 0, 500000000)),
+		Entry("ISO with space and compact zone", "2023-05-04 12:15:00+0200 hello", timePtr(2023, 5, 4, 10, 15, 0, 0)),
+		Entry("ISO without zone", "[2023-05-04 10:15:00,123] hello", timePtr(2023, 5, 4, 10, 15, 0, 123000000)),
+		Entry("syslog", "May  4 10:15:00 host agent[1]: hello", timePtr(2023, 5, 4, 10, 15, 0, 0)),
+		Entry("syslog of the previous year", "Dec 31 10:15:00 host agent[1]: hello", timePtr(2022, 12, 31, 10, 15, 0, 0)),
+		Entry("klog", "I0504 10:15:00.000001 1 main.go:10] hello", timePtr(2023, 5, 4, 10, 15, 0, 1000)),
+		Entry("no time", "hello 2023-05-04T10:15:00Z", nil),
+	)
+})
+
+func timePtr(year int, month time.Month, day, hour, min, sec, nsec int) *time.Time {
+	t := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	return &t
+}
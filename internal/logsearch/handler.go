@@ -0,0 +1,88 @@
+package logsearch
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/log_search"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.LogSearchAPI = (*Handler)(nil)
+
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, logSearchApi API) *Handler {
+	return &Handler{
+		log:          log,
+		db:           db,
+		logSearchApi: logSearchApi,
+	}
+}
+
+// Handler searches the indexed logs of clusters
+type Handler struct {
+	log          logrus.FieldLogger
+	db           *gorm.DB
+	logSearchApi API
+}
+
+func (h *Handler) V2SearchClusterLogs(ctx context.Context, params operations.V2SearchClusterLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !h.logSearchApi.Enabled() {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Log search is not enabled"))
+	}
+	c, err := common.GetClusterFromDB(h.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	query := &Query{
+		ClusterID:    params.ClusterID,
+		Text:         params.Q,
+		HostID:       params.HostID,
+		File:         swag.StringValue(params.File),
+		Since:        dateTimePtr(params.Since),
+		Until:        dateTimePtr(params.Until),
+		IndexedAfter: time.Time(c.InstallStartedAt),
+		Context:      int(swag.Int64Value(params.ContextLines)),
+		Limit:        int(swag.Int64Value(params.Limit)),
+	}
+	if params.LogsType != nil {
+		query.LogsType = models.LogsType(*params.LogsType)
+	}
+	matches, truncated, err := h.logSearchApi.Search(ctx, query)
+	if err != nil {
+		log.WithError(err).Errorf("failed to search the logs of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	hostNames := make(map[strfmt.UUID]string)
+	for _, host := range c.Hosts {
+		hostNames[*host.ID] = hostutil.GetHostnameForMsg(host)
+	}
+	for _, match := range matches {
+		match.HostName = hostNames[match.HostID]
+	}
+	return operations.NewV2SearchClusterLogsOK().WithPayload(&models.LogSearchResult{
+		Matches:   matches,
+		Truncated: truncated,
+	})
+}
+
+func dateTimePtr(value *strfmt.DateTime) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t := time.Time(*value)
+	return &t
+}
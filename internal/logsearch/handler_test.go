@@ -0,0 +1,114 @@
+package logsearch
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/log_search"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Log search handler", func() {
+	var (
+		ctx           = context.Background()
+		ctrl          *gomock.Controller
+		db            *gorm.DB
+		dbName        string
+		mockSearchApi *MockAPI
+		handler       *Handler
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+		installedAt   time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockSearchApi = NewMockAPI(ctrl)
+		handler = NewHandler(common.GetTestLog(), db, mockSearchApi)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		installedAt = time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			InstallStartedAt: strfmt.DateTime(installedAt),
+		}}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:                &hostID,
+			ClusterID:         &clusterID,
+			InfraEnvID:        clusterID,
+			RequestedHostname: "master-0",
+		}}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("fails when log search is disabled", func() {
+		mockSearchApi.EXPECT().Enabled().Return(false)
+		reply := handler.V2SearchClusterLogs(ctx, operations.V2SearchClusterLogsParams{ClusterID: clusterID, Q: "error"})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("fails when the cluster doesn't exist", func() {
+		mockSearchApi.EXPECT().Enabled().Return(true)
+		reply := handler.V2SearchClusterLogs(ctx, operations.V2SearchClusterLogsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+			Q:         "error",
+		})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("returns the matches with the names of the hosts", func() {
+		logsType := string(models.LogsTypeHost)
+		mockSearchApi.EXPECT().Enabled().Return(true)
+		mockSearchApi.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, query *Query) ([]*models.LogSearchMatch, bool, error) {
+				Expect(query.ClusterID).To(Equal(clusterID))
+				Expect(query.Text).To(Equal("error"))
+				Expect(query.LogsType).To(Equal(models.LogsTypeHost))
+				Expect(query.File).To(Equal("agent"))
+				Expect(query.Context).To(Equal(2))
+				Expect(query.Limit).To(Equal(10))
+				Expect(query.IndexedAfter).To(BeTemporally("==", installedAt))
+				return []*models.LogSearchMatch{{HostID: hostID, LogsType: models.LogsTypeHost, File: "agent.logs", Line: 3, Text: "error"}},
+					true, nil
+			})
+		reply := handler.V2SearchClusterLogs(ctx, operations.V2SearchClusterLogsParams{
+			ClusterID:    clusterID,
+			Q:            "error",
+			LogsType:     &logsType,
+			File:         swag.String("agent"),
+			ContextLines: swag.Int64(2),
+			Limit:        swag.Int64(10),
+		})
+		Expect(reply).To(BeAssignableToTypeOf(&operations.V2SearchClusterLogsOK{}))
+		result := reply.(*operations.V2SearchClusterLogsOK).Payload
+		Expect(result.Truncated).To(BeTrue())
+		Expect(result.Matches).To(HaveLen(1))
+		Expect(result.Matches[0].HostName).To(Equal("master-0"))
+	})
+
+	It("fails when the search fails", func() {
+		mockSearchApi.EXPECT().Enabled().Return(true)
+		mockSearchApi.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, false, errors.New("search failed"))
+		reply := handler.V2SearchClusterLogs(ctx, operations.V2SearchClusterLogsParams{ClusterID: clusterID, Q: "error"})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusInternalServerError)))
+	})
+})
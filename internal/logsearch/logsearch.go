@@ -0,0 +1,280 @@
+package logsearch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// BackendDatabase stores the indexed lines in the database
+	BackendDatabase = "database"
+	// BackendObjectStore stores the indexed lines in the object store, next to the uploaded logs
+	BackendObjectStore = "object-store"
+)
+
+type Config struct {
+	// The backend storing the index of the uploaded logs. Log search is disabled when it is empty.
+	Backend           string `envconfig:"LOG_SEARCH_BACKEND" default:""`
+	IndexWorkers      int    `envconfig:"LOG_SEARCH_INDEX_WORKERS" default:"2"`
+	MaxLineLength     int    `envconfig:"LOG_SEARCH_MAX_LINE_LENGTH" default:"2048"`
+	MaxLinesPerUpload int64  `envconfig:"LOG_SEARCH_MAX_LINES_PER_UPLOAD" default:"1000000"`
+	// The interval of the indexer, checking the pending uploads
+	IndexInterval time.Duration `envconfig:"LOG_SEARCH_INDEX_INTERVAL" default:"10s"`
+	// An upload claimed by an indexer is retried by the other replicas once this time has passed
+	IndexTimeout     time.Duration `envconfig:"LOG_SEARCH_INDEX_TIMEOUT" default:"30m"`
+	MaxIndexAttempts int           `envconfig:"LOG_SEARCH_MAX_INDEX_ATTEMPTS" default:"3"`
+}
+
+// Source identifies the logs uploaded for a cluster by one of its hosts or by its controller
+type Source struct {
+	ClusterID strfmt.UUID
+	HostID    *strfmt.UUID
+	LogsType  models.LogsType
+}
+
+func (s Source) name() string {
+	if s.HostID != nil {
+		return s.HostID.String()
+	}
+	return string(s.LogsType)
+}
+
+// Query selects the indexed lines of the logs of a cluster
+type Query struct {
+	ClusterID strfmt.UUID
+	// The text searched in the lines, case-insensitive
+	Text     string
+	HostID   *strfmt.UUID
+	LogsType models.LogsType
+	// Only the files whose path contains File are searched
+	File  string
+	Since *time.Time
+	Until *time.Time
+	// The lines indexed before IndexedAfter belong to logs which were deleted, they are ignored
+	IndexedAfter time.Time
+	// The number of lines returned before and after each matching line
+	Context int
+	Limit   int
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=logsearch -destination=mock_logsearch_api.go . API
+type API interface {
+	// Enabled tells whether the uploaded logs are indexed
+	Enabled() bool
+	// IndexLogs queues the logs tarball uploaded for the source to objectName, to be indexed by IndexPending
+	IndexLogs(ctx context.Context, source Source, objectName string)
+	// IndexPending indexes the queued uploads, at most IndexWorkers at the same time
+	IndexPending()
+	// Search returns the indexed lines matching the query, and whether more lines match it
+	Search(ctx context.Context, query *Query) ([]*models.LogSearchMatch, bool, error)
+	// DeleteExpiredIndexes deletes the lines indexed before olderThan and the lines of the deleted clusters
+	DeleteExpiredIndexes(ctx context.Context, olderThan time.Time) error
+}
+
+type Manager struct {
+	Config
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	objectHandler s3wrapper.API
+	store         Store
+}
+
+var _ API = &Manager{}
+
+func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API) (*Manager, error) {
+	if cfg.IndexWorkers < 1 {
+		cfg.IndexWorkers = 1
+	}
+	m := &Manager{
+		Config:        cfg,
+		log:           log,
+		db:            db,
+		objectHandler: objectHandler,
+	}
+	switch cfg.Backend {
+	case "":
+	case BackendDatabase:
+		store := NewDBStore(db)
+		if err := store.CreateTextIndex(context.Background()); err != nil {
+			log.WithError(err).Warn("Log search is enabled without the trigram index of the log lines, the searches will be slower")
+		}
+		m.store = store
+	case BackendObjectStore:
+		m.store = NewObjectStore(objectHandler)
+	default:
+		return nil, errors.Errorf("unknown log search backend %s, expected %s or %s", cfg.Backend, BackendDatabase, BackendObjectStore)
+	}
+	return m, nil
+}
+
+func (m *Manager) Enabled() bool {
+	return m.store != nil
+}
+
+func (m *Manager) IndexLogs(ctx context.Context, source Source, objectName string) {
+	if m.store == nil {
+		return
+	}
+	log := logutil.FromContext(ctx, m.log).WithField("object", objectName)
+	job := &common.LogIndexJob{
+		ClusterID:     source.ClusterID,
+		HostID:        source.HostID,
+		LogsType:      string(source.LogsType),
+		ObjectName:    objectName,
+		NextAttemptAt: time.Now(),
+	}
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		// A new upload of the source replaces the one still waiting to be indexed
+		if err := sourceJobs(tx, source).Where("next_attempt_at <= ?", time.Now()).
+			Delete(&common.LogIndexJob{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(job).Error
+	})
+	if err != nil {
+		log.WithError(err).Warnf("failed to queue the indexing of the %s logs of cluster %s", source.name(), source.ClusterID)
+	}
+}
+
+func sourceJobs(tx *gorm.DB, source Source) *gorm.DB {
+	tx = tx.Where("cluster_id = ? AND logs_type = ?", source.ClusterID.String(), string(source.LogsType))
+	if source.HostID != nil {
+		return tx.Where("host_id = ?", source.HostID.String())
+	}
+	return tx.Where("host_id IS NULL")
+}
+
+// IndexPending indexes the uploads waiting in the log_index_jobs table. The failed uploads are retried on the next
+// runs, until MaxIndexAttempts is reached.
+func (m *Manager) IndexPending() {
+	if m.store == nil {
+		return
+	}
+	jobs, err := m.claimJobs()
+	if err != nil {
+		m.log.WithError(err).Error("failed to claim the pending log indexing")
+		return
+	}
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job *common.LogIndexJob) {
+			defer wg.Done()
+			m.runJob(job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// claimJobs postpones at most IndexWorkers pending uploads by IndexTimeout, so that the indexers of the other
+// service replicas skip them while they are indexed
+func (m *Manager) claimJobs() ([]*common.LogIndexJob, error) {
+	var jobs []*common.LogIndexJob
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("next_attempt_at <= ?", now).Order("id").Limit(m.IndexWorkers).Find(&jobs).Error; err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+		ids := make([]uint, 0, len(jobs))
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		return tx.Model(&common.LogIndexJob{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(m.IndexTimeout)).Error
+	})
+	return jobs, err
+}
+
+func (m *Manager) runJob(job *common.LogIndexJob) {
+	source := Source{ClusterID: job.ClusterID, HostID: job.HostID, LogsType: models.LogsType(job.LogsType)}
+	log := m.log.WithFields(logrus.Fields{"cluster_id": job.ClusterID, "object": job.ObjectName})
+	ctx, cancel := context.WithTimeout(context.Background(), m.IndexTimeout)
+	defer cancel()
+
+	lines, err := m.index(ctx, source, job.ObjectName)
+	if err == nil {
+		log.Infof("indexed %d lines of the %s logs of cluster %s", lines, source.name(), source.ClusterID)
+		m.deleteJob(log, job)
+		return
+	}
+
+	job.Attempts++
+	if job.Attempts >= m.MaxIndexAttempts {
+		log.WithError(err).Warnf("failed to index the %s logs of cluster %s after %d attempts", source.name(), source.ClusterID, job.Attempts)
+		m.deleteJob(log, job)
+		return
+	}
+	log.WithError(err).Warnf("failed to index the %s logs of cluster %s, retrying", source.name(), source.ClusterID)
+	err = m.db.Model(job).Updates(map[string]interface{}{
+		"attempts":        job.Attempts,
+		"next_attempt_at": time.Now().Add(m.IndexInterval),
+		"last_error":      err.Error(),
+	}).Error
+	if err != nil {
+		log.WithError(err).Error("failed to update the log indexing")
+	}
+}
+
+func (m *Manager) deleteJob(log logrus.FieldLogger, job *common.LogIndexJob) {
+	if err := m.db.Delete(job).Error; err != nil {
+		log.WithError(err).Error("failed to delete the log indexing")
+	}
+}
+
+func (m *Manager) index(ctx context.Context, source Source, objectName string) (int64, error) {
+	reader, _, err := m.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer reader.Close()
+
+	writer, err := m.store.NewWriter(ctx, source, uuid.New().String())
+	if err != nil {
+		return 0, err
+	}
+	var lines int64
+	err = WalkLines(reader, m.MaxLineLength, time.Now(), func(line *Line) error {
+		if m.MaxLinesPerUpload > 0 && lines >= m.MaxLinesPerUpload {
+			return errTooManyLines
+		}
+		lines++
+		return writer.Write(line)
+	})
+	if err != nil && !errors.Is(err, errTooManyLines) {
+		writer.Abort()
+		return lines, err
+	}
+	if err != nil {
+		logutil.FromContext(ctx, m.log).Warnf("only the first %d lines of %s are indexed", lines, objectName)
+	}
+	return lines, writer.Commit()
+}
+
+func (m *Manager) Search(ctx context.Context, query *Query) ([]*models.LogSearchMatch, bool, error) {
+	if m.store == nil {
+		return nil, false, errors.New("log search is not enabled")
+	}
+	return m.store.Search(ctx, query)
+}
+
+func (m *Manager) DeleteExpiredIndexes(ctx context.Context, olderThan time.Time) error {
+	if m.store == nil {
+		return nil
+	}
+	return m.store.DeleteExpired(ctx, olderThan)
+}
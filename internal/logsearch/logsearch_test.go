@@ -0,0 +1,236 @@
+package logsearch
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Manager", func() {
+	It("fails on an unknown backend", func() {
+		_, err := NewManager(Config{Backend: "elastic"}, common.GetTestLog(), nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does nothing when it is disabled", func() {
+		m, err := NewManager(Config{}, common.GetTestLog(), nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Enabled()).To(BeFalse())
+		m.IndexLogs(context.Background(), Source{}, "logs.tar.gz")
+		m.IndexPending()
+		_, _, err = m.Search(context.Background(), &Query{})
+		Expect(err).To(HaveOccurred())
+		Expect(m.DeleteExpiredIndexes(context.Background(), time.Now())).To(Succeed())
+	})
+
+	for _, b := range []string{BackendObjectStore, BackendDatabase} {
+		backend := b
+
+		Context(backend, func() {
+			var (
+				ctx           = context.Background()
+				ctrl          *gomock.Controller
+				db            *gorm.DB
+				dbName        string
+				baseDir       string
+				objectHandler s3wrapper.API
+				m             *Manager
+				clusterID     strfmt.UUID
+				hostID        strfmt.UUID
+				hostSource    Source
+			)
+
+			BeforeEach(func() {
+				db, dbName = common.PrepareTestDB()
+				ctrl = gomock.NewController(GinkgoT())
+				var err error
+				baseDir, err = os.MkdirTemp("", "log-search")
+				Expect(err).ToNot(HaveOccurred())
+				mockMetrics := metrics.NewMockAPI(ctrl)
+				mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+				objectHandler = s3wrapper.NewFSClient(baseDir, common.GetTestLog(), mockMetrics, 80)
+				m, err = NewManager(Config{Backend: backend, IndexWorkers: 1, MaxLineLength: 1024, MaxLinesPerUpload: 100,
+					IndexInterval: time.Hour, IndexTimeout: time.Minute, MaxIndexAttempts: 2},
+					common.GetTestLog(), db, objectHandler)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(m.Enabled()).To(BeTrue())
+
+				clusterID = strfmt.UUID(uuid.New().String())
+				hostID = strfmt.UUID(uuid.New().String())
+				hostSource = Source{ClusterID: clusterID, HostID: &hostID, LogsType: models.LogsTypeHost}
+				Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				ctrl.Finish()
+				os.RemoveAll(baseDir)
+				common.DeleteTestDB(db, dbName)
+			})
+
+			upload := func(source Source, objectName string, files ...testFile) {
+				Expect(objectHandler.Upload(ctx, tarball(true, files...), objectName)).To(Succeed())
+				_, err := m.index(ctx, source, objectName)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			search := func(query Query) ([]*models.LogSearchMatch, bool) {
+				query.ClusterID = clusterID
+				if query.Limit == 0 {
+					query.Limit = 100
+				}
+				matches, truncated, err := m.Search(ctx, &query)
+				Expect(err).ToNot(HaveOccurred())
+				return matches, truncated
+			}
+
+			It("finds the matching lines with their context", func() {
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs",
+					content: []byte("2023-05-04T10:00:00Z one\n2023-05-04T10:01:00Z two ERROR\n2023-05-04T10:02:00Z three\nfour")})
+				matches, truncated := search(Query{Text: "error", Context: 1})
+				Expect(truncated).To(BeFalse())
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].HostID).To(Equal(hostID))
+				Expect(matches[0].LogsType).To(Equal(models.LogsTypeHost))
+				Expect(matches[0].File).To(Equal("agent.logs"))
+				Expect(matches[0].Line).To(Equal(int64(2)))
+				Expect(matches[0].Text).To(Equal("2023-05-04T10:01:00Z two ERROR"))
+				Expect(time.Time(*matches[0].Timestamp)).To(BeTemporally("==", time.Date(2023, 5, 4, 10, 1, 0, 0, time.UTC)))
+				Expect(matches[0].ContextBefore).To(Equal([]string{"2023-05-04T10:00:00Z one"}))
+				Expect(matches[0].ContextAfter).To(Equal([]string{"2023-05-04T10:02:00Z three"}))
+			})
+
+			It("applies the filters of the query", func() {
+				upload(hostSource, "host.tar.gz",
+					testFile{name: "agent.logs", content: []byte("2023-05-04T10:00:00Z failed\n2023-05-04T11:00:00Z failed")},
+					testFile{name: "journal.logs", content: []byte("failed")})
+				upload(Source{ClusterID: clusterID, LogsType: models.LogsTypeController}, "controller.tar.gz",
+					testFile{name: "controller.logs", content: []byte("failed")})
+
+				matches, _ := search(Query{Text: "FAILED"})
+				Expect(matches).To(HaveLen(4))
+				matches, _ = search(Query{Text: "failed", LogsType: models.LogsTypeController})
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].File).To(Equal("controller.logs"))
+				matches, _ = search(Query{Text: "failed", HostID: &hostID, File: "journal"})
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].File).To(Equal("journal.logs"))
+				since := time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC)
+				matches, _ = search(Query{Text: "failed", Since: &since})
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].Line).To(Equal(int64(2)))
+				matches, _ = search(Query{Text: "failed", IndexedAfter: time.Now().Add(time.Hour)})
+				Expect(matches).To(BeEmpty())
+			})
+
+			It("truncates the results to the limit", func() {
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs", content: []byte("match\nmatch\nmatch")})
+				matches, truncated := search(Query{Text: "match", Limit: 2})
+				Expect(truncated).To(BeTrue())
+				Expect(matches).To(HaveLen(2))
+			})
+
+			It("replaces the lines of the previous upload", func() {
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs", content: []byte("old")})
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs", content: []byte("new")})
+				matches, _ := search(Query{Text: "old"})
+				Expect(matches).To(BeEmpty())
+				matches, _ = search(Query{Text: "new"})
+				Expect(matches).To(HaveLen(1))
+			})
+
+			It("indexes the first lines of the large uploads", func() {
+				content := bytes.Repeat([]byte("line\n"), 150)
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs", content: content})
+				matches, truncated := search(Query{Text: "line", Limit: 1000})
+				Expect(truncated).To(BeFalse())
+				Expect(matches).To(HaveLen(100))
+			})
+
+			It("indexes the queued uploads", func() {
+				Expect(objectHandler.Upload(ctx, tarball(true, testFile{name: "agent.logs", content: []byte("old")}), "host.tar.gz")).To(Succeed())
+				m.IndexLogs(ctx, hostSource, "host.tar.gz")
+				Expect(objectHandler.Upload(ctx, tarball(true, testFile{name: "agent.logs", content: []byte("new")}), "host.tar.gz")).To(Succeed())
+				m.IndexLogs(ctx, hostSource, "host.tar.gz")
+				var jobs []*common.LogIndexJob
+				Expect(db.Find(&jobs).Error).ToNot(HaveOccurred())
+				Expect(jobs).To(HaveLen(1))
+
+				m.IndexPending()
+				matches, _ := search(Query{Text: "new"})
+				Expect(matches).To(HaveLen(1))
+				Expect(db.Find(&jobs).Error).ToNot(HaveOccurred())
+				Expect(jobs).To(BeEmpty())
+			})
+
+			It("retries the failed uploads until the maximal attempts", func() {
+				m.IndexLogs(ctx, hostSource, "missing.tar.gz")
+				m.IndexPending()
+				var job common.LogIndexJob
+				Expect(db.Take(&job).Error).ToNot(HaveOccurred())
+				Expect(job.Attempts).To(Equal(1))
+				Expect(job.LastError).ToNot(BeEmpty())
+				Expect(job.NextAttemptAt).To(BeTemporally(">", time.Now()))
+
+				// The job isn't claimed again before its next attempt
+				m.IndexPending()
+				Expect(db.Take(&job).Error).ToNot(HaveOccurred())
+				Expect(job.Attempts).To(Equal(1))
+
+				Expect(db.Model(&job).Update("next_attempt_at", time.Now()).Error).ToNot(HaveOccurred())
+				m.IndexPending()
+				var count int64
+				Expect(db.Model(&common.LogIndexJob{}).Count(&count).Error).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			})
+
+			It("deletes the expired indexes", func() {
+				upload(hostSource, "host.tar.gz", testFile{name: "agent.logs", content: []byte("line")})
+				Expect(m.DeleteExpiredIndexes(ctx, time.Now().Add(-time.Hour))).To(Succeed())
+				matches, _ := search(Query{Text: "line"})
+				Expect(matches).To(HaveLen(1))
+
+				Expect(m.DeleteExpiredIndexes(ctx, time.Now().Add(time.Hour))).To(Succeed())
+				matches, _ = search(Query{Text: "line"})
+				Expect(matches).To(BeEmpty())
+				exists, err := objectHandler.DoesObjectExist(ctx, "host.tar.gz")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+			})
+		})
+	}
+})
+
+var _ = Describe("DBStore", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("creates the trigram index of the text of the lines", func() {
+		store := NewDBStore(db)
+		Expect(store.CreateTextIndex(context.Background())).To(Succeed())
+		Expect(db.Migrator().HasIndex(&common.LogLine{}, "idx_log_lines_text_trgm")).To(BeTrue())
+		// Creating it again is a no-op
+		Expect(store.CreateTextIndex(context.Background())).To(Succeed())
+	})
+})
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/logsearch (interfaces: API)
+
+// Package logsearch is a generated GoMock package.
+package logsearch
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredIndexes mocks base method.
+func (m *MockAPI) DeleteExpiredIndexes(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIndexes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredIndexes indicates an expected call of DeleteExpiredIndexes.
+func (mr *MockAPIMockRecorder) DeleteExpiredIndexes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIndexes", reflect.TypeOf((*MockAPI)(nil).DeleteExpiredIndexes), arg0, arg1)
+}
+
+// Enabled mocks base method.
+func (m *MockAPI) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockAPIMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockAPI)(nil).Enabled))
+}
+
+// IndexLogs mocks base method.
+func (m *MockAPI) IndexLogs(arg0 context.Context, arg1 Source, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IndexLogs", arg0, arg1, arg2)
+}
+
+// IndexLogs indicates an expected call of IndexLogs.
+func (mr *MockAPIMockRecorder) IndexLogs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexLogs", reflect.TypeOf((*MockAPI)(nil).IndexLogs), arg0, arg1, arg2)
+}
+
+// IndexPending mocks base method.
+func (m *MockAPI) IndexPending() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IndexPending")
+}
+
+// IndexPending indicates an expected call of IndexPending.
+func (mr *MockAPIMockRecorder) IndexPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPending", reflect.TypeOf((*MockAPI)(nil).IndexPending))
+}
+
+// Search mocks base method.
+func (m *MockAPI) Search(arg0 context.Context, arg1 *Query) ([]*models.LogSearchMatch, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]*models.LogSearchMatch)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAPIMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAPI)(nil).Search), arg0, arg1)
+}
@@ -0,0 +1,263 @@
+package logsearch
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+)
+
+const (
+	indexFolder    = "logs-index"
+	indexExtension = ".json.gz"
+)
+
+// ObjectStore stores the indexed lines of each source in a gzipped stream of JSON documents, in the folder of the
+// cluster in the object store. The first document of the stream describes the source.
+type ObjectStore struct {
+	objectHandler s3wrapper.API
+}
+
+var _ Store = &ObjectStore{}
+
+func NewObjectStore(objectHandler s3wrapper.API) *ObjectStore {
+	return &ObjectStore{objectHandler: objectHandler}
+}
+
+type indexHeader struct {
+	HostID    *strfmt.UUID    `json:"host_id,omitempty"`
+	LogsType  models.LogsType `json:"logs_type"`
+	IndexedAt time.Time       `json:"indexed_at"`
+}
+
+type indexedLine struct {
+	File      string     `json:"file"`
+	Number    int64      `json:"line"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+	Text      string     `json:"text"`
+}
+
+func indexPrefix(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s/%s/", clusterID, indexFolder)
+}
+
+func (s *ObjectStore) NewWriter(ctx context.Context, source Source, _ string) (Writer, error) {
+	// The index is written to a temporary file, uploaded once all the lines are written
+	file, err := os.CreateTemp("", "logs-index-*"+indexExtension)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the index of the %s logs", source.name())
+	}
+	w := &objectWriter{
+		ctx:           ctx,
+		objectHandler: s.objectHandler,
+		objectName:    indexPrefix(source.ClusterID) + source.name() + indexExtension,
+		file:          file,
+	}
+	w.gzip = gzip.NewWriter(file)
+	w.encoder = json.NewEncoder(w.gzip)
+	header := indexHeader{HostID: source.HostID, LogsType: source.LogsType, IndexedAt: time.Now()}
+	if err = w.encoder.Encode(&header); err != nil {
+		w.Abort()
+		return nil, errors.Wrapf(err, "failed to write the index of the %s logs", source.name())
+	}
+	return w, nil
+}
+
+func (s *ObjectStore) Search(ctx context.Context, query *Query) ([]*models.LogSearchMatch, bool, error) {
+	objectNames, err := s.objectHandler.ListObjectsByPrefix(ctx, indexPrefix(query.ClusterID))
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to list the log indexes of cluster %s", query.ClusterID)
+	}
+	sort.Strings(objectNames)
+
+	search := &objectSearch{query: query, text: strings.ToLower(query.Text), matches: []*models.LogSearchMatch{}}
+	for _, objectName := range objectNames {
+		if !strings.HasSuffix(objectName, indexExtension) {
+			continue
+		}
+		if err = s.searchObject(ctx, search, objectName); err != nil {
+			return nil, false, err
+		}
+		if search.truncated {
+			break
+		}
+	}
+	return search.matches, search.truncated, nil
+}
+
+func (s *ObjectStore) searchObject(ctx context.Context, search *objectSearch, objectName string) error {
+	reader, _, err := s.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download the log index %s", objectName)
+	}
+	defer reader.Close()
+	gzipReader, err := gzip.NewReader(bufio.NewReader(reader))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the log index %s", objectName)
+	}
+	decoder := json.NewDecoder(gzipReader)
+
+	var header indexHeader
+	if err = decoder.Decode(&header); err != nil {
+		return errors.Wrapf(err, "failed to read the header of the log index %s", objectName)
+	}
+	if !search.query.matchesSource(header.HostID, header.LogsType, header.IndexedAt) {
+		return nil
+	}
+	search.start(&header)
+	for {
+		var line indexedLine
+		if err = decoder.Decode(&line); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to read the log index %s", objectName)
+		}
+		if done := search.add((*Line)(&line)); done {
+			return nil
+		}
+	}
+}
+
+func (s *ObjectStore) DeleteExpired(ctx context.Context, olderThan time.Time) error {
+	// The indexes of the deleted clusters are deleted with the files of the clusters
+	objectNames, err := s.objectHandler.ListObjectsByPrefix(ctx, "")
+	if err != nil {
+		return errors.Wrap(err, "failed to list the log indexes")
+	}
+	for _, objectName := range objectNames {
+		if !strings.Contains(objectName, "/"+indexFolder+"/") || !strings.HasSuffix(objectName, indexExtension) {
+			continue
+		}
+		header, err := s.readHeader(ctx, objectName)
+		if err != nil {
+			return err
+		}
+		if !header.IndexedAt.Before(olderThan) {
+			continue
+		}
+		if _, err = s.objectHandler.DeleteObject(ctx, objectName); err != nil {
+			return errors.Wrapf(err, "failed to delete the expired log index %s", objectName)
+		}
+	}
+	return nil
+}
+
+func (s *ObjectStore) readHeader(ctx context.Context, objectName string) (*indexHeader, error) {
+	reader, _, err := s.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the log index %s", objectName)
+	}
+	defer reader.Close()
+	gzipReader, err := gzip.NewReader(bufio.NewReader(reader))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the log index %s", objectName)
+	}
+	var header indexHeader
+	if err = json.NewDecoder(gzipReader).Decode(&header); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the header of the log index %s", objectName)
+	}
+	return &header, nil
+}
+
+// objectSearch collects the matching lines of the indexes read one after the other
+type objectSearch struct {
+	query     *Query
+	text      string
+	matches   []*models.LogSearchMatch
+	truncated bool
+
+	header *indexHeader
+	// the lines of the current file preceding the current line, at most query.Context
+	previous []string
+	// the matches of the current file still missing lines after them
+	pending []*models.LogSearchMatch
+	file    string
+}
+
+func (s *objectSearch) start(header *indexHeader) {
+	s.header = header
+	s.previous = nil
+	s.pending = nil
+	s.file = ""
+}
+
+// add processes the next line of the current index, and tells whether the search is done
+func (s *objectSearch) add(line *Line) bool {
+	if line.File != s.file {
+		s.file = line.File
+		s.previous = nil
+		s.pending = nil
+	}
+
+	pending := s.pending[:0]
+	for _, match := range s.pending {
+		match.ContextAfter = append(match.ContextAfter, line.Text)
+		if len(match.ContextAfter) < s.query.Context {
+			pending = append(pending, match)
+		}
+	}
+	s.pending = pending
+
+	if s.query.matchesLine(line, s.text) {
+		if len(s.matches) >= s.query.Limit {
+			s.truncated = true
+		} else {
+			match := newMatch(s.header.HostID, s.header.LogsType, line)
+			if len(s.previous) > 0 {
+				match.ContextBefore = append([]string{}, s.previous...)
+			}
+			s.matches = append(s.matches, match)
+			if s.query.Context > 0 {
+				s.pending = append(s.pending, match)
+			}
+		}
+	}
+
+	if s.query.Context > 0 {
+		s.previous = append(s.previous, line.Text)
+		if len(s.previous) > s.query.Context {
+			s.previous = s.previous[1:]
+		}
+	}
+	return s.truncated && len(s.pending) == 0
+}
+
+type objectWriter struct {
+	ctx           context.Context
+	objectHandler s3wrapper.API
+	objectName    string
+	file          *os.File
+	gzip          *gzip.Writer
+	encoder       *json.Encoder
+}
+
+func (w *objectWriter) Write(line *Line) error {
+	return w.encoder.Encode((*indexedLine)(line))
+}
+
+func (w *objectWriter) Commit() error {
+	defer w.Abort()
+	if err := w.gzip.Close(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	return w.objectHandler.UploadFile(w.ctx, w.file.Name(), w.objectName)
+}
+
+func (w *objectWriter) Abort() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
@@ -0,0 +1,76 @@
+package logsearch
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+)
+
+// Line is a line of a file of the uploaded logs
+type Line struct {
+	File   string
+	Number int64
+	// The time of the line, or of the closest preceding line of the file with a time
+	Timestamp *time.Time
+	Text      string
+}
+
+// Store is a backend storing the indexed lines of the uploaded logs
+type Store interface {
+	// NewWriter returns a writer of the lines of an upload of the logs of the source. The lines replace the lines of
+	// the previous upload of the source once the writer is committed.
+	NewWriter(ctx context.Context, source Source, uploadID string) (Writer, error)
+	// Search returns the lines matching the query, and whether more lines match it
+	Search(ctx context.Context, query *Query) ([]*models.LogSearchMatch, bool, error)
+	// DeleteExpired deletes the lines indexed before olderThan and the lines of the deleted clusters
+	DeleteExpired(ctx context.Context, olderThan time.Time) error
+}
+
+type Writer interface {
+	Write(line *Line) error
+	Commit() error
+	Abort()
+}
+
+func (q *Query) matchesSource(hostID *strfmt.UUID, logsType models.LogsType, indexedAt time.Time) bool {
+	if q.HostID != nil && (hostID == nil || *hostID != *q.HostID) {
+		return false
+	}
+	if q.LogsType != "" && logsType != q.LogsType {
+		return false
+	}
+	return !indexedAt.Before(q.IndexedAfter)
+}
+
+func (q *Query) matchesLine(line *Line, text string) bool {
+	if q.File != "" && !strings.Contains(line.File, q.File) {
+		return false
+	}
+	if q.Since != nil && (line.Timestamp == nil || line.Timestamp.Before(*q.Since)) {
+		return false
+	}
+	if q.Until != nil && (line.Timestamp == nil || line.Timestamp.After(*q.Until)) {
+		return false
+	}
+	return strings.Contains(strings.ToLower(line.Text), text)
+}
+
+func newMatch(hostID *strfmt.UUID, logsType models.LogsType, line *Line) *models.LogSearchMatch {
+	match := &models.LogSearchMatch{
+		LogsType: logsType,
+		File:     line.File,
+		Line:     line.Number,
+		Text:     line.Text,
+	}
+	if hostID != nil {
+		match.HostID = *hostID
+	}
+	if line.Timestamp != nil {
+		timestamp := strfmt.DateTime(*line.Timestamp)
+		match.Timestamp = &timestamp
+	}
+	return match
+}
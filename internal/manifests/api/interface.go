@@ -21,4 +21,5 @@ type ClusterManifestsInternals interface {
 	CreateClusterManifestInternal(ctx context.Context, params operations.V2CreateClusterManifestParams, isCustomManifest bool) (*models.Manifest, error)
 	ListClusterManifestsInternal(ctx context.Context, params operations.V2ListClusterManifestsParams) (models.ListManifests, error)
 	DeleteClusterManifestInternal(ctx context.Context, params operations.V2DeleteClusterManifestParams) error
+	ValidateClusterManifestInternal(ctx context.Context, clusterID strfmt.UUID, folder string, fileName string) error
 }
@@ -166,3 +166,17 @@ func (mr *MockManifestsAPIMockRecorder) V2UpdateClusterManifest(arg0, arg1 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateClusterManifest", reflect.TypeOf((*MockManifestsAPI)(nil).V2UpdateClusterManifest), arg0, arg1)
 }
+
+// ValidateClusterManifestInternal mocks base method.
+func (m *MockManifestsAPI) ValidateClusterManifestInternal(arg0 context.Context, arg1 strfmt.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateClusterManifestInternal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateClusterManifestInternal indicates an expected call of ValidateClusterManifestInternal.
+func (mr *MockManifestsAPIMockRecorder) ValidateClusterManifestInternal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateClusterManifestInternal", reflect.TypeOf((*MockManifestsAPI)(nil).ValidateClusterManifestInternal), arg0, arg1, arg2, arg3)
+}
@@ -210,6 +210,16 @@ func (m *Manifests) DeleteClusterManifestInternal(ctx context.Context, params op
 	return nil
 }
 
+// ValidateClusterManifestInternal validates the stored content of a cluster manifest the same way it is validated
+// when the manifest is created
+func (m *Manifests) ValidateClusterManifestInternal(ctx context.Context, clusterID strfmt.UUID, folder string, fileName string) error {
+	content, err := m.fetchManifestContent(ctx, clusterID, folder, fileName)
+	if err != nil {
+		return err
+	}
+	return m.validateUserSuppliedManifest(ctx, clusterID, content, filepath.Join(folder, fileName))
+}
+
 func (m *Manifests) UpdateClusterManifestInternal(ctx context.Context, params operations.V2UpdateClusterManifestParams) (*models.Manifest, error) {
 	if params.UpdateManifestParams.UpdatedFolder == nil {
 		params.UpdateManifestParams.UpdatedFolder = &params.UpdateManifestParams.Folder
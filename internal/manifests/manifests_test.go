@@ -1306,6 +1306,30 @@ invalid YAML content: {
 		})
 	})
 
+	Describe("ValidateClusterManifestInternal", func() {
+		It("accepts valid stored manifests", func() {
+			clusterID := registerCluster().ID
+			reader := io.NopCloser(strings.NewReader(contentAsYAML))
+			mockS3Client.EXPECT().Download(ctx, getObjectName(clusterID, validFolder, fileNameYaml)).Return(reader, int64(0), nil).Times(1)
+			Expect(manifestsAPI.ValidateClusterManifestInternal(ctx, *clusterID, validFolder, fileNameYaml)).To(Succeed())
+		})
+
+		It("rejects stored manifests with an invalid content", func() {
+			clusterID := registerCluster().ID
+			reader := io.NopCloser(strings.NewReader("not: valid: yaml"))
+			mockS3Client.EXPECT().Download(ctx, getObjectName(clusterID, validFolder, fileNameYaml)).Return(reader, int64(0), nil).Times(1)
+			err := manifestsAPI.ValidateClusterManifestInternal(ctx, *clusterID, validFolder, fileNameYaml)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid YAML format"))
+		})
+
+		It("fails when the manifest cannot be fetched", func() {
+			clusterID := registerCluster().ID
+			mockDownloadFailure()
+			Expect(manifestsAPI.ValidateClusterManifestInternal(ctx, *clusterID, validFolder, fileNameYaml)).ToNot(Succeed())
+		})
+	})
+
 	Describe("ParsePath", func() {
 		It("Should parse a full manifest path into folder and filename", func() {
 			folder, filename, err := manifests.ParsePath("2716d677-8052-463e-be12-d45e3aa05db0/manifests/openshift/file-1.yaml")
@@ -39,6 +39,10 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterMonitorShardMembers                    = "assisted_installer_monitor_shard_members"
+	counterMonitorShardMonitored                  = "assisted_installer_monitor_shard_monitored"
+	counterInstallerCacheGetRelease               = "assisted_installer_installer_cache_get_release"
+	counterInstallerCacheExtractionSeconds        = "assisted_installer_installer_cache_extraction_seconds"
 )
 
 const (
@@ -61,6 +65,10 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionMonitorShardMembers                    = "Number of replicas sharing the monitoring, as seen by the shard"
+	counterDescriptionMonitorShardMonitored                  = "Number of clusters or hosts monitored by the shard"
+	counterDescriptionInstallerCacheGetRelease               = "Number of installer binaries requested from the installer cache, by result (hit, shared_hit, miss)"
+	counterDescriptionInstallerCacheExtractionSeconds        = "Histogram/sum/count of the extraction time of the installer binaries from the release images"
 )
 
 const (
@@ -77,6 +85,8 @@ const (
 	imageLabel                 = "imageName"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
+	shardLabel                 = "shard"
+	resourceLabel              = "resource"
 )
 
 type API interface {
@@ -94,6 +104,10 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	MonitorShardMembers(shard string, members int)
+	MonitoredShardCount(shard, resource string, monitored int64)
+	InstallerCacheGetRelease(result string)
+	InstallerCacheExtractionDuration(duration time.Duration)
 }
 
 type MetricsManager struct {
@@ -118,7 +132,11 @@ type MetricsManager struct {
 	serviceLogicClusterValidationChanged               *prometheus.CounterVec
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
+	serviceLogicMonitorShardMembers                    *prometheus.GaugeVec
+	serviceLogicMonitorShardMonitored                  *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicInstallerCacheGetRelease               *prometheus.CounterVec
+	serviceLogicInstallerCacheExtractionSeconds        *prometheus.HistogramVec
 }
 
 var _ API = &MetricsManager{}
@@ -280,6 +298,36 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicMonitorShardMembers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterMonitorShardMembers,
+			Help:      counterDescriptionMonitorShardMembers,
+		}, []string{shardLabel}),
+
+		serviceLogicMonitorShardMonitored: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterMonitorShardMonitored,
+			Help:      counterDescriptionMonitorShardMonitored,
+		}, []string{shardLabel, resourceLabel}),
+
+		serviceLogicInstallerCacheGetRelease: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterInstallerCacheGetRelease,
+				Help:      counterDescriptionInstallerCacheGetRelease,
+			}, []string{resultLabel}),
+
+		serviceLogicInstallerCacheExtractionSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterInstallerCacheExtractionSeconds,
+			Help:      counterDescriptionInstallerCacheExtractionSeconds,
+			Buckets:   []float64{5, 10, 20, 30, 60, 90, 120, 180, 300, 600, 900},
+		}, []string{}),
 	}
 
 	registry.MustRegister(
@@ -302,6 +350,10 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicMonitorShardMembers,
+		m.serviceLogicMonitorShardMonitored,
+		m.serviceLogicInstallerCacheGetRelease,
+		m.serviceLogicInstallerCacheExtractionSeconds,
 	)
 	return m
 }
@@ -468,6 +520,22 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) MonitorShardMembers(shard string, members int) {
+	m.serviceLogicMonitorShardMembers.WithLabelValues(shard).Set(float64(members))
+}
+
+func (m *MetricsManager) MonitoredShardCount(shard, resource string, monitored int64) {
+	m.serviceLogicMonitorShardMonitored.WithLabelValues(shard, resource).Set(float64(monitored))
+}
+
+func (m *MetricsManager) InstallerCacheGetRelease(result string) {
+	m.serviceLogicInstallerCacheGetRelease.WithLabelValues(result).Inc()
+}
+
+func (m *MetricsManager) InstallerCacheExtractionDuration(duration time.Duration) {
+	m.serviceLogicInstallerCacheExtractionSeconds.WithLabelValues().Observe(duration.Seconds())
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
@@ -169,6 +169,42 @@ func (mr *MockAPIMockRecorder) InstallationStarted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallationStarted", reflect.TypeOf((*MockAPI)(nil).InstallationStarted))
 }
 
+// InstallerCacheExtractionDuration mocks base method.
+func (m *MockAPI) InstallerCacheExtractionDuration(duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InstallerCacheExtractionDuration", duration)
+}
+
+// InstallerCacheExtractionDuration indicates an expected call of InstallerCacheExtractionDuration.
+func (mr *MockAPIMockRecorder) InstallerCacheExtractionDuration(duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerCacheExtractionDuration", reflect.TypeOf((*MockAPI)(nil).InstallerCacheExtractionDuration), duration)
+}
+
+// InstallerCacheGetRelease mocks base method.
+func (m *MockAPI) InstallerCacheGetRelease(result string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InstallerCacheGetRelease", result)
+}
+
+// InstallerCacheGetRelease indicates an expected call of InstallerCacheGetRelease.
+func (mr *MockAPIMockRecorder) InstallerCacheGetRelease(result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerCacheGetRelease", reflect.TypeOf((*MockAPI)(nil).InstallerCacheGetRelease), result)
+}
+
+// MonitorShardMembers mocks base method.
+func (m *MockAPI) MonitorShardMembers(shard string, members int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitorShardMembers", shard, members)
+}
+
+// MonitorShardMembers indicates an expected call of MonitorShardMembers.
+func (mr *MockAPIMockRecorder) MonitorShardMembers(shard, members interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorShardMembers", reflect.TypeOf((*MockAPI)(nil).MonitorShardMembers), shard, members)
+}
+
 // MonitoredClusterCount mocks base method.
 func (m *MockAPI) MonitoredClusterCount(monitoredClusters int64) {
 	m.ctrl.T.Helper()
@@ -193,6 +229,18 @@ func (mr *MockAPIMockRecorder) MonitoredHostsCount(monitoredHosts interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredHostsCount", reflect.TypeOf((*MockAPI)(nil).MonitoredHostsCount), monitoredHosts)
 }
 
+// MonitoredShardCount mocks base method.
+func (m *MockAPI) MonitoredShardCount(shard, resource string, monitored int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitoredShardCount", shard, resource, monitored)
+}
+
+// MonitoredShardCount indicates an expected call of MonitoredShardCount.
+func (mr *MockAPIMockRecorder) MonitoredShardCount(shard, resource, monitored interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredShardCount", reflect.TypeOf((*MockAPI)(nil).MonitoredShardCount), shard, resource, monitored)
+}
+
 // ReportHostInstallationMetrics mocks base method.
 func (m *MockAPI) ReportHostInstallationMetrics(ctx context.Context, clusterVersion string, clusterID strfmt.UUID, emailDomain string, boot *models.Disk, h *models.Host, previousProgress *models.HostProgressInfo, currentStage models.HostStage) {
 	m.ctrl.T.Helper()
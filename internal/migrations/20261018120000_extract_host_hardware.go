@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+// extractHostHardware fills the hardware columns of the hosts registered before they were added, from their
+// inventories
+func extractHostHardware() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		var hosts []*common.Host
+		return tx.Unscoped().Select("id", "infra_env_id", "inventory").Where("inventory != ''").
+			FindInBatches(&hosts, 100, func(batch *gorm.DB, _ int) error {
+				for _, host := range hosts {
+					inventory, err := common.UnmarshalInventory(host.Inventory)
+					if err != nil {
+						// The hardware of the hosts with invalid inventories is left empty
+						continue
+					}
+					err = tx.Unscoped().Model(&common.Host{}).
+						Where("id = ? and infra_env_id = ?", host.ID.String(), host.InfraEnvID.String()).
+						UpdateColumns(common.NewHostHardware(inventory).Columns()).Error
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		// The columns are owned by the auto-migration of the hosts table
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20261018120000",
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("extractHostHardware", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createHost := func(inventory string) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &id, InfraEnvID: infraEnvID, Inventory: inventory}}).Error).ShouldNot(HaveOccurred())
+		return id
+	}
+
+	getHost := func(id strfmt.UUID) *common.Host {
+		var host common.Host
+		Expect(db.Take(&host, "id = ? and infra_env_id = ?", id.String(), infraEnvID.String()).Error).ShouldNot(HaveOccurred())
+		return &host
+	}
+
+	It("Migrates up", func() {
+		Expect(migrateToBefore(db, "20261018120000")).To(Succeed())
+		withInventory := createHost(`{"cpu": {"architecture": "x86_64", "count": 16}, "memory": {"physical_bytes": 68719476736},
+			"disks": [{"name": "nvme0n1", "drive_type": "SSD", "size_bytes": 960000000000}],
+			"system_vendor": {"manufacturer": "Dell Inc.", "product_name": "PowerEdge R650"}}`)
+		withoutInventory := createHost("")
+		withInvalidInventory := createHost("{")
+
+		Expect(migrateTo(db, "20261018120000")).To(Succeed())
+
+		hardware := getHost(withInventory).Hardware
+		Expect(hardware.CPUCores).To(BeEquivalentTo(16))
+		Expect(hardware.MemoryBytes).To(BeEquivalentTo(68719476736))
+		Expect(hardware.HasNvme).To(BeTrue())
+		Expect(hardware.Vendor).To(Equal("Dell Inc."))
+		Expect(getHost(withoutInventory).Hardware).To(Equal(common.HostHardware{}))
+		Expect(getHost(withInvalidInventory).Hardware).To(Equal(common.HostHardware{}))
+	})
+})
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// indexLogLinesText adds a trigram index on the text of the indexed log lines, used by the case-insensitive
+// substring searches of log search. The index requires the pg_trgm extension, which only a superuser can create
+// before PostgreSQL 13, so it is added only when the extension is installed already. Otherwise the database store of
+// log search tries to create both when it is enabled.
+func indexLogLinesText() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		var installed bool
+		if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')").Scan(&installed).Error; err != nil {
+			return err
+		}
+		if !installed {
+			return nil
+		}
+		return tx.Exec("CREATE INDEX IF NOT EXISTS idx_log_lines_text_trgm ON log_lines USING gin (text gin_trgm_ops)").Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		return tx.Exec("DROP INDEX IF EXISTS idx_log_lines_text_trgm").Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20261018130000",
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
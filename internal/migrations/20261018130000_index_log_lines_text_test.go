@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("indexLogLinesText", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("Skips the index without the pg_trgm extension", func() {
+		Expect(migrateTo(db, "20261018130000")).To(Succeed())
+		Expect(db.Migrator().HasIndex(&common.LogLine{}, "idx_log_lines_text_trgm")).To(BeFalse())
+	})
+
+	It("Migrates down and up", func() {
+		Expect(db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error).ToNot(HaveOccurred())
+		Expect(migrateTo(db, "20261018130000")).To(Succeed())
+		Expect(db.Migrator().HasIndex(&common.LogLine{}, "idx_log_lines_text_trgm")).To(BeTrue())
+
+		Expect(gormigrate.New(db, gormigrate.DefaultOptions, post()).RollbackMigration(indexLogLinesText())).To(Succeed())
+		Expect(db.Migrator().HasIndex(&common.LogLine{}, "idx_log_lines_text_trgm")).To(BeFalse())
+
+		Expect(migrateTo(db, "20261018130000")).To(Succeed())
+		Expect(db.Migrator().HasIndex(&common.LogLine{}, "idx_log_lines_text_trgm")).To(BeTrue())
+	})
+})
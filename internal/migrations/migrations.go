@@ -44,6 +44,8 @@ func post() []*gormigrate.Migration {
 		deleteEventsWithUnboundCluster(),
 		dropClusterApiVipAndIngressVip(),
 		updateOciToExternalPlatformType(),
+		extractHostHardware(),
+		indexLogLinesText(),
 	}
 
 	sort.SliceStable(postMigrations, func(i, j int) bool { return postMigrations[i].ID < postMigrations[j].ID })
@@ -0,0 +1,68 @@
+package network
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	// The Geneve encapsulation of OVN-Kubernetes adds 100 bytes to the packets of the cluster network
+	ovnKubernetesMtuOverhead int64 = 100
+	// The VXLAN encapsulation of OpenShift SDN adds 50 bytes to the packets of the cluster network
+	openShiftSDNMtuOverhead int64 = 50
+)
+
+// GetMtuOverhead returns the number of bytes added to the packets of the cluster network by the encapsulation of the
+// network type. OVN-Kubernetes, the default network type, is assumed when the network type isn't set yet.
+func GetMtuOverhead(networkType *string) int64 {
+	if swag.StringValue(networkType) == models.ClusterNetworkTypeOpenShiftSDN {
+		return openShiftSDNMtuOverhead
+	}
+	return ovnKubernetesMtuOverhead
+}
+
+// GetClusterNetworkMtu returns the MTU left to the packets of the cluster network over a link of the given MTU, once
+// the encapsulation of the network type is added
+func GetClusterNetworkMtu(mtu int64, networkType *string) int64 {
+	return mtu - GetMtuOverhead(networkType)
+}
+
+func UnmarshalMtuPathReport(report string) (*models.MtuPathCheckResponse, error) {
+	var response models.MtuPathCheckResponse
+	if err := json.Unmarshal([]byte(report), &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetInterfaceMtu returns the MTU of the interface of the inventory with the given name, or 0 when there is none
+func GetInterfaceMtu(inventory *models.Inventory, name string) int64 {
+	for _, intf := range inventory.Interfaces {
+		if intf.Name == name {
+			return intf.Mtu
+		}
+	}
+	return 0
+}
+
+// GetMachineNetworksMtu returns the highest MTU of the interfaces of the inventory having an address in one of the
+// machine networks, or 0 when there is none
+func GetMachineNetworksMtu(inventory *models.Inventory, machineNetworks []*models.MachineNetwork) int64 {
+	var ret int64
+	for _, machineNetwork := range machineNetworks {
+		_, ipNet, err := net.ParseCIDR(string(machineNetwork.Cidr))
+		if err != nil {
+			continue
+		}
+		isIPv4 := IsIPV4CIDR(string(machineNetwork.Cidr))
+		for _, intf := range inventory.Interfaces {
+			if found, _ := findMatchingIP(ipNet, intf, isIPv4); found && intf.Mtu > ret {
+				ret = intf.Mtu
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,44 @@
+package network
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("MTU", func() {
+	inventory := &models.Inventory{
+		Interfaces: []*models.Interface{
+			{Name: "eth0", Mtu: 1500, IPV4Addresses: []string{"192.168.126.10/24"}},
+			{Name: "eth1", Mtu: 9000, IPV4Addresses: []string{"10.0.0.10/24"}},
+			{Name: "eth2", Mtu: 9000, IPV6Addresses: []string{"1001:db8::10/120"}},
+		},
+	}
+
+	It("returns the overhead of the network type", func() {
+		Expect(GetMtuOverhead(swag.String(models.ClusterNetworkTypeOVNKubernetes))).To(BeEquivalentTo(100))
+		Expect(GetMtuOverhead(swag.String(models.ClusterNetworkTypeOpenShiftSDN))).To(BeEquivalentTo(50))
+		Expect(GetMtuOverhead(nil)).To(BeEquivalentTo(100))
+	})
+
+	It("returns the MTU of an interface", func() {
+		Expect(GetInterfaceMtu(inventory, "eth1")).To(BeEquivalentTo(9000))
+		Expect(GetInterfaceMtu(inventory, "eth3")).To(BeZero())
+	})
+
+	It("returns the highest MTU of the interfaces in the machine networks", func() {
+		Expect(GetMachineNetworksMtu(inventory, []*models.MachineNetwork{{Cidr: "192.168.126.0/24"}})).To(BeEquivalentTo(1500))
+		Expect(GetMachineNetworksMtu(inventory, []*models.MachineNetwork{{Cidr: "192.168.126.0/24"}, {Cidr: "1001:db8::/120"}})).To(BeEquivalentTo(9000))
+		Expect(GetMachineNetworksMtu(inventory, []*models.MachineNetwork{{Cidr: "172.16.0.0/16"}})).To(BeZero())
+	})
+
+	It("unmarshals the path MTU report", func() {
+		report, err := UnmarshalMtuPathReport(`{"results":[{"remote_host_id":"00000000-0000-0000-0000-000000000001","remote_ip_address":"192.168.126.11","outgoing_nic":"eth0","path_mtu":1500}]}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Results).To(HaveLen(1))
+		Expect(report.Results[0].PathMtu).To(BeEquivalentTo(1500))
+		_, err = UnmarshalMtuPathReport("")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,94 @@
+package networkreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/network_report"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.NetworkReportAPI = (*Handler)(nil)
+
+// NewHandler returns the network report handler. The network thresholds of the hosts are the ones of their
+// requirements, given by hwValidator.
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator) *Handler {
+	return &Handler{
+		log:         log,
+		db:          db,
+		hwValidator: hwValidator,
+	}
+}
+
+// Handler reports the network of the hosts of clusters
+type Handler struct {
+	log         logrus.FieldLogger
+	db          *gorm.DB
+	hwValidator hardware.Validator
+}
+
+func (h *Handler) V2GetNetworkReport(ctx context.Context, params operations.V2GetNetworkReportParams) middleware.Responder {
+	report, err := h.report(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetNetworkReportOK().WithPayload(report)
+}
+
+func (h *Handler) V2DownloadNetworkReport(ctx context.Context, params operations.V2DownloadNetworkReportParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	report, err := h.report(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	var content []byte
+	format := swag.StringValue(params.Format)
+	switch format {
+	case "json":
+		content, err = json.MarshalIndent(report, "", "  ")
+	default:
+		format = "html"
+		content, err = RenderHTML(report)
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to render the network report of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return filemiddleware.NewResponder(
+		operations.NewV2DownloadNetworkReportOK().WithPayload(io.NopCloser(bytes.NewReader(content))),
+		fmt.Sprintf("network-report-%s.%s", params.ClusterID, format),
+		int64(len(content)),
+		nil,
+	)
+}
+
+func (h *Handler) report(ctx context.Context, clusterID strfmt.UUID) (*models.NetworkReport, error) {
+	log := logutil.FromContext(ctx, h.log)
+	c, err := common.GetClusterFromDBWithHosts(h.db, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := Build(c, func(host *models.Host) (*models.ClusterHostRequirements, error) {
+		return h.hwValidator.GetClusterHostRequirements(ctx, c, host)
+	}, log)
+	if err != nil {
+		log.WithError(err).Errorf("failed to build the network report of cluster %s", clusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,111 @@
+package networkreport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/network_report"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Network report handler", func() {
+	var (
+		ctx             = context.Background()
+		db              *gorm.DB
+		dbName          string
+		ctrl            *gomock.Controller
+		mockHwValidator *hardware.MockValidator
+		handler         *Handler
+		clusterID       strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockHwValidator = hardware.NewMockValidator(ctrl)
+		handler = NewHandler(common.GetTestLog(), db, mockHwValidator)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+		for _, host := range []*models.Host{
+			reportHost(hostID1, models.HostRoleMaster, "master-1", 1500, "192.168.126.11/24",
+				remoteHost(hostID2, "192.168.126.12", true, 1, 0)),
+			reportHost(hostID2, models.HostRoleMaster, "master-2", 1500, "192.168.126.12/24",
+				remoteHost(hostID1, "192.168.126.11", true, 1, 0)),
+		} {
+			host.ClusterID = &clusterID
+			host.InfraEnvID = clusterID
+			Expect(db.Create(&common.Host{Host: *host}).Error).ToNot(HaveOccurred())
+		}
+		mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{
+				NetworkLatencyThresholdMs: swag.Float64(100),
+				PacketLossPercentage:      swag.Float64(0),
+			}}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	download := func(format string) (http.Header, string) {
+		response := handler.V2DownloadNetworkReport(ctx, operations.V2DownloadNetworkReportParams{ClusterID: clusterID, Format: swag.String(format)})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		ExpectWithOffset(1, recorder.Code).To(Equal(http.StatusOK))
+		content, err := io.ReadAll(recorder.Body)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return recorder.Header(), string(content)
+	}
+
+	It("returns the report of the cluster", func() {
+		response := handler.V2GetNetworkReport(ctx, operations.V2GetNetworkReportParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2GetNetworkReportOK()))
+		report := response.(*operations.V2GetNetworkReportOK).Payload
+		Expect(report.Hosts).To(HaveLen(2))
+		Expect(report.Links).To(HaveLen(2))
+		Expect(report.Links[0].L3Reachable).To(BeTrue())
+		Expect(report.Links[0].PacketLossThresholdExceeded).To(BeFalse())
+	})
+
+	It("fails for a missing cluster", func() {
+		response := handler.V2GetNetworkReport(ctx, operations.V2GetNetworkReportParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("downloads the HTML report", func() {
+		header, content := download("html")
+		Expect(header.Get("Content-Disposition")).To(ContainSubstring("network-report-" + clusterID.String() + ".html"))
+		Expect(content).To(ContainSubstring("<td>master-1</td><td>master-2</td>"))
+	})
+
+	It("downloads the JSON report", func() {
+		header, content := download("json")
+		Expect(header.Get("Content-Disposition")).To(ContainSubstring("network-report-" + clusterID.String() + ".json"))
+		var report models.NetworkReport
+		Expect(json.Unmarshal([]byte(content), &report)).To(Succeed())
+		Expect(*report.ClusterID).To(Equal(clusterID))
+		Expect(report.Hosts).To(HaveLen(2))
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
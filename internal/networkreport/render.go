@@ -0,0 +1,101 @@
+package networkreport
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+)
+
+var reportTemplate = template.Must(template.New("network-report").Funcs(template.FuncMap{
+	"hostname": func(report *models.NetworkReport, id strfmt.UUID) string {
+		for _, host := range report.Hosts {
+			if host.HostID == id {
+				return host.Hostname
+			}
+		}
+		return id.String()
+	},
+	"verified": func(verification *models.VipVerification) bool {
+		return verification != nil && *verification == models.VipVerificationSucceeded
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Network report of cluster {{.ClusterID}}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+.failure { background-color: #fbe4e4; }
+</style>
+</head>
+<body>
+<h1>Network report of cluster {{.ClusterID}}</h1>
+<p>Generated at {{.GeneratedAt}}</p>
+
+<h2>Hosts</h2>
+<table>
+<tr><th>Host</th><th>Role</th><th>Interface</th><th>MAC address</th><th>MTU</th><th>Speed (Mbps)</th><th>Addresses</th></tr>
+{{- range $host := .Hosts}}
+{{- range $host.Interfaces}}
+<tr{{if not $host.ConnectivityReported}} class="failure"{{end}}><td>{{$host.Hostname}}</td><td>{{$host.Role}}</td><td>{{.Name}}</td><td>{{.MacAddress}}</td><td>{{.Mtu}}</td><td>{{.SpeedMbps}}</td><td>{{range .IPV4Addresses}}{{.}} {{end}}{{range .IPV6Addresses}}{{.}} {{end}}</td></tr>
+{{- else}}
+<tr class="failure"><td>{{$host.Hostname}}</td><td>{{$host.Role}}</td><td colspan="5">No inventory</td></tr>
+{{- end}}
+{{- end}}
+</table>
+
+<h2>Connectivity</h2>
+<table>
+<tr><th>From</th><th>To</th><th>L2</th><th>L3</th><th>Average RTT (ms)</th><th>Packet loss (%)</th></tr>
+{{- range .Links}}
+<tr{{if or (not .L2Reachable) (not .L3Reachable) .LatencyThresholdExceeded .PacketLossThresholdExceeded}} class="failure"{{end}}><td>{{hostname $ .SourceHostID}}</td><td>{{hostname $ .RemoteHostID}}</td><td>{{if .L2Reachable}}reachable{{else}}unreachable{{end}}</td><td>{{if .L3Reachable}}reachable{{else}}unreachable{{end}}</td><td>{{printf "%.2f" .AverageRTTMs}}{{if .LatencyThresholdExceeded}} (above threshold){{end}}</td><td>{{printf "%.2f" .PacketLossPercentage}}{{if .PacketLossThresholdExceeded}} (above threshold){{end}}</td></tr>
+{{- end}}
+</table>
+
+<h2>Networks</h2>
+<table>
+<tr><th>CIDR</th><th>Hosts</th><th>MTUs</th></tr>
+{{- range .Networks}}
+<tr{{if .MtuMismatch}} class="failure"{{end}}><td>{{.Cidr}}</td><td>{{range .HostIds}}{{hostname $ .}} {{end}}</td><td>{{range .Mtus}}{{.}} {{end}}</td></tr>
+{{- end}}
+</table>
+
+<h2>Majority groups</h2>
+<table>
+<tr><th>Network</th><th>Hosts</th></tr>
+{{- range .MajorityGroups}}
+<tr><td>{{.Network}}</td><td>{{range .HostIds}}{{hostname $ .}} {{end}}</td></tr>
+{{- end}}
+</table>
+
+<h2>VIPs</h2>
+<table>
+<tr><th>VIP</th><th>Type</th><th>Verification</th><th>Message</th></tr>
+{{- range .Vips}}
+<tr{{if not (verified .Verification)}} class="failure"{{end}}><td>{{.IP}}</td><td>{{.Type}}</td><td>{{.Verification}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+
+<h2>IP collisions</h2>
+<table>
+<tr><th>IP address</th><th>MAC addresses</th></tr>
+{{- range .IPCollisions}}
+<tr class="failure"><td>{{.IPAddress}}</td><td>{{range .MacAddresses}}{{.}} {{end}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+// RenderHTML renders the report as a standalone HTML page
+func RenderHTML(report *models.NetworkReport) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := reportTemplate.Execute(&buffer, report); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
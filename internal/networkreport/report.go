@@ -0,0 +1,236 @@
+package networkreport
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// RequirementsFunc returns the requirements of a host of the cluster, whose network thresholds apply to the links of
+// the host
+type RequirementsFunc func(host *models.Host) (*models.ClusterHostRequirements, error)
+
+// Build builds the network report of the cluster from the inventories and the connectivity reports of its hosts, and
+// from the majority groups and the IP collisions computed by the cluster monitoring
+func Build(c *common.Cluster, requirements RequirementsFunc, log logrus.FieldLogger) (*models.NetworkReport, error) {
+	hosts := make([]*models.Host, len(c.Hosts))
+	copy(hosts, c.Hosts)
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].ID.String() < hosts[j].ID.String()
+	})
+
+	report := &models.NetworkReport{
+		ClusterID:      c.ID,
+		GeneratedAt:    strfmt.DateTime(time.Now()),
+		Hosts:          []*models.NetworkReportHost{},
+		Links:          []*models.NetworkReportLink{},
+		Networks:       []*models.NetworkReportNetwork{},
+		MajorityGroups: []*models.NetworkReportMajorityGroup{},
+		Vips:           []*models.NetworkReportVip{},
+		IPCollisions:   []*models.NetworkReportIPCollision{},
+	}
+	roles := make(map[strfmt.UUID]models.HostRole)
+	for _, host := range hosts {
+		roles[*host.ID] = common.GetEffectiveRole(host)
+	}
+	networks := make(map[string]*models.NetworkReportNetwork)
+	for _, host := range hosts {
+		reportHost, err := buildHost(host, networks)
+		if err != nil {
+			return nil, err
+		}
+		report.Hosts = append(report.Hosts, reportHost)
+
+		links, err := buildLinks(host, roles, requirements)
+		if err != nil {
+			return nil, err
+		}
+		report.Links = append(report.Links, links...)
+	}
+
+	for _, cidr := range funk.Keys(networks).([]string) {
+		reportNetwork := networks[cidr]
+		sort.Slice(reportNetwork.Mtus, func(i, j int) bool { return reportNetwork.Mtus[i] < reportNetwork.Mtus[j] })
+		reportNetwork.MtuMismatch = len(reportNetwork.Mtus) > 1
+		report.Networks = append(report.Networks, reportNetwork)
+	}
+	sort.Slice(report.Networks, func(i, j int) bool { return report.Networks[i].Cidr < report.Networks[j].Cidr })
+
+	var err error
+	if report.MajorityGroups, err = buildMajorityGroups(c); err != nil {
+		return nil, err
+	}
+	if report.IPCollisions, err = buildIPCollisions(c); err != nil {
+		return nil, err
+	}
+	report.Vips = buildVips(c, log)
+	return report, nil
+}
+
+// buildHost returns the interfaces of the host and adds them to the networks of their addresses
+func buildHost(host *models.Host, networks map[string]*models.NetworkReportNetwork) (*models.NetworkReportHost, error) {
+	reportHost := &models.NetworkReportHost{
+		HostID:               *host.ID,
+		Hostname:             hostutil.GetHostnameForMsg(host),
+		Role:                 common.GetEffectiveRole(host),
+		ConnectivityReported: host.Connectivity != "",
+		Interfaces:           []*models.NetworkReportInterface{},
+	}
+	if host.Inventory == "" {
+		return reportHost, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the inventory of host %s", host.ID)
+	}
+	for _, intf := range inventory.Interfaces {
+		reportHost.Interfaces = append(reportHost.Interfaces, &models.NetworkReportInterface{
+			Name:          intf.Name,
+			MacAddress:    intf.MacAddress,
+			Mtu:           intf.Mtu,
+			SpeedMbps:     intf.SpeedMbps,
+			IPV4Addresses: intf.IPV4Addresses,
+			IPV6Addresses: intf.IPV6Addresses,
+		})
+		for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			_, cidr, err := net.ParseCIDR(address)
+			if err != nil {
+				continue
+			}
+			reportNetwork, ok := networks[cidr.String()]
+			if !ok {
+				reportNetwork = &models.NetworkReportNetwork{Cidr: models.Subnet(cidr.String()), HostIds: []strfmt.UUID{}, Mtus: []int64{}}
+				networks[cidr.String()] = reportNetwork
+			}
+			if !funk.Contains(reportNetwork.HostIds, *host.ID) {
+				reportNetwork.HostIds = append(reportNetwork.HostIds, *host.ID)
+			}
+			if intf.Mtu != 0 && !funk.ContainsInt64(reportNetwork.Mtus, intf.Mtu) {
+				reportNetwork.Mtus = append(reportNetwork.Mtus, intf.Mtu)
+			}
+		}
+	}
+	return reportHost, nil
+}
+
+// buildLinks returns the connectivity from the host to the other hosts. The thresholds are checked between hosts of the
+// same role only, like the packet loss and the network latency validations do.
+func buildLinks(host *models.Host, roles map[strfmt.UUID]models.HostRole, requirements RequirementsFunc) ([]*models.NetworkReportLink, error) {
+	if host.Connectivity == "" {
+		return nil, nil
+	}
+	connectivityReport, err := hostutil.UnmarshalConnectivityReport(host.Connectivity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the connectivity report of host %s", host.ID)
+	}
+	var thresholds *models.ClusterHostRequirementsDetails
+	role := common.GetEffectiveRole(host)
+	if role != models.HostRoleAutoAssign && len(roles) > 1 {
+		hostRequirements, err := requirements(host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the requirements of host %s", host.ID)
+		}
+		thresholds = hostRequirements.Total
+	}
+
+	ret := make([]*models.NetworkReportLink, 0, len(connectivityReport.RemoteHosts))
+	for _, remoteHost := range connectivityReport.RemoteHosts {
+		link := &models.NetworkReportLink{
+			SourceHostID:   *host.ID,
+			RemoteHostID:   remoteHost.HostID,
+			L2Connectivity: remoteHost.L2Connectivity,
+			L3Connectivity: remoteHost.L3Connectivity,
+		}
+		for _, l2 := range remoteHost.L2Connectivity {
+			link.L2Reachable = link.L2Reachable || l2.Successful
+		}
+		for _, l3 := range remoteHost.L3Connectivity {
+			link.L3Reachable = link.L3Reachable || l3.Successful
+			link.AverageRTTMs = maxFloat(link.AverageRTTMs, l3.AverageRTTMs)
+			link.PacketLossPercentage = maxFloat(link.PacketLossPercentage, l3.PacketLossPercentage)
+		}
+		if remoteRole, ok := roles[remoteHost.HostID]; ok && thresholds != nil && remoteRole == role {
+			link.LatencyThresholdExceeded = thresholds.NetworkLatencyThresholdMs != nil &&
+				link.AverageRTTMs > *thresholds.NetworkLatencyThresholdMs
+			link.PacketLossThresholdExceeded = thresholds.PacketLossPercentage != nil &&
+				link.PacketLossPercentage > *thresholds.PacketLossPercentage
+		}
+		ret = append(ret, link)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].RemoteHostID.String() < ret[j].RemoteHostID.String() })
+	return ret, nil
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func buildMajorityGroups(c *common.Cluster) ([]*models.NetworkReportMajorityGroup, error) {
+	ret := []*models.NetworkReportMajorityGroup{}
+	if c.ConnectivityMajorityGroups == "" {
+		return ret, nil
+	}
+	var connectivity network.Connectivity
+	if err := json.Unmarshal([]byte(c.ConnectivityMajorityGroups), &connectivity); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the majority groups of cluster %s", c.ID)
+	}
+	for name, hostIDs := range connectivity.MajorityGroups {
+		ret = append(ret, &models.NetworkReportMajorityGroup{Network: name, HostIds: hostIDs})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Network < ret[j].Network })
+	return ret, nil
+}
+
+func buildIPCollisions(c *common.Cluster) ([]*models.NetworkReportIPCollision, error) {
+	ret := []*models.NetworkReportIPCollision{}
+	if c.IPCollisions == "" {
+		return ret, nil
+	}
+	var collisions map[string][]string
+	if err := json.Unmarshal([]byte(c.IPCollisions), &collisions); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the IP collisions of cluster %s", c.ID)
+	}
+	for address, macs := range collisions {
+		ret = append(ret, &models.NetworkReportIPCollision{IPAddress: address, MacAddresses: macs})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].IPAddress < ret[j].IPAddress })
+	return ret, nil
+}
+
+// buildVips verifies the VIPs of the cluster like the VIPs validations do
+func buildVips(c *common.Cluster, log logrus.FieldLogger) []*models.NetworkReportVip {
+	ret := []*models.NetworkReportVip{}
+	if swag.BoolValue(c.UserManagedNetworking) {
+		return ret
+	}
+	verify := func(ip string, vipType string, name string, index int, verification *models.VipVerification) {
+		vip := &models.NetworkReportVip{IP: models.IP(ip), Type: vipType}
+		result, err := network.VerifyVip(c.Hosts, network.GetMachineCidrById(c, index), ip, name, verification, log)
+		vip.Verification = &result
+		if err != nil {
+			vip.Message = err.Error()
+		}
+		ret = append(ret, vip)
+	}
+	for i, vip := range c.APIVips {
+		verify(string(vip.IP), models.NetworkReportVipTypeAPI, "api vips", i, vip.Verification)
+	}
+	for i, vip := range c.IngressVips {
+		verify(string(vip.IP), models.NetworkReportVipTypeIngress, "ingress vips", i, vip.Verification)
+	}
+	return ret
+}
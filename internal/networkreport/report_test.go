@@ -0,0 +1,190 @@
+package networkreport
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	hostID1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	hostID2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	hostID3 = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+)
+
+func reportHost(id strfmt.UUID, role models.HostRole, hostname string, mtu int64, address string, remoteHosts ...*models.ConnectivityRemoteHost) *models.Host {
+	inventory, err := json.Marshal(&models.Inventory{
+		Hostname: hostname,
+		Interfaces: []*models.Interface{{
+			Name:          "eth0",
+			MacAddress:    "52:54:00:00:00:" + id.String()[34:],
+			Mtu:           mtu,
+			IPV4Addresses: []string{address},
+		}},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	host := &models.Host{ID: &id, Role: role, Inventory: string(inventory)}
+	if len(remoteHosts) > 0 {
+		connectivity, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: remoteHosts})
+		Expect(err).ToNot(HaveOccurred())
+		host.Connectivity = string(connectivity)
+	}
+	return host
+}
+
+func remoteHost(id strfmt.UUID, address string, successful bool, rtt, packetLoss float64) *models.ConnectivityRemoteHost {
+	return &models.ConnectivityRemoteHost{
+		HostID: id,
+		L2Connectivity: []*models.L2Connectivity{{
+			OutgoingNic:     "eth0",
+			RemoteIPAddress: address,
+			Successful:      successful,
+		}},
+		L3Connectivity: []*models.L3Connectivity{{
+			OutgoingNic:          "eth0",
+			RemoteIPAddress:      address,
+			Successful:           successful,
+			AverageRTTMs:         rtt,
+			PacketLossPercentage: packetLoss,
+		}},
+	}
+}
+
+var _ = Describe("Build", func() {
+	var (
+		cluster      *common.Cluster
+		requirements RequirementsFunc
+	)
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID("00000000-0000-0000-0000-0000000000ff")
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &clusterID,
+			Hosts: []*models.Host{
+				reportHost(hostID2, models.HostRoleMaster, "master-2", 1500, "192.168.126.12/24",
+					remoteHost(hostID1, "192.168.126.11", true, 150, 0),
+					remoteHost(hostID3, "192.168.126.13", false, 0, 100)),
+				reportHost(hostID1, models.HostRoleMaster, "master-1", 1500, "192.168.126.11/24",
+					remoteHost(hostID2, "192.168.126.12", true, 1, 5)),
+				reportHost(hostID3, models.HostRoleWorker, "worker-3", 9000, "192.168.126.13/24"),
+			},
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "192.168.126.0/24"}},
+		}}
+		requirements = func(host *models.Host) (*models.ClusterHostRequirements, error) {
+			return &models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{
+				NetworkLatencyThresholdMs: swag.Float64(100),
+				PacketLossPercentage:      swag.Float64(0),
+			}}, nil
+		}
+	})
+
+	It("reports the hosts and their interfaces", func() {
+		report, err := Build(cluster, requirements, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*report.ClusterID).To(Equal(*cluster.ID))
+		Expect(report.Hosts).To(HaveLen(3))
+		Expect(report.Hosts[0].HostID).To(Equal(hostID1))
+		Expect(report.Hosts[0].Hostname).To(Equal("master-1"))
+		Expect(report.Hosts[0].Role).To(Equal(models.HostRoleMaster))
+		Expect(report.Hosts[0].ConnectivityReported).To(BeTrue())
+		Expect(report.Hosts[0].Interfaces).To(HaveLen(1))
+		Expect(report.Hosts[0].Interfaces[0].Mtu).To(BeEquivalentTo(1500))
+		Expect(report.Hosts[2].ConnectivityReported).To(BeFalse())
+	})
+
+	It("reports the links between the hosts", func() {
+		report, err := Build(cluster, requirements, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Links).To(HaveLen(3))
+
+		Expect(report.Links[0].SourceHostID).To(Equal(hostID1))
+		Expect(report.Links[0].RemoteHostID).To(Equal(hostID2))
+		Expect(report.Links[0].L3Reachable).To(BeTrue())
+		Expect(report.Links[0].LatencyThresholdExceeded).To(BeFalse())
+		Expect(report.Links[0].PacketLossThresholdExceeded).To(BeTrue())
+
+		Expect(report.Links[1].SourceHostID).To(Equal(hostID2))
+		Expect(report.Links[1].RemoteHostID).To(Equal(hostID1))
+		Expect(report.Links[1].AverageRTTMs).To(Equal(float64(150)))
+		Expect(report.Links[1].LatencyThresholdExceeded).To(BeTrue())
+		Expect(report.Links[1].PacketLossThresholdExceeded).To(BeFalse())
+
+		By("checking the thresholds between hosts of the same role only")
+		Expect(report.Links[2].RemoteHostID).To(Equal(hostID3))
+		Expect(report.Links[2].L2Reachable).To(BeFalse())
+		Expect(report.Links[2].L3Reachable).To(BeFalse())
+		Expect(report.Links[2].PacketLossThresholdExceeded).To(BeFalse())
+	})
+
+	It("reports the MTU mismatches of the networks", func() {
+		report, err := Build(cluster, requirements, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Networks).To(HaveLen(1))
+		Expect(report.Networks[0].Cidr).To(BeEquivalentTo("192.168.126.0/24"))
+		Expect(report.Networks[0].HostIds).To(ConsistOf(hostID1, hostID2, hostID3))
+		Expect(report.Networks[0].Mtus).To(Equal([]int64{1500, 9000}))
+		Expect(report.Networks[0].MtuMismatch).To(BeTrue())
+	})
+
+	It("reports the majority groups and the IP collisions computed by the monitoring", func() {
+		cluster.ConnectivityMajorityGroups = `{"majority_groups":{"192.168.126.0/24":["` + hostID1.String() + `","` + hostID2.String() + `"],"IPv4":["` + hostID1.String() + `"]}}`
+		cluster.IPCollisions = `{"192.168.126.100":["52:54:00:00:00:01","52:54:00:00:00:02"]}`
+		report, err := Build(cluster, requirements, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.MajorityGroups).To(HaveLen(2))
+		Expect(report.MajorityGroups[0].Network).To(Equal("192.168.126.0/24"))
+		Expect(report.MajorityGroups[0].HostIds).To(Equal([]strfmt.UUID{hostID1, hostID2}))
+		Expect(report.MajorityGroups[1].Network).To(Equal("IPv4"))
+		Expect(report.IPCollisions).To(HaveLen(1))
+		Expect(report.IPCollisions[0].IPAddress).To(Equal("192.168.126.100"))
+		Expect(report.IPCollisions[0].MacAddresses).To(HaveLen(2))
+	})
+
+	It("reports the availability of the VIPs", func() {
+		cluster.APIVips = []*models.APIVip{{IP: "192.168.126.100", Verification: common.VipVerificationPtr(models.VipVerificationSucceeded)}}
+		cluster.IngressVips = []*models.IngressVip{{IP: "192.168.126.11", Verification: common.VipVerificationPtr(models.VipVerificationFailed)}}
+		report, err := Build(cluster, requirements, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Vips).To(HaveLen(2))
+		Expect(report.Vips[0].Type).To(Equal(models.NetworkReportVipTypeAPI))
+		Expect(*report.Vips[0].Verification).To(Equal(models.VipVerificationSucceeded))
+		Expect(report.Vips[0].Message).To(BeEmpty())
+		Expect(report.Vips[1].Type).To(Equal(models.NetworkReportVipTypeIngress))
+		Expect(*report.Vips[1].Verification).To(Equal(models.VipVerificationFailed))
+		Expect(report.Vips[1].Message).To(ContainSubstring("is already in use"))
+	})
+
+	It("fails when the requirements of a host can't be computed", func() {
+		_, err := Build(cluster, func(host *models.Host) (*models.ClusterHostRequirements, error) {
+			return nil, errors.New("no requirements")
+		}, common.GetTestLog())
+		Expect(err).To(MatchError(ContainSubstring("no requirements")))
+	})
+})
+
+var _ = Describe("RenderHTML", func() {
+	It("renders the hosts, the links and the failures", func() {
+		report := &models.NetworkReport{
+			ClusterID: &hostID3,
+			Hosts: []*models.NetworkReportHost{
+				{HostID: hostID1, Hostname: "master-1", ConnectivityReported: true, Interfaces: []*models.NetworkReportInterface{{Name: "eth0", Mtu: 1500}}},
+				{HostID: hostID2, Hostname: "<master-2>"},
+			},
+			Links: []*models.NetworkReportLink{{SourceHostID: hostID1, RemoteHostID: hostID2, L2Reachable: true, L3Reachable: true, PacketLossThresholdExceeded: true}},
+			Vips:  []*models.NetworkReportVip{{IP: "192.168.126.100", Type: models.NetworkReportVipTypeAPI, Verification: common.VipVerificationPtr(models.VipVerificationFailed)}},
+		}
+		content, err := RenderHTML(report)
+		Expect(err).ToNot(HaveOccurred())
+		html := string(content)
+		Expect(html).To(ContainSubstring("<td>master-1</td><td>&lt;master-2&gt;</td>"))
+		Expect(html).To(ContainSubstring("0.00 (above threshold)"))
+		Expect(html).To(ContainSubstring(`<tr class="failure"><td>192.168.126.100</td><td>api</td><td>failed</td>`))
+		Expect(html).To(ContainSubstring("No inventory"))
+	})
+})
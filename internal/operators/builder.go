@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
 	"github.com/openshift/assisted-service/internal/operators/odf"
+	"github.com/openshift/assisted-service/internal/operators/plugin"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
@@ -29,11 +30,69 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+	// Directory holding declarative OLM operator plugins, see docs/dev/olm-operator-plugins.md
+	PluginsDirectory string `envconfig:"OPERATOR_PLUGINS_DIR" default:""`
 }
 
 // NewManager creates new instance of an Operator Manager
 func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API, extracter oc.Extracter) *Manager {
-	return NewManagerWithOperators(log, manifestAPI, options, objectHandler, lso.NewLSOperator(), odf.NewOcsOperator(log), odf.NewOdfOperator(log, extracter), cnv.NewCNVOperator(log, options.CNVConfig, extracter), lvm.NewLvmOperator(log, extracter), mce.NewMceOperator(log))
+	olmOperators := []api.Operator{lso.NewLSOperator(), odf.NewOcsOperator(log), odf.NewOdfOperator(log, extracter), cnv.NewCNVOperator(log, options.CNVConfig, extracter), lvm.NewLvmOperator(log, extracter), mce.NewMceOperator(log)}
+	if options.PluginsDirectory != "" {
+		pluginOperators, err := loadPluginOperators(log, options.PluginsDirectory, olmOperators)
+		if err != nil {
+			log.WithError(err).Error("Failed to load OLM operator plugins")
+		}
+		olmOperators = append(olmOperators, pluginOperators...)
+	}
+	return NewManagerWithOperators(log, manifestAPI, options, objectHandler, olmOperators...)
+}
+
+// loadPluginOperators loads the declarative operator plugins. The plugins that clash with another operator, or depend
+// on an unknown operator, are logged and skipped
+func loadPluginOperators(log logrus.FieldLogger, directory string, builtinOperators []api.Operator) ([]api.Operator, error) {
+	pluginOperators, err := plugin.LoadOperators(log, directory)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, operator := range builtinOperators {
+		names[operator.GetName()] = true
+	}
+	var loaded []api.Operator
+	for _, operator := range pluginOperators {
+		if names[operator.GetName()] {
+			log.Errorf("Skipping OLM operator plugin %s, it clashes with an existing operator", operator.GetName())
+			continue
+		}
+		names[operator.GetName()] = true
+		loaded = append(loaded, operator)
+	}
+	// Skipping a plugin can leave the plugins depending on it with an unknown dependency
+	for skipped := true; skipped; {
+		skipped = false
+		pluginOperators, loaded = loaded, nil
+		for _, operator := range pluginOperators {
+			if dependency := unknownDependency(operator, names); dependency != "" {
+				log.Errorf("Skipping OLM operator plugin %s, it depends on unknown operator %s", operator.GetName(), dependency)
+				delete(names, operator.GetName())
+				skipped = true
+				continue
+			}
+			loaded = append(loaded, operator)
+		}
+	}
+	return loaded, nil
+}
+
+func unknownDependency(operator api.Operator, names map[string]bool) string {
+	// The dependencies of the plugins don't depend on the cluster
+	dependencies, _ := operator.GetDependencies(nil)
+	for _, dependency := range dependencies {
+		if !names[dependency] {
+			return dependency
+		}
+	}
+	return ""
 }
 
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
@@ -64,3 +123,12 @@ func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.Ma
 		objectHandler:      objectHandler,
 	}
 }
+
+// WithStorage returns a copy of the manager that stores the generated manifests with the given manifests API
+// and object handler
+func (mgr *Manager) WithStorage(manifestAPI manifestsapi.ManifestsAPI, objectHandler s3wrapper.API) *Manager {
+	result := *mgr
+	result.manifestsAPI = manifestAPI
+	result.objectHandler = objectHandler
+	return &result
+}
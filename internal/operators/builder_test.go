@@ -1,6 +1,9 @@
 package operators
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -74,4 +77,151 @@ var _ = Describe("Operators manager builder", func() {
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorConsole.Name, &OperatorConsole))
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorCVO.Name, &OperatorCVO))
 	})
+
+	Context("operator plugins", func() {
+		var directory string
+
+		BeforeEach(func() {
+			var err error
+			directory, err = os.MkdirTemp("", "operator-plugins")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(directory)).To(Succeed())
+		})
+
+		writeBundle := func(content string) {
+			Expect(os.WriteFile(filepath.Join(directory, "plugin.yaml"), []byte(content), 0600)).To(Succeed())
+		}
+
+		writeNamedBundle := func(name, content string) {
+			Expect(os.WriteFile(filepath.Join(directory, name), []byte(content), 0600)).To(Succeed())
+		}
+
+		builtinOperators := func() []api.Operator {
+			operator1.EXPECT().GetName().AnyTimes().Return("lso")
+			return []api.Operator{operator1}
+		}
+
+		It("should load plugins depending on builtin operators", func() {
+			writeBundle(`name: partner
+namespace: partner
+subscriptionName: partner
+dependencies: [lso]
+manifests:
+  - fileName: 50_partner_ns.yaml
+    content: "kind: Namespace"
+`)
+			pluginOperators, err := loadPluginOperators(log, directory, builtinOperators())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginOperators).To(HaveLen(1))
+
+			operator1.EXPECT().GetMonitoredOperator().Return(&models.MonitoredOperator{Name: "lso"})
+			manager := NewManagerWithOperators(log, nil, Options{}, nil, append(builtinOperators(), pluginOperators...)...)
+			Expect(manager.GetSupportedOperators()).To(ConsistOf("lso", "partner"))
+			Expect(manager.GetOperatorByName("partner")).To(Equal(&models.MonitoredOperator{
+				Name:             "partner",
+				OperatorType:     models.OperatorTypeOlm,
+				Namespace:        "partner",
+				SubscriptionName: "partner",
+				TimeoutSeconds:   60 * 60,
+			}))
+		})
+
+		It("should skip plugins clashing with builtin operators", func() {
+			writeBundle(`name: lso
+namespace: lso
+subscriptionName: lso
+manifests:
+  - fileName: 50_lso_ns.yaml
+    content: "kind: Namespace"
+`)
+			pluginOperators, err := loadPluginOperators(log, directory, builtinOperators())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginOperators).To(BeEmpty())
+		})
+
+		It("should skip plugins with unknown dependencies and the plugins depending on them", func() {
+			writeNamedBundle("a.yaml", `name: partner
+namespace: partner
+subscriptionName: partner
+dependencies: [unknown]
+manifests:
+  - fileName: 50_partner_ns.yaml
+    content: "kind: Namespace"
+`)
+			writeNamedBundle("b.yaml", `name: partner-addon
+namespace: partner-addon
+subscriptionName: partner-addon
+dependencies: [partner]
+manifests:
+  - fileName: 50_partner-addon_ns.yaml
+    content: "kind: Namespace"
+`)
+			writeNamedBundle("c.yaml", `name: other
+namespace: other
+subscriptionName: other
+dependencies: [lso]
+manifests:
+  - fileName: 50_other_ns.yaml
+    content: "kind: Namespace"
+`)
+			pluginOperators, err := loadPluginOperators(log, directory, builtinOperators())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginOperators).To(HaveLen(1))
+			Expect(pluginOperators[0].GetName()).To(Equal("other"))
+		})
+
+		It("should skip malformed plugins", func() {
+			writeNamedBundle("a.yaml", "name: [malformed")
+			writeNamedBundle("b.yaml", `name: partner
+namespace: partner
+subscriptionName: partner
+manifests:
+  - fileName: 50_partner_ns.yaml
+    content: "kind: Namespace"
+`)
+			pluginOperators, err := loadPluginOperators(log, directory, builtinOperators())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginOperators).To(HaveLen(1))
+			Expect(pluginOperators[0].GetName()).To(Equal("partner"))
+		})
+	})
+
+	Context("validation results", func() {
+		const pluginsValidationID = "operator-plugins-requirements-satisfied"
+
+		It("should keep the results with distinct validation IDs", func() {
+			results := mergeValidationResults([]api.ValidationResult{
+				{Status: api.Success, ValidationId: "lso-requirements-satisfied", Reasons: []string{"lso"}},
+				{Status: api.Failure, ValidationId: "odf-requirements-satisfied", Reasons: []string{"odf"}},
+			})
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Status).To(Equal(api.Success))
+			Expect(results[1].Status).To(Equal(api.Failure))
+		})
+
+		It("should merge the plugin results into the worst of them", func() {
+			results := mergeValidationResults([]api.ValidationResult{
+				{Status: api.Success, ValidationId: pluginsValidationID, Reasons: []string{"a is fine"}},
+				{Status: api.Pending, ValidationId: pluginsValidationID, Reasons: []string{"b is pending"}},
+				{Status: api.Failure, ValidationId: pluginsValidationID, Reasons: []string{"c failed"}},
+				{Status: api.Failure, ValidationId: pluginsValidationID, Reasons: []string{"d failed"}},
+			})
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Status).To(Equal(api.Failure))
+			Expect(results[0].Reasons).To(Equal([]string{"b is pending", "c failed", "d failed"}))
+		})
+
+		It("should keep all the reasons when all the plugins succeed", func() {
+			results := mergeValidationResults([]api.ValidationResult{
+				{Status: api.Success, ValidationId: pluginsValidationID, Reasons: []string{"a is fine"}},
+				{Status: api.Success, ValidationId: pluginsValidationID, Reasons: []string{"b is fine"}},
+			})
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Status).To(Equal(api.Success))
+			Expect(results[0].Reasons).To(Equal([]string{"a is fine", "b is fine"}))
+		})
+	})
 })
@@ -170,7 +170,9 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 				}
 			}
 
-			controllerManifests = append(controllerManifests, Manifest{Name: clusterOperator.Name, Content: base64.StdEncoding.EncodeToString(manifest)})
+			if len(manifest) > 0 {
+				controllerManifests = append(controllerManifests, Manifest{Name: clusterOperator.Name, Content: base64.StdEncoding.EncodeToString(manifest)})
+			}
 		}
 	}
 
@@ -282,7 +284,7 @@ func (mgr *Manager) ValidateHost(ctx context.Context, cluster *common.Cluster, h
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeValidationResults(results), nil
 }
 
 // ValidateCluster validates cluster requirements
@@ -321,7 +323,35 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeValidationResults(results), nil
+}
+
+// mergeValidationResults merges the results sharing a validation ID, i.e. the results of the operator plugins, into
+// the worst of them. Only the reasons of the unsuccessful results are kept when the merged result isn't successful.
+func mergeValidationResults(results []api.ValidationResult) []api.ValidationResult {
+	severity := map[api.ValidationStatus]int{api.Success: 0, api.Pending: 1, api.Failure: 2}
+	merged := make([]api.ValidationResult, 0, len(results))
+	indexes := make(map[string]int)
+	for _, result := range results {
+		i, ok := indexes[result.ValidationId]
+		if !ok {
+			indexes[result.ValidationId] = len(merged)
+			merged = append(merged, result)
+			continue
+		}
+		current := &merged[i]
+		switch {
+		case severity[result.Status] > severity[current.Status]:
+			if current.Status == api.Success {
+				current.Reasons = nil
+			}
+			current.Status = result.Status
+			current.Reasons = append(current.Reasons, result.Reasons...)
+		case severity[result.Status] == severity[current.Status]:
+			current.Reasons = append(current.Reasons, result.Reasons...)
+		}
+	}
+	return merged
 }
 
 // GetSupportedOperators returns a list of OLM operators that are supported
@@ -439,6 +469,10 @@ func (mgr *Manager) GetSupportedOperatorsByType(operatorType models.OperatorType
 
 func isOperatorCompatibleWithArchitecture(cluster *common.Cluster, cpuArchitecture string, operator api.Operator) bool {
 	featureId := operator.GetFeatureSupportID()
+	if featuresupport.GetFeatureByID(featureId) == nil {
+		// Operator plugins are not required to be bound to a feature, they validate the architecture by themselves
+		return true
+	}
 	return featuresupport.IsFeatureCompatibleWithArchitecture(featureId, cluster.OpenshiftVersion, cpuArchitecture)
 }
 
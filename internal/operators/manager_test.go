@@ -139,6 +139,15 @@ var _ = Describe("Operators manager", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("stores the manifests with the storage of the copy", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&lvm.Operator}
+			otherManifestsAPI := manifestsapi.NewMockManifestsAPI(ctrl)
+			otherS3Api := s3wrapper.NewMockAPI(ctrl)
+			otherS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			otherManifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).Return(&models.Manifest{}, nil).MinTimes(1)
+			Expect(manager.WithStorage(otherManifestsAPI, otherS3Api).GenerateManifests(ctx, cluster)).To(Succeed())
+		})
+
 		It("should upload AgentServiceConfig as controller manifest when MCE + ODF is deployed", func() {
 			cluster.MonitoredOperators = []*models.MonitoredOperator{
 				&odf.Operator,
@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/models"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const defaultTimeoutSeconds = 60 * 60
+
+// Definition is the declarative description of an OLM operator plugin, as read from a bundle file
+type Definition struct {
+	// Name is the short name of the operator, used in the API and in validation IDs
+	Name string `json:"name"`
+	// FullName is the human readable name of the operator
+	FullName string `json:"fullName,omitempty"`
+	// Namespace the operator is installed into
+	Namespace string `json:"namespace"`
+	// SubscriptionName is the name of the OLM subscription of the operator
+	SubscriptionName string `json:"subscriptionName"`
+	// TimeoutSeconds is the time the installation waits for the operator to become available
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+	// FeatureSupportID optionally binds the operator to an existing feature support level
+	FeatureSupportID models.FeatureSupportLevelID `json:"featureSupportID,omitempty"`
+	// Dependencies lists the names of the operators this operator depends on
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Requirements holds the hardware requirements the operator adds to each host, per role
+	Requirements Requirements `json:"requirements,omitempty"`
+	// Validations holds the cluster and host validations of the operator
+	Validations Validations `json:"validations,omitempty"`
+	// Manifests are templates of the manifests applied during the bootstrap phase
+	Manifests []ManifestTemplate `json:"manifests"`
+	// ControllerManifest is a template of the manifest applied by the assisted-installer-controller once the operator is ready
+	ControllerManifest string `json:"controllerManifest,omitempty"`
+}
+
+// Requirements holds the per role hardware requirements of an operator
+type Requirements struct {
+	Master RoleRequirements `json:"master,omitempty"`
+	Worker RoleRequirements `json:"worker,omitempty"`
+}
+
+// RoleRequirements holds the hardware requirements an operator adds to a host of a specific role
+type RoleRequirements struct {
+	CPUCores    int64    `json:"cpuCores,omitempty"`
+	RAMMib      int64    `json:"ramMib,omitempty"`
+	DiskSizeGb  int64    `json:"diskSizeGb,omitempty"`
+	Qualitative []string `json:"qualitative,omitempty"`
+}
+
+// Validations holds the declarative validations of an operator
+type Validations struct {
+	Cluster ClusterValidations `json:"cluster,omitempty"`
+	Host    HostValidations    `json:"host,omitempty"`
+}
+
+// ClusterValidations describes the conditions the cluster has to fulfill for the operator to be installed
+type ClusterValidations struct {
+	// MinOpenshiftVersion is the minimal OpenShift version the operator can be installed on
+	MinOpenshiftVersion string `json:"minOpenshiftVersion,omitempty"`
+	// CPUArchitectures restricts the CPU architectures of the cluster, any architecture is allowed when empty
+	CPUArchitectures []string `json:"cpuArchitectures,omitempty"`
+	// HighAvailabilityModes restricts the high availability modes of the cluster, any mode is allowed when empty
+	HighAvailabilityModes []string `json:"highAvailabilityModes,omitempty"`
+	// Platforms restricts the platform types of the cluster, any platform is allowed when empty
+	Platforms []models.PlatformType `json:"platforms,omitempty"`
+}
+
+// HostValidations describes the conditions every host has to fulfill for the operator to be installed
+type HostValidations struct {
+	// MinDisks is the number of non-installation HDD/SSD disks required on each host
+	MinDisks int64 `json:"minDisks,omitempty"`
+	// MinDiskSizeGb is the minimal size of the disks counted by MinDisks
+	MinDiskSizeGb int64 `json:"minDiskSizeGb,omitempty"`
+	// Roles restricts the disk validation to hosts of the given roles, all roles are validated when empty
+	Roles []models.HostRole `json:"roles,omitempty"`
+}
+
+// ManifestTemplate is a go template of a manifest stored under the openshift manifests folder
+type ManifestTemplate struct {
+	FileName string `json:"fileName"`
+	Content  string `json:"content"`
+}
+
+// Validate checks that the definition is complete and that all of its templates can be parsed
+func (d *Definition) Validate() error {
+	var result *multierror.Error
+	if errs := validation.IsDNS1123Label(d.Name); len(errs) > 0 {
+		result = multierror.Append(result, fmt.Errorf("invalid operator name %q: %v", d.Name, errs))
+	}
+	if d.Namespace == "" {
+		result = multierror.Append(result, fmt.Errorf("operator %s: namespace is required", d.Name))
+	}
+	if d.SubscriptionName == "" {
+		result = multierror.Append(result, fmt.Errorf("operator %s: subscriptionName is required", d.Name))
+	}
+	if d.TimeoutSeconds < 0 {
+		result = multierror.Append(result, fmt.Errorf("operator %s: timeoutSeconds must not be negative", d.Name))
+	}
+	if d.FeatureSupportID != "" && featuresupport.GetFeatureByID(d.FeatureSupportID) == nil {
+		result = multierror.Append(result, fmt.Errorf("operator %s: unknown feature support ID %s", d.Name, d.FeatureSupportID))
+	}
+	for _, dependency := range d.Dependencies {
+		if dependency == d.Name {
+			result = multierror.Append(result, fmt.Errorf("operator %s cannot depend on itself", d.Name))
+		}
+	}
+	for _, role := range d.Validations.Host.Roles {
+		if role != models.HostRoleMaster && role != models.HostRoleWorker {
+			result = multierror.Append(result, fmt.Errorf("operator %s: unsupported host role %s in host validations", d.Name, role))
+		}
+	}
+	if len(d.Manifests) == 0 {
+		result = multierror.Append(result, fmt.Errorf("operator %s: at least one manifest is required", d.Name))
+	}
+	fileNames := make(map[string]bool)
+	for _, manifest := range d.Manifests {
+		if manifest.FileName == "" {
+			result = multierror.Append(result, fmt.Errorf("operator %s: manifest fileName is required", d.Name))
+			continue
+		}
+		if fileNames[manifest.FileName] {
+			result = multierror.Append(result, fmt.Errorf("operator %s: duplicate manifest %s", d.Name, manifest.FileName))
+		}
+		fileNames[manifest.FileName] = true
+		if _, err := parseTemplate(manifest.FileName, manifest.Content); err != nil {
+			result = multierror.Append(result, fmt.Errorf("operator %s: invalid manifest %s: %w", d.Name, manifest.FileName, err))
+		}
+	}
+	if d.ControllerManifest != "" {
+		if _, err := parseTemplate("controllerManifest", d.ControllerManifest); err != nil {
+			result = multierror.Append(result, fmt.Errorf("operator %s: invalid controller manifest: %w", d.Name, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func parseTemplate(name, content string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=error").Parse(content)
+}
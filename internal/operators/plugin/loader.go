@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// LoadOperators reads every operator bundle (*.yaml, *.yml) in the given directory and
+// creates an OLM operator plugin for each of them. The invalid bundles are logged and skipped,
+// so that a single malformed bundle doesn't prevent the service from starting
+func LoadOperators(log logrus.FieldLogger, directory string) ([]api.Operator, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operator plugins directory %s: %w", directory, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var operators []api.Operator
+	names := make(map[string]string)
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+			continue
+		}
+		fileName := filepath.Join(directory, entry.Name())
+		operator, err := loadOperator(log, fileName, names)
+		if err != nil {
+			log.WithError(err).Errorf("Skipping OLM operator plugin %s", fileName)
+			continue
+		}
+		log.Infof("Loaded OLM operator plugin %s from %s", operator.GetName(), fileName)
+		operators = append(operators, operator)
+	}
+	return operators, nil
+}
+
+// loadOperator creates the operator of a bundle, names maps the operators already loaded to their bundle
+func loadOperator(log logrus.FieldLogger, fileName string, names map[string]string) (api.Operator, error) {
+	definition, err := readDefinition(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if previous, ok := names[definition.Name]; ok {
+		return nil, fmt.Errorf("operator %s defined in %s is already defined in %s", definition.Name, fileName, previous)
+	}
+	operator, err := NewOperator(log.WithField("operator", definition.Name), *definition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator plugin %s: %w", fileName, err)
+	}
+	names[definition.Name] = fileName
+	return operator, nil
+}
+
+func readDefinition(fileName string) (*Definition, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operator plugin %s: %w", fileName, err)
+	}
+	var definition Definition
+	if err = yaml.UnmarshalStrict(content, &definition); err != nil {
+		return nil, fmt.Errorf("failed to parse operator plugin %s: %w", fileName, err)
+	}
+	return &definition, nil
+}
@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+const partnerOperatorBundle = `name: partner-storage
+fullName: Partner Storage
+namespace: partner-storage
+subscriptionName: partner-storage-operator
+timeoutSeconds: 1800
+dependencies:
+  - lso
+requirements:
+  master:
+    cpuCores: 2
+    ramMib: 2048
+  worker:
+    cpuCores: 1
+    ramMib: 1024
+validations:
+  cluster:
+    minOpenshiftVersion: "4.12"
+  host:
+    minDisks: 1
+    minDiskSizeGb: 20
+manifests:
+  - fileName: 50_partner-storage_ns.yaml
+    content: |
+      apiVersion: v1
+      kind: Namespace
+      metadata:
+        name: "{{.Operator.Namespace}}"
+  - fileName: 50_partner-storage_subscription.yaml
+    content: |
+      apiVersion: operators.coreos.com/v1alpha1
+      kind: Subscription
+      metadata:
+        name: "{{.Operator.SubscriptionName}}"
+        namespace: "{{.Operator.Namespace}}"
+      spec:
+        name: partner-storage-operator
+        source: certified-operators
+        sourceNamespace: openshift-marketplace
+controllerManifest: |
+  apiVersion: partner.example.com/v1
+  kind: StorageCluster
+  metadata:
+    name: "{{.Cluster.Name}}"
+`
+
+var _ = Describe("LoadOperators", func() {
+	var (
+		directory string
+		log       *logrus.Logger
+		hook      *test.Hook
+	)
+
+	BeforeEach(func() {
+		log, hook = test.NewNullLogger()
+		var err error
+		directory, err = os.MkdirTemp("", "operator-plugins")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(directory)).To(Succeed())
+	})
+
+	writeBundle := func(name, content string) {
+		Expect(os.WriteFile(filepath.Join(directory, name), []byte(content), 0600)).To(Succeed())
+	}
+
+	It("loads the operators of all the bundles in the directory", func() {
+		writeBundle("partner.yaml", partnerOperatorBundle)
+		writeBundle("other.yml", `name: other
+namespace: other
+subscriptionName: other
+manifests:
+  - fileName: 50_other_ns.yaml
+    content: "kind: Namespace"
+`)
+		writeBundle("README.md", "not a bundle")
+
+		operators, err := LoadOperators(log, directory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(2))
+		Expect(operators[0].GetName()).To(Equal("other"))
+		Expect(operators[1].GetName()).To(Equal("partner-storage"))
+		Expect(operators[1].GetFullName()).To(Equal("Partner Storage"))
+		Expect(operators[1].GetMonitoredOperator()).To(Equal(&models.MonitoredOperator{
+			Name:             "partner-storage",
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        "partner-storage",
+			SubscriptionName: "partner-storage-operator",
+			TimeoutSeconds:   1800,
+		}))
+		Expect(operators[0].GetMonitoredOperator().TimeoutSeconds).To(BeEquivalentTo(defaultTimeoutSeconds))
+	})
+
+	It("fails when the directory does not exist", func() {
+		_, err := LoadOperators(log, filepath.Join(directory, "missing"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("skips the bundles with unknown fields", func() {
+		writeBundle("partner.yaml", partnerOperatorBundle+"unknownField: true\n")
+		Expect(LoadOperators(log, directory)).To(BeEmpty())
+		Expect(hook.LastEntry().Message).To(ContainSubstring("Skipping OLM operator plugin"))
+		Expect(hook.LastEntry().Data[logrus.ErrorKey]).To(MatchError(ContainSubstring("failed to parse operator plugin")))
+	})
+
+	It("skips the bundles of operators that are already defined", func() {
+		writeBundle("a.yaml", partnerOperatorBundle)
+		writeBundle("b.yaml", partnerOperatorBundle)
+		operators, err := LoadOperators(log, directory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(1))
+		Expect(hook.LastEntry().Data[logrus.ErrorKey]).To(MatchError(ContainSubstring("is already defined")))
+	})
+
+	It("skips the invalid definitions", func() {
+		writeBundle("partner.yaml", `name: Partner_Storage
+manifests:
+  - fileName: 50_ns.yaml
+    content: "{{.Operator.Namespace"
+`)
+		writeBundle("other.yaml", `name: other
+namespace: other
+subscriptionName: other
+manifests:
+  - fileName: 50_other_ns.yaml
+    content: "kind: Namespace"
+`)
+		operators, err := LoadOperators(log, directory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(1))
+		Expect(operators[0].GetName()).To(Equal("other"))
+
+		var skipped error
+		for _, entry := range hook.AllEntries() {
+			if entry.Level == logrus.ErrorLevel {
+				skipped = entry.Data[logrus.ErrorKey].(error)
+			}
+		}
+		Expect(skipped).To(HaveOccurred())
+		Expect(skipped.Error()).To(ContainSubstring("invalid operator name"))
+		Expect(skipped.Error()).To(ContainSubstring("namespace is required"))
+		Expect(skipped.Error()).To(ContainSubstring("subscriptionName is required"))
+		Expect(skipped.Error()).To(ContainSubstring("invalid manifest 50_ns.yaml"))
+	})
+})
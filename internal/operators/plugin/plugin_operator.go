@@ -0,0 +1,280 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// operator is an OLM operator plugin backed by a declarative Definition; it implements api.Operator
+type operator struct {
+	log                logrus.FieldLogger
+	definition         Definition
+	monitoredOperator  models.MonitoredOperator
+	manifests          map[string]*template.Template
+	controllerManifest *template.Template
+}
+
+// templateData is the data available to the manifest templates of a plugin
+type templateData struct {
+	Operator models.MonitoredOperator
+	Cluster  templateClusterData
+}
+
+type templateClusterData struct {
+	ID                   string
+	Name                 string
+	OpenshiftVersion     string
+	BaseDNSDomain        string
+	CPUArchitecture      string
+	HighAvailabilityMode string
+	SingleNode           bool
+}
+
+// NewOperator creates an OLM operator plugin out of the given definition
+func NewOperator(log logrus.FieldLogger, definition Definition) (*operator, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+	timeout := definition.TimeoutSeconds
+	if timeout == 0 {
+		timeout = defaultTimeoutSeconds
+	}
+	o := &operator{
+		log:        log,
+		definition: definition,
+		monitoredOperator: models.MonitoredOperator{
+			Name:             definition.Name,
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        definition.Namespace,
+			SubscriptionName: definition.SubscriptionName,
+			TimeoutSeconds:   timeout,
+		},
+		manifests: make(map[string]*template.Template),
+	}
+	for _, manifest := range definition.Manifests {
+		// Templates are already known to be valid at this point
+		o.manifests[manifest.FileName], _ = parseTemplate(manifest.FileName, manifest.Content)
+	}
+	if definition.ControllerManifest != "" {
+		o.controllerManifest, _ = parseTemplate("controllerManifest", definition.ControllerManifest)
+	}
+	return o, nil
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+// GetFullName reports the full name of the operator, defaulting to its name
+func (o *operator) GetFullName() string {
+	if o.definition.FullName == "" {
+		return o.definition.Name
+	}
+	return o.definition.FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(_ *common.Cluster) ([]string, error) {
+	return append(make([]string, 0, len(o.definition.Dependencies)), o.definition.Dependencies...), nil
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator. All the plugins share the same validation ID,
+// the validation IDs being a closed list of the API
+func (o *operator) GetClusterValidationID() string {
+	return string(models.ClusterValidationIDOperatorPluginsRequirementsSatisfied)
+}
+
+// GetHostValidationID returns host validation ID for the Operator, shared by all the plugins
+func (o *operator) GetHostValidationID() string {
+	return string(models.HostValidationIDOperatorPluginsRequirementsSatisfied)
+}
+
+// ValidateCluster verifies the cluster against the declared cluster validations
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	var reasons []string
+	validations := o.definition.Validations.Cluster
+
+	if validations.MinOpenshiftVersion != "" {
+		if ok, _ := common.BaseVersionLessThan(validations.MinOpenshiftVersion, cluster.OpenshiftVersion); ok {
+			reasons = append(reasons, fmt.Sprintf("%s is only supported for openshift versions %s and above", o.GetFullName(), validations.MinOpenshiftVersion))
+		}
+	}
+	if len(validations.CPUArchitectures) > 0 && !funk.ContainsString(validations.CPUArchitectures, cluster.CPUArchitecture) {
+		reasons = append(reasons, fmt.Sprintf("%s is not supported on %s CPU architecture", o.GetFullName(), cluster.CPUArchitecture))
+	}
+	if len(validations.HighAvailabilityModes) > 0 && !funk.ContainsString(validations.HighAvailabilityModes, swag.StringValue(cluster.HighAvailabilityMode)) {
+		reasons = append(reasons, fmt.Sprintf("%s is only supported for high availability modes %s", o.GetFullName(), strings.Join(validations.HighAvailabilityModes, ", ")))
+	}
+	if len(validations.Platforms) > 0 {
+		platformType := models.PlatformTypeBaremetal
+		if cluster.Platform != nil && cluster.Platform.Type != nil {
+			platformType = *cluster.Platform.Type
+		}
+		if !funk.Contains(validations.Platforms, platformType) {
+			reasons = append(reasons, fmt.Sprintf("%s is not supported on %s platform", o.GetFullName(), platformType))
+		}
+	}
+
+	if len(reasons) > 0 {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetClusterValidationID(), Reasons: reasons}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}, nil
+}
+
+// ValidateHost verifies the host against the declared host validations
+func (o *operator) ValidateHost(_ context.Context, _ *common.Cluster, host *models.Host, additionalOperatorRequirements *models.ClusterHostRequirementsDetails) (api.ValidationResult, error) {
+	validations := o.definition.Validations.Host
+	if validations.MinDisks == 0 {
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+	}
+
+	role := common.GetEffectiveRole(host)
+	if len(validations.Roles) > 0 {
+		if role == models.HostRoleAutoAssign {
+			message := fmt.Sprintf("For %s, host role must be assigned to master or worker.", o.GetFullName())
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, nil
+		}
+		if !funk.Contains(validations.Roles, role) {
+			return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+		}
+	}
+
+	if host.Inventory == "" {
+		message := "Missing Inventory in the host"
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		message := "Failed to get inventory from host"
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, err
+	}
+
+	minDiskSizeGb := validations.MinDiskSizeGb
+	if additionalOperatorRequirements != nil && additionalOperatorRequirements.DiskSizeGb > minDiskSizeGb {
+		minDiskSizeGb = additionalOperatorRequirements.DiskSizeGb
+	}
+	diskCount, _ := operatorscommon.NonInstallationDiskCount(inventory.Disks, host.InstallationDiskID, minDiskSizeGb)
+	if diskCount < validations.MinDisks {
+		minSizeMessage := ""
+		if minDiskSizeGb > 0 {
+			minSizeMessage = fmt.Sprintf(" of %dGB minimum", minDiskSizeGb)
+		}
+		message := fmt.Sprintf("%s requires at least %d non-installation HDD/SSD disk(s)%s on the host", o.GetFullName(), validations.MinDisks, minSizeMessage)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+}
+
+// GenerateManifests renders the manifest templates of the operator
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	data := o.newTemplateData(cluster)
+	openshiftManifests := make(map[string][]byte, len(o.manifests))
+	for fileName, tmpl := range o.manifests {
+		content, err := executeTemplate(tmpl, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to render manifest %s of operator %s: %w", fileName, o.GetName(), err)
+		}
+		openshiftManifests[fileName] = content
+	}
+	var controllerManifest []byte
+	if o.controllerManifest != nil {
+		content, err := executeTemplate(o.controllerManifest, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to render controller manifest of operator %s: %w", o.GetName(), err)
+		}
+		controllerManifest = content
+	}
+	return openshiftManifests, controllerManifest, nil
+}
+
+// GetProperties provides description of operator properties: none supported
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the plugin
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &o.monitoredOperator
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(_ context.Context, _ *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	if common.GetEffectiveRole(host) == models.HostRoleMaster {
+		return o.definition.Requirements.Master.quantitative(), nil
+	}
+	return o.definition.Requirements.Worker.quantitative(), nil
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	dependencies, err := o.GetDependencies(cluster)
+	if err != nil {
+		return &models.OperatorHardwareRequirements{}, err
+	}
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: dependencies,
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative:  o.definition.Requirements.Master.Qualitative,
+				Quantitative: o.definition.Requirements.Master.quantitative(),
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Qualitative:  o.definition.Requirements.Worker.Qualitative,
+				Quantitative: o.definition.Requirements.Worker.quantitative(),
+			},
+		},
+	}, nil
+}
+
+// GetFeatureSupportID returns the feature support ID the operator is bound to, if any
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return o.definition.FeatureSupportID
+}
+
+func (r RoleRequirements) quantitative() *models.ClusterHostRequirementsDetails {
+	return &models.ClusterHostRequirementsDetails{
+		CPUCores:   r.CPUCores,
+		RAMMib:     r.RAMMib,
+		DiskSizeGb: r.DiskSizeGb,
+	}
+}
+
+func (o *operator) newTemplateData(cluster *common.Cluster) templateData {
+	var clusterID string
+	if cluster.ID != nil {
+		clusterID = cluster.ID.String()
+	}
+	return templateData{
+		Operator: o.monitoredOperator,
+		Cluster: templateClusterData{
+			ID:                   clusterID,
+			Name:                 cluster.Name,
+			OpenshiftVersion:     cluster.OpenshiftVersion,
+			BaseDNSDomain:        cluster.BaseDNSDomain,
+			CPUArchitecture:      cluster.CPUArchitecture,
+			HighAvailabilityMode: swag.StringValue(cluster.HighAvailabilityMode),
+			SingleNode:           common.IsSingleNodeCluster(cluster),
+		},
+	}
+}
+
+func executeTemplate(tmpl *template.Template, data templateData) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,167 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Operator plugin", func() {
+	var (
+		ctx        = context.Background()
+		definition Definition
+		operator   *operator
+		cluster    *common.Cluster
+	)
+
+	BeforeEach(func() {
+		definition = Definition{}
+		Expect(yaml.UnmarshalStrict([]byte(partnerOperatorBundle), &definition)).To(Succeed())
+		var err error
+		operator, err = NewOperator(common.GetTestLog(), definition)
+		Expect(err).ToNot(HaveOccurred())
+		clusterID := strfmt.UUID("5c31e3c5-24f4-4a3c-9a2c-2d0b8c0f3b11")
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:                   &clusterID,
+			Name:                 "partner-cluster",
+			OpenshiftVersion:     "4.14.0",
+			CPUArchitecture:      models.ClusterCPUArchitectureX8664,
+			HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+		}}
+	})
+
+	It("uses the operator name for the validation IDs", func() {
+		Expect(operator.GetClusterValidationID()).To(Equal(string(models.ClusterValidationIDOperatorPluginsRequirementsSatisfied)))
+		Expect(operator.GetHostValidationID()).To(Equal(string(models.HostValidationIDOperatorPluginsRequirementsSatisfied)))
+	})
+
+	It("reports its dependencies", func() {
+		Expect(operator.GetDependencies(cluster)).To(ConsistOf("lso"))
+	})
+
+	Context("ValidateCluster", func() {
+		It("succeeds when all the cluster validations pass", func() {
+			result, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("fails when the openshift version is too old", func() {
+			cluster.OpenshiftVersion = "4.11.0"
+			result, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("Partner Storage is only supported for openshift versions 4.12 and above"))
+		})
+
+		It("reports every failed cluster validation", func() {
+			operator.definition.Validations.Cluster.CPUArchitectures = []string{models.ClusterCPUArchitectureArm64}
+			operator.definition.Validations.Cluster.HighAvailabilityModes = []string{models.ClusterHighAvailabilityModeNone}
+			operator.definition.Validations.Cluster.Platforms = []models.PlatformType{models.PlatformTypeVsphere}
+			result, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf(
+				"Partner Storage is not supported on x86_64 CPU architecture",
+				"Partner Storage is only supported for high availability modes None",
+				"Partner Storage is not supported on baremetal platform",
+			))
+		})
+	})
+
+	Context("ValidateHost", func() {
+		var host *models.Host
+
+		newInventory := func(disks ...*models.Disk) string {
+			b, err := json.Marshal(&models.Inventory{Disks: disks})
+			Expect(err).ToNot(HaveOccurred())
+			return string(b)
+		}
+
+		BeforeEach(func() {
+			host = &models.Host{Role: models.HostRoleWorker, InstallationDiskID: "/dev/sda"}
+		})
+
+		It("is pending without inventory", func() {
+			result, err := operator.ValidateHost(ctx, cluster, host, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Pending))
+		})
+
+		It("fails without a large enough non-installation disk", func() {
+			host.Inventory = newInventory(
+				&models.Disk{ID: "/dev/sda", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GbToBytes(120)},
+				&models.Disk{ID: "/dev/sdb", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GbToBytes(10)},
+			)
+			result, err := operator.ValidateHost(ctx, cluster, host, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf("Partner Storage requires at least 1 non-installation HDD/SSD disk(s) of 20GB minimum on the host"))
+		})
+
+		It("succeeds with a large enough non-installation disk", func() {
+			host.Inventory = newInventory(
+				&models.Disk{ID: "/dev/sda", DriveType: models.DriveTypeSSD, SizeBytes: conversions.GbToBytes(120)},
+				&models.Disk{ID: "/dev/sdb", DriveType: models.DriveTypeHDD, SizeBytes: conversions.GbToBytes(30)},
+			)
+			result, err := operator.ValidateHost(ctx, cluster, host, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("skips hosts with roles that are not validated", func() {
+			operator.definition.Validations.Host.Roles = []models.HostRole{models.HostRoleMaster}
+			result, err := operator.ValidateHost(ctx, cluster, host, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+	})
+
+	Context("GenerateManifests", func() {
+		It("renders the manifest templates", func() {
+			openshiftManifests, controllerManifest, err := operator.GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(2))
+			Expect(string(openshiftManifests["50_partner-storage_ns.yaml"])).To(ContainSubstring(`name: "partner-storage"`))
+			Expect(string(openshiftManifests["50_partner-storage_subscription.yaml"])).To(ContainSubstring(`name: "partner-storage-operator"`))
+			Expect(string(controllerManifest)).To(ContainSubstring(`name: "partner-cluster"`))
+			for _, manifest := range openshiftManifests {
+				_, err = yaml.YAMLToJSON(manifest)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("fails on references to unknown fields", func() {
+			definition.Manifests[0].Content = "name: {{.Cluster.PullSecret}}"
+			operator, err := NewOperator(common.GetTestLog(), definition)
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = operator.GenerateManifests(cluster)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("provides the requirements per role", func() {
+		requirements, err := operator.GetHostRequirements(ctx, cluster, &models.Host{Role: models.HostRoleMaster})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 2048}))
+
+		requirements, err = operator.GetHostRequirements(ctx, cluster, &models.Host{Role: models.HostRoleWorker})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 1024}))
+
+		preflight, err := operator.GetPreflightRequirements(ctx, cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(preflight.OperatorName).To(Equal("partner-storage"))
+		Expect(preflight.Dependencies).To(ConsistOf("lso"))
+		Expect(preflight.Requirements.Master.Quantitative).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 2048}))
+	})
+})
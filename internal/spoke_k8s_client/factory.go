@@ -22,6 +22,7 @@ type SpokeK8sClientFactory interface {
 	CreateFromRawKubeconfig(kubeconfig []byte) (SpokeK8sClient, error)
 	CreateFromStorageKubeconfig(ctx context.Context, clusterId *strfmt.UUID, objectHandler s3wrapper.API) (SpokeK8sClient, error)
 	ClientAndSetFromSecret(secret *corev1.Secret) (SpokeK8sClient, *kubernetes.Clientset, error)
+	ClientAndSetFromStorageKubeconfig(ctx context.Context, clusterId *strfmt.UUID, objectHandler s3wrapper.API) (SpokeK8sClient, *kubernetes.Clientset, error)
 }
 
 type spokeK8sClientFactory struct {
@@ -49,6 +50,22 @@ func (cf *spokeK8sClientFactory) CreateFromSecret(secret *corev1.Secret) (SpokeK
 }
 
 func (cf *spokeK8sClientFactory) CreateFromStorageKubeconfig(ctx context.Context, clusterId *strfmt.UUID, objectHandler s3wrapper.API) (SpokeK8sClient, error) {
+	kubeconfig, err := kubeconfigFromStorage(ctx, clusterId, objectHandler)
+	if err != nil {
+		return nil, err
+	}
+	return cf.CreateFromRawKubeconfig(kubeconfig)
+}
+
+func (cf *spokeK8sClientFactory) ClientAndSetFromStorageKubeconfig(ctx context.Context, clusterId *strfmt.UUID, objectHandler s3wrapper.API) (SpokeK8sClient, *kubernetes.Clientset, error) {
+	kubeconfig, err := kubeconfigFromStorage(ctx, clusterId, objectHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cf.clientAndSetForKubeconfig(kubeconfig)
+}
+
+func kubeconfigFromStorage(ctx context.Context, clusterId *strfmt.UUID, objectHandler s3wrapper.API) ([]byte, error) {
 	kubeConfigReader, contentLength, err := objectHandler.Download(ctx, fmt.Sprintf("%s/%s", clusterId, constants.Kubeconfig))
 	if err != nil {
 		return nil, fmt.Errorf("could not load kubeconfig from internal storage with cluster id %s and filename %s: %w", clusterId, constants.Kubeconfig, err)
@@ -62,7 +79,7 @@ func (cf *spokeK8sClientFactory) CreateFromStorageKubeconfig(ctx context.Context
 	if bytesRead > int(contentLength) {
 		return nil, fmt.Errorf("too many bytes read when reading spoke cluster kubeconfig from internal storage with cluster id %s and filename %s", clusterId, constants.Kubeconfig)
 	}
-	return cf.CreateFromRawKubeconfig(kubeconfig)
+	return kubeconfig, nil
 }
 
 func (cf *spokeK8sClientFactory) ClientAndSetFromSecret(secret *corev1.Secret) (SpokeK8sClient, *kubernetes.Clientset, error) {
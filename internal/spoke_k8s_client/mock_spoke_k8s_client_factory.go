@@ -54,6 +54,22 @@ func (mr *MockSpokeK8sClientFactoryMockRecorder) ClientAndSetFromSecret(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientAndSetFromSecret", reflect.TypeOf((*MockSpokeK8sClientFactory)(nil).ClientAndSetFromSecret), arg0)
 }
 
+// ClientAndSetFromStorageKubeconfig mocks base method.
+func (m *MockSpokeK8sClientFactory) ClientAndSetFromStorageKubeconfig(arg0 context.Context, arg1 *strfmt.UUID, arg2 s3wrapper.API) (SpokeK8sClient, *kubernetes.Clientset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientAndSetFromStorageKubeconfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(SpokeK8sClient)
+	ret1, _ := ret[1].(*kubernetes.Clientset)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ClientAndSetFromStorageKubeconfig indicates an expected call of ClientAndSetFromStorageKubeconfig.
+func (mr *MockSpokeK8sClientFactoryMockRecorder) ClientAndSetFromStorageKubeconfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientAndSetFromStorageKubeconfig", reflect.TypeOf((*MockSpokeK8sClientFactory)(nil).ClientAndSetFromStorageKubeconfig), arg0, arg1, arg2)
+}
+
 // CreateFromRawKubeconfig mocks base method.
 func (m *MockSpokeK8sClientFactory) CreateFromRawKubeconfig(arg0 []byte) (SpokeK8sClient, error) {
 	m.ctrl.T.Helper()
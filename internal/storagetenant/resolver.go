@@ -0,0 +1,48 @@
+package storagetenant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// NewResolver returns the resolver of the organizations owning the objects of the storage. The objects are stored
+// under the ID of their cluster or of their infra-env, possibly after a prefix such as the one of the dry run objects.
+// The objects of the deleted clusters keep their organization until they are deleted as well.
+func NewResolver(db *gorm.DB) s3wrapper.TenantResolver {
+	return func(ctx context.Context, objectName string) (string, error) {
+		id := ownerID(objectName)
+		if id == "" {
+			return "", nil
+		}
+		var orgIDs []string
+		if err := db.WithContext(ctx).Unscoped().Model(&common.Cluster{}).Where("id = ?", id).Pluck("org_id", &orgIDs).Error; err != nil {
+			return "", errors.Wrapf(err, "failed to get the cluster of object %s", objectName)
+		}
+		if len(orgIDs) == 0 {
+			if err := db.WithContext(ctx).Model(&common.InfraEnv{}).Where("id = ?", id).Pluck("org_id", &orgIDs).Error; err != nil {
+				return "", errors.Wrapf(err, "failed to get the infra-env of object %s", objectName)
+			}
+		}
+		if len(orgIDs) == 0 {
+			return "", nil
+		}
+		return orgIDs[0], nil
+	}
+}
+
+// ownerID returns the ID of the cluster or of the infra-env in the first two segments of the name of an object
+func ownerID(objectName string) string {
+	segments := strings.SplitN(objectName, "/", 3)
+	for i := 0; i < len(segments)-1 && i < 2; i++ {
+		if strfmt.IsUUID(segments[i]) {
+			return segments[i]
+		}
+	}
+	return ""
+}
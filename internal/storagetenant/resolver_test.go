@@ -0,0 +1,59 @@
+package storagetenant
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("NewResolver", func() {
+	var (
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, OrgID: "org2"}}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the organization of the cluster or of the infra-env of the objects", func() {
+		resolver := NewResolver(db)
+		for objectName, orgID := range map[string]string{
+			clusterID.String() + "/kubeconfig":              "org1",
+			"dry-run/" + clusterID.String() + "/kubeconfig": "org1",
+			infraEnvID.String() + "/discovery.ign":          "org2",
+			uuid.New().String() + "/kubeconfig":             "",
+			"releases/4.14/openshift-install":               "",
+			clusterID.String():                              "",
+			"a/b/" + clusterID.String() + "/kubeconfig":     "",
+		} {
+			tenant, err := resolver(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tenant).To(Equal(orgID), objectName)
+		}
+	})
+
+	It("keeps the organization of the deleted clusters", func() {
+		Expect(db.Delete(&common.Cluster{}, "id = ?", clusterID.String()).Error).ToNot(HaveOccurred())
+		tenant, err := NewResolver(db)(ctx, clusterID.String()+"/logs")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tenant).To(Equal("org1"))
+	})
+})
@@ -3,6 +3,7 @@ package stream
 import (
 	"context"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/pkg/kafka"
 	"github.com/sirupsen/logrus"
 )
@@ -35,3 +36,28 @@ func NewWriter(logger *logrus.Logger, enableNotificationStreaming bool) (StreamW
 	logger.Info("Initializing event stream kafka writer")
 	return kafka.NewWriter()
 }
+
+// MultiWriter writes every notification to all of its writers
+type MultiWriter struct {
+	writers []StreamWriter
+}
+
+func NewMultiWriter(writers ...StreamWriter) *MultiWriter {
+	return &MultiWriter{writers: writers}
+}
+
+func (w *MultiWriter) Write(ctx context.Context, key []byte, payload interface{}) error {
+	var result error
+	for _, writer := range w.writers {
+		if err := writer.Write(ctx, key, payload); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
+
+func (w *MultiWriter) Close() {
+	for _, writer := range w.writers {
+		writer.Close()
+	}
+}
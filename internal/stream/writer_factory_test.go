@@ -0,0 +1,53 @@
+package stream_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/stream"
+)
+
+var _ = Describe("MultiWriter", func() {
+	var (
+		ctx              = context.Background()
+		ctrl             *gomock.Controller
+		writer1, writer2 *stream.MockStreamWriter
+		multiWriter      *stream.MultiWriter
+		envelope         *stream.Envelope
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		writer1 = stream.NewMockStreamWriter(ctrl)
+		writer2 = stream.NewMockStreamWriter(ctrl)
+		multiWriter = stream.NewMultiWriter(writer1, writer2)
+		envelope = &stream.Envelope{Name: "ClusterState"}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("writes to all the writers", func() {
+		writer1.EXPECT().Write(ctx, []byte("key"), envelope).Return(nil).Times(1)
+		writer2.EXPECT().Write(ctx, []byte("key"), envelope).Return(nil).Times(1)
+		Expect(multiWriter.Write(ctx, []byte("key"), envelope)).To(Succeed())
+	})
+
+	It("keeps writing when a writer fails", func() {
+		writer1.EXPECT().Write(ctx, []byte("key"), envelope).Return(errors.New("write failed")).Times(1)
+		writer2.EXPECT().Write(ctx, []byte("key"), envelope).Return(nil).Times(1)
+		err := multiWriter.Write(ctx, []byte("key"), envelope)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("write failed"))
+	})
+
+	It("closes all the writers", func() {
+		writer1.EXPECT().Close().Times(1)
+		writer2.EXPECT().Close().Times(1)
+		multiWriter.Close()
+	})
+})
@@ -0,0 +1,77 @@
+package timeline
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+)
+
+var csvHeader = []string{
+	"kind", "subject", "stage", "started_at", "ended_at", "duration_seconds", "timeout_seconds", "timeout_exceeded",
+	"critical_path",
+}
+
+// RenderCSV renders the timeline as CSV, with a row per stage of the hosts, finalizing stage and operator
+func RenderCSV(timeline *models.InstallationTimeline) ([]byte, error) {
+	critical := make(map[string]bool)
+	for _, segment := range timeline.CriticalPath {
+		critical[segmentKey(segment.Kind, segment.Subject, segment.StartedAt)] = true
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	row := func(kind, subject, stage string, startedAt, endedAt strfmt.DateTime, durationSeconds float64,
+		timeoutSeconds int64, timeoutExceeded bool) []string {
+		return []string{
+			kind,
+			subject,
+			stage,
+			formatTime(startedAt),
+			formatTime(endedAt),
+			strconv.FormatFloat(durationSeconds, 'f', 0, 64),
+			strconv.FormatInt(timeoutSeconds, 10),
+			strconv.FormatBool(timeoutExceeded),
+			strconv.FormatBool(critical[segmentKey(kind, subject, startedAt)]),
+		}
+	}
+
+	var rows [][]string
+	for _, host := range timeline.Hosts {
+		for _, stage := range host.Stages {
+			rows = append(rows, row(models.InstallationTimelineSegmentKindHost, host.Hostname, stage.Name,
+				stage.StartedAt, stage.EndedAt, stage.DurationSeconds, stage.TimeoutSeconds, stage.TimeoutExceeded))
+		}
+	}
+	for _, stage := range timeline.FinalizingStages {
+		rows = append(rows, row(models.InstallationTimelineSegmentKindFinalizing, "cluster", stage.Name,
+			stage.StartedAt, stage.EndedAt, stage.DurationSeconds, stage.TimeoutSeconds, stage.TimeoutExceeded))
+	}
+	for _, operator := range timeline.Operators {
+		rows = append(rows, row(models.InstallationTimelineSegmentKindOperator, operator.Name, string(operator.Status),
+			operator.StartedAt, operator.EndedAt, operator.DurationSeconds, operator.TimeoutSeconds, operator.TimeoutExceeded))
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// segmentKey identifies a stage of the timeline, matching it to the segments of the critical path
+func segmentKey(kind, subject string, startedAt strfmt.DateTime) string {
+	return fmt.Sprintf("%s/%s/%d", kind, subject, time.Time(startedAt).UnixNano())
+}
+
+func formatTime(t strfmt.DateTime) string {
+	if time.Time(t).IsZero() {
+		return ""
+	}
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,96 @@
+package timeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/installation_timeline"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.InstallationTimelineAPI = (*Handler)(nil)
+
+// NewHandler returns the installation timeline handler. The stages of the hosts are compared to the timeouts given by
+// hostStageTimeouts.
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, hostStageTimeouts HostStageTimeouts) *Handler {
+	return &Handler{
+		log:               log,
+		db:                db,
+		hostStageTimeouts: hostStageTimeouts,
+	}
+}
+
+// Handler reports the timeline of the installation of clusters
+type Handler struct {
+	log               logrus.FieldLogger
+	db                *gorm.DB
+	hostStageTimeouts HostStageTimeouts
+}
+
+func (h *Handler) V2GetInstallationTimeline(ctx context.Context, params operations.V2GetInstallationTimelineParams) middleware.Responder {
+	timeline, err := h.timeline(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetInstallationTimelineOK().WithPayload(timeline)
+}
+
+func (h *Handler) V2DownloadInstallationTimeline(ctx context.Context, params operations.V2DownloadInstallationTimelineParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	timeline, err := h.timeline(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	var content []byte
+	format := swag.StringValue(params.Format)
+	switch format {
+	case "csv":
+		content, err = RenderCSV(timeline)
+	default:
+		format = "json"
+		content, err = json.MarshalIndent(timeline, "", "  ")
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to render the installation timeline of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return filemiddleware.NewResponder(
+		operations.NewV2DownloadInstallationTimelineOK().WithPayload(io.NopCloser(bytes.NewReader(content))),
+		fmt.Sprintf("installation-timeline-%s.%s", params.ClusterID, format),
+		int64(len(content)),
+		nil,
+	)
+}
+
+func (h *Handler) timeline(ctx context.Context, clusterID strfmt.UUID) (*models.InstallationTimeline, error) {
+	log := logutil.FromContext(ctx, h.log)
+	c, err := common.GetClusterFromDB(h.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	var events []*common.Event
+	err = h.db.Where("cluster_id = ? AND name IN (?)", clusterID.String(), StageEventNames).
+		Order("event_time, id").Find(&events).Error
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the stage events of cluster %s", clusterID)
+		log.WithError(err).Error()
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return Build(c, events, h.hostStageTimeouts, time.Now(), log), nil
+}
@@ -0,0 +1,111 @@
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/installation_timeline"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Installation timeline handler", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		handler   *Handler
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		handler = NewHandler(common.GetTestLog(), db, testTimeouts{})
+
+		start := time.Now().Add(-time.Hour)
+		c := testCluster(start)
+		clusterID = *c.ID
+		hosts := c.Hosts
+		c.Hosts = nil
+		Expect(db.Create(c).Error).ToNot(HaveOccurred())
+		for _, host := range hosts {
+			host.ClusterID = &clusterID
+			host.InfraEnvID = clusterID
+			Expect(db.Create(&common.Host{Host: *host}).Error).ToNot(HaveOccurred())
+		}
+		for _, event := range []*common.Event{
+			hostStageEvent(hostID1, models.HostStageStartingInstallation, start.Add(time.Minute)),
+			hostStageEvent(hostID1, models.HostStageInstalling, start.Add(5*time.Minute)),
+			hostStageEvent(hostID2, models.HostStageStartingInstallation, start.Add(time.Minute)),
+		} {
+			event.ClusterID = &clusterID
+			Expect(db.Create(event).Error).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	download := func(format string) (http.Header, string) {
+		response := handler.V2DownloadInstallationTimeline(ctx, operations.V2DownloadInstallationTimelineParams{
+			ClusterID: clusterID,
+			Format:    swag.String(format),
+		})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		ExpectWithOffset(1, recorder.Code).To(Equal(http.StatusOK))
+		content, err := io.ReadAll(recorder.Body)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return recorder.Header(), string(content)
+	}
+
+	It("returns the timeline of the cluster", func() {
+		response := handler.V2GetInstallationTimeline(ctx, operations.V2GetInstallationTimelineParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2GetInstallationTimelineOK()))
+		timeline := response.(*operations.V2GetInstallationTimelineOK).Payload
+		Expect(timeline.Hosts).To(HaveLen(2))
+		Expect(timeline.Hosts[0].Stages).To(HaveLen(2))
+		Expect(timeline.Hosts[0].Stages[1].EndedAt).To(BeZero())
+		Expect(timeline.Hosts[1].Stages).To(HaveLen(1))
+	})
+
+	It("fails for a missing cluster", func() {
+		response := handler.V2GetInstallationTimeline(ctx, operations.V2GetInstallationTimelineParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("downloads the JSON timeline", func() {
+		header, content := download("json")
+		Expect(header.Get("Content-Disposition")).To(ContainSubstring("installation-timeline-" + clusterID.String() + ".json"))
+		var timeline models.InstallationTimeline
+		Expect(json.Unmarshal([]byte(content), &timeline)).To(Succeed())
+		Expect(*timeline.ClusterID).To(Equal(clusterID))
+		Expect(timeline.Hosts).To(HaveLen(2))
+	})
+
+	It("downloads the CSV timeline", func() {
+		header, content := download("csv")
+		Expect(header.Get("Content-Disposition")).To(ContainSubstring("installation-timeline-" + clusterID.String() + ".csv"))
+		Expect(strings.Split(strings.TrimSpace(content), "\n")).To(HaveLen(4))
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,338 @@
+package timeline
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// StageEventNames are the names of the events the stages of the timeline are read from
+var StageEventNames = []string{
+	eventgen.HostInstallProgressUpdatedEventName,
+	eventgen.ClusterFinalizingStageUpdatedEventName,
+}
+
+// The properties of the stage events holding the names of the stages
+const (
+	hostStageProperty       = "stage"
+	finalizingStageProperty = "finalizing_stage"
+)
+
+// HostStageTimeouts returns the configured timeouts of the host stages
+type HostStageTimeouts interface {
+	HostStageTimeout(stage models.HostStage) time.Duration
+}
+
+// stageStart is the start of a stage, read from an event or from the progress of the host or the cluster
+type stageStart struct {
+	name string
+	at   time.Time
+}
+
+// Build builds the installation timeline of the cluster from the events of its stage updates, the progress of its
+// hosts and the statuses of its monitored operators. The events must be sorted by time.
+func Build(c *common.Cluster, events []*common.Event, timeouts HostStageTimeouts, now time.Time,
+	log logrus.FieldLogger) *models.InstallationTimeline {
+	startedAt := time.Time(c.InstallStartedAt)
+	completedAt := time.Time(c.InstallCompletedAt)
+	if !completedAt.IsZero() && completedAt.Before(startedAt) {
+		// The cluster was reset and installed again
+		completedAt = time.Time{}
+	}
+	end := now
+	if !completedAt.IsZero() {
+		end = completedAt
+	}
+
+	timeline := &models.InstallationTimeline{
+		ClusterID:        c.ID,
+		GeneratedAt:      strfmt.DateTime(now),
+		Hosts:            []*models.InstallationTimelineHost{},
+		FinalizingStages: []*models.InstallationTimelineStage{},
+		Operators:        []*models.InstallationTimelineOperator{},
+		CriticalPath:     []*models.InstallationTimelineSegment{},
+	}
+	if startedAt.IsZero() {
+		return timeline
+	}
+	timeline.InstallStartedAt = strfmt.DateTime(startedAt)
+	if !completedAt.IsZero() {
+		timeline.InstallCompletedAt = strfmt.DateTime(completedAt)
+	}
+	timeline.DurationSeconds = end.Sub(startedAt).Seconds()
+
+	hostStarts := make(map[strfmt.UUID][]stageStart)
+	var finalizingStarts []stageStart
+	for _, event := range events {
+		if event.EventTime == nil || time.Time(*event.EventTime).Before(startedAt) {
+			continue
+		}
+		at := time.Time(*event.EventTime)
+		switch event.Name {
+		case eventgen.HostInstallProgressUpdatedEventName:
+			if event.HostID == nil {
+				continue
+			}
+			if stage := eventProperty(event, hostStageProperty, log); stage != "" {
+				hostStarts[*event.HostID] = appendStart(hostStarts[*event.HostID], stage, at)
+			}
+		case eventgen.ClusterFinalizingStageUpdatedEventName:
+			if stage := eventProperty(event, finalizingStageProperty, log); stage != "" {
+				finalizingStarts = appendStart(finalizingStarts, stage, at)
+			}
+		}
+	}
+
+	policies, err := common.UnmarshalHostStageTimeoutPolicies(c.HostStageTimeoutPolicies)
+	if err != nil {
+		log.WithError(err).Warnf("failed to unmarshal the host stage timeout policies of cluster %s, using the default timeouts",
+			c.ID.String())
+	}
+	hostStageTimeout := func(name string) time.Duration {
+		stage := models.HostStage(name)
+		if policy := hostutil.GetHostStageTimeoutPolicy(policies, stage); policy != nil {
+			return hostutil.GetHostStageTimeout(policy, timeouts.HostStageTimeout(stage))
+		}
+		return timeouts.HostStageTimeout(stage)
+	}
+
+	hosts := make([]*models.Host, len(c.Hosts))
+	copy(hosts, c.Hosts)
+	sort.Slice(hosts, func(i, j int) bool {
+		return hostutil.GetHostnameForMsg(hosts[i]) < hostutil.GetHostnameForMsg(hosts[j])
+	})
+	for _, host := range hosts {
+		starts := hostStarts[*host.ID]
+		if host.Progress != nil && host.Progress.CurrentStage != "" {
+			starts = appendStart(starts, string(host.Progress.CurrentStage), time.Time(host.Progress.StageStartedAt))
+		}
+		timeline.Hosts = append(timeline.Hosts, &models.InstallationTimelineHost{
+			HostID:    *host.ID,
+			Hostname:  hostutil.GetHostnameForMsg(host),
+			Role:      common.GetEffectiveRole(host),
+			Bootstrap: host.Bootstrap,
+			Stages:    buildStages(starts, end, completedAt.IsZero(), hostStageTimeout, isTerminalHostStage),
+		})
+	}
+
+	if c.Progress != nil && c.Progress.FinalizingStage != "" {
+		finalizingStarts = appendStart(finalizingStarts, string(c.Progress.FinalizingStage),
+			time.Time(c.Progress.FinalizingStageStartedAt))
+	}
+	timeline.FinalizingStages = buildStages(finalizingStarts, end, completedAt.IsZero(), func(name string) time.Duration {
+		return cluster.FinalizingStageTimeout(models.FinalizingStage(name), c.MonitoredOperators, log)
+	}, func(string) bool { return false })
+
+	timeline.Operators = buildOperators(c.MonitoredOperators, timeline.FinalizingStages, end)
+	timeline.CriticalPath = buildCriticalPath(timeline)
+	return timeline
+}
+
+// eventProperty returns a string property of the event, empty when the event has no such property (e.g. the events
+// sent before the properties were stored)
+func eventProperty(event *common.Event, name string, log logrus.FieldLogger) string {
+	if event.Props == "" {
+		return ""
+	}
+	var props map[string]interface{}
+	if err := json.Unmarshal([]byte(event.Props), &props); err != nil {
+		log.WithError(err).Warnf("failed to unmarshal the properties of event %s", event.Name)
+		return ""
+	}
+	value, _ := props[name].(string)
+	return value
+}
+
+// appendStart appends the start of a stage, ignoring the updates of the progress within the current stage and the
+// starts missing a time
+func appendStart(starts []stageStart, name string, at time.Time) []stageStart {
+	if at.IsZero() || (len(starts) > 0 && starts[len(starts)-1].name == name) {
+		return starts
+	}
+	return append(starts, stageStart{name: name, at: at})
+}
+
+// isTerminalHostStage returns whether the host stage ends the installation of the host, which isn't a stage of the
+// timeline but the end of the previous stage
+func isTerminalHostStage(name string) bool {
+	return name == string(models.HostStageDone) || name == string(models.HostStageFailed)
+}
+
+// buildStages builds the stages from their starts. Each stage ends at the start of the next one, and the last stage
+// ends at the end of the installation, or is in progress.
+func buildStages(starts []stageStart, end time.Time, inProgress bool, timeout func(name string) time.Duration,
+	terminal func(name string) bool) []*models.InstallationTimelineStage {
+	stages := []*models.InstallationTimelineStage{}
+	for i, start := range starts {
+		if terminal(start.name) {
+			continue
+		}
+		stageEnd := end
+		last := i+1 == len(starts)
+		if !last {
+			stageEnd = starts[i+1].at
+		}
+		stage := &models.InstallationTimelineStage{
+			Name:      start.name,
+			StartedAt: strfmt.DateTime(start.at),
+		}
+		if !last || !inProgress {
+			stage.EndedAt = strfmt.DateTime(stageEnd)
+		}
+		setDuration(&stage.DurationSeconds, &stage.TimeoutSeconds, &stage.TimeoutExceeded, start.at, stageEnd,
+			timeout(start.name))
+		stages = append(stages, stage)
+	}
+	return stages
+}
+
+func setDuration(durationSeconds *float64, timeoutSeconds *int64, timeoutExceeded *bool, start, end time.Time,
+	timeout time.Duration) {
+	duration := end.Sub(start)
+	if duration < 0 {
+		duration = 0
+	}
+	*durationSeconds = duration.Seconds()
+	*timeoutSeconds = int64(timeout.Seconds())
+	*timeoutExceeded = timeout > 0 && duration > timeout
+}
+
+// buildOperators builds the waits for the monitored operators, from the start of the finalizing stage waiting for them
+// to the time they became available, or to the end of the installation
+func buildOperators(operators []*models.MonitoredOperator, finalizingStages []*models.InstallationTimelineStage,
+	installEnd time.Time) []*models.InstallationTimelineOperator {
+	result := []*models.InstallationTimelineOperator{}
+	stageStarts := make(map[string]time.Time)
+	for _, stage := range finalizingStages {
+		stageStarts[stage.Name] = time.Time(stage.StartedAt)
+	}
+	waitStart := func(operatorType models.OperatorType) time.Time {
+		candidates := []models.FinalizingStage{models.FinalizingStageWaitingForClusterOperators}
+		if operatorType == models.OperatorTypeOlm {
+			candidates = []models.FinalizingStage{
+				models.FinalizingStageWaitingForOlmOperatorsCsvInitialization,
+				models.FinalizingStageWaitingForOlmOperatorsCsv,
+			}
+		}
+		for _, stage := range candidates {
+			if at, ok := stageStarts[string(stage)]; ok {
+				return at
+			}
+		}
+		if len(finalizingStages) > 0 {
+			return time.Time(finalizingStages[0].StartedAt)
+		}
+		return time.Time{}
+	}
+
+	sorted := make([]*models.MonitoredOperator, len(operators))
+	copy(sorted, operators)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	for _, operator := range sorted {
+		start := waitStart(operator.OperatorType)
+		if start.IsZero() {
+			continue
+		}
+		end := installEnd
+		item := &models.InstallationTimelineOperator{
+			Name:         operator.Name,
+			OperatorType: operator.OperatorType,
+			Status:       operator.Status,
+			StartedAt:    strfmt.DateTime(start),
+		}
+		if operator.Status == models.OperatorStatusAvailable && !time.Time(operator.StatusUpdatedAt).IsZero() {
+			end = time.Time(operator.StatusUpdatedAt)
+			item.EndedAt = operator.StatusUpdatedAt
+		}
+		setDuration(&item.DurationSeconds, &item.TimeoutSeconds, &item.TimeoutExceeded, start, end,
+			time.Duration(operator.TimeoutSeconds)*time.Second)
+		result = append(result, item)
+	}
+	return result
+}
+
+// buildCriticalPath returns the stages which determined the duration of the installation: the stages of the host
+// which finished its installation last before the finalizing stages, the finalizing stages, and the operator which was
+// available last
+func buildCriticalPath(timeline *models.InstallationTimeline) []*models.InstallationTimelineSegment {
+	path := []*models.InstallationTimelineSegment{}
+	var finalizingStart time.Time
+	if len(timeline.FinalizingStages) > 0 {
+		finalizingStart = time.Time(timeline.FinalizingStages[0].StartedAt)
+	}
+	stageEnd := func(startedAt strfmt.DateTime, durationSeconds float64) time.Time {
+		return time.Time(startedAt).Add(time.Duration(durationSeconds * float64(time.Second)))
+	}
+
+	var lastHost *models.InstallationTimelineHost
+	var lastHostStages []*models.InstallationTimelineStage
+	var lastHostEnd time.Time
+	for _, host := range timeline.Hosts {
+		var stages []*models.InstallationTimelineStage
+		var hostEnd time.Time
+		for _, stage := range host.Stages {
+			if !finalizingStart.IsZero() && !time.Time(stage.StartedAt).Before(finalizingStart) {
+				continue
+			}
+			stages = append(stages, stage)
+			if end := stageEnd(stage.StartedAt, stage.DurationSeconds); end.After(hostEnd) {
+				hostEnd = end
+			}
+		}
+		if len(stages) > 0 && hostEnd.After(lastHostEnd) {
+			lastHost, lastHostStages, lastHostEnd = host, stages, hostEnd
+		}
+	}
+	for _, stage := range lastHostStages {
+		path = append(path, &models.InstallationTimelineSegment{
+			Kind:            models.InstallationTimelineSegmentKindHost,
+			Subject:         lastHost.Hostname,
+			Stage:           stage.Name,
+			StartedAt:       stage.StartedAt,
+			EndedAt:         stage.EndedAt,
+			DurationSeconds: stage.DurationSeconds,
+		})
+	}
+
+	for _, stage := range timeline.FinalizingStages {
+		path = append(path, &models.InstallationTimelineSegment{
+			Kind:            models.InstallationTimelineSegmentKindFinalizing,
+			Subject:         "cluster",
+			Stage:           stage.Name,
+			StartedAt:       stage.StartedAt,
+			EndedAt:         stage.EndedAt,
+			DurationSeconds: stage.DurationSeconds,
+		})
+	}
+
+	var lastOperator *models.InstallationTimelineOperator
+	for _, operator := range timeline.Operators {
+		if lastOperator == nil ||
+			stageEnd(operator.StartedAt, operator.DurationSeconds).After(stageEnd(lastOperator.StartedAt, lastOperator.DurationSeconds)) {
+			lastOperator = operator
+		}
+	}
+	if lastOperator != nil {
+		path = append(path, &models.InstallationTimelineSegment{
+			Kind:            models.InstallationTimelineSegmentKindOperator,
+			Subject:         lastOperator.Name,
+			Stage:           string(lastOperator.Status),
+			StartedAt:       lastOperator.StartedAt,
+			EndedAt:         lastOperator.EndedAt,
+			DurationSeconds: lastOperator.DurationSeconds,
+		})
+	}
+
+	sort.SliceStable(path, func(i, j int) bool {
+		return time.Time(path[i].StartedAt).Before(time.Time(path[j].StartedAt))
+	})
+	return path
+}
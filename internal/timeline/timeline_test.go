@@ -0,0 +1,242 @@
+package timeline
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+)
+
+// testTimeouts gives a timeout of 20 minutes to every host stage
+type testTimeouts struct{}
+
+func (testTimeouts) HostStageTimeout(models.HostStage) time.Duration {
+	return 20 * time.Minute
+}
+
+var (
+	hostID1 = strfmt.UUID("3f2d4a36-7d0c-4b8c-9d6a-5b4a1f0e6c01")
+	hostID2 = strfmt.UUID("3f2d4a36-7d0c-4b8c-9d6a-5b4a1f0e6c02")
+)
+
+func hostStageEvent(hostID strfmt.UUID, stage models.HostStage, at time.Time) *common.Event {
+	return &common.Event{Event: models.Event{
+		Name:      eventgen.HostInstallProgressUpdatedEventName,
+		HostID:    &hostID,
+		EventTime: (*strfmt.DateTime)(&at),
+		Message:   swag.String(fmt.Sprintf("Host: %s, reached installation stage %s: some info", hostID, stage)),
+		Props:     fmt.Sprintf(`{"stage":"%s"}`, stage),
+	}}
+}
+
+func finalizingStageEvent(stage models.FinalizingStage, at time.Time) *common.Event {
+	return &common.Event{Event: models.Event{
+		Name:      eventgen.ClusterFinalizingStageUpdatedEventName,
+		EventTime: (*strfmt.DateTime)(&at),
+		Message:   swag.String(fmt.Sprintf("Updated finalizing stage of the cluster to '%s'", stage)),
+		Props:     fmt.Sprintf(`{"finalizing_stage":"%s"}`, stage),
+	}}
+}
+
+func testCluster(start time.Time) *common.Cluster {
+	clusterID := strfmt.UUID(uuid.New().String())
+	return &common.Cluster{Cluster: models.Cluster{
+		ID:               &clusterID,
+		InstallStartedAt: strfmt.DateTime(start),
+		Hosts: []*models.Host{
+			{ID: &hostID1, RequestedHostname: "master-1", Role: models.HostRoleMaster, Bootstrap: true},
+			{ID: &hostID2, RequestedHostname: "master-2", Role: models.HostRoleMaster},
+		},
+		MonitoredOperators: []*models.MonitoredOperator{
+			{
+				Name:            "console",
+				OperatorType:    models.OperatorTypeBuiltin,
+				Status:          models.OperatorStatusAvailable,
+				StatusUpdatedAt: strfmt.DateTime(start.Add(75 * time.Minute)),
+				TimeoutSeconds:  3600,
+			},
+			{
+				Name:            "ingress",
+				OperatorType:    models.OperatorTypeBuiltin,
+				Status:          models.OperatorStatusAvailable,
+				StatusUpdatedAt: strfmt.DateTime(start.Add(70 * time.Minute)),
+				TimeoutSeconds:  3600,
+			},
+		},
+	}}
+}
+
+func minutes(d float64) float64 {
+	return (time.Duration(d) * time.Minute).Seconds()
+}
+
+var _ = Describe("Build", func() {
+	var (
+		start  time.Time
+		c      *common.Cluster
+		events []*common.Event
+	)
+
+	at := func(m int) time.Time {
+		return start.Add(time.Duration(m) * time.Minute)
+	}
+
+	BeforeEach(func() {
+		start = time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		c = testCluster(start)
+		events = []*common.Event{
+			hostStageEvent(hostID1, models.HostStageStartingInstallation, at(1)),
+			hostStageEvent(hostID2, models.HostStageStartingInstallation, at(1)),
+			hostStageEvent(hostID2, models.HostStageInstalling, at(3)),
+			hostStageEvent(hostID1, models.HostStageInstalling, at(5)),
+			hostStageEvent(hostID1, models.HostStageInstalling, at(6)),
+			hostStageEvent(hostID1, models.HostStageWritingImageToDisk, at(10)),
+			hostStageEvent(hostID1, models.HostStageRebooting, at(20)),
+			hostStageEvent(hostID2, models.HostStageDone, at(40)),
+			hostStageEvent(hostID1, models.HostStageDone, at(50)),
+			finalizingStageEvent(models.FinalizingStageWaitingForClusterOperators, at(55)),
+			finalizingStageEvent(models.FinalizingStageApplyingOlmManifests, at(80)),
+			finalizingStageEvent(models.FinalizingStageDone, at(85)),
+		}
+	})
+
+	Context("completed installation", func() {
+		BeforeEach(func() {
+			c.InstallCompletedAt = strfmt.DateTime(at(90))
+		})
+
+		It("builds the stages of the hosts", func() {
+			timeline := Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog())
+			Expect(timeline.DurationSeconds).To(Equal(minutes(90)))
+			Expect(timeline.Hosts).To(HaveLen(2))
+
+			host := timeline.Hosts[0]
+			Expect(host.Hostname).To(Equal("master-1"))
+			Expect(host.Bootstrap).To(BeTrue())
+			Expect(host.Stages).To(HaveLen(4))
+			Expect(host.Stages[1].Name).To(Equal(string(models.HostStageInstalling)))
+			Expect(time.Time(host.Stages[1].StartedAt)).To(BeTemporally("==", at(5)))
+			Expect(host.Stages[1].DurationSeconds).To(Equal(minutes(5)))
+			Expect(host.Stages[3].Name).To(Equal(string(models.HostStageRebooting)))
+			Expect(time.Time(host.Stages[3].EndedAt)).To(BeTemporally("==", at(50)))
+			Expect(host.Stages[3].DurationSeconds).To(Equal(minutes(30)))
+			Expect(host.Stages[3].TimeoutSeconds).To(Equal(int64(minutes(20))))
+			Expect(host.Stages[3].TimeoutExceeded).To(BeTrue())
+			Expect(host.Stages[2].TimeoutExceeded).To(BeFalse())
+
+			Expect(timeline.Hosts[1].Stages).To(HaveLen(2))
+			Expect(timeline.Hosts[1].Stages[1].DurationSeconds).To(Equal(minutes(37)))
+		})
+
+		It("builds the finalizing stages and the operators", func() {
+			timeline := Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog())
+			Expect(timeline.FinalizingStages).To(HaveLen(3))
+			Expect(timeline.FinalizingStages[0].DurationSeconds).To(Equal(minutes(25)))
+			Expect(timeline.FinalizingStages[2].Name).To(Equal(string(models.FinalizingStageDone)))
+			Expect(time.Time(timeline.FinalizingStages[2].EndedAt)).To(BeTemporally("==", at(90)))
+
+			Expect(timeline.Operators).To(HaveLen(2))
+			Expect(timeline.Operators[0].Name).To(Equal("console"))
+			Expect(time.Time(timeline.Operators[0].StartedAt)).To(BeTemporally("==", at(55)))
+			Expect(timeline.Operators[0].DurationSeconds).To(Equal(minutes(20)))
+			Expect(timeline.Operators[0].TimeoutExceeded).To(BeFalse())
+		})
+
+		It("builds the critical path", func() {
+			timeline := Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog())
+			Expect(timeline.CriticalPath).To(HaveLen(8))
+			for _, segment := range timeline.CriticalPath[:4] {
+				Expect(segment.Kind).To(Equal(models.InstallationTimelineSegmentKindHost))
+				Expect(segment.Subject).To(Equal("master-1"))
+			}
+			Expect(timeline.CriticalPath[4].Kind).To(Equal(models.InstallationTimelineSegmentKindFinalizing))
+			Expect(timeline.CriticalPath[5].Kind).To(Equal(models.InstallationTimelineSegmentKindOperator))
+			Expect(timeline.CriticalPath[5].Subject).To(Equal("console"))
+			Expect(timeline.CriticalPath[7].Stage).To(Equal(string(models.FinalizingStageDone)))
+		})
+
+		It("renders the timeline as CSV", func() {
+			content, err := RenderCSV(Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog()))
+			Expect(err).ToNot(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			Expect(lines).To(HaveLen(12))
+			Expect(lines[0]).To(Equal(strings.Join(csvHeader, ",")))
+			Expect(lines[4]).To(HavePrefix("host,master-1,Rebooting,"))
+			Expect(lines[4]).To(HaveSuffix(",1800,1200,true,true"))
+			Expect(lines[5]).To(HaveSuffix(",false,false"))
+			Expect(lines[11]).To(HavePrefix("operator,ingress,available,"))
+		})
+	})
+
+	It("reports the stages in progress", func() {
+		now := at(30)
+		timeline := Build(c, events[:7], testTimeouts{}, now, common.GetTestLog())
+		Expect(timeline.InstallCompletedAt).To(BeZero())
+		Expect(timeline.DurationSeconds).To(Equal(minutes(30)))
+		stages := timeline.Hosts[0].Stages
+		Expect(stages).To(HaveLen(4))
+		Expect(stages[3].EndedAt).To(BeZero())
+		Expect(stages[3].DurationSeconds).To(Equal(minutes(10)))
+		Expect(timeline.FinalizingStages).To(BeEmpty())
+		Expect(timeline.Operators).To(BeEmpty())
+	})
+
+	It("reads the current stage from the progress of the host", func() {
+		c.Hosts[0].Progress = &models.HostProgressInfo{
+			CurrentStage:   models.HostStageConfiguring,
+			StageStartedAt: strfmt.DateTime(at(25)),
+		}
+		timeline := Build(c, events[:7], testTimeouts{}, at(30), common.GetTestLog())
+		stages := timeline.Hosts[0].Stages
+		Expect(stages).To(HaveLen(5))
+		Expect(stages[4].Name).To(Equal(string(models.HostStageConfiguring)))
+		Expect(time.Time(stages[3].EndedAt)).To(BeTemporally("==", at(25)))
+	})
+
+	It("applies the host stage timeout policies of the cluster", func() {
+		policies, err := common.MarshalHostStageTimeoutPolicies([]*models.HostStageTimeoutPolicy{{
+			Stage:          models.NewHostStage(models.HostStageRebooting),
+			TimeoutSeconds: int64(minutes(45)),
+			Remediation:    models.NewHostStageRemediation(models.HostStageRemediationFail),
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		c.HostStageTimeoutPolicies = policies
+		c.InstallCompletedAt = strfmt.DateTime(at(90))
+		timeline := Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog())
+		stages := timeline.Hosts[0].Stages
+		Expect(stages[3].Name).To(Equal(string(models.HostStageRebooting)))
+		Expect(stages[3].TimeoutSeconds).To(Equal(int64(minutes(45))))
+		Expect(stages[3].TimeoutExceeded).To(BeFalse())
+		Expect(stages[2].TimeoutSeconds).To(Equal(int64(minutes(20))))
+	})
+
+	It("ignores the stage events without properties", func() {
+		for _, event := range events {
+			event.Props = ""
+		}
+		timeline := Build(c, events, testTimeouts{}, at(100), common.GetTestLog())
+		Expect(timeline.Hosts[0].Stages).To(BeEmpty())
+		Expect(timeline.FinalizingStages).To(BeEmpty())
+	})
+
+	It("ignores the events of a previous installation", func() {
+		previous := hostStageEvent(hostID1, models.HostStageFailed, at(-10))
+		timeline := Build(c, append([]*common.Event{previous}, events...), testTimeouts{}, at(100), common.GetTestLog())
+		Expect(timeline.Hosts[0].Stages[0].Name).To(Equal(string(models.HostStageStartingInstallation)))
+	})
+
+	It("returns an empty timeline before the installation", func() {
+		c.InstallStartedAt = strfmt.DateTime{}
+		timeline := Build(c, events, testTimeouts{}, time.Now(), common.GetTestLog())
+		Expect(timeline.Hosts).To(BeEmpty())
+		Expect(timeline.CriticalPath).To(BeEmpty())
+	})
+})
@@ -0,0 +1,44 @@
+package triage
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/triage"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.TriageAPI = (*Handler)(nil)
+
+func NewHandler(log logrus.FieldLogger, db *gorm.DB) *Handler {
+	return &Handler{
+		log: log,
+		db:  db,
+	}
+}
+
+// Handler lists the findings of the triage of the logs of clusters
+type Handler struct {
+	log logrus.FieldLogger
+	db  *gorm.DB
+}
+
+func (h *Handler) V2ListClusterTriageFindings(ctx context.Context, params operations.V2ListClusterTriageFindingsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if _, err := common.GetClusterFromDB(h.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	findings := []*models.TriageFinding{}
+	// The critical findings are listed first
+	if err := h.db.Where("cluster_id = ?", params.ClusterID.String()).Order("severity, found_at, id").Find(&findings).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the triage findings of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2ListClusterTriageFindingsOK().WithPayload(findings)
+}
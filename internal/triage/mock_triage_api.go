@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/triage (interfaces: API)
+
+// Package triage is a generated GoMock package.
+package triage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logsearch "github.com/openshift/assisted-service/internal/logsearch"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeLogs mocks base method.
+func (m *MockAPI) AnalyzeLogs(arg0 context.Context, arg1 logsearch.Source, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AnalyzeLogs", arg0, arg1, arg2)
+}
+
+// AnalyzeLogs indicates an expected call of AnalyzeLogs.
+func (mr *MockAPIMockRecorder) AnalyzeLogs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeLogs", reflect.TypeOf((*MockAPI)(nil).AnalyzeLogs), arg0, arg1, arg2)
+}
@@ -0,0 +1,187 @@
+package triage
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed signatures.yaml
+var builtinSignatures []byte
+
+// logrusField matches a key=value pair of a line of the logrus text format, the value being quoted when it contains
+// spaces
+var logrusField = regexp.MustCompile(`(\w+)=("(?:[^"\\]|\\.)*"|\S+)`)
+
+type signatureLibrary struct {
+	Signatures []*Signature `json:"signatures"`
+}
+
+// Signature describes the lines of the logs revealing a known failure
+type Signature struct {
+	ID          string            `json:"id"`
+	Title       string            `json:"title"`
+	Severity    string            `json:"severity"`
+	Remediation string            `json:"remediation,omitempty"`
+	LogsTypes   []models.LogsType `json:"logs_types,omitempty"`
+	// A regular expression matching the path of the file in the uploaded logs
+	Files string `json:"files,omitempty"`
+	// A regular expression matching the text of the line
+	Match string `json:"match,omitempty"`
+	// Regular expressions matching the fields of the structured lines
+	Fields map[string]string `json:"fields,omitempty"`
+	// The number of matching lines needed to find the failure
+	MinCount int `json:"min_count,omitempty"`
+
+	files  *regexp.Regexp
+	match  *regexp.Regexp
+	fields map[string]*regexp.Regexp
+}
+
+// loadSignatures returns the builtin signatures, with the signatures of the file, if any, added to them or replacing
+// the builtin ones with the same ID
+func loadSignatures(file string) ([]*Signature, error) {
+	signatures, err := parseSignatures(builtinSignatures)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the builtin signatures")
+	}
+	if file == "" {
+		return signatures, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the signatures file %s", file)
+	}
+	custom, err := parseSignatures(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load the signatures of %s", file)
+	}
+	byID := make(map[string]int, len(signatures))
+	for i, signature := range signatures {
+		byID[signature.ID] = i
+	}
+	for _, signature := range custom {
+		if i, ok := byID[signature.ID]; ok {
+			signatures[i] = signature
+		} else {
+			signatures = append(signatures, signature)
+		}
+	}
+	return signatures, nil
+}
+
+func parseSignatures(data []byte) ([]*Signature, error) {
+	var library signatureLibrary
+	if err := yaml.UnmarshalStrict(data, &library); err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool)
+	for _, signature := range library.Signatures {
+		if err := signature.compile(); err != nil {
+			return nil, err
+		}
+		if ids[signature.ID] {
+			return nil, errors.Errorf("signature %s is defined more than once", signature.ID)
+		}
+		ids[signature.ID] = true
+	}
+	return library.Signatures, nil
+}
+
+func (s *Signature) compile() error {
+	if s.ID == "" || s.Title == "" {
+		return errors.New("signatures must have an id and a title")
+	}
+	if s.Severity != models.TriageFindingSeverityWarning && s.Severity != models.TriageFindingSeverityCritical {
+		return errors.Errorf("signature %s has an invalid severity %q, expected %s or %s", s.ID, s.Severity,
+			models.TriageFindingSeverityWarning, models.TriageFindingSeverityCritical)
+	}
+	if s.Match == "" && len(s.Fields) == 0 {
+		return errors.Errorf("signature %s must have a match or fields", s.ID)
+	}
+	if s.MinCount < 1 {
+		s.MinCount = 1
+	}
+
+	var err error
+	if s.files, err = compile(s.ID, "files", s.Files); err != nil {
+		return err
+	}
+	if s.match, err = compile(s.ID, "match", s.Match); err != nil {
+		return err
+	}
+	s.fields = make(map[string]*regexp.Regexp, len(s.Fields))
+	for key, value := range s.Fields {
+		if s.fields[key], err = compile(s.ID, "field "+key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compile(id, name, expression string) (*regexp.Regexp, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, errors.Wrapf(err, "signature %s has an invalid %s", id, name)
+	}
+	return re, nil
+}
+
+// appliesTo tells whether the lines of the file of the logs can match the signature
+func (s *Signature) appliesTo(logsType models.LogsType, file string) bool {
+	if len(s.LogsTypes) > 0 && !funk.Contains(s.LogsTypes, logsType) {
+		return false
+	}
+	return s.files == nil || s.files.MatchString(file)
+}
+
+func (s *Signature) matches(line *logsearch.Line, fields func() map[string]string) bool {
+	if s.match != nil && !s.match.MatchString(line.Text) {
+		return false
+	}
+	if len(s.fields) == 0 {
+		return true
+	}
+	values := fields()
+	for key, re := range s.fields {
+		value, ok := values[key]
+		if !ok || !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseFields returns the fields of a line of the logrus text format or of a JSON object
+func parseFields(text string) map[string]string {
+	fields := make(map[string]string)
+	if len(text) > 0 && text[0] == '{' {
+		var object map[string]interface{}
+		if json.Unmarshal([]byte(text), &object) == nil {
+			for key, value := range object {
+				fields[key] = fmt.Sprint(value)
+			}
+		}
+		return fields
+	}
+	for _, m := range logrusField.FindAllStringSubmatch(text, -1) {
+		value := m[2]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		fields[m[1]] = value
+	}
+	return fields
+}
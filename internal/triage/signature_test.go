@@ -0,0 +1,147 @@
+package triage
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Signatures", func() {
+	var signatures []*Signature
+
+	BeforeEach(func() {
+		var err error
+		signatures, err = loadSignatures("")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	find := func(id string) *Signature {
+		for _, signature := range signatures {
+			if signature.ID == id {
+				return signature
+			}
+		}
+		Fail("signature " + id + " not found")
+		return nil
+	}
+
+	DescribeTable("builtin signatures match the known failures",
+		func(id string, logsType models.LogsType, file, text string) {
+			signature := find(id)
+			line := &logsearch.Line{File: file, Number: 1, Text: text}
+			Expect(signature.appliesTo(logsType, file)).To(BeTrue())
+			Expect(signature.matches(line, func() map[string]string { return parseFields(text) })).To(BeTrue())
+		},
+		Entry("bootkube timeout", "bootkube-timeout", models.LogsTypeHost, "logs_host_1/log-bundle/bootstrap/journals/bootkube.log",
+			"May 04 10:15:00 bootstrap bootkube.sh[2342]: Error: error while checking pod status: timed out waiting for the condition"),
+		Entry("etcd quorum loss", "etcd-quorum-loss", models.LogsTypeController, "must-gather/etcd.log",
+			`{"level":"warn","msg":"etcdserver: request timed out"}`),
+		Entry("image pull auth failure", "image-pull-auth-failure", models.LogsTypeHost, "journal.logs",
+			`May 04 10:15:00 master-0 crio[1234]: Error pulling image: reading manifest sha256:1234 in quay.io/repo: unauthorized: access to the requested resource is not authorized`),
+		Entry("ignition fetch failure", "ignition-fetch-failure", models.LogsTypeHost, "journal.logs",
+			"May 04 10:15:00 master-0 ignition[812]: GET https://api-int.test.example.com:22623/config/master: attempt #12 failed"),
+		Entry("installation disk write failure", "installation-disk-write-failure", models.LogsTypeHost, "installer.logs",
+			`time="2023-05-04T10:15:00Z" level=error msg="Failed to write image to disk" func=main.main`),
+		Entry("unsynchronized clock", "ntp-unsynchronized", models.LogsTypeHost, "journal.logs",
+			"May 04 10:15:00 master-0 chronyd[900]: System clock wrong by 3600.5 seconds"),
+	)
+
+	It("doesn't match the lines of other logs types", func() {
+		Expect(find("bootkube-timeout").appliesTo(models.LogsTypeController, "bootkube.log")).To(BeFalse())
+	})
+
+	It("requires all the fields to match", func() {
+		signature := find("installation-disk-write-failure")
+		text := `time="2023-05-04T10:15:00Z" level=info msg="Failed to write image to disk"`
+		Expect(signature.matches(&logsearch.Line{Text: text}, func() map[string]string { return parseFields(text) })).To(BeFalse())
+	})
+
+	Context("signatures file", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "signatures")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		write := func(content string) string {
+			file := filepath.Join(dir, "signatures.yaml")
+			Expect(os.WriteFile(file, []byte(content), 0600)).To(Succeed())
+			return file
+		}
+
+		It("adds signatures and replaces the builtin ones", func() {
+			custom, err := loadSignatures(write(`
+signatures:
+- id: bootkube-timeout
+  title: Replaced
+  severity: warning
+  match: replaced
+- id: custom
+  title: Custom
+  severity: critical
+  files: 'agent\.logs$'
+  match: custom failure
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(custom).To(HaveLen(len(signatures) + 1))
+			Expect(custom[0].ID).To(Equal("bootkube-timeout"))
+			Expect(custom[0].Title).To(Equal("Replaced"))
+			Expect(custom[len(custom)-1].ID).To(Equal("custom"))
+			Expect(custom[len(custom)-1].MinCount).To(Equal(1))
+			Expect(custom[len(custom)-1].appliesTo(models.LogsTypeHost, "logs/agent.logs")).To(BeTrue())
+			Expect(custom[len(custom)-1].appliesTo(models.LogsTypeHost, "logs/installer.logs")).To(BeFalse())
+		})
+
+		DescribeTable("rejects invalid signatures",
+			func(content string) {
+				_, err := loadSignatures(write(content))
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing id", "signatures:\n- title: t\n  severity: warning\n  match: m\n"),
+			Entry("invalid severity", "signatures:\n- id: i\n  title: t\n  severity: fatal\n  match: m\n"),
+			Entry("nothing to match", "signatures:\n- id: i\n  title: t\n  severity: warning\n"),
+			Entry("invalid regular expression", "signatures:\n- id: i\n  title: t\n  severity: warning\n  match: '('\n"),
+			Entry("duplicate id", "signatures:\n- id: i\n  title: t\n  severity: warning\n  match: m\n- id: i\n  title: t\n  severity: warning\n  match: m\n"),
+			Entry("unknown property", "signatures:\n- id: i\n  title: t\n  severity: warning\n  regex: m\n"),
+		)
+
+		It("fails when the file doesn't exist", func() {
+			_, err := loadSignatures(filepath.Join(dir, "missing.yaml"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("parseFields", func() {
+	It("parses the logrus text format", func() {
+		Expect(parseFields(`time="2023-05-04T10:15:00Z" level=error msg="failed to \"write\"" count=3`)).To(Equal(map[string]string{
+			"time":  "2023-05-04T10:15:00Z",
+			"level": "error",
+			"msg":   `failed to "write"`,
+			"count": "3",
+		}))
+	})
+
+	It("parses JSON objects", func() {
+		Expect(parseFields(`{"level":"warn","msg":"slow","took":1.5}`)).To(Equal(map[string]string{
+			"level": "warn",
+			"msg":   "slow",
+			"took":  "1.5",
+		}))
+	})
+
+	It("returns no fields for invalid JSON", func() {
+		Expect(parseFields(`{"level":`)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,225 @@
+package triage
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/models"
+	ctxparams "github.com/openshift/assisted-service/pkg/context"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"LOG_TRIAGE_ENABLED" default:"true"`
+	// A YAML file of signatures added to the builtin ones, see signatures.yaml
+	SignaturesFile string `envconfig:"LOG_TRIAGE_SIGNATURES_FILE" default:""`
+	Workers        int    `envconfig:"LOG_TRIAGE_WORKERS" default:"2"`
+	MaxLineLength  int    `envconfig:"LOG_TRIAGE_MAX_LINE_LENGTH" default:"2048"`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=triage -destination=mock_triage_api.go . API
+type API interface {
+	// AnalyzeLogs searches in the background the known failures in the logs tarball uploaded for the source to
+	// objectName. The findings replace the findings of the previous upload of the source.
+	AnalyzeLogs(ctx context.Context, source logsearch.Source, objectName string)
+}
+
+type Manager struct {
+	Config
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	eventsHandler eventsapi.Handler
+	objectHandler s3wrapper.API
+	signatures    []*Signature
+	workers       chan struct{}
+}
+
+var _ API = &Manager{}
+
+func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, objectHandler s3wrapper.API) (*Manager, error) {
+	signatures, err := loadSignatures(cfg.SignaturesFile)
+	if err != nil {
+		return nil, err
+	}
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	return &Manager{
+		Config:        cfg,
+		log:           log,
+		db:            db,
+		eventsHandler: eventsHandler,
+		objectHandler: objectHandler,
+		signatures:    signatures,
+		workers:       make(chan struct{}, workers),
+	}, nil
+}
+
+func (m *Manager) AnalyzeLogs(ctx context.Context, source logsearch.Source, objectName string) {
+	if !m.Enabled {
+		return
+	}
+	asyncCtx := ctxparams.Copy(ctx)
+	go func() {
+		m.workers <- struct{}{}
+		defer func() { <-m.workers }()
+
+		log := logutil.FromContext(asyncCtx, m.log).WithField("object", objectName)
+		findings, err := m.analyze(asyncCtx, source, objectName)
+		if err == nil {
+			err = m.store(asyncCtx, source, findings)
+		}
+		if err != nil {
+			log.WithError(err).Warnf("failed to triage the %s logs of cluster %s", source.LogsType, source.ClusterID)
+			return
+		}
+		log.Infof("found %d known failures in the %s logs of cluster %s", len(findings), source.LogsType, source.ClusterID)
+	}()
+}
+
+// analyze returns the findings of the signatures matched by enough lines of the uploaded logs
+func (m *Manager) analyze(ctx context.Context, source logsearch.Source, objectName string) ([]*models.TriageFinding, error) {
+	reader, _, err := m.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer reader.Close()
+
+	var (
+		file       string
+		applicable []*Signature
+		findings   = make(map[string]*models.TriageFinding)
+	)
+	err = logsearch.WalkLines(reader, m.MaxLineLength, time.Now(), func(line *logsearch.Line) error {
+		if line.File != file || applicable == nil {
+			file = line.File
+			applicable = make([]*Signature, 0, len(m.signatures))
+			for _, signature := range m.signatures {
+				if signature.appliesTo(source.LogsType, file) {
+					applicable = append(applicable, signature)
+				}
+			}
+		}
+		var fields map[string]string
+		lineFields := func() map[string]string {
+			if fields == nil {
+				fields = parseFields(line.Text)
+			}
+			return fields
+		}
+		for _, signature := range applicable {
+			if !signature.matches(line, lineFields) {
+				continue
+			}
+			if finding, ok := findings[signature.ID]; ok {
+				finding.MatchCount++
+				continue
+			}
+			findings[signature.ID] = newFinding(source, signature, line)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*models.TriageFinding, 0, len(findings))
+	for _, signature := range m.signatures {
+		if finding, ok := findings[signature.ID]; ok && finding.MatchCount >= int64(signature.MinCount) {
+			found = append(found, finding)
+		}
+	}
+	return found, nil
+}
+
+func newFinding(source logsearch.Source, signature *Signature, line *logsearch.Line) *models.TriageFinding {
+	id := strfmt.UUID(uuid.New().String())
+	finding := &models.TriageFinding{
+		ID:          id,
+		ClusterID:   source.ClusterID,
+		HostID:      source.HostID,
+		LogsType:    source.LogsType,
+		SignatureID: signature.ID,
+		Title:       signature.Title,
+		Severity:    signature.Severity,
+		Remediation: signature.Remediation,
+		File:        line.File,
+		Line:        line.Number,
+		Excerpt:     line.Text,
+		MatchCount:  1,
+		FoundAt:     time.Now(),
+	}
+	if line.Timestamp != nil {
+		timestamp := strfmt.DateTime(*line.Timestamp)
+		finding.Timestamp = &timestamp
+	}
+	return finding
+}
+
+// store replaces the findings of the previous upload of the source, and sends an event for each new finding
+func (m *Manager) store(ctx context.Context, source logsearch.Source, findings []*models.TriageFinding) error {
+	var previous []*models.TriageFinding
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sourceFindings := func() *gorm.DB {
+			q := tx.Where("cluster_id = ? AND logs_type = ?", source.ClusterID.String(), string(source.LogsType))
+			if source.HostID != nil {
+				return q.Where("host_id = ?", source.HostID.String())
+			}
+			return q.Where("host_id IS NULL")
+		}
+		if err := sourceFindings().Find(&previous).Error; err != nil {
+			return err
+		}
+		if err := sourceFindings().Delete(&models.TriageFinding{}).Error; err != nil {
+			return err
+		}
+		if len(findings) == 0 {
+			return nil
+		}
+		return tx.Create(&findings).Error
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to store the triage findings of cluster %s", source.ClusterID)
+	}
+
+	known := make(map[string]bool, len(previous))
+	for _, finding := range previous {
+		known[finding.SignatureID] = true
+	}
+	var sourceName string
+	for _, finding := range findings {
+		if known[finding.SignatureID] {
+			continue
+		}
+		if sourceName == "" {
+			sourceName = m.sourceName(source)
+		}
+		eventgen.SendClusterLogsTriageFindingEvent(ctx, m.eventsHandler, source.ClusterID, sourceName, finding.Title,
+			finding.File, finding.Line)
+	}
+	return nil
+}
+
+// sourceName returns the name of the host which uploaded the logs, or the logs type for the logs of the controller
+func (m *Manager) sourceName(source logsearch.Source) string {
+	if source.HostID == nil {
+		return string(source.LogsType)
+	}
+	host, err := common.GetClusterHostFromDB(m.db, source.ClusterID.String(), source.HostID.String())
+	if err != nil {
+		return source.HostID.String()
+	}
+	return hostutil.GetHostnameForMsg(&host.Host)
+}
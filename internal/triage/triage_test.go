@@ -0,0 +1,222 @@
+package triage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	operations "github.com/openshift/assisted-service/restapi/operations/triage"
+	"gorm.io/gorm"
+)
+
+func logsTarball(files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("Triage", func() {
+	var (
+		ctx           = context.Background()
+		ctrl          *gomock.Controller
+		baseDir       string
+		objectHandler s3wrapper.API
+		mockEvents    *eventsapi.MockHandler
+		clusterID     strfmt.UUID
+		hostID        strfmt.UUID
+		hostSource    logsearch.Source
+	)
+
+	newManager := func(db *gorm.DB) *Manager {
+		m, err := NewManager(Config{Enabled: true, Workers: 1, MaxLineLength: 2048}, common.GetTestLog(), db, mockEvents, objectHandler)
+		Expect(err).ToNot(HaveOccurred())
+		return m
+	}
+
+	upload := func(objectName string, files map[string]string) {
+		Expect(objectHandler.Upload(ctx, logsTarball(files), objectName)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		var err error
+		baseDir, err = os.MkdirTemp("", "triage")
+		Expect(err).ToNot(HaveOccurred())
+		mockMetrics := metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		objectHandler = s3wrapper.NewFSClient(baseDir, common.GetTestLog(), mockMetrics, 80)
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		hostSource = logsearch.Source{ClusterID: clusterID, HostID: &hostID, LogsType: models.LogsTypeHost}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		os.RemoveAll(baseDir)
+	})
+
+	It("fails when the signatures file is invalid", func() {
+		_, err := NewManager(Config{SignaturesFile: "/missing/signatures.yaml"}, common.GetTestLog(), nil, mockEvents, objectHandler)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("analyze", func() {
+		It("finds the signatures matched by the lines of the logs", func() {
+			upload("host.tar.gz", map[string]string{
+				"journal.logs": strings.Join([]string{
+					"2023-05-04T10:00:00Z master-0 ignition[812]: GET https://api-int:22623/config/master: attempt #1 failed",
+					"2023-05-04T10:00:05Z master-0 ignition[812]: GET https://api-int:22623/config/master: attempt #2 failed",
+					"2023-05-04T10:00:10Z master-0 systemd[1]: Started Journal Service.",
+				}, "\n"),
+			})
+			findings, err := newManager(nil).analyze(ctx, hostSource, "host.tar.gz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(findings).To(HaveLen(1))
+			finding := findings[0]
+			Expect(finding.ID).ToNot(BeEmpty())
+			Expect(finding.ClusterID).To(Equal(clusterID))
+			Expect(*finding.HostID).To(Equal(hostID))
+			Expect(finding.LogsType).To(Equal(models.LogsTypeHost))
+			Expect(finding.SignatureID).To(Equal("ignition-fetch-failure"))
+			Expect(finding.Severity).To(Equal(models.TriageFindingSeverityCritical))
+			Expect(finding.Remediation).ToNot(BeEmpty())
+			Expect(finding.File).To(Equal("journal.logs"))
+			Expect(finding.Line).To(Equal(int64(1)))
+			Expect(finding.Excerpt).To(ContainSubstring("attempt #1 failed"))
+			Expect(finding.MatchCount).To(Equal(int64(2)))
+			Expect(finding.Timestamp.String()).To(Equal("2023-05-04T10:00:00.000Z"))
+		})
+
+		It("requires the minimal count of matching lines", func() {
+			upload("controller.tar.gz", map[string]string{
+				"must-gather/etcd.log": "etcdserver: request timed out\netcdserver: request timed out\n",
+			})
+			m := newManager(nil)
+			source := logsearch.Source{ClusterID: clusterID, LogsType: models.LogsTypeController}
+			findings, err := m.analyze(ctx, source, "controller.tar.gz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(findings).To(BeEmpty())
+
+			upload("controller.tar.gz", map[string]string{
+				"must-gather/etcd.log": strings.Repeat("etcdserver: request timed out\n", 3),
+			})
+			findings, err = m.analyze(ctx, source, "controller.tar.gz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(findings).To(HaveLen(1))
+			Expect(findings[0].SignatureID).To(Equal("etcd-quorum-loss"))
+		})
+
+		It("fails when the logs don't exist", func() {
+			_, err := newManager(nil).analyze(ctx, hostSource, "missing.tar.gz")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("store", func() {
+		var (
+			db     *gorm.DB
+			dbName string
+			m      *Manager
+		)
+
+		BeforeEach(func() {
+			db, dbName = common.PrepareTestDB()
+			m = newManager(db)
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&common.Host{Host: models.Host{
+				ID:                &hostID,
+				ClusterID:         &clusterID,
+				InfraEnvID:        clusterID,
+				RequestedHostname: "master-0",
+			}}).Error).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.DeleteTestDB(db, dbName)
+		})
+
+		expectFindingEvent := func(sourceName string, times int) {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterLogsTriageFindingEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String()),
+				eventstest.WithSeverityMatcher(models.EventSeverityWarning),
+				eventstest.WithMessageContainsMatcher(sourceName))).Times(times)
+		}
+
+		triage := func(source logsearch.Source, objectName string) {
+			findings, err := m.analyze(ctx, source, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.store(ctx, source, findings)).To(Succeed())
+		}
+
+		clusterTriage := func() []*models.TriageFinding {
+			reply := NewHandler(common.GetTestLog(), db).V2ListClusterTriageFindings(ctx,
+				operations.V2ListClusterTriageFindingsParams{ClusterID: clusterID})
+			Expect(reply).To(BeAssignableToTypeOf(&operations.V2ListClusterTriageFindingsOK{}))
+			return reply.(*operations.V2ListClusterTriageFindingsOK).Payload
+		}
+
+		It("doesn't list the findings of a missing cluster", func() {
+			reply := NewHandler(common.GetTestLog(), db).V2ListClusterTriageFindings(ctx,
+				operations.V2ListClusterTriageFindingsParams{ClusterID: strfmt.UUID(uuid.New().String())})
+			Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+		})
+
+		It("lists the findings of the cluster and sends an event for the new ones", func() {
+			upload("host.tar.gz", map[string]string{"journal.logs": "chronyd[900]: System clock wrong by 3600.5 seconds"})
+			expectFindingEvent("master-0", 1)
+			triage(hostSource, "host.tar.gz")
+			Expect(clusterTriage()).To(HaveLen(1))
+			Expect(clusterTriage()[0].SignatureID).To(Equal("ntp-unsynchronized"))
+
+			By("uploading the logs again")
+			upload("host.tar.gz", map[string]string{"journal.logs": strings.Join([]string{
+				"chronyd[900]: System clock wrong by 3600.5 seconds",
+				"crio[1234]: Error: pull access denied for quay.io/repo",
+			}, "\n")})
+			expectFindingEvent("master-0", 1)
+			triage(hostSource, "host.tar.gz")
+			Expect(clusterTriage()).To(HaveLen(2))
+
+			By("uploading the logs of the controller")
+			controllerSource := logsearch.Source{ClusterID: clusterID, LogsType: models.LogsTypeController}
+			upload("controller.tar.gz", map[string]string{"controller.logs": "pull access denied for quay.io/repo"})
+			expectFindingEvent("controller", 1)
+			triage(controllerSource, "controller.tar.gz")
+			Expect(clusterTriage()).To(HaveLen(3))
+
+			By("uploading logs without failures")
+			upload("host.tar.gz", map[string]string{"journal.logs": "all good"})
+			triage(hostSource, "host.tar.gz")
+			Expect(clusterTriage()).To(HaveLen(1))
+			Expect(clusterTriage()[0].LogsType).To(Equal(models.LogsTypeController))
+		})
+	})
+})
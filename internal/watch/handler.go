@@ -0,0 +1,227 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// SyncEventName is sent first to the watchers starting without a revision, with the revision they start from
+	SyncEventName = "sync"
+	// ResetEventName is sent to the watchers resuming from a revision whose following changes are not retained
+	// anymore. They have to get the resource again and continue from the revision of the event.
+	ResetEventName = "reset"
+
+	changesBatchSize = 100
+)
+
+type Config struct {
+	PollInterval      time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"2s"`
+	KeepAliveInterval time.Duration `envconfig:"WATCH_KEEP_ALIVE_INTERVAL" default:"30s"`
+	RetentionPeriod   time.Duration `envconfig:"WATCH_RETENTION_PERIOD" default:"1h"`
+	CleanupInterval   time.Duration `envconfig:"WATCH_CLEANUP_INTERVAL" default:"10m"`
+}
+
+var _ restapi.WatchAPI = (*Handler)(nil)
+
+// NewHandler returns the watch handler. Watching is disabled when writer is nil.
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, cfg Config, writer *Writer) *Handler {
+	return &Handler{
+		log:    log,
+		db:     db,
+		cfg:    cfg,
+		writer: writer,
+	}
+}
+
+// Handler streams the resource changes recorded by the Writer as server-sent events
+type Handler struct {
+	log    logrus.FieldLogger
+	db     *gorm.DB
+	cfg    Config
+	writer *Writer
+}
+
+type revisionData struct {
+	Revision int64 `json:"revision"`
+}
+
+func (h *Handler) V2WatchCluster(ctx context.Context, params operations.V2WatchClusterParams) middleware.Responder {
+	if h.writer == nil {
+		return jsonResponder(common.NewApiError(http.StatusBadRequest, errors.New("Watching resources is not enabled")))
+	}
+	if _, err := common.GetClusterFromDB(h.db, params.ClusterID, common.SkipEagerLoading); err != nil {
+		return jsonResponder(common.GenerateErrorResponder(err))
+	}
+	revision, err := resumeRevision(params.Revision, params.LastEventID)
+	if err != nil {
+		return jsonResponder(common.NewApiError(http.StatusBadRequest, err))
+	}
+	return h.watch(ctx, "cluster_id = ?", params.ClusterID.String(), revision)
+}
+
+func (h *Handler) V2WatchInfraEnv(ctx context.Context, params operations.V2WatchInfraEnvParams) middleware.Responder {
+	if h.writer == nil {
+		return jsonResponder(common.NewApiError(http.StatusBadRequest, errors.New("Watching resources is not enabled")))
+	}
+	if _, err := common.GetInfraEnvFromDB(h.db, params.InfraEnvID); err != nil {
+		return jsonResponder(common.GenerateErrorResponder(err))
+	}
+	revision, err := resumeRevision(params.Revision, params.LastEventID)
+	if err != nil {
+		return jsonResponder(common.NewApiError(http.StatusBadRequest, err))
+	}
+	return h.watch(ctx, "infra_env_id = ?", params.InfraEnvID.String(), revision)
+}
+
+// DeleteExpiredChanges deletes the changes older than the retention period. The latest change is always kept
+// so that resuming watchers can tell whether the changes following their revision were deleted.
+func (h *Handler) DeleteExpiredChanges() {
+	latest := h.db.Model(&common.ResourceChange{}).Select("max(revision)")
+	if err := h.db.Where("created_at < ? AND revision < (?)", time.Now().Add(-h.cfg.RetentionPeriod), latest).
+		Delete(&common.ResourceChange{}).Error; err != nil {
+		h.log.WithError(err).Error("failed to delete expired resource changes")
+	}
+}
+
+func (h *Handler) watch(ctx context.Context, query string, id string, revision *int64) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		if err := h.streamChanges(ctx, rw, query, id, revision); err != nil && ctx.Err() == nil {
+			logutil.FromContext(ctx, h.log).WithError(err).Warn("watch stream interrupted")
+		}
+	})
+}
+
+func (h *Handler) streamChanges(ctx context.Context, rw http.ResponseWriter, query string, id string, revision *int64) error {
+	var revisions struct {
+		Oldest int64
+		Latest int64
+	}
+	if err := h.db.Model(&common.ResourceChange{}).
+		Select("coalesce(min(revision), 0) as oldest, coalesce(max(revision), 0) as latest").Scan(&revisions).Error; err != nil {
+		jsonResponder(common.NewApiError(http.StatusInternalServerError, err)).WriteResponse(rw, nil)
+		return err
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	events := &eventWriter{rw: rw, controller: http.NewResponseController(rw)}
+
+	last := revisions.Latest
+	var err error
+	switch {
+	case revision == nil:
+		err = events.writeRevision(last, SyncEventName)
+	case *revision > revisions.Latest || *revision < revisions.Oldest-1:
+		err = events.writeRevision(last, ResetEventName)
+	default:
+		last = *revision
+	}
+	if err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(h.cfg.KeepAliveInterval)
+	defer keepAlive.Stop()
+	poll := time.NewTicker(h.cfg.PollInterval)
+	defer poll.Stop()
+	for {
+		// Wait for the signal before querying, so that changes written meanwhile are not missed. The Writer commits
+		// the changes of each cluster and infra-env in the order of their revisions, so no change of the watched
+		// resource can appear below the last revision later on.
+		changed := h.writer.signal.wait()
+		var changes []*common.ResourceChange
+		if err = h.db.Where(query, id).Where("revision > ?", last).Order("revision").Limit(changesBatchSize).
+			Find(&changes).Error; err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err = events.write(change.Revision, change.NotificationType, change.Body); err != nil {
+				return err
+			}
+			last = change.Revision
+		}
+		if err = events.flush(); err != nil {
+			return err
+		}
+		if len(changes) == changesBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-poll.C:
+		case <-keepAlive.C:
+			if err = events.keepAlive(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func resumeRevision(revision *int64, lastEventID *string) (*int64, error) {
+	if lastEventID == nil || *lastEventID == "" {
+		return revision, nil
+	}
+	lastRevision, err := strconv.ParseInt(*lastEventID, 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("Invalid Last-Event-ID %s", *lastEventID)
+	}
+	return &lastRevision, nil
+}
+
+// jsonResponder writes the errors of the watch operations as JSON, the negotiated producer being the event stream one
+func jsonResponder(responder middleware.Responder) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		responder.WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+type eventWriter struct {
+	rw         http.ResponseWriter
+	controller *http.ResponseController
+}
+
+func (w *eventWriter) write(revision int64, event string, data string) error {
+	_, err := fmt.Fprintf(w.rw, "id: %d\nevent: %s\ndata: %s\n\n", revision, event, data)
+	return err
+}
+
+func (w *eventWriter) writeRevision(revision int64, event string) error {
+	data, err := json.Marshal(&revisionData{Revision: revision})
+	if err != nil {
+		return err
+	}
+	if err = w.write(revision, event, string(data)); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+func (w *eventWriter) keepAlive() error {
+	if _, err := fmt.Fprint(w.rw, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+func (w *eventWriter) flush() error {
+	return w.controller.Flush()
+}
@@ -0,0 +1,194 @@
+package watch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/watch"
+	"gorm.io/gorm"
+)
+
+type serverSentEvent struct {
+	id    int64
+	event string
+	data  string
+}
+
+func readEvent(reader *bufio.Reader) serverSentEvent {
+	var sse serverSentEvent
+	for {
+		line, err := reader.ReadString('\n')
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if sse.event != "" {
+				return sse
+			}
+		case strings.HasPrefix(line, "id: "):
+			sse.id, err = strconv.ParseInt(strings.TrimPrefix(line, "id: "), 10, 64)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		case strings.HasPrefix(line, "event: "):
+			sse.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			sse.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+var _ = Describe("Watch handler", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		dbName    string
+		writer    *Writer
+		handler   *Handler
+		server    *httptest.Server
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		writer = NewWriter(db)
+		handler = NewHandler(common.GetTestLog(), db, Config{
+			PollInterval:      time.Minute,
+			KeepAliveInterval: time.Minute,
+			RetentionPeriod:   time.Hour,
+		}, writer)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			params := operations.V2WatchClusterParams{HTTPRequest: r, ClusterID: clusterID}
+			if revision := r.URL.Query().Get("revision"); revision != "" {
+				value, err := strconv.ParseInt(revision, 10, 64)
+				Expect(err).ToNot(HaveOccurred())
+				params.Revision = &value
+			}
+			if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+				params.LastEventID = &lastEventID
+			}
+			handler.V2WatchCluster(r.Context(), params).WriteResponse(w, runtime.JSONProducer())
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	notifyCluster := func(status string) {
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeCluster,
+			Payload: &models.Cluster{ID: &clusterID, Status: &status},
+		}
+		ExpectWithOffset(1, writer.Write(ctx, []byte(clusterID), envelope)).To(Succeed())
+	}
+
+	watch := func(query string, headers map[string]string) (*bufio.Reader, func()) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+query, nil)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		res, err := http.DefaultClient.Do(req)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, res.StatusCode).To(Equal(http.StatusOK))
+		ExpectWithOffset(1, res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		return bufio.NewReader(res.Body), func() { res.Body.Close() }
+	}
+
+	It("streams the new changes of the cluster", func() {
+		notifyCluster(models.ClusterStatusInsufficient)
+
+		reader, closeStream := watch("", nil)
+		defer closeStream()
+		sync := readEvent(reader)
+		Expect(sync.event).To(Equal(SyncEventName))
+		Expect(sync.data).To(Equal(fmt.Sprintf(`{"revision":%d}`, sync.id)))
+
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		Expect(writer.Write(ctx, []byte(otherClusterID), &stream.Envelope{
+			Name:    common.NotificationTypeCluster,
+			Payload: &models.Cluster{ID: &otherClusterID},
+		})).To(Succeed())
+		notifyCluster(models.ClusterStatusReady)
+		Expect(writer.Write(ctx, []byte(clusterID), &stream.Envelope{
+			Name:    common.NotificationTypeEvent,
+			Payload: &models.Event{Name: "cluster_status_updated", ClusterID: &clusterID},
+		})).To(Succeed())
+
+		change := readEvent(reader)
+		Expect(change.id).To(BeNumerically(">", sync.id))
+		Expect(change.event).To(Equal(common.NotificationTypeCluster))
+		Expect(change.data).To(ContainSubstring(`"status":"ready"`))
+
+		event := readEvent(reader)
+		Expect(event.id).To(BeNumerically(">", change.id))
+		Expect(event.event).To(Equal(common.NotificationTypeEvent))
+		Expect(event.data).To(ContainSubstring("cluster_status_updated"))
+	})
+
+	It("resumes from a revision", func() {
+		notifyCluster(models.ClusterStatusInsufficient)
+		var first common.ResourceChange
+		Expect(db.Take(&first).Error).ToNot(HaveOccurred())
+		notifyCluster(models.ClusterStatusReady)
+		notifyCluster(models.ClusterStatusPreparingForInstallation)
+
+		reader, closeStream := watch(fmt.Sprintf("?revision=%d", first.Revision), nil)
+		defer closeStream()
+		Expect(readEvent(reader).data).To(ContainSubstring(`"status":"ready"`))
+		change := readEvent(reader)
+		Expect(change.data).To(ContainSubstring(`"status":"preparing-for-installation"`))
+
+		reader, closeResumedStream := watch("?revision=0", map[string]string{"Last-Event-ID": strconv.FormatInt(change.id-1, 10)})
+		defer closeResumedStream()
+		Expect(readEvent(reader).id).To(Equal(change.id))
+	})
+
+	It("resets watchers resuming from a revision that is not retained", func() {
+		notifyCluster(models.ClusterStatusInsufficient)
+		notifyCluster(models.ClusterStatusReady)
+		Expect(db.Model(&common.ResourceChange{}).Where("1 = 1").Update("created_at", time.Now().Add(-2*time.Hour)).Error).ToNot(HaveOccurred())
+		handler.DeleteExpiredChanges()
+
+		var changes []*common.ResourceChange
+		Expect(db.Find(&changes).Error).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+
+		reader, closeStream := watch(fmt.Sprintf("?revision=%d", changes[0].Revision-2), nil)
+		defer closeStream()
+		reset := readEvent(reader)
+		Expect(reset.event).To(Equal(ResetEventName))
+		Expect(reset.id).To(Equal(changes[0].Revision))
+	})
+
+	It("fails for unknown clusters", func() {
+		response := handler.V2WatchCluster(ctx, operations.V2WatchClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.JSONProducer())
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("fails when watching is disabled", func() {
+		handler = NewHandler(common.GetTestLog(), db, Config{}, nil)
+		response := handler.V2WatchCluster(ctx, operations.V2WatchClusterParams{ClusterID: clusterID})
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.JSONProducer())
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+})
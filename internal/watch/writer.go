@@ -0,0 +1,132 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var _ stream.StreamWriter = (*Writer)(nil)
+
+// Writer records the notifications as resource changes and wakes up the watchers of this replica.
+// Watchers served by other replicas pick the changes up when they poll the database.
+type Writer struct {
+	db     *gorm.DB
+	signal *signal
+}
+
+func NewWriter(db *gorm.DB) *Writer {
+	return &Writer{
+		db:     db,
+		signal: newSignal(),
+	}
+}
+
+func (w *Writer) Write(ctx context.Context, key []byte, payload interface{}) error {
+	envelope, ok := payload.(*stream.Envelope)
+	if !ok {
+		return errors.Errorf("unexpected watch notification payload %T", payload)
+	}
+
+	change := &common.ResourceChange{NotificationType: envelope.Name}
+	switch resource := envelope.Payload.(type) {
+	case *models.Cluster:
+		change.ClusterID = resource.ID
+	case *models.InfraEnv:
+		change.InfraEnvID = resource.ID
+		change.ClusterID = optionalID(resource.ClusterID)
+	case *models.Host:
+		change.InfraEnvID = optionalID(resource.InfraEnvID)
+		change.ClusterID = resource.ClusterID
+	case *models.Event:
+		change.InfraEnvID = resource.InfraEnvID
+		change.ClusterID = resource.ClusterID
+	default:
+		return errors.Errorf("unexpected watch notification resource %T", envelope.Payload)
+	}
+
+	body, err := json.Marshal(envelope.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal watch notification")
+	}
+	change.Body = string(body)
+	err = w.db.Transaction(func(tx *gorm.DB) error {
+		// The locks are held until the change is committed, so the revisions of each watched resource are committed
+		// in increasing order
+		for _, key := range streamLockKeys(change) {
+			if err = tx.Exec("SELECT pg_advisory_xact_lock(?)", key).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to store resource change")
+	}
+	w.signal.broadcast()
+	return nil
+}
+
+func (w *Writer) Close() {
+}
+
+// streamLockKeys returns the keys of the advisory locks of the cluster and of the infra-env whose watchers receive the
+// change. The revision is taken from a sequence when the change is inserted, so concurrent transactions could
+// otherwise commit the changes of a resource out of order, and a watcher that already read a higher revision would
+// never receive the change committed last. The changes of different resources don't wait for each other. The cluster
+// is always locked first, so that two writers can't wait for each other's lock.
+func streamLockKeys(change *common.ResourceChange) []int64 {
+	var keys []int64
+	if change.ClusterID != nil {
+		keys = append(keys, lockKey("cluster", *change.ClusterID))
+	}
+	if change.InfraEnvID != nil {
+		keys = append(keys, lockKey("infra-env", *change.InfraEnvID))
+	}
+	return keys
+}
+
+func lockKey(kind string, id strfmt.UUID) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("watch/" + kind + "/" + id.String()))
+	return int64(h.Sum64())
+}
+
+func optionalID(id strfmt.UUID) *strfmt.UUID {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// signal wakes up all the goroutines waiting for it at once
+type signal struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+func newSignal() *signal {
+	return &signal{ch: make(chan struct{})}
+}
+
+// wait returns a channel which is closed on the next broadcast
+func (s *signal) wait() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ch
+}
+
+func (s *signal) broadcast() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	close(s.ch)
+	s.ch = make(chan struct{})
+}
@@ -0,0 +1,121 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Watch writer", func() {
+	var (
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		writer     *Writer
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		writer = NewWriter(db)
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getChange := func() *common.ResourceChange {
+		var change common.ResourceChange
+		ExpectWithOffset(1, db.Order("revision desc").Take(&change).Error).ToNot(HaveOccurred())
+		return &change
+	}
+
+	It("records the cluster and infra-env of hosts", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(writer.Write(ctx, []byte(clusterID), &stream.Envelope{
+			Name:    common.NotificationTypeHost,
+			Payload: &models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID},
+		})).To(Succeed())
+
+		change := getChange()
+		Expect(*change.ClusterID).To(Equal(clusterID))
+		Expect(*change.InfraEnvID).To(Equal(infraEnvID))
+		Expect(change.NotificationType).To(Equal(common.NotificationTypeHost))
+		Expect(change.Body).To(ContainSubstring(hostID.String()))
+	})
+
+	It("records infra-envs without cluster", func() {
+		Expect(writer.Write(ctx, []byte(""), &stream.Envelope{
+			Name:    common.NotificationTypeInfraEnv,
+			Payload: &models.InfraEnv{ID: &infraEnvID},
+		})).To(Succeed())
+
+		change := getChange()
+		Expect(change.ClusterID).To(BeNil())
+		Expect(*change.InfraEnvID).To(Equal(infraEnvID))
+	})
+
+	It("wakes up the watchers", func() {
+		changed := writer.signal.wait()
+		Expect(writer.Write(ctx, []byte(clusterID), &stream.Envelope{
+			Name:    common.NotificationTypeCluster,
+			Payload: &models.Cluster{ID: &clusterID},
+		})).To(Succeed())
+		Eventually(changed).Should(BeClosed())
+		Expect(writer.signal.wait()).ToNot(BeClosed())
+	})
+
+	It("commits the changes of a resource in the order of their revisions", func() {
+		tx := db.Begin()
+		Expect(tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey("cluster", clusterID)).Error).ToNot(HaveOccurred())
+		Expect(tx.Create(&common.ResourceChange{ClusterID: &clusterID, NotificationType: common.NotificationTypeCluster}).Error).ToNot(HaveOccurred())
+
+		written := make(chan error)
+		go func() {
+			defer GinkgoRecover()
+			written <- writer.Write(ctx, []byte(clusterID), &stream.Envelope{
+				Name:    common.NotificationTypeCluster,
+				Payload: &models.Cluster{ID: &clusterID},
+			})
+		}()
+		Consistently(written, "200ms").ShouldNot(Receive())
+		var count int64
+		Expect(db.Model(&common.ResourceChange{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+
+		Expect(tx.Commit().Error).ToNot(HaveOccurred())
+		Eventually(written).Should(Receive(BeNil()))
+		var changes []*common.ResourceChange
+		Expect(db.Order("revision").Find(&changes).Error).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(2))
+		Expect(changes[1].Body).To(ContainSubstring(clusterID.String()))
+	})
+
+	It("doesn't wait for the changes of other resources", func() {
+		tx := db.Begin()
+		defer tx.Rollback()
+		Expect(tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey("cluster", clusterID)).Error).ToNot(HaveOccurred())
+
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		Expect(writer.Write(ctx, []byte(otherClusterID), &stream.Envelope{
+			Name:    common.NotificationTypeInfraEnv,
+			Payload: &models.InfraEnv{ID: &infraEnvID, ClusterID: otherClusterID},
+		})).To(Succeed())
+		Expect(*getChange().ClusterID).To(Equal(otherClusterID))
+	})
+
+	It("fails on unexpected payloads", func() {
+		Expect(writer.Write(ctx, []byte(""), "not an envelope")).ToNot(Succeed())
+		Expect(writer.Write(ctx, []byte(""), &stream.Envelope{Name: "Unknown", Payload: "unknown"})).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,185 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/pkg/netguard"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Config struct {
+	DispatchInterval    time.Duration `envconfig:"WEBHOOK_DISPATCH_INTERVAL" default:"5s"`
+	DeliveryTimeout     time.Duration `envconfig:"WEBHOOK_DELIVERY_TIMEOUT" default:"10s"`
+	DeliveryBatchSize   int           `envconfig:"WEBHOOK_DELIVERY_BATCH_SIZE" default:"100"`
+	MaxDeliveryAttempts int           `envconfig:"WEBHOOK_MAX_DELIVERY_ATTEMPTS" default:"10"`
+	MinRetryBackoff     time.Duration `envconfig:"WEBHOOK_MIN_RETRY_BACKOFF" default:"10s"`
+	MaxRetryBackoff     time.Duration `envconfig:"WEBHOOK_MAX_RETRY_BACKOFF" default:"1h"`
+	// AllowPrivateAddresses allows the webhooks on the loopback, link-local and private networks, for the
+	// deployments where the webhook receivers run next to the service
+	AllowPrivateAddresses bool `envconfig:"WEBHOOK_ALLOW_PRIVATE_ADDRESSES" default:"false"`
+}
+
+// Dispatcher posts the notifications stored in the outbox to the webhooks
+type Dispatcher struct {
+	log         logrus.FieldLogger
+	db          *gorm.DB
+	cfg         Config
+	credentials *credentials.Cipher
+	client      *http.Client
+}
+
+func NewDispatcher(log logrus.FieldLogger, db *gorm.DB, cfg Config, credentials *credentials.Cipher) *Dispatcher {
+	return &Dispatcher{
+		log:         log,
+		db:          db,
+		cfg:         cfg,
+		credentials: credentials,
+		client:      newDeliveryClient(cfg),
+	}
+}
+
+// newDeliveryClient returns the HTTP client of the deliveries. Unless private addresses are allowed, the
+// connections to restricted addresses are refused and no proxy is used, as it would hide the address that the
+// request is sent to
+func newDeliveryClient(cfg Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !cfg.AllowPrivateAddresses {
+		dialer := &net.Dialer{Timeout: cfg.DeliveryTimeout, Control: netguard.Policy{}.Control}
+		transport.DialContext = dialer.DialContext
+		transport.Proxy = nil
+	}
+	return &http.Client{Timeout: cfg.DeliveryTimeout, Transport: transport}
+}
+
+// DeliverPending posts the deliveries that are due. Failed deliveries are retried with an exponential backoff
+// until MaxDeliveryAttempts is reached.
+func (d *Dispatcher) DeliverPending() {
+	deliveries, err := d.claimDeliveries()
+	if err != nil {
+		d.log.WithError(err).Error("failed to claim webhook deliveries")
+		return
+	}
+	for _, delivery := range deliveries {
+		d.deliver(delivery)
+	}
+}
+
+// claimDeliveries postpones the due deliveries until they have all been attempted, so that the
+// dispatchers of other service replicas skip them
+func (d *Dispatcher) claimDeliveries() ([]*common.WebhookDelivery, error) {
+	var deliveries []*common.WebhookDelivery
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("next_attempt_at <= ?", now).Order("id").Limit(d.cfg.DeliveryBatchSize).Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		ids := make([]uint, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		claimedUntil := now.Add(time.Duration(len(deliveries)+1) * d.cfg.DeliveryTimeout)
+		return tx.Model(&common.WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", claimedUntil).Error
+	})
+	return deliveries, err
+}
+
+func (d *Dispatcher) deliver(delivery *common.WebhookDelivery) {
+	log := d.log.WithFields(logrus.Fields{
+		"delivery_id":     delivery.ID,
+		"subscription_id": delivery.SubscriptionID,
+	})
+
+	var subscription common.WebhookSubscription
+	if err := d.db.Take(&subscription, "id = ?", delivery.SubscriptionID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Info("dropping webhook delivery of a deleted subscription")
+			d.deleteDelivery(log, delivery)
+			return
+		}
+		log.WithError(err).Error("failed to get webhook subscription")
+		return
+	}
+
+	err := d.post(&subscription, delivery)
+	if err == nil {
+		d.deleteDelivery(log, delivery)
+		return
+	}
+
+	delivery.Attempts++
+	if delivery.Attempts >= d.cfg.MaxDeliveryAttempts {
+		log.WithError(err).Warnf("dropping webhook delivery after %d attempts", delivery.Attempts)
+		d.deleteDelivery(log, delivery)
+		return
+	}
+	log.WithError(err).Infof("webhook delivery attempt %d failed", delivery.Attempts)
+	if err = d.db.Model(delivery).Updates(map[string]interface{}{
+		"attempts":        delivery.Attempts,
+		"next_attempt_at": time.Now().Add(d.retryBackoff(delivery.Attempts)),
+		"last_error":      err.Error(),
+	}).Error; err != nil {
+		log.WithError(err).Error("failed to reschedule webhook delivery")
+	}
+}
+
+func (d *Dispatcher) post(subscription *common.WebhookSubscription, delivery *common.WebhookDelivery) error {
+	if d.credentials == nil {
+		return errors.New("the secret of the subscription can't be decrypted, the credentials keys are not configured")
+	}
+	secret, err := d.credentials.Decrypt(context.Background(), subscription.ID.String(), subscription.Secret)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, swag.StringValue(subscription.URL), strings.NewReader(delivery.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, []byte(delivery.Body)))
+	req.Header.Set(NotificationTypeHeader, delivery.NotificationType)
+	req.Header.Set(DeliveryIDHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("webhook responded with status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (d *Dispatcher) deleteDelivery(log logrus.FieldLogger, delivery *common.WebhookDelivery) {
+	if err := d.db.Delete(delivery).Error; err != nil {
+		log.WithError(err).Error("failed to delete webhook delivery")
+	}
+}
+
+func (d *Dispatcher) retryBackoff(attempts int) time.Duration {
+	backoff := d.cfg.MinRetryBackoff
+	for i := 1; i < attempts && backoff < d.cfg.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > d.cfg.MaxRetryBackoff {
+		backoff = d.cfg.MaxRetryBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,173 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Webhook dispatcher", func() {
+	var (
+		db           *gorm.DB
+		dbName       string
+		dispatcher   *Dispatcher
+		server       *httptest.Server
+		statusCode   int
+		requests     []*http.Request
+		bodies       []string
+		subscription *common.WebhookSubscription
+		secret       string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		statusCode = http.StatusOK
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(statusCode)
+		}))
+		dispatcher = NewDispatcher(common.GetTestLog(), db, Config{
+			DeliveryTimeout:     time.Second,
+			DeliveryBatchSize:   10,
+			MaxDeliveryAttempts: 3,
+			MinRetryBackoff:     time.Minute,
+			MaxRetryBackoff:     3 * time.Minute,
+			// The test server listens on the loopback address
+			AllowPrivateAddresses: true,
+		}, newTestCredentials())
+		subscription = createSubscription(db, "jdoe", "org1")
+		subscription.URL = swag.String(server.URL)
+		secret = subscription.Secret
+		var err error
+		subscription.Secret, err = dispatcher.credentials.Encrypt(context.Background(), subscription.ID.String(), secret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Save(subscription).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createDelivery := func(nextAttemptAt time.Time) *common.WebhookDelivery {
+		delivery := &common.WebhookDelivery{
+			SubscriptionID:   *subscription.ID,
+			NotificationType: common.NotificationTypeCluster,
+			Body:             `{"Name":"ClusterState"}`,
+			NextAttemptAt:    nextAttemptAt,
+		}
+		ExpectWithOffset(1, db.Create(delivery).Error).ToNot(HaveOccurred())
+		return delivery
+	}
+
+	getDeliveries := func() []*common.WebhookDelivery {
+		var deliveries []*common.WebhookDelivery
+		ExpectWithOffset(1, db.Order("id").Find(&deliveries).Error).ToNot(HaveOccurred())
+		return deliveries
+	}
+
+	It("posts signed notifications and removes them from the outbox", func() {
+		createDelivery(time.Now())
+		createDelivery(time.Now().Add(time.Hour))
+
+		dispatcher.DeliverPending()
+
+		Expect(requests).To(HaveLen(1))
+		Expect(bodies[0]).To(Equal(`{"Name":"ClusterState"}`))
+		Expect(requests[0].Header.Get(NotificationTypeHeader)).To(Equal(common.NotificationTypeCluster))
+		Expect(VerifySignature(secret, []byte(bodies[0]), requests[0].Header.Get(SignatureHeader))).To(BeTrue())
+		Expect(getDeliveries()).To(HaveLen(1))
+	})
+
+	It("reschedules failed deliveries with an exponential backoff", func() {
+		statusCode = http.StatusServiceUnavailable
+		delivery := createDelivery(time.Now())
+
+		dispatcher.DeliverPending()
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Attempts).To(Equal(1))
+		Expect(deliveries[0].LastError).To(ContainSubstring("503"))
+		Expect(deliveries[0].NextAttemptAt).To(BeTemporally("~", time.Now().Add(time.Minute), 10*time.Second))
+
+		Expect(db.Model(delivery).Update("next_attempt_at", time.Now()).Error).ToNot(HaveOccurred())
+		dispatcher.DeliverPending()
+		deliveries = getDeliveries()
+		Expect(deliveries[0].Attempts).To(Equal(2))
+		Expect(deliveries[0].NextAttemptAt).To(BeTemporally("~", time.Now().Add(2*time.Minute), 10*time.Second))
+
+		Expect(db.Model(delivery).Update("next_attempt_at", time.Now()).Error).ToNot(HaveOccurred())
+		dispatcher.DeliverPending()
+		Expect(requests).To(HaveLen(3))
+		Expect(getDeliveries()).To(BeEmpty())
+	})
+
+	It("drops the deliveries of deleted subscriptions", func() {
+		createDelivery(time.Now())
+		Expect(db.Delete(subscription).Error).ToNot(HaveOccurred())
+
+		dispatcher.DeliverPending()
+		Expect(requests).To(BeEmpty())
+		Expect(getDeliveries()).To(BeEmpty())
+	})
+
+	It("refuses to deliver to restricted addresses", func() {
+		dispatcher = NewDispatcher(common.GetTestLog(), db, Config{
+			DeliveryTimeout:     time.Second,
+			DeliveryBatchSize:   10,
+			MaxDeliveryAttempts: 3,
+			MinRetryBackoff:     time.Minute,
+			MaxRetryBackoff:     3 * time.Minute,
+		}, dispatcher.credentials)
+		createDelivery(time.Now())
+
+		dispatcher.DeliverPending()
+		Expect(requests).To(BeEmpty())
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Attempts).To(Equal(1))
+		Expect(deliveries[0].LastError).To(ContainSubstring("are not allowed"))
+	})
+
+	It("fails the deliveries whose secret can't be decrypted", func() {
+		createDelivery(time.Now())
+		dispatcher.credentials = newTestCredentials()
+		Expect(db.Model(subscription).Update("secret", "0123456789abcdef").Error).ToNot(HaveOccurred())
+
+		dispatcher.DeliverPending()
+		Expect(requests).To(BeEmpty())
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].LastError).To(ContainSubstring("isn't encrypted"))
+	})
+
+	It("caps the retry backoff", func() {
+		Expect(dispatcher.retryBackoff(1)).To(Equal(time.Minute))
+		Expect(dispatcher.retryBackoff(2)).To(Equal(2 * time.Minute))
+		Expect(dispatcher.retryBackoff(3)).To(Equal(3 * time.Minute))
+		Expect(dispatcher.retryBackoff(10)).To(Equal(3 * time.Minute))
+	})
+})
+
+var _ = Describe("Signature", func() {
+	It("verifies the signature of the body", func() {
+		signature := Sign("secret", []byte("body"))
+		Expect(signature).To(HavePrefix("sha256="))
+		Expect(VerifySignature("secret", []byte("body"), signature)).To(BeTrue())
+		Expect(VerifySignature("other-secret", []byte("body"), signature)).To(BeFalse())
+		Expect(VerifySignature("secret", []byte("other-body"), signature)).To(BeFalse())
+	})
+})
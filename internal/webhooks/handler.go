@@ -0,0 +1,198 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/netguard"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// minSecretLength is the minimum length of the secrets signing the notifications, as in the swagger definition
+const minSecretLength = 16
+
+var _ restapi.WebhooksAPI = (*Handler)(nil)
+
+// NewHandler returns webhook subscriptions handler. The secrets of the subscriptions are encrypted by credentials,
+// subscriptions can't be registered without it.
+func NewHandler(log logrus.FieldLogger, db *gorm.DB, authzHandler auth.Authorizer, enabled bool, cfg Config,
+	credentials *credentials.Cipher) *Handler {
+	return &Handler{
+		log:          log,
+		db:           db,
+		authzHandler: authzHandler,
+		enabled:      enabled,
+		cfg:          cfg,
+		credentials:  credentials,
+		lookupIP:     netguard.LookupIP,
+	}
+}
+
+// Handler represents webhook subscriptions handler
+type Handler struct {
+	log          logrus.FieldLogger
+	db           *gorm.DB
+	authzHandler auth.Authorizer
+	enabled      bool
+	cfg          Config
+	credentials  *credentials.Cipher
+	lookupIP     netguard.LookupIPFunc
+}
+
+// EncryptPlaintextSecrets encrypts the secrets of the webhook subscriptions that were stored in plaintext
+func (h *Handler) EncryptPlaintextSecrets(ctx context.Context) error {
+	if h.credentials == nil {
+		return nil
+	}
+	var subscriptions []*common.WebhookSubscription
+	if err := h.db.Where("secret NOT LIKE ?", credentials.EncryptedPrefix+"%").Find(&subscriptions).Error; err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		encrypted, err := h.credentials.Encrypt(ctx, subscription.ID.String(), subscription.Secret)
+		if err != nil {
+			return err
+		}
+		err = h.db.Model(&common.WebhookSubscription{}).Where("id = ? and secret = ?", subscription.ID.String(), subscription.Secret).
+			Update("secret", encrypted).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to encrypt the secret of webhook subscription %s", subscription.ID)
+		}
+	}
+	if len(subscriptions) > 0 {
+		h.log.Infof("Encrypted the secrets of %d webhook subscriptions", len(subscriptions))
+	}
+	return nil
+}
+
+func (h *Handler) V2RegisterWebhookSubscription(ctx context.Context, params operations.V2RegisterWebhookSubscriptionParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !h.enabled {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Webhook notifications are not enabled"))
+	}
+
+	if h.credentials == nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("Webhook notifications are not enabled, the credentials keys must be configured"))
+	}
+
+	createParams := params.NewWebhookSubscriptionParams
+	if err := h.validateWebhookURL(ctx, swag.StringValue(createParams.URL)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if len(swag.StringValue(createParams.Secret)) < minSecretLength {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("The webhook secret must contain at least %d characters", minSecretLength))
+	}
+
+	notificationTypes := pq.StringArray{}
+	for _, notificationType := range createParams.NotificationTypes {
+		if !funk.ContainsString(notificationTypes, string(notificationType)) {
+			notificationTypes = append(notificationTypes, string(notificationType))
+		}
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	secret, err := h.credentials.Encrypt(ctx, id.String(), swag.StringValue(createParams.Secret))
+	if err != nil {
+		log.WithError(err).Errorf("failed to encrypt the secret of webhook subscription %s", id)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	subscription := &common.WebhookSubscription{
+		WebhookSubscription: models.WebhookSubscription{
+			ID:                &id,
+			URL:               createParams.URL,
+			NotificationTypes: notificationTypes,
+			UserName:          ocm.UserNameFromContext(ctx),
+			OrgID:             ocm.OrgIDFromContext(ctx),
+			CreatedAt:         time.Now(),
+		},
+		Secret: secret,
+	}
+	if err = h.db.Create(subscription).Error; err != nil {
+		log.WithError(err).Error("failed to create webhook subscription")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Registered webhook subscription %s", id)
+	return operations.NewV2RegisterWebhookSubscriptionCreated().WithPayload(&subscription.WebhookSubscription)
+}
+
+func (h *Handler) V2ListWebhookSubscriptions(ctx context.Context, params operations.V2ListWebhookSubscriptionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	var subscriptions []*common.WebhookSubscription
+	if err := h.authzHandler.OwnedBy(ctx, h.db).Order("created_at").Find(&subscriptions).Error; err != nil {
+		log.WithError(err).Error("failed to list webhook subscriptions")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	payload := models.WebhookSubscriptionList{}
+	for _, subscription := range subscriptions {
+		payload = append(payload, &subscription.WebhookSubscription)
+	}
+	return operations.NewV2ListWebhookSubscriptionsOK().WithPayload(payload)
+}
+
+func (h *Handler) V2DeregisterWebhookSubscription(ctx context.Context, params operations.V2DeregisterWebhookSubscriptionParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		var subscription common.WebhookSubscription
+		if err := h.authzHandler.OwnedBy(ctx, tx).Take(&subscription, "id = ?", params.SubscriptionID.String()).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, errors.Errorf("Webhook subscription %s not found", params.SubscriptionID))
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err := tx.Where("subscription_id = ?", params.SubscriptionID.String()).Delete(&common.WebhookDelivery{}).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err := tx.Delete(&subscription).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to deregister webhook subscription %s", params.SubscriptionID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Deregistered webhook subscription %s", params.SubscriptionID)
+	return operations.NewV2DeregisterWebhookSubscriptionNoContent()
+}
+
+func (h *Handler) validateWebhookURL(ctx context.Context, webhookURL string) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid webhook URL %s", webhookURL)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.Errorf("Webhook URL %s must use the http or https scheme", webhookURL)
+	}
+	if parsed.Host == "" {
+		return errors.Errorf("Webhook URL %s must contain a host", webhookURL)
+	}
+	if h.cfg.AllowPrivateAddresses {
+		return nil
+	}
+	return errors.Wrapf(netguard.Policy{}.ValidateHost(ctx, h.lookupIP, parsed.Hostname()), "Invalid webhook URL %s", webhookURL)
+}
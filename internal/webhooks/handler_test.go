@@ -0,0 +1,211 @@
+package webhooks
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+var _ = Describe("Webhook subscriptions handler", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		handler *Handler
+		ctx     context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}
+		authzHandler := auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		handler = NewHandler(common.GetTestLog(), db, authzHandler, true, Config{}, newTestCredentials())
+		handler.lookupIP = func(_ context.Context, host string) ([]net.IP, error) {
+			switch host {
+			case "example.com":
+				return []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("2606:2800:21f:cb07:6820:80da:af6b:8b2c")}, nil
+			case "rebound.example.com":
+				return []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("10.0.0.1")}, nil
+			}
+			return nil, errors.Errorf("no such host %s", host)
+		}
+		ctx = userContext("jdoe", "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(ctx context.Context, url string, notificationTypes ...models.WebhookNotificationType) *models.WebhookSubscription {
+		response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+			NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+				URL:               swag.String(url),
+				Secret:            swag.String("0123456789abcdef"),
+				NotificationTypes: notificationTypes,
+			},
+		})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2RegisterWebhookSubscriptionCreated()))
+		return response.(*operations.V2RegisterWebhookSubscriptionCreated).Payload
+	}
+
+	It("registers a subscription owned by the caller", func() {
+		subscription := register(ctx, "https://example.com/hook",
+			models.WebhookNotificationTypeClusterState, models.WebhookNotificationTypeClusterState, models.WebhookNotificationTypeEvent)
+		Expect(subscription.UserName).To(Equal("jdoe"))
+		Expect(subscription.OrgID).To(Equal("org1"))
+		Expect(subscription.NotificationTypes).To(ConsistOf("ClusterState", "Event"))
+
+		var stored common.WebhookSubscription
+		Expect(db.Take(&stored, "id = ?", subscription.ID.String()).Error).ToNot(HaveOccurred())
+		Expect(credentials.IsEncrypted(stored.Secret)).To(BeTrue())
+		Expect(handler.credentials.Decrypt(ctx, subscription.ID.String(), stored.Secret)).To(Equal("0123456789abcdef"))
+	})
+
+	It("rejects missing and short secrets", func() {
+		for _, secret := range []*string{nil, swag.String(""), swag.String("0123456789abcde")} {
+			response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+				NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+					URL:    swag.String("https://example.com/hook"),
+					Secret: secret,
+				},
+			})
+			verifyApiError(response, http.StatusBadRequest)
+		}
+	})
+
+	It("rejects registrations without credentials keys", func() {
+		handler.credentials = nil
+		response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+			NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+				URL:    swag.String("https://example.com/hook"),
+				Secret: swag.String("0123456789abcdef"),
+			},
+		})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("encrypts the secrets stored in plaintext", func() {
+		subscription := createSubscription(db, "jdoe", "org1")
+		Expect(handler.EncryptPlaintextSecrets(ctx)).To(Succeed())
+
+		var stored common.WebhookSubscription
+		Expect(db.Take(&stored, "id = ?", subscription.ID.String()).Error).ToNot(HaveOccurred())
+		Expect(handler.credentials.Decrypt(ctx, subscription.ID.String(), stored.Secret)).To(Equal(subscription.Secret))
+		Expect(handler.EncryptPlaintextSecrets(ctx)).To(Succeed())
+		var again common.WebhookSubscription
+		Expect(db.Take(&again, "id = ?", subscription.ID.String()).Error).ToNot(HaveOccurred())
+		Expect(again.Secret).To(Equal(stored.Secret))
+	})
+
+	It("rejects invalid webhook URLs", func() {
+		for _, url := range []string{"ftp://example.com/hook", "https://", "://example.com"} {
+			response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+				NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+					URL:    swag.String(url),
+					Secret: swag.String("0123456789abcdef"),
+				},
+			})
+			verifyApiError(response, http.StatusBadRequest)
+		}
+	})
+
+	It("rejects webhooks on restricted addresses", func() {
+		for _, url := range []string{
+			"http://127.0.0.1:8090/hook",
+			"http://[::1]/hook",
+			"http://169.254.169.254/latest/meta-data",
+			"https://10.1.2.3/hook",
+			"https://192.168.1.1/hook",
+			"https://100.64.0.1/hook",
+			"https://[fe80::1]/hook",
+			"https://[::ffff:127.0.0.1]/hook",
+			"https://rebound.example.com/hook",
+			"https://unknown.example.com/hook",
+		} {
+			response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+				NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+					URL:    swag.String(url),
+					Secret: swag.String("0123456789abcdef"),
+				},
+			})
+			verifyApiError(response, http.StatusBadRequest)
+		}
+
+		By("allowing them when private addresses are allowed")
+		handler.cfg.AllowPrivateAddresses = true
+		register(ctx, "http://10.1.2.3/hook")
+	})
+
+	It("rejects registrations when webhook notifications are disabled", func() {
+		handler.enabled = false
+		response := handler.V2RegisterWebhookSubscription(ctx, operations.V2RegisterWebhookSubscriptionParams{
+			NewWebhookSubscriptionParams: &models.WebhookSubscriptionCreateParams{
+				URL:    swag.String("https://example.com/hook"),
+				Secret: swag.String("0123456789abcdef"),
+			},
+		})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("lists the subscriptions of the tenant", func() {
+		subscription1 := register(ctx, "https://example.com/hook1")
+		subscription2 := register(userContext("other-user", "org1"), "https://example.com/hook2")
+		register(userContext("other-org-user", "org2"), "https://example.com/hook3")
+
+		response := handler.V2ListWebhookSubscriptions(ctx, operations.V2ListWebhookSubscriptionsParams{})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2ListWebhookSubscriptionsOK()))
+		payload := response.(*operations.V2ListWebhookSubscriptionsOK).Payload
+		Expect(payload).To(HaveLen(2))
+		Expect(*payload[0].ID).To(Equal(*subscription1.ID))
+		Expect(*payload[1].ID).To(Equal(*subscription2.ID))
+	})
+
+	It("deregisters a subscription together with its pending deliveries", func() {
+		subscription := register(ctx, "https://example.com/hook")
+		Expect(db.Create(&common.WebhookDelivery{SubscriptionID: *subscription.ID, Body: "{}"}).Error).ToNot(HaveOccurred())
+
+		response := handler.V2DeregisterWebhookSubscription(ctx, operations.V2DeregisterWebhookSubscriptionParams{SubscriptionID: *subscription.ID})
+		Expect(response).To(BeAssignableToTypeOf(operations.NewV2DeregisterWebhookSubscriptionNoContent()))
+
+		var count int64
+		Expect(db.Model(&common.WebhookSubscription{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+		Expect(db.Model(&common.WebhookDelivery{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("does not deregister subscriptions of other tenants", func() {
+		subscription := register(userContext("other-org-user", "org2"), "https://example.com/hook")
+		response := handler.V2DeregisterWebhookSubscription(ctx, operations.V2DeregisterWebhookSubscriptionParams{SubscriptionID: *subscription.ID})
+		verifyApiError(response, http.StatusNotFound)
+
+		response = handler.V2DeregisterWebhookSubscription(ctx, operations.V2DeregisterWebhookSubscriptionParams{SubscriptionID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
+func verifyApiError(responder interface{}, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -0,0 +1,30 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the request body, keyed with the subscription secret
+	SignatureHeader = "X-Assisted-Signature"
+	// NotificationTypeHeader carries the name of the notification envelope
+	NotificationTypeHeader = "X-Assisted-Notification-Type"
+	// DeliveryIDHeader identifies the delivery, it is kept when the delivery is retried
+	DeliveryIDHeader = "X-Assisted-Delivery-ID"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header value of a notification body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the signature header value received together with a notification body
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
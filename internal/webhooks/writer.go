@@ -0,0 +1,115 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ stream.StreamWriter = (*Writer)(nil)
+
+// Writer stores the notifications in the outbox of the webhook subscriptions of the resource owner.
+// The notifications are posted to the webhooks by the Dispatcher, so they survive service restarts.
+type Writer struct {
+	log              logrus.FieldLogger
+	db               *gorm.DB
+	enableOrgTenancy bool
+}
+
+type owner struct {
+	UserName string
+	OrgID    string
+}
+
+// NewWriter returns a webhook stream writer. The subscriptions of the organization owning the resource
+// are notified when org tenancy is enabled, otherwise only the subscriptions of the owning user are.
+func NewWriter(log logrus.FieldLogger, db *gorm.DB, enableOrgTenancy bool) *Writer {
+	return &Writer{
+		log:              log,
+		db:               db,
+		enableOrgTenancy: enableOrgTenancy,
+	}
+}
+
+func (w *Writer) Write(ctx context.Context, key []byte, payload interface{}) error {
+	envelope, ok := payload.(*stream.Envelope)
+	if !ok {
+		return errors.Errorf("unexpected webhook notification payload %T", payload)
+	}
+
+	resourceOwner, err := w.getOwner(envelope.Payload)
+	if err != nil {
+		return err
+	}
+
+	query := w.db.Select("id").Where("coalesce(cardinality(notification_types), 0) = 0 OR ? = ANY(notification_types)", envelope.Name)
+	if w.enableOrgTenancy {
+		query = query.Where("org_id = ?", resourceOwner.OrgID)
+	} else {
+		query = query.Where("user_name = ?", resourceOwner.UserName)
+	}
+	var subscriptions []*common.WebhookSubscription
+	if err = query.Find(&subscriptions).Error; err != nil {
+		return errors.Wrap(err, "failed to find webhook subscriptions")
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook notification")
+	}
+	now := time.Now()
+	deliveries := make([]*common.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, &common.WebhookDelivery{
+			SubscriptionID:   *subscription.ID,
+			NotificationType: envelope.Name,
+			Body:             string(body),
+			NextAttemptAt:    now,
+		})
+	}
+	return errors.Wrap(w.db.Create(&deliveries).Error, "failed to store webhook deliveries")
+}
+
+func (w *Writer) Close() {
+}
+
+func (w *Writer) getOwner(payload interface{}) (*owner, error) {
+	switch resource := payload.(type) {
+	case *models.Cluster:
+		return &owner{UserName: resource.UserName, OrgID: resource.OrgID}, nil
+	case *models.InfraEnv:
+		return &owner{UserName: resource.UserName, OrgID: resource.OrgID}, nil
+	case *models.Host:
+		return w.getResourceOwner(&common.InfraEnv{}, resource.InfraEnvID)
+	case *models.Event:
+		if resource.ClusterID != nil {
+			return w.getResourceOwner(&common.Cluster{}, *resource.ClusterID)
+		}
+		if resource.InfraEnvID != nil {
+			return w.getResourceOwner(&common.InfraEnv{}, *resource.InfraEnvID)
+		}
+		return nil, errors.Errorf("event %s is not bound to a cluster or an infra-env", resource.Name)
+	default:
+		return nil, errors.Errorf("unexpected webhook notification resource %T", payload)
+	}
+}
+
+func (w *Writer) getResourceOwner(model interface{}, id strfmt.UUID) (*owner, error) {
+	var resourceOwner owner
+	// Deleted resources are still notified
+	if err := w.db.Unscoped().Model(model).Select("user_name", "org_id").Where("id = ?", id.String()).Take(&resourceOwner).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to find the owner of %s", id)
+	}
+	return &resourceOwner, nil
+}
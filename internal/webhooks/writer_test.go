@@ -0,0 +1,148 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/credentials"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+// newTestCredentials returns a cipher of a keys file with a fixed current key
+func newTestCredentials() *credentials.Cipher {
+	file, err := os.CreateTemp("", "webhooks-keys")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	defer os.Remove(file.Name())
+	_, err = file.WriteString("current_key: test\nkeys:\n  test: MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=\n")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, file.Close()).To(Succeed())
+	c, err := credentials.LoadCipher(file.Name())
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return c
+}
+
+func createSubscription(db *gorm.DB, userName, orgID string, notificationTypes ...string) *common.WebhookSubscription {
+	id := strfmt.UUID(uuid.New().String())
+	subscription := &common.WebhookSubscription{
+		WebhookSubscription: models.WebhookSubscription{
+			ID:                &id,
+			URL:               swag.String("https://example.com/hook"),
+			NotificationTypes: pq.StringArray(notificationTypes),
+			UserName:          userName,
+			OrgID:             orgID,
+		},
+		Secret: "0123456789abcdef",
+	}
+	ExpectWithOffset(1, db.Create(subscription).Error).ToNot(HaveOccurred())
+	return subscription
+}
+
+var _ = Describe("Webhook writer", func() {
+	var (
+		ctx    = context.Background()
+		db     *gorm.DB
+		dbName string
+		writer *Writer
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		writer = NewWriter(common.GetTestLog(), db, true)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getDeliveries := func() []*common.WebhookDelivery {
+		var deliveries []*common.WebhookDelivery
+		ExpectWithOffset(1, db.Order("id").Find(&deliveries).Error).ToNot(HaveOccurred())
+		return deliveries
+	}
+
+	It("stores the notification for the subscriptions of the organization", func() {
+		subscription := createSubscription(db, "jdoe", "org1")
+		createSubscription(db, "jdoe", "org1", common.NotificationTypeHost)
+		createSubscription(db, "other-org-user", "org2")
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeCluster,
+			Payload: &models.Cluster{ID: &clusterID, UserName: "other-user", OrgID: "org1"},
+		}
+		Expect(writer.Write(ctx, []byte(clusterID), envelope)).To(Succeed())
+
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].SubscriptionID).To(Equal(*subscription.ID))
+		Expect(deliveries[0].NotificationType).To(Equal(common.NotificationTypeCluster))
+		var body map[string]interface{}
+		Expect(json.Unmarshal([]byte(deliveries[0].Body), &body)).To(Succeed())
+		Expect(body["Name"]).To(Equal(common.NotificationTypeCluster))
+	})
+
+	It("stores the notification for the subscriptions of the user when org tenancy is disabled", func() {
+		writer = NewWriter(common.GetTestLog(), db, false)
+		subscription := createSubscription(db, "jdoe", "org1")
+		createSubscription(db, "other-user", "org1")
+
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeInfraEnv,
+			Payload: &models.InfraEnv{ID: &infraEnvID, UserName: "jdoe", OrgID: "org1"},
+		}
+		Expect(writer.Write(ctx, []byte(""), envelope)).To(Succeed())
+
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].SubscriptionID).To(Equal(*subscription.ID))
+	})
+
+	It("resolves the owner of hosts from their infra-env", func() {
+		subscription := createSubscription(db, "jdoe", "org1", common.NotificationTypeHost)
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, UserName: "jdoe", OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+
+		hostID := strfmt.UUID(uuid.New().String())
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeHost,
+			Payload: &models.Host{ID: &hostID, InfraEnvID: infraEnvID},
+		}
+		Expect(writer.Write(ctx, []byte(""), envelope)).To(Succeed())
+
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].SubscriptionID).To(Equal(*subscription.ID))
+	})
+
+	It("resolves the owner of events from their cluster", func() {
+		subscription := createSubscription(db, "jdoe", "org1", common.NotificationTypeEvent)
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "jdoe", OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+
+		envelope := &stream.Envelope{
+			Name:    common.NotificationTypeEvent,
+			Payload: &models.Event{Name: "cluster_registration_succeeded", ClusterID: &clusterID},
+		}
+		Expect(writer.Write(ctx, []byte(clusterID), envelope)).To(Succeed())
+
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].SubscriptionID).To(Equal(*subscription.ID))
+	})
+
+	It("fails on unexpected payloads", func() {
+		Expect(writer.Write(ctx, []byte(""), "not an envelope")).ToNot(Succeed())
+		Expect(writer.Write(ctx, []byte(""), &stream.Envelope{Name: "Unknown", Payload: "unknown"})).ToNot(Succeed())
+	})
+})
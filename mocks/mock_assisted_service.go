@@ -302,6 +302,20 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2DecommissionHost mocks base method.
+func (m *MockInstallerAPI) V2DecommissionHost(arg0 context.Context, arg1 installer.V2DecommissionHostParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DecommissionHost", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DecommissionHost indicates an expected call of V2DecommissionHost.
+func (mr *MockInstallerAPIMockRecorder) V2DecommissionHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DecommissionHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2DecommissionHost), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -498,6 +512,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetHostIgnition(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostIgnition), arg0, arg1)
 }
 
+// V2GetHostIgnitionPreview mocks base method.
+func (m *MockInstallerAPI) V2GetHostIgnitionPreview(arg0 context.Context, arg1 installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetHostIgnitionPreview", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetHostIgnitionPreview indicates an expected call of V2GetHostIgnitionPreview.
+func (mr *MockInstallerAPIMockRecorder) V2GetHostIgnitionPreview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostIgnitionPreview", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostIgnitionPreview), arg0, arg1)
+}
+
 // V2GetIgnoredValidations mocks base method.
 func (m *MockInstallerAPI) V2GetIgnoredValidations(arg0 context.Context, arg1 installer.V2GetIgnoredValidationsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -652,6 +680,20 @@ func (mr *MockInstallerAPIMockRecorder) V2PostStepReply(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2PostStepReply", reflect.TypeOf((*MockInstallerAPI)(nil).V2PostStepReply), arg0, arg1)
 }
 
+// V2PreviewClusterInstallConfig mocks base method.
+func (m *MockInstallerAPI) V2PreviewClusterInstallConfig(arg0 context.Context, arg1 installer.V2PreviewClusterInstallConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2PreviewClusterInstallConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2PreviewClusterInstallConfig indicates an expected call of V2PreviewClusterInstallConfig.
+func (mr *MockInstallerAPIMockRecorder) V2PreviewClusterInstallConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2PreviewClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2PreviewClusterInstallConfig), arg0, arg1)
+}
+
 // V2RegisterCluster mocks base method.
 func (m *MockInstallerAPI) V2RegisterCluster(arg0 context.Context, arg1 installer.V2RegisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
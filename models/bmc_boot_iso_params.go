@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BmcBootIsoParams bmc boot iso params
+//
+// swagger:model bmc-boot-iso-params
+type BmcBootIsoParams struct {
+
+	// The URL of the ISO, the discovery ISO of the infra-env by default.
+	ImageURL string `json:"image_url,omitempty"`
+
+	// Power the host on, or restart it when it is on, to boot the ISO.
+	Reboot *bool `json:"reboot,omitempty"`
+}
+
+// Validate validates this bmc boot iso params
+func (m *BmcBootIsoParams) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this bmc boot iso params based on context it is used
+func (m *BmcBootIsoParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcBootIsoParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcBootIsoParams) UnmarshalBinary(b []byte) error {
+	var res BmcBootIsoParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	timeext "time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BmcHost bmc host
+//
+// swagger:model bmc-host
+type BmcHost struct {
+
+	// The Redfish address of the BMC.
+	// Required: true
+	Address *string `json:"address" gorm:"type:text"`
+
+	// created at
+	// Format: date-time
+	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Skip the verification of the certificate of the BMC.
+	DisableCertificateVerification bool `json:"disable_certificate_verification,omitempty"`
+
+	// The host of the infra-env managed by the BMC.
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty" gorm:"index"`
+
+	// Unique identifier of the object.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
+
+	// The infra-env of the host.
+	// Required: true
+	// Format: uuid
+	InfraEnvID *strfmt.UUID `json:"infra_env_id" gorm:"index"`
+
+	// The last action performed on the host through its BMC.
+	LastAction string `json:"last_action,omitempty"`
+
+	// The time the last action was performed.
+	// Format: date-time
+	LastActionAt timeext.Time `json:"last_action_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The error returned by the BMC for the last action, empty when it succeeded.
+	LastActionError string `json:"last_action_error,omitempty" gorm:"type:text"`
+
+	// A name identifying the host, e.g. its rack position.
+	Name string `json:"name,omitempty"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt timeext.Time `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The user authenticating to the BMC.
+	// Required: true
+	Username *string `json:"username"`
+}
+
+// Validate validates this bmc host
+func (m *BmcHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastActionAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsername(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BmcHost) validateAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("address", "body", m.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.Required("infra_env_id", "body", m.InfraEnvID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateLastActionAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastActionAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_action_at", "body", "date-time", m.LastActionAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHost) validateUsername(formats strfmt.Registry) error {
+
+	if err := validate.Required("username", "body", m.Username); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bmc host based on context it is used
+func (m *BmcHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcHost) UnmarshalBinary(b []byte) error {
+	var res BmcHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
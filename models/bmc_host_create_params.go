@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BmcHostCreateParams bmc host create params
+//
+// swagger:model bmc-host-create-params
+type BmcHostCreateParams struct {
+
+	// The Redfish address of the BMC, e.g. redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/1. The scheme is redfish, redfish-virtualmedia or idrac-virtualmedia, followed by +http for a BMC without TLS. The system is discovered when the address has no path.
+	// Required: true
+	Address *string `json:"address"`
+
+	// Skip the verification of the certificate of the BMC.
+	DisableCertificateVerification *bool `json:"disable_certificate_verification,omitempty"`
+
+	// The host of the infra-env managed by the BMC, once it is discovered.
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+
+	// A name identifying the host, e.g. its rack position.
+	Name string `json:"name,omitempty"`
+
+	// The password of the user. It is never returned by the service.
+	// Required: true
+	// Format: password
+	Password *strfmt.Password `json:"password"`
+
+	// The user authenticating to the BMC.
+	// Required: true
+	Username *string `json:"username"`
+}
+
+// Validate validates this bmc host create params
+func (m *BmcHostCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsername(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BmcHostCreateParams) validateAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("address", "body", m.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHostCreateParams) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHostCreateParams) validatePassword(formats strfmt.Registry) error {
+
+	if err := validate.Required("password", "body", m.Password); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("password", "body", "password", m.Password.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHostCreateParams) validateUsername(formats strfmt.Registry) error {
+
+	if err := validate.Required("username", "body", m.Username); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bmc host create params based on context it is used
+func (m *BmcHostCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcHostCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcHostCreateParams) UnmarshalBinary(b []byte) error {
+	var res BmcHostCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BmcHostStatus bmc host status
+//
+// swagger:model bmc-host-status
+type BmcHostStatus struct {
+
+	// Whether the boot source override applies to the next boot only (Once), to every boot (Continuous) or isn't applied (Disabled).
+	BootSourceOverrideEnabled string `json:"boot_source_override_enabled,omitempty"`
+
+	// The Redfish boot source override of the system, e.g. Cd or None.
+	BootSourceOverrideTarget string `json:"boot_source_override_target,omitempty"`
+
+	// The Redfish power state of the system, e.g. On or Off.
+	// Required: true
+	PowerState *string `json:"power_state"`
+
+	// The image of the CD virtual media.
+	VirtualMediaImage string `json:"virtual_media_image,omitempty"`
+
+	// Whether the image of the CD virtual media is inserted.
+	VirtualMediaInserted bool `json:"virtual_media_inserted,omitempty"`
+}
+
+// Validate validates this bmc host status
+func (m *BmcHostStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePowerState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BmcHostStatus) validatePowerState(formats strfmt.Registry) error {
+
+	if err := validate.Required("power_state", "body", m.PowerState); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bmc host status based on context it is used
+func (m *BmcHostStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcHostStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcHostStatus) UnmarshalBinary(b []byte) error {
+	var res BmcHostStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
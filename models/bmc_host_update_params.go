@@ -0,0 +1,106 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BmcHostUpdateParams bmc host update params
+//
+// swagger:model bmc-host-update-params
+type BmcHostUpdateParams struct {
+
+	// The Redfish address of the BMC.
+	Address *string `json:"address,omitempty"`
+
+	// Skip the verification of the certificate of the BMC.
+	DisableCertificateVerification *bool `json:"disable_certificate_verification,omitempty"`
+
+	// The host of the infra-env managed by the BMC. The empty UUID unlinks the host.
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+
+	// A name identifying the host, e.g. its rack position.
+	Name *string `json:"name,omitempty"`
+
+	// The password of the user. It is never returned by the service.
+	// Format: password
+	Password *strfmt.Password `json:"password,omitempty"`
+
+	// The user authenticating to the BMC.
+	Username *string `json:"username,omitempty"`
+}
+
+// Validate validates this bmc host update params
+func (m *BmcHostUpdateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BmcHostUpdateParams) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BmcHostUpdateParams) validatePassword(formats strfmt.Registry) error {
+	if swag.IsZero(m.Password) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("password", "body", "password", m.Password.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bmc host update params based on context it is used
+func (m *BmcHostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcHostUpdateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcHostUpdateParams) UnmarshalBinary(b []byte) error {
+	var res BmcHostUpdateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BmcPowerActionParams bmc power action params
+//
+// swagger:model bmc-power-action-params
+type BmcPowerActionParams struct {
+
+	// The power action. off shuts the host down gracefully, force-off cuts its power.
+	// Required: true
+	// Enum: [on off force-off restart force-restart]
+	Action *string `json:"action"`
+}
+
+// Validate validates this bmc power action params
+func (m *BmcPowerActionParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var bmcPowerActionParamsTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["on","off","force-off","restart","force-restart"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bmcPowerActionParamsTypeActionPropEnum = append(bmcPowerActionParamsTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// BmcPowerActionParamsActionOn captures enum value "on"
+	BmcPowerActionParamsActionOn string = "on"
+
+	// BmcPowerActionParamsActionOff captures enum value "off"
+	BmcPowerActionParamsActionOff string = "off"
+
+	// BmcPowerActionParamsActionForceOff captures enum value "force-off"
+	BmcPowerActionParamsActionForceOff string = "force-off"
+
+	// BmcPowerActionParamsActionRestart captures enum value "restart"
+	BmcPowerActionParamsActionRestart string = "restart"
+
+	// BmcPowerActionParamsActionForceRestart captures enum value "force-restart"
+	BmcPowerActionParamsActionForceRestart string = "force-restart"
+)
+
+// prop value enum
+func (m *BmcPowerActionParams) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bmcPowerActionParamsTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BmcPowerActionParams) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this bmc power action params based on context it is used
+func (m *BmcPowerActionParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BmcPowerActionParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BmcPowerActionParams) UnmarshalBinary(b []byte) error {
+	var res BmcPowerActionParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
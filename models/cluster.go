@@ -70,6 +70,9 @@ type Cluster struct {
 	// Format: date-time
 	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// Json containing the validations defined by the user that the hosts of the cluster must pass.
+	CustomHostValidations string `json:"custom_host_validations,omitempty" gorm:"type:text"`
+
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
@@ -94,6 +97,12 @@ type Cluster struct {
 	// List of host networks to be filled during query.
 	HostNetworks []*HostNetwork `json:"host_networks" gorm:"-"`
 
+	// Json containing the rules assigning roles, installation disks and node labels to the hosts of the cluster.
+	HostRoleRules string `json:"host_role_rules,omitempty" gorm:"type:text"`
+
+	// Json containing the timeouts of the installation stages of the hosts and the remediations of the hosts stuck in a stage.
+	HostStageTimeoutPolicies string `json:"host_stage_timeout_policies,omitempty" gorm:"type:text"`
+
 	// Hosts that are associated with this cluster.
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:ClusterID;references:ID"`
 
@@ -123,6 +132,9 @@ type Cluster struct {
 	// Explicit ignition endpoint overrides the default ignition endpoint.
 	IgnitionEndpoint *IgnitionEndpoint `json:"ignition_endpoint,omitempty" gorm:"embedded;embeddedPrefix:ignition_endpoint_"`
 
+	// Json containing the ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.
+	IgnitionOverrideLayers string `json:"ignition_override_layers,omitempty" gorm:"type:text"`
+
 	// Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string "all" to ignore all cluster validations. Some validations cannot be ignored.
 	IgnoredClusterValidations string `json:"ignored_cluster_validations,omitempty" gorm:"type:text"`
 
@@ -259,6 +271,9 @@ type Cluster struct {
 	// All hosts associated to this cluster.
 	TotalHostCount int64 `json:"total_host_count,omitempty" gorm:"-"`
 
+	// The number of known failures found in the logs uploaded for the cluster, set by v2GetCluster only.
+	Triage *TriageSummary `json:"triage,omitempty" gorm:"-"`
+
 	// The last time that this cluster was updated.
 	// Format: date-time
 	UpdatedAt timeext.Time `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -424,6 +439,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTriage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1250,6 +1269,25 @@ func (m *Cluster) validateStatusUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateTriage(formats strfmt.Registry) error {
+	if swag.IsZero(m.Triage) { // not required
+		return nil
+	}
+
+	if m.Triage != nil {
+		if err := m.Triage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateUpdatedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.UpdatedAt) { // not required
 		return nil
@@ -1326,6 +1364,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTriage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -1600,6 +1642,22 @@ func (m *Cluster) contextValidateServiceNetworks(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Cluster) contextValidateTriage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Triage != nil {
+		if err := m.Triage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("triage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("triage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Cluster) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -46,6 +46,9 @@ type ClusterCreateParams struct {
 	// Enum: [x86_64 aarch64 arm64 ppc64le s390x multi]
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
+	// Validations defined by the user that the hosts of the cluster must pass.
+	CustomHostValidations []*CustomHostValidation `json:"custom_host_validations"`
+
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
@@ -55,6 +58,12 @@ type ClusterCreateParams struct {
 	// Enum: [Full None]
 	HighAvailabilityMode *string `json:"high_availability_mode,omitempty"`
 
+	// Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies.
+	HostRoleRules []*HostRoleRule `json:"host_role_rules"`
+
+	// Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage.
+	HostStageTimeoutPolicies []*HostStageTimeoutPolicy `json:"host_stage_timeout_policies"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -72,6 +81,9 @@ type ClusterCreateParams struct {
 	// Explicit ignition endpoint overrides the default ignition endpoint.
 	IgnitionEndpoint *IgnitionEndpoint `json:"ignition_endpoint,omitempty" gorm:"embedded;embeddedPrefix:ignition_endpoint_"`
 
+	// Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.
+	IgnitionOverrideLayers []*IgnitionOverrideLayer `json:"ignition_override_layers"`
+
 	// The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	IngressVips []*IngressVip `json:"ingress_vips"`
 
@@ -155,6 +167,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCustomHostValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDiskEncryption(formats); err != nil {
 		res = append(res, err)
 	}
@@ -163,6 +179,14 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostRoleRules(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostStageTimeoutPolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHyperthreading(formats); err != nil {
 		res = append(res, err)
 	}
@@ -171,6 +195,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIgnitionOverrideLayers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIngressVips(formats); err != nil {
 		res = append(res, err)
 	}
@@ -351,6 +379,32 @@ func (m *ClusterCreateParams) validateCPUArchitecture(formats strfmt.Registry) e
 	return nil
 }
 
+func (m *ClusterCreateParams) validateCustomHostValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.CustomHostValidations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+		if swag.IsZero(m.CustomHostValidations[i]) { // not required
+			continue
+		}
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateDiskEncryption(formats strfmt.Registry) error {
 	if swag.IsZero(m.DiskEncryption) { // not required
 		return nil
@@ -412,6 +466,58 @@ func (m *ClusterCreateParams) validateHighAvailabilityMode(formats strfmt.Regist
 	return nil
 }
 
+func (m *ClusterCreateParams) validateHostRoleRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRoleRules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+		if swag.IsZero(m.HostRoleRules[i]) { // not required
+			continue
+		}
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateHostStageTimeoutPolicies(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostStageTimeoutPolicies) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostStageTimeoutPolicies); i++ {
+		if swag.IsZero(m.HostStageTimeoutPolicies[i]) { // not required
+			continue
+		}
+
+		if m.HostStageTimeoutPolicies[i] != nil {
+			if err := m.HostStageTimeoutPolicies[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeHyperthreadingPropEnum []interface{}
 
 func init() {
@@ -479,6 +585,32 @@ func (m *ClusterCreateParams) validateIgnitionEndpoint(formats strfmt.Registry)
 	return nil
 }
 
+func (m *ClusterCreateParams) validateIgnitionOverrideLayers(formats strfmt.Registry) error {
+	if swag.IsZero(m.IgnitionOverrideLayers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.IgnitionOverrideLayers); i++ {
+		if swag.IsZero(m.IgnitionOverrideLayers[i]) { // not required
+			continue
+		}
+
+		if m.IgnitionOverrideLayers[i] != nil {
+			if err := m.IgnitionOverrideLayers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateIngressVips(formats strfmt.Registry) error {
 	if swag.IsZero(m.IngressVips) { // not required
 		return nil
@@ -703,14 +835,30 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateCustomHostValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDiskEncryption(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHostRoleRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHostStageTimeoutPolicies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateIgnitionOverrideLayers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIngressVips(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -777,6 +925,26 @@ func (m *ClusterCreateParams) contextValidateClusterNetworks(ctx context.Context
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateCustomHostValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateDiskEncryption(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.DiskEncryption != nil {
@@ -793,6 +961,46 @@ func (m *ClusterCreateParams) contextValidateDiskEncryption(ctx context.Context,
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateHostRoleRules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) contextValidateHostStageTimeoutPolicies(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.HostStageTimeoutPolicies); i++ {
+
+		if m.HostStageTimeoutPolicies[i] != nil {
+			if err := m.HostStageTimeoutPolicies[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
@@ -809,6 +1017,26 @@ func (m *ClusterCreateParams) contextValidateIgnitionEndpoint(ctx context.Contex
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateIgnitionOverrideLayers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.IgnitionOverrideLayers); i++ {
+
+		if m.IgnitionOverrideLayers[i] != nil {
+			if err := m.IgnitionOverrideLayers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateIngressVips(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.IngressVips); i++ {
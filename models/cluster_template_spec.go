@@ -0,0 +1,741 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterTemplateSpec The definition of the clusters created from a template.
+//
+// swagger:model cluster-template-spec
+type ClusterTemplateSpec struct {
+
+	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.
+	AdditionalNtpSource *string `json:"additional_ntp_source,omitempty"`
+
+	// Default base domain of the clusters, it can be overridden for each cluster.
+	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
+
+	// Cluster networks of the clusters.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
+	// The CPU architecture of the clusters (x86_64/arm64/etc).
+	// Enum: [x86_64 aarch64 arm64 ppc64le s390x multi]
+	CPUArchitecture string `json:"cpu_architecture,omitempty"`
+
+	// Manifests added to the clusters.
+	CustomManifests []*CreateManifestParams `json:"custom_manifests"`
+
+	// Guaranteed availability of the installed clusters.
+	// Enum: [Full None]
+	HighAvailabilityMode *string `json:"high_availability_mode,omitempty"`
+
+	// Rules assigning roles to the hosts of the clusters.
+	HostRoleRules []*HostRoleRule `json:"host_role_rules"`
+
+	// Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.
+	// Enum: [masters workers none all]
+	Hyperthreading *string `json:"hyperthreading,omitempty"`
+
+	// image type
+	ImageType ImageType `json:"image_type,omitempty"`
+
+	// Machine networks of the clusters.
+	MachineNetworks []*MachineNetwork `json:"machine_networks"`
+
+	// The desired network type used.
+	// Enum: [OpenShiftSDN OVNKubernetes]
+	NetworkType *string `json:"network_type,omitempty"`
+
+	// List of OLM operators to be installed.
+	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
+
+	// Version of the OpenShift clusters.
+	// Required: true
+	OpenshiftVersion *string `json:"openshift_version"`
+
+	// platform
+	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
+
+	// Schedule workloads on masters.
+	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
+
+	// Service networks of the clusters.
+	ServiceNetworks []*ServiceNetwork `json:"service_networks"`
+
+	// SSH public key for debugging OpenShift nodes.
+	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+
+	// Indicate if the networking is managed by the user.
+	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
+}
+
+// Validate validates this cluster template spec
+func (m *ClusterTemplateSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCPUArchitecture(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCustomManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHighAvailabilityMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostRoleRules(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHyperthreading(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateImageType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNetworkType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOlmOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOpenshiftVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePlatform(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateClusterNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var clusterTemplateSpecTypeCPUArchitecturePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["x86_64","aarch64","arm64","ppc64le","s390x","multi"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateSpecTypeCPUArchitecturePropEnum = append(clusterTemplateSpecTypeCPUArchitecturePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateSpecCPUArchitectureX8664 captures enum value "x86_64"
+	ClusterTemplateSpecCPUArchitectureX8664 string = "x86_64"
+
+	// ClusterTemplateSpecCPUArchitectureAarch64 captures enum value "aarch64"
+	ClusterTemplateSpecCPUArchitectureAarch64 string = "aarch64"
+
+	// ClusterTemplateSpecCPUArchitectureArm64 captures enum value "arm64"
+	ClusterTemplateSpecCPUArchitectureArm64 string = "arm64"
+
+	// ClusterTemplateSpecCPUArchitecturePpc64le captures enum value "ppc64le"
+	ClusterTemplateSpecCPUArchitecturePpc64le string = "ppc64le"
+
+	// ClusterTemplateSpecCPUArchitectureS390x captures enum value "s390x"
+	ClusterTemplateSpecCPUArchitectureS390x string = "s390x"
+
+	// ClusterTemplateSpecCPUArchitectureMulti captures enum value "multi"
+	ClusterTemplateSpecCPUArchitectureMulti string = "multi"
+)
+
+// prop value enum
+func (m *ClusterTemplateSpec) validateCPUArchitectureEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateSpecTypeCPUArchitecturePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateCPUArchitecture(formats strfmt.Registry) error {
+	if swag.IsZero(m.CPUArchitecture) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCPUArchitectureEnum("cpu_architecture", "body", m.CPUArchitecture); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateCustomManifests(formats strfmt.Registry) error {
+	if swag.IsZero(m.CustomManifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CustomManifests); i++ {
+		if swag.IsZero(m.CustomManifests[i]) { // not required
+			continue
+		}
+
+		if m.CustomManifests[i] != nil {
+			if err := m.CustomManifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var clusterTemplateSpecTypeHighAvailabilityModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Full","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateSpecTypeHighAvailabilityModePropEnum = append(clusterTemplateSpecTypeHighAvailabilityModePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateSpecHighAvailabilityModeFull captures enum value "Full"
+	ClusterTemplateSpecHighAvailabilityModeFull string = "Full"
+
+	// ClusterTemplateSpecHighAvailabilityModeNone captures enum value "None"
+	ClusterTemplateSpecHighAvailabilityModeNone string = "None"
+)
+
+// prop value enum
+func (m *ClusterTemplateSpec) validateHighAvailabilityModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateSpecTypeHighAvailabilityModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateHighAvailabilityMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.HighAvailabilityMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHighAvailabilityModeEnum("high_availability_mode", "body", *m.HighAvailabilityMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateHostRoleRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRoleRules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+		if swag.IsZero(m.HostRoleRules[i]) { // not required
+			continue
+		}
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var clusterTemplateSpecTypeHyperthreadingPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["masters","workers","none","all"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateSpecTypeHyperthreadingPropEnum = append(clusterTemplateSpecTypeHyperthreadingPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateSpecHyperthreadingMasters captures enum value "masters"
+	ClusterTemplateSpecHyperthreadingMasters string = "masters"
+
+	// ClusterTemplateSpecHyperthreadingWorkers captures enum value "workers"
+	ClusterTemplateSpecHyperthreadingWorkers string = "workers"
+
+	// ClusterTemplateSpecHyperthreadingNone captures enum value "none"
+	ClusterTemplateSpecHyperthreadingNone string = "none"
+
+	// ClusterTemplateSpecHyperthreadingAll captures enum value "all"
+	ClusterTemplateSpecHyperthreadingAll string = "all"
+)
+
+// prop value enum
+func (m *ClusterTemplateSpec) validateHyperthreadingEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateSpecTypeHyperthreadingPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateHyperthreading(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hyperthreading) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHyperthreadingEnum("hyperthreading", "body", *m.Hyperthreading); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateImageType(formats strfmt.Registry) error {
+	if swag.IsZero(m.ImageType) { // not required
+		return nil
+	}
+
+	if err := m.ImageType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("image_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("image_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateMachineNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+		if swag.IsZero(m.MachineNetworks[i]) { // not required
+			continue
+		}
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var clusterTemplateSpecTypeNetworkTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OpenShiftSDN","OVNKubernetes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTemplateSpecTypeNetworkTypePropEnum = append(clusterTemplateSpecTypeNetworkTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterTemplateSpecNetworkTypeOpenShiftSDN captures enum value "OpenShiftSDN"
+	ClusterTemplateSpecNetworkTypeOpenShiftSDN string = "OpenShiftSDN"
+
+	// ClusterTemplateSpecNetworkTypeOVNKubernetes captures enum value "OVNKubernetes"
+	ClusterTemplateSpecNetworkTypeOVNKubernetes string = "OVNKubernetes"
+)
+
+// prop value enum
+func (m *ClusterTemplateSpec) validateNetworkTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTemplateSpecTypeNetworkTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateNetworkType(formats strfmt.Registry) error {
+	if swag.IsZero(m.NetworkType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNetworkTypeEnum("network_type", "body", *m.NetworkType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateOlmOperators(formats strfmt.Registry) error {
+	if swag.IsZero(m.OlmOperators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OlmOperators); i++ {
+		if swag.IsZero(m.OlmOperators[i]) { // not required
+			continue
+		}
+
+		if m.OlmOperators[i] != nil {
+			if err := m.OlmOperators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateOpenshiftVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("openshift_version", "body", m.OpenshiftVersion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validatePlatform(formats strfmt.Registry) error {
+	if swag.IsZero(m.Platform) { // not required
+		return nil
+	}
+
+	if m.Platform != nil {
+		if err := m.Platform.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("platform")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("platform")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) validateServiceNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+		if swag.IsZero(m.ServiceNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster template spec based on the context it is used
+func (m *ClusterTemplateSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCustomManifests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHostRoleRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateImageType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMachineNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOlmOperators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePlatform(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateServiceNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateCustomManifests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CustomManifests); i++ {
+
+		if m.CustomManifests[i] != nil {
+			if err := m.CustomManifests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateHostRoleRules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("image_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("image_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateMachineNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateOlmOperators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.OlmOperators); i++ {
+
+		if m.OlmOperators[i] != nil {
+			if err := m.OlmOperators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("olm_operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidatePlatform(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Platform != nil {
+		if err := m.Platform.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("platform")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("platform")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateSpec) contextValidateServiceNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplateSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplateSpec) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplateSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -101,6 +101,12 @@ const (
 
 	// ClusterValidationIDPlatformRequirementsSatisfied captures enum value "platform-requirements-satisfied"
 	ClusterValidationIDPlatformRequirementsSatisfied ClusterValidationID = "platform-requirements-satisfied"
+
+	// ClusterValidationIDSufficientPathMtu captures enum value "sufficient-path-mtu"
+	ClusterValidationIDSufficientPathMtu ClusterValidationID = "sufficient-path-mtu"
+
+	// ClusterValidationIDOperatorPluginsRequirementsSatisfied captures enum value "operator-plugins-requirements-satisfied"
+	ClusterValidationIDOperatorPluginsRequirementsSatisfied ClusterValidationID = "operator-plugins-requirements-satisfied"
 )
 
 // for schema
@@ -108,7 +114,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","network-type-valid","platform-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","network-type-valid","platform-requirements-satisfied","sufficient-path-mtu","operator-plugins-requirements-satisfied"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CustomHostValidation A validation of the hosts defined by the user, evaluated over the inventory of the hosts. A failing custom validation prevents the installation like the built-in validations do.
+//
+// swagger:model custom-host-validation
+type CustomHostValidation struct {
+
+	// A description of the requirement, reported when the validation fails.
+	Description string `json:"description,omitempty"`
+
+	// The expression evaluated over the inventory of the hosts, e.g. 'inventory.cpu.count >= 16' or '{.interfaces[?(@.vendor=="0x8086")].name}'.
+	// Required: true
+	Expression *string `json:"expression"`
+
+	// The ID of the validation, reported in the validations info of the hosts in the custom category. It must start with custom-, so that it can't be taken for a built-in validation.
+	// Required: true
+	// Max Length: 63
+	// Pattern: ^custom-[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	ID *string `json:"id"`
+
+	// The language of the expression. A CEL expression evaluates to a boolean over the 'inventory' and 'role' variables. A JSONPath expression selects values of the inventory and succeeds when it selects at least one value and none of the selected values is false.
+	// Required: true
+	// Enum: [cel jsonpath]
+	Language *string `json:"language"`
+}
+
+// Validate validates this custom host validation
+func (m *CustomHostValidation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpression(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLanguage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CustomHostValidation) validateExpression(formats strfmt.Registry) error {
+
+	if err := validate.Required("expression", "body", m.Expression); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CustomHostValidation) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("id", "body", *m.ID, 63); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("id", "body", *m.ID, `^custom-[a-z0-9]([-a-z0-9]*[a-z0-9])?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var customHostValidationTypeLanguagePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cel","jsonpath"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		customHostValidationTypeLanguagePropEnum = append(customHostValidationTypeLanguagePropEnum, v)
+	}
+}
+
+const (
+
+	// CustomHostValidationLanguageCel captures enum value "cel"
+	CustomHostValidationLanguageCel string = "cel"
+
+	// CustomHostValidationLanguageJsonpath captures enum value "jsonpath"
+	CustomHostValidationLanguageJsonpath string = "jsonpath"
+)
+
+// prop value enum
+func (m *CustomHostValidation) validateLanguageEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, customHostValidationTypeLanguagePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CustomHostValidation) validateLanguage(formats strfmt.Registry) error {
+
+	if err := validate.Required("language", "body", m.Language); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateLanguageEnum("language", "body", *m.Language); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this custom host validation based on context it is used
+func (m *CustomHostValidation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CustomHostValidation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CustomHostValidation) UnmarshalBinary(b []byte) error {
+	var res CustomHostValidation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DecommissionParams decommission params
+//
+// swagger:model decommission-params
+type DecommissionParams struct {
+
+	// Wipe the disks of the host once its node is removed. The disks are wiped by the agent of the host, which must be running, e.g. by booting the host with the discovery image.
+	WipeDisks *bool `json:"wipe_disks,omitempty"`
+}
+
+// Validate validates this decommission params
+func (m *DecommissionParams) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this decommission params based on context it is used
+func (m *DecommissionParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DecommissionParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DecommissionParams) UnmarshalBinary(b []byte) error {
+	var res DecommissionParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DryRunGenerateFailure dry run generate failure
+//
+// swagger:model dry-run-generate-failure
+type DryRunGenerateFailure struct {
+
+	// The manifest which failed validation, for the custom-manifests stage.
+	FileName string `json:"file_name,omitempty"`
+
+	// The reason of the failure.
+	// Required: true
+	Message *string `json:"message"`
+
+	// stage
+	// Required: true
+	Stage *DryRunGenerateStage `json:"stage"`
+}
+
+// Validate validates this dry run generate failure
+func (m *DryRunGenerateFailure) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunGenerateFailure) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DryRunGenerateFailure) validateStage(formats strfmt.Registry) error {
+
+	if err := validate.Required("stage", "body", m.Stage); err != nil {
+		return err
+	}
+
+	if err := validate.Required("stage", "body", m.Stage); err != nil {
+		return err
+	}
+
+	if m.Stage != nil {
+		if err := m.Stage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("stage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("stage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dry run generate failure based on the context it is used
+func (m *DryRunGenerateFailure) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateStage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunGenerateFailure) contextValidateStage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Stage != nil {
+		if err := m.Stage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("stage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("stage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DryRunGenerateFailure) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DryRunGenerateFailure) UnmarshalBinary(b []byte) error {
+	var res DryRunGenerateFailure
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
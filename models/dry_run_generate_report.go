@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DryRunGenerateReport dry run generate report
+//
+// swagger:model dry-run-generate-report
+type DryRunGenerateReport struct {
+
+	// cluster id
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"primaryKey"`
+
+	// completed at
+	// Format: date-time
+	CompletedAt strfmt.DateTime `json:"completed_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// failures
+	Failures []*DryRunGenerateFailure `json:"failures" gorm:"type:text;serializer:json"`
+
+	// The generated files, relative to the storage prefix.
+	Objects []string `json:"objects" gorm:"type:text;serializer:json"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The status of the dry run. An interrupted dry run didn't complete, for example because the service restarted, and can be started again.
+	// Required: true
+	// Enum: [running completed interrupted]
+	Status *string `json:"status"`
+
+	// The reason why the dry run was interrupted.
+	StatusInfo string `json:"status_info,omitempty" gorm:"type:text"`
+
+	// The storage prefix under which the generated files are stored.
+	// Required: true
+	StoragePrefix *string `json:"storage_prefix"`
+
+	// Whether every stage of the generation succeeded, once the dry run is completed.
+	Succeeded bool `json:"succeeded,omitempty"`
+
+	// The number of synthetic hosts which completed the hosts of the cluster.
+	SyntheticHostsCount int64 `json:"synthetic_hosts_count,omitempty"`
+}
+
+// Validate validates this dry run generate report
+func (m *DryRunGenerateReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailures(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStoragePrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completed_at", "body", "date-time", m.CompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateFailures(formats strfmt.Registry) error {
+	if swag.IsZero(m.Failures) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Failures); i++ {
+		if swag.IsZero(m.Failures[i]) { // not required
+			continue
+		}
+
+		if m.Failures[i] != nil {
+			if err := m.Failures[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("failures" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("failures" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dryRunGenerateReportTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","completed","interrupted"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dryRunGenerateReportTypeStatusPropEnum = append(dryRunGenerateReportTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// DryRunGenerateReportStatusRunning captures enum value "running"
+	DryRunGenerateReportStatusRunning string = "running"
+
+	// DryRunGenerateReportStatusCompleted captures enum value "completed"
+	DryRunGenerateReportStatusCompleted string = "completed"
+
+	// DryRunGenerateReportStatusInterrupted captures enum value "interrupted"
+	DryRunGenerateReportStatusInterrupted string = "interrupted"
+)
+
+// prop value enum
+func (m *DryRunGenerateReport) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dryRunGenerateReportTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DryRunGenerateReport) validateStoragePrefix(formats strfmt.Registry) error {
+
+	if err := validate.Required("storage_prefix", "body", m.StoragePrefix); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dry run generate report based on the context it is used
+func (m *DryRunGenerateReport) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFailures(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunGenerateReport) contextValidateFailures(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Failures); i++ {
+
+		if m.Failures[i] != nil {
+			if err := m.Failures[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("failures" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("failures" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DryRunGenerateReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DryRunGenerateReport) UnmarshalBinary(b []byte) error {
+	var res DryRunGenerateReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
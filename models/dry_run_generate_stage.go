@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// DryRunGenerateStage A step of the installation files generation.
+//
+// swagger:model dry-run-generate-stage
+type DryRunGenerateStage string
+
+func NewDryRunGenerateStage(value DryRunGenerateStage) *DryRunGenerateStage {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated DryRunGenerateStage.
+func (m DryRunGenerateStage) Pointer() *DryRunGenerateStage {
+	return &m
+}
+
+const (
+
+	// DryRunGenerateStageCustomManifests captures enum value "custom-manifests"
+	DryRunGenerateStageCustomManifests DryRunGenerateStage = "custom-manifests"
+
+	// DryRunGenerateStageAdditionalManifests captures enum value "additional-manifests"
+	DryRunGenerateStageAdditionalManifests DryRunGenerateStage = "additional-manifests"
+
+	// DryRunGenerateStageInstallConfig captures enum value "install-config"
+	DryRunGenerateStageInstallConfig DryRunGenerateStage = "install-config"
+
+	// DryRunGenerateStageIgnition captures enum value "ignition"
+	DryRunGenerateStageIgnition DryRunGenerateStage = "ignition"
+)
+
+// for schema
+var dryRunGenerateStageEnum []interface{}
+
+func init() {
+	var res []DryRunGenerateStage
+	if err := json.Unmarshal([]byte(`["custom-manifests","additional-manifests","install-config","ignition"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dryRunGenerateStageEnum = append(dryRunGenerateStageEnum, v)
+	}
+}
+
+func (m DryRunGenerateStage) validateDryRunGenerateStageEnum(path, location string, value DryRunGenerateStage) error {
+	if err := validate.EnumCase(path, location, value, dryRunGenerateStageEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this dry run generate stage
+func (m DryRunGenerateStage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateDryRunGenerateStageEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this dry run generate stage based on context it is used
+func (m DryRunGenerateStage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -47,6 +47,13 @@ type Host struct {
 	// Format: date-time
 	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// Additional information about the decommission stage of the host.
+	DecommissionInfo string `json:"decommission_info,omitempty" gorm:"type:varchar(2048)"`
+
+	// The stage of the decommission of the host, or empty when the host isn't decommissioned.
+	// Enum: [draining deleting-node wiping-disks completed failed]
+	DecommissionStage string `json:"decommission_stage,omitempty"`
+
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
@@ -133,6 +140,9 @@ type Host struct {
 	// Enum: [connected disconnected]
 	MediaStatus *string `json:"media_status,omitempty"`
 
+	// Contains a serialized mtu_path_check_response
+	MtuPathReport string `json:"mtu_path_report,omitempty" gorm:"type:text"`
+
 	// Json containing node's labels.
 	NodeLabels string `json:"node_labels,omitempty" gorm:"type:text"`
 
@@ -216,6 +226,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDecommissionStage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -334,6 +348,57 @@ func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+var hostTypeDecommissionStagePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["draining","deleting-node","wiping-disks","completed","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostTypeDecommissionStagePropEnum = append(hostTypeDecommissionStagePropEnum, v)
+	}
+}
+
+const (
+
+	// HostDecommissionStageDraining captures enum value "draining"
+	HostDecommissionStageDraining string = "draining"
+
+	// HostDecommissionStageDeletingNode captures enum value "deleting-node"
+	HostDecommissionStageDeletingNode string = "deleting-node"
+
+	// HostDecommissionStageWipingDisks captures enum value "wiping-disks"
+	HostDecommissionStageWipingDisks string = "wiping-disks"
+
+	// HostDecommissionStageCompleted captures enum value "completed"
+	HostDecommissionStageCompleted string = "completed"
+
+	// HostDecommissionStageFailed captures enum value "failed"
+	HostDecommissionStageFailed string = "failed"
+)
+
+// prop value enum
+func (m *Host) validateDecommissionStageEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostTypeDecommissionStagePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Host) validateDecommissionStage(formats strfmt.Registry) error {
+	if swag.IsZero(m.DecommissionStage) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDecommissionStageEnum("decommission_stage", "body", m.DecommissionStage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -0,0 +1,261 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	timeext "time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostClaim host claim
+//
+// swagger:model host-claim
+type HostClaim struct {
+
+	// The number of masters of the cluster.
+	BoundMasters int64 `json:"bound_masters,omitempty"`
+
+	// The number of workers of the cluster.
+	BoundWorkers int64 `json:"bound_workers,omitempty"`
+
+	// The cluster the hosts are bound to.
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id" gorm:"primaryKey"`
+
+	// created at
+	// Format: date-time
+	CreatedAt timeext.Time `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// org id
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+
+	// The last time the scheduler served the claim.
+	// Format: date-time
+	ScheduledAt timeext.Time `json:"scheduled_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// spec
+	// Required: true
+	Spec *HostClaimSpec `json:"spec" gorm:"type:text;serializer:json"`
+
+	// pending while the cluster misses hosts, satisfied when it has all the requested hosts.
+	// Required: true
+	// Enum: [pending satisfied paused]
+	Status *string `json:"status"`
+
+	// The reason the claim is pending.
+	StatusInfo string `json:"status_info,omitempty" gorm:"type:text"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt timeext.Time `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// user name
+	UserName string `json:"user_name,omitempty" gorm:"index"`
+}
+
+// Validate validates this host claim
+func (m *HostClaim) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScheduledAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSpec(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostClaim) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostClaim) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostClaim) validateScheduledAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduled_at", "body", "date-time", m.ScheduledAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostClaim) validateSpec(formats strfmt.Registry) error {
+
+	if err := validate.Required("spec", "body", m.Spec); err != nil {
+		return err
+	}
+
+	if m.Spec != nil {
+		if err := m.Spec.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("spec")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("spec")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var hostClaimTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","satisfied","paused"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostClaimTypeStatusPropEnum = append(hostClaimTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// HostClaimStatusPending captures enum value "pending"
+	HostClaimStatusPending string = "pending"
+
+	// HostClaimStatusSatisfied captures enum value "satisfied"
+	HostClaimStatusSatisfied string = "satisfied"
+
+	// HostClaimStatusPaused captures enum value "paused"
+	HostClaimStatusPaused string = "paused"
+)
+
+// prop value enum
+func (m *HostClaim) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostClaimTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostClaim) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostClaim) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host claim based on the context it is used
+func (m *HostClaim) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSpec(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostClaim) contextValidateSpec(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Spec != nil {
+		if err := m.Spec.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("spec")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("spec")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostClaim) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostClaim) UnmarshalBinary(b []byte) error {
+	var res HostClaim
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
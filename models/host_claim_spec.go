@@ -0,0 +1,213 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostClaimSpec The hosts a cluster needs and the infra-envs they are taken from.
+//
+// swagger:model host-claim-spec
+type HostClaimSpec struct {
+
+	// The infra-envs whose unbound hosts can be bound to the cluster.
+	// Required: true
+	// Min Items: 1
+	InfraEnvIds []strfmt.UUID `json:"infra_env_ids"`
+
+	// masters
+	Masters *HostClaimRole `json:"masters,omitempty"`
+
+	// No host is bound by the claim while it is paused. The reserved hosts stay reserved.
+	Paused *bool `json:"paused,omitempty"`
+
+	// The claims with a higher priority are served first. The claims with the same priority are served in the order they were created.
+	Priority int64 `json:"priority,omitempty"`
+
+	// Hosts reserved for the cluster. They are only bound by this claim, and are bound before the other hosts matching the selectors.
+	ReservedHostIds []strfmt.UUID `json:"reserved_host_ids"`
+
+	// workers
+	Workers *HostClaimRole `json:"workers,omitempty"`
+}
+
+// Validate validates this host claim spec
+func (m *HostClaimSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInfraEnvIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMasters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReservedHostIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWorkers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostClaimSpec) validateInfraEnvIds(formats strfmt.Registry) error {
+
+	if err := validate.Required("infra_env_ids", "body", m.InfraEnvIds); err != nil {
+		return err
+	}
+
+	iInfraEnvIdsSize := int64(len(m.InfraEnvIds))
+
+	if err := validate.MinItems("infra_env_ids", "body", iInfraEnvIdsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.InfraEnvIds); i++ {
+
+		if err := validate.FormatOf("infra_env_ids"+"."+strconv.Itoa(i), "body", "uuid", m.InfraEnvIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostClaimSpec) validateMasters(formats strfmt.Registry) error {
+	if swag.IsZero(m.Masters) { // not required
+		return nil
+	}
+
+	if m.Masters != nil {
+		if err := m.Masters.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("masters")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("masters")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostClaimSpec) validateReservedHostIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReservedHostIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ReservedHostIds); i++ {
+
+		if err := validate.FormatOf("reserved_host_ids"+"."+strconv.Itoa(i), "body", "uuid", m.ReservedHostIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostClaimSpec) validateWorkers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Workers) { // not required
+		return nil
+	}
+
+	if m.Workers != nil {
+		if err := m.Workers.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("workers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("workers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host claim spec based on the context it is used
+func (m *HostClaimSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMasters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateWorkers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostClaimSpec) contextValidateMasters(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Masters != nil {
+		if err := m.Masters.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("masters")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("masters")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostClaimSpec) contextValidateWorkers(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Workers != nil {
+		if err := m.Workers.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("workers")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("workers")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostClaimSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostClaimSpec) UnmarshalBinary(b []byte) error {
+	var res HostClaimSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
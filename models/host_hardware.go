@@ -0,0 +1,252 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostHardware The hardware of a host, extracted from its inventory.
+//
+// swagger:model host-hardware
+type HostHardware struct {
+
+	// The cluster the host is bound to.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// cpu architecture
+	CPUArchitecture string `json:"cpu_architecture,omitempty"`
+
+	// cpu cores
+	CPUCores int64 `json:"cpu_cores,omitempty"`
+
+	// cpu model
+	CPUModel string `json:"cpu_model,omitempty"`
+
+	// disk count
+	DiskCount int64 `json:"disk_count,omitempty"`
+
+	// The types of the disks of the host.
+	DiskTypes []string `json:"disk_types"`
+
+	// disks total bytes
+	DisksTotalBytes int64 `json:"disks_total_bytes,omitempty"`
+
+	// host id
+	// Required: true
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id"`
+
+	// hostname
+	Hostname string `json:"hostname,omitempty"`
+
+	// infra env id
+	// Required: true
+	// Format: uuid
+	InfraEnvID *strfmt.UUID `json:"infra_env_id"`
+
+	// largest disk bytes
+	LargestDiskBytes int64 `json:"largest_disk_bytes,omitempty"`
+
+	// max nic speed mbps
+	MaxNicSpeedMbps int64 `json:"max_nic_speed_mbps,omitempty"`
+
+	// The physical memory of the host.
+	MemoryBytes int64 `json:"memory_bytes,omitempty"`
+
+	// nic count
+	NicCount int64 `json:"nic_count,omitempty"`
+
+	// The product name of the system.
+	Product string `json:"product,omitempty"`
+
+	// role
+	Role HostRole `json:"role,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+
+	// The manufacturer of the system.
+	Vendor string `json:"vendor,omitempty"`
+
+	// virtual
+	Virtual bool `json:"virtual,omitempty"`
+}
+
+// Validate validates this host hardware
+func (m *HostHardware) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDiskTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostHardware) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var hostHardwareDiskTypesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["nvme","ssd","hdd"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostHardwareDiskTypesItemsEnum = append(hostHardwareDiskTypesItemsEnum, v)
+	}
+}
+
+func (m *HostHardware) validateDiskTypesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostHardwareDiskTypesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostHardware) validateDiskTypes(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiskTypes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DiskTypes); i++ {
+
+		// value enum
+		if err := m.validateDiskTypesItemsEnum("disk_types"+"."+strconv.Itoa(i), "body", m.DiskTypes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostHardware) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.Required("host_id", "body", m.HostID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardware) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.Required("infra_env_id", "body", m.InfraEnvID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostHardware) validateRole(formats strfmt.Registry) error {
+	if swag.IsZero(m.Role) { // not required
+		return nil
+	}
+
+	if err := m.Role.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("role")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("role")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host hardware based on the context it is used
+func (m *HostHardware) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRole(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostHardware) contextValidateRole(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Role.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("role")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("role")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostHardware) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostHardware) UnmarshalBinary(b []byte) error {
+	var res HostHardware
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
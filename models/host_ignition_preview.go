@@ -0,0 +1,302 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostIgnitionPreview host ignition preview
+//
+// swagger:model host-ignition-preview
+type HostIgnitionPreview struct {
+
+	// The ignition the layers are applied to. The ignition generated for the role of the host, the ignition template of the hosts added to an installed cluster, or none when the ignition of the cluster wasn't generated yet.
+	// Required: true
+	// Enum: [generated template none]
+	Base *string `json:"base"`
+
+	// The files of the ignition and the layer which set them.
+	Files []*IgnitionProvenance `json:"files"`
+
+	// The rendered ignition of the host, in JSON.
+	// Required: true
+	Ignition *string `json:"ignition"`
+
+	// The pointer ignition of a host installed with its cluster, merging the configuration served by the machine config server, or the full ignition of a host added to an installed cluster.
+	// Required: true
+	// Enum: [pointer full]
+	IgnitionType *string `json:"ignition_type"`
+
+	// The layers applied in order, e.g. cluster, role/worker, machine-pool/infra and host.
+	Layers []string `json:"layers"`
+
+	// The systemd units of the ignition and the layer which set them.
+	Units []*IgnitionProvenance `json:"units"`
+}
+
+// Validate validates this host ignition preview
+func (m *HostIgnitionPreview) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBase(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFiles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIgnition(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIgnitionType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var hostIgnitionPreviewTypeBasePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["generated","template","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostIgnitionPreviewTypeBasePropEnum = append(hostIgnitionPreviewTypeBasePropEnum, v)
+	}
+}
+
+const (
+
+	// HostIgnitionPreviewBaseGenerated captures enum value "generated"
+	HostIgnitionPreviewBaseGenerated string = "generated"
+
+	// HostIgnitionPreviewBaseTemplate captures enum value "template"
+	HostIgnitionPreviewBaseTemplate string = "template"
+
+	// HostIgnitionPreviewBaseNone captures enum value "none"
+	HostIgnitionPreviewBaseNone string = "none"
+)
+
+// prop value enum
+func (m *HostIgnitionPreview) validateBaseEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostIgnitionPreviewTypeBasePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostIgnitionPreview) validateBase(formats strfmt.Registry) error {
+
+	if err := validate.Required("base", "body", m.Base); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateBaseEnum("base", "body", *m.Base); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostIgnitionPreview) validateFiles(formats strfmt.Registry) error {
+	if swag.IsZero(m.Files) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Files); i++ {
+		if swag.IsZero(m.Files[i]) { // not required
+			continue
+		}
+
+		if m.Files[i] != nil {
+			if err := m.Files[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostIgnitionPreview) validateIgnition(formats strfmt.Registry) error {
+
+	if err := validate.Required("ignition", "body", m.Ignition); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var hostIgnitionPreviewTypeIgnitionTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pointer","full"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostIgnitionPreviewTypeIgnitionTypePropEnum = append(hostIgnitionPreviewTypeIgnitionTypePropEnum, v)
+	}
+}
+
+const (
+
+	// HostIgnitionPreviewIgnitionTypePointer captures enum value "pointer"
+	HostIgnitionPreviewIgnitionTypePointer string = "pointer"
+
+	// HostIgnitionPreviewIgnitionTypeFull captures enum value "full"
+	HostIgnitionPreviewIgnitionTypeFull string = "full"
+)
+
+// prop value enum
+func (m *HostIgnitionPreview) validateIgnitionTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostIgnitionPreviewTypeIgnitionTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostIgnitionPreview) validateIgnitionType(formats strfmt.Registry) error {
+
+	if err := validate.Required("ignition_type", "body", m.IgnitionType); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateIgnitionTypeEnum("ignition_type", "body", *m.IgnitionType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostIgnitionPreview) validateUnits(formats strfmt.Registry) error {
+	if swag.IsZero(m.Units) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Units); i++ {
+		if swag.IsZero(m.Units[i]) { // not required
+			continue
+		}
+
+		if m.Units[i] != nil {
+			if err := m.Units[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("units" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("units" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host ignition preview based on the context it is used
+func (m *HostIgnitionPreview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFiles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateUnits(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostIgnitionPreview) contextValidateFiles(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Files); i++ {
+
+		if m.Files[i] != nil {
+			if err := m.Files[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("files" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("files" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostIgnitionPreview) contextValidateUnits(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Units); i++ {
+
+		if m.Units[i] != nil {
+			if err := m.Units[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("units" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("units" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostIgnitionPreview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostIgnitionPreview) UnmarshalBinary(b []byte) error {
+	var res HostIgnitionPreview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
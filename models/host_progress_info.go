@@ -28,6 +28,16 @@ type HostProgressInfo struct {
 	// progress info
 	ProgressInfo string `json:"progress_info,omitempty" gorm:"type:varchar(2048)"`
 
+	// stage remediation
+	StageRemediation HostStageRemediation `json:"stage_remediation,omitempty"`
+
+	// Time at which the remediation of the timeout policy of the current stage was last applied.
+	// Format: date-time
+	StageRemediationAt strfmt.DateTime `json:"stage_remediation_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The number of times the remediation of the timeout policy of the current stage was applied.
+	StageRemediationAttempts int64 `json:"stage_remediation_attempts,omitempty"`
+
 	// Time at which the current progress stage started.
 	// Format: date-time
 	StageStartedAt strfmt.DateTime `json:"stage_started_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -48,6 +58,14 @@ func (m *HostProgressInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStageRemediation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStageRemediationAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStageStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -79,6 +97,35 @@ func (m *HostProgressInfo) validateCurrentStage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostProgressInfo) validateStageRemediation(formats strfmt.Registry) error {
+	if swag.IsZero(m.StageRemediation) { // not required
+		return nil
+	}
+
+	if err := m.StageRemediation.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("stage_remediation")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("stage_remediation")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostProgressInfo) validateStageRemediationAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StageRemediationAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("stage_remediation_at", "body", "date-time", m.StageRemediationAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *HostProgressInfo) validateStageStartedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.StageStartedAt) { // not required
 		return nil
@@ -111,6 +158,10 @@ func (m *HostProgressInfo) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStageRemediation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -131,6 +182,20 @@ func (m *HostProgressInfo) contextValidateCurrentStage(ctx context.Context, form
 	return nil
 }
 
+func (m *HostProgressInfo) contextValidateStageRemediation(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.StageRemediation.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("stage_remediation")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("stage_remediation")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *HostProgressInfo) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,220 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostRoleRule Assigns a role, an installation disk and node labels to the hosts matching all the criteria of the rule. The rules only apply to hosts whose role is auto-assign.
+//
+// swagger:model host-role-rule
+type HostRoleRule struct {
+
+	// The BMC address of the matching hosts.
+	BmcAddress string `json:"bmc_address,omitempty"`
+
+	// A regular expression the hostname of the matching hosts must match.
+	HostnamePattern string `json:"hostname_pattern,omitempty"`
+
+	// A regular expression matched against the path, the by-id and the by-path names of the disks of the matching hosts. The first eligible disk that matches becomes the installation disk.
+	InstallationDiskPattern string `json:"installation_disk_pattern,omitempty"`
+
+	// The matching hosts must have an interface with one of these MAC addresses.
+	MacAddresses []string `json:"mac_addresses"`
+
+	// The minimal number of CPU cores of the matching hosts.
+	MinCPUCores int64 `json:"min_cpu_cores,omitempty"`
+
+	// The matching hosts must have a disk eligible for installation of at least this size, in GB.
+	MinDiskSizeGb int64 `json:"min_disk_size_gb,omitempty"`
+
+	// The minimal physical memory of the matching hosts, in MiB.
+	MinMemoryMib int64 `json:"min_memory_mib,omitempty"`
+
+	// A name identifying the rule.
+	Name string `json:"name,omitempty"`
+
+	// The matching hosts must have an interface of this vendor.
+	NicVendor string `json:"nic_vendor,omitempty"`
+
+	// Labels added to the nodes of the matching hosts.
+	NodeLabels []*NodeLabelParams `json:"node_labels"`
+
+	// The role assigned to the matching hosts.
+	// Required: true
+	// Enum: [master worker]
+	Role *string `json:"role"`
+
+	// The serial number of the matching hosts.
+	SerialNumber string `json:"serial_number,omitempty"`
+}
+
+// Validate validates this host role rule
+func (m *HostRoleRule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMacAddresses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodeLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostRoleRule) validateMacAddresses(formats strfmt.Registry) error {
+	if swag.IsZero(m.MacAddresses) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MacAddresses); i++ {
+
+		if err := validate.Pattern("mac_addresses"+"."+strconv.Itoa(i), "body", m.MacAddresses[i], `^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostRoleRule) validateNodeLabels(formats strfmt.Registry) error {
+	if swag.IsZero(m.NodeLabels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NodeLabels); i++ {
+		if swag.IsZero(m.NodeLabels[i]) { // not required
+			continue
+		}
+
+		if m.NodeLabels[i] != nil {
+			if err := m.NodeLabels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("node_labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("node_labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var hostRoleRuleTypeRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["master","worker"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostRoleRuleTypeRolePropEnum = append(hostRoleRuleTypeRolePropEnum, v)
+	}
+}
+
+const (
+
+	// HostRoleRuleRoleMaster captures enum value "master"
+	HostRoleRuleRoleMaster string = "master"
+
+	// HostRoleRuleRoleWorker captures enum value "worker"
+	HostRoleRuleRoleWorker string = "worker"
+)
+
+// prop value enum
+func (m *HostRoleRule) validateRoleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostRoleRuleTypeRolePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostRoleRule) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.Required("role", "body", m.Role); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateRoleEnum("role", "body", *m.Role); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host role rule based on the context it is used
+func (m *HostRoleRule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNodeLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostRoleRule) contextValidateNodeLabels(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NodeLabels); i++ {
+
+		if m.NodeLabels[i] != nil {
+			if err := m.NodeLabels[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("node_labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("node_labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostRoleRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostRoleRule) UnmarshalBinary(b []byte) error {
+	var res HostRoleRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,271 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostSelector Selects the hosts by their hardware. A host matches the selector when it meets all of its criteria. A selector without criteria matches every host.
+//
+// swagger:model host-selector
+type HostSelector struct {
+
+	// The CPU architecture of the hosts.
+	CPUArchitecture string `json:"cpu_architecture,omitempty"`
+
+	// The hosts have disks of all these types.
+	DiskTypes []string `json:"disk_types"`
+
+	// The maximal number of CPU cores.
+	// Minimum: 0
+	MaxCPUCores *int64 `json:"max_cpu_cores,omitempty"`
+
+	// The maximal physical memory in MiB.
+	// Minimum: 0
+	MaxMemoryMib *int64 `json:"max_memory_mib,omitempty"`
+
+	// The minimal number of CPU cores.
+	// Minimum: 0
+	MinCPUCores *int64 `json:"min_cpu_cores,omitempty"`
+
+	// The minimal number of disks.
+	// Minimum: 0
+	MinDiskCount *int64 `json:"min_disk_count,omitempty"`
+
+	// The minimal size in GB of the largest disk.
+	// Minimum: 0
+	MinDiskSizeGb *int64 `json:"min_disk_size_gb,omitempty"`
+
+	// The minimal physical memory in MiB.
+	// Minimum: 0
+	MinMemoryMib *int64 `json:"min_memory_mib,omitempty"`
+
+	// The minimal number of physical network interfaces.
+	// Minimum: 0
+	MinNicCount *int64 `json:"min_nic_count,omitempty"`
+
+	// The minimal speed in Mbps of the fastest physical network interface.
+	// Minimum: 0
+	MinNicSpeedMbps *int64 `json:"min_nic_speed_mbps,omitempty"`
+
+	// The product name of the hosts.
+	Product string `json:"product,omitempty"`
+
+	// The manufacturer of the hosts.
+	Vendor string `json:"vendor,omitempty"`
+
+	// Whether the hosts are virtual machines.
+	Virtual *bool `json:"virtual,omitempty"`
+}
+
+// Validate validates this host selector
+func (m *HostSelector) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDiskTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCPUCores(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxMemoryMib(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinCPUCores(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinDiskCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinDiskSizeGb(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinMemoryMib(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinNicCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinNicSpeedMbps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var hostSelectorDiskTypesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["nvme","ssd","hdd"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostSelectorDiskTypesItemsEnum = append(hostSelectorDiskTypesItemsEnum, v)
+	}
+}
+
+func (m *HostSelector) validateDiskTypesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostSelectorDiskTypesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostSelector) validateDiskTypes(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiskTypes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DiskTypes); i++ {
+
+		// value enum
+		if err := m.validateDiskTypesItemsEnum("disk_types"+"."+strconv.Itoa(i), "body", m.DiskTypes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMaxCPUCores(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCPUCores) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_cpu_cores", "body", *m.MaxCPUCores, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMaxMemoryMib(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxMemoryMib) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_memory_mib", "body", *m.MaxMemoryMib, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinCPUCores(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinCPUCores) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_cpu_cores", "body", *m.MinCPUCores, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinDiskCount(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinDiskCount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_disk_count", "body", *m.MinDiskCount, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinDiskSizeGb(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinDiskSizeGb) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_disk_size_gb", "body", *m.MinDiskSizeGb, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinMemoryMib(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinMemoryMib) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_memory_mib", "body", *m.MinMemoryMib, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinNicCount(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinNicCount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_nic_count", "body", *m.MinNicCount, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostSelector) validateMinNicSpeedMbps(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinNicSpeedMbps) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_nic_speed_mbps", "body", *m.MinNicSpeedMbps, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this host selector based on context it is used
+func (m *HostSelector) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostSelector) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostSelector) UnmarshalBinary(b []byte) error {
+	var res HostSelector
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// HostStageRemediation The remediation of a host stuck in an installation stage. fail moves the host to error. retry restarts the timeout of the stage so that the host can retry the step. reboot reboots the host via its BMC, only for the hosts managed by a BareMetalHost, and fails the other hosts. pause moves the host to installing-pending-user-action until the host reports progress again.
+//
+// swagger:model host-stage-remediation
+type HostStageRemediation string
+
+func NewHostStageRemediation(value HostStageRemediation) *HostStageRemediation {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated HostStageRemediation.
+func (m HostStageRemediation) Pointer() *HostStageRemediation {
+	return &m
+}
+
+const (
+
+	// HostStageRemediationFail captures enum value "fail"
+	HostStageRemediationFail HostStageRemediation = "fail"
+
+	// HostStageRemediationRetry captures enum value "retry"
+	HostStageRemediationRetry HostStageRemediation = "retry"
+
+	// HostStageRemediationReboot captures enum value "reboot"
+	HostStageRemediationReboot HostStageRemediation = "reboot"
+
+	// HostStageRemediationPause captures enum value "pause"
+	HostStageRemediationPause HostStageRemediation = "pause"
+)
+
+// for schema
+var hostStageRemediationEnum []interface{}
+
+func init() {
+	var res []HostStageRemediation
+	if err := json.Unmarshal([]byte(`["fail","retry","reboot","pause"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostStageRemediationEnum = append(hostStageRemediationEnum, v)
+	}
+}
+
+func (m HostStageRemediation) validateHostStageRemediationEnum(path, location string, value HostStageRemediation) error {
+	if err := validate.EnumCase(path, location, value, hostStageRemediationEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this host stage remediation
+func (m HostStageRemediation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateHostStageRemediationEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this host stage remediation based on context it is used
+func (m HostStageRemediation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,203 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostStageTimeoutPolicy The timeout of an installation stage of the hosts and the remediation applied to the hosts that stay in the stage longer than the timeout.
+//
+// swagger:model host-stage-timeout-policy
+type HostStageTimeoutPolicy struct {
+
+	// The number of times the retry and reboot remediations are applied to a host in the stage. The host fails when the stage times out again after the last attempt.
+	// Minimum: 1
+	MaxAttempts int64 `json:"max_attempts,omitempty"`
+
+	// remediation
+	// Required: true
+	Remediation *HostStageRemediation `json:"remediation"`
+
+	// stage
+	// Required: true
+	Stage *HostStage `json:"stage"`
+
+	// The timeout of the stage, in seconds. The timeout configured in the service for the stage applies when it is not set.
+	// Minimum: 60
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+}
+
+// Validate validates this host stage timeout policy
+func (m *HostStageTimeoutPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxAttempts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRemediation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeoutSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) validateMaxAttempts(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxAttempts) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_attempts", "body", m.MaxAttempts, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) validateRemediation(formats strfmt.Registry) error {
+
+	if err := validate.Required("remediation", "body", m.Remediation); err != nil {
+		return err
+	}
+
+	if err := validate.Required("remediation", "body", m.Remediation); err != nil {
+		return err
+	}
+
+	if m.Remediation != nil {
+		if err := m.Remediation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("remediation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("remediation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) validateStage(formats strfmt.Registry) error {
+
+	if err := validate.Required("stage", "body", m.Stage); err != nil {
+		return err
+	}
+
+	if err := validate.Required("stage", "body", m.Stage); err != nil {
+		return err
+	}
+
+	if m.Stage != nil {
+		if err := m.Stage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("stage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("stage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) validateTimeoutSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeoutSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("timeout_seconds", "body", m.TimeoutSeconds, 60, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host stage timeout policy based on the context it is used
+func (m *HostStageTimeoutPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRemediation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) contextValidateRemediation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Remediation != nil {
+		if err := m.Remediation.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("remediation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("remediation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *HostStageTimeoutPolicy) contextValidateStage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Stage != nil {
+		if err := m.Stage.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("stage")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("stage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostStageTimeoutPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostStageTimeoutPolicy) UnmarshalBinary(b []byte) error {
+	var res HostStageTimeoutPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
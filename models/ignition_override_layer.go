@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// IgnitionOverrideLayer ignition override layer
+//
+// swagger:model ignition-override-layer
+type IgnitionOverrideLayer struct {
+
+	// The ignition config merged into the ignition of the hosts, in JSON.
+	// Required: true
+	Config *string `json:"config"`
+
+	// The hosts whose ignition the layer applies to. The layers are applied in the order cluster, role, machine-pool, followed by the ignition config overrides of the host.
+	// Required: true
+	// Enum: [cluster role machine-pool]
+	Scope *string `json:"scope"`
+
+	// The role of the hosts (master or worker) for a role layer, the machine config pool of the hosts for a machine-pool layer. Unset for a cluster layer.
+	Target string `json:"target,omitempty"`
+}
+
+// Validate validates this ignition override layer
+func (m *IgnitionOverrideLayer) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScope(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IgnitionOverrideLayer) validateConfig(formats strfmt.Registry) error {
+
+	if err := validate.Required("config", "body", m.Config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var ignitionOverrideLayerTypeScopePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cluster","role","machine-pool"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ignitionOverrideLayerTypeScopePropEnum = append(ignitionOverrideLayerTypeScopePropEnum, v)
+	}
+}
+
+const (
+
+	// IgnitionOverrideLayerScopeCluster captures enum value "cluster"
+	IgnitionOverrideLayerScopeCluster string = "cluster"
+
+	// IgnitionOverrideLayerScopeRole captures enum value "role"
+	IgnitionOverrideLayerScopeRole string = "role"
+
+	// IgnitionOverrideLayerScopeMachinePool captures enum value "machine-pool"
+	IgnitionOverrideLayerScopeMachinePool string = "machine-pool"
+)
+
+// prop value enum
+func (m *IgnitionOverrideLayer) validateScopeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, ignitionOverrideLayerTypeScopePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *IgnitionOverrideLayer) validateScope(formats strfmt.Registry) error {
+
+	if err := validate.Required("scope", "body", m.Scope); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateScopeEnum("scope", "body", *m.Scope); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ignition override layer based on context it is used
+func (m *IgnitionOverrideLayer) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IgnitionOverrideLayer) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IgnitionOverrideLayer) UnmarshalBinary(b []byte) error {
+	var res IgnitionOverrideLayer
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
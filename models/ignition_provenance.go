@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// IgnitionProvenance ignition provenance
+//
+// swagger:model ignition-provenance
+type IgnitionProvenance struct {
+
+	// The layer which set the file or the unit last, base for the ignition the layers are applied to.
+	// Required: true
+	Layer *string `json:"layer"`
+
+	// The path of the file or the name of the systemd unit.
+	// Required: true
+	Name *string `json:"name"`
+}
+
+// Validate validates this ignition provenance
+func (m *IgnitionProvenance) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLayer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IgnitionProvenance) validateLayer(formats strfmt.Registry) error {
+
+	if err := validate.Required("layer", "body", m.Layer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *IgnitionProvenance) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ignition provenance based on context it is used
+func (m *IgnitionProvenance) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IgnitionProvenance) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IgnitionProvenance) UnmarshalBinary(b []byte) error {
+	var res IgnitionProvenance
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -43,6 +43,9 @@ type InfraEnv struct {
 	// Format: date-time
 	CreatedAt *timeext.Time `json:"created_at" gorm:"type:timestamp with time zone"`
 
+	// Json containing the validations defined by the user that the hosts of the infra-env must pass.
+	CustomHostValidations string `json:"custom_host_validations,omitempty" gorm:"type:text"`
+
 	// download url
 	DownloadURL string `json:"download_url,omitempty"`
 
@@ -39,6 +39,9 @@ type InfraEnvCreateParams struct {
 	// Enum: [x86_64 aarch64 arm64 ppc64le s390x]
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
+	// Validations defined by the user that the hosts of the infra-env must pass.
+	CustomHostValidations []*CustomHostValidation `json:"custom_host_validations"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -85,6 +88,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCustomHostValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -190,6 +197,32 @@ func (m *InfraEnvCreateParams) validateCPUArchitecture(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateCustomHostValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.CustomHostValidations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+		if swag.IsZero(m.CustomHostValidations[i]) { // not required
+			continue
+		}
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -291,6 +324,10 @@ func (m *InfraEnvCreateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateCustomHostValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -313,6 +350,26 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateCustomHostValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
@@ -27,6 +27,9 @@ type InfraEnvUpdateParams struct {
 	// Max Length: 65535
 	AdditionalTrustBundle *string `json:"additional_trust_bundle,omitempty"`
 
+	// Validations defined by the user that the hosts of the infra-env must pass. An empty list deletes the validations.
+	CustomHostValidations []*CustomHostValidation `json:"custom_host_validations"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -57,6 +60,10 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCustomHostValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -91,6 +98,32 @@ func (m *InfraEnvUpdateParams) validateAdditionalTrustBundle(formats strfmt.Regi
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateCustomHostValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.CustomHostValidations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+		if swag.IsZero(m.CustomHostValidations[i]) { // not required
+			continue
+		}
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -174,6 +207,10 @@ func (m *InfraEnvUpdateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateCustomHostValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -196,6 +233,26 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateCustomHostValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
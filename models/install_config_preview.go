@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallConfigPreview install config preview
+//
+// swagger:model install-config-preview
+type InstallConfigPreview struct {
+
+	// The paths of the fields changed by the overrides, e.g. networking.clusterNetwork[0].hostPrefix.
+	ChangedFields []string `json:"changed_fields"`
+
+	// The unified diff of the install config generated from the cluster without overrides to the install config with the overrides. Empty when the overrides don't change the install config.
+	// Required: true
+	Diff *string `json:"diff"`
+
+	// The install config of the cluster with the overrides applied, in YAML.
+	// Required: true
+	InstallConfig *string `json:"install_config"`
+
+	// The paths of the overridden fields which are set by the service from the cluster. Applying the overrides requires allow_service_owned_fields.
+	ServiceOwnedFields []string `json:"service_owned_fields"`
+}
+
+// Validate validates this install config preview
+func (m *InstallConfigPreview) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDiff(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallConfigPreview) validateDiff(formats strfmt.Registry) error {
+
+	if err := validate.Required("diff", "body", m.Diff); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallConfigPreview) validateInstallConfig(formats strfmt.Registry) error {
+
+	if err := validate.Required("install_config", "body", m.InstallConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this install config preview based on context it is used
+func (m *InstallConfigPreview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallConfigPreview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallConfigPreview) UnmarshalBinary(b []byte) error {
+	var res InstallConfigPreview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// InstallConfigPreviewParams install config preview params
+//
+// swagger:model install-config-preview-params
+type InstallConfigPreviewParams struct {
+
+	// The install config overrides, in JSON. The overrides of the cluster are previewed when unset.
+	InstallConfigParams *string `json:"install_config_params,omitempty"`
+}
+
+// Validate validates this install config preview params
+func (m *InstallConfigPreviewParams) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this install config preview params based on context it is used
+func (m *InstallConfigPreviewParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallConfigPreviewParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallConfigPreviewParams) UnmarshalBinary(b []byte) error {
+	var res InstallConfigPreviewParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,373 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimeline installation timeline
+//
+// swagger:model installation-timeline
+type InstallationTimeline struct {
+
+	// cluster id
+	// Required: true
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id"`
+
+	// The stages which determined the duration of the installation, in chronological order.
+	CriticalPath []*InstallationTimelineSegment `json:"critical_path"`
+
+	// The duration of the installation, up to now while the installation is in progress.
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// finalizing stages
+	FinalizingStages []*InstallationTimelineStage `json:"finalizing_stages"`
+
+	// generated at
+	// Format: date-time
+	GeneratedAt strfmt.DateTime `json:"generated_at,omitempty"`
+
+	// hosts
+	Hosts []*InstallationTimelineHost `json:"hosts"`
+
+	// The end of the installation, empty while the installation is in progress.
+	// Format: date-time
+	InstallCompletedAt strfmt.DateTime `json:"install_completed_at,omitempty"`
+
+	// install started at
+	// Format: date-time
+	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty"`
+
+	// operators
+	Operators []*InstallationTimelineOperator `json:"operators"`
+}
+
+// Validate validates this installation timeline
+func (m *InstallationTimeline) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCriticalPath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinalizingStages(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGeneratedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimeline) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_id", "body", m.ClusterID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateCriticalPath(formats strfmt.Registry) error {
+	if swag.IsZero(m.CriticalPath) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CriticalPath); i++ {
+		if swag.IsZero(m.CriticalPath[i]) { // not required
+			continue
+		}
+
+		if m.CriticalPath[i] != nil {
+			if err := m.CriticalPath[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateFinalizingStages(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinalizingStages) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.FinalizingStages); i++ {
+		if swag.IsZero(m.FinalizingStages[i]) { // not required
+			continue
+		}
+
+		if m.FinalizingStages[i] != nil {
+			if err := m.FinalizingStages[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("finalizing_stages" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("finalizing_stages" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateGeneratedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.GeneratedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("generated_at", "body", "date-time", m.GeneratedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateInstallCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallCompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_completed_at", "body", "date-time", m.InstallCompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateInstallStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallStartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_started_at", "body", "date-time", m.InstallStartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateOperators(formats strfmt.Registry) error {
+	if swag.IsZero(m.Operators) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Operators); i++ {
+		if swag.IsZero(m.Operators[i]) { // not required
+			continue
+		}
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this installation timeline based on the context it is used
+func (m *InstallationTimeline) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCriticalPath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFinalizingStages(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOperators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateCriticalPath(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CriticalPath); i++ {
+
+		if m.CriticalPath[i] != nil {
+			if err := m.CriticalPath[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateFinalizingStages(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.FinalizingStages); i++ {
+
+		if m.FinalizingStages[i] != nil {
+			if err := m.FinalizingStages[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("finalizing_stages" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("finalizing_stages" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateOperators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Operators); i++ {
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimeline) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimeline) UnmarshalBinary(b []byte) error {
+	var res InstallationTimeline
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,195 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimelineOperator installation timeline operator
+//
+// swagger:model installation-timeline-operator
+type InstallationTimelineOperator struct {
+
+	// duration seconds
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// The time the operator became available, empty while it isn't available.
+	// Format: date-time
+	EndedAt strfmt.DateTime `json:"ended_at,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// operator type
+	OperatorType OperatorType `json:"operator_type,omitempty"`
+
+	// The start of the finalizing stage waiting for the operator.
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// status
+	Status OperatorStatus `json:"status,omitempty"`
+
+	// timeout exceeded
+	TimeoutExceeded bool `json:"timeout_exceeded,omitempty"`
+
+	// timeout seconds
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+}
+
+// Validate validates this installation timeline operator
+func (m *InstallationTimelineOperator) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperatorType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimelineOperator) validateEndedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EndedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("ended_at", "body", "date-time", m.EndedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineOperator) validateOperatorType(formats strfmt.Registry) error {
+	if swag.IsZero(m.OperatorType) { // not required
+		return nil
+	}
+
+	if err := m.OperatorType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("operator_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("operator_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineOperator) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineOperator) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("status")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("status")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this installation timeline operator based on the context it is used
+func (m *InstallationTimelineOperator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOperatorType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimelineOperator) contextValidateOperatorType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.OperatorType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("operator_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("operator_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineOperator) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Status.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("status")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("status")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimelineOperator) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimelineOperator) UnmarshalBinary(b []byte) error {
+	var res InstallationTimelineOperator
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimelineSegment installation timeline segment
+//
+// swagger:model installation-timeline-segment
+type InstallationTimelineSegment struct {
+
+	// duration seconds
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// ended at
+	// Format: date-time
+	EndedAt strfmt.DateTime `json:"ended_at,omitempty"`
+
+	// kind
+	// Enum: [host finalizing operator]
+	Kind string `json:"kind,omitempty"`
+
+	// stage
+	Stage string `json:"stage,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// The hostname of the host, the name of the operator, or the cluster for the finalizing stages.
+	Subject string `json:"subject,omitempty"`
+}
+
+// Validate validates this installation timeline segment
+func (m *InstallationTimelineSegment) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimelineSegment) validateEndedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EndedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("ended_at", "body", "date-time", m.EndedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var installationTimelineSegmentTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["host","finalizing","operator"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		installationTimelineSegmentTypeKindPropEnum = append(installationTimelineSegmentTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// InstallationTimelineSegmentKindHost captures enum value "host"
+	InstallationTimelineSegmentKindHost string = "host"
+
+	// InstallationTimelineSegmentKindFinalizing captures enum value "finalizing"
+	InstallationTimelineSegmentKindFinalizing string = "finalizing"
+
+	// InstallationTimelineSegmentKindOperator captures enum value "operator"
+	InstallationTimelineSegmentKindOperator string = "operator"
+)
+
+// prop value enum
+func (m *InstallationTimelineSegment) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, installationTimelineSegmentTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InstallationTimelineSegment) validateKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineSegment) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this installation timeline segment based on context it is used
+func (m *InstallationTimelineSegment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimelineSegment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimelineSegment) UnmarshalBinary(b []byte) error {
+	var res InstallationTimelineSegment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
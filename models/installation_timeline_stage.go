@@ -0,0 +1,106 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimelineStage installation timeline stage
+//
+// swagger:model installation-timeline-stage
+type InstallationTimelineStage struct {
+
+	// The duration of the stage, up to now for the stage in progress.
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// The end of the stage, empty for the stage in progress.
+	// Format: date-time
+	EndedAt strfmt.DateTime `json:"ended_at,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// Whether the stage lasted longer than its timeout.
+	TimeoutExceeded bool `json:"timeout_exceeded,omitempty"`
+
+	// The configured timeout of the stage, 0 when the stage has no timeout.
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+}
+
+// Validate validates this installation timeline stage
+func (m *InstallationTimelineStage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimelineStage) validateEndedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EndedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("ended_at", "body", "date-time", m.EndedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimelineStage) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this installation timeline stage based on context it is used
+func (m *InstallationTimelineStage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimelineStage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimelineStage) UnmarshalBinary(b []byte) error {
+	var res InstallationTimelineStage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
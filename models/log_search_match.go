@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LogSearchMatch log search match
+//
+// swagger:model log-search-match
+type LogSearchMatch struct {
+
+	// The lines of the file following the matching line.
+	ContextAfter []string `json:"context_after"`
+
+	// The lines of the file preceding the matching line.
+	ContextBefore []string `json:"context_before"`
+
+	// The path of the file in the uploaded logs.
+	File string `json:"file,omitempty"`
+
+	// The host which uploaded the logs. Unset for the logs of the controller.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty"`
+
+	// The name of the host which uploaded the logs.
+	HostName string `json:"host_name,omitempty"`
+
+	// The number of the line in the file, starting at 1.
+	Line int64 `json:"line,omitempty"`
+
+	// logs type
+	LogsType LogsType `json:"logs_type,omitempty"`
+
+	// The matching line.
+	Text string `json:"text,omitempty"`
+
+	// The time of the line, or of the closest preceding line of the file with a time. Unset when the file has no time.
+	// Format: date-time
+	Timestamp *strfmt.DateTime `json:"timestamp,omitempty"`
+}
+
+// Validate validates this log search match
+func (m *LogSearchMatch) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogsType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogSearchMatch) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogSearchMatch) validateLogsType(formats strfmt.Registry) error {
+	if swag.IsZero(m.LogsType) { // not required
+		return nil
+	}
+
+	if err := m.LogsType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("logs_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("logs_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *LogSearchMatch) validateTimestamp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Timestamp) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("timestamp", "body", "date-time", m.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this log search match based on the context it is used
+func (m *LogSearchMatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLogsType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogSearchMatch) contextValidateLogsType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.LogsType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("logs_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("logs_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LogSearchMatch) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LogSearchMatch) UnmarshalBinary(b []byte) error {
+	var res LogSearchMatch
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
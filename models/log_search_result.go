@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LogSearchResult log search result
+//
+// swagger:model log-search-result
+type LogSearchResult struct {
+
+	// The matching lines, ordered by host, file and line.
+	// Required: true
+	Matches []*LogSearchMatch `json:"matches"`
+
+	// Whether more lines match the search than the returned ones.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Validate validates this log search result
+func (m *LogSearchResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatches(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogSearchResult) validateMatches(formats strfmt.Registry) error {
+
+	if err := validate.Required("matches", "body", m.Matches); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Matches); i++ {
+		if swag.IsZero(m.Matches[i]) { // not required
+			continue
+		}
+
+		if m.Matches[i] != nil {
+			if err := m.Matches[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("matches" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("matches" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this log search result based on the context it is used
+func (m *LogSearchResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMatches(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogSearchResult) contextValidateMatches(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Matches); i++ {
+
+		if m.Matches[i] != nil {
+			if err := m.Matches[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("matches" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("matches" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LogSearchResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LogSearchResult) UnmarshalBinary(b []byte) error {
+	var res LogSearchResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -24,7 +24,7 @@ type ManagedDomain struct {
 	Domain string `json:"domain,omitempty"`
 
 	// provider
-	// Enum: [route53]
+	// Enum: [route53 zonefile]
 	Provider string `json:"provider,omitempty"`
 }
 
@@ -46,7 +46,7 @@ var managedDomainTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["route53"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["route53","zonefile"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -58,6 +58,9 @@ const (
 
 	// ManagedDomainProviderRoute53 captures enum value "route53"
 	ManagedDomainProviderRoute53 string = "route53"
+
+	// ManagedDomainProviderZonefile captures enum value "zonefile"
+	ManagedDomainProviderZonefile string = "zonefile"
 )
 
 // prop value enum
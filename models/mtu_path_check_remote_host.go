@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// MtuPathCheckRemoteHost mtu path check remote host
+//
+// swagger:model mtu_path_check_remote_host
+type MtuPathCheckRemoteHost struct {
+
+	// host id
+	// Required: true
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id"`
+
+	// The addresses of the host in the machine networks.
+	// Required: true
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// Validate validates this mtu path check remote host
+func (m *MtuPathCheckRemoteHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIPAddresses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MtuPathCheckRemoteHost) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.Required("host_id", "body", m.HostID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MtuPathCheckRemoteHost) validateIPAddresses(formats strfmt.Registry) error {
+
+	if err := validate.Required("ip_addresses", "body", m.IPAddresses); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this mtu path check remote host based on context it is used
+func (m *MtuPathCheckRemoteHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MtuPathCheckRemoteHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MtuPathCheckRemoteHost) UnmarshalBinary(b []byte) error {
+	var res MtuPathCheckRemoteHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// MtuPathCheckRequest mtu path check request
+//
+// swagger:model mtu_path_check_request
+type MtuPathCheckRequest struct {
+
+	// The hosts whose path MTU is probed.
+	// Required: true
+	RemoteHosts []*MtuPathCheckRemoteHost `json:"remote_hosts"`
+}
+
+// Validate validates this mtu path check request
+func (m *MtuPathCheckRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRemoteHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MtuPathCheckRequest) validateRemoteHosts(formats strfmt.Registry) error {
+
+	if err := validate.Required("remote_hosts", "body", m.RemoteHosts); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.RemoteHosts); i++ {
+		if swag.IsZero(m.RemoteHosts[i]) { // not required
+			continue
+		}
+
+		if m.RemoteHosts[i] != nil {
+			if err := m.RemoteHosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this mtu path check request based on the context it is used
+func (m *MtuPathCheckRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRemoteHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MtuPathCheckRequest) contextValidateRemoteHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.RemoteHosts); i++ {
+
+		if m.RemoteHosts[i] != nil {
+			if err := m.RemoteHosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("remote_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MtuPathCheckRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MtuPathCheckRequest) UnmarshalBinary(b []byte) error {
+	var res MtuPathCheckRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
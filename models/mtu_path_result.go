@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// MtuPathResult The largest packet sent without fragmentation to an address of a remote host.
+//
+// swagger:model mtu_path_result
+type MtuPathResult struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// outgoing nic
+	OutgoingNic string `json:"outgoing_nic,omitempty"`
+
+	// The path MTU in bytes, 0 when the remote address couldn't be reached.
+	PathMtu int64 `json:"path_mtu,omitempty"`
+
+	// remote host id
+	// Format: uuid
+	RemoteHostID strfmt.UUID `json:"remote_host_id,omitempty"`
+
+	// remote ip address
+	RemoteIPAddress string `json:"remote_ip_address,omitempty"`
+}
+
+// Validate validates this mtu path result
+func (m *MtuPathResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRemoteHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MtuPathResult) validateRemoteHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.RemoteHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("remote_host_id", "body", "uuid", m.RemoteHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this mtu path result based on context it is used
+func (m *MtuPathResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MtuPathResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MtuPathResult) UnmarshalBinary(b []byte) error {
+	var res MtuPathResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkReportInterface network report interface
+//
+// swagger:model network-report-interface
+type NetworkReportInterface struct {
+
+	// ipv4 addresses
+	IPV4Addresses []string `json:"ipv4_addresses"`
+
+	// ipv6 addresses
+	IPV6Addresses []string `json:"ipv6_addresses"`
+
+	// mac address
+	MacAddress string `json:"mac_address,omitempty"`
+
+	// mtu
+	Mtu int64 `json:"mtu,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// speed mbps
+	SpeedMbps int64 `json:"speed_mbps,omitempty"`
+}
+
+// Validate validates this network report interface
+func (m *NetworkReportInterface) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this network report interface based on context it is used
+func (m *NetworkReportInterface) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportInterface) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportInterface) UnmarshalBinary(b []byte) error {
+	var res NetworkReportInterface
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
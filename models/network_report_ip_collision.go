@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkReportIPCollision network report ip collision
+//
+// swagger:model network-report-ip-collision
+type NetworkReportIPCollision struct {
+
+	// ip address
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// The MAC addresses answering for the IP address.
+	MacAddresses []string `json:"mac_addresses"`
+}
+
+// Validate validates this network report ip collision
+func (m *NetworkReportIPCollision) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this network report ip collision based on context it is used
+func (m *NetworkReportIPCollision) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportIPCollision) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportIPCollision) UnmarshalBinary(b []byte) error {
+	var res NetworkReportIPCollision
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,232 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkReportLink network report link
+//
+// swagger:model network-report-link
+type NetworkReportLink struct {
+
+	// The highest average round trip time to the addresses of the remote host, in milliseconds.
+	AverageRTTMs float64 `json:"average_rtt_ms,omitempty"`
+
+	// l2 connectivity
+	L2Connectivity []*L2Connectivity `json:"l2_connectivity"`
+
+	// Whether an address of the remote host was reached by ARP.
+	L2Reachable bool `json:"l2_reachable,omitempty"`
+
+	// l3 connectivity
+	L3Connectivity []*L3Connectivity `json:"l3_connectivity"`
+
+	// Whether an address of the remote host was reached by ping.
+	L3Reachable bool `json:"l3_reachable,omitempty"`
+
+	// Whether the round trip time exceeds the threshold of the role of the hosts.
+	LatencyThresholdExceeded bool `json:"latency_threshold_exceeded,omitempty"`
+
+	// The highest packet loss to the addresses of the remote host.
+	PacketLossPercentage float64 `json:"packet_loss_percentage,omitempty"`
+
+	// Whether the packet loss exceeds the threshold of the role of the hosts.
+	PacketLossThresholdExceeded bool `json:"packet_loss_threshold_exceeded,omitempty"`
+
+	// remote host id
+	// Format: uuid
+	RemoteHostID strfmt.UUID `json:"remote_host_id,omitempty"`
+
+	// source host id
+	// Format: uuid
+	SourceHostID strfmt.UUID `json:"source_host_id,omitempty"`
+}
+
+// Validate validates this network report link
+func (m *NetworkReportLink) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateL2Connectivity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateL3Connectivity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRemoteHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportLink) validateL2Connectivity(formats strfmt.Registry) error {
+	if swag.IsZero(m.L2Connectivity) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.L2Connectivity); i++ {
+		if swag.IsZero(m.L2Connectivity[i]) { // not required
+			continue
+		}
+
+		if m.L2Connectivity[i] != nil {
+			if err := m.L2Connectivity[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("l2_connectivity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("l2_connectivity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworkReportLink) validateL3Connectivity(formats strfmt.Registry) error {
+	if swag.IsZero(m.L3Connectivity) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.L3Connectivity); i++ {
+		if swag.IsZero(m.L3Connectivity[i]) { // not required
+			continue
+		}
+
+		if m.L3Connectivity[i] != nil {
+			if err := m.L3Connectivity[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("l3_connectivity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("l3_connectivity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworkReportLink) validateRemoteHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.RemoteHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("remote_host_id", "body", "uuid", m.RemoteHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkReportLink) validateSourceHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourceHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("source_host_id", "body", "uuid", m.SourceHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this network report link based on the context it is used
+func (m *NetworkReportLink) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateL2Connectivity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateL3Connectivity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportLink) contextValidateL2Connectivity(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.L2Connectivity); i++ {
+
+		if m.L2Connectivity[i] != nil {
+			if err := m.L2Connectivity[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("l2_connectivity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("l2_connectivity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworkReportLink) contextValidateL3Connectivity(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.L3Connectivity); i++ {
+
+		if m.L3Connectivity[i] != nil {
+			if err := m.L3Connectivity[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("l3_connectivity" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("l3_connectivity" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportLink) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportLink) UnmarshalBinary(b []byte) error {
+	var res NetworkReportLink
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
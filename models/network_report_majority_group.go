@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkReportMajorityGroup network report majority group
+//
+// swagger:model network-report-majority-group
+type NetworkReportMajorityGroup struct {
+
+	// The hosts connected to each other in the network.
+	HostIds []strfmt.UUID `json:"host_ids"`
+
+	// The CIDR of the L2 group, or the address family (IPv4, IPv6) of the L3 group.
+	Network string `json:"network,omitempty"`
+}
+
+// Validate validates this network report majority group
+func (m *NetworkReportMajorityGroup) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportMajorityGroup) validateHostIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostIds); i++ {
+
+		if err := validate.FormatOf("host_ids"+"."+strconv.Itoa(i), "body", "uuid", m.HostIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this network report majority group based on context it is used
+func (m *NetworkReportMajorityGroup) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportMajorityGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportMajorityGroup) UnmarshalBinary(b []byte) error {
+	var res NetworkReportMajorityGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
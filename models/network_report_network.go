@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkReportNetwork network report network
+//
+// swagger:model network-report-network
+type NetworkReportNetwork struct {
+
+	// cidr
+	Cidr Subnet `json:"cidr,omitempty" gorm:"primaryKey"`
+
+	// The hosts having an address in the network.
+	HostIds []strfmt.UUID `json:"host_ids"`
+
+	// Whether the interfaces of the hosts in the network have different MTUs.
+	MtuMismatch bool `json:"mtu_mismatch,omitempty"`
+
+	// The distinct MTUs of the interfaces of the hosts in the network.
+	Mtus []int64 `json:"mtus"`
+}
+
+// Validate validates this network report network
+func (m *NetworkReportNetwork) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportNetwork) validateCidr(formats strfmt.Registry) error {
+	if swag.IsZero(m.Cidr) { // not required
+		return nil
+	}
+
+	if err := m.Cidr.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("cidr")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("cidr")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkReportNetwork) validateHostIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostIds); i++ {
+
+		if err := validate.FormatOf("host_ids"+"."+strconv.Itoa(i), "body", "uuid", m.HostIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this network report network based on the context it is used
+func (m *NetworkReportNetwork) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCidr(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportNetwork) contextValidateCidr(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Cidr.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("cidr")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("cidr")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportNetwork) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportNetwork) UnmarshalBinary(b []byte) error {
+	var res NetworkReportNetwork
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkReportVip network report vip
+//
+// swagger:model network-report-vip
+type NetworkReportVip struct {
+
+	// ip
+	IP IP `json:"ip,omitempty" gorm:"primaryKey"`
+
+	// The reason why the VIP isn't available.
+	Message string `json:"message,omitempty"`
+
+	// type
+	// Enum: [api ingress]
+	Type string `json:"type,omitempty"`
+
+	// verification
+	Verification *VipVerification `json:"verification,omitempty"`
+}
+
+// Validate validates this network report vip
+func (m *NetworkReportVip) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIP(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVerification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportVip) validateIP(formats strfmt.Registry) error {
+	if swag.IsZero(m.IP) { // not required
+		return nil
+	}
+
+	if err := m.IP.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("ip")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("ip")
+		}
+		return err
+	}
+
+	return nil
+}
+
+var networkReportVipTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["api","ingress"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		networkReportVipTypeTypePropEnum = append(networkReportVipTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NetworkReportVipTypeAPI captures enum value "api"
+	NetworkReportVipTypeAPI string = "api"
+
+	// NetworkReportVipTypeIngress captures enum value "ingress"
+	NetworkReportVipTypeIngress string = "ingress"
+)
+
+// prop value enum
+func (m *NetworkReportVip) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, networkReportVipTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NetworkReportVip) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkReportVip) validateVerification(formats strfmt.Registry) error {
+	if swag.IsZero(m.Verification) { // not required
+		return nil
+	}
+
+	if m.Verification != nil {
+		if err := m.Verification.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("verification")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("verification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this network report vip based on the context it is used
+func (m *NetworkReportVip) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateIP(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVerification(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkReportVip) contextValidateIP(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.IP.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("ip")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("ip")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkReportVip) contextValidateVerification(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Verification != nil {
+		if err := m.Verification.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("verification")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("verification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkReportVip) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkReportVip) UnmarshalBinary(b []byte) error {
+	var res NetworkReportVip
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,274 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	timeext "time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TriageFinding A known failure found in the logs uploaded for a cluster.
+//
+// swagger:model triage-finding
+type TriageFinding struct {
+
+	// The cluster that this finding is associated with.
+	// Format: uuid
+	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"index"`
+
+	// The first matching line.
+	Excerpt string `json:"excerpt,omitempty" gorm:"type:text"`
+
+	// The path of the file of the first matching line in the uploaded logs.
+	File string `json:"file,omitempty" gorm:"type:text"`
+
+	// The time the uploaded logs were analyzed.
+	// Format: date-time
+	FoundAt timeext.Time `json:"found_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The host which uploaded the logs. Unset for the logs of the controller.
+	// Format: uuid
+	HostID *strfmt.UUID `json:"host_id,omitempty"`
+
+	// Unique identifier of the finding.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty" gorm:"primaryKey"`
+
+	// The number of the first matching line in the file, starting at 1.
+	Line int64 `json:"line,omitempty"`
+
+	// logs type
+	LogsType LogsType `json:"logs_type,omitempty"`
+
+	// The number of matching lines in the uploaded logs.
+	MatchCount int64 `json:"match_count,omitempty"`
+
+	// What to check to fix the known failure.
+	Remediation string `json:"remediation,omitempty" gorm:"type:text"`
+
+	// Whether the known failure may fail the installation (warning) or fails it (critical).
+	// Enum: [warning critical]
+	Severity string `json:"severity,omitempty"`
+
+	// The identifier of the signature of the known failure.
+	SignatureID string `json:"signature_id,omitempty"`
+
+	// The time of the first matching line, when known.
+	// Format: date-time
+	Timestamp *strfmt.DateTime `json:"timestamp,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The known failure.
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this triage finding
+func (m *TriageFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFoundAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLogsType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TriageFinding) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TriageFinding) validateFoundAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FoundAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("found_at", "body", "date-time", m.FoundAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TriageFinding) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TriageFinding) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TriageFinding) validateLogsType(formats strfmt.Registry) error {
+	if swag.IsZero(m.LogsType) { // not required
+		return nil
+	}
+
+	if err := m.LogsType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("logs_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("logs_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+var triageFindingTypeSeverityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["warning","critical"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		triageFindingTypeSeverityPropEnum = append(triageFindingTypeSeverityPropEnum, v)
+	}
+}
+
+const (
+
+	// TriageFindingSeverityWarning captures enum value "warning"
+	TriageFindingSeverityWarning string = "warning"
+
+	// TriageFindingSeverityCritical captures enum value "critical"
+	TriageFindingSeverityCritical string = "critical"
+)
+
+// prop value enum
+func (m *TriageFinding) validateSeverityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, triageFindingTypeSeverityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TriageFinding) validateSeverity(formats strfmt.Registry) error {
+	if swag.IsZero(m.Severity) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSeverityEnum("severity", "body", m.Severity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TriageFinding) validateTimestamp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Timestamp) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("timestamp", "body", "date-time", m.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this triage finding based on the context it is used
+func (m *TriageFinding) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLogsType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TriageFinding) contextValidateLogsType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.LogsType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("logs_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("logs_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TriageFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TriageFinding) UnmarshalBinary(b []byte) error {
+	var res TriageFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TriageSummary The number of known failures found by the automatic triage of the logs uploaded for a cluster, set by v2GetCluster only. The findings are listed by v2ListClusterTriageFindings.
+//
+// swagger:model triage-summary
+type TriageSummary struct {
+
+	// The number of known failures which fail the installation.
+	CriticalCount int64 `json:"critical_count,omitempty"`
+
+	// The number of known failures found.
+	FindingCount int64 `json:"finding_count,omitempty"`
+}
+
+// Validate validates this triage summary
+func (m *TriageSummary) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this triage summary based on context it is used
+func (m *TriageSummary) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TriageSummary) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TriageSummary) UnmarshalBinary(b []byte) error {
+	var res TriageSummary
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -45,9 +45,18 @@ type V2ClusterUpdateParams struct {
 	// Cluster networks that are associated with this cluster.
 	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
 
+	// Validations defined by the user that the hosts of the cluster must pass. An empty list deletes the validations.
+	CustomHostValidations []*CustomHostValidation `json:"custom_host_validations"`
+
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies. An empty list deletes the rules.
+	HostRoleRules []*HostRoleRule `json:"host_role_rules"`
+
+	// Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage. An empty list deletes the policies.
+	HostStageTimeoutPolicies []*HostStageTimeoutPolicy `json:"host_stage_timeout_policies"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -65,6 +74,9 @@ type V2ClusterUpdateParams struct {
 	// Explicit ignition endpoint overrides the default ignition endpoint.
 	IgnitionEndpoint *IgnitionEndpoint `json:"ignition_endpoint,omitempty" gorm:"embedded;embeddedPrefix:ignition_endpoint_"`
 
+	// Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool. An empty list deletes the layers.
+	IgnitionOverrideLayers []*IgnitionOverrideLayer `json:"ignition_override_layers"`
+
 	// The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	IngressVips []*IngressVip `json:"ingress_vips"`
 
@@ -139,10 +151,22 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCustomHostValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDiskEncryption(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostRoleRules(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostStageTimeoutPolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHyperthreading(formats); err != nil {
 		res = append(res, err)
 	}
@@ -151,6 +175,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIgnitionOverrideLayers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIngressVips(formats); err != nil {
 		res = append(res, err)
 	}
@@ -273,6 +301,32 @@ func (m *V2ClusterUpdateParams) validateClusterNetworks(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateCustomHostValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.CustomHostValidations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+		if swag.IsZero(m.CustomHostValidations[i]) { // not required
+			continue
+		}
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateDiskEncryption(formats strfmt.Registry) error {
 	if swag.IsZero(m.DiskEncryption) { // not required
 		return nil
@@ -292,6 +346,58 @@ func (m *V2ClusterUpdateParams) validateDiskEncryption(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateHostRoleRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostRoleRules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+		if swag.IsZero(m.HostRoleRules[i]) { // not required
+			continue
+		}
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *V2ClusterUpdateParams) validateHostStageTimeoutPolicies(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostStageTimeoutPolicies) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostStageTimeoutPolicies); i++ {
+		if swag.IsZero(m.HostStageTimeoutPolicies[i]) { // not required
+			continue
+		}
+
+		if m.HostStageTimeoutPolicies[i] != nil {
+			if err := m.HostStageTimeoutPolicies[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var v2ClusterUpdateParamsTypeHyperthreadingPropEnum []interface{}
 
 func init() {
@@ -359,6 +465,32 @@ func (m *V2ClusterUpdateParams) validateIgnitionEndpoint(formats strfmt.Registry
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateIgnitionOverrideLayers(formats strfmt.Registry) error {
+	if swag.IsZero(m.IgnitionOverrideLayers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.IgnitionOverrideLayers); i++ {
+		if swag.IsZero(m.IgnitionOverrideLayers[i]) { // not required
+			continue
+		}
+
+		if m.IgnitionOverrideLayers[i] != nil {
+			if err := m.IgnitionOverrideLayers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateIngressVips(formats strfmt.Registry) error {
 	if swag.IsZero(m.IngressVips) { // not required
 		return nil
@@ -576,14 +708,30 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateCustomHostValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDiskEncryption(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHostRoleRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHostStageTimeoutPolicies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateIgnitionOverrideLayers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIngressVips(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -650,6 +798,26 @@ func (m *V2ClusterUpdateParams) contextValidateClusterNetworks(ctx context.Conte
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateCustomHostValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CustomHostValidations); i++ {
+
+		if m.CustomHostValidations[i] != nil {
+			if err := m.CustomHostValidations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("custom_host_validations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateDiskEncryption(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.DiskEncryption != nil {
@@ -666,6 +834,46 @@ func (m *V2ClusterUpdateParams) contextValidateDiskEncryption(ctx context.Contex
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateHostRoleRules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.HostRoleRules); i++ {
+
+		if m.HostRoleRules[i] != nil {
+			if err := m.HostRoleRules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_role_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *V2ClusterUpdateParams) contextValidateHostStageTimeoutPolicies(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.HostStageTimeoutPolicies); i++ {
+
+		if m.HostStageTimeoutPolicies[i] != nil {
+			if err := m.HostStageTimeoutPolicies[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("host_stage_timeout_policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
@@ -682,6 +890,26 @@ func (m *V2ClusterUpdateParams) contextValidateIgnitionEndpoint(ctx context.Cont
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateIgnitionOverrideLayers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.IgnitionOverrideLayers); i++ {
+
+		if m.IgnitionOverrideLayers[i] != nil {
+			if err := m.IgnitionOverrideLayers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ignition_override_layers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateIngressVips(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.IngressVips); i++ {
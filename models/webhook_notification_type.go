@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookNotificationType webhook notification type
+//
+// swagger:model webhook-notification-type
+type WebhookNotificationType string
+
+func NewWebhookNotificationType(value WebhookNotificationType) *WebhookNotificationType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated WebhookNotificationType.
+func (m WebhookNotificationType) Pointer() *WebhookNotificationType {
+	return &m
+}
+
+const (
+
+	// WebhookNotificationTypeClusterState captures enum value "ClusterState"
+	WebhookNotificationTypeClusterState WebhookNotificationType = "ClusterState"
+
+	// WebhookNotificationTypeHostState captures enum value "HostState"
+	WebhookNotificationTypeHostState WebhookNotificationType = "HostState"
+
+	// WebhookNotificationTypeInfraEnv captures enum value "InfraEnv"
+	WebhookNotificationTypeInfraEnv WebhookNotificationType = "InfraEnv"
+
+	// WebhookNotificationTypeEvent captures enum value "Event"
+	WebhookNotificationTypeEvent WebhookNotificationType = "Event"
+)
+
+// for schema
+var webhookNotificationTypeEnum []interface{}
+
+func init() {
+	var res []WebhookNotificationType
+	if err := json.Unmarshal([]byte(`["ClusterState","HostState","InfraEnv","Event"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookNotificationTypeEnum = append(webhookNotificationTypeEnum, v)
+	}
+}
+
+func (m WebhookNotificationType) validateWebhookNotificationTypeEnum(path, location string, value WebhookNotificationType) error {
+	if err := validate.EnumCase(path, location, value, webhookNotificationTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this webhook notification type
+func (m WebhookNotificationType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateWebhookNotificationTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this webhook notification type based on context it is used
+func (m WebhookNotificationType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookSubscriptionCreateParams webhook subscription create params
+//
+// swagger:model webhook-subscription-create-params
+type WebhookSubscriptionCreateParams struct {
+
+	// The notification types delivered to the webhook. All the types are delivered if empty.
+	NotificationTypes []WebhookNotificationType `json:"notification_types"`
+
+	// Key used to sign the notifications with HMAC-SHA256. It is never returned by the service.
+	// Required: true
+	// Min Length: 16
+	Secret *string `json:"secret"`
+
+	// The http(s) URL the notifications are posted to.
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this webhook subscription create params
+func (m *WebhookSubscriptionCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNotificationTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookSubscriptionCreateParams) validateNotificationTypes(formats strfmt.Registry) error {
+	if swag.IsZero(m.NotificationTypes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NotificationTypes); i++ {
+
+		if err := m.NotificationTypes[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("notification_types" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("notification_types" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *WebhookSubscriptionCreateParams) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("secret", "body", *m.Secret, 16); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookSubscriptionCreateParams) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this webhook subscription create params based on the context it is used
+func (m *WebhookSubscriptionCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNotificationTypes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookSubscriptionCreateParams) contextValidateNotificationTypes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NotificationTypes); i++ {
+
+		if err := m.NotificationTypes[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("notification_types" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("notification_types" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookSubscriptionCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookSubscriptionCreateParams) UnmarshalBinary(b []byte) error {
+	var res WebhookSubscriptionCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WipeDisksRequest wipe disks request
+//
+// swagger:model wipe_disks_request
+type WipeDisksRequest struct {
+
+	// The paths of the disks to wipe.
+	// Required: true
+	Disks []string `json:"disks"`
+}
+
+// Validate validates this wipe disks request
+func (m *WipeDisksRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WipeDisksRequest) validateDisks(formats strfmt.Registry) error {
+
+	if err := validate.Required("disks", "body", m.Disks); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this wipe disks request based on context it is used
+func (m *WipeDisksRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WipeDisksRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WipeDisksRequest) UnmarshalBinary(b []byte) error {
+	var res WipeDisksRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
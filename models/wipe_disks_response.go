@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// WipeDisksResponse wipe disks response
+//
+// swagger:model wipe_disks_response
+type WipeDisksResponse struct {
+
+	// The paths of the disks that were wiped.
+	WipedDisks []string `json:"wiped_disks"`
+}
+
+// Validate validates this wipe disks response
+func (m *WipeDisksResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this wipe disks response based on context it is used
+func (m *WipeDisksResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WipeDisksResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WipeDisksResponse) UnmarshalBinary(b []byte) error {
+	var res WipeDisksResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
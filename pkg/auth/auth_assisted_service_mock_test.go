@@ -45,6 +45,10 @@ func (f fakeInventory) V2DeregisterHost(ctx context.Context, params installer.V2
 	return installer.NewV2DeregisterHostNoContent()
 }
 
+func (f fakeInventory) V2DecommissionHost(ctx context.Context, params installer.V2DecommissionHostParams) middleware.Responder {
+	return installer.NewV2DecommissionHostAccepted()
+}
+
 func (f fakeInventory) DownloadMinimalInitrd(ctx context.Context, params installer.DownloadMinimalInitrdParams) middleware.Responder {
 	return installer.NewDownloadMinimalInitrdOK()
 }
@@ -109,6 +113,10 @@ func (f fakeInventory) V2UpdateClusterInstallConfig(ctx context.Context, params
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
 
+func (f fakeInventory) V2PreviewClusterInstallConfig(ctx context.Context, params installer.V2PreviewClusterInstallConfigParams) middleware.Responder {
+	return installer.NewV2PreviewClusterInstallConfigOK()
+}
+
 func (f fakeInventory) V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder {
 	return installer.NewV2UploadClusterIngressCertCreated()
 }
@@ -197,6 +205,10 @@ func (f fakeInventory) V2GetHostIgnition(ctx context.Context, params installer.V
 	return installer.NewV2GetHostIgnitionOK()
 }
 
+func (f fakeInventory) V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	return installer.NewV2GetHostIgnitionPreviewOK()
+}
+
 func (f fakeInventory) V2ResetHostValidation(ctx context.Context, params installer.V2ResetHostValidationParams) middleware.Responder {
 	return installer.NewV2ResetHostValidationOK()
 }
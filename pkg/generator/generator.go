@@ -7,6 +7,8 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/openshift/assisted-service/internal/installercache"
+	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -32,6 +34,8 @@ type Config struct {
 	InstallInvoker         string `envconfig:"INSTALL_INVOKER" default:"assisted-installer"`
 	ServiceBaseURL         string `envconfig:"SERVICE_BASE_URL"`
 	InstallerCacheCapacity int64  `envconfig:"INSTALLER_CACHE_CAPACITY"`
+	// Share the installer binaries with the other replicas through the object storage
+	InstallerSharedCache bool `envconfig:"INSTALLER_SHARED_CACHE" default:"false"`
 }
 
 type installGenerator struct {
@@ -43,22 +47,43 @@ type installGenerator struct {
 	workDir                   string
 	providerRegistry          registry.ProviderRegistry
 	clusterTLSCertOverrideDir string
+	installerCache            *installercache.Installers
 }
 
 func New(log logrus.FieldLogger, s3Client s3wrapper.API, cfg Config, workDir string, operatorsApi operators.API,
-	providerRegistry registry.ProviderRegistry, clusterTLSCertOverrideDir string, auth auth.Authenticator) *installGenerator {
+	providerRegistry registry.ProviderRegistry, clusterTLSCertOverrideDir string, auth auth.Authenticator, metricsAPI metrics.API) *installGenerator {
+	workDir = filepath.Join(workDir, "install-config-generate")
+	var sharedStorage s3wrapper.API
+	if cfg.InstallerSharedCache {
+		sharedStorage = s3Client
+	}
 	return &installGenerator{
 		Config:                    cfg,
 		authHandler:               auth,
 		log:                       log,
 		s3Client:                  s3Client,
 		operatorsApi:              operatorsApi,
-		workDir:                   filepath.Join(workDir, "install-config-generate"),
+		workDir:                   workDir,
 		providerRegistry:          providerRegistry,
 		clusterTLSCertOverrideDir: clusterTLSCertOverrideDir,
+		installerCache:            installercache.New(filepath.Join(workDir, "installercache"), cfg.InstallerCacheCapacity, log, sharedStorage, metricsAPI),
 	}
 }
 
+// InstallerCache returns the cache of the installer binaries used to generate the ignitions
+func (k *installGenerator) InstallerCache() *installercache.Installers {
+	return k.installerCache
+}
+
+// WithStorage returns a generator storing the files it generates with s3Client and working in workDir, which shares
+// the installer cache of this generator
+func (k *installGenerator) WithStorage(s3Client s3wrapper.API, workDir string) *installGenerator {
+	generator := *k
+	generator.s3Client = s3Client
+	generator.workDir = filepath.Join(workDir, "install-config-generate")
+	return &generator
+}
+
 // GenerateInstallConfig creates install config and ignition files
 func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string) error {
 	log := logutil.FromContext(ctx, k.log)
@@ -70,7 +95,6 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 	if err != nil {
 		return err
 	}
-	installerCacheDir := filepath.Join(k.workDir, "installercache")
 	defer func() {
 		// keep results in case of failure so a human can debug
 		if err != nil {
@@ -99,8 +123,8 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 	if k.Config.DummyIgnition {
 		generator = ignition.NewDummyGenerator(k.ServiceBaseURL, clusterWorkDir, &cluster, k.s3Client, log)
 	} else {
-		generator = ignition.NewGenerator(k.ServiceBaseURL, clusterWorkDir, installerCacheDir, &cluster, releaseImage, k.Config.ReleaseImageMirror,
-			k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi, k.providerRegistry, installerReleaseImageOverride, k.clusterTLSCertOverrideDir, k.InstallerCacheCapacity)
+		generator = ignition.NewGenerator(k.ServiceBaseURL, clusterWorkDir, k.installerCache, &cluster, releaseImage, k.Config.ReleaseImageMirror,
+			k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi, k.providerRegistry, installerReleaseImageOverride, k.clusterTLSCertOverrideDir)
 	}
 	err = generator.Generate(ctx, cfg, k.authHandler.AuthType())
 	if err != nil {
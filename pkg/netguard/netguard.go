@@ -0,0 +1,107 @@
+package netguard
+
+import (
+	"context"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// restrictedNetworks are the networks that aren't covered by the net.IP predicates used by Policy.IsRestricted
+var restrictedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "this" network
+	"100.64.0.0/10", // carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // benchmarking
+	"64:ff9b::/96",  // NAT64, which maps the IPv4 addresses including the restricted ones
+)
+
+// LookupIPFunc resolves the IP addresses of a host
+type LookupIPFunc func(ctx context.Context, host string) ([]net.IP, error)
+
+func LookupIP(ctx context.Context, host string) ([]net.IP, error) {
+	return net.DefaultResolver.LookupIP(ctx, "ip", host)
+}
+
+// Policy tells which addresses the service must not connect to on behalf of its users. The loopback, link-local
+// (including the cloud metadata service), multicast and unspecified addresses are always restricted
+type Policy struct {
+	// AllowPrivate allows the private addresses, e.g. for the BMCs that are on the network of the hosts
+	AllowPrivate bool
+	// Networks are restricted in addition to the default ones, e.g. the service network of the cluster running
+	// the service
+	Networks []*net.IPNet
+}
+
+// IsRestricted returns true for the addresses that the policy doesn't allow
+func (p Policy) IsRestricted(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() || (ip.IsPrivate() && !p.AllowPrivate) {
+		return true
+	}
+	for _, networks := range [][]*net.IPNet{restrictedNetworks, p.Networks} {
+		for _, network := range networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateHost resolves the host and rejects it if any of its addresses is restricted
+func (p Policy) ValidateHost(ctx context.Context, lookup LookupIPFunc, host string) error {
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		var err error
+		if ips, err = lookup(ctx, host); err != nil {
+			return errors.Wrapf(err, "failed to resolve host %s", host)
+		}
+	}
+	for _, ip := range ips {
+		if p.IsRestricted(ip) {
+			return errors.Errorf("host %s resolves to the restricted address %s", host, ip)
+		}
+	}
+	return nil
+}
+
+// Control is used as the net.Dialer Control of the connections. It checks the address that is actually connected
+// to, after the name resolution, so that a host can't be rebound to a restricted address after it was validated
+func (p Policy) Control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || p.IsRestricted(ip) {
+		return errors.Errorf("connections to the address %s are not allowed", host)
+	}
+	return nil
+}
+
+// ParseCIDRs parses a comma separated list of CIDRs, e.g. of a setting
+func ParseCIDRs(cidrs string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(cidrs, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid CIDR %s", cidr)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks, err := ParseCIDRs(strings.Join(cidrs, ","))
+	if err != nil {
+		panic(err)
+	}
+	return networks
+}
@@ -0,0 +1,13 @@
+package netguard
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNetguard(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Netguard test Suite")
+}
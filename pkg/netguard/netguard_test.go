@@ -0,0 +1,58 @@
+package netguard
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	It("restricts the loopback, link-local and private addresses", func() {
+		for _, address := range []string{
+			"127.0.0.1", "::1", "169.254.169.254", "fe80::1", "10.0.0.1", "172.16.0.1", "192.168.0.1", "fd00::1",
+			"100.64.0.1", "0.0.0.0", "0.1.2.3", "::", "224.0.0.1", "ff02::1", "::ffff:127.0.0.1", "64:ff9b::a9fe:a9fe",
+		} {
+			Expect(Policy{}.IsRestricted(net.ParseIP(address))).To(BeTrue(), address)
+		}
+	})
+
+	It("allows the public addresses", func() {
+		for _, address := range []string{"93.184.215.14", "8.8.8.8", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"} {
+			Expect(Policy{}.IsRestricted(net.ParseIP(address))).To(BeFalse(), address)
+		}
+	})
+
+	It("allows the private addresses outside of the restricted networks", func() {
+		networks, err := ParseCIDRs("172.30.0.0/16, fd02::/112")
+		Expect(err).ToNot(HaveOccurred())
+		policy := Policy{AllowPrivate: true, Networks: networks}
+		for _, address := range []string{"10.0.0.1", "192.168.0.1", "172.16.0.1", "fd00::1"} {
+			Expect(policy.IsRestricted(net.ParseIP(address))).To(BeFalse(), address)
+		}
+		for _, address := range []string{"127.0.0.1", "169.254.169.254", "fe80::1", "172.30.0.1", "fd02::1", "100.64.0.1"} {
+			Expect(policy.IsRestricted(net.ParseIP(address))).To(BeTrue(), address)
+		}
+	})
+
+	It("rejects the hosts resolving to a restricted address", func() {
+		lookup := func(_ context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("10.0.0.1")}, nil
+		}
+		Expect(Policy{}.ValidateHost(context.Background(), lookup, "rebound.example.com")).To(HaveOccurred())
+		Expect(Policy{AllowPrivate: true}.ValidateHost(context.Background(), lookup, "rebound.example.com")).ToNot(HaveOccurred())
+		Expect(Policy{AllowPrivate: true}.ValidateHost(context.Background(), lookup, "169.254.169.254")).To(HaveOccurred())
+	})
+
+	It("refuses the connections to restricted addresses", func() {
+		Expect(Policy{}.Control("tcp", "127.0.0.1:443", nil)).To(HaveOccurred())
+		Expect(Policy{}.Control("tcp", "[fe80::1]:443", nil)).To(HaveOccurred())
+		Expect(Policy{}.Control("tcp", "93.184.215.14:443", nil)).ToNot(HaveOccurred())
+	})
+
+	It("rejects invalid CIDRs", func() {
+		_, err := ParseCIDRs("172.30.0.0/16,invalid")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,517 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	azureAPIVersion     = "2019-12-12"
+	azureEndpointSuffix = ".blob.core.windows.net"
+)
+
+// The size of the blocks of the streamed uploads. A blob has at most 50000 blocks.
+var azureBlockSize = 8 * 1024 * 1024
+
+type AzureConfig struct {
+	AccountName string `envconfig:"AZURE_STORAGE_ACCOUNT"`
+	// The base64 encoded shared key of the storage account
+	AccountKey string `envconfig:"AZURE_STORAGE_KEY"`
+	Container  string `envconfig:"AZURE_STORAGE_CONTAINER"`
+	// The URL of the blob service, https://<account>.blob.core.windows.net by default. The URL of an emulator includes
+	// the account, e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite.
+	EndpointURL string `envconfig:"AZURE_STORAGE_ENDPOINT_URL"`
+}
+
+// AzureClient stores the objects as block blobs of a container of Azure Blob Storage, using its REST API. The Azure
+// SDK isn't vendored, the requests are signed with the shared key directly.
+type AzureClient struct {
+	log      logrus.FieldLogger
+	cfg      *AzureConfig
+	key      []byte
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ API = &AzureClient{}
+
+func NewAzureClient(cfg *AzureConfig, logger logrus.FieldLogger) (*AzureClient, error) {
+	if cfg.AccountName == "" || cfg.AccountKey == "" || cfg.Container == "" {
+		return nil, errors.New("the account, the key and the container of the Azure storage are required")
+	}
+	key, err := base64.StdEncoding.DecodeString(cfg.AccountKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the key of the Azure storage account")
+	}
+	endpointURL := cfg.EndpointURL
+	if endpointURL == "" {
+		endpointURL = fmt.Sprintf("https://%s%s", cfg.AccountName, azureEndpointSuffix)
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(endpointURL, "/"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid Azure storage endpoint %s", endpointURL)
+	}
+	return &AzureClient{log: logger, cfg: cfg, key: key, endpoint: endpoint, client: newHTTPClient()}, nil
+}
+
+// IsAwsS3 tells whether the presigned URLs can be used by the clients of the service, i.e. the storage is the public
+// Azure Blob Storage rather than an emulator
+func (c *AzureClient) IsAwsS3() bool {
+	return strings.HasSuffix(c.endpoint.Host, azureEndpointSuffix)
+}
+
+func (c *AzureClient) CreateBucket() error {
+	resp, err := c.do(context.Background(), http.MethodPut, "", url.Values{"restype": {"container"}}, nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create Azure container %s", c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusCreated {
+		return nil
+	}
+	return errors.Wrapf(azureError(resp), "Failed to create Azure container %s", c.cfg.Container)
+}
+
+func (c *AzureClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+func (c *AzureClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if reader == nil {
+		err := errors.Errorf("Upfile log may not be nil. Cannot upload %s to container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return err
+	}
+	if err := c.uploadBlocks(ctx, reader, objectName); err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, c.cfg.Container)
+	return nil
+}
+
+// uploadBlocks uploads a stream of unknown length block by block, and commits the list of the blocks. The streams
+// shorter than a block are uploaded at once.
+func (c *AzureClient) uploadBlocks(ctx context.Context, reader io.Reader, objectName string) error {
+	buf := make([]byte, azureBlockSize)
+	var blockIDs []string
+	for {
+		n, err := io.ReadFull(reader, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		last := err != nil
+		if last && len(blockIDs) == 0 {
+			headers := http.Header{"X-Ms-Blob-Type": {"BlockBlob"}, "X-Ms-Blob-Cache-Control": {"no-cache"}}
+			return c.expect(c.do(ctx, http.MethodPut, objectName, nil, headers, buf[:n]))(http.StatusCreated)
+		}
+		if n > 0 {
+			blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%08d", len(blockIDs))))
+			query := url.Values{"comp": {"block"}, "blockid": {blockID}}
+			if err = c.expect(c.do(ctx, http.MethodPut, objectName, query, nil, buf[:n]))(http.StatusCreated); err != nil {
+				return err
+			}
+			blockIDs = append(blockIDs, blockID)
+		}
+		if last {
+			break
+		}
+	}
+
+	var blockList bytes.Buffer
+	blockList.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, blockID := range blockIDs {
+		fmt.Fprintf(&blockList, "<Latest>%s</Latest>", blockID)
+	}
+	blockList.WriteString("</BlockList>")
+	headers := http.Header{"X-Ms-Blob-Cache-Control": {"no-cache"}}
+	return c.expect(c.do(ctx, http.MethodPut, objectName, url.Values{"comp": {"blocklist"}}, headers, blockList.Bytes()))(http.StatusCreated)
+}
+
+func (c *AzureClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to container %s", filePath, objectName, c.cfg.Container)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return c.UploadStream(ctx, file, objectName)
+}
+
+func (c *AzureClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from container %s", objectName, c.cfg.Container)
+	resp, err := c.do(ctx, http.MethodGet, objectName, nil, nil, nil)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Failed to get %s object from container %s", objectName, c.cfg.Container)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, 0, common.NotFound(objectName)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		err = errors.Wrapf(azureError(resp), "Failed to get %s object from container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// properties returns the response to a HEAD of the blob, nil when the blob doesn't exist
+func (c *AzureClient) properties(ctx context.Context, objectName string) (*http.Response, error) {
+	resp, err := c.do(ctx, http.MethodHead, objectName, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, azureError(resp)
+	}
+}
+
+func (c *AzureClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, c.cfg.Container)
+	resp, err := c.properties(ctx, objectName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get %s from container %s", objectName, c.cfg.Container)
+	}
+	return resp != nil, nil
+}
+
+func (c *AzureClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Container)
+	resp, err := c.do(ctx, http.MethodDelete, objectName, nil, nil, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		log.Infof("Deleted object %s from container %s", objectName, c.cfg.Container)
+		return true, nil
+	case http.StatusNotFound:
+		log.Infof("Object %s does not exist in container %s", objectName, c.cfg.Container)
+		return false, nil
+	default:
+		return false, errors.Wrapf(azureError(resp), "Failed to delete object %s from container %s", objectName, c.cfg.Container)
+	}
+}
+
+func (c *AzureClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	resp, err := c.properties(ctx, objectName)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Failed to fetch metadata for object %s in container %s", objectName, c.cfg.Container)
+	}
+	if resp == nil {
+		return 0, common.NotFound(objectName)
+	}
+	return resp.ContentLength, nil
+}
+
+// GeneratePresignedDownloadURL returns a URL of the blob with a service shared access signature
+func (c *AzureClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	values := url.Values{
+		"sv":   {azureAPIVersion},
+		"sr":   {"b"},
+		"sp":   {"r"},
+		"se":   {time.Now().UTC().Add(duration).Format("2006-01-02T15:04:05Z")},
+		"rscd": {fmt.Sprintf("attachment;filename=%s", downloadFilename)},
+	}
+	if c.endpoint.Scheme == "https" {
+		values.Set("spr", "https")
+	}
+	stringToSign := strings.Join([]string{
+		values.Get("sp"),
+		"", // signed start
+		values.Get("se"),
+		fmt.Sprintf("/blob/%s/%s/%s", c.cfg.AccountName, c.cfg.Container, objectName),
+		"", // signed identifier
+		"", // signed IP
+		values.Get("spr"),
+		values.Get("sv"),
+		values.Get("sr"),
+		"", // signed snapshot time
+		"", // cache control
+		values.Get("rscd"),
+		"", // content encoding
+		"", // content language
+		"", // content type
+	}, "\n")
+	values.Set("sig", c.sign(stringToSign))
+	u := c.blobURL(objectName)
+	u.RawQuery = values.Encode()
+	return u.String(), nil
+}
+
+// UpdateObjectTimestamp sets the creation time used by ExpireObjects in the metadata of the blob
+func (c *AzureClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	headers := http.Header{"x-ms-meta-" + timestampTagKey: {strconv.FormatInt(time.Now().Unix(), 10)}}
+	resp, err := c.do(ctx, http.MethodPut, objectName, url.Values{"comp": {"metadata"}}, headers, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to update the metadata of object %s in container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, errors.Wrapf(azureError(resp), "Failed to update the metadata of object %s in container %s", objectName, c.cfg.Container)
+	}
+}
+
+type azureBlob struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		LastModified string `xml:"Last-Modified"`
+	} `xml:"Properties"`
+	Metadata struct {
+		Items []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"Metadata"`
+}
+
+func (b *azureBlob) timestamp() string {
+	for _, item := range b.Metadata.Items {
+		if strings.EqualFold(item.XMLName.Local, timestampTagKey) {
+			return item.Value
+		}
+	}
+	return ""
+}
+
+type azureBlobList struct {
+	Blobs      []*azureBlob `xml:"Blobs>Blob"`
+	NextMarker string       `xml:"NextMarker"`
+}
+
+// listBlobs calls fn with each page of the blobs whose name starts with prefix
+func (c *AzureClient) listBlobs(ctx context.Context, prefix string, fn func(blobs []*azureBlob)) error {
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "include": {"metadata"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := c.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = azureError(resp)
+			resp.Body.Close()
+			return err
+		}
+		var list azureBlobList
+		err = xml.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			return errors.Wrap(err, "failed to decode the list of blobs")
+		}
+		fn(list.Blobs)
+		if list.NextMarker == "" {
+			return nil
+		}
+		marker = list.NextMarker
+	}
+}
+
+func (c *AzureClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := c.listBlobs(ctx, prefix, func(blobs []*azureBlob) {
+		for _, blob := range blobs {
+			lastModified, err := time.Parse(http.TimeFormat, blob.Properties.LastModified)
+			if err != nil {
+				log.WithError(err).Errorf("Invalid last modification time of object %s", blob.Name)
+				continue
+			}
+			if !isExpired(now, lastModified, blob.timestamp(), deleteTime) {
+				continue
+			}
+			if _, err = c.DeleteObject(ctx, blob.Name); err != nil {
+				log.WithError(err).Errorf("Error deleting expired object %s", blob.Name)
+				continue
+			}
+			log.Infof("Deleted expired object %s", blob.Name)
+			callback(ctx, log, blob.Name)
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+func (c *AzureClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Listing objects by with prefix %s", prefix)
+	var objects []string
+	err := c.listBlobs(ctx, prefix, func(blobs []*azureBlob) {
+		for _, blob := range blobs {
+			objects = append(objects, blob.Name)
+		}
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+// blobURL returns the URL of the blob, or of the container when objectName is empty
+func (c *AzureClient) blobURL(objectName string) *url.URL {
+	u := *c.endpoint
+	u.Path = fmt.Sprintf("%s/%s", u.Path, c.cfg.Container)
+	if objectName != "" {
+		u.Path = fmt.Sprintf("%s/%s", u.Path, objectName)
+	}
+	u.RawPath = ""
+	return &u
+}
+
+// do sends a request signed with the shared key of the account
+func (c *AzureClient) do(ctx context.Context, method, objectName string, query url.Values, headers http.Header, body []byte) (*http.Response, error) {
+	u := c.blobURL(objectName)
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	// The names of the metadata are sent as is, since they are returned with the same case by the list of the blobs
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+	req.ContentLength = int64(len(body))
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("X-Ms-Version", azureAPIVersion)
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", c.cfg.AccountName, c.sign(c.stringToSign(req))))
+	return c.client.Do(req)
+}
+
+// expect returns a function checking that the response of a request has the expected status
+func (c *AzureClient) expect(resp *http.Response, err error) func(status int) error {
+	return func(status int) error {
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != status {
+			return azureError(resp)
+		}
+		return nil
+	}
+}
+
+// stringToSign returns the string signed by the shared key authorization of the request
+func (c *AzureClient) stringToSign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	msHeaders := map[string]string{}
+	var msHeaderNames []string
+	for name, values := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") && len(values) > 0 {
+			msHeaders[lower] = strings.TrimSpace(values[0])
+			msHeaderNames = append(msHeaderNames, lower)
+		}
+	}
+	sort.Strings(msHeaderNames)
+	var canonicalized strings.Builder
+	for _, name := range msHeaderNames {
+		fmt.Fprintf(&canonicalized, "%s:%s\n", name, msHeaders[name])
+	}
+
+	fmt.Fprintf(&canonicalized, "/%s%s", c.cfg.AccountName, req.URL.EscapedPath())
+	query := req.URL.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := append([]string{}, query[name]...)
+		sort.Strings(values)
+		fmt.Fprintf(&canonicalized, "\n%s:%s", strings.ToLower(name), strings.Join(values, ","))
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // date, replaced by x-ms-date
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalized.String(),
+	}, "\n")
+}
+
+func (c *AzureClient) sign(stringToSign string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func azureError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var azureErr struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if xml.Unmarshal(body, &azureErr) == nil && azureErr.Code != "" {
+		return errors.Errorf("%s (code %s, status %d)", strings.TrimSpace(azureErr.Message), azureErr.Code, resp.StatusCode)
+	}
+	return errors.Errorf("unexpected status %d", resp.StatusCode)
+}
@@ -0,0 +1,349 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+type fakeBlob struct {
+	data         []byte
+	lastModified time.Time
+	metadata     map[string]string
+	cacheControl string
+}
+
+// fakeAzure is an in-memory blob service serving a single account, whose blobs are listed two by two
+type fakeAzure struct {
+	sync.Mutex
+	account    string
+	containers map[string]map[string]*fakeBlob
+	blocks     map[string][]byte
+}
+
+func newFakeAzure(account string) *fakeAzure {
+	return &fakeAzure{account: account, containers: map[string]map[string]*fakeBlob{}, blocks: map[string][]byte{}}
+}
+
+func (f *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), fmt.Sprintf("SharedKey %s:", f.account)) ||
+		r.Header.Get("X-Ms-Version") == "" || r.Header.Get("X-Ms-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"+f.account+"/"), "/", 2)
+	query := r.URL.Query()
+	container := parts[0]
+	if len(parts) == 1 {
+		f.serveContainer(w, r, container, query)
+		return
+	}
+	blobs, ok := f.containers[container]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name := parts[1]
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		f.blocks[name+"/"+query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		Expect(xml.Unmarshal(body, &list)).To(Succeed())
+		blob := &fakeBlob{lastModified: time.Now(), cacheControl: r.Header.Get("X-Ms-Blob-Cache-Control")}
+		for _, blockID := range list.Latest {
+			blob.data = append(blob.data, f.blocks[name+"/"+blockID]...)
+		}
+		blobs[name] = blob
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "metadata":
+		blob, ok := blobs[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		blob.metadata = map[string]string{}
+		for header := range r.Header {
+			if lower := strings.ToLower(header); strings.HasPrefix(lower, "x-ms-meta-") {
+				blob.metadata[strings.TrimPrefix(lower, "x-ms-meta-")] = r.Header.Get(header)
+			}
+		}
+	case r.Method == http.MethodPut:
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		blobs[name] = &fakeBlob{data: body, lastModified: time.Now(), cacheControl: r.Header.Get("X-Ms-Blob-Cache-Control")}
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		blob, ok := blobs[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(blob.data)
+		}
+	case r.Method == http.MethodDelete:
+		if _, ok := blobs[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (f *fakeAzure) serveContainer(w http.ResponseWriter, r *http.Request, container string, query url.Values) {
+	if query.Get("restype") != "container" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if r.Method == http.MethodPut {
+		if _, ok := f.containers[container]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		f.containers[container] = map[string]*fakeBlob{}
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	blobs, ok := f.containers[container]
+	if !ok || query.Get("comp") != "list" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var names []string
+	for name := range blobs {
+		if strings.HasPrefix(name, query.Get("prefix")) && name > query.Get("marker") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var out strings.Builder
+	out.WriteString("<EnumerationResults><Blobs>")
+	for i, name := range names {
+		if i == 2 {
+			break
+		}
+		blob := blobs[name]
+		fmt.Fprintf(&out, "<Blob><Name>%s</Name><Properties><Last-Modified>%s</Last-Modified></Properties><Metadata>",
+			name, blob.lastModified.UTC().Format(http.TimeFormat))
+		for key, value := range blob.metadata {
+			fmt.Fprintf(&out, "<%s>%s</%s>", key, value, key)
+		}
+		out.WriteString("</Metadata></Blob>")
+	}
+	out.WriteString("</Blobs><NextMarker>")
+	if len(names) > 2 {
+		out.WriteString(names[1])
+	}
+	out.WriteString("</NextMarker></EnumerationResults>")
+	_, _ = w.Write([]byte(out.String()))
+}
+
+var _ = Describe("AzureClient", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		fake    *fakeAzure
+		server  *httptest.Server
+		client  *AzureClient
+		key     = []byte("the key of the storage account")
+		account = "devstoreaccount1"
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		fake = newFakeAzure(account)
+		server = httptest.NewServer(fake)
+		var err error
+		client, err = NewAzureClient(&AzureConfig{
+			AccountName: account,
+			AccountKey:  base64.StdEncoding.EncodeToString(key),
+			Container:   "assisted",
+			EndpointURL: server.URL + "/" + account,
+		}, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.CreateBucket()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requires the account, the key and the container", func() {
+		_, err := NewAzureClient(&AzureConfig{AccountName: account, Container: "assisted"}, log)
+		Expect(err).To(HaveOccurred())
+		_, err = NewAzureClient(&AzureConfig{AccountName: account, AccountKey: "not base64", Container: "assisted"}, log)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses presigned URLs with the public endpoint only", func() {
+		Expect(client.IsAwsS3()).To(BeFalse())
+		public, err := NewAzureClient(&AzureConfig{AccountName: "account", AccountKey: client.cfg.AccountKey, Container: "assisted"}, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(public.endpoint.String()).To(Equal("https://account.blob.core.windows.net"))
+		Expect(public.IsAwsS3()).To(BeTrue())
+	})
+
+	It("doesn't fail when the container exists", func() {
+		Expect(client.CreateBucket()).To(Succeed())
+	})
+
+	It("uploads, downloads and deletes objects", func() {
+		Expect(client.Upload(ctx, []byte("hello world"), "dir/object")).To(Succeed())
+		Expect(fake.containers["assisted"]["dir/object"].cacheControl).To(Equal("no-cache"))
+
+		exists, err := client.DoesObjectExist(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		size, err := client.GetObjectSizeBytes(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(11)))
+
+		reader, length, err := client.Download(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(length).To(Equal(int64(11)))
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(data)).To(Equal("hello world"))
+
+		deleted, err := client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		deleted, err = client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+		exists, err = client.DoesObjectExist(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("uploads the long streams by blocks", func() {
+		defer func(size int) { azureBlockSize = size }(azureBlockSize)
+		azureBlockSize = 4
+		Expect(client.UploadStream(ctx, strings.NewReader("hello world"), "object")).To(Succeed())
+		Expect(fake.blocks).To(HaveLen(3))
+		Expect(string(fake.containers["assisted"]["object"].data)).To(Equal("hello world"))
+		Expect(fake.containers["assisted"]["object"].cacheControl).To(Equal("no-cache"))
+	})
+
+	It("returns a not found error for the missing objects", func() {
+		_, _, err := client.Download(ctx, "missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+		_, err = client.GetObjectSizeBytes(ctx, "missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+	})
+
+	It("fails when the requests are rejected", func() {
+		client.cfg.AccountName = "other"
+		Expect(client.Upload(ctx, []byte("hello world"), "object")).ToNot(Succeed())
+		_, err := client.DoesObjectExist(ctx, "object")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("lists the objects by prefix", func() {
+		for _, name := range []string{"a/1", "a/2", "a/3", "b/1"} {
+			Expect(client.Upload(ctx, []byte(name), name)).To(Succeed())
+		}
+		objects, err := client.ListObjectsByPrefix(ctx, "a/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{"a/1", "a/2", "a/3"}))
+		objects, err = client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(4))
+	})
+
+	It("expires the objects by their last modification or their timestamp", func() {
+		for _, name := range []string{"old", "old-updated", "new", "other"} {
+			Expect(client.Upload(ctx, []byte(name), "images/"+name)).To(Succeed())
+		}
+		blobs := fake.containers["assisted"]
+		blobs["images/old"].lastModified = time.Now().Add(-2 * time.Hour)
+		blobs["images/old-updated"].lastModified = time.Now().Add(-2 * time.Hour)
+		updated, err := client.UpdateObjectTimestamp(ctx, "images/old-updated")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		Expect(blobs["images/old-updated"].metadata).To(HaveKey(timestampTagKey))
+		Expect(client.Upload(ctx, []byte("other"), "other")).To(Succeed())
+		blobs["other"].lastModified = time.Now().Add(-2 * time.Hour)
+
+		var expired []string
+		client.ExpireObjects(ctx, "images/", time.Hour, func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		})
+		Expect(expired).To(Equal([]string{"images/old"}))
+		Expect(blobs).ToNot(HaveKey("images/old"))
+		Expect(blobs).To(HaveKey("images/old-updated"))
+		Expect(blobs).To(HaveKey("other"))
+	})
+
+	It("doesn't update the timestamp of the missing objects", func() {
+		updated, err := client.UpdateObjectTimestamp(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeFalse())
+	})
+
+	It("signs the requests with the shared key", func() {
+		req, err := http.NewRequest(http.MethodPut, "https://account.blob.core.windows.net/assisted/dir/object?comp=block&blockid=YQ%3D%3D", strings.NewReader("data"))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("X-Ms-Date", "Thu, 04 May 2023 10:00:00 GMT")
+		req.Header.Set("X-Ms-Version", azureAPIVersion)
+		req.Header["x-ms-meta-"+timestampTagKey] = []string{"1683194400"}
+		Expect(client.stringToSign(req)).To(Equal("PUT\n\n\n4\n\n\n\n\n\n\n\n\n" +
+			"x-ms-date:Thu, 04 May 2023 10:00:00 GMT\n" +
+			"x-ms-meta-create_sec_since_epoch:1683194400\n" +
+			"x-ms-version:2019-12-12\n" +
+			"/devstoreaccount1/assisted/dir/object\nblockid:YQ==\ncomp:block"))
+	})
+
+	It("generates the URLs of the objects with a shared access signature", func() {
+		presigned, err := client.GeneratePresignedDownloadURL(ctx, "dir/object", "file.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(presigned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Path).To(Equal("/devstoreaccount1/assisted/dir/object"))
+		query := u.Query()
+		Expect(query.Get("sp")).To(Equal("r"))
+		Expect(query.Get("sr")).To(Equal("b"))
+		Expect(query.Get("rscd")).To(Equal("attachment;filename=file.iso"))
+		Expect(query.Has("spr")).To(BeFalse())
+		expiry, err := time.Parse(time.RFC3339, query.Get("se"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		stringToSign := fmt.Sprintf("r\n\n%s\n/blob/devstoreaccount1/assisted/dir/object\n\n\n\n%s\nb\n\n\nattachment;filename=file.iso\n\n\n",
+			query.Get("se"), azureAPIVersion)
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(stringToSign))
+		Expect(query.Get("sig")).To(Equal(base64.StdEncoding.EncodeToString(mac.Sum(nil))))
+	})
+})
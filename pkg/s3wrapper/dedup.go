@@ -0,0 +1,535 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dedupPointerMagic = "ASREF01\n"
+	// The pointers are much smaller, the larger objects aren't read to find whether they are pointers
+	maxDedupPointerSize = 1024
+	// The deletion of a content by PruneBlobs takes a few requests, a deletion marker older than that was left by a
+	// failed run
+	dedupDeletionTimeout      = time.Minute
+	dedupDeletionPollInterval = time.Second
+)
+
+var (
+	dedupBlobsPrefix    = path.Join(internalPrefix, "cas", "blobs") + "/"
+	dedupRefsPrefix     = path.Join(internalPrefix, "cas", "refs") + "/"
+	dedupObjectsPrefix  = path.Join(internalPrefix, "cas", "objects") + "/"
+	dedupOrphansPrefix  = path.Join(internalPrefix, "cas", "orphans") + "/"
+	dedupDeletingPrefix = path.Join(internalPrefix, "cas", "deleting") + "/"
+)
+
+type DedupConfig struct {
+	Enabled bool `envconfig:"STORAGE_DEDUP_ENABLED" default:"false"`
+	// The smaller objects are stored as is
+	MinSize int64 `envconfig:"STORAGE_DEDUP_MIN_SIZE" default:"1048576"`
+	// The contents are deleted when they are left without references for this long
+	PruneGracePeriod time.Duration `envconfig:"STORAGE_DEDUP_PRUNE_GRACE_PERIOD" default:"1h"`
+}
+
+type dedupPointer struct {
+	Tenant string `json:"tenant,omitempty"`
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// key returns the name of the content in the namespace of its tenant. The pointers stored before the contents had a
+// namespace per tenant have no tenant.
+func (p *dedupPointer) key() string {
+	return dedupKey(p.Tenant, p.Digest)
+}
+
+func dedupKey(tenant, digest string) string {
+	if tenant == "" {
+		return digest
+	}
+	return tenant + "/" + digest
+}
+
+var _ API = &DedupClient{}
+
+// DedupClient stores the large objects once per content of a tenant, i.e. the organization owning the objects. The
+// content is stored under the tenant and its SHA-256 digest, and the objects are pointers to it. Each object
+// referencing a content has a reference marker, and the contents left without references are deleted by PruneBlobs.
+// An index of the objects gives the content each object references, so that the references are released when the
+// objects are overwritten, deleted or expired.
+type DedupClient struct {
+	base    API
+	cfg     *DedupConfig
+	tenants TenantResolver
+	log     logrus.FieldLogger
+}
+
+func NewDedupClient(base API, cfg *DedupConfig, tenants TenantResolver, log logrus.FieldLogger) *DedupClient {
+	return &DedupClient{base: base, cfg: cfg, tenants: tenants, log: log}
+}
+
+func dedupBlobName(key string) string {
+	return dedupBlobsPrefix + key
+}
+
+func dedupRefName(key, objectName string) string {
+	return dedupRefsPrefix + key + "/" + hex.EncodeToString([]byte(objectName))
+}
+
+func dedupIndexName(objectName string) string {
+	return dedupObjectsPrefix + hex.EncodeToString([]byte(objectName))
+}
+
+func (d *DedupClient) IsAwsS3() bool {
+	return d.base.IsAwsS3()
+}
+
+func (d *DedupClient) CreateBucket() error {
+	return d.base.CreateBucket()
+}
+
+func (d *DedupClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	if int64(len(data)) < d.cfg.MinSize {
+		return d.uploadAsIs(ctx, objectName, func() error {
+			return d.base.Upload(ctx, data, objectName)
+		})
+	}
+	digest := sha256.Sum256(data)
+	return d.uploadDeduplicated(ctx, objectName, hex.EncodeToString(digest[:]), int64(len(data)), func(blobName string) error {
+		return d.base.Upload(ctx, data, blobName)
+	})
+}
+
+// UploadStream reads the beginning of the stream, and spools the streams of the objects to deduplicate to a
+// temporary file to get their digest
+func (d *DedupClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	if reader == nil {
+		return errors.Errorf("Upfile log may not be nil. Cannot upload %s", objectName)
+	}
+	head := make([]byte, d.cfg.MinSize)
+	n, err := io.ReadFull(reader, head)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return d.Upload(ctx, head[:n], objectName)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+
+	file, err := os.CreateTemp("", "dedup")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	if _, err = io.Copy(file, io.MultiReader(bytes.NewReader(head), reader)); err != nil {
+		return errors.Wrapf(err, "failed to spool object %s", objectName)
+	}
+	return d.UploadFile(ctx, file.Name(), objectName)
+}
+
+func (d *DedupClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+	}
+	if info.Size() < d.cfg.MinSize {
+		return d.uploadAsIs(ctx, objectName, func() error {
+			return d.base.UploadFile(ctx, filePath, objectName)
+		})
+	}
+	digest, err := fileDigest(filePath)
+	if err != nil {
+		return err
+	}
+	return d.uploadDeduplicated(ctx, objectName, digest, info.Size(), func(blobName string) error {
+		return d.base.UploadFile(ctx, filePath, blobName)
+	})
+}
+
+func fileDigest(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", errors.Wrapf(err, "failed to read file %s", filePath)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// uploadAsIs uploads a small object, releasing the content the object referenced before
+func (d *DedupClient) uploadAsIs(ctx context.Context, objectName string, upload func() error) error {
+	previous, err := d.indexedKey(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	if err = upload(); err != nil {
+		return err
+	}
+	if previous != "" {
+		return d.release(ctx, objectName, previous)
+	}
+	return nil
+}
+
+// uploadDeduplicated references the content of the object, uploading the content when it isn't stored yet, and
+// replaces the object with a pointer to the content
+func (d *DedupClient) uploadDeduplicated(ctx context.Context, objectName, digest string, size int64, upload func(blobName string) error) error {
+	log := logutil.FromContext(ctx, d.log)
+	tenant, err := objectTenant(ctx, d.tenants, objectName)
+	if err != nil {
+		return err
+	}
+	key := dedupKey(tenant, digest)
+	previous, err := d.indexedKey(ctx, objectName)
+	if err != nil {
+		return err
+	}
+
+	// The reference is added before the content is checked, and the content is checked once a deletion by PruneBlobs
+	// is over, so that PruneBlobs either finds the reference or deletes the content before it is checked
+	if err = d.base.Upload(ctx, []byte{}, dedupRefName(key, objectName)); err != nil {
+		return errors.Wrapf(err, "failed to reference the content of object %s", objectName)
+	}
+	if _, err = d.base.DeleteObject(ctx, dedupOrphansPrefix+key); err != nil {
+		return errors.Wrapf(err, "failed to reference the content of object %s", objectName)
+	}
+	if err = d.waitForDeletion(ctx, key); err != nil {
+		return errors.Wrapf(err, "failed to reference the content of object %s", objectName)
+	}
+	blobName := dedupBlobName(key)
+	exists, err := d.base.DoesObjectExist(ctx, blobName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find the content of object %s", objectName)
+	}
+	if exists {
+		log.Infof("Object %s has the same content as stored object %s", objectName, blobName)
+	} else if err = upload(blobName); err != nil {
+		return err
+	}
+
+	if err = d.base.Upload(ctx, []byte(key), dedupIndexName(objectName)); err != nil {
+		return errors.Wrapf(err, "failed to index object %s", objectName)
+	}
+	pointer, err := json.Marshal(&dedupPointer{Tenant: tenant, Digest: digest, Size: size})
+	if err != nil {
+		return err
+	}
+	if err = d.base.Upload(ctx, append([]byte(dedupPointerMagic), pointer...), objectName); err != nil {
+		return err
+	}
+	if previous != "" && previous != key {
+		if _, err = d.base.DeleteObject(ctx, dedupRefName(previous, objectName)); err != nil {
+			return errors.Wrapf(err, "failed to release the previous content of object %s", objectName)
+		}
+	}
+	return nil
+}
+
+// waitForDeletion waits until PruneBlobs is done deleting the content, if it is deleting it
+func (d *DedupClient) waitForDeletion(ctx context.Context, key string) error {
+	deadline := time.Now().Add(dedupDeletionTimeout)
+	for time.Now().Before(deadline) {
+		deleting, err := d.base.DoesObjectExist(ctx, dedupDeletingPrefix+key)
+		if err != nil || !deleting {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(dedupDeletionPollInterval):
+		}
+	}
+	return nil
+}
+
+// indexedKey returns the key of the content referenced by the object, empty when the object isn't deduplicated
+func (d *DedupClient) indexedKey(ctx context.Context, objectName string) (string, error) {
+	reader, _, err := d.base.Download(ctx, dedupIndexName(objectName))
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "failed to get the index of object %s", objectName)
+	}
+	defer reader.Close()
+	key, err := io.ReadAll(reader)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the index of object %s", objectName)
+	}
+	return string(key), nil
+}
+
+// release removes the reference of the object to its content
+func (d *DedupClient) release(ctx context.Context, objectName, key string) error {
+	if _, err := d.base.DeleteObject(ctx, dedupRefName(key, objectName)); err != nil {
+		return errors.Wrapf(err, "failed to release the content of object %s", objectName)
+	}
+	if _, err := d.base.DeleteObject(ctx, dedupIndexName(objectName)); err != nil {
+		return errors.Wrapf(err, "failed to delete the index of object %s", objectName)
+	}
+	return nil
+}
+
+func (d *DedupClient) releaseIndexed(ctx context.Context, objectName string) error {
+	key, err := d.indexedKey(ctx, objectName)
+	if err != nil || key == "" {
+		return err
+	}
+	return d.release(ctx, objectName, key)
+}
+
+// pointer returns the pointer stored as the object, nil when the object isn't deduplicated
+func (d *DedupClient) pointer(ctx context.Context, objectName string) (*dedupPointer, error) {
+	size, err := d.base.GetObjectSizeBytes(ctx, objectName)
+	if err != nil || size > maxDedupPointerSize {
+		return nil, err
+	}
+	reader, _, err := d.base.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return readDedupPointer(bufio.NewReader(reader))
+}
+
+// readDedupPointer returns the pointer at the beginning of the reader, nil when it isn't one
+func readDedupPointer(reader *bufio.Reader) (*dedupPointer, error) {
+	magic, err := reader.Peek(len(dedupPointerMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if string(magic) != dedupPointerMagic {
+		return nil, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(reader, maxDedupPointerSize))
+	if err != nil {
+		return nil, err
+	}
+	var pointer dedupPointer
+	if err = json.Unmarshal(data[len(dedupPointerMagic):], &pointer); err != nil {
+		return nil, errors.Wrap(err, "invalid deduplicated object pointer")
+	}
+	return &pointer, nil
+}
+
+func (d *DedupClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	reader, size, err := d.base.Download(ctx, objectName)
+	if err != nil || size > maxDedupPointerSize {
+		return reader, size, err
+	}
+	buffered := bufio.NewReader(reader)
+	pointer, err := readDedupPointer(buffered)
+	if err != nil {
+		reader.Close()
+		return nil, 0, errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	if pointer == nil {
+		return readCloser{Reader: buffered, Closer: reader}, size, nil
+	}
+	reader.Close()
+	return d.base.Download(ctx, dedupBlobName(pointer.key()))
+}
+
+func (d *DedupClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return d.base.DoesObjectExist(ctx, objectName)
+}
+
+func (d *DedupClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	if err := d.releaseIndexed(ctx, objectName); err != nil {
+		return false, err
+	}
+	return d.base.DeleteObject(ctx, objectName)
+}
+
+func (d *DedupClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	size, err := d.base.GetObjectSizeBytes(ctx, objectName)
+	if err != nil || size > maxDedupPointerSize {
+		return size, err
+	}
+	pointer, err := d.pointer(ctx, objectName)
+	if err != nil || pointer == nil {
+		return size, err
+	}
+	return pointer.Size, nil
+}
+
+func (d *DedupClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	pointer, err := d.pointer(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	if pointer != nil {
+		objectName = dedupBlobName(pointer.key())
+	}
+	return d.base.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
+}
+
+func (d *DedupClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	return d.base.UpdateObjectTimestamp(ctx, objectName)
+}
+
+func (d *DedupClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	expireObjects(ctx, d.log, d.base, prefix, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) {
+		if err := d.releaseIndexed(ctx, objectName); err != nil {
+			log.WithError(err).Errorf("Failed to release the content of expired object %s", objectName)
+		}
+		callback(ctx, log, objectName)
+	})
+}
+
+func (d *DedupClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	return listObjects(ctx, d.base, prefix)
+}
+
+// needsMigration tells whether a large object isn't deduplicated, or references a content shared by the tenants, or
+// the underlying storage needs the object to be rewritten
+func (d *DedupClient) needsMigration(ctx context.Context, objectName string) (bool, error) {
+	size, err := d.base.GetObjectSizeBytes(ctx, objectName)
+	if err != nil {
+		return false, err
+	}
+	if size >= d.cfg.MinSize {
+		return true, nil
+	}
+	if size <= maxDedupPointerSize {
+		pointer, err := d.pointer(ctx, objectName)
+		if err != nil {
+			return false, err
+		}
+		if pointer != nil && pointer.Tenant == "" {
+			return true, nil
+		}
+	}
+	if m, ok := d.base.(migrator); ok {
+		return m.needsMigration(ctx, objectName)
+	}
+	return false, nil
+}
+
+// PruneBlobs deletes the contents left without references for the grace period. A content is marked as an orphan
+// first, and deleted by a later run once the grace period is over if it wasn't referenced again meanwhile. The content
+// is deleted under a deletion marker, and its references are listed again once the marker is stored: the uploads
+// referencing the content meanwhile wait for the deletion to be over before checking the content.
+func (d *DedupClient) PruneBlobs(ctx context.Context) error {
+	log := logutil.FromContext(ctx, d.log)
+	blobNames, err := listInternalObjects(ctx, d.base, dedupBlobsPrefix)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the deduplicated contents")
+	}
+	orphanNames, err := listInternalObjects(ctx, d.base, dedupOrphansPrefix)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the orphan contents")
+	}
+	orphans := map[string]bool{}
+	for _, orphanName := range orphanNames {
+		orphans[strings.TrimPrefix(orphanName, dedupOrphansPrefix)] = true
+	}
+
+	for _, blobName := range blobNames {
+		key := strings.TrimPrefix(blobName, dedupBlobsPrefix)
+		referenced, err := d.isReferenced(ctx, key)
+		if err != nil {
+			return err
+		}
+		switch {
+		case referenced:
+			if orphans[key] {
+				_, err = d.base.DeleteObject(ctx, dedupOrphansPrefix+key)
+			}
+		case orphans[key]:
+			var expired bool
+			if expired, err = d.isOrphanExpired(ctx, key); err == nil && expired {
+				log.Infof("Deleting unreferenced deduplicated content %s", key)
+				err = d.deleteBlob(ctx, key)
+			}
+		default:
+			err = d.markOrphan(ctx, key)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to prune content %s", key)
+		}
+		delete(orphans, key)
+	}
+
+	// The markers of the contents deleted otherwise
+	for key := range orphans {
+		if _, err = d.base.DeleteObject(ctx, dedupOrphansPrefix+key); err != nil {
+			return errors.Wrapf(err, "failed to delete the orphan marker of content %s", key)
+		}
+	}
+	return nil
+}
+
+func (d *DedupClient) isReferenced(ctx context.Context, key string) (bool, error) {
+	refs, err := listInternalObjects(ctx, d.base, dedupRefsPrefix+key+"/")
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list the references of content %s", key)
+	}
+	return len(refs) > 0, nil
+}
+
+// markOrphan stores the time the content was found without references
+func (d *DedupClient) markOrphan(ctx context.Context, key string) error {
+	return d.base.Upload(ctx, []byte(time.Now().UTC().Format(time.RFC3339)), dedupOrphansPrefix+key)
+}
+
+// isOrphanExpired tells whether the content was found without references for the grace period. The markers without a
+// valid time are marked again.
+func (d *DedupClient) isOrphanExpired(ctx context.Context, key string) (bool, error) {
+	reader, _, err := d.base.Download(ctx, dedupOrphansPrefix+key)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return false, err
+	}
+	markedAt, err := time.Parse(time.RFC3339, string(data))
+	if err != nil {
+		return false, d.markOrphan(ctx, key)
+	}
+	return time.Since(markedAt) >= d.cfg.PruneGracePeriod, nil
+}
+
+// deleteBlob deletes a content under a deletion marker, unless it was referenced meanwhile
+func (d *DedupClient) deleteBlob(ctx context.Context, key string) error {
+	deletingName := dedupDeletingPrefix + key
+	if err := d.base.Upload(ctx, []byte{}, deletingName); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := d.base.DeleteObject(ctx, deletingName); err != nil {
+			logutil.FromContext(ctx, d.log).WithError(err).Errorf("Failed to delete the deletion marker of content %s", key)
+		}
+	}()
+	referenced, err := d.isReferenced(ctx, key)
+	if err != nil || referenced {
+		return err
+	}
+	if _, err = d.base.DeleteObject(ctx, dedupBlobName(key)); err != nil {
+		return err
+	}
+	_, err = d.base.DeleteObject(ctx, dedupOrphansPrefix+key)
+	return err
+}
@@ -0,0 +1,280 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("DedupClient", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		base    *FSClient
+		client  *DedupClient
+		large   = strings.Repeat("shared manifest\n", 200)
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		var err error
+		baseDir, err = os.MkdirTemp("", "dedup")
+		Expect(err).ToNot(HaveOccurred())
+		base = &FSClient{basedir: filepath.Join(baseDir, "storage"), log: log}
+		client = NewDedupClient(base, &DedupConfig{Enabled: true, MinSize: 2048}, testTenants, log)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(baseDir)
+	})
+
+	internalObjects := func(prefix string) []string {
+		objects, err := base.ListObjectsByPrefix(ctx, prefix)
+		Expect(err).ToNot(HaveOccurred())
+		return objects
+	}
+
+	download := func(objectName string) string {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(len(data))))
+		return string(data)
+	}
+
+	It("stores the large objects once per organization", func() {
+		Expect(client.Upload(ctx, []byte(large), "cluster1/manifests/openshift/a.yaml")).To(Succeed())
+		Expect(client.UploadStream(ctx, strings.NewReader(large), "cluster2/manifests/openshift/a.yaml")).To(Succeed())
+		file := filepath.Join(baseDir, "file")
+		Expect(os.WriteFile(file, []byte(large), 0600)).To(Succeed())
+		Expect(client.UploadFile(ctx, file, "cluster3/manifests/openshift/a.yaml")).To(Succeed())
+
+		Expect(internalObjects(dedupBlobsPrefix)).To(ConsistOf(HavePrefix(dedupBlobsPrefix+"org-a/"), HavePrefix(dedupBlobsPrefix+"org-b/")))
+		Expect(internalObjects(dedupRefsPrefix)).To(HaveLen(3))
+		for _, objectName := range []string{"cluster1/manifests/openshift/a.yaml", "cluster2/manifests/openshift/a.yaml", "cluster3/manifests/openshift/a.yaml"} {
+			Expect(download(objectName)).To(Equal(large))
+			size, err := client.GetObjectSizeBytes(ctx, objectName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(int64(len(large))))
+			Expect(len(readRaw(base, objectName))).To(BeNumerically("<", maxDedupPointerSize))
+		}
+	})
+
+	It("stores the small objects as is", func() {
+		Expect(client.UploadStream(ctx, strings.NewReader("small"), "cluster/small")).To(Succeed())
+		Expect(string(readRaw(base, "cluster/small"))).To(Equal("small"))
+		Expect(download("cluster/small")).To(Equal("small"))
+		Expect(internalObjects(internalPrefix)).To(BeEmpty())
+	})
+
+	It("releases the contents of the overwritten and deleted objects", func() {
+		Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+		Expect(client.Upload(ctx, []byte(large), "cluster3/a")).To(Succeed())
+
+		Expect(client.Upload(ctx, []byte(large+"changed"), "cluster1/a")).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(2))
+		Expect(internalObjects(dedupRefsPrefix)).To(HaveLen(2))
+		Expect(download("cluster1/a")).To(Equal(large + "changed"))
+
+		deleted, err := client.DeleteObject(ctx, "cluster3/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(internalObjects(dedupRefsPrefix)).To(HaveLen(1))
+
+		Expect(client.Upload(ctx, []byte("small"), "cluster1/a")).To(Succeed())
+		Expect(internalObjects(dedupRefsPrefix)).To(BeEmpty())
+		Expect(internalObjects(dedupObjectsPrefix)).To(BeEmpty())
+		Expect(download("cluster1/a")).To(Equal("small"))
+	})
+
+	It("releases the contents of the expired objects and doesn't expire the internal objects", func() {
+		fake := newFakeAzure("account")
+		server := httptest.NewServer(fake)
+		defer server.Close()
+		azure, err := NewAzureClient(&AzureConfig{AccountName: "account", AccountKey: "a2V5", Container: "assisted", EndpointURL: server.URL + "/account"}, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(azure.CreateBucket()).To(Succeed())
+		client = NewDedupClient(azure, &DedupConfig{Enabled: true, MinSize: 2048}, testTenants, log)
+		Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+		Expect(client.Upload(ctx, []byte(large), "cluster2/a")).To(Succeed())
+		for _, blob := range fake.containers["assisted"] {
+			blob.lastModified = time.Now().Add(-2 * time.Hour)
+		}
+
+		var expired []string
+		client.ExpireObjects(ctx, "", time.Hour, func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		})
+		Expect(expired).To(ConsistOf("cluster1/a", "cluster2/a"))
+		objects, err := azure.ListObjectsByPrefix(ctx, internalPrefix)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(HavePrefix(dedupBlobsPrefix+"org-a/"), HavePrefix(dedupBlobsPrefix+"org-b/")))
+	})
+
+	It("prunes the contents left without references on the next run", func() {
+		Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+		Expect(client.Upload(ctx, []byte(large+"other"), "cluster2/a")).To(Succeed())
+		_, err := client.DeleteObject(ctx, "cluster1/a")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(2))
+		Expect(internalObjects(dedupOrphansPrefix)).To(HaveLen(1))
+
+		By("referencing the orphan content again")
+		Expect(client.Upload(ctx, []byte(large), "cluster3/a")).To(Succeed())
+		Expect(internalObjects(dedupOrphansPrefix)).To(BeEmpty())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(2))
+
+		By("deleting the content on the next run")
+		_, err = client.DeleteObject(ctx, "cluster3/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(1))
+		Expect(internalObjects(dedupOrphansPrefix)).To(BeEmpty())
+		Expect(download("cluster2/a")).To(Equal(large + "other"))
+	})
+
+	It("keeps the contents left without references for the grace period", func() {
+		client = NewDedupClient(base, &DedupConfig{Enabled: true, MinSize: 2048, PruneGracePeriod: time.Hour}, testTenants, log)
+		Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+		_, err := client.DeleteObject(ctx, "cluster1/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(1))
+
+		By("rewriting the invalid orphan markers")
+		orphans := internalObjects(dedupOrphansPrefix)
+		Expect(orphans).To(HaveLen(1))
+		Expect(base.Upload(ctx, []byte("invalid"), orphans[0])).To(Succeed())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(1))
+		markedAt, err := time.Parse(time.RFC3339, string(readRaw(base, orphans[0])))
+		Expect(err).ToNot(HaveOccurred())
+
+		By("deleting the content once the grace period is over")
+		Expect(base.Upload(ctx, []byte(markedAt.Add(-2*time.Hour).Format(time.RFC3339)), orphans[0])).To(Succeed())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(BeEmpty())
+		Expect(internalObjects(internalPrefix)).To(BeEmpty())
+	})
+
+	It("doesn't delete a content referenced while it is being deleted", func() {
+		Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+		key := strings.TrimPrefix(internalObjects(dedupBlobsPrefix)[0], dedupBlobsPrefix)
+		Expect(client.deleteBlob(ctx, key)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(1))
+		Expect(internalObjects(dedupDeletingPrefix)).To(BeEmpty())
+		Expect(download("cluster1/a")).To(Equal(large))
+	})
+
+	It("moves the contents shared by the organizations to the contents of the organizations", func() {
+		digest := sha256.Sum256([]byte(large))
+		key := hex.EncodeToString(digest[:])
+		Expect(base.Upload(ctx, []byte(large), dedupBlobName(key))).To(Succeed())
+		for _, objectName := range []string{"cluster1/a", "cluster2/a"} {
+			pointer, err := json.Marshal(&dedupPointer{Digest: key, Size: int64(len(large))})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(base.Upload(ctx, append([]byte(dedupPointerMagic), pointer...), objectName)).To(Succeed())
+			Expect(base.Upload(ctx, []byte{}, dedupRefName(key, objectName))).To(Succeed())
+			Expect(base.Upload(ctx, []byte(key), dedupIndexName(objectName))).To(Succeed())
+		}
+		Expect(download("cluster1/a")).To(Equal(large))
+
+		result, err := MigrateObjects(ctx, client, "", false, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*result).To(Equal(MigrationResult{Checked: 2, Migrated: 2}))
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(client.PruneBlobs(ctx)).To(Succeed())
+		Expect(internalObjects(dedupBlobsPrefix)).To(ConsistOf(dedupBlobName("org-a/"+key), dedupBlobName("org-b/"+key)))
+		Expect(download("cluster1/a")).To(Equal(large))
+		Expect(download("cluster2/a")).To(Equal(large))
+	})
+
+	It("presigns the URL of the content", func() {
+		Expect(client.Upload(ctx, []byte(large), "cluster/a")).To(Succeed())
+		_, err := client.GeneratePresignedDownloadURL(ctx, "cluster/a", "a.yaml", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		objects, err := client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{"cluster/a"}))
+	})
+
+	It("rejects a minimal size smaller than the pointers", func() {
+		_, err := NewWrappedClient(base, &EncryptionConfig{}, &DedupConfig{Enabled: true, MinSize: 100}, testTenants, log)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("with encryption", func() {
+		var wrapped API
+
+		BeforeEach(func() {
+			var err error
+			wrapped, err = NewWrappedClient(base,
+				&EncryptionConfig{Enabled: true, KeyProvider: "local", KeysFile: writeKeysFile(baseDir, "k1", "k1")},
+				&DedupConfig{Enabled: true, MinSize: 2048}, testTenants, log)
+			Expect(err).ToNot(HaveOccurred())
+			client = wrapped.(*DedupClient)
+		})
+
+		It("encrypts the contents with the key of their organization and the pointers", func() {
+			Expect(client.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+			Expect(client.Upload(ctx, []byte(large), "cluster3/a")).To(Succeed())
+			blobs := internalObjects(dedupBlobsPrefix)
+			Expect(blobs).To(ConsistOf(HavePrefix(dedupBlobsPrefix + "org-a/")))
+			Expect(string(readRaw(base, blobs[0])[:len(encryptedMagic)])).To(Equal(encryptedMagic))
+			Expect(string(readRaw(base, "cluster1/a")[:len(encryptedMagic)])).To(Equal(encryptedMagic))
+			Expect(download("cluster3/a")).To(Equal(large))
+			Expect(internalObjects(internalPrefix + "keys")).To(ContainElement(tenantKeyObjectName("org-a")))
+
+			_, err := client.DeleteObject(ctx, "cluster1/a")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.DeleteObject(ctx, "cluster3/a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.PruneBlobs(ctx)).To(Succeed())
+			Expect(client.PruneBlobs(ctx)).To(Succeed())
+			Expect(internalObjects(dedupBlobsPrefix)).To(BeEmpty())
+		})
+
+		It("migrates the objects stored before", func() {
+			Expect(base.Upload(ctx, []byte(large), "cluster1/a")).To(Succeed())
+			Expect(base.Upload(ctx, []byte(large), "cluster2/a")).To(Succeed())
+			Expect(base.Upload(ctx, []byte("kubeconfig"), "cluster1/kubeconfig")).To(Succeed())
+			Expect(client.Upload(ctx, []byte("new"), "cluster2/kubeconfig")).To(Succeed())
+
+			result, err := MigrateObjects(ctx, wrapped, "", true, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result).To(Equal(MigrationResult{Checked: 4, Migrated: 3}))
+			Expect(string(readRaw(base, "cluster1/kubeconfig"))).To(Equal("kubeconfig"))
+
+			result, err = MigrateObjects(ctx, wrapped, "", false, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result).To(Equal(MigrationResult{Checked: 6, Migrated: 3}))
+			Expect(string(readRaw(base, "cluster1/kubeconfig")[:len(encryptedMagic)])).To(Equal(encryptedMagic))
+			Expect(internalObjects(dedupBlobsPrefix)).To(HaveLen(2))
+			Expect(download("cluster1/a")).To(Equal(large))
+			Expect(download("cluster1/kubeconfig")).To(Equal("kubeconfig"))
+
+			result, err = MigrateObjects(ctx, wrapped, "", false, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result).To(Equal(MigrationResult{Checked: 6}))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package s3wrapper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+// The well known account of Azurite
+const azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+// The clients are tested against the emulators of the storages when their URLs are given, e.g.
+//
+//	docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+//	docker run -p 4443:4443 fsouza/fake-gcs-server -scheme http -public-host localhost:4443
+//	AZURITE_ENDPOINT_URL=http://127.0.0.1:10000/devstoreaccount1 GCS_EMULATOR_ENDPOINT_URL=http://localhost:4443 \
+//	    go test ./pkg/s3wrapper/...
+var _ = Describe("Storage emulators", func() {
+	var (
+		ctx    = context.Background()
+		log    = logrus.New()
+		prefix string
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		prefix = fmt.Sprintf("test-%s/", uuid.New().String())
+	})
+
+	testClient := func(client API) {
+		Expect(client.CreateBucket()).To(Succeed())
+		Expect(client.CreateBucket()).To(Succeed())
+
+		objectName := prefix + "object"
+		Expect(client.Upload(ctx, []byte("hello world"), objectName)).To(Succeed())
+		exists, err := client.DoesObjectExist(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		size, err := client.GetObjectSizeBytes(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(11)))
+		reader, _, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(data)).To(Equal("hello world"))
+		_, _, err = client.Download(ctx, prefix+"missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+
+		Expect(client.Upload(ctx, []byte("other"), prefix+"other")).To(Succeed())
+		objects, err := client.ListObjectsByPrefix(ctx, prefix)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(objectName, prefix+"other"))
+
+		updated, err := client.UpdateObjectTimestamp(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		var expired []string
+		client.ExpireObjects(ctx, prefix, -time.Minute, func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		})
+		Expect(expired).To(ConsistOf(objectName, prefix+"other"))
+		objects, err = client.ListObjectsByPrefix(ctx, prefix)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	}
+
+	It("Azurite", func() {
+		endpoint := os.Getenv("AZURITE_ENDPOINT_URL")
+		if endpoint == "" {
+			Skip("AZURITE_ENDPOINT_URL is not set")
+		}
+		client, err := NewAzureClient(&AzureConfig{
+			AccountName: "devstoreaccount1",
+			AccountKey:  azuriteAccountKey,
+			Container:   "assisted",
+			EndpointURL: endpoint,
+		}, log)
+		Expect(err).ToNot(HaveOccurred())
+		testClient(client)
+	})
+
+	It("fake-gcs-server", func() {
+		endpoint := os.Getenv("GCS_EMULATOR_ENDPOINT_URL")
+		if endpoint == "" {
+			Skip("GCS_EMULATOR_ENDPOINT_URL is not set")
+		}
+		client, err := NewGCSClient(&GCSConfig{Bucket: "assisted", ProjectID: "test", EndpointURL: endpoint}, log)
+		Expect(err).ToNot(HaveOccurred())
+		testClient(client)
+	})
+})
@@ -0,0 +1,712 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// The objects of the wrappers of the storage, hidden from their clients
+	internalPrefix = ".assisted-storage/"
+
+	encryptedMagic = "ASENC02\n"
+	// The magic of the objects encrypted without binding them to their name, which are refused
+	legacyEncryptedMagic = "ASENC01\n"
+	encryptedChunkSize   = 64 * 1024
+	// The size of the beginning of the encrypted objects holding the magic, the length of the header and the header,
+	// so that the size of the plaintext is known from the size of the object
+	encryptedHeaderSize = 4096
+	// The size of the authentication tag of AES-GCM, added to each chunk
+	gcmTagSize = 16
+	// The tenant of the objects without owner
+	defaultTenant = "default"
+	// The tenant of the internal objects of the wrappers, e.g. the index of the deduplicated objects
+	sharedTenant = "shared"
+)
+
+// TenantResolver returns the organization owning an object, i.e. the organization of the cluster or of the infra-env
+// the object belongs to. It is empty when the object has no owner.
+type TenantResolver func(ctx context.Context, objectName string) (string, error)
+
+type plaintextAllowedKey struct{}
+
+// withPlaintextAllowed returns a context reading the objects stored before the encryption was enabled, for their
+// migration
+func withPlaintextAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, plaintextAllowedKey{}, true)
+}
+
+func plaintextAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(plaintextAllowedKey{}).(bool)
+	return allowed
+}
+
+type EncryptionConfig struct {
+	Enabled bool `envconfig:"STORAGE_ENCRYPTION_ENABLED" default:"false"`
+	// The provider of the key encryption keys, see RegisterKeyProvider
+	KeyProvider string `envconfig:"STORAGE_ENCRYPTION_KEY_PROVIDER" default:"local"`
+	// The keys of the local provider
+	KeysFile string `envconfig:"STORAGE_ENCRYPTION_KEYS_FILE"`
+}
+
+// WrappedKey is a data key encrypted by a key encryption key of a KeyProvider
+type WrappedKey struct {
+	KeyID      string `json:"key_id"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// KeyProvider encrypts the data keys with key encryption keys (KEK) it doesn't disclose, e.g. the keys of a KMS
+type KeyProvider interface {
+	WrapKey(ctx context.Context, dataKey []byte) (*WrappedKey, error)
+	UnwrapKey(ctx context.Context, key *WrappedKey) ([]byte, error)
+	// IsCurrent tells whether the data key was wrapped by the current KEK, rather than by a retired one
+	IsCurrent(key *WrappedKey) bool
+}
+
+type KeyProviderFactory func(cfg *EncryptionConfig) (KeyProvider, error)
+
+var (
+	keyProvidersLock sync.Mutex
+	keyProviders     = map[string]KeyProviderFactory{
+		"local": func(cfg *EncryptionConfig) (KeyProvider, error) {
+			return NewLocalKeyProvider(cfg.KeysFile)
+		},
+	}
+)
+
+// RegisterKeyProvider makes a KEK provider selectable by the STORAGE_ENCRYPTION_KEY_PROVIDER setting
+func RegisterKeyProvider(name string, factory KeyProviderFactory) {
+	keyProvidersLock.Lock()
+	defer keyProvidersLock.Unlock()
+	keyProviders[name] = factory
+}
+
+func newKeyProvider(cfg *EncryptionConfig) (KeyProvider, error) {
+	keyProvidersLock.Lock()
+	factory, ok := keyProviders[cfg.KeyProvider]
+	keyProvidersLock.Unlock()
+	if !ok {
+		return nil, errors.Errorf("unsupported storage encryption key provider %s", cfg.KeyProvider)
+	}
+	return factory(cfg)
+}
+
+// LocalKeyProvider wraps the data keys with the AES-256 keys of a local file, e.g. a mounted secret:
+//
+//	current_key: "2024-01"
+//	keys:
+//	  "2023-01": <base64 encoded key>
+//	  "2024-01": <base64 encoded key>
+//
+// The data keys are wrapped by the current key, the retired keys still unwrap the data keys they wrapped.
+type LocalKeyProvider struct {
+	currentKey string
+	keys       map[string]cipher.AEAD
+}
+
+func NewLocalKeyProvider(keysFile string) (*LocalKeyProvider, error) {
+	data, err := os.ReadFile(keysFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the storage encryption keys file %s", keysFile)
+	}
+	var file struct {
+		CurrentKey string            `json:"current_key"`
+		Keys       map[string]string `json:"keys"`
+	}
+	if err = yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the storage encryption keys file %s", keysFile)
+	}
+	if _, ok := file.Keys[file.CurrentKey]; !ok {
+		return nil, errors.Errorf("the current key %q of the storage encryption keys file %s doesn't exist", file.CurrentKey, keysFile)
+	}
+	p := &LocalKeyProvider{currentKey: file.CurrentKey, keys: map[string]cipher.AEAD{}}
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != 32 {
+			return nil, errors.Errorf("the key %q of the storage encryption keys file %s isn't a base64 encoded 256 bits key", id, keysFile)
+		}
+		if p.keys[id], err = newGCM(key); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *LocalKeyProvider) WrapKey(_ context.Context, dataKey []byte) (*WrappedKey, error) {
+	aead := p.keys[p.currentKey]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &WrappedKey{KeyID: p.currentKey, Ciphertext: aead.Seal(nonce, nonce, dataKey, []byte(p.currentKey))}, nil
+}
+
+func (p *LocalKeyProvider) UnwrapKey(_ context.Context, key *WrappedKey) ([]byte, error) {
+	aead, ok := p.keys[key.KeyID]
+	if !ok {
+		return nil, errors.Errorf("unknown key encryption key %q", key.KeyID)
+	}
+	if len(key.Ciphertext) < aead.NonceSize() {
+		return nil, errors.New("invalid wrapped key")
+	}
+	nonce, ciphertext := key.Ciphertext[:aead.NonceSize()], key.Ciphertext[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(key.KeyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap a data key with key encryption key %q", key.KeyID)
+	}
+	return dataKey, nil
+}
+
+func (p *LocalKeyProvider) IsCurrent(key *WrappedKey) bool {
+	return key.KeyID == p.currentKey
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+var _ API = &EncryptedClient{}
+
+// EncryptedClient encrypts the objects of the underlying storage with AES-256-GCM (envelope encryption). Each tenant,
+// i.e. the organization owning the cluster or the infra-env of the objects, has a data key of its own, wrapped by the
+// KEK provider. The wrapped data key is stored in the header of each object, so that the objects are decrypted even
+// when the data key of their tenant is renewed. The header and the name of the object are authenticated with each
+// chunk, so that an object can't be replaced by another one. The objects which aren't encrypted are rejected, they are
+// only read by MigrateObjects.
+//
+// The objects are decrypted by the service, so they can't be downloaded from the storage with presigned URLs.
+type EncryptedClient struct {
+	base     API
+	provider KeyProvider
+	tenants  TenantResolver
+	log      logrus.FieldLogger
+
+	lock        sync.Mutex
+	tenantKeys  map[string]*tenantKey
+	unwrapCache map[[sha256.Size]byte][]byte
+}
+
+type tenantKey struct {
+	plaintext []byte
+	wrapped   *WrappedKey
+}
+
+type encryptionHeader struct {
+	Tenant    string      `json:"tenant"`
+	Key       *WrappedKey `json:"key"`
+	ChunkSize int         `json:"chunk_size"`
+	Nonce     []byte      `json:"nonce"`
+}
+
+func NewEncryptedClient(base API, provider KeyProvider, tenants TenantResolver, log logrus.FieldLogger) *EncryptedClient {
+	return &EncryptedClient{
+		base:        base,
+		provider:    provider,
+		tenants:     tenants,
+		log:         log,
+		tenantKeys:  map[string]*tenantKey{},
+		unwrapCache: map[[sha256.Size]byte][]byte{},
+	}
+}
+
+// objectTenant returns the tenant of an object: the organization owning it, or the tenant of the namespace of a
+// deduplicated content
+func objectTenant(ctx context.Context, tenants TenantResolver, objectName string) (string, error) {
+	if isInternalObject(objectName) {
+		if key := strings.TrimPrefix(objectName, dedupBlobsPrefix); key != objectName {
+			if i := strings.Index(key, "/"); i > 0 {
+				return key[:i], nil
+			}
+		}
+		return sharedTenant, nil
+	}
+	if tenants == nil {
+		return defaultTenant, nil
+	}
+	org, err := tenants(ctx, objectName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the tenant of object %s", objectName)
+	}
+	if org == "" {
+		return defaultTenant, nil
+	}
+	return "org-" + url.PathEscape(org), nil
+}
+
+func isInternalObject(objectName string) bool {
+	return strings.HasPrefix(objectName, internalPrefix)
+}
+
+func tenantKeyObjectName(tenant string) string {
+	return path.Join(internalPrefix, "keys", tenant)
+}
+
+// tenantKey returns the data key of the tenant, creating it when the tenant has none or when its key was wrapped by a
+// retired KEK
+func (e *EncryptedClient) tenantKey(ctx context.Context, tenant string) (*tenantKey, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if key, ok := e.tenantKeys[tenant]; ok && e.provider.IsCurrent(key.wrapped) {
+		return key, nil
+	}
+
+	objectName := tenantKeyObjectName(tenant)
+	reader, _, err := e.base.Download(ctx, objectName)
+	if err == nil {
+		var wrapped WrappedKey
+		err = json.NewDecoder(reader).Decode(&wrapped)
+		reader.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the data key of tenant %s", tenant)
+		}
+		if e.provider.IsCurrent(&wrapped) {
+			plaintext, err := e.provider.UnwrapKey(ctx, &wrapped)
+			if err != nil {
+				return nil, err
+			}
+			e.tenantKeys[tenant] = &tenantKey{plaintext: plaintext, wrapped: &wrapped}
+			return e.tenantKeys[tenant], nil
+		}
+	} else if _, ok := err.(common.NotFound); !ok {
+		return nil, errors.Wrapf(err, "failed to get the data key of tenant %s", tenant)
+	}
+
+	plaintext := make([]byte, 32)
+	if _, err = rand.Read(plaintext); err != nil {
+		return nil, err
+	}
+	wrapped, err := e.provider.WrapKey(ctx, plaintext)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to wrap the data key of tenant %s", tenant)
+	}
+	data, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if err = e.base.Upload(ctx, data, objectName); err != nil {
+		return nil, errors.Wrapf(err, "failed to store the data key of tenant %s", tenant)
+	}
+	logutil.FromContext(ctx, e.log).Infof("Created a new data key for tenant %s", tenant)
+	e.tenantKeys[tenant] = &tenantKey{plaintext: plaintext, wrapped: wrapped}
+	return e.tenantKeys[tenant], nil
+}
+
+// unwrapKey returns the data key of the header of an object
+func (e *EncryptedClient) unwrapKey(ctx context.Context, wrapped *WrappedKey) ([]byte, error) {
+	cacheKey := sha256.Sum256(append([]byte(wrapped.KeyID+"\n"), wrapped.Ciphertext...))
+	e.lock.Lock()
+	plaintext, ok := e.unwrapCache[cacheKey]
+	e.lock.Unlock()
+	if ok {
+		return plaintext, nil
+	}
+	plaintext, err := e.provider.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	e.lock.Lock()
+	e.unwrapCache[cacheKey] = plaintext
+	e.lock.Unlock()
+	return plaintext, nil
+}
+
+// encrypt returns the encrypted stream of the object. The plaintext is split in chunks sealed with successive nonces,
+// the last chunk being flagged in its additional data so that a truncated object can't be decrypted.
+func (e *EncryptedClient) encrypt(ctx context.Context, reader io.Reader, objectName string) (io.ReadCloser, error) {
+	tenant, err := objectTenant(ctx, e.tenants, objectName)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.tenantKey(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(key.plaintext)
+	if err != nil {
+		return nil, err
+	}
+	header := encryptionHeader{Tenant: tenant, Key: key.wrapped, ChunkSize: encryptedChunkSize, Nonce: make([]byte, aead.NonceSize()-4)}
+	if _, err = rand.Read(header.Nonce); err != nil {
+		return nil, err
+	}
+	headerData, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if len(encryptedMagic)+4+len(headerData) > encryptedHeaderSize {
+		return nil, errors.Errorf("the encryption header of object %s is too large", objectName)
+	}
+	headerBlock := make([]byte, encryptedHeaderSize)
+	copy(headerBlock, encryptedMagic)
+	binary.BigEndian.PutUint32(headerBlock[len(encryptedMagic):], uint32(len(headerData)))
+	copy(headerBlock[len(encryptedMagic)+4:], headerData)
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeEncrypted(pw, reader, aead, headerBlock, objectBinding(headerBlock, objectName), header.Nonce))
+	}()
+	return pr, nil
+}
+
+// objectBinding returns the digest of the header and of the name of an object, authenticated with each chunk
+func objectBinding(headerBlock []byte, objectName string) []byte {
+	hash := sha256.New()
+	hash.Write(headerBlock)
+	hash.Write([]byte(objectName))
+	return hash.Sum(nil)
+}
+
+func writeEncrypted(w io.Writer, reader io.Reader, aead cipher.AEAD, headerBlock, binding, noncePrefix []byte) error {
+	if _, err := w.Write(headerBlock); err != nil {
+		return err
+	}
+
+	src := bufio.NewReaderSize(reader, encryptedChunkSize)
+	plaintext := make([]byte, encryptedChunkSize)
+	ciphertext := make([]byte, 0, encryptedChunkSize+aead.Overhead())
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(src, plaintext)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		last := err != nil
+		if !last {
+			if _, err = src.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return err
+			}
+		}
+		ciphertext = aead.Seal(ciphertext[:0], chunkNonce(noncePrefix, counter), plaintext[:n], chunkAdditionalData(binding, last))
+		if _, err = w.Write(ciphertext); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+func chunkNonce(prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, len(prefix)+4)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(prefix):], counter)
+	return nonce
+}
+
+// chunkAdditionalData returns the additional data of a chunk: the binding of the chunk to its object, and whether
+// it is the last chunk
+func chunkAdditionalData(binding []byte, last bool) []byte {
+	ad := make([]byte, len(binding)+1)
+	copy(ad, binding)
+	if last {
+		ad[len(binding)] = 1
+	}
+	return ad
+}
+
+// readEncryptionHeader returns the header of an encrypted object and the block holding it, nil when the object isn't
+// encrypted
+func readEncryptionHeader(reader *bufio.Reader) (*encryptionHeader, []byte, error) {
+	magic, err := reader.Peek(len(encryptedMagic))
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	if string(magic) == legacyEncryptedMagic {
+		return nil, nil, errors.New("unsupported encryption format")
+	}
+	if string(magic) != encryptedMagic {
+		return nil, nil, nil
+	}
+	headerBlock := make([]byte, encryptedHeaderSize)
+	if _, err = io.ReadFull(reader, headerBlock); err != nil {
+		return nil, nil, errors.Wrap(err, "truncated encryption header")
+	}
+	length := int(binary.BigEndian.Uint32(headerBlock[len(encryptedMagic):]))
+	if length > encryptedHeaderSize-len(encryptedMagic)-4 {
+		return nil, nil, errors.New("invalid encryption header")
+	}
+	var header encryptionHeader
+	if err = json.Unmarshal(headerBlock[len(encryptedMagic)+4:len(encryptedMagic)+4+length], &header); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid encryption header")
+	}
+	if header.Key == nil || header.ChunkSize != encryptedChunkSize {
+		return nil, nil, errors.New("invalid encryption header")
+	}
+	return &header, headerBlock, nil
+}
+
+// plaintextSize returns the size of the plaintext of an encrypted object, given the size of its chunks
+func plaintextSize(encryptedSize int64, chunkSize int, overhead int) int64 {
+	sealedChunkSize := int64(chunkSize + overhead)
+	chunks := (encryptedSize + sealedChunkSize - 1) / sealedChunkSize
+	return encryptedSize - chunks*int64(overhead)
+}
+
+type decryptingReader struct {
+	src         *bufio.Reader
+	aead        cipher.AEAD
+	binding     []byte
+	noncePrefix []byte
+	chunk       []byte
+	plaintext   []byte
+	counter     uint32
+	done        bool
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(d.src, d.chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		last := err != nil
+		if !last {
+			if _, err = d.src.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return 0, err
+			}
+		}
+		d.plaintext, err = d.aead.Open(d.chunk[:0], chunkNonce(d.noncePrefix, d.counter), d.chunk[:n], chunkAdditionalData(d.binding, last))
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to decrypt the object")
+		}
+		d.counter++
+		d.done = last
+	}
+	n := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[n:]
+	return n, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// open returns the plaintext of an object of the underlying storage, with its size
+func (e *EncryptedClient) open(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	reader, size, err := e.base.Download(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	src := bufio.NewReaderSize(reader, encryptedChunkSize)
+	header, headerBlock, err := readEncryptionHeader(src)
+	if err != nil {
+		reader.Close()
+		return nil, 0, errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	if header == nil {
+		if plaintextAllowed(ctx) {
+			return readCloser{Reader: src, Closer: reader}, size, nil
+		}
+		reader.Close()
+		return nil, 0, errors.Errorf("object %s isn't encrypted", objectName)
+	}
+	dataKey, err := e.unwrapKey(ctx, header.Key)
+	if err != nil {
+		reader.Close()
+		return nil, 0, errors.Wrapf(err, "failed to get the data key of object %s", objectName)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		reader.Close()
+		return nil, 0, err
+	}
+	decrypting := &decryptingReader{
+		src:         src,
+		aead:        aead,
+		binding:     objectBinding(headerBlock, objectName),
+		noncePrefix: header.Nonce,
+		chunk:       make([]byte, header.ChunkSize+aead.Overhead()),
+	}
+	return readCloser{Reader: decrypting, Closer: reader}, plaintextSize(size-encryptedHeaderSize, header.ChunkSize, aead.Overhead()), nil
+}
+
+// needsMigration tells whether the object isn't encrypted, or its data key was wrapped by a retired KEK
+func (e *EncryptedClient) needsMigration(ctx context.Context, objectName string) (bool, error) {
+	reader, _, err := e.base.Download(ctx, objectName)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+	header, _, err := readEncryptionHeader(bufio.NewReader(reader))
+	if err != nil {
+		return false, err
+	}
+	return header == nil || !e.provider.IsCurrent(header.Key), nil
+}
+
+func (e *EncryptedClient) IsAwsS3() bool {
+	return false
+}
+
+func (e *EncryptedClient) CreateBucket() error {
+	return e.base.CreateBucket()
+}
+
+func (e *EncryptedClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return e.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+func (e *EncryptedClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	if reader == nil {
+		return errors.Errorf("Upfile log may not be nil. Cannot upload %s", objectName)
+	}
+	encrypted, err := e.encrypt(ctx, reader, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encrypt object %s", objectName)
+	}
+	defer encrypted.Close()
+	return e.base.UploadStream(ctx, encrypted, objectName)
+}
+
+func (e *EncryptedClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+	}
+	defer file.Close()
+	return e.UploadStream(ctx, file, objectName)
+}
+
+func (e *EncryptedClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return e.open(ctx, objectName)
+}
+
+func (e *EncryptedClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return e.base.DoesObjectExist(ctx, objectName)
+}
+
+func (e *EncryptedClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	return e.base.DeleteObject(ctx, objectName)
+}
+
+// GetObjectSizeBytes returns the size of the plaintext from the size of the object, since the header and the chunks
+// have a fixed size
+func (e *EncryptedClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	if plaintextAllowed(ctx) {
+		// The object may not be encrypted yet
+		reader, size, err := e.open(ctx, objectName)
+		if err != nil {
+			return 0, err
+		}
+		reader.Close()
+		return size, nil
+	}
+	size, err := e.base.GetObjectSizeBytes(ctx, objectName)
+	if err != nil {
+		return 0, err
+	}
+	if size < encryptedHeaderSize+gcmTagSize {
+		return 0, errors.Errorf("object %s isn't encrypted", objectName)
+	}
+	return plaintextSize(size-encryptedHeaderSize, encryptedChunkSize, gcmTagSize), nil
+}
+
+func (e *EncryptedClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	return "", errors.Errorf("encrypted object %s can't be downloaded with a presigned URL", objectName)
+}
+
+func (e *EncryptedClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	return e.base.UpdateObjectTimestamp(ctx, objectName)
+}
+
+func (e *EncryptedClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	expireObjects(ctx, e.log, e.base, prefix, deleteTime, callback)
+}
+
+func (e *EncryptedClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	return listObjects(ctx, e.base, prefix)
+}
+
+func (e *EncryptedClient) listInternalObjects(ctx context.Context, prefix string) ([]string, error) {
+	return listInternalObjects(ctx, e.base, prefix)
+}
+
+// internalLister is implemented by the wrappers hiding the internal objects of the storage they wrap
+type internalLister interface {
+	listInternalObjects(ctx context.Context, prefix string) ([]string, error)
+}
+
+// listInternalObjects lists the internal objects of the wrappers stored by the client
+func listInternalObjects(ctx context.Context, client API, prefix string) ([]string, error) {
+	if l, ok := client.(internalLister); ok {
+		return l.listInternalObjects(ctx, prefix)
+	}
+	return client.ListObjectsByPrefix(ctx, prefix)
+}
+
+// listObjects lists the objects of the underlying storage, except the internal objects of the wrappers
+func listObjects(ctx context.Context, base API, prefix string) ([]string, error) {
+	objectNames, err := base.ListObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		if !isInternalObject(objectName) {
+			result = append(result, objectName)
+		}
+	}
+	return result, nil
+}
+
+// expireObjects expires the objects of the underlying storage, except the internal objects of the wrappers. The
+// objects are expired by the first segment of their names when the prefix includes the internal objects.
+func expireObjects(ctx context.Context, log logrus.FieldLogger, base API, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if isInternalObject(prefix) {
+		return
+	}
+	if !strings.HasPrefix(internalPrefix, prefix) {
+		base.ExpireObjects(ctx, prefix, deleteTime, callback)
+		return
+	}
+	objectNames, err := listObjects(ctx, base, prefix)
+	if err != nil {
+		logutil.FromContext(ctx, log).WithError(err).Error("Error listing objects")
+		return
+	}
+	prefixes := map[string]bool{}
+	for _, objectName := range objectNames {
+		segment := strings.SplitN(objectName, "/", 2)[0]
+		if strings.Contains(objectName, "/") {
+			segment += "/"
+		}
+		if !prefixes[segment] {
+			prefixes[segment] = true
+			base.ExpireObjects(ctx, segment, deleteTime, callback)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func writeKeysFile(dir, currentKey string, keyIDs ...string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "current_key: %s\nkeys:\n", currentKey)
+	for _, keyID := range keyIDs {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		Expect(err).ToNot(HaveOccurred())
+		fmt.Fprintf(&content, "  %s: %s\n", keyID, base64.StdEncoding.EncodeToString(key))
+	}
+	file := filepath.Join(dir, "keys-"+currentKey+".yaml")
+	Expect(os.WriteFile(file, []byte(content.String()), 0600)).To(Succeed())
+	return file
+}
+
+// testTenants gives the objects of cluster1 and cluster3 to organization a and the objects of cluster2 to organization b
+func testTenants(_ context.Context, objectName string) (string, error) {
+	switch strings.SplitN(objectName, "/", 2)[0] {
+	case "cluster1", "cluster3":
+		return "a", nil
+	case "cluster2":
+		return "b", nil
+	case "broken":
+		return "", errors.New("database is down")
+	}
+	return "", nil
+}
+
+func readRaw(client API, objectName string) []byte {
+	reader, _, err := client.Download(context.Background(), objectName)
+	Expect(err).ToNot(HaveOccurred())
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	Expect(err).ToNot(HaveOccurred())
+	return data
+}
+
+var _ = Describe("EncryptedClient", func() {
+	var (
+		ctx      = context.Background()
+		log      = logrus.New()
+		baseDir  string
+		base     *FSClient
+		provider *LocalKeyProvider
+		client   *EncryptedClient
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		var err error
+		baseDir, err = os.MkdirTemp("", "encryption")
+		Expect(err).ToNot(HaveOccurred())
+		base = &FSClient{basedir: filepath.Join(baseDir, "storage"), log: log}
+		provider, err = NewLocalKeyProvider(writeKeysFile(baseDir, "k1", "k1"))
+		Expect(err).ToNot(HaveOccurred())
+		client = NewEncryptedClient(base, provider, testTenants, log)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(baseDir)
+	})
+
+	download := func(objectName string) ([]byte, int64) {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		return data, size
+	}
+
+	for _, size := range []int{0, 1, encryptedChunkSize - 1, encryptedChunkSize, 3*encryptedChunkSize + 17} {
+		size := size
+		It(fmt.Sprintf("encrypts and decrypts %d bytes", size), func() {
+			data := make([]byte, size)
+			_, err := rand.Read(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.Upload(ctx, data, "cluster/ignition")).To(Succeed())
+
+			raw := readRaw(base, "cluster/ignition")
+			Expect(string(raw[:len(encryptedMagic)])).To(Equal(encryptedMagic))
+			if size >= 16 {
+				Expect(bytes.Contains(raw, data)).To(BeFalse())
+			}
+
+			decrypted, downloadSize := download("cluster/ignition")
+			Expect(decrypted).To(Equal(data))
+			Expect(downloadSize).To(Equal(int64(size)))
+			objectSize, err := client.GetObjectSizeBytes(ctx, "cluster/ignition")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectSize).To(Equal(int64(size)))
+		})
+	}
+
+	It("uses a data key per organization", func() {
+		Expect(client.Upload(ctx, []byte("password1"), "cluster1/kubeadmin-password")).To(Succeed())
+		Expect(client.Upload(ctx, []byte("password2"), "cluster2/kubeadmin-password")).To(Succeed())
+		Expect(client.UploadStream(ctx, strings.NewReader("kubeconfig"), "cluster3/kubeconfig")).To(Succeed())
+		Expect(client.Upload(ctx, []byte("discovery"), "discovery.ign")).To(Succeed())
+
+		keys, err := base.ListObjectsByPrefix(ctx, internalPrefix+"keys")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(ConsistOf(tenantKeyObjectName("org-a"), tenantKeyObjectName("org-b"), tenantKeyObjectName(defaultTenant)))
+
+		By("decrypting with a new client")
+		client = NewEncryptedClient(base, provider, testTenants, log)
+		data, _ := download("cluster2/kubeadmin-password")
+		Expect(string(data)).To(Equal("password2"))
+		Expect(client.Upload(ctx, []byte("password3"), "cluster2/kubeadmin-password")).To(Succeed())
+		keys, err = base.ListObjectsByPrefix(ctx, internalPrefix+"keys")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(HaveLen(3))
+
+		By("failing when the organization isn't known")
+		Expect(client.Upload(ctx, []byte("secret"), "broken/object")).ToNot(Succeed())
+	})
+
+	It("rejects the objects stored before the encryption, unless they are migrated", func() {
+		Expect(base.Upload(ctx, []byte("plaintext"), "cluster/manifest.yaml")).To(Succeed())
+		_, _, err := client.Download(ctx, "cluster/manifest.yaml")
+		Expect(err).To(HaveOccurred())
+		_, err = client.GetObjectSizeBytes(ctx, "cluster/manifest.yaml")
+		Expect(err).To(HaveOccurred())
+
+		migrating := withPlaintextAllowed(ctx)
+		reader, size, err := client.Download(migrating, "cluster/manifest.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		reader.Close()
+		Expect(string(data)).To(Equal("plaintext"))
+		Expect(size).To(Equal(int64(9)))
+		size, err = client.GetObjectSizeBytes(migrating, "cluster/manifest.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(9)))
+		needed, err := client.needsMigration(ctx, "cluster/manifest.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needed).To(BeTrue())
+	})
+
+	It("rejects the objects of the previous format", func() {
+		Expect(base.Upload(ctx, []byte(legacyEncryptedMagic+"header"), "cluster/object")).To(Succeed())
+		_, _, err := client.Download(withPlaintextAllowed(ctx), "cluster/object")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails to decrypt an object stored under another name", func() {
+		Expect(client.Upload(ctx, []byte("password1"), "cluster1/kubeadmin-password")).To(Succeed())
+		Expect(base.Upload(ctx, readRaw(base, "cluster1/kubeadmin-password"), "cluster3/kubeadmin-password")).To(Succeed())
+		reader, _, err := client.Download(ctx, "cluster3/kubeadmin-password")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = io.ReadAll(reader)
+		Expect(err).To(HaveOccurred())
+		reader.Close()
+	})
+
+	It("fails to decrypt an object with an altered header", func() {
+		Expect(client.Upload(ctx, []byte("secret"), "cluster/object")).To(Succeed())
+		raw := readRaw(base, "cluster/object")
+		raw[encryptedHeaderSize-1] = 1
+		Expect(base.Upload(ctx, raw, "cluster/object")).To(Succeed())
+		reader, _, err := client.Download(ctx, "cluster/object")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = io.ReadAll(reader)
+		Expect(err).To(HaveOccurred())
+		reader.Close()
+	})
+
+	It("fails to decrypt altered or truncated objects", func() {
+		data := make([]byte, 2*encryptedChunkSize)
+		Expect(client.Upload(ctx, data, "cluster/object")).To(Succeed())
+		raw := readRaw(base, "cluster/object")
+
+		Expect(base.Upload(ctx, raw[:len(raw)-encryptedChunkSize], "cluster/object")).To(Succeed())
+		reader, _, err := client.Download(ctx, "cluster/object")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = io.ReadAll(reader)
+		Expect(err).To(HaveOccurred())
+		reader.Close()
+
+		raw[len(raw)-1] ^= 1
+		Expect(base.Upload(ctx, raw, "cluster/object")).To(Succeed())
+		reader, _, err = client.Download(ctx, "cluster/object")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = io.ReadAll(reader)
+		Expect(err).To(HaveOccurred())
+		reader.Close()
+	})
+
+	It("decrypts the objects of the retired keys and renews the data keys", func() {
+		Expect(client.Upload(ctx, []byte("old"), "cluster/object")).To(Succeed())
+		keysFile := filepath.Join(baseDir, "keys-k1.yaml")
+		content, err := os.ReadFile(keysFile)
+		Expect(err).ToNot(HaveOccurred())
+		rotated := strings.Replace(string(content), "current_key: k1", "current_key: k2", 1) +
+			"  k2: " + base64.StdEncoding.EncodeToString(make([]byte, 32)) + "\n"
+		Expect(os.WriteFile(keysFile, []byte(rotated), 0600)).To(Succeed())
+		provider, err = NewLocalKeyProvider(keysFile)
+		Expect(err).ToNot(HaveOccurred())
+		client = NewEncryptedClient(base, provider, testTenants, log)
+
+		data, _ := download("cluster/object")
+		Expect(string(data)).To(Equal("old"))
+		needed, err := client.needsMigration(ctx, "cluster/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needed).To(BeTrue())
+
+		Expect(client.Upload(ctx, []byte("new"), "cluster/object")).To(Succeed())
+		needed, err = client.needsMigration(ctx, "cluster/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needed).To(BeFalse())
+	})
+
+	It("fails to decrypt without the key encryption key", func() {
+		Expect(client.Upload(ctx, []byte("secret"), "cluster/object")).To(Succeed())
+		other, err := NewLocalKeyProvider(writeKeysFile(baseDir, "other", "other"))
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = NewEncryptedClient(base, other, testTenants, log).Download(ctx, "cluster/object")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("hides the data keys and doesn't presign the objects", func() {
+		Expect(client.Upload(ctx, []byte("secret"), "cluster/object")).To(Succeed())
+		objects, err := client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{"cluster/object"}))
+		Expect(client.IsAwsS3()).To(BeFalse())
+		_, err = client.GeneratePresignedDownloadURL(ctx, "cluster/object", "object", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns a not found error for the missing objects", func() {
+		_, _, err := client.Download(ctx, "cluster/missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+	})
+
+	Context("LocalKeyProvider", func() {
+		It("rejects invalid keys files", func() {
+			for _, content := range []string{
+				"current_key: k1\nkeys:\n  k2: " + base64.StdEncoding.EncodeToString(make([]byte, 32)),
+				"current_key: k1\nkeys:\n  k1: " + base64.StdEncoding.EncodeToString(make([]byte, 16)),
+				"current_key: k1\nkeys:\n  k1: not base64",
+				"current: k1",
+			} {
+				file := filepath.Join(baseDir, "invalid.yaml")
+				Expect(os.WriteFile(file, []byte(content), 0600)).To(Succeed())
+				_, err := NewLocalKeyProvider(file)
+				Expect(err).To(HaveOccurred(), content)
+			}
+			_, err := NewLocalKeyProvider(filepath.Join(baseDir, "missing.yaml"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("is selected by the configuration", func() {
+			wrapped, err := NewWrappedClient(base, &EncryptionConfig{Enabled: true, KeyProvider: "local", KeysFile: filepath.Join(baseDir, "keys-k1.yaml")},
+				&DedupConfig{}, testTenants, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wrapped).To(BeAssignableToTypeOf(&EncryptedClient{}))
+			_, err = NewWrappedClient(base, &EncryptionConfig{Enabled: true, KeyProvider: "vault"}, &DedupConfig{}, testTenants, log)
+			Expect(err).To(HaveOccurred())
+			wrapped, err = NewWrappedClient(base, &EncryptionConfig{}, &DedupConfig{}, testTenants, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wrapped).To(Equal(base))
+		})
+	})
+})
@@ -0,0 +1,760 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsTokenScope      = "https://www.googleapis.com/auth/devstorage.read_write"
+	// The longest duration of a V4 signed URL
+	gcsMaxSignedURLDuration = 7 * 24 * time.Hour
+	gcsTokenTimeout         = 30 * time.Second
+	// The default host of the metadata server of the GCE instances and of the GKE workload identity
+	gcsMetadataHost = "metadata.google.internal"
+)
+
+// The IAM credentials API, signing the URLs for the service accounts without key
+var gcsIAMCredentialsURL = "https://iamcredentials.googleapis.com"
+
+type GCSConfig struct {
+	Bucket    string `envconfig:"GCS_BUCKET"`
+	ProjectID string `envconfig:"GCS_PROJECT_ID"`
+	// The JSON key of a service account, GOOGLE_APPLICATION_CREDENTIALS by default. Without a key, the service account
+	// of the metadata server (workload identity) is used with the public storage, and the requests to an emulator are
+	// not authenticated.
+	CredentialsFile string `envconfig:"GCS_CREDENTIALS_FILE"`
+	// The URL of the storage, https://storage.googleapis.com by default, e.g. http://localhost:4443 for fake-gcs-server
+	EndpointURL string `envconfig:"GCS_ENDPOINT_URL"`
+}
+
+type gcsCredentials struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+	ProjectID   string `json:"project_id"`
+
+	key *rsa.PrivateKey
+}
+
+// GCSClient stores the objects in a bucket of Google Cloud Storage, using its JSON API. The Google Cloud client
+// libraries aren't vendored, the API is used directly.
+type GCSClient struct {
+	log      logrus.FieldLogger
+	cfg      *GCSConfig
+	creds    *gcsCredentials
+	endpoint *url.URL
+	client   *http.Client
+	// The host of the metadata server granting the tokens when there is no key, empty for an emulator
+	metadataHost string
+	// The email of the service account of the metadata server
+	serviceAccount string
+
+	// refreshLock serializes the token requests, tokenLock only protects the token
+	refreshLock sync.Mutex
+	tokenLock   sync.RWMutex
+	token       string
+	tokenExpiry time.Time
+}
+
+var _ API = &GCSClient{}
+
+func NewGCSClient(cfg *GCSConfig, logger logrus.FieldLogger) (*GCSClient, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("the bucket of the GCS storage is required")
+	}
+	endpointURL := cfg.EndpointURL
+	if endpointURL == "" {
+		endpointURL = gcsDefaultEndpoint
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(endpointURL, "/"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid GCS endpoint %s", endpointURL)
+	}
+	c := &GCSClient{log: logger, cfg: cfg, endpoint: endpoint, client: newHTTPClient()}
+	credentialsFile := cfg.CredentialsFile
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	switch {
+	case credentialsFile != "":
+		if c.creds, err = loadGCSCredentials(credentialsFile); err != nil {
+			return nil, err
+		}
+	case c.IsAwsS3():
+		// The application default credentials of the workload identity, or of the service account of the instance
+		c.metadataHost = os.Getenv("GCE_METADATA_HOST")
+		if c.metadataHost == "" {
+			c.metadataHost = gcsMetadataHost
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), gcsTokenTimeout)
+		defer cancel()
+		email, err := c.metadata(ctx, "instance/service-accounts/default/email")
+		if err != nil {
+			return nil, errors.Wrap(err, "the GCS credentials file isn't set and the metadata server isn't available")
+		}
+		c.serviceAccount = strings.TrimSpace(string(email))
+	}
+	return c, nil
+}
+
+func loadGCSCredentials(file string) (*gcsCredentials, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the GCS credentials file %s", file)
+	}
+	var creds gcsCredentials
+	if err = json.Unmarshal(data, &creds); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the GCS credentials file %s", file)
+	}
+	if creds.ClientEmail == "" || creds.PrivateKey == "" {
+		return nil, errors.Errorf("the GCS credentials file %s is not the key of a service account", file)
+	}
+	if creds.TokenURI == "" {
+		creds.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+	block, _ := pem.Decode([]byte(creds.PrivateKey))
+	if block == nil {
+		return nil, errors.Errorf("invalid private key in the GCS credentials file %s", file)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return nil, errors.Wrapf(err, "invalid private key in the GCS credentials file %s", file)
+		}
+	}
+	var ok bool
+	if creds.key, ok = key.(*rsa.PrivateKey); !ok {
+		return nil, errors.Errorf("the private key in the GCS credentials file %s is not an RSA key", file)
+	}
+	return &creds, nil
+}
+
+// IsAwsS3 tells whether the presigned URLs can be used by the clients of the service, i.e. the storage is the public
+// Google Cloud Storage rather than an emulator
+func (c *GCSClient) IsAwsS3() bool {
+	return c.endpoint.String() == gcsDefaultEndpoint
+}
+
+func (c *GCSClient) CreateBucket() error {
+	ctx := context.Background()
+	resp, err := c.do(ctx, http.MethodGet, c.bucketPath(), nil, nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to get GCS bucket %s", c.cfg.Bucket)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	projectID := c.cfg.ProjectID
+	if projectID == "" && c.creds != nil {
+		projectID = c.creds.ProjectID
+	}
+	if projectID == "" && c.metadataHost != "" {
+		data, err := c.metadata(ctx, "project/project-id")
+		if err != nil {
+			return errors.Wrap(err, "Failed to get the GCS project from the metadata server")
+		}
+		projectID = strings.TrimSpace(string(data))
+	}
+	body, err := json.Marshal(map[string]string{"name": c.cfg.Bucket})
+	if err != nil {
+		return err
+	}
+	headers := http.Header{"Content-Type": {"application/json"}}
+	resp, err = c.do(ctx, http.MethodPost, "/storage/v1/b", url.Values{"project": {projectID}}, headers, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create GCS bucket %s", c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusConflict {
+		return errors.Wrapf(gcsError(resp), "Failed to create GCS bucket %s", c.cfg.Bucket)
+	}
+	return nil
+}
+
+func (c *GCSClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+// UploadStream streams the object in a multipart upload, whose first part is the metadata of the object
+func (c *GCSClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if reader == nil {
+		err := errors.Errorf("Upfile log may not be nil. Cannot upload %s to bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return err
+	}
+
+	metadata, err := json.Marshal(map[string]string{"name": objectName, "cacheControl": "no-cache"})
+	if err != nil {
+		return err
+	}
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeGCSMultipart(mw, metadata, reader))
+	}()
+	defer pr.Close()
+
+	headers := http.Header{"Content-Type": {"multipart/related; boundary=" + mw.Boundary()}}
+	query := url.Values{"uploadType": {"multipart"}}
+	resp, err := c.do(ctx, http.MethodPost, "/upload"+c.bucketPath()+"/o", query, headers, pr)
+	if err == nil {
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			err = gcsError(resp)
+		}
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to bucket %s", objectName, c.cfg.Bucket)
+	return nil
+}
+
+func writeGCSMultipart(mw *multipart.Writer, metadata []byte, reader io.Reader) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=UTF-8"}})
+	if err != nil {
+		return err
+	}
+	if _, err = part.Write(metadata); err != nil {
+		return err
+	}
+	part, err = mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/octet-stream"}})
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(part, reader); err != nil {
+		return err
+	}
+	return mw.Close()
+}
+
+func (c *GCSClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to bucket %s", filePath, objectName, c.cfg.Bucket)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return c.UploadStream(ctx, file, objectName)
+}
+
+func (c *GCSClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from bucket %s", objectName, c.cfg.Bucket)
+	resp, err := c.do(ctx, http.MethodGet, c.objectPath(objectName), url.Values{"alt": {"media"}}, nil, nil)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Failed to get %s object from bucket %s", objectName, c.cfg.Bucket)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, 0, common.NotFound(objectName)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		err = errors.Wrapf(gcsError(resp), "Failed to get %s object from bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+type gcsObject struct {
+	Name     string            `json:"name"`
+	Size     string            `json:"size"`
+	Updated  time.Time         `json:"updated"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// object returns the metadata of the object, nil when the object doesn't exist
+func (c *GCSClient) object(ctx context.Context, objectName string) (*gcsObject, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.objectPath(objectName), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var object gcsObject
+		if err = json.NewDecoder(resp.Body).Decode(&object); err != nil {
+			return nil, errors.Wrap(err, "failed to decode the metadata of the object")
+		}
+		return &object, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, gcsError(resp)
+	}
+}
+
+func (c *GCSClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, c.cfg.Bucket)
+	object, err := c.object(ctx, objectName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	return object != nil, nil
+}
+
+func (c *GCSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Bucket)
+	resp, err := c.do(ctx, http.MethodDelete, c.objectPath(objectName), nil, nil, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusOK:
+		log.Infof("Deleted object %s from bucket %s", objectName, c.cfg.Bucket)
+		return true, nil
+	case http.StatusNotFound:
+		log.Infof("Object %s does not exist in bucket %s", objectName, c.cfg.Bucket)
+		return false, nil
+	default:
+		return false, errors.Wrapf(gcsError(resp), "Failed to delete object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+}
+
+func (c *GCSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	object, err := c.object(ctx, objectName)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, c.cfg.Bucket)
+	}
+	if object == nil {
+		return 0, common.NotFound(objectName)
+	}
+	size, err := strconv.ParseInt(object.Size, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Invalid size of object %s in bucket %s", objectName, c.cfg.Bucket)
+	}
+	return size, nil
+}
+
+// GeneratePresignedDownloadURL returns a V4 signed URL of the object, signed with the key of the service account, or
+// by the IAM credentials API for the service account of the metadata server
+func (c *GCSClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	clientEmail := c.serviceAccount
+	if c.creds != nil {
+		clientEmail = c.creds.ClientEmail
+	}
+	if clientEmail == "" {
+		return "", errors.New("the URLs of the GCS objects can't be signed without the credentials of a service account")
+	}
+	if duration > gcsMaxSignedURLDuration {
+		duration = gcsMaxSignedURLDuration
+	}
+	now := time.Now().UTC()
+	date := now.Format("20060102")
+	values := url.Values{
+		"X-Goog-Algorithm":             {"GOOG4-RSA-SHA256"},
+		"X-Goog-Credential":            {fmt.Sprintf("%s/%s/auto/storage/goog4_request", clientEmail, date)},
+		"X-Goog-Date":                  {now.Format("20060102T150405Z")},
+		"X-Goog-Expires":               {strconv.FormatInt(int64(duration/time.Second), 10)},
+		"X-Goog-SignedHeaders":         {"host"},
+		"response-content-disposition": {fmt.Sprintf("attachment;filename=%s", downloadFilename)},
+	}
+
+	u := *c.endpoint
+	u.Path = fmt.Sprintf("%s/%s/%s", u.Path, c.cfg.Bucket, objectName)
+	u.RawPath = ""
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(values),
+		"host:" + u.Host,
+		"",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"GOOG4-RSA-SHA256",
+		values.Get("X-Goog-Date"),
+		fmt.Sprintf("%s/auto/storage/goog4_request", date),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+	signature, err := c.sign(ctx, []byte(stringToSign))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to sign the URL of object %s", objectName)
+	}
+	u.RawQuery = canonicalQuery(values) + "&X-Goog-Signature=" + hex.EncodeToString(signature)
+	return u.String(), nil
+}
+
+// UpdateObjectTimestamp sets the creation time used by ExpireObjects in the metadata of the object
+func (c *GCSClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	body, err := json.Marshal(map[string]map[string]string{
+		"metadata": {timestampTagKey: strconv.FormatInt(time.Now().Unix(), 10)},
+	})
+	if err != nil {
+		return false, err
+	}
+	headers := http.Header{"Content-Type": {"application/json"}}
+	resp, err := c.do(ctx, http.MethodPatch, c.objectPath(objectName), nil, headers, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to update the metadata of object %s in bucket %s", objectName, c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, errors.Wrapf(gcsError(resp), "Failed to update the metadata of object %s in bucket %s", objectName, c.cfg.Bucket)
+	}
+}
+
+// listObjects calls fn with each page of the objects whose name starts with prefix
+func (c *GCSClient) listObjects(ctx context.Context, prefix string, fn func(objects []*gcsObject)) error {
+	pageToken := ""
+	for {
+		query := url.Values{}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		resp, err := c.do(ctx, http.MethodGet, c.bucketPath()+"/o", query, nil, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = gcsError(resp)
+			resp.Body.Close()
+			return err
+		}
+		var list struct {
+			Items         []*gcsObject `json:"items"`
+			NextPageToken string       `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			return errors.Wrap(err, "failed to decode the list of objects")
+		}
+		fn(list.Items)
+		if list.NextPageToken == "" {
+			return nil
+		}
+		pageToken = list.NextPageToken
+	}
+}
+
+func (c *GCSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := c.listObjects(ctx, prefix, func(objects []*gcsObject) {
+		for _, object := range objects {
+			if !isExpired(now, object.Updated, object.Metadata[timestampTagKey], deleteTime) {
+				continue
+			}
+			if _, err := c.DeleteObject(ctx, object.Name); err != nil {
+				log.WithError(err).Errorf("Error deleting expired object %s", object.Name)
+				continue
+			}
+			log.Infof("Deleted expired object %s", object.Name)
+			callback(ctx, log, object.Name)
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+func (c *GCSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Listing objects by with prefix %s", prefix)
+	var objects []string
+	err := c.listObjects(ctx, prefix, func(page []*gcsObject) {
+		for _, object := range page {
+			objects = append(objects, object.Name)
+		}
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *GCSClient) bucketPath() string {
+	return "/storage/v1/b/" + url.PathEscape(c.cfg.Bucket)
+}
+
+func (c *GCSClient) objectPath(objectName string) string {
+	return c.bucketPath() + "/o/" + url.PathEscape(objectName)
+}
+
+// do sends a request to the JSON API, authenticated by the token of the service account unless it is an emulator.
+// The path is escaped already, since the names of the objects are a single segment of the path.
+func (c *GCSClient) do(ctx context.Context, method, path string, query url.Values, headers http.Header, body io.Reader) (*http.Response, error) {
+	u := *c.endpoint
+	u.Opaque = ""
+	rawURL := fmt.Sprintf("%s://%s%s%s", u.Scheme, u.Host, u.EscapedPath(), path)
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+	if body == nil {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+	if c.creds != nil || c.metadataHost != "" {
+		token, err := c.accessToken(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get a GCS access token")
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.client.Do(req)
+}
+
+// accessToken returns the cached token of the service account, or requests a new one when it expired. The requests
+// using a valid token aren't blocked while a new token is requested.
+func (c *GCSClient) accessToken(ctx context.Context) (string, error) {
+	if token := c.cachedToken(); token != "" {
+		return token, nil
+	}
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+	// The token may have been renewed while waiting for the lock
+	if token := c.cachedToken(); token != "" {
+		return token, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gcsTokenTimeout)
+	defer cancel()
+	now := time.Now()
+	var (
+		token *gcsToken
+		err   error
+	)
+	if c.creds != nil {
+		token, err = c.serviceAccountToken(ctx, now)
+	} else {
+		token, err = c.metadataToken(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	c.tokenLock.Lock()
+	defer c.tokenLock.Unlock()
+	c.token = token.AccessToken
+	// Renew the token a minute before it expires
+	c.tokenExpiry = now.Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+	return c.token, nil
+}
+
+func (c *GCSClient) cachedToken() string {
+	c.tokenLock.RLock()
+	defer c.tokenLock.RUnlock()
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token
+	}
+	return ""
+}
+
+type gcsToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// serviceAccountToken requests the OAuth2 token of the service account, granted for its signed JWT assertion
+func (c *GCSClient) serviceAccountToken(ctx context.Context, now time.Time) (*gcsToken, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return nil, err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   c.creds.ClientEmail,
+		"scope": gcsTokenScope,
+		"aud":   c.creds.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	signature, err := c.sign(ctx, []byte(unsigned))
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.creds.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c.decodeToken(req)
+}
+
+// metadataToken requests the OAuth2 token of the service account of the metadata server
+func (c *GCSClient) metadataToken(ctx context.Context) (*gcsToken, error) {
+	data, err := c.metadata(ctx, "instance/service-accounts/default/token?scopes="+url.QueryEscape(gcsTokenScope))
+	if err != nil {
+		return nil, err
+	}
+	var token gcsToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the access token")
+	}
+	return &token, nil
+}
+
+func (c *GCSClient) decodeToken(req *http.Request) (*gcsToken, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, gcsError(resp)
+	}
+	var token gcsToken
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the access token")
+	}
+	return &token, nil
+}
+
+// metadata returns a value of the metadata server
+func (c *GCSClient) metadata(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/computeMetadata/v1/%s", c.metadataHost, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d of the metadata server", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+}
+
+// sign signs the data with the key of the service account, or with the IAM credentials API when there is no key
+func (c *GCSClient) sign(ctx context.Context, data []byte) ([]byte, error) {
+	if c.creds != nil {
+		hash := sha256.Sum256(data)
+		return rsa.SignPKCS1v15(rand.Reader, c.creds.key, crypto.SHA256, hash[:])
+	}
+
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get a GCS access token")
+	}
+	body, err := json.Marshal(map[string]string{"payload": base64.StdEncoding.EncodeToString(data)})
+	if err != nil {
+		return nil, err
+	}
+	signURL := fmt.Sprintf("%s/v1/projects/-/serviceAccounts/%s:signBlob", gcsIAMCredentialsURL, url.PathEscape(c.serviceAccount))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, signURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, gcsError(resp)
+	}
+	var signed struct {
+		SignedBlob string `json:"signedBlob"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&signed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the signature")
+	}
+	return base64.StdEncoding.DecodeString(signed.SignedBlob)
+}
+
+// canonicalQuery returns the query sorted by name, with the names and the values escaped as required by the signed URLs
+func canonicalQuery(values url.Values) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		params = append(params, gcsEscape(name)+"="+gcsEscape(values.Get(name)))
+	}
+	return strings.Join(params, "&")
+}
+
+func gcsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func gcsError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var gcsErr struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &gcsErr) == nil && gcsErr.Error.Message != "" {
+		return errors.Errorf("%s (status %d)", gcsErr.Error.Message, resp.StatusCode)
+	}
+	return errors.Errorf("unexpected status %d", resp.StatusCode)
+}
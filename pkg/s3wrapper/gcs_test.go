@@ -0,0 +1,449 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+type fakeGCSObject struct {
+	data         []byte
+	updated      time.Time
+	metadata     map[string]string
+	cacheControl string
+}
+
+// fakeGCS is an in-memory JSON API of the storage, whose objects are listed two by two. The requests must carry the
+// token granted by its token endpoint, when it has one.
+type fakeGCS struct {
+	sync.Mutex
+	token   string
+	buckets map[string]map[string]*fakeGCSObject
+	// The key of the service account of the metadata server, signing the blobs of the IAM credentials API
+	key *rsa.PrivateKey
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	if r.URL.Path == "/token" {
+		Expect(r.ParseForm()).To(Succeed())
+		Expect(r.PostForm.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:jwt-bearer"))
+		Expect(strings.Split(r.PostForm.Get("assertion"), ".")).To(HaveLen(3))
+		f.token = "token"
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": f.token, "expires_in": 3600})
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, "/computeMetadata/v1/") {
+		Expect(r.Header.Get("Metadata-Flavor")).To(Equal("Google"))
+		switch strings.TrimPrefix(r.URL.Path, "/computeMetadata/v1/") {
+		case "instance/service-accounts/default/email":
+			_, _ = w.Write([]byte("workload@project.iam.gserviceaccount.com\n"))
+		case "instance/service-accounts/default/token":
+			Expect(r.URL.Query().Get("scopes")).To(Equal(gcsTokenScope))
+			f.token = "metadata-token"
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": f.token, "expires_in": 3600})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		return
+	}
+	if f.token != "" && r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := r.URL.EscapedPath()
+	if r.URL.Path == "/v1/projects/-/serviceAccounts/workload@project.iam.gserviceaccount.com:signBlob" {
+		var blob struct {
+			Payload string `json:"payload"`
+		}
+		Expect(json.NewDecoder(r.Body).Decode(&blob)).To(Succeed())
+		payload, err := base64.StdEncoding.DecodeString(blob.Payload)
+		Expect(err).ToNot(HaveOccurred())
+		hash := sha256.Sum256(payload)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, hash[:])
+		Expect(err).ToNot(HaveOccurred())
+		_ = json.NewEncoder(w).Encode(map[string]string{"signedBlob": base64.StdEncoding.EncodeToString(signature)})
+		return
+	}
+	switch {
+	case path == "/storage/v1/b" && r.Method == http.MethodPost:
+		var bucket struct {
+			Name string `json:"name"`
+		}
+		Expect(json.NewDecoder(r.Body).Decode(&bucket)).To(Succeed())
+		Expect(r.URL.Query().Get("project")).ToNot(BeEmpty())
+		f.buckets[bucket.Name] = map[string]*fakeGCSObject{}
+		_ = json.NewEncoder(w).Encode(bucket)
+		return
+	case strings.HasPrefix(path, "/upload/storage/v1/b/"):
+		f.upload(w, r, strings.TrimSuffix(strings.TrimPrefix(path, "/upload/storage/v1/b/"), "/o"))
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(path, "/storage/v1/b/"), "/", 3)
+	objects, ok := f.buckets[parts[0]]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch len(parts) {
+	case 1:
+		_, _ = w.Write([]byte("{}"))
+		return
+	case 2:
+		f.list(w, r, objects)
+		return
+	}
+	name, err := url.PathUnescape(parts[2])
+	Expect(err).ToNot(HaveOccurred())
+	object, ok := objects[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("alt") == "media" {
+			w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+			_, _ = w.Write(object.data)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(object.resource(name))
+	case http.MethodPatch:
+		var patch struct {
+			Metadata map[string]string `json:"metadata"`
+		}
+		Expect(json.NewDecoder(r.Body).Decode(&patch)).To(Succeed())
+		object.metadata = patch.Metadata
+		_ = json.NewEncoder(w).Encode(object.resource(name))
+	case http.MethodDelete:
+		delete(objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (o *fakeGCSObject) resource(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":     name,
+		"size":     strconv.Itoa(len(o.data)),
+		"updated":  o.updated.UTC().Format(time.RFC3339Nano),
+		"metadata": o.metadata,
+	}
+}
+
+func (f *fakeGCS) upload(w http.ResponseWriter, r *http.Request, bucket string) {
+	objects, ok := f.buckets[bucket]
+	if !ok || r.URL.Query().Get("uploadType") != "multipart" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(mediaType).To(Equal("multipart/related"))
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	part, err := reader.NextPart()
+	Expect(err).ToNot(HaveOccurred())
+	var metadata struct {
+		Name         string `json:"name"`
+		CacheControl string `json:"cacheControl"`
+	}
+	Expect(json.NewDecoder(part).Decode(&metadata)).To(Succeed())
+	part, err = reader.NextPart()
+	Expect(err).ToNot(HaveOccurred())
+	data, err := io.ReadAll(part)
+	Expect(err).ToNot(HaveOccurred())
+	objects[metadata.Name] = &fakeGCSObject{data: data, updated: time.Now(), cacheControl: metadata.CacheControl}
+	_ = json.NewEncoder(w).Encode(objects[metadata.Name].resource(metadata.Name))
+}
+
+func (f *fakeGCS) list(w http.ResponseWriter, r *http.Request, objects map[string]*fakeGCSObject) {
+	query := r.URL.Query()
+	var names []string
+	for name := range objects {
+		if strings.HasPrefix(name, query.Get("prefix")) && name > query.Get("pageToken") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	list := map[string]interface{}{}
+	var items []interface{}
+	for i, name := range names {
+		if i == 2 {
+			list["nextPageToken"] = names[1]
+			break
+		}
+		items = append(items, objects[name].resource(name))
+	}
+	list["items"] = items
+	_ = json.NewEncoder(w).Encode(list)
+}
+
+var _ = Describe("GCSClient", func() {
+	var (
+		ctx    = context.Background()
+		log    = logrus.New()
+		fake   *fakeGCS
+		server *httptest.Server
+		client *GCSClient
+		dir    string
+		key    *rsa.PrivateKey
+	)
+
+	writeCredentials := func(tokenURI string) string {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := json.Marshal(map[string]string{
+			"type":         "service_account",
+			"client_email": "assisted@project.iam.gserviceaccount.com",
+			"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			"token_uri":    tokenURI,
+			"project_id":   "project",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		file := filepath.Join(dir, "credentials.json")
+		Expect(os.WriteFile(file, data, 0600)).To(Succeed())
+		return file
+	}
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		var err error
+		dir, err = os.MkdirTemp("", "gcs")
+		Expect(err).ToNot(HaveOccurred())
+		if key == nil {
+			key, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		fake = &fakeGCS{buckets: map[string]map[string]*fakeGCSObject{}, key: key}
+		server = httptest.NewServer(fake)
+		client, err = NewGCSClient(&GCSConfig{
+			Bucket:          "assisted",
+			CredentialsFile: writeCredentials(server.URL + "/token"),
+			EndpointURL:     server.URL,
+		}, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.CreateBucket()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("authenticates the requests with the token of the service account", func() {
+		Expect(fake.token).To(Equal("token"))
+		Expect(fake.buckets).To(HaveKey("assisted"))
+	})
+
+	It("doesn't authenticate the requests without credentials", func() {
+		anonymous, err := NewGCSClient(&GCSConfig{Bucket: "other", ProjectID: "test", EndpointURL: server.URL}, log)
+		Expect(err).ToNot(HaveOccurred())
+		fake.token = ""
+		Expect(anonymous.CreateBucket()).To(Succeed())
+		Expect(anonymous.Upload(ctx, []byte("hello"), "object")).To(Succeed())
+		Expect(fake.buckets["other"]).To(HaveKey("object"))
+		_, err = anonymous.GeneratePresignedDownloadURL(ctx, "object", "file.iso", time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects invalid credentials", func() {
+		file := filepath.Join(dir, "invalid.json")
+		Expect(os.WriteFile(file, []byte(`{"client_email": "a@b", "private_key": "invalid"}`), 0600)).To(Succeed())
+		_, err := NewGCSClient(&GCSConfig{Bucket: "assisted", CredentialsFile: file}, log)
+		Expect(err).To(HaveOccurred())
+		_, err = NewGCSClient(&GCSConfig{Bucket: "assisted", CredentialsFile: filepath.Join(dir, "missing.json")}, log)
+		Expect(err).To(HaveOccurred())
+		_, err = NewGCSClient(&GCSConfig{}, log)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("without credentials file", func() {
+		var iamCredentialsURL string
+
+		BeforeEach(func() {
+			iamCredentialsURL = gcsIAMCredentialsURL
+			gcsIAMCredentialsURL = server.URL
+			u, err := url.Parse(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Setenv("GCE_METADATA_HOST", u.Host)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			gcsIAMCredentialsURL = iamCredentialsURL
+			Expect(os.Unsetenv("GCE_METADATA_HOST")).To(Succeed())
+		})
+
+		It("uses presigned URLs with the public endpoint only", func() {
+			Expect(client.IsAwsS3()).To(BeFalse())
+			public, err := NewGCSClient(&GCSConfig{Bucket: "assisted"}, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(public.IsAwsS3()).To(BeTrue())
+		})
+
+		It("signs the URLs for the service account of the metadata server", func() {
+			public, err := NewGCSClient(&GCSConfig{Bucket: "assisted"}, log)
+			Expect(err).ToNot(HaveOccurred())
+			presigned, err := public.GeneratePresignedDownloadURL(ctx, "object", "file.iso", time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fake.token).To(Equal("metadata-token"))
+			u, err := url.Parse(presigned)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u.Host).To(Equal("storage.googleapis.com"))
+			Expect(u.Query().Get("X-Goog-Credential")).To(HavePrefix("workload@project.iam.gserviceaccount.com/"))
+			Expect(u.Query().Get("X-Goog-Signature")).ToNot(BeEmpty())
+		})
+
+		It("fails without the metadata server", func() {
+			Expect(os.Setenv("GCE_METADATA_HOST", "127.0.0.1:1")).To(Succeed())
+			_, err := NewGCSClient(&GCSConfig{Bucket: "assisted"}, log)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("uses the cached token while a new token is requested", func() {
+		client.refreshLock.Lock()
+		defer client.refreshLock.Unlock()
+		token, err := client.accessToken(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token).To(Equal("token"))
+	})
+
+	It("uploads, downloads and deletes objects", func() {
+		Expect(client.UploadStream(ctx, strings.NewReader("hello world"), "dir/object")).To(Succeed())
+		Expect(fake.buckets["assisted"]["dir/object"].cacheControl).To(Equal("no-cache"))
+
+		exists, err := client.DoesObjectExist(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		size, err := client.GetObjectSizeBytes(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(11)))
+
+		reader, length, err := client.Download(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(length).To(Equal(int64(11)))
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(data)).To(Equal("hello world"))
+
+		deleted, err := client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		deleted, err = client.DeleteObject(ctx, "dir/object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+	})
+
+	It("uploads files", func() {
+		file := filepath.Join(dir, "file")
+		Expect(os.WriteFile(file, []byte("content"), 0600)).To(Succeed())
+		Expect(client.UploadFile(ctx, file, "object")).To(Succeed())
+		Expect(string(fake.buckets["assisted"]["object"].data)).To(Equal("content"))
+		Expect(client.UploadFile(ctx, filepath.Join(dir, "missing"), "object")).ToNot(Succeed())
+	})
+
+	It("returns a not found error for the missing objects", func() {
+		_, _, err := client.Download(ctx, "missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+		_, err = client.GetObjectSizeBytes(ctx, "missing")
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+		exists, err := client.DoesObjectExist(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("lists the objects by prefix", func() {
+		for _, name := range []string{"a/1", "a/2", "a/3", "b/1"} {
+			Expect(client.Upload(ctx, []byte(name), name)).To(Succeed())
+		}
+		objects, err := client.ListObjectsByPrefix(ctx, "a/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{"a/1", "a/2", "a/3"}))
+		objects, err = client.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(4))
+	})
+
+	It("expires the objects by their last modification or their timestamp", func() {
+		for _, name := range []string{"old", "old-updated", "new"} {
+			Expect(client.Upload(ctx, []byte(name), "images/"+name)).To(Succeed())
+		}
+		objects := fake.buckets["assisted"]
+		objects["images/old"].updated = time.Now().Add(-2 * time.Hour)
+		objects["images/old-updated"].updated = time.Now().Add(-2 * time.Hour)
+		updated, err := client.UpdateObjectTimestamp(ctx, "images/old-updated")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		updated, err = client.UpdateObjectTimestamp(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeFalse())
+
+		var expired []string
+		client.ExpireObjects(ctx, "images/", time.Hour, func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		})
+		Expect(expired).To(Equal([]string{"images/old"}))
+		Expect(objects).To(HaveLen(2))
+	})
+
+	It("generates V4 signed URLs of the objects", func() {
+		presigned, err := client.GeneratePresignedDownloadURL(ctx, "dir/object", "file.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(presigned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Path).To(Equal("/assisted/dir/object"))
+		query := u.Query()
+		Expect(query.Get("X-Goog-Algorithm")).To(Equal("GOOG4-RSA-SHA256"))
+		Expect(query.Get("X-Goog-Credential")).To(HavePrefix("assisted@project.iam.gserviceaccount.com/"))
+		Expect(query.Get("X-Goog-Expires")).To(Equal("3600"))
+		Expect(query.Get("response-content-disposition")).To(Equal("attachment;filename=file.iso"))
+
+		signature, err := hex.DecodeString(query.Get("X-Goog-Signature"))
+		Expect(err).ToNot(HaveOccurred())
+		query.Del("X-Goog-Signature")
+		canonicalRequest := fmt.Sprintf("GET\n/assisted/dir/object\n%s\nhost:%s\n\nhost\nUNSIGNED-PAYLOAD", canonicalQuery(query), u.Host)
+		Expect(canonicalQuery(query)).To(ContainSubstring("response-content-disposition=attachment%3Bfilename%3Dfile.iso"))
+		hash := sha256.Sum256([]byte(canonicalRequest))
+		date := query.Get("X-Goog-Date")
+		stringToSign := fmt.Sprintf("GOOG4-RSA-SHA256\n%s\n%s/auto/storage/goog4_request\n%s", date, date[:8], hex.EncodeToString(hash[:]))
+		digest := sha256.Sum256([]byte(stringToSign))
+		Expect(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature)).To(Succeed())
+	})
+
+	It("limits the duration of the signed URLs", func() {
+		presigned, err := client.GeneratePresignedDownloadURL(ctx, "object", "file.iso", 30*24*time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(presigned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Query().Get("X-Goog-Expires")).To(Equal("604800"))
+	})
+})
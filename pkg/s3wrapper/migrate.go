@@ -0,0 +1,108 @@
+package s3wrapper
+
+import (
+	"context"
+	"io"
+	"os"
+
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// migrator is implemented by the wrappers whose objects stored before they were enabled, or with a retired key, are
+// rewritten by MigrateObjects
+type migrator interface {
+	API
+	needsMigration(ctx context.Context, objectName string) (bool, error)
+}
+
+type MigrationResult struct {
+	Checked  int
+	Migrated int
+	Failed   int
+}
+
+// MigrateObjects rewrites the objects whose name starts with prefix through the wrappers of the storage, e.g. the
+// objects stored before the encryption was enabled. The contents of the deduplicated objects are rewritten as well, and
+// the objects referencing a content shared by the tenants reference a content of their tenant.
+func MigrateObjects(ctx context.Context, client API, prefix string, dryRun bool, log logrus.FieldLogger) (*MigrationResult, error) {
+	log = logutil.FromContext(ctx, log)
+	// The objects stored before the encryption was enabled are read to be encrypted
+	ctx = withPlaintextAllowed(ctx)
+	result := &MigrationResult{}
+	m, ok := client.(migrator)
+	if !ok {
+		return result, nil
+	}
+	objectNames, err := client.ListObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, objectName := range objectNames {
+		migrateObject(ctx, log, m, objectName, dryRun, result)
+	}
+
+	// The contents of the deduplicated objects are stored by the underlying storage
+	if d, ok := client.(*DedupClient); ok {
+		if base, ok := d.base.(migrator); ok {
+			blobNames, err := listInternalObjects(ctx, d.base, dedupBlobsPrefix)
+			if err != nil {
+				return nil, err
+			}
+			for _, blobName := range blobNames {
+				migrateObject(ctx, log, base, blobName, dryRun, result)
+			}
+		}
+	}
+	return result, nil
+}
+
+func migrateObject(ctx context.Context, log logrus.FieldLogger, m migrator, objectName string, dryRun bool, result *MigrationResult) {
+	result.Checked++
+	needed, err := m.needsMigration(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to check object %s", objectName)
+		result.Failed++
+		return
+	}
+	if !needed {
+		return
+	}
+	if dryRun {
+		log.Infof("Object %s would be migrated", objectName)
+		result.Migrated++
+		return
+	}
+	if err = rewriteObject(ctx, m, objectName); err != nil {
+		log.WithError(err).Errorf("Failed to migrate object %s", objectName)
+		result.Failed++
+		return
+	}
+	log.Infof("Migrated object %s", objectName)
+	result.Migrated++
+}
+
+// rewriteObject downloads the object to a temporary file before uploading it again with the same name
+func rewriteObject(ctx context.Context, client API, objectName string) error {
+	reader, _, err := client.Download(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	file, err := os.CreateTemp("", "migrate")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	if _, err = io.Copy(file, reader); err != nil {
+		return errors.Wrapf(err, "failed to download object %s", objectName)
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return client.UploadFile(ctx, file.Name(), objectName)
+}
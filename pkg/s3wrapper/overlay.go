@@ -0,0 +1,166 @@
+package s3wrapper
+
+import (
+	"context"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var _ API = &OverlayClient{}
+
+// OverlayClient stores the objects it is given under a prefix of the underlying storage, leaving the objects
+// outside of the prefix untouched. Objects are read from the prefix first and then from their original location,
+// so that callers see the underlying objects along with the ones they wrote.
+type OverlayClient struct {
+	base   API
+	prefix string
+}
+
+func NewOverlayClient(base API, prefix string) *OverlayClient {
+	return &OverlayClient{
+		base:   base,
+		prefix: prefix,
+	}
+}
+
+// ObjectName returns the name of the object in the underlying storage
+func (o *OverlayClient) ObjectName(objectName string) string {
+	return path.Join(o.prefix, objectName)
+}
+
+// ListOverlayObjectsByPrefix lists the objects written through the overlay whose name starts with prefix
+func (o *OverlayClient) ListOverlayObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	objectNames, err := o.base.ListObjectsByPrefix(ctx, o.ObjectName(prefix))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(objectNames))
+	for _, objectName := range objectNames {
+		result = append(result, strings.TrimPrefix(objectName, o.prefix+"/"))
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// DeleteOverlayObjectsByPrefix deletes the objects written through the overlay whose name starts with prefix
+func (o *OverlayClient) DeleteOverlayObjectsByPrefix(ctx context.Context, prefix string) error {
+	objectNames, err := o.ListOverlayObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, objectName := range objectNames {
+		if _, err = o.DeleteObject(ctx, objectName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *OverlayClient) IsAwsS3() bool {
+	return o.base.IsAwsS3()
+}
+
+func (o *OverlayClient) CreateBucket() error {
+	return o.base.CreateBucket()
+}
+
+func (o *OverlayClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return o.base.Upload(ctx, data, o.ObjectName(objectName))
+}
+
+func (o *OverlayClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return o.base.UploadStream(ctx, reader, o.ObjectName(objectName))
+}
+
+func (o *OverlayClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return o.base.UploadFile(ctx, filePath, o.ObjectName(objectName))
+}
+
+func (o *OverlayClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	name, err := o.resolve(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	return o.base.Download(ctx, name)
+}
+
+func (o *OverlayClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	name, err := o.resolve(ctx, objectName)
+	if err != nil {
+		return false, err
+	}
+	return o.base.DoesObjectExist(ctx, name)
+}
+
+// DeleteObject deletes the object from the overlay only, the underlying object is never deleted
+func (o *OverlayClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	return o.base.DeleteObject(ctx, o.ObjectName(objectName))
+}
+
+func (o *OverlayClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	name, err := o.resolve(ctx, objectName)
+	if err != nil {
+		return 0, err
+	}
+	return o.base.GetObjectSizeBytes(ctx, name)
+}
+
+func (o *OverlayClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	name, err := o.resolve(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	return o.base.GeneratePresignedDownloadURL(ctx, name, downloadFilename, duration)
+}
+
+func (o *OverlayClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	return o.base.UpdateObjectTimestamp(ctx, o.ObjectName(objectName))
+}
+
+func (o *OverlayClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	o.base.ExpireObjects(ctx, o.ObjectName(prefix), deleteTime, callback)
+}
+
+func (o *OverlayClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	overlayObjects, err := o.ListOverlayObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	baseObjects, err := o.base.ListObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make(map[string]bool)
+	for _, objectName := range overlayObjects {
+		objects[objectName] = true
+	}
+	for _, objectName := range baseObjects {
+		if !strings.HasPrefix(objectName, o.prefix+"/") {
+			objects[objectName] = true
+		}
+	}
+	result := make([]string, 0, len(objects))
+	for objectName := range objects {
+		result = append(result, objectName)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// resolve returns the name of the overlay object when it exists and the original one otherwise
+func (o *OverlayClient) resolve(ctx context.Context, objectName string) (string, error) {
+	exists, err := o.base.DoesObjectExist(ctx, o.ObjectName(objectName))
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return o.ObjectName(objectName), nil
+	}
+	return objectName, nil
+}
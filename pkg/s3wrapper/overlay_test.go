@@ -0,0 +1,106 @@
+package s3wrapper
+
+import (
+	"context"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Overlay", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		base    *FSClient
+		overlay *OverlayClient
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		var err error
+		baseDir, err = os.MkdirTemp("", "overlay")
+		Expect(err).ToNot(HaveOccurred())
+		base = &FSClient{basedir: baseDir, log: log}
+		overlay = NewOverlayClient(base, "dry-run")
+		Expect(base.Upload(ctx, []byte("base"), "cluster/manifests/base.yaml")).To(Succeed())
+		Expect(base.Upload(ctx, []byte("base"), "cluster/manifests/shadowed.yaml")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	download := func(client API, objectName string) string {
+		reader, _, err := client.Download(ctx, objectName)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	It("writes under the prefix and reads the underlying objects", func() {
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/manifests/shadowed.yaml")).To(Succeed())
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/bootstrap.ign")).To(Succeed())
+
+		Expect(download(overlay, "cluster/manifests/shadowed.yaml")).To(Equal("overlay"))
+		Expect(download(overlay, "cluster/manifests/base.yaml")).To(Equal("base"))
+		Expect(download(base, "cluster/manifests/shadowed.yaml")).To(Equal("base"))
+		Expect(download(base, "dry-run/cluster/bootstrap.ign")).To(Equal("overlay"))
+
+		exists, err := base.DoesObjectExist(ctx, "cluster/bootstrap.ign")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		exists, err = overlay.DoesObjectExist(ctx, "cluster/bootstrap.ign")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("lists the objects of both layers once", func() {
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/manifests/shadowed.yaml")).To(Succeed())
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/manifests/operator.yaml")).To(Succeed())
+
+		objects, err := overlay.ListObjectsByPrefix(ctx, "cluster/manifests")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{
+			"cluster/manifests/base.yaml",
+			"cluster/manifests/operator.yaml",
+			"cluster/manifests/shadowed.yaml",
+		}))
+	})
+
+	It("never deletes the underlying objects", func() {
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/manifests/shadowed.yaml")).To(Succeed())
+
+		deleted, err := overlay.DeleteObject(ctx, "cluster/manifests/shadowed.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		deleted, err = overlay.DeleteObject(ctx, "cluster/manifests/base.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+		Expect(download(overlay, "cluster/manifests/shadowed.yaml")).To(Equal("base"))
+		Expect(download(overlay, "cluster/manifests/base.yaml")).To(Equal("base"))
+	})
+
+	It("lists and deletes the overlay objects by prefix", func() {
+		Expect(overlay.Upload(ctx, []byte("overlay"), "cluster/bootstrap.ign")).To(Succeed())
+		Expect(overlay.Upload(ctx, []byte("overlay"), "other/bootstrap.ign")).To(Succeed())
+
+		objects, err := overlay.ListOverlayObjectsByPrefix(ctx, "cluster")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]string{"cluster/bootstrap.ign"}))
+
+		Expect(overlay.DeleteOverlayObjectsByPrefix(ctx, "cluster")).To(Succeed())
+		objects, err = base.ListObjectsByPrefix(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(
+			"cluster/manifests/base.yaml",
+			"cluster/manifests/shadowed.yaml",
+			"dry-run/other/bootstrap.ign",
+		))
+	})
+})
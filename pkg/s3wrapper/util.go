@@ -4,8 +4,11 @@ import (
 	"archive/tar"
 	"context"
 	"io"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -118,3 +121,49 @@ func TarAwsFiles(ctx context.Context, tarName string, files, tarredFilenames []s
 	wg.Wait()
 	return err
 }
+
+// newHTTPClient returns the client of the REST APIs of the object stores which have no SDK in the service
+func newHTTPClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     time.Minute,
+	}}
+}
+
+// isExpired tells whether an object was created more than deleteTime ago. The creation time is the time of the last
+// modification of the object, unless the timestamp set by UpdateObjectTimestamp is given.
+func isExpired(now, lastModified time.Time, timestamp string, deleteTime time.Duration) bool {
+	creationTime := lastModified
+	if timestamp != "" {
+		if objTime, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+			creationTime = time.Unix(objTime, 0)
+		}
+	}
+	return now.After(creationTime.Add(deleteTime))
+}
+
+// NewWrappedClient wraps the storage with the encryption and the deduplication, when they are enabled. The tenants
+// give the organization owning each object, which has its own data key and its own deduplicated contents.
+func NewWrappedClient(base API, encryptionCfg *EncryptionConfig, dedupCfg *DedupConfig, tenants TenantResolver, log logrus.FieldLogger) (API, error) {
+	client := base
+	if encryptionCfg.Enabled {
+		provider, err := newKeyProvider(encryptionCfg)
+		if err != nil {
+			return nil, err
+		}
+		client = NewEncryptedClient(client, provider, tenants, log)
+	}
+	if dedupCfg.Enabled {
+		if dedupCfg.MinSize <= maxDedupPointerSize {
+			return nil, errors.Errorf("the minimal size of the deduplicated objects must be larger than %d bytes", maxDedupPointerSize)
+		}
+		client = NewDedupClient(client, dedupCfg, tenants, log)
+	}
+	return client, nil
+}
@@ -0,0 +1,268 @@
+package shard
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	coordv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// groupLabel is the label of the leases of the members of a group
+	groupLabel = "agent-install.openshift.io/shard-group"
+	// staleLeaseFactor is the number of lease durations after which the lease of a member which didn't leave the
+	// group, e.g. a crashed replica, is deleted
+	staleLeaseFactor = 10
+)
+
+type Config struct {
+	Enabled       bool          `envconfig:"MONITOR_SHARDING_ENABLED" default:"false"`
+	LeaseDuration time.Duration `envconfig:"MONITOR_SHARD_LEASE_DURATION" default:"15s"`
+	RenewInterval time.Duration `envconfig:"MONITOR_SHARD_RENEW_INTERVAL" default:"5s"`
+	VirtualNodes  int           `envconfig:"MONITOR_SHARD_VIRTUAL_NODES" default:"100"`
+	Namespace     string        `envconfig:"NAMESPACE" default:"assisted-installer"`
+}
+
+// Sharder partitions the monitored clusters and infra-envs between the replicas of the service
+type Sharder interface {
+	// Owns tells whether the cluster or infra-env with the given ID belongs to the shard of this replica
+	Owns(id string) bool
+	// Name returns the name of the shard of this replica, used in the logs and metrics
+	Name() string
+}
+
+// AllSharder is the sharder of a single replica, owning everything
+type AllSharder struct{}
+
+func (a *AllSharder) Owns(id string) bool {
+	return true
+}
+
+func (a *AllSharder) Name() string {
+	return "all"
+}
+
+var _ Sharder = &Membership{}
+
+// Membership is the sharder of a replica among the replicas of a group. Every member keeps a lease of its own
+// renewed, and the IDs are distributed with consistent hashing between the members having a live lease. The
+// distribution is computed again when a member joins or leaves the group.
+type Membership struct {
+	log       logrus.FieldLogger
+	config    Config
+	kube      kubernetes.Interface
+	metricApi metrics.API
+	group     string
+	identity  string
+
+	mutex     sync.RWMutex
+	ring      *ring
+	members   []string
+	renewedAt time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewMembership(kubeClient kubernetes.Interface, config Config, group string, metricApi metrics.API, logger logrus.FieldLogger) *Membership {
+	identity, err := os.Hostname()
+	if err != nil || identity == "" {
+		identity = string(uuid.NewUUID())
+	}
+	identity = strings.ToLower(identity)
+	return &Membership{
+		log:       logger.WithFields(logrus.Fields{"group": group, "member": identity}),
+		config:    config,
+		kube:      kubeClient,
+		metricApi: metricApi,
+		group:     group,
+		identity:  identity,
+		ring:      newRing(nil, config.VirtualNodes),
+	}
+}
+
+func (m *Membership) Name() string {
+	return m.identity
+}
+
+// Owns tells whether the ID belongs to this member. Nothing is owned while the lease of the member isn't renewed,
+// since the other members stop counting it once its lease expires.
+func (m *Membership) Owns(id string) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if time.Since(m.renewedAt) > m.config.LeaseDuration {
+		return false
+	}
+	return m.ring.owner(id) == m.identity
+}
+
+func (m *Membership) leaseName() string {
+	return m.group + "-" + m.identity
+}
+
+// Start joins the group and keeps the membership up to date until the context is cancelled or Leave is called, when
+// the member leaves the group
+func (m *Membership) Start(ctx context.Context) error {
+	if err := m.refresh(ctx); err != nil {
+		return errors.Wrapf(err, "failed to join shard group %s", m.group)
+	}
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go func() {
+		defer close(m.done)
+		ticker := time.NewTicker(m.config.RenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				m.leave()
+				return
+			case <-ticker.C:
+				if err := m.refresh(ctx); err != nil {
+					m.log.WithError(err).Warn("failed to refresh the shard membership")
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (m *Membership) refresh(ctx context.Context) error {
+	if err := m.renew(ctx); err != nil {
+		return err
+	}
+	renewedAt := time.Now()
+	leases, err := m.kube.CoordinationV1().Leases(m.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: groupLabel + "=" + m.group,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the leases of shard group %s", m.group)
+	}
+	members := liveMembers(leases.Items, renewedAt)
+	for _, name := range staleLeases(leases.Items, renewedAt, staleLeaseFactor*m.config.LeaseDuration) {
+		err = m.kube.CoordinationV1().Leases(m.config.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			m.log.WithError(err).Warnf("failed to delete stale lease %s", name)
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.renewedAt = renewedAt
+	if !equalMembers(members, m.members) {
+		m.log.Infof("Rebalancing the shards between %d members: %s", len(members), strings.Join(members, ", "))
+		m.members = members
+		m.ring = newRing(members, m.config.VirtualNodes)
+	}
+	m.metricApi.MonitorShardMembers(m.identity, len(members))
+	return nil
+}
+
+// renew creates the lease of the member, or updates its renew time
+func (m *Membership) renew(ctx context.Context) error {
+	leases := m.kube.CoordinationV1().Leases(m.config.Namespace)
+	now := metav1.NewMicroTime(time.Now())
+	durationSeconds := int32(m.config.LeaseDuration.Seconds())
+	lease, err := leases.Get(ctx, m.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = leases.Create(ctx, &coordv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.leaseName(),
+				Namespace: m.config.Namespace,
+				Labels:    map[string]string{groupLabel: m.group},
+			},
+			Spec: coordv1.LeaseSpec{
+				HolderIdentity:       &m.identity,
+				LeaseDurationSeconds: &durationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		return errors.Wrapf(err, "failed to create lease %s", m.leaseName())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get lease %s", m.leaseName())
+	}
+	lease.Spec.HolderIdentity = &m.identity
+	lease.Spec.LeaseDurationSeconds = &durationSeconds
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return errors.Wrapf(err, "failed to renew lease %s", m.leaseName())
+}
+
+// Leave stops the membership and waits for the member to leave the group
+func (m *Membership) Leave() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+}
+
+// leave deletes the lease of the member, so the other members take its shard over without waiting for the lease to
+// expire
+func (m *Membership) leave() {
+	m.mutex.Lock()
+	m.renewedAt = time.Time{}
+	m.mutex.Unlock()
+	err := m.kube.CoordinationV1().Leases(m.config.Namespace).Delete(context.Background(), m.leaseName(), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		m.log.WithError(err).Warnf("failed to delete lease %s", m.leaseName())
+		return
+	}
+	m.log.Info("Left the shard group")
+}
+
+func leaseExpiration(lease *coordv1.Lease) (time.Time, bool) {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return time.Time{}, false
+	}
+	return spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second), true
+}
+
+// liveMembers returns the sorted holders of the leases renewed within their duration
+func liveMembers(leases []coordv1.Lease, now time.Time) []string {
+	var members []string
+	for i := range leases {
+		if expiresAt, ok := leaseExpiration(&leases[i]); ok && expiresAt.After(now) {
+			members = append(members, *leases[i].Spec.HolderIdentity)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// staleLeases returns the names of the leases expired for longer than the grace period
+func staleLeases(leases []coordv1.Lease, now time.Time, grace time.Duration) []string {
+	var names []string
+	for i := range leases {
+		if expiresAt, ok := leaseExpiration(&leases[i]); ok && expiresAt.Add(grace).Before(now) {
+			names = append(names, leases[i].Name)
+		}
+	}
+	return names
+}
+
+func equalMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/sirupsen/logrus"
+	coordv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func lease(name, holder string, renewedAt time.Time, durationSeconds int32) coordv1.Lease {
+	renewTime := metav1.NewMicroTime(renewedAt)
+	return coordv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: coordv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			RenewTime:            &renewTime,
+			LeaseDurationSeconds: &durationSeconds,
+		},
+	}
+}
+
+var _ = Describe("Membership", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockMetricApi *metrics.MockAPI
+		membership    *Membership
+		now           time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetricApi = metrics.NewMockAPI(ctrl)
+		membership = NewMembership(nil, Config{LeaseDuration: 15 * time.Second, VirtualNodes: 100}, "monitor",
+			mockMetricApi, logrus.New())
+		now = time.Now()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("lists the holders of the live leases", func() {
+		leases := []coordv1.Lease{
+			lease("monitor-b", "b", now.Add(-5*time.Second), 15),
+			lease("monitor-a", "a", now, 15),
+			lease("monitor-c", "c", now.Add(-20*time.Second), 15),
+			{ObjectMeta: metav1.ObjectMeta{Name: "monitor-d"}},
+		}
+		Expect(liveMembers(leases, now)).To(Equal([]string{"a", "b"}))
+	})
+
+	It("lists the leases expired for longer than the grace period", func() {
+		leases := []coordv1.Lease{
+			lease("monitor-a", "a", now, 15),
+			lease("monitor-b", "b", now.Add(-20*time.Second), 15),
+			lease("monitor-c", "c", now.Add(-200*time.Second), 15),
+		}
+		Expect(staleLeases(leases, now, 150*time.Second)).To(Equal([]string{"monitor-c"}))
+	})
+
+	It("owns nothing before joining the group", func() {
+		Expect(membership.Owns("id")).To(BeFalse())
+	})
+
+	It("owns its shard while its lease is renewed", func() {
+		membership.members = []string{membership.identity, "other"}
+		membership.ring = newRing(membership.members, 100)
+		membership.renewedAt = now
+
+		owned := 0
+		for i := 0; i < 100; i++ {
+			id := fmt.Sprintf("cluster-%d", i)
+			if membership.Owns(id) {
+				owned++
+				Expect(membership.ring.owner(id)).To(Equal(membership.identity))
+			}
+		}
+		Expect(owned).To(BeNumerically(">", 0))
+		Expect(owned).To(BeNumerically("<", 100))
+
+		membership.renewedAt = now.Add(-20 * time.Second)
+		for i := 0; i < 100; i++ {
+			Expect(membership.Owns(fmt.Sprintf("cluster-%d", i))).To(BeFalse())
+		}
+	})
+
+	It("is named after its identity", func() {
+		Expect(membership.Name()).To(Equal(membership.identity))
+		Expect(membership.Name()).ToNot(BeEmpty())
+	})
+
+	It("leaves without having started", func() {
+		membership.Leave()
+	})
+})
+
+var _ = Describe("AllSharder", func() {
+	It("owns everything", func() {
+		sharder := &AllSharder{}
+		Expect(sharder.Owns("id")).To(BeTrue())
+		Expect(sharder.Name()).To(Equal("all"))
+	})
+})
+
+func TestShard(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shard tests")
+}
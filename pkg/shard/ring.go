@@ -0,0 +1,58 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// ring is a consistent hashing ring distributing IDs between members. Each member is placed on the ring at several
+// points (virtual nodes), so the IDs are spread evenly and a member joining or leaving only moves the IDs of its
+// own points.
+type ring struct {
+	points  []uint64
+	members map[uint64]string
+}
+
+// hash places the keys on the ring. The IDs and the virtual node names differ only by a few characters, so a hash
+// with a good avalanche is needed for an even spread.
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+func newRing(members []string, virtualNodes int) *ring {
+	if virtualNodes < 1 {
+		virtualNodes = 1
+	}
+	r := &ring{members: make(map[uint64]string)}
+	for _, member := range members {
+		for i := 0; i < virtualNodes; i++ {
+			point := hash(fmt.Sprintf("%s#%d", member, i))
+			// Keep the placement independent of the order of the members on the unlikely collision
+			if existing, ok := r.members[point]; ok && existing < member {
+				continue
+			} else if !ok {
+				r.points = append(r.points, point)
+			}
+			r.members[point] = member
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// owner returns the member owning the ID, which is the member of the first point following the hash of the ID, or an
+// empty string when the ring has no members
+func (r *ring) owner(id string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := hash(id)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.members[r.points[i]]
+}
@@ -0,0 +1,66 @@
+package shard
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ring", func() {
+	ids := func(n int) []string {
+		ret := make([]string, n)
+		for i := range ret {
+			ret[i] = fmt.Sprintf("8c3f9d2a-1b4e-4f6a-9c7d-%012d", i)
+		}
+		return ret
+	}
+
+	It("has no owner without members", func() {
+		Expect(newRing(nil, 100).owner("id")).To(BeEmpty())
+	})
+
+	It("assigns everything to a single member", func() {
+		r := newRing([]string{"replica-a"}, 100)
+		for _, id := range ids(100) {
+			Expect(r.owner(id)).To(Equal("replica-a"))
+		}
+	})
+
+	It("spreads the ids between the members", func() {
+		members := []string{"replica-a", "replica-b", "replica-c"}
+		r := newRing(members, 100)
+		counts := map[string]int{}
+		for _, id := range ids(3000) {
+			counts[r.owner(id)]++
+		}
+		Expect(counts).To(HaveLen(3))
+		for _, member := range members {
+			Expect(counts[member]).To(BeNumerically(">", 600))
+		}
+	})
+
+	It("doesn't depend on the order of the members", func() {
+		r1 := newRing([]string{"replica-a", "replica-b", "replica-c"}, 100)
+		r2 := newRing([]string{"replica-c", "replica-a", "replica-b"}, 100)
+		for _, id := range ids(500) {
+			Expect(r1.owner(id)).To(Equal(r2.owner(id)))
+		}
+	})
+
+	It("only moves the ids of the member leaving or joining", func() {
+		before := newRing([]string{"replica-a", "replica-b", "replica-c"}, 100)
+		after := newRing([]string{"replica-a", "replica-b"}, 100)
+		for _, id := range ids(1000) {
+			if owner := before.owner(id); owner != "replica-c" {
+				Expect(after.owner(id)).To(Equal(owner))
+			}
+		}
+		joined := newRing([]string{"replica-a", "replica-b", "replica-c", "replica-d"}, 100)
+		for _, id := range ids(1000) {
+			if owner := joined.owner(id); owner != "replica-d" {
+				Expect(before.owner(id)).To(Equal(owner))
+			}
+		}
+	})
+})
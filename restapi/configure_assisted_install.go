@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -15,18 +16,95 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/bmc"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/dry_run"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/hardware_inventory"
+	"github.com/openshift/assisted-service/restapi/operations/host_claims"
+	"github.com/openshift/assisted-service/restapi/operations/installation_timeline"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/log_search"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/openshift/assisted-service/restapi/operations/network_report"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/triage"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name BmcAPI -inpkg
+
+/* BmcAPI  */
+type BmcAPI interface {
+	/* V2BmcHostBootIso Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it. */
+	V2BmcHostBootIso(ctx context.Context, params bmc.V2BmcHostBootIsoParams) middleware.Responder
+
+	/* V2BmcHostEjectVirtualMedia Ejects the virtual media of a BMC host. */
+	V2BmcHostEjectVirtualMedia(ctx context.Context, params bmc.V2BmcHostEjectVirtualMediaParams) middleware.Responder
+
+	/* V2BmcHostPowerAction Powers a BMC host on or off, or restarts it. */
+	V2BmcHostPowerAction(ctx context.Context, params bmc.V2BmcHostPowerActionParams) middleware.Responder
+
+	/* V2CreateBmcHost Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish. */
+	V2CreateBmcHost(ctx context.Context, params bmc.V2CreateBmcHostParams) middleware.Responder
+
+	/* V2DeleteBmcHost Deletes a BMC host together with its credentials. The host itself isn't changed. */
+	V2DeleteBmcHost(ctx context.Context, params bmc.V2DeleteBmcHostParams) middleware.Responder
+
+	/* V2GetBmcHost Retrieves a BMC host. */
+	V2GetBmcHost(ctx context.Context, params bmc.V2GetBmcHostParams) middleware.Responder
+
+	/* V2GetBmcHostStatus Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC. */
+	V2GetBmcHostStatus(ctx context.Context, params bmc.V2GetBmcHostStatusParams) middleware.Responder
+
+	/* V2ListBmcHosts Lists the BMC hosts of the infra-env. */
+	V2ListBmcHosts(ctx context.Context, params bmc.V2ListBmcHostsParams) middleware.Responder
+
+	/* V2UpdateBmcHost Updates the address, the credentials or the host of a BMC host. */
+	V2UpdateBmcHost(ctx context.Context, params bmc.V2UpdateBmcHostParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterTemplatesAPI -inpkg
+
+/* ClusterTemplatesAPI  */
+type ClusterTemplatesAPI interface {
+	/* V2CreateClusterTemplate Creates a template from which clusters can be created repeatedly. */
+	V2CreateClusterTemplate(ctx context.Context, params cluster_templates.V2CreateClusterTemplateParams) middleware.Responder
+
+	/* V2DeleteClusterTemplate Deletes a cluster template. Clusters already created from it are not changed. */
+	V2DeleteClusterTemplate(ctx context.Context, params cluster_templates.V2DeleteClusterTemplateParams) middleware.Responder
+
+	/* V2GetClusterTemplate Retrieves the details of a cluster template. */
+	V2GetClusterTemplate(ctx context.Context, params cluster_templates.V2GetClusterTemplateParams) middleware.Responder
+
+	/* V2InstantiateClusterTemplate Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template. */
+	V2InstantiateClusterTemplate(ctx context.Context, params cluster_templates.V2InstantiateClusterTemplateParams) middleware.Responder
+
+	/* V2ListClusterTemplates Lists the cluster templates of the tenant. */
+	V2ListClusterTemplates(ctx context.Context, params cluster_templates.V2ListClusterTemplatesParams) middleware.Responder
+
+	/* V2UpdateClusterTemplate Updates the description and the definition of a cluster template. Clusters already created from it are not changed. */
+	V2UpdateClusterTemplate(ctx context.Context, params cluster_templates.V2UpdateClusterTemplateParams) middleware.Responder
+}
+
+//go:generate mockery -name DryRunAPI -inpkg
+
+/* DryRunAPI  */
+type DryRunAPI interface {
+	/* V2DryRunGenerate Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate. */
+	V2DryRunGenerate(ctx context.Context, params dry_run.V2DryRunGenerateParams) middleware.Responder
+
+	/* V2GetDryRunGenerate Retrieves the report of the last dry run of the cluster installation files generation. */
+	V2GetDryRunGenerate(ctx context.Context, params dry_run.V2GetDryRunGenerateParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
@@ -38,6 +116,45 @@ type EventsAPI interface {
 	V2TriggerEvent(ctx context.Context, params events.V2TriggerEventParams) middleware.Responder
 }
 
+//go:generate mockery -name HardwareInventoryAPI -inpkg
+
+/* HardwareInventoryAPI  */
+type HardwareInventoryAPI interface {
+	/* V2ExportHostHardware Exports the hardware of all the hosts of the tenant matching the filters. */
+	V2ExportHostHardware(ctx context.Context, params hardware_inventory.V2ExportHostHardwareParams) middleware.Responder
+
+	/* V2ListHostHardware Queries the hardware of the hosts of the tenant, as reported in their inventories. */
+	V2ListHostHardware(ctx context.Context, params hardware_inventory.V2ListHostHardwareParams) middleware.Responder
+}
+
+//go:generate mockery -name HostClaimsAPI -inpkg
+
+/* HostClaimsAPI  */
+type HostClaimsAPI interface {
+	/* V2DeleteHostClaim Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster. */
+	V2DeleteHostClaim(ctx context.Context, params host_claims.V2DeleteHostClaimParams) middleware.Responder
+
+	/* V2GetHostClaim Retrieves the host claim of the cluster and the hosts bound by it. */
+	V2GetHostClaim(ctx context.Context, params host_claims.V2GetHostClaimParams) middleware.Responder
+
+	/* V2ListHostClaims Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler. */
+	V2ListHostClaims(ctx context.Context, params host_claims.V2ListHostClaimsParams) middleware.Responder
+
+	/* V2SetHostClaim Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers. */
+	V2SetHostClaim(ctx context.Context, params host_claims.V2SetHostClaimParams) middleware.Responder
+}
+
+//go:generate mockery -name InstallationTimelineAPI -inpkg
+
+/* InstallationTimelineAPI  */
+type InstallationTimelineAPI interface {
+	/* V2DownloadInstallationTimeline Downloads the installation timeline of the cluster. */
+	V2DownloadInstallationTimeline(ctx context.Context, params installation_timeline.V2DownloadInstallationTimelineParams) middleware.Responder
+
+	/* V2GetInstallationTimeline Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation. */
+	V2GetInstallationTimeline(ctx context.Context, params installation_timeline.V2GetInstallationTimelineParams) middleware.Responder
+}
+
 //go:generate mockery -name InstallerAPI -inpkg
 
 /* InstallerAPI  */
@@ -133,6 +250,9 @@ type InstallerAPI interface {
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
+	/* V2DecommissionHost Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events. */
+	V2DecommissionHost(ctx context.Context, params installer.V2DecommissionHostParams) middleware.Responder
+
 	/* V2DeregisterCluster Deletes an OpenShift cluster definition. */
 	V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder
 
@@ -157,6 +277,9 @@ type InstallerAPI interface {
 	/* V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error */
 	V2GetHostIgnition(ctx context.Context, params installer.V2GetHostIgnitionParams) middleware.Responder
 
+	/* V2GetHostIgnitionPreview Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit. */
+	V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder
+
 	/* V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster. */
 	V2GetIgnoredValidations(ctx context.Context, params installer.V2GetIgnoredValidationsParams) middleware.Responder
 
@@ -184,6 +307,9 @@ type InstallerAPI interface {
 	/* V2PostStepReply Posts the result of the operations from the host agent. */
 	V2PostStepReply(ctx context.Context, params installer.V2PostStepReplyParams) middleware.Responder
 
+	/* V2PreviewClusterInstallConfig Previews the install config resulting from install config overrides, without applying them. */
+	V2PreviewClusterInstallConfig(ctx context.Context, params installer.V2PreviewClusterInstallConfigParams) middleware.Responder
+
 	/* V2RegisterCluster Creates a new OpenShift cluster definition. */
 	V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder
 
@@ -230,6 +356,14 @@ type InstallerAPI interface {
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
 }
 
+//go:generate mockery -name LogSearchAPI -inpkg
+
+/* LogSearchAPI  */
+type LogSearchAPI interface {
+	/* V2SearchClusterLogs Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service. */
+	V2SearchClusterLogs(ctx context.Context, params log_search.V2SearchClusterLogsParams) middleware.Responder
+}
+
 //go:generate mockery -name ManagedDomainsAPI -inpkg
 
 /* ManagedDomainsAPI  */
@@ -258,6 +392,17 @@ type ManifestsAPI interface {
 	V2DownloadClusterManifest(ctx context.Context, params manifests.V2DownloadClusterManifestParams) middleware.Responder
 }
 
+//go:generate mockery -name NetworkReportAPI -inpkg
+
+/* NetworkReportAPI  */
+type NetworkReportAPI interface {
+	/* V2DownloadNetworkReport Downloads the network report of the cluster, to be attached to support cases. */
+	V2DownloadNetworkReport(ctx context.Context, params network_report.V2DownloadNetworkReportParams) middleware.Responder
+
+	/* V2GetNetworkReport Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation. */
+	V2GetNetworkReport(ctx context.Context, params network_report.V2GetNetworkReportParams) middleware.Responder
+}
+
 //go:generate mockery -name OperatorsAPI -inpkg
 
 /* OperatorsAPI  */
@@ -275,6 +420,14 @@ type OperatorsAPI interface {
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params operators.V2ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name TriageAPI -inpkg
+
+/* TriageAPI  */
+type TriageAPI interface {
+	/* V2ListClusterTriageFindings Lists the known failures found by the automatic triage of the logs uploaded for the cluster. */
+	V2ListClusterTriageFindings(ctx context.Context, params triage.V2ListClusterTriageFindingsParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
@@ -289,14 +442,50 @@ type VersionsAPI interface {
 	V2ListSupportedOpenshiftVersions(ctx context.Context, params versions.V2ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
+//go:generate mockery -name WatchAPI -inpkg
+
+/* WatchAPI  */
+type WatchAPI interface {
+	/* V2WatchCluster Streams the changes of the cluster, its hosts and its events as server-sent events. */
+	V2WatchCluster(ctx context.Context, params watch.V2WatchClusterParams) middleware.Responder
+
+	/* V2WatchInfraEnv Streams the changes of the infra-env, its hosts and its events as server-sent events. */
+	V2WatchInfraEnv(ctx context.Context, params watch.V2WatchInfraEnvParams) middleware.Responder
+}
+
+//go:generate mockery -name WebhooksAPI -inpkg
+
+/* WebhooksAPI  */
+type WebhooksAPI interface {
+	/* V2DeregisterWebhookSubscription Deletes a webhook subscription together with its pending deliveries. */
+	V2DeregisterWebhookSubscription(ctx context.Context, params webhooks.V2DeregisterWebhookSubscriptionParams) middleware.Responder
+
+	/* V2ListWebhookSubscriptions Lists the webhook subscriptions of the tenant. */
+	V2ListWebhookSubscriptions(ctx context.Context, params webhooks.V2ListWebhookSubscriptionsParams) middleware.Responder
+
+	/* V2RegisterWebhookSubscription Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant. */
+	V2RegisterWebhookSubscription(ctx context.Context, params webhooks.V2RegisterWebhookSubscriptionParams) middleware.Responder
+}
+
 // Config is configuration for Handler
 type Config struct {
+	BmcAPI
+	ClusterTemplatesAPI
+	DryRunAPI
 	EventsAPI
+	HardwareInventoryAPI
+	HostClaimsAPI
+	InstallationTimelineAPI
 	InstallerAPI
+	LogSearchAPI
 	ManagedDomainsAPI
 	ManifestsAPI
+	NetworkReportAPI
 	OperatorsAPI
+	TriageAPI
 	VersionsAPI
+	WatchAPI
+	WebhooksAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
 	// The middleware executes after routing but before authentication, binding and validation
@@ -375,6 +564,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	}
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -596,11 +788,56 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadLogs(ctx, params)
 	})
+	api.BmcV2BmcHostBootIsoHandler = bmc.V2BmcHostBootIsoHandlerFunc(func(params bmc.V2BmcHostBootIsoParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2BmcHostBootIso(ctx, params)
+	})
+	api.BmcV2BmcHostEjectVirtualMediaHandler = bmc.V2BmcHostEjectVirtualMediaHandlerFunc(func(params bmc.V2BmcHostEjectVirtualMediaParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2BmcHostEjectVirtualMedia(ctx, params)
+	})
+	api.BmcV2BmcHostPowerActionHandler = bmc.V2BmcHostPowerActionHandlerFunc(func(params bmc.V2BmcHostPowerActionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2BmcHostPowerAction(ctx, params)
+	})
 	api.InstallerV2CompleteInstallationHandler = installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.BmcV2CreateBmcHostHandler = bmc.V2CreateBmcHostHandlerFunc(func(params bmc.V2CreateBmcHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2CreateBmcHost(ctx, params)
+	})
+	api.ClusterTemplatesV2CreateClusterTemplateHandler = cluster_templates.V2CreateClusterTemplateHandlerFunc(func(params cluster_templates.V2CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2CreateClusterTemplate(ctx, params)
+	})
+	api.InstallerV2DecommissionHostHandler = installer.V2DecommissionHostHandlerFunc(func(params installer.V2DecommissionHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DecommissionHost(ctx, params)
+	})
+	api.BmcV2DeleteBmcHostHandler = bmc.V2DeleteBmcHostHandlerFunc(func(params bmc.V2DeleteBmcHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2DeleteBmcHost(ctx, params)
+	})
+	api.ClusterTemplatesV2DeleteClusterTemplateHandler = cluster_templates.V2DeleteClusterTemplateHandlerFunc(func(params cluster_templates.V2DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2DeleteClusterTemplate(ctx, params)
+	})
+	api.HostClaimsV2DeleteHostClaimHandler = host_claims.V2DeleteHostClaimHandlerFunc(func(params host_claims.V2DeleteHostClaimParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HostClaimsAPI.V2DeleteHostClaim(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -611,6 +848,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterHost(ctx, params)
 	})
+	api.WebhooksV2DeregisterWebhookSubscriptionHandler = webhooks.V2DeregisterWebhookSubscriptionHandlerFunc(func(params webhooks.V2DeregisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2DeregisterWebhookSubscription(ctx, params)
+	})
 	api.ManifestsV2DownloadClusterManifestHandler = manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -626,6 +868,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallationTimelineV2DownloadInstallationTimelineHandler = installation_timeline.V2DownloadInstallationTimelineHandlerFunc(func(params installation_timeline.V2DownloadInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallationTimelineAPI.V2DownloadInstallationTimeline(ctx, params)
+	})
+	api.NetworkReportV2DownloadNetworkReportHandler = network_report.V2DownloadNetworkReportHandlerFunc(func(params network_report.V2DownloadNetworkReportParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.NetworkReportAPI.V2DownloadNetworkReport(ctx, params)
+	})
+	api.DryRunV2DryRunGenerateHandler = dry_run.V2DryRunGenerateHandlerFunc(func(params dry_run.V2DryRunGenerateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.DryRunAPI.V2DryRunGenerate(ctx, params)
+	})
+	api.HardwareInventoryV2ExportHostHardwareHandler = hardware_inventory.V2ExportHostHardwareHandlerFunc(func(params hardware_inventory.V2ExportHostHardwareParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HardwareInventoryAPI.V2ExportHostHardware(ctx, params)
+	})
+	api.BmcV2GetBmcHostHandler = bmc.V2GetBmcHostHandlerFunc(func(params bmc.V2GetBmcHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2GetBmcHost(ctx, params)
+	})
+	api.BmcV2GetBmcHostStatusHandler = bmc.V2GetBmcHostStatusHandlerFunc(func(params bmc.V2GetBmcHostStatusParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2GetBmcHostStatus(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -636,21 +908,51 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterInstallConfig(ctx, params)
 	})
+	api.ClusterTemplatesV2GetClusterTemplateHandler = cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2GetClusterTemplate(ctx, params)
+	})
+	api.DryRunV2GetDryRunGenerateHandler = dry_run.V2GetDryRunGenerateHandlerFunc(func(params dry_run.V2GetDryRunGenerateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.DryRunAPI.V2GetDryRunGenerate(ctx, params)
+	})
 	api.InstallerV2GetHostHandler = installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetHost(ctx, params)
 	})
+	api.HostClaimsV2GetHostClaimHandler = host_claims.V2GetHostClaimHandlerFunc(func(params host_claims.V2GetHostClaimParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HostClaimsAPI.V2GetHostClaim(ctx, params)
+	})
 	api.InstallerV2GetHostIgnitionHandler = installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetHostIgnition(ctx, params)
 	})
+	api.InstallerV2GetHostIgnitionPreviewHandler = installer.V2GetHostIgnitionPreviewHandlerFunc(func(params installer.V2GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetHostIgnitionPreview(ctx, params)
+	})
 	api.InstallerV2GetIgnoredValidationsHandler = installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetIgnoredValidations(ctx, params)
 	})
+	api.InstallationTimelineV2GetInstallationTimelineHandler = installation_timeline.V2GetInstallationTimelineHandlerFunc(func(params installation_timeline.V2GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallationTimelineAPI.V2GetInstallationTimeline(ctx, params)
+	})
+	api.NetworkReportV2GetNetworkReportHandler = network_report.V2GetNetworkReportHandlerFunc(func(params network_report.V2GetNetworkReportParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.NetworkReportAPI.V2GetNetworkReport(ctx, params)
+	})
 	api.InstallerV2GetNextStepsHandler = installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -676,6 +978,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.ClusterTemplatesV2InstantiateClusterTemplateHandler = cluster_templates.V2InstantiateClusterTemplateHandlerFunc(func(params cluster_templates.V2InstantiateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2InstantiateClusterTemplate(ctx, params)
+	})
+	api.BmcV2ListBmcHostsHandler = bmc.V2ListBmcHostsHandlerFunc(func(params bmc.V2ListBmcHostsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2ListBmcHosts(ctx, params)
+	})
+	api.ClusterTemplatesV2ListClusterTemplatesHandler = cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2ListClusterTemplates(ctx, params)
+	})
+	api.TriageV2ListClusterTriageFindingsHandler = triage.V2ListClusterTriageFindingsHandlerFunc(func(params triage.V2ListClusterTriageFindingsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TriageAPI.V2ListClusterTriageFindings(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -691,6 +1013,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2ListEvents(ctx, params)
 	})
+	api.HostClaimsV2ListHostClaimsHandler = host_claims.V2ListHostClaimsHandlerFunc(func(params host_claims.V2ListHostClaimsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HostClaimsAPI.V2ListHostClaims(ctx, params)
+	})
+	api.HardwareInventoryV2ListHostHardwareHandler = hardware_inventory.V2ListHostHardwareHandlerFunc(func(params hardware_inventory.V2ListHostHardwareParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HardwareInventoryAPI.V2ListHostHardware(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -706,11 +1038,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListSupportedOpenshiftVersions(ctx, params)
 	})
+	api.WebhooksV2ListWebhookSubscriptionsHandler = webhooks.V2ListWebhookSubscriptionsHandlerFunc(func(params webhooks.V2ListWebhookSubscriptionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2ListWebhookSubscriptions(ctx, params)
+	})
 	api.InstallerV2PostStepReplyHandler = installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2PostStepReply(ctx, params)
 	})
+	api.InstallerV2PreviewClusterInstallConfigHandler = installer.V2PreviewClusterInstallConfigHandlerFunc(func(params installer.V2PreviewClusterInstallConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2PreviewClusterInstallConfig(ctx, params)
+	})
 	api.InstallerV2RegisterClusterHandler = installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -721,6 +1063,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.WebhooksV2RegisterWebhookSubscriptionHandler = webhooks.V2RegisterWebhookSubscriptionHandlerFunc(func(params webhooks.V2RegisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2RegisterWebhookSubscription(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -741,6 +1088,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.LogSearchV2SearchClusterLogsHandler = log_search.V2SearchClusterLogsHandlerFunc(func(params log_search.V2SearchClusterLogsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LogSearchAPI.V2SearchClusterLogs(ctx, params)
+	})
+	api.HostClaimsV2SetHostClaimHandler = host_claims.V2SetHostClaimHandlerFunc(func(params host_claims.V2SetHostClaimParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.HostClaimsAPI.V2SetHostClaim(ctx, params)
+	})
 	api.InstallerV2SetIgnoredValidationsHandler = installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -751,6 +1108,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2TriggerEvent(ctx, params)
 	})
+	api.BmcV2UpdateBmcHostHandler = bmc.V2UpdateBmcHostHandlerFunc(func(params bmc.V2UpdateBmcHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BmcAPI.V2UpdateBmcHost(ctx, params)
+	})
 	api.InstallerV2UpdateClusterFinalizingProgressHandler = installer.V2UpdateClusterFinalizingProgressHandlerFunc(func(params installer.V2UpdateClusterFinalizingProgressParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -766,6 +1128,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateClusterLogsProgress(ctx, params)
 	})
+	api.ClusterTemplatesV2UpdateClusterTemplateHandler = cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2UpdateClusterTemplate(ctx, params)
+	})
 	api.InstallerV2UpdateHostHandler = installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -796,6 +1163,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.WatchV2WatchClusterHandler = watch.V2WatchClusterHandlerFunc(func(params watch.V2WatchClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WatchAPI.V2WatchCluster(ctx, params)
+	})
+	api.WatchV2WatchInfraEnvHandler = watch.V2WatchInfraEnvHandlerFunc(func(params watch.V2WatchInfraEnvParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WatchAPI.V2WatchInfraEnv(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
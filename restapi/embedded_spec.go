@@ -41,7 +41,7 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install",
   "paths": {
-    "/v2/clusters": {
+    "/v2/cluster-templates": {
       "get": {
         "security": [
           {
@@ -52,55 +52,16 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Lists the cluster templates of the tenant.",
         "tags": [
-          "installer"
-        ],
-        "operationId": "v2ListClusters",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
-          }
+          "cluster_templates"
         ],
+        "operationId": "v2ListClusterTemplates",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/cluster-template-list"
             }
           },
           "401": {
@@ -115,40 +76,28 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Creates a template from which clusters can be created repeatedly.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "v2CreateClusterTemplate",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/cluster-template-create-params"
             }
           }
         ],
@@ -156,7 +105,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "400": {
@@ -177,12 +126,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -192,7 +135,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/cluster-templates/{cluster_template_id}": {
       "get": {
         "security": [
           {
@@ -203,16 +146,26 @@ func init() {
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Retrieves the details of a cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
+        ],
+        "operationId": "v2GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be retrieved.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "V2GetClusterDefaultConfig",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "401": {
@@ -227,58 +180,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2ImportCluster",
-        "parameters": [
-          {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -286,62 +193,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Deletes a cluster template. Clusters already created from it are not changed.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "v2DeleteClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
-            "name": "cluster_id",
+            "description": "The cluster template to be deleted.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -361,45 +232,51 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "patch": {
+        "description": "Updates the description and the definition of a cluster template. Clusters already created from it are not changed.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2UpdateClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
+            "description": "The cluster template to be updated.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-template-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-update-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -419,18 +296,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -438,29 +303,31 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/v2/cluster-templates/{cluster_template_id}/actions/instantiate": {
+      "post": {
+        "description": "Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2InstantiateClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
-            "name": "cluster_id",
+            "description": "The cluster template to be instantiated.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "The properties of the new cluster which are not taken from the template.",
+            "name": "instantiate-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "$ref": "#/definitions/cluster-template-instantiate-params"
             }
           }
         ],
@@ -468,7 +335,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template-instance"
             }
           },
           "400": {
@@ -495,18 +362,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -516,28 +371,66 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToAddingHosts",
+        "operationId": "v2ListClusters",
         "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-list"
             }
           },
           "401": {
@@ -552,58 +445,56 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      },
       "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "v2RegisterCluster",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to transform.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -616,24 +507,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -643,28 +522,27 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "V2CancelInstallation",
-        "parameters": [
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster_default_config"
             }
           },
           "401": {
@@ -679,24 +557,58 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
             }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -706,45 +618,56 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
             "name": "discovery_agent_version",
             "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -774,12 +697,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -793,37 +710,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "v2DeregisterCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -862,32 +768,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -927,35 +846,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "TransformClusterToAddingHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster to transform.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -997,56 +909,29 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster to transform.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1084,63 +969,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1182,68 +1036,48 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "v2CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
+            "description": "The software version of the discovery agent that is completing the installation.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1291,83 +1125,36 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
+    "/v2/clusters/{cluster_id}/actions/dry-run-generate": {
+      "post": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate.",
         "tags": [
-          "installer"
+          "dry_run"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2DryRunGenerate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation files are generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/dry-run-generate-report"
             }
           },
           "401": {
@@ -1388,14 +1175,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -1409,69 +1190,34 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "master",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1492,89 +1238,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
-      "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetIgnoredValidations",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
           },
-          "400": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2SetIgnoredValidations",
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1595,6 +1301,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1604,27 +1322,25 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "V2GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1634,7 +1350,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1661,73 +1377,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2UpdateClusterInstallConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1737,54 +1392,49 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           },
           {
             "urlAuth": []
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Download cluster logs.",
+        "description": "Downloads credentials relating to the installed/installing cluster.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster that owns the credential file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "host",
-              "controller",
-              "all"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -1829,69 +1479,64 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      },
-      "post": {
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
           {
             "enum": [
-              "host",
-              "controller"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
             "in": "query",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -1928,50 +1573,73 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2018,32 +1686,69 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
             "in": "query"
           }
         ],
@@ -2051,7 +1756,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2091,48 +1802,38 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/dry-run-generate": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Retrieves the report of the last dry run of the cluster installation files generation.",
         "tags": [
-          "manifests"
+          "dry_run"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "v2GetDryRunGenerate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose dry run report is retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/dry-run-generate-report"
             }
           },
           "401": {
@@ -2153,18 +1854,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2172,44 +1861,40 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
-        "tags": [
-          "manifests"
-        ],
-        "operationId": "V2DeleteClusterManifest",
-        "parameters": [
+      }
+    },
+    "/v2/clusters/{cluster_id}/host-claim": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the host claim of the cluster and the hosts bound by it.",
+        "tags": [
+          "host_claims"
+        ],
+        "operationId": "v2GetHostClaim",
+        "parameters": [
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster whose host claim is retrieved.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-claim"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2229,18 +1914,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2249,33 +1922,28 @@ func init() {
           }
         }
       },
-      "patch": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Updates a manifest for customizing cluster installation.",
+      "put": {
+        "description": "Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers.",
         "tags": [
-          "manifests"
+          "host_claims"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2SetHostClaim",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
+            "description": "The cluster whose host claim is set.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
+            "description": "The definition of the host claim.",
+            "name": "host-claim-spec",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/update-manifest-params"
+              "$ref": "#/definitions/host-claim-spec"
             }
           }
         ],
@@ -2283,7 +1951,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "$ref": "#/definitions/host-claim"
             }
           },
           "400": {
@@ -2310,12 +1978,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2329,57 +1991,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/manifests/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster.",
         "tags": [
-          "manifests"
+          "host_claims"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2DeleteHostClaim",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose host claim is deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2399,18 +2030,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2420,32 +2039,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "master",
+              "worker",
+              "auto-assign"
+            ],
+            "type": "string",
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
+          },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
             "in": "query"
           }
         ],
@@ -2453,7 +2101,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -2474,12 +2122,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2487,44 +2129,50 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Controller API to report of monitored operators.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission": {
+      "post": {
+        "description": "Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2DecommissionHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster of the host that is being decommissioned.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being decommissioned.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The options of the decommission.",
+            "name": "decommission-params",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/decommission-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2547,12 +2195,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2564,37 +2206,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/clusters/{cluster_id}/ignored-validations": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Fetch the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "v2GetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2604,19 +2231,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/ignored-validations"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -2624,56 +2245,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/progress": {
+      },
       "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation finalizing progress.",
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
+              "$ref": "#/definitions/ignored-validations"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignored-validations"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2693,28 +2304,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
@@ -2725,16 +2324,16 @@ func init() {
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2744,10 +2343,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "type": "string"
             }
           },
           "401": {
@@ -2768,6 +2364,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2775,32 +2377,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "string"
             }
           },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Allow overriding the fields set by the service from the cluster, i.e. the networking and the platform, including the VIPs.",
+            "name": "allow_service_owned_fields",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
           "400": {
             "description": "Error.",
             "schema": {
@@ -2825,6 +2438,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2832,29 +2451,40 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config/preview": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Previews the install config resulting from install config overrides, without applying them.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2PreviewClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
+            "description": "The cluster whose install config is previewed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
+            "description": "The install config overrides to preview.",
+            "name": "preview-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/install-config-preview-params"
             }
           }
         ],
@@ -2862,7 +2492,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/install-config-preview"
             }
           },
           "400": {
@@ -2889,6 +2519,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2898,51 +2534,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/clusters/{cluster_id}/installation-timeline": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation.",
         "tags": [
-          "installer"
+          "installation_timeline"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2GetInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose installation is reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -2963,28 +2585,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/component-versions": {
+    "/v2/clusters/{cluster_id}/installation-timeline/download": {
       "get": {
         "security": [
           {
@@ -2995,42 +2605,58 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Downloads the installation timeline of the cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "versions"
+          "installation_timeline"
         ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
+        "operationId": "v2DownloadInstallationTimeline",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is reported.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "The format of the downloaded timeline. The CSV format has a row per stage.",
+            "name": "format",
+            "in": "query"
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -3042,7 +2668,7 @@ func init() {
         }
       }
     },
-    "/v2/events": {
+    "/v2/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
@@ -3056,106 +2682,39 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
-        "tags": [
-          "events"
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
         ],
-        "operationId": "v2ListEvents",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
             "enum": [
-              "ascending",
-              "descending"
+              "host",
+              "controller",
+              "all"
             ],
             "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
             "in": "query"
           }
         ],
@@ -3163,29 +2722,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
+              "type": "file"
             }
           },
           "401": {
@@ -3212,6 +2749,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3226,32 +2769,61 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Add new assisted installer event.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/event"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -3277,7 +2849,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3288,12 +2860,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -3303,52 +2869,40 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -3395,7 +2949,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/clusters/{cluster_id}/logs/search": {
       "get": {
         "security": [
           {
@@ -3406,31 +2960,95 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service.",
         "tags": [
-          "installer"
+          "log_search"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "v2SearchClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "description": "The cluster whose logs are searched.",
             "name": "cluster_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
+            "maxLength": 1024,
+            "minLength": 2,
             "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+            "description": "The text searched in the lines of the logs, case-insensitive.",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only search the logs of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "Only search the logs of this type.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only search the files whose path contains this text.",
+            "name": "file",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the lines whose time is at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the lines whose time is at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 10,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of lines of the file returned before and after each matching line.",
+            "name": "context_lines",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of matching lines returned.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/log-search-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3445,8 +3063,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3456,49 +3074,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the manifests should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -3536,59 +3149,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3615,20 +3219,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3636,23 +3234,41 @@ func init() {
         }
       },
       "delete": {
-        "description": "Deletes an infra-env.",
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
-            "name": "infra_env_id",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "Success."
           },
           "401": {
@@ -3690,45 +3306,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "patch": {
-        "description": "Updates an infra-env.",
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
-            "name": "infra_env_id",
+            "description": "The cluster for which a new manifest should be updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "$ref": "#/definitions/update-manifest-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -3772,94 +3387,51 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Downloads cluster manifest.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
           },
           {
-            "enum": [
-              "full-iso",
-              "minimal-iso"
-            ],
             "type": "string",
-            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
-            "name": "discovery_iso_type",
-            "in": "query"
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -3869,12 +3441,6 @@ func init() {
               "type": "file"
             }
           },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -3910,57 +3476,36 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
-            "name": "infra_env_id",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
             "in": "query"
           }
         ],
@@ -3968,7 +3513,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -4000,48 +3545,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
+            "description": "Success."
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4070,14 +3613,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4091,7 +3634,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+    "/v2/clusters/{cluster_id}/network-report": {
       "get": {
         "security": [
           {
@@ -4100,28 +3643,19 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
           }
         ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation.",
         "tags": [
-          "installer"
+          "network_report"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2GetNetworkReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster whose network is reported.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
@@ -4130,13 +3664,9 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/network-report"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4155,18 +3685,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4176,7 +3694,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
+    "/v2/clusters/{cluster_id}/network-report/download": {
       "get": {
         "security": [
           {
@@ -4185,31 +3703,42 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+        "description": "Downloads the network report of the cluster, to be attached to support cases.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "network_report"
         ],
-        "operationId": "v2ListHosts",
+        "operationId": "v2DownloadNetworkReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
-            "name": "infra_env_id",
+            "description": "The cluster whose network is reported.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "html",
+              "json"
+            ],
+            "type": "string",
+            "default": "html",
+            "description": "The format of the downloaded report.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "file"
             }
           },
           "401": {
@@ -4224,8 +3753,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4235,68 +3764,41 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
-            "name": "infra_env_id",
+            "description": "The cluster to return preflight requirements for.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -4323,20 +3825,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateClusterFinalizingProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "500": {
+          {
+            "description": "New progress value.",
+            "name": "finalizing-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-finalizing-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4350,7 +3908,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
         "security": [
           {
@@ -4361,25 +3919,17 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
@@ -4388,7 +3938,10 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
             }
           },
           "401": {
@@ -4409,58 +3962,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/triage": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the known failures found by the automatic triage of the logs uploaded for the cluster.",
         "tags": [
-          "installer"
+          "triage"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2ListClusterTriageFindings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
+            "description": "The cluster whose findings are listed.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/triage-finding"
+              }
             }
           },
           "401": {
@@ -4481,12 +4025,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4494,45 +4032,30 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update an Openshift host",
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "V2GetClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be updated.",
-            "name": "host_id",
+            "description": "The cluster for which UI settings should be retrieved.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "host-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string"
             }
           },
           "400": {
@@ -4559,18 +4082,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4578,39 +4089,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+      },
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being bound.",
-            "name": "host_id",
+            "description": "The cluster for which UI settings should be updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bind-host-params"
+              "type": "string"
             }
           }
         ],
@@ -4618,7 +4119,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string"
             }
           },
           "400": {
@@ -4645,63 +4146,60 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
       "post": {
-        "description": "install specific host for day2 cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
-            "name": "infra_env_id",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4722,8 +4220,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4733,40 +4231,69 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/clusters/{cluster_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the changes of the cluster, its hosts and its events as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "installer"
+          "watch"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2WatchCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
-            "name": "infra_env_id",
+            "description": "The cluster to be watched.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after this revision. Only new changes are streamed if not set.",
+            "name": "revision",
+            "in": "query"
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4787,12 +4314,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4802,74 +4323,53 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
-        "tags": [
-          "installer"
-        ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
+    "/v2/component-versions": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListComponentVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-versions"
             }
-          },
-          "409": {
-            "description": "Error.",
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -4881,125 +4381,150 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster to return events for.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
             "name": "host_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+            "in": "query"
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
           },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
+            "in": "query"
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "string",
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetHostIgnition",
-        "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
             }
           },
           "401": {
@@ -5031,51 +4556,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Add new assisted installer event.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2TriggerEvent",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/event"
             }
           }
         ],
@@ -5113,6 +4615,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5124,49 +4632,170 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/hardware-inventory": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Queries the hardware of the hosts of the tenant, as reported in their inventories.",
         "tags": [
-          "installer"
+          "hardware_inventory"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2ListHostHardware",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "Only return the hosts of this infra-env.",
             "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Only return the hosts bound to this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
+            "type": "boolean",
+            "description": "Only return the hosts bound to a cluster when true, or not bound to a cluster when false.",
+            "name": "bound",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Only return the hosts in one of these states.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this CPU architecture.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of CPU cores.",
+            "name": "min_cpu_cores",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at most this number of CPU cores.",
+            "name": "max_cpu_cores",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this physical memory, in MiB.",
+            "name": "min_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at most this physical memory, in MiB.",
+            "name": "max_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "nvme",
+                "ssd",
+                "hdd"
+              ],
+              "type": "string"
+            },
+            "description": "Only return the hosts with disks of all these types.",
+            "name": "disk_types",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of disks.",
+            "name": "min_disk_count",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with a disk of at least this size, in GB.",
+            "name": "min_disk_size_gb",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of network interfaces.",
+            "name": "min_nic_count",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with a network interface of at least this speed, in Mbps.",
+            "name": "min_nic_speed_mbps",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system manufacturer, case-sensitive.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system product name, case-sensitive.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the virtual hosts when true, or the physical hosts when false.",
+            "name": "virtual",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of hosts returned.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 0,
+            "description": "The number of matching hosts skipped before the returned ones.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-hardware-list"
             }
           },
           "400": {
@@ -5187,86 +4816,173 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+    "/v2/hardware-inventory/export": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Exports the hardware of all the hosts of the tenant matching the filters.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "hardware_inventory"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2ExportHostHardware",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "Only return the hosts of this infra-env.",
             "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Only return the hosts bound to this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the hosts bound to a cluster when true, or not bound to a cluster when false.",
+            "name": "bound",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Only return the hosts in one of these states.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this CPU architecture.",
+            "name": "cpu_architecture",
+            "in": "query"
           },
           {
             "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
+            "description": "Only return the hosts with at least this number of CPU cores.",
+            "name": "min_cpu_cores",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at most this number of CPU cores.",
+            "name": "max_cpu_cores",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this physical memory, in MiB.",
+            "name": "min_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at most this physical memory, in MiB.",
+            "name": "max_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "nvme",
+                "ssd",
+                "hdd"
+              ],
+              "type": "string"
+            },
+            "description": "Only return the hosts with disks of all these types.",
+            "name": "disk_types",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of disks.",
+            "name": "min_disk_count",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with a disk of at least this size, in GB.",
+            "name": "min_disk_size_gb",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of network interfaces.",
+            "name": "min_nic_count",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Only return the hosts with a network interface of at least this speed, in Mbps.",
+            "name": "min_nic_speed_mbps",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Only return the hosts of this system manufacturer, case-sensitive.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system product name, case-sensitive.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the virtual hosts when true, or the physical hosts when false.",
+            "name": "virtual",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "description": "The format of the exported file.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5281,60 +4997,86 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/host-claims": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler.",
+        "tags": [
+          "host_claims"
+        ],
+        "operationId": "v2ListHostClaims",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-claim-list"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5342,28 +5084,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -5390,14 +5121,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5411,48 +5142,111 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
+    "/v2/infra-envs": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterInfraEnv",
+        "parameters": [
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5495,64 +5289,54 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "GetInfraEnv",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5584,6 +5368,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -5591,20 +5381,18 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env to be deleted.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5638,100 +5426,110 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
-        "parameters": [
+      },
+      "patch": {
+        "description": "Updates an infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateInfraEnv",
+        "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/infra-env-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/release-sources": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves openshift release sources configuration.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListReleaseSources",
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/release-sources"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "/v2/support-levels/architectures": {
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts": {
       "get": {
         "security": [
           {
@@ -5742,17 +5540,18 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+        "description": "Lists the BMC hosts of the infra-env.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "GetSupportedArchitectures",
+        "operationId": "v2ListBmcHosts",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           }
         ],
@@ -5760,19 +5559,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "architectures": {
-                  "description": "Keys will be one of architecture-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bmc-host-list"
             }
           },
           "401": {
@@ -5793,85 +5580,44 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/support-levels/features": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the features support levels for each OpenShift version.",
+      },
+      "post": {
+        "description": "Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "GetSupportedFeatures",
+        "operationId": "v2CreateBmcHost",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
-            "type": "string",
-            "description": "The provider platform type.",
-            "name": "platform_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
-            "name": "external_platform_name",
-            "in": "query"
+            "description": "The address and the credentials of the BMC.",
+            "name": "new-bmc-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-host-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "description": "Keys will be one of features-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
+              "$ref": "#/definitions/bmc-host"
             }
           },
           "400": {
@@ -5898,44 +5644,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
-        ],
-        "operationId": "V2ListSupportedOperators",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5945,7 +5653,7 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}": {
       "get": {
         "security": [
           {
@@ -5956,16 +5664,25 @@ func init() {
             ]
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Retrieves a BMC host.",
         "tags": [
-          "operators"
+          "bmc"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2GetBmcHost",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
             "in": "path",
             "required": true
           }
@@ -5974,7 +5691,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/bmc-host"
             }
           },
           "401": {
@@ -6002,1597 +5719,6839 @@ func init() {
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "api_vip": {
-      "description": "The virtual IP used to reach the OpenShift cluster's API.",
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
-        },
-        "verification": {
-          "description": "API VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
-        }
-      }
-    },
-    "api_vip_connectivity_additional_request_header": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "description": "Value of the header's key when making a request",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value corresponding to the header key",
-          "type": "string"
+      },
+      "delete": {
+        "description": "Deletes a BMC host together with its credentials. The host itself isn't changed.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2DeleteBmcHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "ca_certificate": {
-          "description": "A CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
-          "type": "string",
-          "x-nullable": true
-        },
-        "request_headers": {
-          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+      },
+      "patch": {
+        "description": "Updates the address, the credentials or the host of a BMC host.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2UpdateBmcHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true,
-          "x-omitempty": true
-        },
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "bmc-host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-host-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
-      "type": "object",
-      "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
-          "type": "string"
-        },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
-          "type": "string"
-        },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
-          "type": "boolean"
-        },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
-          "type": "string"
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso": {
+      "post": {
+        "description": "Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2BmcHostBootIso",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ISO and whether to restart the host.",
+            "name": "boot-iso-params",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/bmc-boot-iso-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "architecture-support-level-id": {
-      "type": "string",
-      "enum": [
-        "X86_64_ARCHITECTURE",
-        "ARM64_ARCHITECTURE",
-        "PPC64LE_ARCHITECTURE",
-        "S390X_ARCHITECTURE",
-        "MULTIARCH_RELEASE_IMAGE"
-      ]
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media": {
+      "post": {
+        "description": "Ejects the virtual media of a BMC host.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2BmcHostEjectVirtualMedia",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "bind-host-params": {
-      "required": [
-        "cluster_id"
-      ],
-      "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power": {
+      "post": {
+        "description": "Powers a BMC host on or off, or restarts it.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2BmcHostPowerAction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The power action.",
+            "name": "power-action-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-power-action-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "command_line": {
-          "type": "string"
-        },
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2GetBmcHostStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host-status"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          {
+            "agentAuth": []
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
-        },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Information regarding hosts' installation disks encryption.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
+          {
+            "urlAuth": []
           },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
-        },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host"
+          {
+            "imageAuth": []
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ]
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ignored_cluster_validations": {
-          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "ignored_host_validations": {
-          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "image_info": {
-          "$ref": "#/definitions/image_info"
-        },
-        "imported": {
-          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
-          "type": "boolean",
-          "default": false
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          {
+            "imageURLAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DownloadInfraEnvFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose file should be downloaded.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
-        },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "ip_collisions": {
-          "description": "Json formatted string containing ip collisions detected in the cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
-          "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
-        },
-        "last-installation-preparation": {
-          "$ref": "#/definitions/last-installation-preparation"
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/monitored-operator"
+          {
+            "type": "string",
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "org_id": {
-          "type": "string"
-        },
-        "org_soft_timeouts_enabled": {
-          "description": "Indication if organization soft timeouts is enabled for the cluster.",
-          "type": "boolean"
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "progress": {
-          "description": "Installation progress percentages of the cluster.",
-          "$ref": "#/definitions/cluster-progress-info"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "schedulable_masters_forced_true": {
-          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
-          "type": "boolean",
-          "default": true
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
-          ]
-        },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string"
-        },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
+            "name": "discovery_iso_type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "user_name": {
-          "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
         }
       }
     },
-    "cluster-create-params": {
-      "type": "object",
-      "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
-          }
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetInfraEnvPresignedFileURL",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The file's infra-env.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
-          }
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "platform": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-finalizing-progress": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+      "get": {
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetInfraEnvDownloadURL",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
-        },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
-        },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
-        },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
-        },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
-        },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
-          "type": "boolean"
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          }
+        ],
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadMinimalInitrd",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "204": {
+            "description": "Empty Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-progress-info": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
-        },
-        "finalizing_stage_percentage": {
-          "type": "integer"
-        },
-        "finalizing_stage_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
-        },
-        "installing_stage_percentage": {
-          "type": "integer"
-        },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
-        },
-        "total_percentage": {
-          "type": "integer"
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "networks-same-address-families",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vips-defined",
-        "api-vips-valid",
-        "ingress-vips-defined",
-        "ingress-vips-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "cnv-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "network-type-valid",
-        "platform-requirements-satisfied"
-      ]
-    },
-    "cluster_default_config": {
-      "type": "object",
-      "properties": {
-        "cluster_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
-        },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
-        },
-        "cluster_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+    "/v2/infra-envs/{infra_env_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
           }
-        },
-        "cluster_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+        ],
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the hosts are asociated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "forbidden_hostnames": {
-          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "inactive_deletion_hours": {
-          "type": "integer"
-        },
-        "ntp_source": {
-          "type": "string",
-          "x-omitempty": false
-        },
-        "service_network_cidr": {
-          "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
-        },
-        "service_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
           }
-        },
-        "service_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+        ],
+        "description": "Registers a new OpenShift agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the agent is associated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "cluster_network": {
-      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        }
-      }
-    },
-    "completion-params": {
-      "type": "object",
-      "required": [
-        "is_success"
-      ],
-      "properties": {
-        "data": {
-          "description": "additional data from the cluster",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
-          }
-        },
-        "error_info": {
-          "type": "string"
-        },
-        "is_success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "connectivity-check-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
-        }
-      }
-    },
-    "connectivity-check-nic": {
-      "type": "object",
-      "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHost",
+        "parameters": [
+          {
             "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+            "format": "uuid",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "mac": {
-          "type": "string",
-          "format": "mac"
-        },
-        "name": {
-          "type": "string"
         }
-      }
-    },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be deregistered.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
+      },
+      "patch": {
+        "description": "Update an Openshift host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "container_image_availability": {
-      "type": "object",
-      "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
-        },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
-        },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
-        },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
-        }
-      }
-    },
-    "container_image_availability_request": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
-          "type": "array",
-          "items": {
-            "description": "A fully qualified image name (FQIN).",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "BindHost",
+        "parameters": [
+          {
             "type": "string",
-            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
         }
       }
     },
-    "container_image_availability_response": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being installed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
-      "type": "object",
-      "properties": {
-        "architecture": {
-          "type": "string"
-        },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
-          "type": "string"
-        }
-      }
-    },
-    "create-manifest-params": {
-      "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
-      "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
-        },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "credentials": {
-      "type": "object",
-      "properties": {
-        "console_url": {
-          "type": "string"
-        },
-        "password": {
-          "type": "string"
-        },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_request": {
-      "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
-      "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
-          "type": "string"
-        },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
-          "type": "string",
-          "format": "mac"
-        },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
-          "type": "string"
-        },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
-          "type": "string",
-          "format": "mac"
-        },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
-          "type": "string"
         }
       }
     },
-    "dhcp_allocation_response": {
-      "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
-      "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
-          "type": "string"
-        },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that its validation is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "disk": {
-      "type": "object",
-      "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
-          "$ref": "#/definitions/drive_type"
-        },
-        "has_uuid": {
-          "type": "boolean"
-        },
-        "hctl": {
-          "type": "string"
-        },
-        "holders": {
-          "description": "A comma-separated list of disk names that this disk belongs to",
-          "type": "string"
-        },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
-        },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UnbindHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
             }
           },
-          "x-nullable": false
-        },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
-        },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "path": {
-          "type": "string"
-        },
-        "removable": {
-          "type": "boolean"
-        },
-        "serial": {
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "smart": {
-          "type": "string"
-        },
-        "vendor": {
-          "type": "string"
-        },
-        "wwn": {
-          "type": "string"
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "disk-config-params": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "type": "string"
-        },
-        "role": {
-          "$ref": "#/definitions/disk-role"
-        }
-      }
-    },
-    "disk-encryption": {
-      "type": "object",
-      "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "default": "none",
-          "enum": [
-            "none",
-            "all",
-            "masters",
-            "workers"
-          ]
-        },
-        "mode": {
-          "description": "The disk encryption mode to use.",
-          "type": "string",
-          "default": "tpmv2",
-          "enum": [
-            "tpmv2",
-            "tang"
-          ]
-        },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be obtained.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
-    },
-    "disk-role": {
-      "type": "string",
-      "enum": [
-        "none",
-        "install"
-      ]
-    },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
-      "type": "object",
-      "required": [
-        "disk_id",
-        "skip_formatting"
-      ],
-      "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
-          "type": "string"
-        },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
-        }
-      }
-    },
-    "disk_info": {
-      "type": "object",
-      "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "path": {
-          "type": "string"
-        }
-      }
-    },
-    "disk_speed": {
-      "type": "object",
-      "properties": {
-        "exit_code": {
-          "type": "integer"
-        },
-        "speed_ms": {
-          "type": "integer"
-        },
-        "tested": {
-          "type": "boolean"
-        }
-      }
-    },
-    "disk_speed_check_request": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
-          "type": "string"
-        }
-      }
-    },
-    "disk_speed_check_response": {
-      "type": "object",
-      "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
-        },
-        "path": {
-          "description": "The device path.",
-          "type": "string"
-        }
-      }
-    },
-    "domain_resolution_request": {
-      "type": "object",
-      "required": [
-        "domains"
-      ],
-      "properties": {
-        "domains": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "x-go-type": {
-              "import": {
-                "path": "github.com/openshift/assisted-service/models"
-              },
-              "type": "DomainResolutionRequestDomain"
+      "patch": {
+        "description": "Patch the ignition file for this host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
-        }
-      }
-    },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
-      "type": "object",
-      "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
-          "type": "string"
-        },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
-          "type": "string"
-        },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
-        },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
-          "type": "string"
-        }
-      }
-    },
-    "drive_type": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostIgnitionPreview",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition is previewed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition is previewed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-preview"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallerArgs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetNextSteps",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is retrieving instructions.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2PostStepReply",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is posting results.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose log progress is being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegenerateInfraEnvSigningKey",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the changes of the infra-env, its hosts and its events as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "watch"
+        ],
+        "operationId": "v2WatchInfraEnv",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be watched.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after this revision. Only new changes are streamed if not set.",
+            "name": "revision",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/release-sources"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/architectures": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedArchitectures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "architectures": {
+                  "description": "Keys will be one of architecture-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the features support levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "description": "Keys will be one of features-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/webhook-subscriptions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhook subscriptions of the tenant.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2ListWebhookSubscriptions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-subscription-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2RegisterWebhookSubscription",
+        "parameters": [
+          {
+            "description": "The properties describing the new webhook subscription.",
+            "name": "new-webhook-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-subscription-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/webhook-subscriptions/{subscription_id}": {
+      "delete": {
+        "description": "Deletes a webhook subscription together with its pending deliveries.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2DeregisterWebhookSubscription",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook subscription to be deregistered.",
+            "name": "subscription_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "api_vip": {
+      "description": "The virtual IP used to reach the OpenShift cluster's API.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "API VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "api_vip_connectivity_additional_request_header": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "description": "Value of the header's key when making a request",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value corresponding to the header key",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "ca_certificate": {
+          "description": "A CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
+          "type": "string",
+          "x-nullable": true
+        },
+        "request_headers": {
+          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+          },
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "architecture-support-level-id": {
+      "type": "string",
+      "enum": [
+        "X86_64_ARCHITECTURE",
+        "ARM64_ARCHITECTURE",
+        "PPC64LE_ARCHITECTURE",
+        "S390X_ARCHITECTURE",
+        "MULTIARCH_RELEASE_IMAGE"
+      ]
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bmc-boot-iso-params": {
+      "type": "object",
+      "properties": {
+        "image_url": {
+          "description": "The URL of the ISO, the discovery ISO of the infra-env by default.",
+          "type": "string"
+        },
+        "reboot": {
+          "description": "Power the host on, or restart it when it is on, to boot the ISO.",
+          "type": "boolean",
+          "default": true
+        }
+      }
+    },
+    "bmc-host": {
+      "type": "object",
+      "required": [
+        "id",
+        "infra_env_id",
+        "address",
+        "username"
+      ],
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean"
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "last_action": {
+          "description": "The last action performed on the host through its BMC.",
+          "type": "string"
+        },
+        "last_action_at": {
+          "description": "The time the last action was performed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "last_action_error": {
+          "description": "The error returned by the BMC for the last action, empty when it succeeded.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-host-create-params": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC, e.g. redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/1. The scheme is redfish, redfish-virtualmedia or idrac-virtualmedia, followed by +http for a BMC without TLS. The system is discovered when the address has no path.",
+          "type": "string"
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean",
+          "default": false
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC, once it is discovered.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string"
+        },
+        "password": {
+          "description": "The password of the user. It is never returned by the service.",
+          "type": "string",
+          "format": "password"
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bmc-host"
+      }
+    },
+    "bmc-host-status": {
+      "type": "object",
+      "required": [
+        "power_state"
+      ],
+      "properties": {
+        "boot_source_override_enabled": {
+          "description": "Whether the boot source override applies to the next boot only (Once), to every boot (Continuous) or isn't applied (Disabled).",
+          "type": "string"
+        },
+        "boot_source_override_target": {
+          "description": "The Redfish boot source override of the system, e.g. Cd or None.",
+          "type": "string"
+        },
+        "power_state": {
+          "description": "The Redfish power state of the system, e.g. On or Off.",
+          "type": "string"
+        },
+        "virtual_media_image": {
+          "description": "The image of the CD virtual media.",
+          "type": "string"
+        },
+        "virtual_media_inserted": {
+          "description": "Whether the image of the CD virtual media is inserted.",
+          "type": "boolean"
+        }
+      }
+    },
+    "bmc-host-update-params": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC. The empty UUID unlinks the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "password": {
+          "description": "The password of the user. It is never returned by the service.",
+          "type": "string",
+          "format": "password",
+          "x-nullable": true
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "bmc-power-action-params": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "The power action. off shuts the host down gracefully, force-off cuts its power.",
+          "type": "string",
+          "enum": [
+            "on",
+            "off",
+            "force-off",
+            "restart",
+            "force-restart"
+          ]
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "command_line": {
+          "type": "string"
+        },
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "custom_host_validations": {
+          "description": "Json containing the validations defined by the user that the hosts of the cluster must pass.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Information regarding hosts' installation disks encryption.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "host_role_rules": {
+          "description": "Json containing the rules assigning roles, installation disks and node labels to the hosts of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "host_stage_timeout_policies": {
+          "description": "Json containing the timeouts of the installation stages of the hosts and the remediations of the hosts stuck in a stage.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignition_override_layers": {
+          "description": "Json containing the ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ignored_cluster_validations": {
+          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ignored_host_validations": {
+          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "image_info": {
+          "$ref": "#/definitions/image_info"
+        },
+        "imported": {
+          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
+          "type": "boolean",
+          "default": false
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "ip_collisions": {
+          "description": "Json formatted string containing ip collisions detected in the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "last-installation-preparation": {
+          "$ref": "#/definitions/last-installation-preparation"
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "org_soft_timeouts_enabled": {
+          "description": "Indication if organization soft timeouts is enabled for the cluster.",
+          "type": "boolean"
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "progress": {
+          "description": "Installation progress percentages of the cluster.",
+          "$ref": "#/definitions/cluster-progress-info"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "schedulable_masters_forced_true": {
+          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
+          "type": "boolean",
+          "default": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "triage": {
+          "description": "The number of known failures found in the logs uploaded for the cluster, set by v2GetCluster only.",
+          "$ref": "#/definitions/triage-summary"
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the cluster must pass.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          }
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_role_rules": {
+          "description": "Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          }
+        },
+        "host_stage_timeout_policies": {
+          "description": "Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage-timeout-policy"
+          }
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignition_override_layers": {
+          "description": "Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-layer"
+          }
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-finalizing-progress": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        },
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "spec"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "spec"
+      ],
+      "properties": {
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string",
+          "maxLength": 128,
+          "minLength": 1
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
+    },
+    "cluster-template-instance": {
+      "type": "object",
+      "required": [
+        "cluster",
+        "infra_env"
+      ],
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "infra_env": {
+          "$ref": "#/definitions/infra-env"
+        }
+      }
+    },
+    "cluster-template-instantiate-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "api_vips": {
+          "description": "The virtual IPs used to reach the API of the new cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the new cluster, the one of the template is used when empty.",
+          "type": "string"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for the ingress traffic of the new cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "name": {
+          "description": "Name of the new OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-template-spec": {
+      "description": "The definition of the clusters created from a template.",
+      "type": "object",
+      "required": [
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Default base domain of the clusters, it can be overridden for each cluster.",
+          "type": "string"
+        },
+        "cluster_networks": {
+          "description": "Cluster networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the clusters (x86_64/arm64/etc).",
+          "type": "string",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ]
+        },
+        "custom_manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed clusters.",
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
+        "host_role_rules": {
+          "description": "Rules assigning roles to the hosts of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          }
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "machine_networks": {
+          "description": "Machine networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift clusters.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+    },
+    "cluster-template-update-params": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "spec": {
+          "x-nullable": true,
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "networks-same-address-families",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vips-defined",
+        "api-vips-valid",
+        "ingress-vips-defined",
+        "ingress-vips-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "network-type-valid",
+        "platform-requirements-satisfied",
+        "sufficient-path-mtu",
+        "operator-plugins-requirements-satisfied"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "cluster_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "cluster_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "forbidden_hostnames": {
+          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "inactive_deletion_hours": {
+          "type": "integer"
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "service_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "service_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "data": {
+          "description": "additional data from the cluster",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "mac": {
+          "type": "string",
+          "format": "mac"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string",
+            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "custom-host-validation": {
+      "description": "A validation of the hosts defined by the user, evaluated over the inventory of the hosts. A failing custom validation prevents the installation like the built-in validations do.",
+      "type": "object",
+      "required": [
+        "id",
+        "language",
+        "expression"
+      ],
+      "properties": {
+        "description": {
+          "description": "A description of the requirement, reported when the validation fails.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "The expression evaluated over the inventory of the hosts, e.g. 'inventory.cpu.count \u003e= 16' or '{.interfaces[?(@.vendor==\"0x8086\")].name}'.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the validation, reported in the validations info of the hosts in the custom category. It must start with custom-, so that it can't be taken for a built-in validation.",
+          "type": "string",
+          "maxLength": 63,
+          "pattern": "^custom-[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "language": {
+          "description": "The language of the expression. A CEL expression evaluates to a boolean over the 'inventory' and 'role' variables. A JSONPath expression selects values of the inventory and succeeds when it selects at least one value and none of the selected values is false.",
+          "type": "string",
+          "enum": [
+            "cel",
+            "jsonpath"
+          ]
+        }
+      }
+    },
+    "decommission-params": {
+      "type": "object",
+      "properties": {
+        "wipe_disks": {
+          "description": "Wipe the disks of the host once its node is removed. The disks are wiped by the agent of the host, which must be running, e.g. by booting the host with the discovery image.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
+        },
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
+        },
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
+        },
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "has_uuid": {
+          "type": "boolean"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "holders": {
+          "description": "A comma-separated list of disk names that this disk belongs to",
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "removable": {
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "all",
+            "masters",
+            "workers"
+          ]
+        },
+        "mode": {
+          "description": "The disk encryption mode to use.",
+          "type": "string",
+          "default": "tpmv2",
+          "enum": [
+            "tpmv2",
+            "tang"
+          ]
+        },
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
+      "required": [
+        "disk_id",
+        "skip_formatting"
+      ],
+      "properties": {
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+          "type": "string"
+        },
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "speed_ms": {
+          "type": "integer"
+        },
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "x-go-type": {
+              "import": {
+                "path": "github.com/openshift/assisted-service/models"
+              },
+              "type": "DomainResolutionRequestDomain"
+            }
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
+        },
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
+        },
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
+        },
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM",
+        "RAID",
+        "ECKD",
+        "ECKD (ESE)",
+        "FBA"
+      ]
+    },
+    "dry-run-generate-failure": {
+      "type": "object",
+      "required": [
+        "stage",
+        "message"
+      ],
+      "properties": {
+        "file_name": {
+          "description": "The manifest which failed validation, for the custom-manifests stage.",
+          "type": "string"
+        },
+        "message": {
+          "description": "The reason of the failure.",
+          "type": "string"
+        },
+        "stage": {
+          "$ref": "#/definitions/dry-run-generate-stage"
+        }
+      }
+    },
+    "dry-run-generate-report": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "storage_prefix",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "completed_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dry-run-generate-failure"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "objects": {
+          "description": "The generated files, relative to the storage prefix.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "description": "The status of the dry run. An interrupted dry run didn't complete, for example because the service restarted, and can be started again.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "interrupted"
+          ]
+        },
+        "status_info": {
+          "description": "The reason why the dry run was interrupted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "storage_prefix": {
+          "description": "The storage prefix under which the generated files are stored.",
+          "type": "string"
+        },
+        "succeeded": {
+          "description": "Whether every stage of the generation succeeded, once the dry run is completed.",
+          "type": "boolean"
+        },
+        "synthetic_hosts_count": {
+          "description": "The number of synthetic hosts which completed the hosts of the cluster.",
+          "type": "integer"
+        }
+      }
+    },
+    "dry-run-generate-stage": {
+      "description": "A step of the installation files generation.",
+      "type": "string",
+      "enum": [
+        "custom-manifests",
+        "additional-manifests",
+        "install-config",
+        "ignition"
+      ]
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "name": {
+          "description": "Event Name.",
+          "type": "string"
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "feature-support-level-id": {
+      "type": "string",
+      "enum": [
+        "SNO",
+        "VIP_AUTO_ALLOC",
+        "CUSTOM_MANIFEST",
+        "SINGLE_NODE_EXPANSION",
+        "LVM",
+        "ODF",
+        "LSO",
+        "CNV",
+        "MCE",
+        "NUTANIX_INTEGRATION",
+        "BAREMETAL_PLATFORM",
+        "NONE_PLATFORM",
+        "VSPHERE_INTEGRATION",
+        "DUAL_STACK_VIPS",
+        "CLUSTER_MANAGED_NETWORKING",
+        "USER_MANAGED_NETWORKING",
+        "MINIMAL_ISO",
+        "FULL_ISO",
+        "EXTERNAL_PLATFORM_OCI",
+        "DUAL_STACK",
+        "PLATFORM_MANAGED_NETWORKING",
+        "SKIP_MCO_REBOOT",
+        "EXTERNAL_PLATFORM",
+        "OVN_NETWORK_TYPE",
+        "SDN_NETWORK_TYPE"
+      ]
+    },
+    "finalizing-stage": {
+      "description": "Cluster finalizing stage managed by controller",
+      "type": "string",
+      "enum": [
+        "Waiting for cluster operators",
+        "Adding router ca",
+        "Applying olm manifests",
+        "Waiting for olm operators csv initialization",
+        "Waiting for olm operators csv",
+        "Done"
+      ]
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
+        },
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
+        },
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
+        },
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
+        }
+      }
+    },
+    "host": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
+          "x-nullable": true
+        },
+        "connection_timed_out": {
+          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
+          "type": "boolean"
+        },
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "decommission_info": {
+          "description": "Additional information about the decommission stage of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "decommission_stage": {
+          "description": "The stage of the decommission of the host, or empty when the host isn't decommissioned.",
+          "type": "string",
+          "enum": [
+            "draining",
+            "deleting-node",
+            "wiping-disks",
+            "completed",
+            "failed"
+          ]
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+          "type": "boolean"
+        },
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        },
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
+        },
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
+        },
+        "installer_args": {
+          "type": "string"
+        },
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
+        },
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+          "type": "string",
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
+        },
+        "logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "machine_config_pool_name": {
+          "type": "string"
+        },
+        "media_status": {
+          "type": "string",
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
+          "x-nullable": true
+        },
+        "mtu_path_report": {
+          "description": "Contains a serialized mtu_path_check_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "node_labels": {
+          "description": "Json containing node's labels.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "requested_hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
+          ]
+        },
+        "status_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "tang_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
+          "type": "integer"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-claim": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "spec",
+        "status"
+      ],
+      "properties": {
+        "bound_masters": {
+          "description": "The number of masters of the cluster.",
+          "type": "integer"
+        },
+        "bound_workers": {
+          "description": "The number of workers of the cluster.",
+          "type": "integer"
+        },
+        "cluster_id": {
+          "description": "The cluster the hosts are bound to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "scheduled_at": {
+          "description": "The last time the scheduler served the claim.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "spec": {
+          "$ref": "#/definitions/host-claim-spec"
+        },
+        "status": {
+          "description": "pending while the cluster misses hosts, satisfied when it has all the requested hosts.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "satisfied",
+            "paused"
+          ]
+        },
+        "status_info": {
+          "description": "The reason the claim is pending.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "host-claim-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-claim"
+      }
+    },
+    "host-claim-role": {
+      "description": "The number of hosts of a role requested by a host claim and the hardware they must have.",
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of hosts of the role the cluster needs. The hosts of the cluster which already have the role are counted.",
+          "type": "integer"
+        },
+        "selector": {
+          "$ref": "#/definitions/host-selector"
+        }
+      }
+    },
+    "host-claim-spec": {
+      "description": "The hosts a cluster needs and the infra-envs they are taken from.",
+      "type": "object",
+      "required": [
+        "infra_env_ids"
+      ],
+      "properties": {
+        "infra_env_ids": {
+          "description": "The infra-envs whose unbound hosts can be bound to the cluster.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "masters": {
+          "$ref": "#/definitions/host-claim-role"
+        },
+        "paused": {
+          "description": "No host is bound by the claim while it is paused. The reserved hosts stay reserved.",
+          "type": "boolean",
+          "default": false
+        },
+        "priority": {
+          "description": "The claims with a higher priority are served first. The claims with the same priority are served in the order they were created.",
+          "type": "integer",
+          "default": 0
+        },
+        "reserved_host_ids": {
+          "description": "Hosts reserved for the cluster. They are only bound by this claim, and are bound before the other hosts matching the selectors.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "workers": {
+          "$ref": "#/definitions/host-claim-role"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-hardware": {
+      "description": "The hardware of a host, extracted from its inventory.",
+      "type": "object",
+      "required": [
+        "host_id",
+        "infra_env_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the host is bound to.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "type": "string"
+        },
+        "cpu_cores": {
+          "type": "integer"
+        },
+        "cpu_model": {
+          "type": "string"
+        },
+        "disk_count": {
+          "type": "integer"
+        },
+        "disk_types": {
+          "description": "The types of the disks of the host.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "nvme",
+              "ssd",
+              "hdd"
+            ]
+          }
+        },
+        "disks_total_bytes": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "infra_env_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "largest_disk_bytes": {
+          "type": "integer"
+        },
+        "max_nic_speed_mbps": {
+          "type": "integer"
+        },
+        "memory_bytes": {
+          "description": "The physical memory of the host.",
+          "type": "integer"
+        },
+        "nic_count": {
+          "type": "integer"
+        },
+        "product": {
+          "description": "The product name of the system.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "status": {
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The manufacturer of the system.",
+          "type": "string"
+        },
+        "virtual": {
+          "type": "boolean"
+        }
+      }
+    },
+    "host-hardware-list": {
+      "type": "object",
+      "required": [
+        "total",
+        "hosts"
+      ],
+      "properties": {
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-hardware"
+          }
+        },
+        "total": {
+          "description": "The number of hosts matching the filters.",
+          "type": "integer"
+        }
+      }
+    },
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "host-ignition-preview": {
+      "type": "object",
+      "required": [
+        "ignition",
+        "ignition_type",
+        "base"
+      ],
+      "properties": {
+        "base": {
+          "description": "The ignition the layers are applied to. The ignition generated for the role of the host, the ignition template of the hosts added to an installed cluster, or none when the ignition of the cluster wasn't generated yet.",
+          "type": "string",
+          "enum": [
+            "generated",
+            "template",
+            "none"
+          ]
+        },
+        "files": {
+          "description": "The files of the ignition and the layer which set them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-provenance"
+          }
+        },
+        "ignition": {
+          "description": "The rendered ignition of the host, in JSON.",
+          "type": "string"
+        },
+        "ignition_type": {
+          "description": "The pointer ignition of a host installed with its cluster, merging the configuration served by the machine config server, or the full ignition of a host added to an installed cluster.",
+          "type": "string",
+          "enum": [
+            "pointer",
+            "full"
+          ]
+        },
+        "layers": {
+          "description": "The layers applied in order, e.g. cluster, role/worker, machine-pool/infra and host.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "units": {
+          "description": "The systemd units of the ignition and the layer which set them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-provenance"
+          }
+        }
+      }
+    },
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
+      "type": "object",
+      "properties": {
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "properties": {
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "installation_percentage": {
+          "type": "integer"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "stage_remediation": {
+          "$ref": "#/definitions/host-stage-remediation"
+        },
+        "stage_remediation_at": {
+          "description": "Time at which the remediation of the timeout policy of the current stage was last applied.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_remediation_attempts": {
+          "description": "The number of times the remediation of the timeout policy of the current stage was applied.",
+          "type": "integer"
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_timed_out": {
+          "description": "Indicate of the current stage has been timed out.",
+          "type": "boolean"
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-rule": {
+      "description": "Assigns a role, an installation disk and node labels to the hosts matching all the criteria of the rule. The rules only apply to hosts whose role is auto-assign.",
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "bmc_address": {
+          "description": "The BMC address of the matching hosts.",
+          "type": "string"
+        },
+        "hostname_pattern": {
+          "description": "A regular expression the hostname of the matching hosts must match.",
+          "type": "string"
+        },
+        "installation_disk_pattern": {
+          "description": "A regular expression matched against the path, the by-id and the by-path names of the disks of the matching hosts. The first eligible disk that matches becomes the installation disk.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The matching hosts must have an interface with one of these MAC addresses.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
+          }
+        },
+        "min_cpu_cores": {
+          "description": "The minimal number of CPU cores of the matching hosts.",
+          "type": "integer"
+        },
+        "min_disk_size_gb": {
+          "description": "The matching hosts must have a disk eligible for installation of at least this size, in GB.",
+          "type": "integer"
+        },
+        "min_memory_mib": {
+          "description": "The minimal physical memory of the matching hosts, in MiB.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "A name identifying the rule.",
+          "type": "string"
+        },
+        "nic_vendor": {
+          "description": "The matching hosts must have an interface of this vendor.",
+          "type": "string"
+        },
+        "node_labels": {
+          "description": "Labels added to the nodes of the matching hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          }
+        },
+        "role": {
+          "description": "The role assigned to the matching hosts.",
+          "type": "string",
+          "enum": [
+            "master",
+            "worker"
+          ]
+        },
+        "serial_number": {
+          "description": "The serial number of the matching hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-selector": {
+      "description": "Selects the hosts by their hardware. A host matches the selector when it meets all of its criteria. A selector without criteria matches every host.",
+      "type": "object",
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts.",
+          "type": "string"
+        },
+        "disk_types": {
+          "description": "The hosts have disks of all these types.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "nvme",
+              "ssd",
+              "hdd"
+            ]
+          }
+        },
+        "max_cpu_cores": {
+          "description": "The maximal number of CPU cores.",
+          "type": "integer"
+        },
+        "max_memory_mib": {
+          "description": "The maximal physical memory in MiB.",
+          "type": "integer"
+        },
+        "min_cpu_cores": {
+          "description": "The minimal number of CPU cores.",
+          "type": "integer"
+        },
+        "min_disk_count": {
+          "description": "The minimal number of disks.",
+          "type": "integer"
+        },
+        "min_disk_size_gb": {
+          "description": "The minimal size in GB of the largest disk.",
+          "type": "integer"
+        },
+        "min_memory_mib": {
+          "description": "The minimal physical memory in MiB.",
+          "type": "integer"
+        },
+        "min_nic_count": {
+          "description": "The minimal number of physical network interfaces.",
+          "type": "integer"
+        },
+        "min_nic_speed_mbps": {
+          "description": "The minimal speed in Mbps of the fastest physical network interface.",
+          "type": "integer"
+        },
+        "product": {
+          "description": "The product name of the hosts.",
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The manufacturer of the hosts.",
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the hosts are virtual machines.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-stage-remediation": {
+      "description": "The remediation of a host stuck in an installation stage. fail moves the host to error. retry restarts the timeout of the stage so that the host can retry the step. reboot reboots the host via its BMC, only for the hosts managed by a BareMetalHost, and fails the other hosts. pause moves the host to installing-pending-user-action until the host reports progress again.",
+      "type": "string",
+      "enum": [
+        "fail",
+        "retry",
+        "reboot",
+        "pause"
+      ]
+    },
+    "host-stage-timeout-policy": {
+      "description": "The timeout of an installation stage of the hosts and the remediation applied to the hosts that stay in the stage longer than the timeout.",
+      "type": "object",
+      "required": [
+        "stage",
+        "remediation"
+      ],
+      "properties": {
+        "max_attempts": {
+          "description": "The number of times the retry and reboot remediations are applied to a host in the stage. The host fails when the stage times out again after the last attempt.",
+          "type": "integer",
+          "default": 1,
+          "minimum": 1
+        },
+        "remediation": {
+          "$ref": "#/definitions/host-stage-remediation"
+        },
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "timeout_seconds": {
+          "description": "The timeout of the stage, in seconds. The timeout configured in the service for the stage applies when it is not set.",
+          "type": "integer",
+          "minimum": 60
+        }
+      }
+    },
+    "host-type-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "host-type-hardware-requirements-wrapper": {
+      "type": "object",
+      "properties": {
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        },
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        }
+      }
+    },
+    "host-update-params": {
+      "type": "object",
+      "properties": {
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
+          },
+          "x-nullable": true
+        },
+        "disks_skip_formatting": {
+          "description": "Allows changing the host's skip_formatting_disks parameter",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-skip-formatting-params"
+          },
+          "x-nullable": true
+        },
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "worker"
+          ],
+          "x-nullable": true
+        },
+        "ignition_endpoint_http_headers": {
+          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
+          },
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "machine_config_pool_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "node_labels": {
+          "description": "Labels to be added to the corresponding node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          },
+          "x-nullable": true
+        }
+      }
+    },
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "media-connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "ignition-downloadable",
+        "belongs-to-majority-group",
+        "valid-platform-network-settings",
+        "ntp-synced",
+        "time-synced-between-host-and-service",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "has-default-route",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly",
+        "release-domain-name-resolved-correctly",
+        "compatible-with-cluster-platform",
+        "dns-wildcard-not-configured",
+        "disk-encryption-requirements-satisfied",
+        "non-overlapping-subnets",
+        "vsphere-disk-uuid-enabled",
+        "compatible-agent",
+        "no-skip-installation-disk",
+        "no-skip-missing-disk",
+        "no-ip-collisions-in-network",
+        "sufficient-path-mtu",
+        "operator-plugins-requirements-satisfied"
+      ]
+    },
+    "host_network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "type": "string"
+        },
+        "host_ids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
+        },
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "host_static_network_config": {
+      "type": "object",
+      "properties": {
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
+        },
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-endpoint": {
+      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+      "type": "object",
+      "properties": {
+        "ca_certificate": {
+          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "description": "The URL for the ignition endpoint.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+    },
+    "ignition-endpoint-http-headers-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the http header's key-value pair.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value for the http header's key-value pair.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-override-layer": {
+      "type": "object",
+      "required": [
+        "scope",
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "The ignition config merged into the ignition of the hosts, in JSON.",
+          "type": "string"
+        },
+        "scope": {
+          "description": "The hosts whose ignition the layer applies to. The layers are applied in the order cluster, role, machine-pool, followed by the ignition config overrides of the host.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "role",
+            "machine-pool"
+          ]
+        },
+        "target": {
+          "description": "The role of the hosts (master or worker) for a role layer, the machine config pool of the hosts for a machine-pool layer. Unset for a cluster layer.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-provenance": {
+      "type": "object",
+      "required": [
+        "name",
+        "layer"
+      ],
+      "properties": {
+        "layer": {
+          "description": "The layer which set the file or the unit last, base for the ignition the layers are applied to.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The path of the file or the name of the systemd unit.",
+          "type": "string"
+        }
+      }
+    },
+    "ignored-validations": {
+      "type": "object",
+      "properties": {
+        "cluster-validation-ids": {
+          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
+        },
+        "host-validation-ids": {
+          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
+        }
+      }
+    },
+    "image-create-params": {
+      "type": "object",
+      "properties": {
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "image_info": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "download_url": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
+    },
+    "image_type": {
       "type": "string",
       "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM",
-        "RAID",
-        "ECKD",
-        "ECKD (ESE)",
-        "FBA"
+        "full-iso",
+        "minimal-iso"
       ]
     },
-    "error": {
+    "import-cluster-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "api_vip_dnsname",
+        "openshift_cluster_id"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string"
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "The id of the OCP cluster, that hosts will be added to",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "infra-env": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "code",
-        "reason"
+        "name",
+        "type",
+        "updated_at",
+        "created_at"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
+          "type": "string",
+          "x-nullable": false
+        },
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "custom_host_validations": {
+          "description": "Json containing the validations defined by the user that the hosts of the infra-env must pass.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "download_url": {
+          "type": "string"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
         "href": {
@@ -7600,2079 +12559,2854 @@ func init() {
           "type": "string"
         },
         "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_override": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "kernel_arguments": {
+          "description": "JSON formatted string array representing the discovery image kernel arguments.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
+        },
+        "kind": {
+          "description": "Indicates the type of this object.",
+          "type": "string",
+          "enum": [
+            "InfraEnv"
+          ]
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "updated_at": {
+          "description": "The last time that this infra-env was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string"
+        }
+      }
+    },
+    "infra-env-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
+          "type": "string",
+          "maxLength": 65535,
+          "x-nullable": false
+        },
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
+        },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the infra-env must pass.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          }
+        },
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "enum": [
-            "Error"
-          ]
+          "x-nullable": true
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "event": {
+    "infra-env-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/infra-env"
+      }
+    },
+    "infra-env-update-params": {
       "type": "object",
-      "required": [
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "additional_trust_bundle": {
+          "description": "Allows users to change the additional_trust_bundle infra-env field",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
+          "maxLength": 65535,
           "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the infra-env must pass. An empty list deletes the validations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          },
           "x-nullable": true
         },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
-        },
-        "name": {
-          "description": "Event Name.",
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "image_type": {
+          "$ref": "#/definitions/image_type"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
         },
-        "severity": {
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
+        },
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
       }
     },
-    "feature-support-level-id": {
-      "type": "string",
-      "enum": [
-        "SNO",
-        "VIP_AUTO_ALLOC",
-        "CUSTOM_MANIFEST",
-        "SINGLE_NODE_EXPANSION",
-        "LVM",
-        "ODF",
-        "LSO",
-        "CNV",
-        "MCE",
-        "NUTANIX_INTEGRATION",
-        "BAREMETAL_PLATFORM",
-        "NONE_PLATFORM",
-        "VSPHERE_INTEGRATION",
-        "DUAL_STACK_VIPS",
-        "CLUSTER_MANAGED_NETWORKING",
-        "USER_MANAGED_NETWORKING",
-        "MINIMAL_ISO",
-        "FULL_ISO",
-        "EXTERNAL_PLATFORM_OCI",
-        "DUAL_STACK",
-        "PLATFORM_MANAGED_NETWORKING",
-        "SKIP_MCO_REBOOT",
-        "EXTERNAL_PLATFORM",
-        "OVN_NETWORK_TYPE",
-        "SDN_NETWORK_TYPE"
-      ]
+    "ingress-cert-params": {
+      "type": "string"
     },
-    "finalizing-stage": {
-      "description": "Cluster finalizing stage managed by controller",
-      "type": "string",
-      "enum": [
-        "Waiting for cluster operators",
-        "Adding router ca",
-        "Applying olm manifests",
-        "Waiting for olm operators csv initialization",
-        "Waiting for olm operators csv",
-        "Done"
-      ]
+    "ingress_vip": {
+      "description": "The virtual IP used for cluster ingress traffic.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "Ingress VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
     },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
+    "install-config-preview": {
+      "type": "object",
+      "required": [
+        "install_config",
+        "diff"
+      ],
+      "properties": {
+        "changed_fields": {
+          "description": "The paths of the fields changed by the overrides, e.g. networking.clusterNetwork[0].hostPrefix.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "diff": {
+          "description": "The unified diff of the install config generated from the cluster without overrides to the install config with the overrides. Empty when the overrides don't change the install config.",
+          "type": "string"
+        },
+        "install_config": {
+          "description": "The install config of the cluster with the overrides applied, in YAML.",
+          "type": "string"
+        },
+        "service_owned_fields": {
+          "description": "The paths of the overridden fields which are set by the service from the cluster. Applying the overrides requires allow_service_owned_fields.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+    "install-config-preview-params": {
+      "type": "object",
+      "properties": {
+        "install_config_params": {
+          "description": "The install config overrides, in JSON. The overrides of the cluster are previewed when unset.",
+          "type": "string",
+          "x-nullable": true
+        }
       }
     },
-    "free_network_addresses": {
+    "install_cmd_request": {
       "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "role",
+        "boot_device",
+        "controller_image",
+        "installer_image"
+      ],
       "properties": {
-        "free_addresses": {
+        "boot_device": {
+          "description": "Boot device to write image on",
+          "type": "string"
+        },
+        "check_cvo": {
+          "description": "Check CVO status if needed",
+          "type": "boolean",
+          "default": true
+        },
+        "cluster_id": {
+          "description": "Cluster id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "controller_image": {
+          "description": "Assisted installer controller image",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "disks_to_format": {
+          "description": "List of disks to format",
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "description": "Disk to format",
+            "type": "string"
           }
         },
-        "network": {
+        "enable_skip_mco_reboot": {
+          "description": "If true, assisted service will attempt to skip MCO reboot",
+          "type": "boolean"
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        }
-      }
-    },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
-      "type": "object",
-      "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
-          "type": "string"
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
-          "type": "string"
+        "host_id": {
+          "description": "Host id",
+          "type": "string",
+          "format": "uuid"
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "installer_args": {
+          "description": "Core-os installer addtional args",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+        "installer_image": {
+          "description": "Assisted installer image",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "mco_image": {
+          "description": "Machine config operator image",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "must_gather_image": {
+          "description": "Must-gather images to use",
           "type": "string"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
         }
       }
     },
-    "host": {
+    "installation-timeline": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "cluster_id"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
+        "cluster_id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "bootstrap": {
-          "type": "boolean"
+        "critical_path": {
+          "description": "The stages which determined the duration of the installation, in chronological order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "duration_seconds": {
+          "description": "The duration of the installation, up to now while the installation is in progress.",
+          "type": "number",
+          "format": "double"
         },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+        "finalizing_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        },
+        "generated_at": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
-          "x-nullable": true
+          "format": "date-time"
         },
-        "connection_timed_out": {
-          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
-          "type": "boolean"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-host"
+          }
         },
-        "connectivity": {
+        "install_completed_at": {
+          "description": "The end of the installation, empty while the installation is in progress.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time"
         },
-        "created_at": {
+        "install_started_at": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          "format": "date-time"
+        },
+        "operators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-operator"
           }
+        }
+      }
+    },
+    "installation-timeline-host": {
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         },
-        "discovery_agent_version": {
+        "hostname": {
           "type": "string"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        }
+      }
+    },
+    "installation-timeline-operator": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "type": "number",
+          "format": "double"
         },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
+        "ended_at": {
+          "description": "The time the operator became available, empty while it isn't available.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time"
         },
-        "free_addresses": {
+        "name": {
+          "type": "string"
+        },
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "started_at": {
+          "description": "The start of the finalizing stage waiting for the operator.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time"
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "timeout_exceeded": {
+          "type": "boolean"
+        },
+        "timeout_seconds": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "installation-timeline-segment": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "type": "number",
+          "format": "double"
+        },
+        "ended_at": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "format": "date-time"
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+        "kind": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+          "enum": [
+            "host",
+            "finalizing",
+            "operator"
+          ]
         },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
-          "type": "boolean"
+        "stage": {
+          "type": "string"
         },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "started_at": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time"
+        },
+        "subject": {
+          "description": "The hostname of the host, the name of the operator, or the cluster for the finalizing stages.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-timeline-stage": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the stage, up to now for the stage in progress.",
+          "type": "number",
+          "format": "double"
         },
-        "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
+        "ended_at": {
+          "description": "The end of the stage, empty for the stage in progress.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+          "format": "date-time"
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "name": {
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "started_at": {
           "type": "string",
-          "example": "/dev/sda"
+          "format": "date-time"
         },
-        "installer_args": {
+        "timeout_exceeded": {
+          "description": "Whether the stage lasted longer than its timeout.",
+          "type": "boolean"
+        },
+        "timeout_seconds": {
+          "description": "The configured timeout of the stage, 0 when the stage has no timeout.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "installer-args-params": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
+        }
+      }
+    },
+    "interface": {
+      "type": "object",
+      "properties": {
+        "biosdevname": {
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "client_id": {
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
+        "has_carrier": {
+          "type": "boolean"
         },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "mac_address": {
+          "type": "string"
         },
-        "machine_config_pool_name": {
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
           "type": "string"
         },
-        "media_status": {
-          "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
-          "x-nullable": true
+        "product": {
+          "type": "string"
         },
-        "node_labels": {
-          "description": "Json containing node's labels.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "speed_mbps": {
+          "type": "integer"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "type": {
+          "type": "string"
         },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+        "vendor": {
+          "type": "string"
+        }
+      }
+    },
+    "inventory": {
+      "type": "object",
+      "properties": {
+        "bmc_address": {
+          "type": "string"
         },
-        "progress_stages": {
+        "bmc_v6address": {
+          "type": "string"
+        },
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+            "$ref": "#/definitions/disk"
+          }
         },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
         },
-        "requested_hostname": {
+        "hostname": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
         },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "memory": {
+          "$ref": "#/definitions/memory"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
         },
-        "status": {
+        "tpm_version": {
           "type": "string",
           "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
+            "none",
+            "1.2",
+            "2.0"
           ]
-        },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
+        }
+      }
+    },
+    "io_perf": {
+      "type": "object",
+      "properties": {
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
+        }
+      }
+    },
+    "ip": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "kernel_argument": {
+      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+      "type": "object",
+      "properties": {
+        "operation": {
+          "description": "The operation to apply on the kernel argument.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "suggested_role": {
-          "$ref": "#/definitions/host-role"
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
         },
-        "tang_connectivity": {
+        "value": {
+          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+        }
+      }
+    },
+    "kernel_arguments": {
+      "description": "List of kernel arugment objects that define the operations and values to be applied.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/kernel_argument"
+      },
+      "x-omitempty": false
+    },
+    "l2-connectivity": {
+      "type": "object",
+      "properties": {
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
+        "outgoing_nic": {
+          "type": "string"
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "remote_ip_address": {
+          "type": "string"
         },
-        "user_name": {
+        "remote_mac": {
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-create-params": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
+        },
+        "outgoing_nic": {
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-ignition-params": {
+    "last-installation-preparation": {
+      "description": "Gives the status of the last installation preparation (if any)",
+      "type": "object",
       "properties": {
-        "config": {
+        "reason": {
+          "description": "The reason for the preparation status if applicable",
           "type": "string"
+        },
+        "status": {
+          "description": "The last installation preparation status",
+          "type": "string",
+          "default": "not_started",
+          "enum": [
+            "not_started",
+            "failed",
+            "success"
+          ],
+          "x-nullable": false
         }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
+      "x-nullable": false
+    },
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
       }
     },
-    "host-list": {
+    "list-manifests": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
+        "$ref": "#/definitions/manifest"
       }
     },
-    "host-progress": {
+    "list-versions": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "release_tag": {
+          "type": "string"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "host-progress-info": {
+    "log-search-match": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "context_after": {
+          "description": "The lines of the file following the matching line.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "installation_percentage": {
-          "type": "integer"
+        "context_before": {
+          "description": "The lines of the file preceding the matching line.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "file": {
+          "description": "The path of the file in the uploaded logs.",
+          "type": "string"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
+        "host_id": {
+          "description": "The host which uploaded the logs. Unset for the logs of the controller.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "stage_timed_out": {
-          "description": "Indicate of the current stage has been timed out.",
-          "type": "boolean"
+        "host_name": {
+          "description": "The name of the host which uploaded the logs.",
+          "type": "string"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
+        "line": {
+          "description": "The number of the line in the file, starting at 1.",
+          "type": "integer"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "text": {
+          "description": "The matching line.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "The time of the line, or of the closest preceding line of the file with a time. Unset when the file has no time.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-nullable": true
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+      }
     },
-    "host-type-hardware-requirements": {
+    "log-search-result": {
       "type": "object",
+      "required": [
+        "matches"
+      ],
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+        "matches": {
+          "description": "The matching lines, ordered by host, file and line.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/log-search-match"
           }
         },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "truncated": {
+          "description": "Whether more lines match the search than the returned ones.",
+          "type": "boolean"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "logs-progress-params": {
       "type": "object",
+      "required": [
+        "logs_state"
+      ],
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
         }
       }
     },
-    "host-update-params": {
+    "logs_gather_cmd_request": {
       "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "bootstrap",
+        "installer_gather"
+      ],
       "properties": {
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-config-params"
-          },
-          "x-nullable": true
-        },
-        "disks_skip_formatting": {
-          "description": "Allows changing the host's skip_formatting_disks parameter",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-skip-formatting-params"
-          },
-          "x-nullable": true
-        },
-        "host_name": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "host_role": {
-          "type": "string",
-          "enum": [
-            "auto-assign",
-            "master",
-            "worker"
-          ],
-          "x-nullable": true
+        "bootstrap": {
+          "description": "Host is bootstrap or not",
+          "type": "boolean"
         },
-        "ignition_endpoint_http_headers": {
-          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
-          },
-          "x-nullable": true
+        "cluster_id": {
+          "description": "Cluster id",
+          "type": "string",
+          "format": "uuid"
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "machine_config_pool_name": {
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "node_labels": {
-          "description": "Labels to be added to the corresponding node.",
+        "installer_gather": {
+          "description": "Run installer gather logs",
+          "type": "boolean",
+          "default": true
+        },
+        "master_ips": {
+          "description": "List of master ips",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/node-label-params"
-          },
-          "x-nullable": true
+            "description": "Master ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
         }
       }
     },
-    "host-validation-id": {
+    "logs_state": {
       "type": "string",
       "enum": [
-        "connected",
-        "media-connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "ignition-downloadable",
-        "belongs-to-majority-group",
-        "valid-platform-network-settings",
-        "ntp-synced",
-        "time-synced-between-host-and-service",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role",
-        "has-default-route",
-        "api-domain-name-resolved-correctly",
-        "api-int-domain-name-resolved-correctly",
-        "apps-domain-name-resolved-correctly",
-        "release-domain-name-resolved-correctly",
-        "compatible-with-cluster-platform",
-        "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied",
-        "non-overlapping-subnets",
-        "vsphere-disk-uuid-enabled",
-        "compatible-agent",
-        "no-skip-installation-disk",
-        "no-skip-missing-disk",
-        "no-ip-collisions-in-network"
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ],
+      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
       ]
     },
-    "host_network": {
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
       "type": "object",
       "properties": {
         "cidr": {
-          "type": "string"
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "host_ids": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "uuid"
-          }
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
+    "managed-domain": {
+      "type": "object",
+      "properties": {
+        "domain": {
+          "type": "string"
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53",
+            "zonefile"
+          ]
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "manifest": {
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
-        },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
           "type": "string"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
         }
       }
     },
-    "ignition-endpoint": {
-      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+    "memory": {
       "type": "object",
       "properties": {
-        "ca_certificate": {
-          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
+        "physical_bytes": {
+          "type": "integer"
         },
-        "url": {
-          "description": "The URL for the ignition endpoint.",
-          "type": "string",
-          "x-nullable": true
+        "physical_bytes_method": {
+          "description": "The method by which the physical memory was set",
+          "$ref": "#/definitions/memory_method"
+        },
+        "usable_bytes": {
+          "type": "integer"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+      }
     },
-    "ignition-endpoint-http-headers-params": {
+    "memory_method": {
+      "type": "string",
+      "enum": [
+        "dmidecode",
+        "ghw",
+        "meminfo"
+      ]
+    },
+    "monitored-operator": {
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the http header's key-value pair.",
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
+          "type": "string"
+        },
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "value": {
-          "description": "The value for the http header's key-value pair.",
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Operator version",
           "type": "string"
         }
       }
     },
-    "ignored-validations": {
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "mtu_path_check_remote_host": {
       "type": "object",
+      "required": [
+        "host_id",
+        "ip_addresses"
+      ],
       "properties": {
-        "cluster-validation-ids": {
-          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+        "host_id": {
           "type": "string",
-          "format": "string"
+          "format": "uuid"
         },
-        "host-validation-ids": {
-          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
-          "type": "string",
-          "format": "string"
+        "ip_addresses": {
+          "description": "The addresses of the host in the machine networks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "image-create-params": {
+    "mtu_path_check_request": {
       "type": "object",
+      "required": [
+        "remote_hosts"
+      ],
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
+        "remote_hosts": {
+          "description": "The hosts whose path MTU is probed.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "$ref": "#/definitions/mtu_path_check_remote_host"
           }
         }
       }
     },
-    "image_info": {
+    "mtu_path_check_response": {
       "type": "object",
       "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu_path_result"
           }
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
-        },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
-          "type": "string"
-        },
-        "type": {
-          "$ref": "#/definitions/image_type"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
-    },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
+      }
     },
-    "import-cluster-params": {
+    "mtu_path_result": {
+      "description": "The largest packet sent without fragmentation to an address of a remote host.",
       "type": "object",
-      "required": [
-        "name",
-        "api_vip_dnsname",
-        "openshift_cluster_id"
-      ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
+        "error": {
           "type": "string"
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "outgoing_nic": {
           "type": "string"
         },
-        "openshift_cluster_id": {
-          "description": "The id of the OCP cluster, that hosts will be added to",
+        "path_mtu": {
+          "description": "The path MTU in bytes, 0 when the remote address couldn't be reached.",
+          "type": "integer"
+        },
+        "remote_host_id": {
           "type": "string",
           "format": "uuid"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "remote_ip_address": {
           "type": "string"
         }
       }
     },
-    "infra-env": {
+    "network-report": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "name",
-        "type",
-        "updated_at",
-        "created_at"
+        "cluster_id"
       ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "x-nullable": false
-        },
         "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
+          "format": "uuid"
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "generated_at": {
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "format": "date-time"
         },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-host"
           }
         },
-        "download_url": {
-          "type": "string"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
+        "ip_collisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-ip-collision"
+          }
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "links": {
+          "description": "The connectivity from each host to each of the other hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-link"
+          }
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "majority_groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-majority-group"
+          }
         },
-        "ignition_config_override": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+        "networks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-network"
+          }
         },
-        "kernel_arguments": {
-          "description": "JSON formatted string array representing the discovery image kernel arguments.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "x-nullable": true
+        "vips": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-vip"
+          }
+        }
+      }
+    },
+    "network-report-host": {
+      "type": "object",
+      "properties": {
+        "connectivity_reported": {
+          "description": "Whether the host reported the results of its connectivity checks.",
+          "type": "boolean"
         },
-        "kind": {
-          "description": "Indicates the type of this object.",
+        "host_id": {
           "type": "string",
-          "enum": [
-            "InfraEnv"
-          ]
+          "format": "uuid"
         },
-        "name": {
-          "description": "Name of the infra-env.",
+        "hostname": {
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
-          "type": "string"
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-interface"
+          }
         },
-        "org_id": {
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
+    "network-report-interface": {
+      "type": "object",
+      "properties": {
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
+        "mac_address": {
+          "type": "string"
         },
-        "size_bytes": {
+        "mtu": {
           "type": "integer"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+        "name": {
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation.",
+        "speed_mbps": {
+          "type": "integer"
+        }
+      }
+    },
+    "network-report-ip-collision": {
+      "type": "object",
+      "properties": {
+        "ip_address": {
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "updated_at": {
-          "description": "The last time that this infra-env was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "mac_addresses": {
+          "description": "The MAC addresses answering for the IP address.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        },
-        "user_name": {
-          "type": "string"
         }
       }
     },
-    "infra-env-create-params": {
+    "network-report-link": {
       "type": "object",
-      "required": [
-        "name",
-        "pull_secret"
-      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
+        "average_rtt_ms": {
+          "description": "The highest average round trip time to the addresses of the remote host, in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": false
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
         },
-        "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-nullable": true
+        "l2_reachable": {
+          "description": "Whether an address of the remote host was reached by ARP.",
+          "type": "boolean"
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+        "l3_reachable": {
+          "description": "Whether an address of the remote host was reached by ping.",
+          "type": "boolean"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
+        "latency_threshold_exceeded": {
+          "description": "Whether the round trip time exceeds the threshold of the role of the hosts.",
+          "type": "boolean"
         },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
+        "packet_loss_percentage": {
+          "description": "The highest packet loss to the addresses of the remote host.",
+          "type": "number",
+          "format": "double"
         },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
+        "packet_loss_threshold_exceeded": {
+          "description": "Whether the packet loss exceeds the threshold of the role of the hosts.",
+          "type": "boolean"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
-          "type": "string"
+        "remote_host_id": {
+          "type": "string",
+          "format": "uuid"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "network-report-majority-group": {
+      "type": "object",
+      "properties": {
+        "host_ids": {
+          "description": "The hosts connected to each other in the network.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "network": {
+          "description": "The CIDR of the L2 group, or the address family (IPv4, IPv6) of the L3 group.",
           "type": "string"
+        }
+      }
+    },
+    "network-report-network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "$ref": "#/definitions/subnet"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
+        "host_ids": {
+          "description": "The hosts having an address in the network.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         },
-        "static_network_config": {
+        "mtu_mismatch": {
+          "description": "Whether the interfaces of the hosts in the network have different MTUs.",
+          "type": "boolean"
+        },
+        "mtus": {
+          "description": "The distinct MTUs of the interfaces of the hosts in the network.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "type": "integer"
           }
         }
       }
     },
-    "infra-env-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/infra-env"
+    "network-report-vip": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "$ref": "#/definitions/ip"
+        },
+        "message": {
+          "description": "The reason why the VIP isn't available.",
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "api",
+            "ingress"
+          ]
+        },
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
+        }
       }
     },
-    "infra-env-update-params": {
+    "next_step_cmd_request": {
       "type": "object",
+      "required": [
+        "infra_env_id",
+        "host_id",
+        "agent_version"
+      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "agent_version": {
+          "description": "Agent image version",
           "type": "string",
-          "x-nullable": true
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "additional_trust_bundle": {
-          "description": "Allows users to change the additional_trust_bundle infra-env field",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "maxLength": 65535,
-          "x-nullable": true
+          "format": "uuid"
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "node-label-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the label's key-value pair.",
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
-        },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "value": {
+          "description": "The value for the label's key-value pair.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "static_network_config": {
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
+        }
+      }
+    },
+    "ntp_synchronization_request": {
+      "type": "object",
+      "required": [
+        "ntp_source"
+      ],
+      "properties": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "$ref": "#/definitions/ntp_source"
           }
         }
       }
     },
-    "infra_error": {
+    "openshift-version": {
       "type": "object",
       "required": [
-        "code",
-        "message"
+        "display_name",
+        "support_level",
+        "cpu_architectures"
       ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+        "cpu_architectures": {
+          "description": "Available CPU architectures.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "message": {
-          "description": "Human-readable description of the error.",
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
     },
-    "ingress_vip": {
-      "description": "The virtual IP used for cluster ingress traffic.",
+    "operator-create-params": {
       "type": "object",
       "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
+        "name": {
+          "type": "string"
         },
-        "verification": {
-          "description": "Ingress VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "install_cmd_request": {
+    "operator-hardware-requirements": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "role",
-        "boot_device",
-        "controller_image",
-        "installer_image"
-      ],
       "properties": {
-        "boot_device": {
-          "description": "Boot device to write image on",
-          "type": "string"
-        },
-        "check_cvo": {
-          "description": "Check CVO status if needed",
-          "type": "boolean",
-          "default": true
-        },
-        "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "controller_image": {
-          "description": "Assisted installer controller image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "disks_to_format": {
-          "description": "List of disks to format",
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "array",
           "items": {
-            "description": "Disk to format",
             "type": "string"
           }
         },
-        "enable_skip_mco_reboot": {
-          "description": "If true, assisted service will attempt to skip MCO reboot",
-          "type": "boolean"
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
+          "type": "string"
         },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
         },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "installer_args": {
-          "description": "Core-os installer addtional args",
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "installer_image": {
-          "description": "Assisted installer image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "mco_image": {
-          "description": "Machine config operator image",
+        "version": {
+          "description": "operator version.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "must_gather_image": {
-          "description": "Must-gather images to use",
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "description": {
+          "description": "Description of a property",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
+          "type": "boolean"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
         },
-        "service_ips": {
-          "description": "List of service ips",
+        "options": {
+          "description": "Values to select from",
           "type": "array",
           "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+            "type": "string"
           }
+        }
+      }
+    },
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "os-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "openshift_version": {
+          "description": "Version of the operating system image",
+          "type": "string",
+          "example": "4.12"
         },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "url": {
+          "description": "The base OS image used for the discovery iso.",
+          "type": "string"
+        },
+        "version": {
+          "description": "Build ID of the OS image.",
+          "type": "string"
         }
       }
     },
-    "installer-args-params": {
+    "os-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/os-image"
+      }
+    },
+    "platform": {
+      "description": "The configuration for the specific platform upon which to perform the installation.",
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+        "external": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_external"
+        },
+        "type": {
+          "$ref": "#/definitions/platform_type"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
     },
-    "interface": {
+    "platform_external": {
+      "description": "Configuration used when installing with an external platform type.",
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "has_carrier": {
-          "type": "boolean"
+        "cloud_controller_manager": {
+          "description": "When set to external, this property will enable an external cloud provider.",
+          "type": "string",
+          "default": "",
+          "enum": [
+            "",
+            "External"
+          ],
+          "x-nullable": true
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "platform_name": {
+          "description": "Holds the arbitrary string representing the infrastructure provider name.",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+    },
+    "platform_type": {
+      "type": "string",
+      "enum": [
+        "baremetal",
+        "nutanix",
+        "vsphere",
+        "none",
+        "external"
+      ]
+    },
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         },
-        "ipv6_addresses": {
+        "operators": {
+          "description": "Preflight operators hardware requirements",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/operator-hardware-requirements"
           }
+        }
+      }
+    },
+    "presigned-url": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "expires_at": {
+          "description": "Expiration time for the URL token.",
+          "type": "string",
+          "format": "date-time"
         },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
+        "url": {
+          "description": "Pre-signed URL for downloading the infra-env discovery image.",
           "type": "string"
+        }
+      }
+    },
+    "proxy": {
+      "type": "object",
+      "properties": {
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
         },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "type": {
-          "type": "string"
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
+          "x-nullable": true
         },
-        "vendor": {
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
+    },
+    "reboot_for_reclaim_request": {
+      "description": "Information sent to the agent for rebooting a host into discovery.",
+      "type": "object",
+      "required": [
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
           "type": "string"
         }
       }
     },
-    "inventory": {
+    "release-channel": {
+      "description": "Release channel.",
+      "type": "string",
+      "enum": [
+        "candidate",
+        "fast",
+        "stable",
+        "eus"
+      ]
+    },
+    "release-image": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
       "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
+        "cpu_architecture": {
+          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
-        "disks": {
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk"
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
           }
         },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
         },
-        "hostname": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "interfaces": {
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
+        },
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primarykey\""
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
+        }
+      }
+    },
+    "release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-image"
+      }
+    },
+    "release-source": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "multi_cpu_architectures",
+        "upgrade_channels"
+      ],
+      "properties": {
+        "multi_cpu_architectures": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/interface"
+            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
+            "type": "string",
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x"
+            ]
           }
         },
-        "memory": {
-          "$ref": "#/definitions/memory"
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "example": "4.14"
         },
-        "routes": {
+        "upgrade_channels": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/route"
+            "$ref": "#/definitions/upgrade-channel"
           }
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "tpm_version": {
-          "type": "string",
-          "enum": [
-            "none",
-            "1.2",
-            "2.0"
-          ]
         }
       }
     },
-    "io_perf": {
+    "release-sources": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-source"
+      }
+    },
+    "route": {
       "type": "object",
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
+        },
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
+          "type": "string"
+        },
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
+          "type": "string"
+        },
+        "metric": {
+          "description": "Route priority metric",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "ip": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "kernel_argument": {
-      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
       "properties": {
-        "operation": {
-          "description": "The operation to apply on the kernel argument.",
-          "type": "string",
-          "enum": [
-            "append",
-            "replace",
-            "delete"
-          ]
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "value": {
-          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
+        "cluster_id": {
+          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "kernel_arguments": {
-      "description": "List of kernel arugment objects that define the operations and values to be applied.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/kernel_argument"
-      },
-      "x-omitempty": false
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
     },
-    "l2-connectivity": {
+    "step": {
       "type": "object",
       "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "remote_mac": {
+        "step_id": {
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "l3-connectivity": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
+        "error": {
           "type": "string"
         },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
+        "exit_code": {
+          "type": "integer"
         },
-        "remote_ip_address": {
+        "output": {
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "last-installation-preparation": {
-      "description": "Gives the status of the last installation preparation (if any)",
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "tang-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "stop-installation",
+        "logs-gather",
+        "next-step-runner",
+        "upgrade-agent",
+        "download-boot-artifacts",
+        "reboot-for-reclaim",
+        "verify-vips",
+        "mtu-path-check",
+        "wipe-disks"
+      ]
+    },
+    "steps": {
       "type": "object",
       "properties": {
-        "reason": {
-          "description": "The reason for the preparation status if applicable",
-          "type": "string"
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
         },
-        "status": {
-          "description": "The last installation preparation status",
+        "next_instruction_seconds": {
+          "type": "integer"
+        },
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
           "type": "string",
-          "default": "not_started",
+          "default": "continue",
           "enum": [
-            "not_started",
-            "failed",
-            "success"
-          ],
-          "x-nullable": false
+            "exit",
+            "continue"
+          ]
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
-      "x-nullable": false
+      }
     },
-    "list-managed-domains": {
+    "steps-reply": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/managed-domain"
+        "$ref": "#/definitions/step-reply"
       }
     },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
+    "subnet": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "support-level": {
+      "type": "string",
+      "enum": [
+        "supported",
+        "unsupported",
+        "tech-preview",
+        "dev-preview",
+        "unavailable"
+      ]
+    },
+    "support-levels": {
+      "description": "Map of feature ID or CPU architecture alongside their support level",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/support-level"
       }
     },
-    "list-versions": {
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "release_tag": {
+        "manufacturer": {
           "type": "string"
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "product_name": {
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
       }
     },
-    "logs-progress-params": {
+    "tang_connectivity_request": {
       "type": "object",
       "required": [
-        "logs_state"
+        "tang_servers"
       ],
       "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string"
         }
       }
     },
-    "logs_gather_cmd_request": {
+    "tang_connectivity_response": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "bootstrap",
-        "installer_gather"
-      ],
       "properties": {
-        "bootstrap": {
-          "description": "Host is bootstrap or not",
+        "is_success": {
+          "description": "Tang check result.",
           "type": "boolean"
         },
+        "tang_server_response": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "payload": {
+                "description": "Tang response payload.",
+                "type": "string"
+              },
+              "signatures": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "protected": {
+                      "type": "string"
+                    },
+                    "signature": {
+                      "type": "string"
+                    }
+                  },
+                  "x-go-name": "TangServerSignatures"
+                }
+              },
+              "tang_url": {
+                "description": "Tang URL.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangServerResponse"
+          }
+        }
+      }
+    },
+    "triage-finding": {
+      "description": "A known failure found in the logs uploaded for a cluster.",
+      "type": "object",
+      "properties": {
         "cluster_id": {
-          "description": "Cluster id",
+          "description": "The cluster that this finding is associated with.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "excerpt": {
+          "description": "The first matching line.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "file": {
+          "description": "The path of the file of the first matching line in the uploaded logs.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "found_at": {
+          "description": "The time the uploaded logs were analyzed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
         "host_id": {
-          "description": "Host id",
+          "description": "The host which uploaded the logs. Unset for the logs of the controller.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "id": {
+          "description": "Unique identifier of the finding.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "installer_gather": {
-          "description": "Run installer gather logs",
-          "type": "boolean",
-          "default": true
+        "line": {
+          "description": "The number of the first matching line in the file, starting at 1.",
+          "type": "integer"
         },
-        "master_ips": {
-          "description": "List of master ips",
-          "type": "array",
-          "items": {
-            "description": "Master ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "match_count": {
+          "description": "The number of matching lines in the uploaded logs.",
+          "type": "integer"
+        },
+        "remediation": {
+          "description": "What to check to fix the known failure.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "severity": {
+          "description": "Whether the known failure may fail the installation (warning) or fails it (critical).",
+          "type": "string",
+          "enum": [
+            "warning",
+            "critical"
+          ]
+        },
+        "signature_id": {
+          "description": "The identifier of the signature of the known failure.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "The time of the first matching line, when known.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "title": {
+          "description": "The known failure.",
+          "type": "string"
         }
       }
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ],
-      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-    },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
-    },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
-          }
+    "triage-summary": {
+      "description": "The number of known failures found by the automatic triage of the logs uploaded for a cluster, set by v2GetCluster only. The findings are listed by v2ListClusterTriageFindings.",
+      "type": "object",
+      "properties": {
+        "critical_count": {
+          "description": "The number of known failures which fail the installation.",
+          "type": "integer"
+        },
+        "finding_count": {
+          "description": "The number of known failures found.",
+          "type": "integer"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"-\"",
+      "x-nullable": true
     },
-    "machine_network": {
-      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+    "update-manifest-params": {
       "type": "object",
+      "required": [
+        "folder",
+        "file_name"
+      ],
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "file_name": {
+          "description": "The file name for the manifest to modify.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": false
         },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+        "folder": {
+          "description": "The folder for the manifest to modify.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": false
+        },
+        "updated_content": {
+          "description": "The new base64 encoded manifest content.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "updated_file_name": {
+          "description": "The new file name for the manifest.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": true
+        },
+        "updated_folder": {
+          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": true
         }
       }
     },
-    "managed-domain": {
+    "upgrade-channel": {
       "type": "object",
+      "required": [
+        "cpu_architecture",
+        "channels"
+      ],
       "properties": {
-        "domain": {
-          "type": "string"
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/release-channel"
+          }
         },
-        "provider": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image.",
           "type": "string",
           "enum": [
-            "route53"
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
           ]
         }
       }
     },
-    "manifest": {
+    "upgrade_agent_request": {
+      "type": "object",
+      "properties": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
+          "type": "string"
+        }
+      }
+    },
+    "upgrade_agent_response": {
       "type": "object",
       "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
           "type": "string"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
       }
     },
-    "memory": {
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "usage": {
       "type": "object",
       "properties": {
-        "physical_bytes": {
-          "type": "integer"
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
         },
-        "physical_bytes_method": {
-          "description": "The method by which the physical memory was set",
-          "$ref": "#/definitions/memory_method"
+        "id": {
+          "description": "Unique idenftifier of the feature",
+          "type": "string"
         },
-        "usable_bytes": {
-          "type": "integer"
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
         }
       }
     },
-    "memory_method": {
-      "type": "string",
-      "enum": [
-        "dmidecode",
-        "ghw",
-        "meminfo"
-      ]
-    },
-    "monitored-operator": {
+    "v2-cluster-update-params": {
       "type": "object",
       "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "x-nullable": true
         },
-        "name": {
-          "description": "Unique name of the operator.",
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "x-nullable": true
         },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-nullable": true
         },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
         },
-        "status": {
-          "$ref": "#/definitions/operator-status"
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
         },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
         },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the cluster must pass. An empty list deletes the validations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          },
+          "x-nullable": true
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "host_role_rules": {
+          "description": "Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies. An empty list deletes the rules.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          },
+          "x-nullable": true
+        },
+        "host_stage_timeout_policies": {
+          "description": "Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage. An empty list deletes the policies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage-timeout-policy"
+          },
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-nullable": true
         },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
         },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
         },
-        "version": {
-          "description": "Operator version",
-          "type": "string"
-        }
-      }
-    },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "next_step_cmd_request": {
-      "type": "object",
-      "required": [
-        "infra_env_id",
-        "host_id",
-        "agent_version"
-      ],
-      "properties": {
-        "agent_version": {
-          "description": "Agent image version",
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignition_override_layers": {
+          "description": "Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool. An empty list deletes the layers.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-layer"
+          },
+          "x-nullable": true
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
         },
-        "host_id": {
-          "description": "Host id",
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "node-label-params": {
+    "verified_vip": {
+      "description": "Single VIP verification result.",
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the label's key-value pair.",
-          "type": "string"
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
         },
-        "value": {
-          "description": "The value for the label's key-value pair.",
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
+        },
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "ntp_source": {
+    "verify_vip": {
+      "description": "Request to verify single vip.",
       "type": "object",
       "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        }
+    "verify_vips_request": {
+      "description": "list of vips to be verified.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verify_vip"
       }
     },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
-          }
-        }
+    "verify_vips_response": {
+      "description": "list of verified vips.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verified_vip"
       }
     },
-    "openshift-version": {
+    "versioned-host-requirements": {
       "type": "object",
-      "required": [
-        "display_name",
-        "support_level",
-        "cpu_architectures"
-      ],
       "properties": {
-        "cpu_architectures": {
-          "description": "Available CPU architectures.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "edge-worker": {
+          "description": "Edge Worker OpenShift node requirements",
+          "x-go-name": "EdgeWorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "openshift-versions": {
+    "versions": {
       "type": "object",
       "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
+        "type": "string"
       }
     },
-    "operator-create-params": {
+    "vip_type": {
+      "description": "The vip type.",
+      "type": "string",
+      "enum": [
+        "api",
+        "ingress"
+      ]
+    },
+    "vip_verification": {
+      "description": "vip verification result.",
+      "type": "string",
+      "default": "unverified",
+      "enum": [
+        "unverified",
+        "failed",
+        "succeeded"
+      ]
+    },
+    "webhook-notification-type": {
+      "type": "string",
+      "enum": [
+        "ClusterState",
+        "HostState",
+        "InfraEnv",
+        "Event"
+      ]
+    },
+    "webhook-subscription": {
       "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
       "properties": {
-        "name": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        }
-      }
-    },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "notification_types": {
+          "description": "The notification types delivered to the webhook. All the types are delivered if empty.",
           "type": "array",
           "items": {
             "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
           }
         },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        }
-      }
-    },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
+        "url": {
+          "description": "The http(s) URL the notifications are posted to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "operator-monitor-report": {
+    "webhook-subscription-create-params": {
       "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
       "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
+        "notification_types": {
+          "description": "The notification types delivered to the webhook. All the types are delivered if empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-notification-type"
+          }
         },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "secret": {
+          "description": "Key used to sign the notifications with HMAC-SHA256. It is never returned by the service.",
+          "type": "string",
+          "minLength": 16
         },
-        "version": {
-          "description": "operator version.",
+        "url": {
+          "description": "The http(s) URL the notifications are posted to.",
           "type": "string"
         }
       }
     },
-    "operator-properties": {
+    "webhook-subscription-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/operator-property"
+        "$ref": "#/definitions/webhook-subscription"
       }
     },
-    "operator-property": {
+    "wipe_disks_request": {
       "type": "object",
+      "required": [
+        "disks"
+      ],
       "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
+        "disks": {
+          "description": "The paths of the disks to wipe.",
           "type": "array",
           "items": {
             "type": "string"
@@ -9680,1030 +15414,2152 @@ func init() {
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
+    "wipe_disks_response": {
+      "type": "object",
+      "properties": {
+        "wiped_disks": {
+          "description": "The paths of the disks that were wiped.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
+    "imageAuth": {
+      "type": "apiKey",
+      "name": "Image-Token",
+      "in": "header"
+    },
+    "imageURLAuth": {
+      "type": "apiKey",
+      "name": "image_token",
+      "in": "query"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
       ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
     },
-    "os-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "openshift_version": {
-          "description": "Version of the operating system image",
-          "type": "string",
-          "example": "4.12"
-        },
-        "url": {
-          "description": "The base OS image used for the discovery iso.",
-          "type": "string"
-        },
-        "version": {
-          "description": "Build ID of the OS image.",
-          "type": "string"
+    {
+      "description": "Out-of-band management of the hosts with Redfish.",
+      "name": "bmc"
+    },
+    {
+      "description": "Templates for the repeatable creation of clusters.",
+      "name": "cluster_templates"
+    },
+    {
+      "description": "Dry runs of the cluster installation steps.",
+      "name": "dry_run"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "Queries of the hardware of the hosts of the tenant.",
+      "name": "hardware_inventory"
+    },
+    {
+      "description": "Automatic binding of the unbound hosts to the clusters.",
+      "name": "host_claims"
+    },
+    {
+      "description": "Timelines of the installation of clusters.",
+      "name": "installation_timeline"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Search of the logs uploaded for clusters.",
+      "name": "log_search"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Diagnostic reports of the network of clusters.",
+      "name": "network_report"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Known failures found in the logs uploaded for clusters.",
+      "name": "triage"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    },
+    {
+      "description": "Streams of the changes of clusters and infra-envs.",
+      "name": "watch"
+    },
+    {
+      "description": "Webhook subscriptions to the change notifications of the tenant resources.",
+      "name": "webhooks"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install",
+  "paths": {
+    "/v2/cluster-templates": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the cluster templates of the tenant.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2ListClusterTemplates",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a template from which clusters can be created repeatedly.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2CreateClusterTemplate",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "os-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/os-image"
-      }
-    },
-    "platform": {
-      "description": "The configuration for the specific platform upon which to perform the installation.",
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "external": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform_external"
-        },
-        "type": {
-          "$ref": "#/definitions/platform_type"
+    "/v2/cluster-templates/{cluster_template_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be retrieved.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
-    },
-    "platform_external": {
-      "description": "Configuration used when installing with an external platform type.",
-      "type": "object",
-      "properties": {
-        "cloud_controller_manager": {
-          "description": "When set to external, this property will enable an external cloud provider.",
-          "type": "string",
-          "default": "",
-          "enum": [
-            "",
-            "External"
-          ],
-          "x-nullable": true
-        },
-        "platform_name": {
-          "description": "Holds the arbitrary string representing the infrastructure provider name.",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
+      "delete": {
+        "description": "Deletes a cluster template. Clusters already created from it are not changed.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2DeleteClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be deleted.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
-    },
-    "platform_type": {
-      "type": "string",
-      "enum": [
-        "baremetal",
-        "nutanix",
-        "vsphere",
-        "none",
-        "external"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+      "patch": {
+        "description": "Updates the description and the definition of a cluster template. Clusters already created from it are not changed.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2UpdateClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be updated.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-template-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "presigned-url": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "expires_at": {
-          "description": "Expiration time for the URL token.",
-          "type": "string",
-          "format": "date-time"
-        },
-        "url": {
-          "description": "Pre-signed URL for downloading the infra-env discovery image.",
-          "type": "string"
-        }
-      }
-    },
-    "proxy": {
-      "type": "object",
-      "properties": {
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
+      }
     },
-    "reboot_for_reclaim_request": {
-      "description": "Information sent to the agent for rebooting a host into discovery.",
-      "type": "object",
-      "required": [
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
-          "type": "string"
+    "/v2/cluster-templates/{cluster_template_id}/actions/instantiate": {
+      "post": {
+        "description": "Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2InstantiateClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be instantiated.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties of the new cluster which are not taken from the template.",
+            "name": "instantiate-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-instantiate-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-instance"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "release-channel": {
-      "description": "Release channel.",
-      "type": "string",
-      "enum": [
-        "candidate",
-        "fast",
-        "stable",
-        "eus"
-      ]
-    },
-    "release-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "cpu_architectures": {
-          "description": "List of CPU architectures provided by the image.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
           },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "github.com/lib/pq"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
             },
-            "type": "StringArray"
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
-        },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primarykey\""
-        },
-        "version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
         }
       }
     },
-    "release-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-image"
-      }
-    },
-    "release-source": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "multi_cpu_architectures",
-        "upgrade_channels"
-      ],
-      "properties": {
-        "multi_cpu_architectures": {
-          "type": "array",
-          "items": {
-            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
-            "type": "string",
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x"
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
             ]
           }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "example": "4.14"
-        },
-        "upgrade_channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/upgrade-channel"
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "release-sources": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-source"
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
-        },
-        "metric": {
-          "description": "Route priority metric",
-          "type": "integer",
-          "format": "int32"
-        }
-      }
-    },
-    "service_network": {
-      "description": "IP address block for service IP blocks.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
-      }
-    },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "tang-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution",
-        "stop-installation",
-        "logs-gather",
-        "next-step-runner",
-        "upgrade-agent",
-        "download-boot-artifacts",
-        "reboot-for-reclaim",
-        "verify-vips"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToAddingHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "subnet": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "support-level": {
-      "type": "string",
-      "enum": [
-        "supported",
-        "unsupported",
-        "tech-preview",
-        "dev-preview",
-        "unavailable"
-      ]
-    },
-    "support-levels": {
-      "description": "Map of feature ID or CPU architecture alongside their support level",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/support-level"
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_request": {
-      "type": "object",
-      "required": [
-        "tang_servers"
-      ],
-      "properties": {
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string"
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "Tang check result.",
-          "type": "boolean"
-        },
-        "tang_server_response": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "payload": {
-                "description": "Tang response payload.",
-                "type": "string"
-              },
-              "signatures": {
-                "type": "array",
-                "items": {
-                  "type": "object",
-                  "properties": {
-                    "protected": {
-                      "type": "string"
-                    },
-                    "signature": {
-                      "type": "string"
-                    }
-                  },
-                  "x-go-name": "TangServerSignatures"
-                }
-              },
-              "tang_url": {
-                "description": "Tang URL.",
-                "type": "string"
-              }
-            },
-            "x-go-name": "TangServerResponse"
+    "/v2/clusters/{cluster_id}/actions/dry-run-generate": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate.",
+        "tags": [
+          "dry_run"
+        ],
+        "operationId": "v2DryRunGenerate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation files are generated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/dry-run-generate-report"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "update-manifest-params": {
-      "type": "object",
-      "required": [
-        "folder",
-        "file_name"
-      ],
-      "properties": {
-        "file_name": {
-          "description": "The file name for the manifest to modify.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": false
-        },
-        "folder": {
-          "description": "The folder for the manifest to modify.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": false
-        },
-        "updated_content": {
-          "description": "The new base64 encoded manifest content.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "updated_file_name": {
-          "description": "The new file name for the manifest.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": true
-        },
-        "updated_folder": {
-          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": true
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade-channel": {
-      "type": "object",
-      "required": [
-        "cpu_architecture",
-        "channels"
-      ],
-      "properties": {
-        "channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/release-channel"
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image.",
-          "type": "string",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ]
         }
       }
     },
-    "upgrade_agent_request": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
+    "/v2/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_response": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/upgrade_agent_result"
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_result": {
-      "description": "Agent upgrade result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetPresignedForClusterCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "description": "Unique idenftifier of the feature",
-          "type": "string"
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
       }
     },
-    "v2-cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           },
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          {
+            "agentAuth": []
           },
-          "x-nullable": true
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verified_vip": {
-      "description": "Single VIP verification result.",
-      "type": "object",
-      "properties": {
-        "verification": {
-          "$ref": "#/definitions/vip_verification"
-        },
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vip": {
-      "description": "Request to verify single vip.",
-      "type": "object",
-      "properties": {
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/dry-run-generate": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the report of the last dry run of the cluster installation files generation.",
+        "tags": [
+          "dry_run"
+        ],
+        "operationId": "v2GetDryRunGenerate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose dry run report is retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/dry-run-generate-report"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vips_request": {
-      "description": "list of vips to be verified.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verify_vip"
-      }
-    },
-    "verify_vips_response": {
-      "description": "list of verified vips.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verified_vip"
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "edge-worker": {
-          "description": "Edge Worker OpenShift node requirements",
-          "x-go-name": "EdgeWorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/v2/clusters/{cluster_id}/host-claim": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the host claim of the cluster and the hosts bound by it.",
+        "tags": [
+          "host_claims"
+        ],
+        "operationId": "v2GetHostClaim",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose host claim is retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-claim"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers.",
+        "tags": [
+          "host_claims"
+        ],
+        "operationId": "v2SetHostClaim",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose host claim is set.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The definition of the host claim.",
+            "name": "host-claim-spec",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-claim-spec"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-claim"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster.",
+        "tags": [
+          "host_claims"
+        ],
+        "operationId": "v2DeleteHostClaim",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose host claim is deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    },
-    "vip_type": {
-      "description": "The vip type.",
-      "type": "string",
-      "enum": [
-        "api",
-        "ingress"
-      ]
-    },
-    "vip_verification": {
-      "description": "vip verification result.",
-      "type": "string",
-      "default": "unverified",
-      "enum": [
-        "unverified",
-        "failed",
-        "succeeded"
-      ]
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "imageAuth": {
-      "type": "apiKey",
-      "name": "Image-Token",
-      "in": "header"
-    },
-    "imageURLAuth": {
-      "type": "apiKey",
-      "name": "image_token",
-      "in": "query"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install",
-  "paths": {
-    "/v2/clusters": {
+    "/v2/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -10712,49 +17568,52 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose hosts should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
+            "enum": [
+              "master",
+              "worker",
+              "auto-assign"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
+            "description": "Role to request.",
+            "name": "role",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
+            "type": "string",
+            "description": "Hosts status to request.",
+            "name": "status",
             "in": "query"
           },
           {
             "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
+            "type": "boolean",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
             "in": "query"
           }
         ],
@@ -10762,7 +17621,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -10777,8 +17636,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10788,37 +17647,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission": {
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "v2DecommissionHost",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster of the host that is being decommissioned.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being decommissioned.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The options of the decommission.",
+            "name": "decommission-params",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/decommission-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -10839,8 +17709,14 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10854,65 +17730,66 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/ignored-validations": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Fetch the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "v2GetIgnoredValidations",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/ignored-validations"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+      },
+      "put": {
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/import-cluster-params"
+              "$ref": "#/definitions/ignored-validations"
             }
           }
         ],
@@ -10920,7 +17797,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/ignored-validations"
             }
           },
           "400": {
@@ -10941,6 +17818,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10950,7 +17833,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
@@ -10959,50 +17842,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -11034,35 +17895,51 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Allow overriding the fields set by the service from the cluster, i.e. the networking and the platform, including the VIPs.",
+            "name": "allow_service_owned_fields",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -11087,12 +17964,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11100,37 +17971,48 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config/preview": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Previews the install config resulting from install config overrides, without applying them.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2PreviewClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose install config is previewed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "The install config overrides to preview.",
+            "name": "preview-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "$ref": "#/definitions/install-config-preview-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/install-config-preview"
             }
           },
           "400": {
@@ -11163,12 +18045,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11178,28 +18054,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
+    "/v2/clusters/{cluster_id}/installation-timeline": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation.",
         "tags": [
-          "installer"
+          "installation_timeline"
         ],
-        "operationId": "TransformClusterToAddingHosts",
+        "operationId": "v2GetInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose installation is reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -11220,13 +18105,75 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/installation-timeline/download": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the installation timeline of the cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installation_timeline"
+        ],
+        "operationId": "v2DownloadInstallationTimeline",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is reported.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "409": {
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "The format of the downloaded timeline. The CSV format has a row per stage.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -11241,29 +18188,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -11303,31 +18282,67 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
+      },
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11364,53 +18379,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/completion-params"
+              "$ref": "#/definitions/logs-progress-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11457,28 +18469,101 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/v2/clusters/{cluster_id}/logs/search": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service.",
         "tags": [
-          "installer"
+          "log_search"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "v2SearchClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster whose logs are searched.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 1024,
+            "minLength": 2,
+            "type": "string",
+            "description": "The text searched in the lines of the logs, case-insensitive.",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only search the logs of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "Only search the logs of this type.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only search the files whose path contains this text.",
+            "name": "file",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the lines whose time is at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the lines whose time is at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 10,
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of lines of the file returned before and after each matching line.",
+            "name": "context_lines",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of matching lines returned.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/log-search-result"
             }
           },
           "400": {
@@ -11505,18 +18590,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11526,28 +18599,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+    "/v2/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -11587,37 +18672,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11648,57 +18744,45 @@ func init() {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            }
           },
-          {
-            "agentAuth": []
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        }
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The credential file to be downloaded.",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -11706,10 +18790,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11746,57 +18827,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
+      },
+      "patch": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/update-manifest-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -11844,61 +18912,47 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
+        "description": "Downloads cluster manifest.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
@@ -11943,79 +18997,36 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
             "in": "query"
           }
         ],
@@ -12023,13 +19034,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -12056,12 +19061,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12069,71 +19068,46 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/hosts": {
-      "get": {
+      },
+      "put": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "master",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
-            "in": "query"
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12154,89 +19128,64 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
-      "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetIgnoredValidations",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/error"
             }
           },
-          "400": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/network-report": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation.",
         "tags": [
-          "installer"
+          "network_report"
         ],
-        "operationId": "v2SetIgnoredValidations",
+        "operationId": "v2GetNetworkReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster whose network is reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/network-report"
             }
           },
           "401": {
@@ -12266,7 +19215,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/network-report/download": {
       "get": {
         "security": [
           {
@@ -12277,26 +19226,40 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Downloads the network report of the cluster, to be attached to support cases.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "network_report"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2DownloadNetworkReport",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose network is reported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "html",
+              "json"
+            ],
+            "type": "string",
+            "default": "html",
+            "description": "The format of the downloaded report.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "type": "file"
             }
           },
           "401": {
@@ -12317,12 +19280,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12330,40 +19287,39 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -12399,62 +19355,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
-      "get": {
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "New progress value.",
+            "name": "finalizing-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-finalizing-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12480,80 +19414,56 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "tags": [
-          "installer"
-        ],
-        "operationId": "V2UploadLogs",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
+        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterSupportedPlatforms",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -12573,67 +19483,50 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/triage": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Lists the known failures found by the automatic triage of the logs uploaded for the cluster.",
         "tags": [
-          "installer"
+          "triage"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2ListClusterTriageFindings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose findings are listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/triage-finding"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -12653,67 +19546,107 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/manifests": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Lists manifests for customizing cluster installation.",
+      },
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster for which UI settings should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
-            "in": "query"
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -12734,18 +19667,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12753,43 +19674,48 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
       "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/create-manifest-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -12821,57 +19747,75 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the changes of the cluster, its hosts and its events as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "manifests"
+          "watch"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "v2WatchCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster to be watched.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after this revision. Only new changes are streamed if not set.",
+            "name": "revision",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -12891,16 +19835,62 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "409": {
-            "description": "Error.",
+          }
+        }
+      }
+    },
+    "/v2/component-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -12910,48 +19900,157 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/v2/events": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Updates a manifest for customizing cluster installation.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/update-manifest-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
             }
           },
           "401": {
@@ -12978,12 +20077,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12991,56 +20084,37 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/manifests/files": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "manifests"
-        ],
-        "operationId": "v2DownloadClusterManifest",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
+        "description": "Add new assisted installer event.",
+        "tags": [
+          "events"
+        ],
+        "operationId": "v2TriggerEvent",
+        "parameters": [
           {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13068,7 +20142,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13078,36 +20152,177 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/hardware-inventory": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Queries the hardware of the hosts of the tenant, as reported in their inventories.",
         "tags": [
-          "operators",
-          "installer"
+          "hardware_inventory"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2ListHostHardware",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "Only return the hosts of this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the hosts bound to this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the hosts bound to a cluster when true, or not bound to a cluster when false.",
+            "name": "bound",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Only return the hosts in one of these states.",
+            "name": "status",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "description": "Only return the hosts of this CPU architecture.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of CPU cores.",
+            "name": "min_cpu_cores",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at most this number of CPU cores.",
+            "name": "max_cpu_cores",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this physical memory, in MiB.",
+            "name": "min_memory_mib",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at most this physical memory, in MiB.",
+            "name": "max_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "nvme",
+                "ssd",
+                "hdd"
+              ],
+              "type": "string"
+            },
+            "description": "Only return the hosts with disks of all these types.",
+            "name": "disk_types",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of disks.",
+            "name": "min_disk_count",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with a disk of at least this size, in GB.",
+            "name": "min_disk_size_gb",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of network interfaces.",
+            "name": "min_nic_count",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with a network interface of at least this speed, in Mbps.",
+            "name": "min_nic_speed_mbps",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system manufacturer, case-sensitive.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system product name, case-sensitive.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the virtual hosts when true, or the physical hosts when false.",
+            "name": "virtual",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of hosts returned.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of matching hosts skipped before the returned ones.",
+            "name": "offset",
             "in": "query"
           }
         ],
@@ -13115,7 +20330,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/host-hardware-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13130,18 +20351,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13149,44 +20358,172 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/v2/hardware-inventory/export": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Exports the hardware of all the hosts of the tenant matching the filters.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators",
-          "installer"
+          "hardware_inventory"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2ExportHostHardware",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "Only return the hosts of this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the hosts bound to this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the hosts bound to a cluster when true, or not bound to a cluster when false.",
+            "name": "bound",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Only return the hosts in one of these states.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this CPU architecture.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of CPU cores.",
+            "name": "min_cpu_cores",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at most this number of CPU cores.",
+            "name": "max_cpu_cores",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this physical memory, in MiB.",
+            "name": "min_memory_mib",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at most this physical memory, in MiB.",
+            "name": "max_memory_mib",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "nvme",
+                "ssd",
+                "hdd"
+              ],
+              "type": "string"
+            },
+            "description": "Only return the hosts with disks of all these types.",
+            "name": "disk_types",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of disks.",
+            "name": "min_disk_count",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with a disk of at least this size, in GB.",
+            "name": "min_disk_size_gb",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with at least this number of network interfaces.",
+            "name": "min_nic_count",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Only return the hosts with a network interface of at least this speed, in Mbps.",
+            "name": "min_nic_speed_mbps",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system manufacturer, case-sensitive.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the hosts of this system product name, case-sensitive.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the virtual hosts when true, or the physical hosts when false.",
+            "name": "virtual",
+            "in": "query"
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "description": "The format of the exported file.",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13203,40 +20540,16 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/host-claims": {
       "get": {
         "security": [
           {
@@ -13247,26 +20560,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler.",
         "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetPreflightRequirements",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
+          "host_claims"
         ],
+        "operationId": "v2ListHostClaims",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/host-claim-list"
             }
           },
           "401": {
@@ -13281,18 +20584,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13302,40 +20593,52 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Update installation finalizing progress.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
-            "name": "cluster_id",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13361,6 +20664,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13376,7 +20685,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
@@ -13387,29 +20696,31 @@ func init() {
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -13424,8 +20735,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13435,96 +20746,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "V2GetClusterUISettings",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
           },
-          "404": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -13551,60 +20809,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/infra-envs/{infra_env_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "401": {
@@ -13637,223 +20911,39 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/component-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Lists events for a cluster.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The infra-env to be deleted.",
             "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ascending",
-              "descending"
-            ],
-            "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13867,51 +20957,69 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Add new assisted installer event.",
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/event"
+              "$ref": "#/definitions/infra-env-update-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "400": {
             "description": "Error.",
@@ -13944,7 +21052,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13960,17 +21068,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts": {
       "get": {
         "security": [
           {
@@ -13979,42 +21081,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the BMC hosts of the infra-env.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2ListBmcHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "description": "The infra-env of the BMC host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/bmc-host-list"
             }
           },
           "401": {
@@ -14035,26 +21123,72 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2CreateBmcHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "409": {
+          {
+            "description": "The address and the credentials of the BMC.",
+            "name": "new-bmc-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bmc-host-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14062,7 +21196,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}": {
       "get": {
         "security": [
           {
@@ -14073,31 +21207,34 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Retrieves a BMC host.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "v2GetBmcHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
-            "in": "query"
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/bmc-host"
             }
           },
           "401": {
@@ -14112,8 +21249,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14123,43 +21260,101 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a BMC host together with its credentials. The host itself isn't changed.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2DeleteBmcHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "501": {
-            "description": "Not implemented.",
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+      "patch": {
+        "description": "Updates the address, the credentials or the host of a BMC host.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2UpdateBmcHost",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "bmc-host-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/bmc-host-update-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/bmc-host"
             }
           },
           "400": {
@@ -14186,76 +21381,59 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Retrieves the details of the infra-env.",
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso": {
+      "post": {
+        "description": "Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2BmcHostBootIso",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the BMC host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ISO and whether to restart the host.",
+            "name": "boot-iso-params",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/bmc-boot-iso-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/bmc-host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -14276,8 +21454,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14287,40 +21465,41 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an infra-env.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media": {
+      "post": {
+        "description": "Ejects the virtual media of a BMC host.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "v2BmcHostEjectVirtualMedia",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
+            "description": "The infra-env of the BMC host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14340,62 +21519,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an infra-env.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power": {
+      "post": {
+        "description": "Powers a BMC host on or off, or restarts it.",
         "tags": [
-          "installer"
+          "bmc"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2BmcHostPowerAction",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
+            "description": "The infra-env of the BMC host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The power action.",
+            "name": "power-action-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "$ref": "#/definitions/bmc-power-action-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/bmc-host"
             }
           },
           "400": {
@@ -14422,26 +21593,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC.",
+        "tags": [
+          "bmc"
+        ],
+        "operationId": "v2GetBmcHostStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the BMC host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The BMC host.",
+            "name": "bmc_host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/bmc-host-status"
+            }
           },
-          "409": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15711,19 +22932,111 @@ func init() {
               "$ref": "#/definitions/error"
             }
           }
-        }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+        }
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2GetHostIgnitionPreview",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host whose ignition is previewed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15731,29 +23044,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host whose ignition is previewed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-ignition-preview"
             }
           },
           "401": {
@@ -15780,14 +23081,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16314,6 +23615,88 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the changes of the infra-env, its hosts and its events as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "watch"
+        ],
+        "operationId": "v2WatchInfraEnv",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be watched.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after this revision. Only new changes are streamed if not set.",
+            "name": "revision",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The revision of the last received change, sent by clients reconnecting to the stream. Takes precedence over the revision parameter.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/openshift-versions": {
       "get": {
         "security": [
@@ -16532,19 +23915,166 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "description": "Keys will be one of features-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "object",
+              "properties": {
+                "features": {
+                  "description": "Keys will be one of features-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/webhook-subscriptions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhook subscriptions of the tenant.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2ListWebhookSubscriptions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-subscription-list"
             }
           },
           "401": {
@@ -16559,36 +24089,42 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
+      },
+      "post": {
+        "description": "Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant.",
         "tags": [
-          "operators"
+          "webhooks"
+        ],
+        "operationId": "v2RegisterWebhookSubscription",
+        "parameters": [
+          {
+            "description": "The properties describing the new webhook subscription.",
+            "name": "new-webhook-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-subscription-create-params"
+            }
+          }
         ],
-        "operationId": "V2ListSupportedOperators",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/webhook-subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -16612,37 +24148,26 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Lists properties for an operator.",
+    "/v2/webhook-subscriptions/{subscription_id}": {
+      "delete": {
+        "description": "Deletes a webhook subscription together with its pending deliveries.",
         "tags": [
-          "operators"
+          "webhooks"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2DeregisterWebhookSubscription",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The webhook subscription to be deregistered.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/operator-properties"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16886,12 +24411,253 @@ func init() {
     },
     "bind-host-params": {
       "required": [
-        "cluster_id"
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "bmc-boot-iso-params": {
+      "type": "object",
+      "properties": {
+        "image_url": {
+          "description": "The URL of the ISO, the discovery ISO of the infra-env by default.",
+          "type": "string"
+        },
+        "reboot": {
+          "description": "Power the host on, or restart it when it is on, to boot the ISO.",
+          "type": "boolean",
+          "default": true
+        }
+      }
+    },
+    "bmc-host": {
+      "type": "object",
+      "required": [
+        "id",
+        "infra_env_id",
+        "address",
+        "username"
+      ],
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean"
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "last_action": {
+          "description": "The last action performed on the host through its BMC.",
+          "type": "string"
+        },
+        "last_action_at": {
+          "description": "The time the last action was performed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "last_action_error": {
+          "description": "The error returned by the BMC for the last action, empty when it succeeded.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-host-create-params": {
+      "type": "object",
+      "required": [
+        "address",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC, e.g. redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/1. The scheme is redfish, redfish-virtualmedia or idrac-virtualmedia, followed by +http for a BMC without TLS. The system is discovered when the address has no path.",
+          "type": "string"
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean",
+          "default": false
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC, once it is discovered.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string"
+        },
+        "password": {
+          "description": "The password of the user. It is never returned by the service.",
+          "type": "string",
+          "format": "password"
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string"
+        }
+      }
+    },
+    "bmc-host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bmc-host"
+      }
+    },
+    "bmc-host-status": {
+      "type": "object",
+      "required": [
+        "power_state"
+      ],
+      "properties": {
+        "boot_source_override_enabled": {
+          "description": "Whether the boot source override applies to the next boot only (Once), to every boot (Continuous) or isn't applied (Disabled).",
+          "type": "string"
+        },
+        "boot_source_override_target": {
+          "description": "The Redfish boot source override of the system, e.g. Cd or None.",
+          "type": "string"
+        },
+        "power_state": {
+          "description": "The Redfish power state of the system, e.g. On or Off.",
+          "type": "string"
+        },
+        "virtual_media_image": {
+          "description": "The image of the CD virtual media.",
+          "type": "string"
+        },
+        "virtual_media_inserted": {
+          "description": "Whether the image of the CD virtual media is inserted.",
+          "type": "boolean"
+        }
+      }
+    },
+    "bmc-host-update-params": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "The Redfish address of the BMC.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "disable_certificate_verification": {
+          "description": "Skip the verification of the certificate of the BMC.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "host_id": {
+          "description": "The host of the infra-env managed by the BMC. The empty UUID unlinks the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "A name identifying the host, e.g. its rack position.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "password": {
+          "description": "The password of the user. It is never returned by the service.",
+          "type": "string",
+          "format": "password",
+          "x-nullable": true
+        },
+        "username": {
+          "description": "The user authenticating to the BMC.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "bmc-power-action-params": {
+      "type": "object",
+      "required": [
+        "action"
       ],
       "properties": {
-        "cluster_id": {
+        "action": {
+          "description": "The power action. off shuts the host down gracefully, force-off cuts its power.",
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "on",
+            "off",
+            "force-off",
+            "restart",
+            "force-restart"
+          ]
         }
       }
     },
@@ -17011,6 +24777,11 @@ func init() {
             "type": "Time"
           }
         },
+        "custom_host_validations": {
+          "description": "Json containing the validations defined by the user that the hosts of the cluster must pass.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "deleted_at": {
           "description": "swagger:ignore",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
@@ -17061,6 +24832,16 @@ func init() {
           "x-go-custom-tag": "gorm:\"-\"",
           "x-nullable": true
         },
+        "host_role_rules": {
+          "description": "Json containing the rules assigning roles, installation disks and node labels to the hosts of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "host_stage_timeout_policies": {
+          "description": "Json containing the timeouts of the installation stages of the hosts and the remediations of the hosts stuck in a stage.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "hosts": {
           "description": "Hosts that are associated with this cluster.",
           "type": "array",
@@ -17102,6 +24883,11 @@ func init() {
           "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
           "$ref": "#/definitions/ignition-endpoint"
         },
+        "ignition_override_layers": {
+          "description": "Json containing the ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "ignored_cluster_validations": {
           "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
           "type": "string",
@@ -17309,6 +25095,10 @@ func init() {
           "format": "int64",
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "triage": {
+          "description": "The number of known failures found in the logs uploaded for the cluster, set by v2GetCluster only.",
+          "$ref": "#/definitions/triage-summary"
+        },
         "updated_at": {
           "description": "The last time that this cluster was updated.",
           "type": "string",
@@ -17403,6 +25193,13 @@ func init() {
           ],
           "x-nullable": false
         },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the cluster must pass.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          }
+        },
         "disk_encryption": {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "$ref": "#/definitions/disk-encryption"
@@ -17416,6 +25213,20 @@ func init() {
             "None"
           ]
         },
+        "host_role_rules": {
+          "description": "Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          }
+        },
+        "host_stage_timeout_policies": {
+          "description": "Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage-timeout-policy"
+          }
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -17441,6 +25252,13 @@ func init() {
           "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
           "$ref": "#/definitions/ignition-endpoint"
         },
+        "ignition_override_layers": {
+          "description": "Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-layer"
+          }
+        },
         "ingress_vips": {
           "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
           "type": "array",
@@ -17629,28 +25447,316 @@ func init() {
         "finalizing_stage": {
           "$ref": "#/definitions/finalizing-stage"
         },
-        "finalizing_stage_percentage": {
-          "type": "integer"
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "spec"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "spec"
+      ],
+      "properties": {
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string",
+          "maxLength": 128,
+          "minLength": 1
+        },
+        "spec": {
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
+    },
+    "cluster-template-instance": {
+      "type": "object",
+      "required": [
+        "cluster",
+        "infra_env"
+      ],
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/cluster"
+        },
+        "infra_env": {
+          "$ref": "#/definitions/infra-env"
+        }
+      }
+    },
+    "cluster-template-instantiate-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "api_vips": {
+          "description": "The virtual IPs used to reach the API of the new cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the new cluster, the one of the template is used when empty.",
+          "type": "string"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for the ingress traffic of the new cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "name": {
+          "description": "Name of the new OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-template-spec": {
+      "description": "The definition of the clusters created from a template.",
+      "type": "object",
+      "required": [
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Default base domain of the clusters, it can be overridden for each cluster.",
+          "type": "string"
+        },
+        "cluster_networks": {
+          "description": "Cluster networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the clusters (x86_64/arm64/etc).",
+          "type": "string",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ]
+        },
+        "custom_manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed clusters.",
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ],
+          "x-nullable": true
+        },
+        "host_role_rules": {
+          "description": "Rules assigning roles to the hosts of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          }
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "machine_networks": {
+          "description": "Machine networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift clusters.",
+          "type": "string"
         },
-        "finalizing_stage_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
         },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters.",
+          "type": "boolean",
+          "x-nullable": true
         },
-        "installing_stage_percentage": {
-          "type": "integer"
+        "service_networks": {
+          "description": "Service networks of the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
         },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
         },
-        "total_percentage": {
-          "type": "integer"
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+    },
+    "cluster-template-update-params": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Free-form description of the cluster template.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "spec": {
+          "x-nullable": true,
+          "$ref": "#/definitions/cluster-template-spec"
+        }
+      }
     },
     "cluster-validation-id": {
       "type": "string",
@@ -17678,7 +25784,9 @@ func init() {
         "lvm-requirements-satisfied",
         "mce-requirements-satisfied",
         "network-type-valid",
-        "platform-requirements-satisfied"
+        "platform-requirements-satisfied",
+        "sufficient-path-mtu",
+        "operator-plugins-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -17984,6 +26092,49 @@ func init() {
         }
       }
     },
+    "custom-host-validation": {
+      "description": "A validation of the hosts defined by the user, evaluated over the inventory of the hosts. A failing custom validation prevents the installation like the built-in validations do.",
+      "type": "object",
+      "required": [
+        "id",
+        "language",
+        "expression"
+      ],
+      "properties": {
+        "description": {
+          "description": "A description of the requirement, reported when the validation fails.",
+          "type": "string"
+        },
+        "expression": {
+          "description": "The expression evaluated over the inventory of the hosts, e.g. 'inventory.cpu.count \u003e= 16' or '{.interfaces[?(@.vendor==\"0x8086\")].name}'.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the validation, reported in the validations info of the hosts in the custom category. It must start with custom-, so that it can't be taken for a built-in validation.",
+          "type": "string",
+          "maxLength": 63,
+          "pattern": "^custom-[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "language": {
+          "description": "The language of the expression. A CEL expression evaluates to a boolean over the 'inventory' and 'role' variables. A JSONPath expression selects values of the inventory and succeeds when it selects at least one value and none of the selected values is false.",
+          "type": "string",
+          "enum": [
+            "cel",
+            "jsonpath"
+          ]
+        }
+      }
+    },
+    "decommission-params": {
+      "type": "object",
+      "properties": {
+        "wipe_disks": {
+          "description": "Wipe the disks of the host once its node is removed. The disks are wiped by the agent of the host, which must be running, e.g. by booting the host with the discovery image.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -18119,476 +26270,878 @@ func init() {
         "smart": {
           "type": "string"
         },
-        "vendor": {
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "all",
+            "masters",
+            "workers"
+          ]
+        },
+        "mode": {
+          "description": "The disk encryption mode to use.",
+          "type": "string",
+          "default": "tpmv2",
+          "enum": [
+            "tpmv2",
+            "tang"
+          ]
+        },
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
+      "required": [
+        "disk_id",
+        "skip_formatting"
+      ],
+      "properties": {
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+          "type": "string"
+        },
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
+        },
+        "speed_ms": {
+          "type": "integer"
+        },
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "x-go-type": {
+              "import": {
+                "path": "github.com/openshift/assisted-service/models"
+              },
+              "type": "DomainResolutionRequestDomain"
+            }
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DomainResolutionResponseResolutionsItems0"
+          }
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
+        },
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
+        },
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
           "type": "string"
         },
-        "wwn": {
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
           "type": "string"
         }
       }
     },
-    "disk-config-params": {
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM",
+        "RAID",
+        "ECKD",
+        "ECKD (ESE)",
+        "FBA"
+      ]
+    },
+    "dry-run-generate-failure": {
       "type": "object",
       "required": [
-        "id"
+        "stage",
+        "message"
       ],
       "properties": {
-        "id": {
+        "file_name": {
+          "description": "The manifest which failed validation, for the custom-manifests stage.",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
+        "message": {
+          "description": "The reason of the failure.",
+          "type": "string"
+        },
+        "stage": {
+          "$ref": "#/definitions/dry-run-generate-stage"
         }
       }
     },
-    "disk-encryption": {
+    "dry-run-generate-report": {
       "type": "object",
+      "required": [
+        "cluster_id",
+        "storage_prefix",
+        "status"
+      ],
       "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
+        "cluster_id": {
           "type": "string",
-          "default": "none",
-          "enum": [
-            "none",
-            "all",
-            "masters",
-            "workers"
-          ]
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "mode": {
-          "description": "The disk encryption mode to use.",
+        "completed_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dry-run-generate-failure"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "objects": {
+          "description": "The generated files, relative to the storage prefix.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "description": "The status of the dry run. An interrupted dry run didn't complete, for example because the service restarted, and can be started again.",
           "type": "string",
-          "default": "tpmv2",
           "enum": [
-            "tpmv2",
-            "tang"
+            "running",
+            "completed",
+            "interrupted"
           ]
         },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+        "status_info": {
+          "description": "The reason why the dry run was interrupted.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "storage_prefix": {
+          "description": "The storage prefix under which the generated files are stored.",
+          "type": "string"
+        },
+        "succeeded": {
+          "description": "Whether every stage of the generation succeeded, once the dry run is completed.",
+          "type": "boolean"
+        },
+        "synthetic_hosts_count": {
+          "description": "The number of synthetic hosts which completed the hosts of the cluster.",
+          "type": "integer"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+      }
     },
-    "disk-role": {
+    "dry-run-generate-stage": {
+      "description": "A step of the installation files generation.",
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "custom-manifests",
+        "additional-manifests",
+        "install-config",
+        "ignition"
       ]
     },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+    "error": {
       "type": "object",
       "required": [
-        "disk_id",
-        "skip_formatting"
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
       ],
       "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
           "type": "string"
         },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
-        }
-      }
-    },
-    "disk_info": {
-      "type": "object",
-      "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
+        "href": {
+          "description": "Self link.",
+          "type": "string"
         },
         "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "Error"
+          ]
         },
-        "path": {
+        "reason": {
+          "description": "Human-readable description of the error.",
           "type": "string"
         }
       }
     },
-    "disk_speed": {
+    "event": {
       "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
       "properties": {
-        "exit_code": {
-          "type": "integer"
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "speed_ms": {
-          "type": "integer"
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "tested": {
-          "type": "boolean"
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "name": {
+          "description": "Event Name.",
+          "type": "string"
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
         }
       }
     },
-    "disk_speed_check_request": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
-          "type": "string"
-        }
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "feature-support-level-id": {
+      "type": "string",
+      "enum": [
+        "SNO",
+        "VIP_AUTO_ALLOC",
+        "CUSTOM_MANIFEST",
+        "SINGLE_NODE_EXPANSION",
+        "LVM",
+        "ODF",
+        "LSO",
+        "CNV",
+        "MCE",
+        "NUTANIX_INTEGRATION",
+        "BAREMETAL_PLATFORM",
+        "NONE_PLATFORM",
+        "VSPHERE_INTEGRATION",
+        "DUAL_STACK_VIPS",
+        "CLUSTER_MANAGED_NETWORKING",
+        "USER_MANAGED_NETWORKING",
+        "MINIMAL_ISO",
+        "FULL_ISO",
+        "EXTERNAL_PLATFORM_OCI",
+        "DUAL_STACK",
+        "PLATFORM_MANAGED_NETWORKING",
+        "SKIP_MCO_REBOOT",
+        "EXTERNAL_PLATFORM",
+        "OVN_NETWORK_TYPE",
+        "SDN_NETWORK_TYPE"
+      ]
+    },
+    "finalizing-stage": {
+      "description": "Cluster finalizing stage managed by controller",
+      "type": "string",
+      "enum": [
+        "Waiting for cluster operators",
+        "Adding router ca",
+        "Applying olm manifests",
+        "Waiting for olm operators csv initialization",
+        "Waiting for olm operators csv",
+        "Done"
+      ]
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
       }
     },
-    "disk_speed_check_response": {
-      "type": "object",
-      "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
-        },
-        "path": {
-          "description": "The device path.",
-          "type": "string"
-        }
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
       }
     },
-    "domain_resolution_request": {
+    "free_network_addresses": {
       "type": "object",
-      "required": [
-        "domains"
-      ],
       "properties": {
-        "domains": {
+        "free_addresses": {
           "type": "array",
           "items": {
-            "type": "object",
-            "x-go-type": {
-              "import": {
-                "path": "github.com/openshift/assisted-service/models"
-              },
-              "type": "DomainResolutionRequestDomain"
-            }
+            "type": "string",
+            "format": "ipv4"
           }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/DomainResolutionResponseResolutionsItems0"
-          }
-        }
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
       }
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+    "gpu": {
       "type": "object",
-      "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
-      ],
       "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
           "type": "string"
         },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
           "type": "string"
         },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
           "type": "string"
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
+        },
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
           "type": "string"
         }
       }
     },
-    "drive_type": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM",
-        "RAID",
-        "ECKD",
-        "ECKD (ESE)",
-        "FBA"
-      ]
-    },
-    "error": {
+    "host": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "code",
-        "reason"
+        "status",
+        "status_info"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
+          "x-nullable": true
+        },
+        "connection_timed_out": {
+          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
+          "type": "boolean"
+        },
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "decommission_info": {
+          "description": "Additional information about the decommission stage of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "decommission_stage": {
+          "description": "The stage of the decommission of the host, or empty when the host isn't decommissioned.",
+          "type": "string",
+          "enum": [
+            "draining",
+            "deleting-node",
+            "wiping-disks",
+            "completed",
+            "failed"
+          ]
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "discovery_agent_version": {
           "type": "string"
         },
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
         },
         "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+          "type": "boolean"
+        },
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        },
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
+        },
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
+        },
+        "installer_args": {
+          "type": "string"
+        },
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
+        },
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
           "enum": [
-            "Error"
+            "Host",
+            "AddToExistingClusterHost"
           ]
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
-        }
-      }
-    },
-    "event": {
-      "type": "object",
-      "required": [
-        "severity",
-        "message",
-        "event_time"
-      ],
-      "properties": {
-        "category": {
+        "logs_collected_at": {
           "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
         },
-        "event_time": {
+        "logs_started_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "machine_config_pool_name": {
+          "type": "string"
+        },
+        "media_status": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
           "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
+        "mtu_path_report": {
+          "description": "Contains a serialized mtu_path_check_response",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "node_labels": {
+          "description": "Json containing node's labels.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
           "x-nullable": true
         },
-        "message": {
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "Event Name.",
+        "requested_hostname": {
           "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "format": "uuid"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "severity": {
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
           "type": "string",
           "enum": [
-            "info",
-            "warning",
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
             "error",
-            "critical"
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
           ]
-        }
-      }
-    },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "feature-support-level-id": {
-      "type": "string",
-      "enum": [
-        "SNO",
-        "VIP_AUTO_ALLOC",
-        "CUSTOM_MANIFEST",
-        "SINGLE_NODE_EXPANSION",
-        "LVM",
-        "ODF",
-        "LSO",
-        "CNV",
-        "MCE",
-        "NUTANIX_INTEGRATION",
-        "BAREMETAL_PLATFORM",
-        "NONE_PLATFORM",
-        "VSPHERE_INTEGRATION",
-        "DUAL_STACK_VIPS",
-        "CLUSTER_MANAGED_NETWORKING",
-        "USER_MANAGED_NETWORKING",
-        "MINIMAL_ISO",
-        "FULL_ISO",
-        "EXTERNAL_PLATFORM_OCI",
-        "DUAL_STACK",
-        "PLATFORM_MANAGED_NETWORKING",
-        "SKIP_MCO_REBOOT",
-        "EXTERNAL_PLATFORM",
-        "OVN_NETWORK_TYPE",
-        "SDN_NETWORK_TYPE"
-      ]
-    },
-    "finalizing-stage": {
-      "description": "Cluster finalizing stage managed by controller",
-      "type": "string",
-      "enum": [
-        "Waiting for cluster operators",
-        "Adding router ca",
-        "Applying olm manifests",
-        "Waiting for olm operators csv initialization",
-        "Waiting for olm operators csv",
-        "Done"
-      ]
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
-      "type": "object",
-      "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
         },
-        "network": {
+        "status_info": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        }
-      }
-    },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
-      "type": "object",
-      "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
-          "type": "string"
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
-          "type": "string"
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "tang_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
+          "type": "integer"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+        "user_name": {
           "type": "string"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
-          "type": "string"
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "host": {
+    "host-claim": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "cluster_id",
+        "spec",
+        "status"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
+        "bound_masters": {
+          "description": "The number of masters of the cluster.",
+          "type": "integer"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "bound_workers": {
+          "description": "The number of workers of the cluster.",
+          "type": "integer"
         },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "The cluster the hosts are bound to.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
-          "x-nullable": true
-        },
-        "connection_timed_out": {
-          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
-          "type": "boolean"
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
         "created_at": {
           "type": "string",
@@ -18604,267 +27157,243 @@ func init() {
             "type": "Time"
           }
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "scheduled_at": {
+          "description": "The last time the scheduler served the claim.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "gorm.io/gorm"
+              "package": "time"
             },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
-        },
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+            "type": "Time"
+          }
         },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "spec": {
+          "$ref": "#/definitions/host-claim-spec"
         },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
+        "status": {
+          "description": "pending while the cluster misses hosts, satisfied when it has all the requested hosts.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "enum": [
+            "pending",
+            "satisfied",
+            "paused"
+          ]
         },
-        "free_addresses": {
+        "status_info": {
+          "description": "The reason the claim is pending.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "updated_at": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+        "user_name": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
-          "type": "boolean"
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "host-claim-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-claim"
+      }
+    },
+    "host-claim-role": {
+      "description": "The number of hosts of a role requested by a host claim and the hardware they must have.",
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of hosts of the role the cluster needs. The hosts of the cluster which already have the role are counted.",
+          "type": "integer",
+          "minimum": 0
         },
-        "images_status": {
-          "description": "Array of image statuses.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "selector": {
+          "$ref": "#/definitions/host-selector"
+        }
+      }
+    },
+    "host-claim-spec": {
+      "description": "The hosts a cluster needs and the infra-envs they are taken from.",
+      "type": "object",
+      "required": [
+        "infra_env_ids"
+      ],
+      "properties": {
+        "infra_env_ids": {
+          "description": "The infra-envs whose unbound hosts can be bound to the cluster.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         },
-        "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        "masters": {
+          "$ref": "#/definitions/host-claim-role"
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
-          "type": "string"
+        "paused": {
+          "description": "No host is bound by the claim while it is paused. The reserved hosts stay reserved.",
+          "type": "boolean",
+          "default": false
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
-          "type": "string",
-          "example": "/dev/sda"
+        "priority": {
+          "description": "The claims with a higher priority are served first. The claims with the same priority are served in the order they were created.",
+          "type": "integer",
+          "default": 0
         },
-        "installer_args": {
-          "type": "string"
+        "reserved_host_ids": {
+          "description": "Hosts reserved for the cluster. They are only bound by this claim, and are bound before the other hosts matching the selectors.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "workers": {
+          "$ref": "#/definitions/host-claim-role"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
-        },
-        "logs_collected_at": {
+        "host_id": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
-        },
-        "logs_started_at": {
+          "format": "uuid"
+        }
+      }
+    },
+    "host-hardware": {
+      "description": "The hardware of a host, extracted from its inventory.",
+      "type": "object",
+      "required": [
+        "host_id",
+        "infra_env_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the host is bound to.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid",
+          "x-nullable": true
         },
-        "machine_config_pool_name": {
+        "cpu_architecture": {
           "type": "string"
         },
-        "media_status": {
-          "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
-          "x-nullable": true
-        },
-        "node_labels": {
-          "description": "Json containing node's labels.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "cpu_cores": {
+          "type": "integer"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "cpu_model": {
+          "type": "string"
         },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+        "disk_count": {
+          "type": "integer"
         },
-        "progress_stages": {
+        "disk_types": {
+          "description": "The types of the disks of the host.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+            "type": "string",
+            "enum": [
+              "nvme",
+              "ssd",
+              "hdd"
+            ]
+          }
+        },
+        "disks_total_bytes": {
+          "type": "integer"
         },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
+        "host_id": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "requested_hostname": {
+        "hostname": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+        "infra_env_id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "largest_disk_bytes": {
+          "type": "integer"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "max_nic_speed_mbps": {
+          "type": "integer"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
-          ]
+        "memory_bytes": {
+          "description": "The physical memory of the host.",
+          "type": "integer"
         },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "nic_count": {
+          "type": "integer"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "product": {
+          "description": "The product name of the system.",
+          "type": "string"
         },
-        "suggested_role": {
+        "role": {
           "$ref": "#/definitions/host-role"
         },
-        "tang_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
-        },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "status": {
+          "type": "string"
         },
-        "user_name": {
+        "vendor": {
+          "description": "The manufacturer of the system.",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "virtual": {
+          "type": "boolean"
         }
       }
     },
-    "host-create-params": {
+    "host-hardware-list": {
       "type": "object",
       "required": [
-        "host_id"
+        "total",
+        "hosts"
       ],
       "properties": {
-        "discovery_agent_version": {
-          "type": "string"
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-hardware"
+          }
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "total": {
+          "description": "The number of hosts matching the filters.",
+          "type": "integer"
         }
       }
     },
@@ -18875,6 +27404,58 @@ func init() {
         }
       }
     },
+    "host-ignition-preview": {
+      "type": "object",
+      "required": [
+        "ignition",
+        "ignition_type",
+        "base"
+      ],
+      "properties": {
+        "base": {
+          "description": "The ignition the layers are applied to. The ignition generated for the role of the host, the ignition template of the hosts added to an installed cluster, or none when the ignition of the cluster wasn't generated yet.",
+          "type": "string",
+          "enum": [
+            "generated",
+            "template",
+            "none"
+          ]
+        },
+        "files": {
+          "description": "The files of the ignition and the layer which set them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-provenance"
+          }
+        },
+        "ignition": {
+          "description": "The rendered ignition of the host, in JSON.",
+          "type": "string"
+        },
+        "ignition_type": {
+          "description": "The pointer ignition of a host installed with its cluster, merging the configuration served by the machine config server, or the full ignition of a host added to an installed cluster.",
+          "type": "string",
+          "enum": [
+            "pointer",
+            "full"
+          ]
+        },
+        "layers": {
+          "description": "The layers applied in order, e.g. cluster, role/worker, machine-pool/infra and host.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "units": {
+          "description": "The systemd units of the ignition and the layer which set them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-provenance"
+          }
+        }
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -18906,6 +27487,19 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
+        "stage_remediation": {
+          "$ref": "#/definitions/host-stage-remediation"
+        },
+        "stage_remediation_at": {
+          "description": "Time at which the remediation of the timeout policy of the current stage was last applied.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_remediation_attempts": {
+          "description": "The number of times the remediation of the timeout policy of the current stage was applied.",
+          "type": "integer"
+        },
         "stage_started_at": {
           "description": "Time at which the current progress stage started.",
           "type": "string",
@@ -18934,6 +27528,74 @@ func init() {
         "bootstrap"
       ]
     },
+    "host-role-rule": {
+      "description": "Assigns a role, an installation disk and node labels to the hosts matching all the criteria of the rule. The rules only apply to hosts whose role is auto-assign.",
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "bmc_address": {
+          "description": "The BMC address of the matching hosts.",
+          "type": "string"
+        },
+        "hostname_pattern": {
+          "description": "A regular expression the hostname of the matching hosts must match.",
+          "type": "string"
+        },
+        "installation_disk_pattern": {
+          "description": "A regular expression matched against the path, the by-id and the by-path names of the disks of the matching hosts. The first eligible disk that matches becomes the installation disk.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The matching hosts must have an interface with one of these MAC addresses.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"
+          }
+        },
+        "min_cpu_cores": {
+          "description": "The minimal number of CPU cores of the matching hosts.",
+          "type": "integer"
+        },
+        "min_disk_size_gb": {
+          "description": "The matching hosts must have a disk eligible for installation of at least this size, in GB.",
+          "type": "integer"
+        },
+        "min_memory_mib": {
+          "description": "The minimal physical memory of the matching hosts, in MiB.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "A name identifying the rule.",
+          "type": "string"
+        },
+        "nic_vendor": {
+          "description": "The matching hosts must have an interface of this vendor.",
+          "type": "string"
+        },
+        "node_labels": {
+          "description": "Labels added to the nodes of the matching hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          }
+        },
+        "role": {
+          "description": "The role assigned to the matching hosts.",
+          "type": "string",
+          "enum": [
+            "master",
+            "worker"
+          ]
+        },
+        "serial_number": {
+          "description": "The serial number of the matching hosts.",
+          "type": "string"
+        }
+      }
+    },
     "host-role-update-params": {
       "type": "string",
       "enum": [
@@ -18942,6 +27604,81 @@ func init() {
         "worker"
       ]
     },
+    "host-selector": {
+      "description": "Selects the hosts by their hardware. A host matches the selector when it meets all of its criteria. A selector without criteria matches every host.",
+      "type": "object",
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts.",
+          "type": "string"
+        },
+        "disk_types": {
+          "description": "The hosts have disks of all these types.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "nvme",
+              "ssd",
+              "hdd"
+            ]
+          }
+        },
+        "max_cpu_cores": {
+          "description": "The maximal number of CPU cores.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "max_memory_mib": {
+          "description": "The maximal physical memory in MiB.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_cpu_cores": {
+          "description": "The minimal number of CPU cores.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_disk_count": {
+          "description": "The minimal number of disks.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_disk_size_gb": {
+          "description": "The minimal size in GB of the largest disk.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_memory_mib": {
+          "description": "The minimal physical memory in MiB.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_nic_count": {
+          "description": "The minimal number of physical network interfaces.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_nic_speed_mbps": {
+          "description": "The minimal speed in Mbps of the fastest physical network interface.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "product": {
+          "description": "The product name of the hosts.",
+          "type": "string"
+        },
+        "vendor": {
+          "description": "The manufacturer of the hosts.",
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the hosts are virtual machines.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
     "host-stage": {
       "type": "string",
       "enum": [
@@ -18959,6 +27696,43 @@ func init() {
         "Failed"
       ]
     },
+    "host-stage-remediation": {
+      "description": "The remediation of a host stuck in an installation stage. fail moves the host to error. retry restarts the timeout of the stage so that the host can retry the step. reboot reboots the host via its BMC, only for the hosts managed by a BareMetalHost, and fails the other hosts. pause moves the host to installing-pending-user-action until the host reports progress again.",
+      "type": "string",
+      "enum": [
+        "fail",
+        "retry",
+        "reboot",
+        "pause"
+      ]
+    },
+    "host-stage-timeout-policy": {
+      "description": "The timeout of an installation stage of the hosts and the remediation applied to the hosts that stay in the stage longer than the timeout.",
+      "type": "object",
+      "required": [
+        "stage",
+        "remediation"
+      ],
+      "properties": {
+        "max_attempts": {
+          "description": "The number of times the retry and reboot remediations are applied to a host in the stage. The host fails when the stage times out again after the last attempt.",
+          "type": "integer",
+          "default": 1,
+          "minimum": 1
+        },
+        "remediation": {
+          "$ref": "#/definitions/host-stage-remediation"
+        },
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "timeout_seconds": {
+          "description": "The timeout of the stage, in seconds. The timeout configured in the service for the stage applies when it is not set.",
+          "type": "integer",
+          "minimum": 60
+        }
+      }
+    },
     "host-type-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -19089,7 +27863,9 @@ func init() {
         "compatible-agent",
         "no-skip-installation-disk",
         "no-skip-missing-disk",
-        "no-ip-collisions-in-network"
+        "no-ip-collisions-in-network",
+        "sufficient-path-mtu",
+        "operator-plugins-requirements-satisfied"
       ]
     },
     "host_network": {
@@ -19184,6 +27960,49 @@ func init() {
         }
       }
     },
+    "ignition-override-layer": {
+      "type": "object",
+      "required": [
+        "scope",
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "The ignition config merged into the ignition of the hosts, in JSON.",
+          "type": "string"
+        },
+        "scope": {
+          "description": "The hosts whose ignition the layer applies to. The layers are applied in the order cluster, role, machine-pool, followed by the ignition config overrides of the host.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "role",
+            "machine-pool"
+          ]
+        },
+        "target": {
+          "description": "The role of the hosts (master or worker) for a role layer, the machine config pool of the hosts for a machine-pool layer. Unset for a cluster layer.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-provenance": {
+      "type": "object",
+      "required": [
+        "name",
+        "layer"
+      ],
+      "properties": {
+        "layer": {
+          "description": "The layer which set the file or the unit last, base for the ignition the layers are applied to.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The path of the file or the name of the systemd unit.",
+          "type": "string"
+        }
+      }
+    },
     "ignored-validations": {
       "type": "object",
       "properties": {
@@ -19353,6 +28172,11 @@ func init() {
             "type": "Time"
           }
         },
+        "custom_host_validations": {
+          "description": "Json containing the validations defined by the user that the hosts of the infra-env must pass.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "download_url": {
           "type": "string"
         },
@@ -19485,6 +28309,13 @@ func init() {
           ],
           "x-nullable": false
         },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the infra-env must pass.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          }
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -19543,6 +28374,14 @@ func init() {
           "maxLength": 65535,
           "x-nullable": true
         },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the infra-env must pass. An empty list deletes the validations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          },
+          "x-nullable": true
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -19616,6 +28455,47 @@ func init() {
         }
       }
     },
+    "install-config-preview": {
+      "type": "object",
+      "required": [
+        "install_config",
+        "diff"
+      ],
+      "properties": {
+        "changed_fields": {
+          "description": "The paths of the fields changed by the overrides, e.g. networking.clusterNetwork[0].hostPrefix.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "diff": {
+          "description": "The unified diff of the install config generated from the cluster without overrides to the install config with the overrides. Empty when the overrides don't change the install config.",
+          "type": "string"
+        },
+        "install_config": {
+          "description": "The install config of the cluster with the overrides applied, in YAML.",
+          "type": "string"
+        },
+        "service_owned_fields": {
+          "description": "The paths of the overridden fields which are set by the service from the cluster. Applying the overrides requires allow_service_owned_fields.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "install-config-preview-params": {
+      "type": "object",
+      "properties": {
+        "install_config_params": {
+          "description": "The install config overrides, in JSON. The overrides of the cluster are previewed when unset.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
     "install_cmd_request": {
       "type": "object",
       "required": [
@@ -19721,6 +28601,183 @@ func init() {
         }
       }
     },
+    "installation-timeline": {
+      "type": "object",
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "critical_path": {
+          "description": "The stages which determined the duration of the installation, in chronological order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
+        },
+        "duration_seconds": {
+          "description": "The duration of the installation, up to now while the installation is in progress.",
+          "type": "number",
+          "format": "double"
+        },
+        "finalizing_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        },
+        "generated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-host"
+          }
+        },
+        "install_completed_at": {
+          "description": "The end of the installation, empty while the installation is in progress.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "install_started_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "operators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-operator"
+          }
+        }
+      }
+    },
+    "installation-timeline-host": {
+      "type": "object",
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        }
+      }
+    },
+    "installation-timeline-operator": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "type": "number",
+          "format": "double"
+        },
+        "ended_at": {
+          "description": "The time the operator became available, empty while it isn't available.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "started_at": {
+          "description": "The start of the finalizing stage waiting for the operator.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "timeout_exceeded": {
+          "type": "boolean"
+        },
+        "timeout_seconds": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "installation-timeline-segment": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "type": "number",
+          "format": "double"
+        },
+        "ended_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "host",
+            "finalizing",
+            "operator"
+          ]
+        },
+        "stage": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "subject": {
+          "description": "The hostname of the host, the name of the operator, or the cluster for the finalizing stages.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-timeline-stage": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the stage, up to now for the stage in progress.",
+          "type": "number",
+          "format": "double"
+        },
+        "ended_at": {
+          "description": "The end of the stage, empty for the stage in progress.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "timeout_exceeded": {
+          "description": "Whether the stage lasted longer than its timeout.",
+          "type": "boolean"
+        },
+        "timeout_seconds": {
+          "description": "The configured timeout of the stage, 0 when the stage has no timeout.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -19976,11 +29033,79 @@ func init() {
     "list-versions": {
       "type": "object",
       "properties": {
-        "release_tag": {
-          "type": "string"
+        "release_tag": {
+          "type": "string"
+        },
+        "versions": {
+          "$ref": "#/definitions/versions"
+        }
+      }
+    },
+    "log-search-match": {
+      "type": "object",
+      "properties": {
+        "context_after": {
+          "description": "The lines of the file following the matching line.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "context_before": {
+          "description": "The lines of the file preceding the matching line.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "file": {
+          "description": "The path of the file in the uploaded logs.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "The host which uploaded the logs. Unset for the logs of the controller.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_name": {
+          "description": "The name of the host which uploaded the logs.",
+          "type": "string"
+        },
+        "line": {
+          "description": "The number of the line in the file, starting at 1.",
+          "type": "integer"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "text": {
+          "description": "The matching line.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "The time of the line, or of the closest preceding line of the file with a time. Unset when the file has no time.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "log-search-result": {
+      "type": "object",
+      "required": [
+        "matches"
+      ],
+      "properties": {
+        "matches": {
+          "description": "The matching lines, ordered by host, file and line.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/log-search-match"
+          }
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "truncated": {
+          "description": "Whether more lines match the search than the returned ones.",
+          "type": "boolean"
         }
       }
     },
@@ -20092,7 +29217,8 @@ func init() {
         "provider": {
           "type": "string",
           "enum": [
-            "route53"
+            "route53",
+            "zonefile"
           ]
         }
       }
@@ -20166,36 +29292,345 @@ func init() {
         "status": {
           "$ref": "#/definitions/operator-status"
         },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
+          "type": "string"
+        },
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Operator version",
+          "type": "string"
+        }
+      }
+    },
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "mtu_path_check_remote_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_addresses"
+      ],
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_addresses": {
+          "description": "The addresses of the host in the machine networks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "mtu_path_check_request": {
+      "type": "object",
+      "required": [
+        "remote_hosts"
+      ],
+      "properties": {
+        "remote_hosts": {
+          "description": "The hosts whose path MTU is probed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu_path_check_remote_host"
+          }
+        }
+      }
+    },
+    "mtu_path_check_response": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu_path_result"
+          }
+        }
+      }
+    },
+    "mtu_path_result": {
+      "description": "The largest packet sent without fragmentation to an address of a remote host.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "path_mtu": {
+          "description": "The path MTU in bytes, 0 when the remote address couldn't be reached.",
+          "type": "integer"
+        },
+        "remote_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        }
+      }
+    },
+    "network-report": {
+      "type": "object",
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "generated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-host"
+          }
+        },
+        "ip_collisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-ip-collision"
+          }
+        },
+        "links": {
+          "description": "The connectivity from each host to each of the other hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-link"
+          }
+        },
+        "majority_groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-majority-group"
+          }
+        },
+        "networks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-network"
+          }
+        },
+        "vips": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-vip"
+          }
+        }
+      }
+    },
+    "network-report-host": {
+      "type": "object",
+      "properties": {
+        "connectivity_reported": {
+          "description": "Whether the host reported the results of its connectivity checks.",
+          "type": "boolean"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-report-interface"
+          }
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
+    "network-report-interface": {
+      "type": "object",
+      "properties": {
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "speed_mbps": {
+          "type": "integer"
+        }
+      }
+    },
+    "network-report-ip-collision": {
+      "type": "object",
+      "properties": {
+        "ip_address": {
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The MAC addresses answering for the IP address.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "network-report-link": {
+      "type": "object",
+      "properties": {
+        "average_rtt_ms": {
+          "description": "The highest average round trip time to the addresses of the remote host, in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l2_reachable": {
+          "description": "Whether an address of the remote host was reached by ARP.",
+          "type": "boolean"
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "l3_reachable": {
+          "description": "Whether an address of the remote host was reached by ping.",
+          "type": "boolean"
+        },
+        "latency_threshold_exceeded": {
+          "description": "Whether the round trip time exceeds the threshold of the role of the hosts.",
+          "type": "boolean"
+        },
+        "packet_loss_percentage": {
+          "description": "The highest packet loss to the addresses of the remote host.",
+          "type": "number",
+          "format": "double"
+        },
+        "packet_loss_threshold_exceeded": {
+          "description": "Whether the packet loss exceeds the threshold of the role of the hosts.",
+          "type": "boolean"
+        },
+        "remote_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "network-report-majority-group": {
+      "type": "object",
+      "properties": {
+        "host_ids": {
+          "description": "The hosts connected to each other in the network.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "network": {
+          "description": "The CIDR of the L2 group, or the address family (IPv4, IPv6) of the L3 group.",
+          "type": "string"
+        }
+      }
+    },
+    "network-report-network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "$ref": "#/definitions/subnet"
+        },
+        "host_ids": {
+          "description": "The hosts having an address in the network.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "mtu_mismatch": {
+          "description": "Whether the interfaces of the hosts in the network have different MTUs.",
+          "type": "boolean"
         },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "mtus": {
+          "description": "The distinct MTUs of the interfaces of the hosts in the network.",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
+    "network-report-vip": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "$ref": "#/definitions/ip"
         },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
+        "message": {
+          "description": "The reason why the VIP isn't available.",
           "type": "string"
         },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+        "type": {
+          "type": "string",
+          "enum": [
+            "api",
+            "ingress"
+          ]
         },
-        "version": {
-          "description": "Operator version",
-          "type": "string"
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
         }
       }
     },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
     "next_step_cmd_request": {
       "type": "object",
       "required": [
@@ -20839,7 +30274,9 @@ func init() {
         "upgrade-agent",
         "download-boot-artifacts",
         "reboot-for-reclaim",
-        "verify-vips"
+        "verify-vips",
+        "mtu-path-check",
+        "wipe-disks"
       ]
     },
     "steps": {
@@ -20938,6 +30375,110 @@ func init() {
         }
       }
     },
+    "triage-finding": {
+      "description": "A known failure found in the logs uploaded for a cluster.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this finding is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "excerpt": {
+          "description": "The first matching line.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "file": {
+          "description": "The path of the file of the first matching line in the uploaded logs.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "found_at": {
+          "description": "The time the uploaded logs were analyzed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "host_id": {
+          "description": "The host which uploaded the logs. Unset for the logs of the controller.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the finding.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "line": {
+          "description": "The number of the first matching line in the file, starting at 1.",
+          "type": "integer"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "match_count": {
+          "description": "The number of matching lines in the uploaded logs.",
+          "type": "integer"
+        },
+        "remediation": {
+          "description": "What to check to fix the known failure.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "severity": {
+          "description": "Whether the known failure may fail the installation (warning) or fails it (critical).",
+          "type": "string",
+          "enum": [
+            "warning",
+            "critical"
+          ]
+        },
+        "signature_id": {
+          "description": "The identifier of the signature of the known failure.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "The time of the first matching line, when known.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "title": {
+          "description": "The known failure.",
+          "type": "string"
+        }
+      }
+    },
+    "triage-summary": {
+      "description": "The number of known failures found by the automatic triage of the logs uploaded for a cluster, set by v2GetCluster only. The findings are listed by v2ListClusterTriageFindings.",
+      "type": "object",
+      "properties": {
+        "critical_count": {
+          "description": "The number of known failures which fail the installation.",
+          "type": "integer"
+        },
+        "finding_count": {
+          "description": "The number of known failures found.",
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"-\"",
+      "x-nullable": true
+    },
     "update-manifest-params": {
       "type": "object",
       "required": [
@@ -21107,10 +30648,34 @@ func init() {
           },
           "x-nullable": true
         },
+        "custom_host_validations": {
+          "description": "Validations defined by the user that the hosts of the cluster must pass. An empty list deletes the validations.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/custom-host-validation"
+          },
+          "x-nullable": true
+        },
         "disk_encryption": {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "$ref": "#/definitions/disk-encryption"
         },
+        "host_role_rules": {
+          "description": "Rules assigning roles, installation disks and node labels to the hosts of the cluster. The first matching rule applies. An empty list deletes the rules.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-role-rule"
+          },
+          "x-nullable": true
+        },
+        "host_stage_timeout_policies": {
+          "description": "Timeouts of the installation stages of the hosts and remediations of the hosts stuck in a stage. An empty list deletes the policies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage-timeout-policy"
+          },
+          "x-nullable": true
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -21136,6 +30701,14 @@ func init() {
           "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
           "$ref": "#/definitions/ignition-endpoint"
         },
+        "ignition_override_layers": {
+          "description": "Ignition configs merged into the ignition of the hosts of the cluster, by cluster, role and machine config pool. An empty list deletes the layers.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-layer"
+          },
+          "x-nullable": true
+        },
         "ingress_vips": {
           "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
           "type": "array",
@@ -21328,6 +30901,132 @@ func init() {
         "failed",
         "succeeded"
       ]
+    },
+    "webhook-notification-type": {
+      "type": "string",
+      "enum": [
+        "ClusterState",
+        "HostState",
+        "InfraEnv",
+        "Event"
+      ]
+    },
+    "webhook-subscription": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "notification_types": {
+          "description": "The notification types delivered to the webhook. All the types are delivered if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "url": {
+          "description": "The http(s) URL the notifications are posted to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-subscription-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
+      "properties": {
+        "notification_types": {
+          "description": "The notification types delivered to the webhook. All the types are delivered if empty.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-notification-type"
+          }
+        },
+        "secret": {
+          "description": "Key used to sign the notifications with HMAC-SHA256. It is never returned by the service.",
+          "type": "string",
+          "minLength": 16
+        },
+        "url": {
+          "description": "The http(s) URL the notifications are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-subscription"
+      }
+    },
+    "wipe_disks_request": {
+      "type": "object",
+      "required": [
+        "disks"
+      ],
+      "properties": {
+        "disks": {
+          "description": "The paths of the disks to wipe.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "wipe_disks_response": {
+      "type": "object",
+      "properties": {
+        "wiped_disks": {
+          "description": "The paths of the disks that were wiped.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -21370,14 +31069,42 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Out-of-band management of the hosts with Redfish.",
+      "name": "bmc"
+    },
+    {
+      "description": "Templates for the repeatable creation of clusters.",
+      "name": "cluster_templates"
+    },
+    {
+      "description": "Dry runs of the cluster installation steps.",
+      "name": "dry_run"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
     },
+    {
+      "description": "Queries of the hardware of the hosts of the tenant.",
+      "name": "hardware_inventory"
+    },
+    {
+      "description": "Automatic binding of the unbound hosts to the clusters.",
+      "name": "host_claims"
+    },
+    {
+      "description": "Timelines of the installation of clusters.",
+      "name": "installation_timeline"
+    },
     {
       "description": "General OpenShift cluster installation APIs.",
       "name": "installer"
     },
+    {
+      "description": "Search of the logs uploaded for clusters.",
+      "name": "log_search"
+    },
     {
       "description": "Managed dns domains for a cluster installation.",
       "name": "managed_domains"
@@ -21386,13 +31113,29 @@ func init() {
       "description": "Manifests for customizing a cluster installation.",
       "name": "manifests"
     },
+    {
+      "description": "Diagnostic reports of the network of clusters.",
+      "name": "network_report"
+    },
     {
       "description": "Information regarding supported operators.",
       "name": "operators"
     },
+    {
+      "description": "Known failures found in the logs uploaded for clusters.",
+      "name": "triage"
+    },
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Streams of the changes of clusters and infra-envs.",
+      "name": "watch"
+    },
+    {
+      "description": "Webhook subscriptions to the change notifications of the tenant resources.",
+      "name": "webhooks"
     }
   ]
 }`))
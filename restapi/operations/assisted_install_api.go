@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,12 +20,23 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/bmc"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/dry_run"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/openshift/assisted-service/restapi/operations/hardware_inventory"
+	"github.com/openshift/assisted-service/restapi/operations/host_claims"
+	"github.com/openshift/assisted-service/restapi/operations/installation_timeline"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/openshift/assisted-service/restapi/operations/log_search"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/openshift/assisted-service/restapi/operations/network_report"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/triage"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/watch"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 // NewAssistedInstallAPI creates a new AssistedInstall instance
@@ -50,6 +62,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerBindHostHandler: installer.BindHostHandlerFunc(func(params installer.BindHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.BindHost has not yet been implemented")
@@ -162,15 +177,45 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadLogsHandler: installer.V2UploadLogsHandlerFunc(func(params installer.V2UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadLogs has not yet been implemented")
 		}),
+		BmcV2BmcHostBootIsoHandler: bmc.V2BmcHostBootIsoHandlerFunc(func(params bmc.V2BmcHostBootIsoParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2BmcHostBootIso has not yet been implemented")
+		}),
+		BmcV2BmcHostEjectVirtualMediaHandler: bmc.V2BmcHostEjectVirtualMediaHandlerFunc(func(params bmc.V2BmcHostEjectVirtualMediaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2BmcHostEjectVirtualMedia has not yet been implemented")
+		}),
+		BmcV2BmcHostPowerActionHandler: bmc.V2BmcHostPowerActionHandlerFunc(func(params bmc.V2BmcHostPowerActionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2BmcHostPowerAction has not yet been implemented")
+		}),
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		BmcV2CreateBmcHostHandler: bmc.V2CreateBmcHostHandlerFunc(func(params bmc.V2CreateBmcHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2CreateBmcHost has not yet been implemented")
+		}),
+		ClusterTemplatesV2CreateClusterTemplateHandler: cluster_templates.V2CreateClusterTemplateHandlerFunc(func(params cluster_templates.V2CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2CreateClusterTemplate has not yet been implemented")
+		}),
+		InstallerV2DecommissionHostHandler: installer.V2DecommissionHostHandlerFunc(func(params installer.V2DecommissionHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DecommissionHost has not yet been implemented")
+		}),
+		BmcV2DeleteBmcHostHandler: bmc.V2DeleteBmcHostHandlerFunc(func(params bmc.V2DeleteBmcHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2DeleteBmcHost has not yet been implemented")
+		}),
+		ClusterTemplatesV2DeleteClusterTemplateHandler: cluster_templates.V2DeleteClusterTemplateHandlerFunc(func(params cluster_templates.V2DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2DeleteClusterTemplate has not yet been implemented")
+		}),
+		HostClaimsV2DeleteHostClaimHandler: host_claims.V2DeleteHostClaimHandlerFunc(func(params host_claims.V2DeleteHostClaimParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_claims.V2DeleteHostClaim has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
 		InstallerV2DeregisterHostHandler: installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterHost has not yet been implemented")
 		}),
+		WebhooksV2DeregisterWebhookSubscriptionHandler: webhooks.V2DeregisterWebhookSubscriptionHandlerFunc(func(params webhooks.V2DeregisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2DeregisterWebhookSubscription has not yet been implemented")
+		}),
 		ManifestsV2DownloadClusterManifestHandler: manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DownloadClusterManifest has not yet been implemented")
 		}),
@@ -180,21 +225,57 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallationTimelineV2DownloadInstallationTimelineHandler: installation_timeline.V2DownloadInstallationTimelineHandlerFunc(func(params installation_timeline.V2DownloadInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installation_timeline.V2DownloadInstallationTimeline has not yet been implemented")
+		}),
+		NetworkReportV2DownloadNetworkReportHandler: network_report.V2DownloadNetworkReportHandlerFunc(func(params network_report.V2DownloadNetworkReportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation network_report.V2DownloadNetworkReport has not yet been implemented")
+		}),
+		DryRunV2DryRunGenerateHandler: dry_run.V2DryRunGenerateHandlerFunc(func(params dry_run.V2DryRunGenerateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation dry_run.V2DryRunGenerate has not yet been implemented")
+		}),
+		HardwareInventoryV2ExportHostHardwareHandler: hardware_inventory.V2ExportHostHardwareHandlerFunc(func(params hardware_inventory.V2ExportHostHardwareParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation hardware_inventory.V2ExportHostHardware has not yet been implemented")
+		}),
+		BmcV2GetBmcHostHandler: bmc.V2GetBmcHostHandlerFunc(func(params bmc.V2GetBmcHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2GetBmcHost has not yet been implemented")
+		}),
+		BmcV2GetBmcHostStatusHandler: bmc.V2GetBmcHostStatusHandlerFunc(func(params bmc.V2GetBmcHostStatusParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2GetBmcHostStatus has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
+		ClusterTemplatesV2GetClusterTemplateHandler: cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2GetClusterTemplate has not yet been implemented")
+		}),
+		DryRunV2GetDryRunGenerateHandler: dry_run.V2GetDryRunGenerateHandlerFunc(func(params dry_run.V2GetDryRunGenerateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation dry_run.V2GetDryRunGenerate has not yet been implemented")
+		}),
 		InstallerV2GetHostHandler: installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHost has not yet been implemented")
 		}),
+		HostClaimsV2GetHostClaimHandler: host_claims.V2GetHostClaimHandlerFunc(func(params host_claims.V2GetHostClaimParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_claims.V2GetHostClaim has not yet been implemented")
+		}),
 		InstallerV2GetHostIgnitionHandler: installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHostIgnition has not yet been implemented")
 		}),
+		InstallerV2GetHostIgnitionPreviewHandler: installer.V2GetHostIgnitionPreviewHandlerFunc(func(params installer.V2GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetHostIgnitionPreview has not yet been implemented")
+		}),
 		InstallerV2GetIgnoredValidationsHandler: installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetIgnoredValidations has not yet been implemented")
 		}),
+		InstallationTimelineV2GetInstallationTimelineHandler: installation_timeline.V2GetInstallationTimelineHandlerFunc(func(params installation_timeline.V2GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installation_timeline.V2GetInstallationTimeline has not yet been implemented")
+		}),
+		NetworkReportV2GetNetworkReportHandler: network_report.V2GetNetworkReportHandlerFunc(func(params network_report.V2GetNetworkReportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation network_report.V2GetNetworkReport has not yet been implemented")
+		}),
 		InstallerV2GetNextStepsHandler: installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetNextSteps has not yet been implemented")
 		}),
@@ -210,6 +291,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		ClusterTemplatesV2InstantiateClusterTemplateHandler: cluster_templates.V2InstantiateClusterTemplateHandlerFunc(func(params cluster_templates.V2InstantiateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2InstantiateClusterTemplate has not yet been implemented")
+		}),
+		BmcV2ListBmcHostsHandler: bmc.V2ListBmcHostsHandlerFunc(func(params bmc.V2ListBmcHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2ListBmcHosts has not yet been implemented")
+		}),
+		ClusterTemplatesV2ListClusterTemplatesHandler: cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2ListClusterTemplates has not yet been implemented")
+		}),
+		TriageV2ListClusterTriageFindingsHandler: triage.V2ListClusterTriageFindingsHandlerFunc(func(params triage.V2ListClusterTriageFindingsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation triage.V2ListClusterTriageFindings has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
@@ -219,6 +312,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
+		HostClaimsV2ListHostClaimsHandler: host_claims.V2ListHostClaimsHandlerFunc(func(params host_claims.V2ListHostClaimsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_claims.V2ListHostClaims has not yet been implemented")
+		}),
+		HardwareInventoryV2ListHostHardwareHandler: hardware_inventory.V2ListHostHardwareHandlerFunc(func(params hardware_inventory.V2ListHostHardwareParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation hardware_inventory.V2ListHostHardware has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
@@ -228,15 +327,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		VersionsV2ListSupportedOpenshiftVersionsHandler: versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
+		WebhooksV2ListWebhookSubscriptionsHandler: webhooks.V2ListWebhookSubscriptionsHandlerFunc(func(params webhooks.V2ListWebhookSubscriptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2ListWebhookSubscriptions has not yet been implemented")
+		}),
 		InstallerV2PostStepReplyHandler: installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2PostStepReply has not yet been implemented")
 		}),
+		InstallerV2PreviewClusterInstallConfigHandler: installer.V2PreviewClusterInstallConfigHandlerFunc(func(params installer.V2PreviewClusterInstallConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2PreviewClusterInstallConfig has not yet been implemented")
+		}),
 		InstallerV2RegisterClusterHandler: installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterCluster has not yet been implemented")
 		}),
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		WebhooksV2RegisterWebhookSubscriptionHandler: webhooks.V2RegisterWebhookSubscriptionHandlerFunc(func(params webhooks.V2RegisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2RegisterWebhookSubscription has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -249,12 +357,21 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		LogSearchV2SearchClusterLogsHandler: log_search.V2SearchClusterLogsHandlerFunc(func(params log_search.V2SearchClusterLogsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation log_search.V2SearchClusterLogs has not yet been implemented")
+		}),
+		HostClaimsV2SetHostClaimHandler: host_claims.V2SetHostClaimHandlerFunc(func(params host_claims.V2SetHostClaimParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation host_claims.V2SetHostClaim has not yet been implemented")
+		}),
 		InstallerV2SetIgnoredValidationsHandler: installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2SetIgnoredValidations has not yet been implemented")
 		}),
 		EventsV2TriggerEventHandler: events.V2TriggerEventHandlerFunc(func(params events.V2TriggerEventParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2TriggerEvent has not yet been implemented")
 		}),
+		BmcV2UpdateBmcHostHandler: bmc.V2UpdateBmcHostHandlerFunc(func(params bmc.V2UpdateBmcHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation bmc.V2UpdateBmcHost has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterFinalizingProgressHandler: installer.V2UpdateClusterFinalizingProgressHandlerFunc(func(params installer.V2UpdateClusterFinalizingProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterFinalizingProgress has not yet been implemented")
 		}),
@@ -264,6 +381,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UpdateClusterLogsProgressHandler: installer.V2UpdateClusterLogsProgressHandlerFunc(func(params installer.V2UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		ClusterTemplatesV2UpdateClusterTemplateHandler: cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2UpdateClusterTemplate has not yet been implemented")
+		}),
 		InstallerV2UpdateHostHandler: installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHost has not yet been implemented")
 		}),
@@ -282,6 +402,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		WatchV2WatchClusterHandler: watch.V2WatchClusterHandlerFunc(func(params watch.V2WatchClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation watch.V2WatchCluster has not yet been implemented")
+		}),
+		WatchV2WatchInfraEnvHandler: watch.V2WatchInfraEnvHandlerFunc(func(params watch.V2WatchInfraEnvParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation watch.V2WatchInfraEnv has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -346,6 +472,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -444,28 +573,72 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterUISettingsHandler installer.V2UpdateClusterUISettingsHandler
 	// InstallerV2UploadLogsHandler sets the operation handler for the v2 upload logs operation
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
+	// BmcV2BmcHostBootIsoHandler sets the operation handler for the v2 bmc host boot iso operation
+	BmcV2BmcHostBootIsoHandler bmc.V2BmcHostBootIsoHandler
+	// BmcV2BmcHostEjectVirtualMediaHandler sets the operation handler for the v2 bmc host eject virtual media operation
+	BmcV2BmcHostEjectVirtualMediaHandler bmc.V2BmcHostEjectVirtualMediaHandler
+	// BmcV2BmcHostPowerActionHandler sets the operation handler for the v2 bmc host power action operation
+	BmcV2BmcHostPowerActionHandler bmc.V2BmcHostPowerActionHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// BmcV2CreateBmcHostHandler sets the operation handler for the v2 create bmc host operation
+	BmcV2CreateBmcHostHandler bmc.V2CreateBmcHostHandler
+	// ClusterTemplatesV2CreateClusterTemplateHandler sets the operation handler for the v2 create cluster template operation
+	ClusterTemplatesV2CreateClusterTemplateHandler cluster_templates.V2CreateClusterTemplateHandler
+	// InstallerV2DecommissionHostHandler sets the operation handler for the v2 decommission host operation
+	InstallerV2DecommissionHostHandler installer.V2DecommissionHostHandler
+	// BmcV2DeleteBmcHostHandler sets the operation handler for the v2 delete bmc host operation
+	BmcV2DeleteBmcHostHandler bmc.V2DeleteBmcHostHandler
+	// ClusterTemplatesV2DeleteClusterTemplateHandler sets the operation handler for the v2 delete cluster template operation
+	ClusterTemplatesV2DeleteClusterTemplateHandler cluster_templates.V2DeleteClusterTemplateHandler
+	// HostClaimsV2DeleteHostClaimHandler sets the operation handler for the v2 delete host claim operation
+	HostClaimsV2DeleteHostClaimHandler host_claims.V2DeleteHostClaimHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
+	// WebhooksV2DeregisterWebhookSubscriptionHandler sets the operation handler for the v2 deregister webhook subscription operation
+	WebhooksV2DeregisterWebhookSubscriptionHandler webhooks.V2DeregisterWebhookSubscriptionHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
 	ManifestsV2DownloadClusterManifestHandler manifests.V2DownloadClusterManifestHandler
 	// InstallerV2DownloadHostIgnitionHandler sets the operation handler for the v2 download host ignition operation
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallationTimelineV2DownloadInstallationTimelineHandler sets the operation handler for the v2 download installation timeline operation
+	InstallationTimelineV2DownloadInstallationTimelineHandler installation_timeline.V2DownloadInstallationTimelineHandler
+	// NetworkReportV2DownloadNetworkReportHandler sets the operation handler for the v2 download network report operation
+	NetworkReportV2DownloadNetworkReportHandler network_report.V2DownloadNetworkReportHandler
+	// DryRunV2DryRunGenerateHandler sets the operation handler for the v2 dry run generate operation
+	DryRunV2DryRunGenerateHandler dry_run.V2DryRunGenerateHandler
+	// HardwareInventoryV2ExportHostHardwareHandler sets the operation handler for the v2 export host hardware operation
+	HardwareInventoryV2ExportHostHardwareHandler hardware_inventory.V2ExportHostHardwareHandler
+	// BmcV2GetBmcHostHandler sets the operation handler for the v2 get bmc host operation
+	BmcV2GetBmcHostHandler bmc.V2GetBmcHostHandler
+	// BmcV2GetBmcHostStatusHandler sets the operation handler for the v2 get bmc host status operation
+	BmcV2GetBmcHostStatusHandler bmc.V2GetBmcHostStatusHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
+	// ClusterTemplatesV2GetClusterTemplateHandler sets the operation handler for the v2 get cluster template operation
+	ClusterTemplatesV2GetClusterTemplateHandler cluster_templates.V2GetClusterTemplateHandler
+	// DryRunV2GetDryRunGenerateHandler sets the operation handler for the v2 get dry run generate operation
+	DryRunV2GetDryRunGenerateHandler dry_run.V2GetDryRunGenerateHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
+	// HostClaimsV2GetHostClaimHandler sets the operation handler for the v2 get host claim operation
+	HostClaimsV2GetHostClaimHandler host_claims.V2GetHostClaimHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
+	// InstallerV2GetHostIgnitionPreviewHandler sets the operation handler for the v2 get host ignition preview operation
+	InstallerV2GetHostIgnitionPreviewHandler installer.V2GetHostIgnitionPreviewHandler
 	// InstallerV2GetIgnoredValidationsHandler sets the operation handler for the v2 get ignored validations operation
 	InstallerV2GetIgnoredValidationsHandler installer.V2GetIgnoredValidationsHandler
+	// InstallationTimelineV2GetInstallationTimelineHandler sets the operation handler for the v2 get installation timeline operation
+	InstallationTimelineV2GetInstallationTimelineHandler installation_timeline.V2GetInstallationTimelineHandler
+	// NetworkReportV2GetNetworkReportHandler sets the operation handler for the v2 get network report operation
+	NetworkReportV2GetNetworkReportHandler network_report.V2GetNetworkReportHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
@@ -476,24 +649,42 @@ type AssistedInstallAPI struct {
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// ClusterTemplatesV2InstantiateClusterTemplateHandler sets the operation handler for the v2 instantiate cluster template operation
+	ClusterTemplatesV2InstantiateClusterTemplateHandler cluster_templates.V2InstantiateClusterTemplateHandler
+	// BmcV2ListBmcHostsHandler sets the operation handler for the v2 list bmc hosts operation
+	BmcV2ListBmcHostsHandler bmc.V2ListBmcHostsHandler
+	// ClusterTemplatesV2ListClusterTemplatesHandler sets the operation handler for the v2 list cluster templates operation
+	ClusterTemplatesV2ListClusterTemplatesHandler cluster_templates.V2ListClusterTemplatesHandler
+	// TriageV2ListClusterTriageFindingsHandler sets the operation handler for the v2 list cluster triage findings operation
+	TriageV2ListClusterTriageFindingsHandler triage.V2ListClusterTriageFindingsHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
+	// HostClaimsV2ListHostClaimsHandler sets the operation handler for the v2 list host claims operation
+	HostClaimsV2ListHostClaimsHandler host_claims.V2ListHostClaimsHandler
+	// HardwareInventoryV2ListHostHardwareHandler sets the operation handler for the v2 list host hardware operation
+	HardwareInventoryV2ListHostHardwareHandler hardware_inventory.V2ListHostHardwareHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
 	VersionsV2ListReleaseSourcesHandler versions.V2ListReleaseSourcesHandler
 	// VersionsV2ListSupportedOpenshiftVersionsHandler sets the operation handler for the v2 list supported openshift versions operation
 	VersionsV2ListSupportedOpenshiftVersionsHandler versions.V2ListSupportedOpenshiftVersionsHandler
+	// WebhooksV2ListWebhookSubscriptionsHandler sets the operation handler for the v2 list webhook subscriptions operation
+	WebhooksV2ListWebhookSubscriptionsHandler webhooks.V2ListWebhookSubscriptionsHandler
 	// InstallerV2PostStepReplyHandler sets the operation handler for the v2 post step reply operation
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
+	// InstallerV2PreviewClusterInstallConfigHandler sets the operation handler for the v2 preview cluster install config operation
+	InstallerV2PreviewClusterInstallConfigHandler installer.V2PreviewClusterInstallConfigHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// WebhooksV2RegisterWebhookSubscriptionHandler sets the operation handler for the v2 register webhook subscription operation
+	WebhooksV2RegisterWebhookSubscriptionHandler webhooks.V2RegisterWebhookSubscriptionHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -502,16 +693,24 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// LogSearchV2SearchClusterLogsHandler sets the operation handler for the v2 search cluster logs operation
+	LogSearchV2SearchClusterLogsHandler log_search.V2SearchClusterLogsHandler
+	// HostClaimsV2SetHostClaimHandler sets the operation handler for the v2 set host claim operation
+	HostClaimsV2SetHostClaimHandler host_claims.V2SetHostClaimHandler
 	// InstallerV2SetIgnoredValidationsHandler sets the operation handler for the v2 set ignored validations operation
 	InstallerV2SetIgnoredValidationsHandler installer.V2SetIgnoredValidationsHandler
 	// EventsV2TriggerEventHandler sets the operation handler for the v2 trigger event operation
 	EventsV2TriggerEventHandler events.V2TriggerEventHandler
+	// BmcV2UpdateBmcHostHandler sets the operation handler for the v2 update bmc host operation
+	BmcV2UpdateBmcHostHandler bmc.V2UpdateBmcHostHandler
 	// InstallerV2UpdateClusterFinalizingProgressHandler sets the operation handler for the v2 update cluster finalizing progress operation
 	InstallerV2UpdateClusterFinalizingProgressHandler installer.V2UpdateClusterFinalizingProgressHandler
 	// InstallerV2UpdateClusterInstallConfigHandler sets the operation handler for the v2 update cluster install config operation
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
 	InstallerV2UpdateClusterLogsProgressHandler installer.V2UpdateClusterLogsProgressHandler
+	// ClusterTemplatesV2UpdateClusterTemplateHandler sets the operation handler for the v2 update cluster template operation
+	ClusterTemplatesV2UpdateClusterTemplateHandler cluster_templates.V2UpdateClusterTemplateHandler
 	// InstallerV2UpdateHostHandler sets the operation handler for the v2 update host operation
 	InstallerV2UpdateHostHandler installer.V2UpdateHostHandler
 	// InstallerV2UpdateHostIgnitionHandler sets the operation handler for the v2 update host ignition operation
@@ -524,6 +723,10 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// WatchV2WatchClusterHandler sets the operation handler for the v2 watch cluster operation
+	WatchV2WatchClusterHandler watch.V2WatchClusterHandler
+	// WatchV2WatchInfraEnvHandler sets the operation handler for the v2 watch infra env operation
+	WatchV2WatchInfraEnvHandler watch.V2WatchInfraEnvHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -606,6 +809,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -734,15 +940,45 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadLogsHandler")
 	}
+	if o.BmcV2BmcHostBootIsoHandler == nil {
+		unregistered = append(unregistered, "bmc.V2BmcHostBootIsoHandler")
+	}
+	if o.BmcV2BmcHostEjectVirtualMediaHandler == nil {
+		unregistered = append(unregistered, "bmc.V2BmcHostEjectVirtualMediaHandler")
+	}
+	if o.BmcV2BmcHostPowerActionHandler == nil {
+		unregistered = append(unregistered, "bmc.V2BmcHostPowerActionHandler")
+	}
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.BmcV2CreateBmcHostHandler == nil {
+		unregistered = append(unregistered, "bmc.V2CreateBmcHostHandler")
+	}
+	if o.ClusterTemplatesV2CreateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2CreateClusterTemplateHandler")
+	}
+	if o.InstallerV2DecommissionHostHandler == nil {
+		unregistered = append(unregistered, "installer.V2DecommissionHostHandler")
+	}
+	if o.BmcV2DeleteBmcHostHandler == nil {
+		unregistered = append(unregistered, "bmc.V2DeleteBmcHostHandler")
+	}
+	if o.ClusterTemplatesV2DeleteClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2DeleteClusterTemplateHandler")
+	}
+	if o.HostClaimsV2DeleteHostClaimHandler == nil {
+		unregistered = append(unregistered, "host_claims.V2DeleteHostClaimHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
 	if o.InstallerV2DeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterHostHandler")
 	}
+	if o.WebhooksV2DeregisterWebhookSubscriptionHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2DeregisterWebhookSubscriptionHandler")
+	}
 	if o.ManifestsV2DownloadClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DownloadClusterManifestHandler")
 	}
@@ -752,21 +988,57 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallationTimelineV2DownloadInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "installation_timeline.V2DownloadInstallationTimelineHandler")
+	}
+	if o.NetworkReportV2DownloadNetworkReportHandler == nil {
+		unregistered = append(unregistered, "network_report.V2DownloadNetworkReportHandler")
+	}
+	if o.DryRunV2DryRunGenerateHandler == nil {
+		unregistered = append(unregistered, "dry_run.V2DryRunGenerateHandler")
+	}
+	if o.HardwareInventoryV2ExportHostHardwareHandler == nil {
+		unregistered = append(unregistered, "hardware_inventory.V2ExportHostHardwareHandler")
+	}
+	if o.BmcV2GetBmcHostHandler == nil {
+		unregistered = append(unregistered, "bmc.V2GetBmcHostHandler")
+	}
+	if o.BmcV2GetBmcHostStatusHandler == nil {
+		unregistered = append(unregistered, "bmc.V2GetBmcHostStatusHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
+	if o.ClusterTemplatesV2GetClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2GetClusterTemplateHandler")
+	}
+	if o.DryRunV2GetDryRunGenerateHandler == nil {
+		unregistered = append(unregistered, "dry_run.V2GetDryRunGenerateHandler")
+	}
 	if o.InstallerV2GetHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostHandler")
 	}
+	if o.HostClaimsV2GetHostClaimHandler == nil {
+		unregistered = append(unregistered, "host_claims.V2GetHostClaimHandler")
+	}
 	if o.InstallerV2GetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostIgnitionHandler")
 	}
+	if o.InstallerV2GetHostIgnitionPreviewHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetHostIgnitionPreviewHandler")
+	}
 	if o.InstallerV2GetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetIgnoredValidationsHandler")
 	}
+	if o.InstallationTimelineV2GetInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "installation_timeline.V2GetInstallationTimelineHandler")
+	}
+	if o.NetworkReportV2GetNetworkReportHandler == nil {
+		unregistered = append(unregistered, "network_report.V2GetNetworkReportHandler")
+	}
 	if o.InstallerV2GetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetNextStepsHandler")
 	}
@@ -782,6 +1054,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.ClusterTemplatesV2InstantiateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2InstantiateClusterTemplateHandler")
+	}
+	if o.BmcV2ListBmcHostsHandler == nil {
+		unregistered = append(unregistered, "bmc.V2ListBmcHostsHandler")
+	}
+	if o.ClusterTemplatesV2ListClusterTemplatesHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2ListClusterTemplatesHandler")
+	}
+	if o.TriageV2ListClusterTriageFindingsHandler == nil {
+		unregistered = append(unregistered, "triage.V2ListClusterTriageFindingsHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
@@ -791,6 +1075,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
+	if o.HostClaimsV2ListHostClaimsHandler == nil {
+		unregistered = append(unregistered, "host_claims.V2ListHostClaimsHandler")
+	}
+	if o.HardwareInventoryV2ListHostHardwareHandler == nil {
+		unregistered = append(unregistered, "hardware_inventory.V2ListHostHardwareHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
@@ -800,15 +1090,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.VersionsV2ListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListSupportedOpenshiftVersionsHandler")
 	}
+	if o.WebhooksV2ListWebhookSubscriptionsHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2ListWebhookSubscriptionsHandler")
+	}
 	if o.InstallerV2PostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.V2PostStepReplyHandler")
 	}
+	if o.InstallerV2PreviewClusterInstallConfigHandler == nil {
+		unregistered = append(unregistered, "installer.V2PreviewClusterInstallConfigHandler")
+	}
 	if o.InstallerV2RegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterClusterHandler")
 	}
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.WebhooksV2RegisterWebhookSubscriptionHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2RegisterWebhookSubscriptionHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -821,12 +1120,21 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.LogSearchV2SearchClusterLogsHandler == nil {
+		unregistered = append(unregistered, "log_search.V2SearchClusterLogsHandler")
+	}
+	if o.HostClaimsV2SetHostClaimHandler == nil {
+		unregistered = append(unregistered, "host_claims.V2SetHostClaimHandler")
+	}
 	if o.InstallerV2SetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2SetIgnoredValidationsHandler")
 	}
 	if o.EventsV2TriggerEventHandler == nil {
 		unregistered = append(unregistered, "events.V2TriggerEventHandler")
 	}
+	if o.BmcV2UpdateBmcHostHandler == nil {
+		unregistered = append(unregistered, "bmc.V2UpdateBmcHostHandler")
+	}
 	if o.InstallerV2UpdateClusterFinalizingProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterFinalizingProgressHandler")
 	}
@@ -836,6 +1144,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterLogsProgressHandler")
 	}
+	if o.ClusterTemplatesV2UpdateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2UpdateClusterTemplateHandler")
+	}
 	if o.InstallerV2UpdateHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostHandler")
 	}
@@ -854,6 +1165,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.WatchV2WatchClusterHandler == nil {
+		unregistered = append(unregistered, "watch.V2WatchClusterHandler")
+	}
+	if o.WatchV2WatchInfraEnvHandler == nil {
+		unregistered = append(unregistered, "watch.V2WatchInfraEnvHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -931,6 +1248,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -1122,7 +1441,43 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso"] = bmc.NewV2BmcHostBootIso(o.context, o.BmcV2BmcHostBootIsoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media"] = bmc.NewV2BmcHostEjectVirtualMedia(o.context, o.BmcV2BmcHostEjectVirtualMediaHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power"] = bmc.NewV2BmcHostPowerAction(o.context, o.BmcV2BmcHostPowerActionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/bmc-hosts"] = bmc.NewV2CreateBmcHost(o.context, o.BmcV2CreateBmcHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/cluster-templates"] = cluster_templates.NewV2CreateClusterTemplate(o.context, o.ClusterTemplatesV2CreateClusterTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission"] = installer.NewV2DecommissionHost(o.context, o.InstallerV2DecommissionHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}"] = bmc.NewV2DeleteBmcHost(o.context, o.BmcV2DeleteBmcHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2DeleteClusterTemplate(o.context, o.ClusterTemplatesV2DeleteClusterTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/clusters/{cluster_id}/host-claim"] = host_claims.NewV2DeleteHostClaim(o.context, o.HostClaimsV2DeleteHostClaimHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1131,6 +1486,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2DeregisterHost(o.context, o.InstallerV2DeregisterHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/webhook-subscriptions/{subscription_id}"] = webhooks.NewV2DeregisterWebhookSubscription(o.context, o.WebhooksV2DeregisterWebhookSubscriptionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1146,6 +1505,30 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/installation-timeline/download"] = installation_timeline.NewV2DownloadInstallationTimeline(o.context, o.InstallationTimelineV2DownloadInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/network-report/download"] = network_report.NewV2DownloadNetworkReport(o.context, o.NetworkReportV2DownloadNetworkReportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/dry-run-generate"] = dry_run.NewV2DryRunGenerate(o.context, o.DryRunV2DryRunGenerateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/hardware-inventory/export"] = hardware_inventory.NewV2ExportHostHardware(o.context, o.HardwareInventoryV2ExportHostHardwareHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}"] = bmc.NewV2GetBmcHost(o.context, o.BmcV2GetBmcHostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status"] = bmc.NewV2GetBmcHostStatus(o.context, o.BmcV2GetBmcHostStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}"] = installer.NewV2GetCluster(o.context, o.InstallerV2GetClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1154,18 +1537,42 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2GetClusterTemplate(o.context, o.ClusterTemplatesV2GetClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/dry-run-generate"] = dry_run.NewV2GetDryRunGenerate(o.context, o.DryRunV2GetDryRunGenerateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2GetHost(o.context, o.InstallerV2GetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/host-claim"] = host_claims.NewV2GetHostClaim(o.context, o.HostClaimsV2GetHostClaimHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition"] = installer.NewV2GetHostIgnition(o.context, o.InstallerV2GetHostIgnitionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview"] = installer.NewV2GetHostIgnitionPreview(o.context, o.InstallerV2GetHostIgnitionPreviewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/ignored-validations"] = installer.NewV2GetIgnoredValidations(o.context, o.InstallerV2GetIgnoredValidationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/installation-timeline"] = installation_timeline.NewV2GetInstallationTimeline(o.context, o.InstallationTimelineV2GetInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/network-report"] = network_report.NewV2GetNetworkReport(o.context, o.NetworkReportV2GetNetworkReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions"] = installer.NewV2GetNextSteps(o.context, o.InstallerV2GetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1183,6 +1590,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install"] = installer.NewV2InstallHost(o.context, o.InstallerV2InstallHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/cluster-templates/{cluster_template_id}/actions/instantiate"] = cluster_templates.NewV2InstantiateClusterTemplate(o.context, o.ClusterTemplatesV2InstantiateClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/bmc-hosts"] = bmc.NewV2ListBmcHosts(o.context, o.BmcV2ListBmcHostsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/cluster-templates"] = cluster_templates.NewV2ListClusterTemplates(o.context, o.ClusterTemplatesV2ListClusterTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/triage"] = triage.NewV2ListClusterTriageFindings(o.context, o.TriageV2ListClusterTriageFindingsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1198,6 +1621,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/host-claims"] = host_claims.NewV2ListHostClaims(o.context, o.HostClaimsV2ListHostClaimsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/hardware-inventory"] = hardware_inventory.NewV2ListHostHardware(o.context, o.HardwareInventoryV2ListHostHardwareHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1207,6 +1638,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/openshift-versions"] = versions.NewV2ListSupportedOpenshiftVersions(o.context, o.VersionsV2ListSupportedOpenshiftVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/webhook-subscriptions"] = webhooks.NewV2ListWebhookSubscriptions(o.context, o.WebhooksV2ListWebhookSubscriptionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1214,11 +1649,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/install-config/preview"] = installer.NewV2PreviewClusterInstallConfig(o.context, o.InstallerV2PreviewClusterInstallConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters"] = installer.NewV2RegisterCluster(o.context, o.InstallerV2RegisterClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/webhook-subscriptions"] = webhooks.NewV2RegisterWebhookSubscription(o.context, o.WebhooksV2RegisterWebhookSubscriptionHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1235,6 +1678,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/logs/search"] = log_search.NewV2SearchClusterLogs(o.context, o.LogSearchV2SearchClusterLogsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v2/clusters/{cluster_id}/host-claim"] = host_claims.NewV2SetHostClaim(o.context, o.HostClaimsV2SetHostClaimHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1243,6 +1694,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/events"] = events.NewV2TriggerEvent(o.context, o.EventsV2TriggerEventHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}"] = bmc.NewV2UpdateBmcHost(o.context, o.BmcV2UpdateBmcHostHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1258,6 +1713,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/v2/cluster-templates/{cluster_template_id}"] = cluster_templates.NewV2UpdateClusterTemplate(o.context, o.ClusterTemplatesV2UpdateClusterTemplateHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2UpdateHost(o.context, o.InstallerV2UpdateHostHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -1279,6 +1738,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/watch"] = watch.NewV2WatchCluster(o.context, o.WatchV2WatchClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/watch"] = watch.NewV2WatchInfraEnv(o.context, o.WatchV2WatchInfraEnvHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
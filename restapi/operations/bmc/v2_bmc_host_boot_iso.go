@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2BmcHostBootIsoHandlerFunc turns a function with the right signature into a v2 bmc host boot iso handler
+type V2BmcHostBootIsoHandlerFunc func(V2BmcHostBootIsoParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2BmcHostBootIsoHandlerFunc) Handle(params V2BmcHostBootIsoParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2BmcHostBootIsoHandler interface for that can handle valid v2 bmc host boot iso params
+type V2BmcHostBootIsoHandler interface {
+	Handle(V2BmcHostBootIsoParams, interface{}) middleware.Responder
+}
+
+// NewV2BmcHostBootIso creates a new http.Handler for the v2 bmc host boot iso operation
+func NewV2BmcHostBootIso(ctx *middleware.Context, handler V2BmcHostBootIsoHandler) *V2BmcHostBootIso {
+	return &V2BmcHostBootIso{Context: ctx, Handler: handler}
+}
+
+/*
+	V2BmcHostBootIso swagger:route POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/boot-iso bmc v2BmcHostBootIso
+
+Inserts the discovery ISO of the infra-env as virtual media of a BMC host, sets its next boot to the virtual media and powers it on or restarts it.
+*/
+type V2BmcHostBootIso struct {
+	Context *middleware.Context
+	Handler V2BmcHostBootIsoHandler
+}
+
+func (o *V2BmcHostBootIso) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2BmcHostBootIsoParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostBootIsoAcceptedCode is the HTTP code returned for type V2BmcHostBootIsoAccepted
+const V2BmcHostBootIsoAcceptedCode int = 202
+
+/*
+V2BmcHostBootIsoAccepted Success.
+
+swagger:response v2BmcHostBootIsoAccepted
+*/
+type V2BmcHostBootIsoAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoAccepted creates V2BmcHostBootIsoAccepted with default headers values
+func NewV2BmcHostBootIsoAccepted() *V2BmcHostBootIsoAccepted {
+
+	return &V2BmcHostBootIsoAccepted{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso accepted response
+func (o *V2BmcHostBootIsoAccepted) WithPayload(payload *models.BmcHost) *V2BmcHostBootIsoAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso accepted response
+func (o *V2BmcHostBootIsoAccepted) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoBadRequestCode is the HTTP code returned for type V2BmcHostBootIsoBadRequest
+const V2BmcHostBootIsoBadRequestCode int = 400
+
+/*
+V2BmcHostBootIsoBadRequest Error.
+
+swagger:response v2BmcHostBootIsoBadRequest
+*/
+type V2BmcHostBootIsoBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoBadRequest creates V2BmcHostBootIsoBadRequest with default headers values
+func NewV2BmcHostBootIsoBadRequest() *V2BmcHostBootIsoBadRequest {
+
+	return &V2BmcHostBootIsoBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso bad request response
+func (o *V2BmcHostBootIsoBadRequest) WithPayload(payload *models.Error) *V2BmcHostBootIsoBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso bad request response
+func (o *V2BmcHostBootIsoBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoUnauthorizedCode is the HTTP code returned for type V2BmcHostBootIsoUnauthorized
+const V2BmcHostBootIsoUnauthorizedCode int = 401
+
+/*
+V2BmcHostBootIsoUnauthorized Unauthorized.
+
+swagger:response v2BmcHostBootIsoUnauthorized
+*/
+type V2BmcHostBootIsoUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoUnauthorized creates V2BmcHostBootIsoUnauthorized with default headers values
+func NewV2BmcHostBootIsoUnauthorized() *V2BmcHostBootIsoUnauthorized {
+
+	return &V2BmcHostBootIsoUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso unauthorized response
+func (o *V2BmcHostBootIsoUnauthorized) WithPayload(payload *models.InfraError) *V2BmcHostBootIsoUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso unauthorized response
+func (o *V2BmcHostBootIsoUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoForbiddenCode is the HTTP code returned for type V2BmcHostBootIsoForbidden
+const V2BmcHostBootIsoForbiddenCode int = 403
+
+/*
+V2BmcHostBootIsoForbidden Forbidden.
+
+swagger:response v2BmcHostBootIsoForbidden
+*/
+type V2BmcHostBootIsoForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoForbidden creates V2BmcHostBootIsoForbidden with default headers values
+func NewV2BmcHostBootIsoForbidden() *V2BmcHostBootIsoForbidden {
+
+	return &V2BmcHostBootIsoForbidden{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso forbidden response
+func (o *V2BmcHostBootIsoForbidden) WithPayload(payload *models.InfraError) *V2BmcHostBootIsoForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso forbidden response
+func (o *V2BmcHostBootIsoForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoNotFoundCode is the HTTP code returned for type V2BmcHostBootIsoNotFound
+const V2BmcHostBootIsoNotFoundCode int = 404
+
+/*
+V2BmcHostBootIsoNotFound Error.
+
+swagger:response v2BmcHostBootIsoNotFound
+*/
+type V2BmcHostBootIsoNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoNotFound creates V2BmcHostBootIsoNotFound with default headers values
+func NewV2BmcHostBootIsoNotFound() *V2BmcHostBootIsoNotFound {
+
+	return &V2BmcHostBootIsoNotFound{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso not found response
+func (o *V2BmcHostBootIsoNotFound) WithPayload(payload *models.Error) *V2BmcHostBootIsoNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso not found response
+func (o *V2BmcHostBootIsoNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoConflictCode is the HTTP code returned for type V2BmcHostBootIsoConflict
+const V2BmcHostBootIsoConflictCode int = 409
+
+/*
+V2BmcHostBootIsoConflict Error.
+
+swagger:response v2BmcHostBootIsoConflict
+*/
+type V2BmcHostBootIsoConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoConflict creates V2BmcHostBootIsoConflict with default headers values
+func NewV2BmcHostBootIsoConflict() *V2BmcHostBootIsoConflict {
+
+	return &V2BmcHostBootIsoConflict{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso conflict response
+func (o *V2BmcHostBootIsoConflict) WithPayload(payload *models.Error) *V2BmcHostBootIsoConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso conflict response
+func (o *V2BmcHostBootIsoConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostBootIsoInternalServerErrorCode is the HTTP code returned for type V2BmcHostBootIsoInternalServerError
+const V2BmcHostBootIsoInternalServerErrorCode int = 500
+
+/*
+V2BmcHostBootIsoInternalServerError Error.
+
+swagger:response v2BmcHostBootIsoInternalServerError
+*/
+type V2BmcHostBootIsoInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostBootIsoInternalServerError creates V2BmcHostBootIsoInternalServerError with default headers values
+func NewV2BmcHostBootIsoInternalServerError() *V2BmcHostBootIsoInternalServerError {
+
+	return &V2BmcHostBootIsoInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 bmc host boot iso internal server error response
+func (o *V2BmcHostBootIsoInternalServerError) WithPayload(payload *models.Error) *V2BmcHostBootIsoInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host boot iso internal server error response
+func (o *V2BmcHostBootIsoInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostBootIsoInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2BmcHostEjectVirtualMediaHandlerFunc turns a function with the right signature into a v2 bmc host eject virtual media handler
+type V2BmcHostEjectVirtualMediaHandlerFunc func(V2BmcHostEjectVirtualMediaParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2BmcHostEjectVirtualMediaHandlerFunc) Handle(params V2BmcHostEjectVirtualMediaParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2BmcHostEjectVirtualMediaHandler interface for that can handle valid v2 bmc host eject virtual media params
+type V2BmcHostEjectVirtualMediaHandler interface {
+	Handle(V2BmcHostEjectVirtualMediaParams, interface{}) middleware.Responder
+}
+
+// NewV2BmcHostEjectVirtualMedia creates a new http.Handler for the v2 bmc host eject virtual media operation
+func NewV2BmcHostEjectVirtualMedia(ctx *middleware.Context, handler V2BmcHostEjectVirtualMediaHandler) *V2BmcHostEjectVirtualMedia {
+	return &V2BmcHostEjectVirtualMedia{Context: ctx, Handler: handler}
+}
+
+/*
+	V2BmcHostEjectVirtualMedia swagger:route POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/eject-virtual-media bmc v2BmcHostEjectVirtualMedia
+
+Ejects the virtual media of a BMC host.
+*/
+type V2BmcHostEjectVirtualMedia struct {
+	Context *middleware.Context
+	Handler V2BmcHostEjectVirtualMediaHandler
+}
+
+func (o *V2BmcHostEjectVirtualMedia) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2BmcHostEjectVirtualMediaParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostEjectVirtualMediaAcceptedCode is the HTTP code returned for type V2BmcHostEjectVirtualMediaAccepted
+const V2BmcHostEjectVirtualMediaAcceptedCode int = 202
+
+/*
+V2BmcHostEjectVirtualMediaAccepted Success.
+
+swagger:response v2BmcHostEjectVirtualMediaAccepted
+*/
+type V2BmcHostEjectVirtualMediaAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2BmcHostEjectVirtualMediaAccepted creates V2BmcHostEjectVirtualMediaAccepted with default headers values
+func NewV2BmcHostEjectVirtualMediaAccepted() *V2BmcHostEjectVirtualMediaAccepted {
+
+	return &V2BmcHostEjectVirtualMediaAccepted{}
+}
+
+// WithPayload adds the payload to the v2 bmc host eject virtual media accepted response
+func (o *V2BmcHostEjectVirtualMediaAccepted) WithPayload(payload *models.BmcHost) *V2BmcHostEjectVirtualMediaAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host eject virtual media accepted response
+func (o *V2BmcHostEjectVirtualMediaAccepted) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostEjectVirtualMediaAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostEjectVirtualMediaUnauthorizedCode is the HTTP code returned for type V2BmcHostEjectVirtualMediaUnauthorized
+const V2BmcHostEjectVirtualMediaUnauthorizedCode int = 401
+
+/*
+V2BmcHostEjectVirtualMediaUnauthorized Unauthorized.
+
+swagger:response v2BmcHostEjectVirtualMediaUnauthorized
+*/
+type V2BmcHostEjectVirtualMediaUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostEjectVirtualMediaUnauthorized creates V2BmcHostEjectVirtualMediaUnauthorized with default headers values
+func NewV2BmcHostEjectVirtualMediaUnauthorized() *V2BmcHostEjectVirtualMediaUnauthorized {
+
+	return &V2BmcHostEjectVirtualMediaUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 bmc host eject virtual media unauthorized response
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) WithPayload(payload *models.InfraError) *V2BmcHostEjectVirtualMediaUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host eject virtual media unauthorized response
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostEjectVirtualMediaUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostEjectVirtualMediaForbiddenCode is the HTTP code returned for type V2BmcHostEjectVirtualMediaForbidden
+const V2BmcHostEjectVirtualMediaForbiddenCode int = 403
+
+/*
+V2BmcHostEjectVirtualMediaForbidden Forbidden.
+
+swagger:response v2BmcHostEjectVirtualMediaForbidden
+*/
+type V2BmcHostEjectVirtualMediaForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostEjectVirtualMediaForbidden creates V2BmcHostEjectVirtualMediaForbidden with default headers values
+func NewV2BmcHostEjectVirtualMediaForbidden() *V2BmcHostEjectVirtualMediaForbidden {
+
+	return &V2BmcHostEjectVirtualMediaForbidden{}
+}
+
+// WithPayload adds the payload to the v2 bmc host eject virtual media forbidden response
+func (o *V2BmcHostEjectVirtualMediaForbidden) WithPayload(payload *models.InfraError) *V2BmcHostEjectVirtualMediaForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host eject virtual media forbidden response
+func (o *V2BmcHostEjectVirtualMediaForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostEjectVirtualMediaForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostEjectVirtualMediaNotFoundCode is the HTTP code returned for type V2BmcHostEjectVirtualMediaNotFound
+const V2BmcHostEjectVirtualMediaNotFoundCode int = 404
+
+/*
+V2BmcHostEjectVirtualMediaNotFound Error.
+
+swagger:response v2BmcHostEjectVirtualMediaNotFound
+*/
+type V2BmcHostEjectVirtualMediaNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostEjectVirtualMediaNotFound creates V2BmcHostEjectVirtualMediaNotFound with default headers values
+func NewV2BmcHostEjectVirtualMediaNotFound() *V2BmcHostEjectVirtualMediaNotFound {
+
+	return &V2BmcHostEjectVirtualMediaNotFound{}
+}
+
+// WithPayload adds the payload to the v2 bmc host eject virtual media not found response
+func (o *V2BmcHostEjectVirtualMediaNotFound) WithPayload(payload *models.Error) *V2BmcHostEjectVirtualMediaNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host eject virtual media not found response
+func (o *V2BmcHostEjectVirtualMediaNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostEjectVirtualMediaNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostEjectVirtualMediaInternalServerErrorCode is the HTTP code returned for type V2BmcHostEjectVirtualMediaInternalServerError
+const V2BmcHostEjectVirtualMediaInternalServerErrorCode int = 500
+
+/*
+V2BmcHostEjectVirtualMediaInternalServerError Error.
+
+swagger:response v2BmcHostEjectVirtualMediaInternalServerError
+*/
+type V2BmcHostEjectVirtualMediaInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostEjectVirtualMediaInternalServerError creates V2BmcHostEjectVirtualMediaInternalServerError with default headers values
+func NewV2BmcHostEjectVirtualMediaInternalServerError() *V2BmcHostEjectVirtualMediaInternalServerError {
+
+	return &V2BmcHostEjectVirtualMediaInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 bmc host eject virtual media internal server error response
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) WithPayload(payload *models.Error) *V2BmcHostEjectVirtualMediaInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host eject virtual media internal server error response
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostEjectVirtualMediaInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
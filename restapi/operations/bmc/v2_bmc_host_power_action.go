@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2BmcHostPowerActionHandlerFunc turns a function with the right signature into a v2 bmc host power action handler
+type V2BmcHostPowerActionHandlerFunc func(V2BmcHostPowerActionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2BmcHostPowerActionHandlerFunc) Handle(params V2BmcHostPowerActionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2BmcHostPowerActionHandler interface for that can handle valid v2 bmc host power action params
+type V2BmcHostPowerActionHandler interface {
+	Handle(V2BmcHostPowerActionParams, interface{}) middleware.Responder
+}
+
+// NewV2BmcHostPowerAction creates a new http.Handler for the v2 bmc host power action operation
+func NewV2BmcHostPowerAction(ctx *middleware.Context, handler V2BmcHostPowerActionHandler) *V2BmcHostPowerAction {
+	return &V2BmcHostPowerAction{Context: ctx, Handler: handler}
+}
+
+/*
+	V2BmcHostPowerAction swagger:route POST /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/actions/power bmc v2BmcHostPowerAction
+
+Powers a BMC host on or off, or restarts it.
+*/
+type V2BmcHostPowerAction struct {
+	Context *middleware.Context
+	Handler V2BmcHostPowerActionHandler
+}
+
+func (o *V2BmcHostPowerAction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2BmcHostPowerActionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2BmcHostPowerActionAcceptedCode is the HTTP code returned for type V2BmcHostPowerActionAccepted
+const V2BmcHostPowerActionAcceptedCode int = 202
+
+/*
+V2BmcHostPowerActionAccepted Success.
+
+swagger:response v2BmcHostPowerActionAccepted
+*/
+type V2BmcHostPowerActionAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionAccepted creates V2BmcHostPowerActionAccepted with default headers values
+func NewV2BmcHostPowerActionAccepted() *V2BmcHostPowerActionAccepted {
+
+	return &V2BmcHostPowerActionAccepted{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action accepted response
+func (o *V2BmcHostPowerActionAccepted) WithPayload(payload *models.BmcHost) *V2BmcHostPowerActionAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action accepted response
+func (o *V2BmcHostPowerActionAccepted) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostPowerActionBadRequestCode is the HTTP code returned for type V2BmcHostPowerActionBadRequest
+const V2BmcHostPowerActionBadRequestCode int = 400
+
+/*
+V2BmcHostPowerActionBadRequest Error.
+
+swagger:response v2BmcHostPowerActionBadRequest
+*/
+type V2BmcHostPowerActionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionBadRequest creates V2BmcHostPowerActionBadRequest with default headers values
+func NewV2BmcHostPowerActionBadRequest() *V2BmcHostPowerActionBadRequest {
+
+	return &V2BmcHostPowerActionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action bad request response
+func (o *V2BmcHostPowerActionBadRequest) WithPayload(payload *models.Error) *V2BmcHostPowerActionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action bad request response
+func (o *V2BmcHostPowerActionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostPowerActionUnauthorizedCode is the HTTP code returned for type V2BmcHostPowerActionUnauthorized
+const V2BmcHostPowerActionUnauthorizedCode int = 401
+
+/*
+V2BmcHostPowerActionUnauthorized Unauthorized.
+
+swagger:response v2BmcHostPowerActionUnauthorized
+*/
+type V2BmcHostPowerActionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionUnauthorized creates V2BmcHostPowerActionUnauthorized with default headers values
+func NewV2BmcHostPowerActionUnauthorized() *V2BmcHostPowerActionUnauthorized {
+
+	return &V2BmcHostPowerActionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action unauthorized response
+func (o *V2BmcHostPowerActionUnauthorized) WithPayload(payload *models.InfraError) *V2BmcHostPowerActionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action unauthorized response
+func (o *V2BmcHostPowerActionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostPowerActionForbiddenCode is the HTTP code returned for type V2BmcHostPowerActionForbidden
+const V2BmcHostPowerActionForbiddenCode int = 403
+
+/*
+V2BmcHostPowerActionForbidden Forbidden.
+
+swagger:response v2BmcHostPowerActionForbidden
+*/
+type V2BmcHostPowerActionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionForbidden creates V2BmcHostPowerActionForbidden with default headers values
+func NewV2BmcHostPowerActionForbidden() *V2BmcHostPowerActionForbidden {
+
+	return &V2BmcHostPowerActionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action forbidden response
+func (o *V2BmcHostPowerActionForbidden) WithPayload(payload *models.InfraError) *V2BmcHostPowerActionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action forbidden response
+func (o *V2BmcHostPowerActionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostPowerActionNotFoundCode is the HTTP code returned for type V2BmcHostPowerActionNotFound
+const V2BmcHostPowerActionNotFoundCode int = 404
+
+/*
+V2BmcHostPowerActionNotFound Error.
+
+swagger:response v2BmcHostPowerActionNotFound
+*/
+type V2BmcHostPowerActionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionNotFound creates V2BmcHostPowerActionNotFound with default headers values
+func NewV2BmcHostPowerActionNotFound() *V2BmcHostPowerActionNotFound {
+
+	return &V2BmcHostPowerActionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action not found response
+func (o *V2BmcHostPowerActionNotFound) WithPayload(payload *models.Error) *V2BmcHostPowerActionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action not found response
+func (o *V2BmcHostPowerActionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2BmcHostPowerActionInternalServerErrorCode is the HTTP code returned for type V2BmcHostPowerActionInternalServerError
+const V2BmcHostPowerActionInternalServerErrorCode int = 500
+
+/*
+V2BmcHostPowerActionInternalServerError Error.
+
+swagger:response v2BmcHostPowerActionInternalServerError
+*/
+type V2BmcHostPowerActionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2BmcHostPowerActionInternalServerError creates V2BmcHostPowerActionInternalServerError with default headers values
+func NewV2BmcHostPowerActionInternalServerError() *V2BmcHostPowerActionInternalServerError {
+
+	return &V2BmcHostPowerActionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 bmc host power action internal server error response
+func (o *V2BmcHostPowerActionInternalServerError) WithPayload(payload *models.Error) *V2BmcHostPowerActionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 bmc host power action internal server error response
+func (o *V2BmcHostPowerActionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2BmcHostPowerActionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CreateBmcHostHandlerFunc turns a function with the right signature into a v2 create bmc host handler
+type V2CreateBmcHostHandlerFunc func(V2CreateBmcHostParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CreateBmcHostHandlerFunc) Handle(params V2CreateBmcHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CreateBmcHostHandler interface for that can handle valid v2 create bmc host params
+type V2CreateBmcHostHandler interface {
+	Handle(V2CreateBmcHostParams, interface{}) middleware.Responder
+}
+
+// NewV2CreateBmcHost creates a new http.Handler for the v2 create bmc host operation
+func NewV2CreateBmcHost(ctx *middleware.Context, handler V2CreateBmcHostHandler) *V2CreateBmcHost {
+	return &V2CreateBmcHost{Context: ctx, Handler: handler}
+}
+
+/*
+	V2CreateBmcHost swagger:route POST /v2/infra-envs/{infra_env_id}/bmc-hosts bmc v2CreateBmcHost
+
+Registers the baseboard management controller of a host of the infra-env, for the host to be managed out-of-band with Redfish.
+*/
+type V2CreateBmcHost struct {
+	Context *middleware.Context
+	Handler V2CreateBmcHostHandler
+}
+
+func (o *V2CreateBmcHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CreateBmcHostParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateBmcHostCreatedCode is the HTTP code returned for type V2CreateBmcHostCreated
+const V2CreateBmcHostCreatedCode int = 201
+
+/*
+V2CreateBmcHostCreated Success.
+
+swagger:response v2CreateBmcHostCreated
+*/
+type V2CreateBmcHostCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostCreated creates V2CreateBmcHostCreated with default headers values
+func NewV2CreateBmcHostCreated() *V2CreateBmcHostCreated {
+
+	return &V2CreateBmcHostCreated{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host created response
+func (o *V2CreateBmcHostCreated) WithPayload(payload *models.BmcHost) *V2CreateBmcHostCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host created response
+func (o *V2CreateBmcHostCreated) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateBmcHostBadRequestCode is the HTTP code returned for type V2CreateBmcHostBadRequest
+const V2CreateBmcHostBadRequestCode int = 400
+
+/*
+V2CreateBmcHostBadRequest Error.
+
+swagger:response v2CreateBmcHostBadRequest
+*/
+type V2CreateBmcHostBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostBadRequest creates V2CreateBmcHostBadRequest with default headers values
+func NewV2CreateBmcHostBadRequest() *V2CreateBmcHostBadRequest {
+
+	return &V2CreateBmcHostBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host bad request response
+func (o *V2CreateBmcHostBadRequest) WithPayload(payload *models.Error) *V2CreateBmcHostBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host bad request response
+func (o *V2CreateBmcHostBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateBmcHostUnauthorizedCode is the HTTP code returned for type V2CreateBmcHostUnauthorized
+const V2CreateBmcHostUnauthorizedCode int = 401
+
+/*
+V2CreateBmcHostUnauthorized Unauthorized.
+
+swagger:response v2CreateBmcHostUnauthorized
+*/
+type V2CreateBmcHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostUnauthorized creates V2CreateBmcHostUnauthorized with default headers values
+func NewV2CreateBmcHostUnauthorized() *V2CreateBmcHostUnauthorized {
+
+	return &V2CreateBmcHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host unauthorized response
+func (o *V2CreateBmcHostUnauthorized) WithPayload(payload *models.InfraError) *V2CreateBmcHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host unauthorized response
+func (o *V2CreateBmcHostUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateBmcHostForbiddenCode is the HTTP code returned for type V2CreateBmcHostForbidden
+const V2CreateBmcHostForbiddenCode int = 403
+
+/*
+V2CreateBmcHostForbidden Forbidden.
+
+swagger:response v2CreateBmcHostForbidden
+*/
+type V2CreateBmcHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostForbidden creates V2CreateBmcHostForbidden with default headers values
+func NewV2CreateBmcHostForbidden() *V2CreateBmcHostForbidden {
+
+	return &V2CreateBmcHostForbidden{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host forbidden response
+func (o *V2CreateBmcHostForbidden) WithPayload(payload *models.InfraError) *V2CreateBmcHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host forbidden response
+func (o *V2CreateBmcHostForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateBmcHostNotFoundCode is the HTTP code returned for type V2CreateBmcHostNotFound
+const V2CreateBmcHostNotFoundCode int = 404
+
+/*
+V2CreateBmcHostNotFound Error.
+
+swagger:response v2CreateBmcHostNotFound
+*/
+type V2CreateBmcHostNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostNotFound creates V2CreateBmcHostNotFound with default headers values
+func NewV2CreateBmcHostNotFound() *V2CreateBmcHostNotFound {
+
+	return &V2CreateBmcHostNotFound{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host not found response
+func (o *V2CreateBmcHostNotFound) WithPayload(payload *models.Error) *V2CreateBmcHostNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host not found response
+func (o *V2CreateBmcHostNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateBmcHostInternalServerErrorCode is the HTTP code returned for type V2CreateBmcHostInternalServerError
+const V2CreateBmcHostInternalServerErrorCode int = 500
+
+/*
+V2CreateBmcHostInternalServerError Error.
+
+swagger:response v2CreateBmcHostInternalServerError
+*/
+type V2CreateBmcHostInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateBmcHostInternalServerError creates V2CreateBmcHostInternalServerError with default headers values
+func NewV2CreateBmcHostInternalServerError() *V2CreateBmcHostInternalServerError {
+
+	return &V2CreateBmcHostInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 create bmc host internal server error response
+func (o *V2CreateBmcHostInternalServerError) WithPayload(payload *models.Error) *V2CreateBmcHostInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create bmc host internal server error response
+func (o *V2CreateBmcHostInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateBmcHostInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
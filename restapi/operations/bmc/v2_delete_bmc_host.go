@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeleteBmcHostHandlerFunc turns a function with the right signature into a v2 delete bmc host handler
+type V2DeleteBmcHostHandlerFunc func(V2DeleteBmcHostParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeleteBmcHostHandlerFunc) Handle(params V2DeleteBmcHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeleteBmcHostHandler interface for that can handle valid v2 delete bmc host params
+type V2DeleteBmcHostHandler interface {
+	Handle(V2DeleteBmcHostParams, interface{}) middleware.Responder
+}
+
+// NewV2DeleteBmcHost creates a new http.Handler for the v2 delete bmc host operation
+func NewV2DeleteBmcHost(ctx *middleware.Context, handler V2DeleteBmcHostHandler) *V2DeleteBmcHost {
+	return &V2DeleteBmcHost{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DeleteBmcHost swagger:route DELETE /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id} bmc v2DeleteBmcHost
+
+Deletes a BMC host together with its credentials. The host itself isn't changed.
+*/
+type V2DeleteBmcHost struct {
+	Context *middleware.Context
+	Handler V2DeleteBmcHostHandler
+}
+
+func (o *V2DeleteBmcHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeleteBmcHostParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
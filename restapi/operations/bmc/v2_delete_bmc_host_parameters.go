@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2DeleteBmcHostParams creates a new V2DeleteBmcHostParams object
+//
+// There are no default values defined in the spec.
+func NewV2DeleteBmcHostParams() V2DeleteBmcHostParams {
+
+	return V2DeleteBmcHostParams{}
+}
+
+// V2DeleteBmcHostParams contains all the bound params for the v2 delete bmc host operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2DeleteBmcHost
+type V2DeleteBmcHostParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The BMC host.
+	  Required: true
+	  In: path
+	*/
+	BmcHostID strfmt.UUID
+	/*The infra-env of the BMC host.
+	  Required: true
+	  In: path
+	*/
+	InfraEnvID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2DeleteBmcHostParams() beforehand.
+func (o *V2DeleteBmcHostParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rBmcHostID, rhkBmcHostID, _ := route.Params.GetOK("bmc_host_id")
+	if err := o.bindBmcHostID(rBmcHostID, rhkBmcHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rInfraEnvID, rhkInfraEnvID, _ := route.Params.GetOK("infra_env_id")
+	if err := o.bindInfraEnvID(rInfraEnvID, rhkInfraEnvID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBmcHostID binds and validates parameter BmcHostID from path.
+func (o *V2DeleteBmcHostParams) bindBmcHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("bmc_host_id", "path", "strfmt.UUID", raw)
+	}
+	o.BmcHostID = *(value.(*strfmt.UUID))
+
+	if err := o.validateBmcHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateBmcHostID carries on validations for parameter BmcHostID
+func (o *V2DeleteBmcHostParams) validateBmcHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("bmc_host_id", "path", "uuid", o.BmcHostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindInfraEnvID binds and validates parameter InfraEnvID from path.
+func (o *V2DeleteBmcHostParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("infra_env_id", "path", "strfmt.UUID", raw)
+	}
+	o.InfraEnvID = *(value.(*strfmt.UUID))
+
+	if err := o.validateInfraEnvID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInfraEnvID carries on validations for parameter InfraEnvID
+func (o *V2DeleteBmcHostParams) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("infra_env_id", "path", "uuid", o.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteBmcHostNoContentCode is the HTTP code returned for type V2DeleteBmcHostNoContent
+const V2DeleteBmcHostNoContentCode int = 204
+
+/*
+V2DeleteBmcHostNoContent Success.
+
+swagger:response v2DeleteBmcHostNoContent
+*/
+type V2DeleteBmcHostNoContent struct {
+}
+
+// NewV2DeleteBmcHostNoContent creates V2DeleteBmcHostNoContent with default headers values
+func NewV2DeleteBmcHostNoContent() *V2DeleteBmcHostNoContent {
+
+	return &V2DeleteBmcHostNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeleteBmcHostNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeleteBmcHostUnauthorizedCode is the HTTP code returned for type V2DeleteBmcHostUnauthorized
+const V2DeleteBmcHostUnauthorizedCode int = 401
+
+/*
+V2DeleteBmcHostUnauthorized Unauthorized.
+
+swagger:response v2DeleteBmcHostUnauthorized
+*/
+type V2DeleteBmcHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteBmcHostUnauthorized creates V2DeleteBmcHostUnauthorized with default headers values
+func NewV2DeleteBmcHostUnauthorized() *V2DeleteBmcHostUnauthorized {
+
+	return &V2DeleteBmcHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 delete bmc host unauthorized response
+func (o *V2DeleteBmcHostUnauthorized) WithPayload(payload *models.InfraError) *V2DeleteBmcHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete bmc host unauthorized response
+func (o *V2DeleteBmcHostUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteBmcHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteBmcHostForbiddenCode is the HTTP code returned for type V2DeleteBmcHostForbidden
+const V2DeleteBmcHostForbiddenCode int = 403
+
+/*
+V2DeleteBmcHostForbidden Forbidden.
+
+swagger:response v2DeleteBmcHostForbidden
+*/
+type V2DeleteBmcHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteBmcHostForbidden creates V2DeleteBmcHostForbidden with default headers values
+func NewV2DeleteBmcHostForbidden() *V2DeleteBmcHostForbidden {
+
+	return &V2DeleteBmcHostForbidden{}
+}
+
+// WithPayload adds the payload to the v2 delete bmc host forbidden response
+func (o *V2DeleteBmcHostForbidden) WithPayload(payload *models.InfraError) *V2DeleteBmcHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete bmc host forbidden response
+func (o *V2DeleteBmcHostForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteBmcHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteBmcHostNotFoundCode is the HTTP code returned for type V2DeleteBmcHostNotFound
+const V2DeleteBmcHostNotFoundCode int = 404
+
+/*
+V2DeleteBmcHostNotFound Error.
+
+swagger:response v2DeleteBmcHostNotFound
+*/
+type V2DeleteBmcHostNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteBmcHostNotFound creates V2DeleteBmcHostNotFound with default headers values
+func NewV2DeleteBmcHostNotFound() *V2DeleteBmcHostNotFound {
+
+	return &V2DeleteBmcHostNotFound{}
+}
+
+// WithPayload adds the payload to the v2 delete bmc host not found response
+func (o *V2DeleteBmcHostNotFound) WithPayload(payload *models.Error) *V2DeleteBmcHostNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete bmc host not found response
+func (o *V2DeleteBmcHostNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteBmcHostNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteBmcHostInternalServerErrorCode is the HTTP code returned for type V2DeleteBmcHostInternalServerError
+const V2DeleteBmcHostInternalServerErrorCode int = 500
+
+/*
+V2DeleteBmcHostInternalServerError Error.
+
+swagger:response v2DeleteBmcHostInternalServerError
+*/
+type V2DeleteBmcHostInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteBmcHostInternalServerError creates V2DeleteBmcHostInternalServerError with default headers values
+func NewV2DeleteBmcHostInternalServerError() *V2DeleteBmcHostInternalServerError {
+
+	return &V2DeleteBmcHostInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 delete bmc host internal server error response
+func (o *V2DeleteBmcHostInternalServerError) WithPayload(payload *models.Error) *V2DeleteBmcHostInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete bmc host internal server error response
+func (o *V2DeleteBmcHostInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteBmcHostInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
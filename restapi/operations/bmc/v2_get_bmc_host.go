@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetBmcHostHandlerFunc turns a function with the right signature into a v2 get bmc host handler
+type V2GetBmcHostHandlerFunc func(V2GetBmcHostParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetBmcHostHandlerFunc) Handle(params V2GetBmcHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetBmcHostHandler interface for that can handle valid v2 get bmc host params
+type V2GetBmcHostHandler interface {
+	Handle(V2GetBmcHostParams, interface{}) middleware.Responder
+}
+
+// NewV2GetBmcHost creates a new http.Handler for the v2 get bmc host operation
+func NewV2GetBmcHost(ctx *middleware.Context, handler V2GetBmcHostHandler) *V2GetBmcHost {
+	return &V2GetBmcHost{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetBmcHost swagger:route GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id} bmc v2GetBmcHost
+
+Retrieves a BMC host.
+*/
+type V2GetBmcHost struct {
+	Context *middleware.Context
+	Handler V2GetBmcHostHandler
+}
+
+func (o *V2GetBmcHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetBmcHostParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetBmcHostOKCode is the HTTP code returned for type V2GetBmcHostOK
+const V2GetBmcHostOKCode int = 200
+
+/*
+V2GetBmcHostOK Success.
+
+swagger:response v2GetBmcHostOK
+*/
+type V2GetBmcHostOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostOK creates V2GetBmcHostOK with default headers values
+func NewV2GetBmcHostOK() *V2GetBmcHostOK {
+
+	return &V2GetBmcHostOK{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host o k response
+func (o *V2GetBmcHostOK) WithPayload(payload *models.BmcHost) *V2GetBmcHostOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host o k response
+func (o *V2GetBmcHostOK) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostUnauthorizedCode is the HTTP code returned for type V2GetBmcHostUnauthorized
+const V2GetBmcHostUnauthorizedCode int = 401
+
+/*
+V2GetBmcHostUnauthorized Unauthorized.
+
+swagger:response v2GetBmcHostUnauthorized
+*/
+type V2GetBmcHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostUnauthorized creates V2GetBmcHostUnauthorized with default headers values
+func NewV2GetBmcHostUnauthorized() *V2GetBmcHostUnauthorized {
+
+	return &V2GetBmcHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host unauthorized response
+func (o *V2GetBmcHostUnauthorized) WithPayload(payload *models.InfraError) *V2GetBmcHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host unauthorized response
+func (o *V2GetBmcHostUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostForbiddenCode is the HTTP code returned for type V2GetBmcHostForbidden
+const V2GetBmcHostForbiddenCode int = 403
+
+/*
+V2GetBmcHostForbidden Forbidden.
+
+swagger:response v2GetBmcHostForbidden
+*/
+type V2GetBmcHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostForbidden creates V2GetBmcHostForbidden with default headers values
+func NewV2GetBmcHostForbidden() *V2GetBmcHostForbidden {
+
+	return &V2GetBmcHostForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host forbidden response
+func (o *V2GetBmcHostForbidden) WithPayload(payload *models.InfraError) *V2GetBmcHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host forbidden response
+func (o *V2GetBmcHostForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostNotFoundCode is the HTTP code returned for type V2GetBmcHostNotFound
+const V2GetBmcHostNotFoundCode int = 404
+
+/*
+V2GetBmcHostNotFound Error.
+
+swagger:response v2GetBmcHostNotFound
+*/
+type V2GetBmcHostNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostNotFound creates V2GetBmcHostNotFound with default headers values
+func NewV2GetBmcHostNotFound() *V2GetBmcHostNotFound {
+
+	return &V2GetBmcHostNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host not found response
+func (o *V2GetBmcHostNotFound) WithPayload(payload *models.Error) *V2GetBmcHostNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host not found response
+func (o *V2GetBmcHostNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostInternalServerErrorCode is the HTTP code returned for type V2GetBmcHostInternalServerError
+const V2GetBmcHostInternalServerErrorCode int = 500
+
+/*
+V2GetBmcHostInternalServerError Error.
+
+swagger:response v2GetBmcHostInternalServerError
+*/
+type V2GetBmcHostInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostInternalServerError creates V2GetBmcHostInternalServerError with default headers values
+func NewV2GetBmcHostInternalServerError() *V2GetBmcHostInternalServerError {
+
+	return &V2GetBmcHostInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host internal server error response
+func (o *V2GetBmcHostInternalServerError) WithPayload(payload *models.Error) *V2GetBmcHostInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host internal server error response
+func (o *V2GetBmcHostInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetBmcHostStatusHandlerFunc turns a function with the right signature into a v2 get bmc host status handler
+type V2GetBmcHostStatusHandlerFunc func(V2GetBmcHostStatusParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetBmcHostStatusHandlerFunc) Handle(params V2GetBmcHostStatusParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetBmcHostStatusHandler interface for that can handle valid v2 get bmc host status params
+type V2GetBmcHostStatusHandler interface {
+	Handle(V2GetBmcHostStatusParams, interface{}) middleware.Responder
+}
+
+// NewV2GetBmcHostStatus creates a new http.Handler for the v2 get bmc host status operation
+func NewV2GetBmcHostStatus(ctx *middleware.Context, handler V2GetBmcHostStatusHandler) *V2GetBmcHostStatus {
+	return &V2GetBmcHostStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetBmcHostStatus swagger:route GET /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id}/status bmc v2GetBmcHostStatus
+
+Retrieves the power state, the boot override and the virtual media of a BMC host from its BMC.
+*/
+type V2GetBmcHostStatus struct {
+	Context *middleware.Context
+	Handler V2GetBmcHostStatusHandler
+}
+
+func (o *V2GetBmcHostStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetBmcHostStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
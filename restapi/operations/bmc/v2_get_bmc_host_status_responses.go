@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetBmcHostStatusOKCode is the HTTP code returned for type V2GetBmcHostStatusOK
+const V2GetBmcHostStatusOKCode int = 200
+
+/*
+V2GetBmcHostStatusOK Success.
+
+swagger:response v2GetBmcHostStatusOK
+*/
+type V2GetBmcHostStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHostStatus `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostStatusOK creates V2GetBmcHostStatusOK with default headers values
+func NewV2GetBmcHostStatusOK() *V2GetBmcHostStatusOK {
+
+	return &V2GetBmcHostStatusOK{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host status o k response
+func (o *V2GetBmcHostStatusOK) WithPayload(payload *models.BmcHostStatus) *V2GetBmcHostStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host status o k response
+func (o *V2GetBmcHostStatusOK) SetPayload(payload *models.BmcHostStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostStatusUnauthorizedCode is the HTTP code returned for type V2GetBmcHostStatusUnauthorized
+const V2GetBmcHostStatusUnauthorizedCode int = 401
+
+/*
+V2GetBmcHostStatusUnauthorized Unauthorized.
+
+swagger:response v2GetBmcHostStatusUnauthorized
+*/
+type V2GetBmcHostStatusUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostStatusUnauthorized creates V2GetBmcHostStatusUnauthorized with default headers values
+func NewV2GetBmcHostStatusUnauthorized() *V2GetBmcHostStatusUnauthorized {
+
+	return &V2GetBmcHostStatusUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host status unauthorized response
+func (o *V2GetBmcHostStatusUnauthorized) WithPayload(payload *models.InfraError) *V2GetBmcHostStatusUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host status unauthorized response
+func (o *V2GetBmcHostStatusUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostStatusForbiddenCode is the HTTP code returned for type V2GetBmcHostStatusForbidden
+const V2GetBmcHostStatusForbiddenCode int = 403
+
+/*
+V2GetBmcHostStatusForbidden Forbidden.
+
+swagger:response v2GetBmcHostStatusForbidden
+*/
+type V2GetBmcHostStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostStatusForbidden creates V2GetBmcHostStatusForbidden with default headers values
+func NewV2GetBmcHostStatusForbidden() *V2GetBmcHostStatusForbidden {
+
+	return &V2GetBmcHostStatusForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host status forbidden response
+func (o *V2GetBmcHostStatusForbidden) WithPayload(payload *models.InfraError) *V2GetBmcHostStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host status forbidden response
+func (o *V2GetBmcHostStatusForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostStatusNotFoundCode is the HTTP code returned for type V2GetBmcHostStatusNotFound
+const V2GetBmcHostStatusNotFoundCode int = 404
+
+/*
+V2GetBmcHostStatusNotFound Error.
+
+swagger:response v2GetBmcHostStatusNotFound
+*/
+type V2GetBmcHostStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostStatusNotFound creates V2GetBmcHostStatusNotFound with default headers values
+func NewV2GetBmcHostStatusNotFound() *V2GetBmcHostStatusNotFound {
+
+	return &V2GetBmcHostStatusNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host status not found response
+func (o *V2GetBmcHostStatusNotFound) WithPayload(payload *models.Error) *V2GetBmcHostStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host status not found response
+func (o *V2GetBmcHostStatusNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBmcHostStatusInternalServerErrorCode is the HTTP code returned for type V2GetBmcHostStatusInternalServerError
+const V2GetBmcHostStatusInternalServerErrorCode int = 500
+
+/*
+V2GetBmcHostStatusInternalServerError Error.
+
+swagger:response v2GetBmcHostStatusInternalServerError
+*/
+type V2GetBmcHostStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBmcHostStatusInternalServerError creates V2GetBmcHostStatusInternalServerError with default headers values
+func NewV2GetBmcHostStatusInternalServerError() *V2GetBmcHostStatusInternalServerError {
+
+	return &V2GetBmcHostStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get bmc host status internal server error response
+func (o *V2GetBmcHostStatusInternalServerError) WithPayload(payload *models.Error) *V2GetBmcHostStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bmc host status internal server error response
+func (o *V2GetBmcHostStatusInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBmcHostStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListBmcHostsHandlerFunc turns a function with the right signature into a v2 list bmc hosts handler
+type V2ListBmcHostsHandlerFunc func(V2ListBmcHostsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListBmcHostsHandlerFunc) Handle(params V2ListBmcHostsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListBmcHostsHandler interface for that can handle valid v2 list bmc hosts params
+type V2ListBmcHostsHandler interface {
+	Handle(V2ListBmcHostsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListBmcHosts creates a new http.Handler for the v2 list bmc hosts operation
+func NewV2ListBmcHosts(ctx *middleware.Context, handler V2ListBmcHostsHandler) *V2ListBmcHosts {
+	return &V2ListBmcHosts{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListBmcHosts swagger:route GET /v2/infra-envs/{infra_env_id}/bmc-hosts bmc v2ListBmcHosts
+
+Lists the BMC hosts of the infra-env.
+*/
+type V2ListBmcHosts struct {
+	Context *middleware.Context
+	Handler V2ListBmcHostsHandler
+}
+
+func (o *V2ListBmcHosts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListBmcHostsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListBmcHostsOKCode is the HTTP code returned for type V2ListBmcHostsOK
+const V2ListBmcHostsOKCode int = 200
+
+/*
+V2ListBmcHostsOK Success.
+
+swagger:response v2ListBmcHostsOK
+*/
+type V2ListBmcHostsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.BmcHostList `json:"body,omitempty"`
+}
+
+// NewV2ListBmcHostsOK creates V2ListBmcHostsOK with default headers values
+func NewV2ListBmcHostsOK() *V2ListBmcHostsOK {
+
+	return &V2ListBmcHostsOK{}
+}
+
+// WithPayload adds the payload to the v2 list bmc hosts o k response
+func (o *V2ListBmcHostsOK) WithPayload(payload models.BmcHostList) *V2ListBmcHostsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bmc hosts o k response
+func (o *V2ListBmcHostsOK) SetPayload(payload models.BmcHostList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBmcHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.BmcHostList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListBmcHostsUnauthorizedCode is the HTTP code returned for type V2ListBmcHostsUnauthorized
+const V2ListBmcHostsUnauthorizedCode int = 401
+
+/*
+V2ListBmcHostsUnauthorized Unauthorized.
+
+swagger:response v2ListBmcHostsUnauthorized
+*/
+type V2ListBmcHostsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListBmcHostsUnauthorized creates V2ListBmcHostsUnauthorized with default headers values
+func NewV2ListBmcHostsUnauthorized() *V2ListBmcHostsUnauthorized {
+
+	return &V2ListBmcHostsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list bmc hosts unauthorized response
+func (o *V2ListBmcHostsUnauthorized) WithPayload(payload *models.InfraError) *V2ListBmcHostsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bmc hosts unauthorized response
+func (o *V2ListBmcHostsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBmcHostsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListBmcHostsForbiddenCode is the HTTP code returned for type V2ListBmcHostsForbidden
+const V2ListBmcHostsForbiddenCode int = 403
+
+/*
+V2ListBmcHostsForbidden Forbidden.
+
+swagger:response v2ListBmcHostsForbidden
+*/
+type V2ListBmcHostsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListBmcHostsForbidden creates V2ListBmcHostsForbidden with default headers values
+func NewV2ListBmcHostsForbidden() *V2ListBmcHostsForbidden {
+
+	return &V2ListBmcHostsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list bmc hosts forbidden response
+func (o *V2ListBmcHostsForbidden) WithPayload(payload *models.InfraError) *V2ListBmcHostsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bmc hosts forbidden response
+func (o *V2ListBmcHostsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBmcHostsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListBmcHostsNotFoundCode is the HTTP code returned for type V2ListBmcHostsNotFound
+const V2ListBmcHostsNotFoundCode int = 404
+
+/*
+V2ListBmcHostsNotFound Error.
+
+swagger:response v2ListBmcHostsNotFound
+*/
+type V2ListBmcHostsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListBmcHostsNotFound creates V2ListBmcHostsNotFound with default headers values
+func NewV2ListBmcHostsNotFound() *V2ListBmcHostsNotFound {
+
+	return &V2ListBmcHostsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list bmc hosts not found response
+func (o *V2ListBmcHostsNotFound) WithPayload(payload *models.Error) *V2ListBmcHostsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bmc hosts not found response
+func (o *V2ListBmcHostsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBmcHostsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListBmcHostsInternalServerErrorCode is the HTTP code returned for type V2ListBmcHostsInternalServerError
+const V2ListBmcHostsInternalServerErrorCode int = 500
+
+/*
+V2ListBmcHostsInternalServerError Error.
+
+swagger:response v2ListBmcHostsInternalServerError
+*/
+type V2ListBmcHostsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListBmcHostsInternalServerError creates V2ListBmcHostsInternalServerError with default headers values
+func NewV2ListBmcHostsInternalServerError() *V2ListBmcHostsInternalServerError {
+
+	return &V2ListBmcHostsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list bmc hosts internal server error response
+func (o *V2ListBmcHostsInternalServerError) WithPayload(payload *models.Error) *V2ListBmcHostsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bmc hosts internal server error response
+func (o *V2ListBmcHostsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBmcHostsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
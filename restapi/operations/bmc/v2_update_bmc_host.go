@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateBmcHostHandlerFunc turns a function with the right signature into a v2 update bmc host handler
+type V2UpdateBmcHostHandlerFunc func(V2UpdateBmcHostParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateBmcHostHandlerFunc) Handle(params V2UpdateBmcHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateBmcHostHandler interface for that can handle valid v2 update bmc host params
+type V2UpdateBmcHostHandler interface {
+	Handle(V2UpdateBmcHostParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateBmcHost creates a new http.Handler for the v2 update bmc host operation
+func NewV2UpdateBmcHost(ctx *middleware.Context, handler V2UpdateBmcHostHandler) *V2UpdateBmcHost {
+	return &V2UpdateBmcHost{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateBmcHost swagger:route PATCH /v2/infra-envs/{infra_env_id}/bmc-hosts/{bmc_host_id} bmc v2UpdateBmcHost
+
+Updates the address, the credentials or the host of a BMC host.
+*/
+type V2UpdateBmcHost struct {
+	Context *middleware.Context
+	Handler V2UpdateBmcHostHandler
+}
+
+func (o *V2UpdateBmcHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateBmcHostParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
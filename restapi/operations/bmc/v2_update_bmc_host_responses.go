@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package bmc
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateBmcHostOKCode is the HTTP code returned for type V2UpdateBmcHostOK
+const V2UpdateBmcHostOKCode int = 200
+
+/*
+V2UpdateBmcHostOK Success.
+
+swagger:response v2UpdateBmcHostOK
+*/
+type V2UpdateBmcHostOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BmcHost `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostOK creates V2UpdateBmcHostOK with default headers values
+func NewV2UpdateBmcHostOK() *V2UpdateBmcHostOK {
+
+	return &V2UpdateBmcHostOK{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host o k response
+func (o *V2UpdateBmcHostOK) WithPayload(payload *models.BmcHost) *V2UpdateBmcHostOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host o k response
+func (o *V2UpdateBmcHostOK) SetPayload(payload *models.BmcHost) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateBmcHostBadRequestCode is the HTTP code returned for type V2UpdateBmcHostBadRequest
+const V2UpdateBmcHostBadRequestCode int = 400
+
+/*
+V2UpdateBmcHostBadRequest Error.
+
+swagger:response v2UpdateBmcHostBadRequest
+*/
+type V2UpdateBmcHostBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostBadRequest creates V2UpdateBmcHostBadRequest with default headers values
+func NewV2UpdateBmcHostBadRequest() *V2UpdateBmcHostBadRequest {
+
+	return &V2UpdateBmcHostBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host bad request response
+func (o *V2UpdateBmcHostBadRequest) WithPayload(payload *models.Error) *V2UpdateBmcHostBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host bad request response
+func (o *V2UpdateBmcHostBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateBmcHostUnauthorizedCode is the HTTP code returned for type V2UpdateBmcHostUnauthorized
+const V2UpdateBmcHostUnauthorizedCode int = 401
+
+/*
+V2UpdateBmcHostUnauthorized Unauthorized.
+
+swagger:response v2UpdateBmcHostUnauthorized
+*/
+type V2UpdateBmcHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostUnauthorized creates V2UpdateBmcHostUnauthorized with default headers values
+func NewV2UpdateBmcHostUnauthorized() *V2UpdateBmcHostUnauthorized {
+
+	return &V2UpdateBmcHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host unauthorized response
+func (o *V2UpdateBmcHostUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateBmcHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host unauthorized response
+func (o *V2UpdateBmcHostUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateBmcHostForbiddenCode is the HTTP code returned for type V2UpdateBmcHostForbidden
+const V2UpdateBmcHostForbiddenCode int = 403
+
+/*
+V2UpdateBmcHostForbidden Forbidden.
+
+swagger:response v2UpdateBmcHostForbidden
+*/
+type V2UpdateBmcHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostForbidden creates V2UpdateBmcHostForbidden with default headers values
+func NewV2UpdateBmcHostForbidden() *V2UpdateBmcHostForbidden {
+
+	return &V2UpdateBmcHostForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host forbidden response
+func (o *V2UpdateBmcHostForbidden) WithPayload(payload *models.InfraError) *V2UpdateBmcHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host forbidden response
+func (o *V2UpdateBmcHostForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateBmcHostNotFoundCode is the HTTP code returned for type V2UpdateBmcHostNotFound
+const V2UpdateBmcHostNotFoundCode int = 404
+
+/*
+V2UpdateBmcHostNotFound Error.
+
+swagger:response v2UpdateBmcHostNotFound
+*/
+type V2UpdateBmcHostNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostNotFound creates V2UpdateBmcHostNotFound with default headers values
+func NewV2UpdateBmcHostNotFound() *V2UpdateBmcHostNotFound {
+
+	return &V2UpdateBmcHostNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host not found response
+func (o *V2UpdateBmcHostNotFound) WithPayload(payload *models.Error) *V2UpdateBmcHostNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host not found response
+func (o *V2UpdateBmcHostNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateBmcHostInternalServerErrorCode is the HTTP code returned for type V2UpdateBmcHostInternalServerError
+const V2UpdateBmcHostInternalServerErrorCode int = 500
+
+/*
+V2UpdateBmcHostInternalServerError Error.
+
+swagger:response v2UpdateBmcHostInternalServerError
+*/
+type V2UpdateBmcHostInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateBmcHostInternalServerError creates V2UpdateBmcHostInternalServerError with default headers values
+func NewV2UpdateBmcHostInternalServerError() *V2UpdateBmcHostInternalServerError {
+
+	return &V2UpdateBmcHostInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update bmc host internal server error response
+func (o *V2UpdateBmcHostInternalServerError) WithPayload(payload *models.Error) *V2UpdateBmcHostInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update bmc host internal server error response
+func (o *V2UpdateBmcHostInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateBmcHostInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
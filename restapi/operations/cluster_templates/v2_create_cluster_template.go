@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CreateClusterTemplateHandlerFunc turns a function with the right signature into a v2 create cluster template handler
+type V2CreateClusterTemplateHandlerFunc func(V2CreateClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CreateClusterTemplateHandlerFunc) Handle(params V2CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CreateClusterTemplateHandler interface for that can handle valid v2 create cluster template params
+type V2CreateClusterTemplateHandler interface {
+	Handle(V2CreateClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2CreateClusterTemplate creates a new http.Handler for the v2 create cluster template operation
+func NewV2CreateClusterTemplate(ctx *middleware.Context, handler V2CreateClusterTemplateHandler) *V2CreateClusterTemplate {
+	return &V2CreateClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2CreateClusterTemplate swagger:route POST /v2/cluster-templates cluster_templates v2CreateClusterTemplate
+
+Creates a template from which clusters can be created repeatedly.
+*/
+type V2CreateClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2CreateClusterTemplateHandler
+}
+
+func (o *V2CreateClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CreateClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateClusterTemplateCreatedCode is the HTTP code returned for type V2CreateClusterTemplateCreated
+const V2CreateClusterTemplateCreatedCode int = 201
+
+/*
+V2CreateClusterTemplateCreated Success.
+
+swagger:response v2CreateClusterTemplateCreated
+*/
+type V2CreateClusterTemplateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterTemplateCreated creates V2CreateClusterTemplateCreated with default headers values
+func NewV2CreateClusterTemplateCreated() *V2CreateClusterTemplateCreated {
+
+	return &V2CreateClusterTemplateCreated{}
+}
+
+// WithPayload adds the payload to the v2 create cluster template created response
+func (o *V2CreateClusterTemplateCreated) WithPayload(payload *models.ClusterTemplate) *V2CreateClusterTemplateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster template created response
+func (o *V2CreateClusterTemplateCreated) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterTemplateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterTemplateBadRequestCode is the HTTP code returned for type V2CreateClusterTemplateBadRequest
+const V2CreateClusterTemplateBadRequestCode int = 400
+
+/*
+V2CreateClusterTemplateBadRequest Error.
+
+swagger:response v2CreateClusterTemplateBadRequest
+*/
+type V2CreateClusterTemplateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterTemplateBadRequest creates V2CreateClusterTemplateBadRequest with default headers values
+func NewV2CreateClusterTemplateBadRequest() *V2CreateClusterTemplateBadRequest {
+
+	return &V2CreateClusterTemplateBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 create cluster template bad request response
+func (o *V2CreateClusterTemplateBadRequest) WithPayload(payload *models.Error) *V2CreateClusterTemplateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster template bad request response
+func (o *V2CreateClusterTemplateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterTemplateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterTemplateUnauthorizedCode is the HTTP code returned for type V2CreateClusterTemplateUnauthorized
+const V2CreateClusterTemplateUnauthorizedCode int = 401
+
+/*
+V2CreateClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2CreateClusterTemplateUnauthorized
+*/
+type V2CreateClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterTemplateUnauthorized creates V2CreateClusterTemplateUnauthorized with default headers values
+func NewV2CreateClusterTemplateUnauthorized() *V2CreateClusterTemplateUnauthorized {
+
+	return &V2CreateClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 create cluster template unauthorized response
+func (o *V2CreateClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2CreateClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster template unauthorized response
+func (o *V2CreateClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterTemplateForbiddenCode is the HTTP code returned for type V2CreateClusterTemplateForbidden
+const V2CreateClusterTemplateForbiddenCode int = 403
+
+/*
+V2CreateClusterTemplateForbidden Forbidden.
+
+swagger:response v2CreateClusterTemplateForbidden
+*/
+type V2CreateClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterTemplateForbidden creates V2CreateClusterTemplateForbidden with default headers values
+func NewV2CreateClusterTemplateForbidden() *V2CreateClusterTemplateForbidden {
+
+	return &V2CreateClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 create cluster template forbidden response
+func (o *V2CreateClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2CreateClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster template forbidden response
+func (o *V2CreateClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2CreateClusterTemplateInternalServerError
+const V2CreateClusterTemplateInternalServerErrorCode int = 500
+
+/*
+V2CreateClusterTemplateInternalServerError Error.
+
+swagger:response v2CreateClusterTemplateInternalServerError
+*/
+type V2CreateClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterTemplateInternalServerError creates V2CreateClusterTemplateInternalServerError with default headers values
+func NewV2CreateClusterTemplateInternalServerError() *V2CreateClusterTemplateInternalServerError {
+
+	return &V2CreateClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 create cluster template internal server error response
+func (o *V2CreateClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2CreateClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster template internal server error response
+func (o *V2CreateClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeleteClusterTemplateHandlerFunc turns a function with the right signature into a v2 delete cluster template handler
+type V2DeleteClusterTemplateHandlerFunc func(V2DeleteClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeleteClusterTemplateHandlerFunc) Handle(params V2DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeleteClusterTemplateHandler interface for that can handle valid v2 delete cluster template params
+type V2DeleteClusterTemplateHandler interface {
+	Handle(V2DeleteClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2DeleteClusterTemplate creates a new http.Handler for the v2 delete cluster template operation
+func NewV2DeleteClusterTemplate(ctx *middleware.Context, handler V2DeleteClusterTemplateHandler) *V2DeleteClusterTemplate {
+	return &V2DeleteClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DeleteClusterTemplate swagger:route DELETE /v2/cluster-templates/{cluster_template_id} cluster_templates v2DeleteClusterTemplate
+
+Deletes a cluster template. Clusters already created from it are not changed.
+*/
+type V2DeleteClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2DeleteClusterTemplateHandler
+}
+
+func (o *V2DeleteClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeleteClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
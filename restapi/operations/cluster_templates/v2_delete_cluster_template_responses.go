@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteClusterTemplateNoContentCode is the HTTP code returned for type V2DeleteClusterTemplateNoContent
+const V2DeleteClusterTemplateNoContentCode int = 204
+
+/*
+V2DeleteClusterTemplateNoContent Success.
+
+swagger:response v2DeleteClusterTemplateNoContent
+*/
+type V2DeleteClusterTemplateNoContent struct {
+}
+
+// NewV2DeleteClusterTemplateNoContent creates V2DeleteClusterTemplateNoContent with default headers values
+func NewV2DeleteClusterTemplateNoContent() *V2DeleteClusterTemplateNoContent {
+
+	return &V2DeleteClusterTemplateNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeleteClusterTemplateNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeleteClusterTemplateUnauthorizedCode is the HTTP code returned for type V2DeleteClusterTemplateUnauthorized
+const V2DeleteClusterTemplateUnauthorizedCode int = 401
+
+/*
+V2DeleteClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2DeleteClusterTemplateUnauthorized
+*/
+type V2DeleteClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteClusterTemplateUnauthorized creates V2DeleteClusterTemplateUnauthorized with default headers values
+func NewV2DeleteClusterTemplateUnauthorized() *V2DeleteClusterTemplateUnauthorized {
+
+	return &V2DeleteClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 delete cluster template unauthorized response
+func (o *V2DeleteClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2DeleteClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete cluster template unauthorized response
+func (o *V2DeleteClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteClusterTemplateForbiddenCode is the HTTP code returned for type V2DeleteClusterTemplateForbidden
+const V2DeleteClusterTemplateForbiddenCode int = 403
+
+/*
+V2DeleteClusterTemplateForbidden Forbidden.
+
+swagger:response v2DeleteClusterTemplateForbidden
+*/
+type V2DeleteClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteClusterTemplateForbidden creates V2DeleteClusterTemplateForbidden with default headers values
+func NewV2DeleteClusterTemplateForbidden() *V2DeleteClusterTemplateForbidden {
+
+	return &V2DeleteClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 delete cluster template forbidden response
+func (o *V2DeleteClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2DeleteClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete cluster template forbidden response
+func (o *V2DeleteClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteClusterTemplateNotFoundCode is the HTTP code returned for type V2DeleteClusterTemplateNotFound
+const V2DeleteClusterTemplateNotFoundCode int = 404
+
+/*
+V2DeleteClusterTemplateNotFound Error.
+
+swagger:response v2DeleteClusterTemplateNotFound
+*/
+type V2DeleteClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteClusterTemplateNotFound creates V2DeleteClusterTemplateNotFound with default headers values
+func NewV2DeleteClusterTemplateNotFound() *V2DeleteClusterTemplateNotFound {
+
+	return &V2DeleteClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 delete cluster template not found response
+func (o *V2DeleteClusterTemplateNotFound) WithPayload(payload *models.Error) *V2DeleteClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete cluster template not found response
+func (o *V2DeleteClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2DeleteClusterTemplateInternalServerError
+const V2DeleteClusterTemplateInternalServerErrorCode int = 500
+
+/*
+V2DeleteClusterTemplateInternalServerError Error.
+
+swagger:response v2DeleteClusterTemplateInternalServerError
+*/
+type V2DeleteClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteClusterTemplateInternalServerError creates V2DeleteClusterTemplateInternalServerError with default headers values
+func NewV2DeleteClusterTemplateInternalServerError() *V2DeleteClusterTemplateInternalServerError {
+
+	return &V2DeleteClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 delete cluster template internal server error response
+func (o *V2DeleteClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2DeleteClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete cluster template internal server error response
+func (o *V2DeleteClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
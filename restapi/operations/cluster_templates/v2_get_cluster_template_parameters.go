@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2GetClusterTemplateParams creates a new V2GetClusterTemplateParams object
+//
+// There are no default values defined in the spec.
+func NewV2GetClusterTemplateParams() V2GetClusterTemplateParams {
+
+	return V2GetClusterTemplateParams{}
+}
+
+// V2GetClusterTemplateParams contains all the bound params for the v2 get cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2GetClusterTemplate
+type V2GetClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster template to be retrieved.
+	  Required: true
+	  In: path
+	*/
+	ClusterTemplateID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2GetClusterTemplateParams() beforehand.
+func (o *V2GetClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rClusterTemplateID, rhkClusterTemplateID, _ := route.Params.GetOK("cluster_template_id")
+	if err := o.bindClusterTemplateID(rClusterTemplateID, rhkClusterTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterTemplateID binds and validates parameter ClusterTemplateID from path.
+func (o *V2GetClusterTemplateParams) bindClusterTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_template_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterTemplateID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterTemplateID carries on validations for parameter ClusterTemplateID
+func (o *V2GetClusterTemplateParams) validateClusterTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_template_id", "path", "uuid", o.ClusterTemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2InstantiateClusterTemplateHandlerFunc turns a function with the right signature into a v2 instantiate cluster template handler
+type V2InstantiateClusterTemplateHandlerFunc func(V2InstantiateClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2InstantiateClusterTemplateHandlerFunc) Handle(params V2InstantiateClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2InstantiateClusterTemplateHandler interface for that can handle valid v2 instantiate cluster template params
+type V2InstantiateClusterTemplateHandler interface {
+	Handle(V2InstantiateClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2InstantiateClusterTemplate creates a new http.Handler for the v2 instantiate cluster template operation
+func NewV2InstantiateClusterTemplate(ctx *middleware.Context, handler V2InstantiateClusterTemplateHandler) *V2InstantiateClusterTemplate {
+	return &V2InstantiateClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2InstantiateClusterTemplate swagger:route POST /v2/cluster-templates/{cluster_template_id}/actions/instantiate cluster_templates v2InstantiateClusterTemplate
+
+Creates a cluster, its custom manifests and an infra-env for its hosts from a cluster template.
+*/
+type V2InstantiateClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2InstantiateClusterTemplateHandler
+}
+
+func (o *V2InstantiateClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2InstantiateClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
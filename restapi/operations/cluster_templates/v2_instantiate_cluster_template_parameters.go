@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2InstantiateClusterTemplateParams creates a new V2InstantiateClusterTemplateParams object
+//
+// There are no default values defined in the spec.
+func NewV2InstantiateClusterTemplateParams() V2InstantiateClusterTemplateParams {
+
+	return V2InstantiateClusterTemplateParams{}
+}
+
+// V2InstantiateClusterTemplateParams contains all the bound params for the v2 instantiate cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2InstantiateClusterTemplate
+type V2InstantiateClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster template to be instantiated.
+	  Required: true
+	  In: path
+	*/
+	ClusterTemplateID strfmt.UUID
+	/*The properties of the new cluster which are not taken from the template.
+	  Required: true
+	  In: body
+	*/
+	InstantiateParams *models.ClusterTemplateInstantiateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2InstantiateClusterTemplateParams() beforehand.
+func (o *V2InstantiateClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rClusterTemplateID, rhkClusterTemplateID, _ := route.Params.GetOK("cluster_template_id")
+	if err := o.bindClusterTemplateID(rClusterTemplateID, rhkClusterTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.ClusterTemplateInstantiateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("instantiateParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("instantiateParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.InstantiateParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("instantiateParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterTemplateID binds and validates parameter ClusterTemplateID from path.
+func (o *V2InstantiateClusterTemplateParams) bindClusterTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_template_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterTemplateID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterTemplateID carries on validations for parameter ClusterTemplateID
+func (o *V2InstantiateClusterTemplateParams) validateClusterTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_template_id", "path", "uuid", o.ClusterTemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InstantiateClusterTemplateCreatedCode is the HTTP code returned for type V2InstantiateClusterTemplateCreated
+const V2InstantiateClusterTemplateCreatedCode int = 201
+
+/*
+V2InstantiateClusterTemplateCreated Success.
+
+swagger:response v2InstantiateClusterTemplateCreated
+*/
+type V2InstantiateClusterTemplateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplateInstance `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateCreated creates V2InstantiateClusterTemplateCreated with default headers values
+func NewV2InstantiateClusterTemplateCreated() *V2InstantiateClusterTemplateCreated {
+
+	return &V2InstantiateClusterTemplateCreated{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template created response
+func (o *V2InstantiateClusterTemplateCreated) WithPayload(payload *models.ClusterTemplateInstance) *V2InstantiateClusterTemplateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template created response
+func (o *V2InstantiateClusterTemplateCreated) SetPayload(payload *models.ClusterTemplateInstance) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstantiateClusterTemplateBadRequestCode is the HTTP code returned for type V2InstantiateClusterTemplateBadRequest
+const V2InstantiateClusterTemplateBadRequestCode int = 400
+
+/*
+V2InstantiateClusterTemplateBadRequest Error.
+
+swagger:response v2InstantiateClusterTemplateBadRequest
+*/
+type V2InstantiateClusterTemplateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateBadRequest creates V2InstantiateClusterTemplateBadRequest with default headers values
+func NewV2InstantiateClusterTemplateBadRequest() *V2InstantiateClusterTemplateBadRequest {
+
+	return &V2InstantiateClusterTemplateBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template bad request response
+func (o *V2InstantiateClusterTemplateBadRequest) WithPayload(payload *models.Error) *V2InstantiateClusterTemplateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template bad request response
+func (o *V2InstantiateClusterTemplateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstantiateClusterTemplateUnauthorizedCode is the HTTP code returned for type V2InstantiateClusterTemplateUnauthorized
+const V2InstantiateClusterTemplateUnauthorizedCode int = 401
+
+/*
+V2InstantiateClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2InstantiateClusterTemplateUnauthorized
+*/
+type V2InstantiateClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateUnauthorized creates V2InstantiateClusterTemplateUnauthorized with default headers values
+func NewV2InstantiateClusterTemplateUnauthorized() *V2InstantiateClusterTemplateUnauthorized {
+
+	return &V2InstantiateClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template unauthorized response
+func (o *V2InstantiateClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2InstantiateClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template unauthorized response
+func (o *V2InstantiateClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstantiateClusterTemplateForbiddenCode is the HTTP code returned for type V2InstantiateClusterTemplateForbidden
+const V2InstantiateClusterTemplateForbiddenCode int = 403
+
+/*
+V2InstantiateClusterTemplateForbidden Forbidden.
+
+swagger:response v2InstantiateClusterTemplateForbidden
+*/
+type V2InstantiateClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateForbidden creates V2InstantiateClusterTemplateForbidden with default headers values
+func NewV2InstantiateClusterTemplateForbidden() *V2InstantiateClusterTemplateForbidden {
+
+	return &V2InstantiateClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template forbidden response
+func (o *V2InstantiateClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2InstantiateClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template forbidden response
+func (o *V2InstantiateClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstantiateClusterTemplateNotFoundCode is the HTTP code returned for type V2InstantiateClusterTemplateNotFound
+const V2InstantiateClusterTemplateNotFoundCode int = 404
+
+/*
+V2InstantiateClusterTemplateNotFound Error.
+
+swagger:response v2InstantiateClusterTemplateNotFound
+*/
+type V2InstantiateClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateNotFound creates V2InstantiateClusterTemplateNotFound with default headers values
+func NewV2InstantiateClusterTemplateNotFound() *V2InstantiateClusterTemplateNotFound {
+
+	return &V2InstantiateClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template not found response
+func (o *V2InstantiateClusterTemplateNotFound) WithPayload(payload *models.Error) *V2InstantiateClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template not found response
+func (o *V2InstantiateClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstantiateClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2InstantiateClusterTemplateInternalServerError
+const V2InstantiateClusterTemplateInternalServerErrorCode int = 500
+
+/*
+V2InstantiateClusterTemplateInternalServerError Error.
+
+swagger:response v2InstantiateClusterTemplateInternalServerError
+*/
+type V2InstantiateClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstantiateClusterTemplateInternalServerError creates V2InstantiateClusterTemplateInternalServerError with default headers values
+func NewV2InstantiateClusterTemplateInternalServerError() *V2InstantiateClusterTemplateInternalServerError {
+
+	return &V2InstantiateClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 instantiate cluster template internal server error response
+func (o *V2InstantiateClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2InstantiateClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 instantiate cluster template internal server error response
+func (o *V2InstantiateClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstantiateClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
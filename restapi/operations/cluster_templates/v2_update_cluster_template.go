@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateClusterTemplateHandlerFunc turns a function with the right signature into a v2 update cluster template handler
+type V2UpdateClusterTemplateHandlerFunc func(V2UpdateClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateClusterTemplateHandlerFunc) Handle(params V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateClusterTemplateHandler interface for that can handle valid v2 update cluster template params
+type V2UpdateClusterTemplateHandler interface {
+	Handle(V2UpdateClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateClusterTemplate creates a new http.Handler for the v2 update cluster template operation
+func NewV2UpdateClusterTemplate(ctx *middleware.Context, handler V2UpdateClusterTemplateHandler) *V2UpdateClusterTemplate {
+	return &V2UpdateClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateClusterTemplate swagger:route PATCH /v2/cluster-templates/{cluster_template_id} cluster_templates v2UpdateClusterTemplate
+
+Updates the description and the definition of a cluster template. Clusters already created from it are not changed.
+*/
+type V2UpdateClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2UpdateClusterTemplateHandler
+}
+
+func (o *V2UpdateClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DryRunGenerateHandlerFunc turns a function with the right signature into a v2 dry run generate handler
+type V2DryRunGenerateHandlerFunc func(V2DryRunGenerateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DryRunGenerateHandlerFunc) Handle(params V2DryRunGenerateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DryRunGenerateHandler interface for that can handle valid v2 dry run generate params
+type V2DryRunGenerateHandler interface {
+	Handle(V2DryRunGenerateParams, interface{}) middleware.Responder
+}
+
+// NewV2DryRunGenerate creates a new http.Handler for the v2 dry run generate operation
+func NewV2DryRunGenerate(ctx *middleware.Context, handler V2DryRunGenerateHandler) *V2DryRunGenerate {
+	return &V2DryRunGenerate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DryRunGenerate swagger:route POST /v2/clusters/{cluster_id}/actions/dry-run-generate dry_run v2DryRunGenerate
+
+Starts the manifests and ignition generation of the cluster installation against synthetic hosts, without changing the cluster. The generated files are stored apart from the ones of the cluster and every failure is reported. The generation runs in the background, its report is returned by v2GetDryRunGenerate.
+*/
+type V2DryRunGenerate struct {
+	Context *middleware.Context
+	Handler V2DryRunGenerateHandler
+}
+
+func (o *V2DryRunGenerate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DryRunGenerateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunGenerateAcceptedCode is the HTTP code returned for type V2DryRunGenerateAccepted
+const V2DryRunGenerateAcceptedCode int = 202
+
+/*
+V2DryRunGenerateAccepted Success.
+
+swagger:response v2DryRunGenerateAccepted
+*/
+type V2DryRunGenerateAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DryRunGenerateReport `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateAccepted creates V2DryRunGenerateAccepted with default headers values
+func NewV2DryRunGenerateAccepted() *V2DryRunGenerateAccepted {
+
+	return &V2DryRunGenerateAccepted{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate accepted response
+func (o *V2DryRunGenerateAccepted) WithPayload(payload *models.DryRunGenerateReport) *V2DryRunGenerateAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate accepted response
+func (o *V2DryRunGenerateAccepted) SetPayload(payload *models.DryRunGenerateReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunGenerateUnauthorizedCode is the HTTP code returned for type V2DryRunGenerateUnauthorized
+const V2DryRunGenerateUnauthorizedCode int = 401
+
+/*
+V2DryRunGenerateUnauthorized Unauthorized.
+
+swagger:response v2DryRunGenerateUnauthorized
+*/
+type V2DryRunGenerateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateUnauthorized creates V2DryRunGenerateUnauthorized with default headers values
+func NewV2DryRunGenerateUnauthorized() *V2DryRunGenerateUnauthorized {
+
+	return &V2DryRunGenerateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate unauthorized response
+func (o *V2DryRunGenerateUnauthorized) WithPayload(payload *models.InfraError) *V2DryRunGenerateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate unauthorized response
+func (o *V2DryRunGenerateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunGenerateForbiddenCode is the HTTP code returned for type V2DryRunGenerateForbidden
+const V2DryRunGenerateForbiddenCode int = 403
+
+/*
+V2DryRunGenerateForbidden Forbidden.
+
+swagger:response v2DryRunGenerateForbidden
+*/
+type V2DryRunGenerateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateForbidden creates V2DryRunGenerateForbidden with default headers values
+func NewV2DryRunGenerateForbidden() *V2DryRunGenerateForbidden {
+
+	return &V2DryRunGenerateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate forbidden response
+func (o *V2DryRunGenerateForbidden) WithPayload(payload *models.InfraError) *V2DryRunGenerateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate forbidden response
+func (o *V2DryRunGenerateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunGenerateNotFoundCode is the HTTP code returned for type V2DryRunGenerateNotFound
+const V2DryRunGenerateNotFoundCode int = 404
+
+/*
+V2DryRunGenerateNotFound Error.
+
+swagger:response v2DryRunGenerateNotFound
+*/
+type V2DryRunGenerateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateNotFound creates V2DryRunGenerateNotFound with default headers values
+func NewV2DryRunGenerateNotFound() *V2DryRunGenerateNotFound {
+
+	return &V2DryRunGenerateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate not found response
+func (o *V2DryRunGenerateNotFound) WithPayload(payload *models.Error) *V2DryRunGenerateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate not found response
+func (o *V2DryRunGenerateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunGenerateConflictCode is the HTTP code returned for type V2DryRunGenerateConflict
+const V2DryRunGenerateConflictCode int = 409
+
+/*
+V2DryRunGenerateConflict Error.
+
+swagger:response v2DryRunGenerateConflict
+*/
+type V2DryRunGenerateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateConflict creates V2DryRunGenerateConflict with default headers values
+func NewV2DryRunGenerateConflict() *V2DryRunGenerateConflict {
+
+	return &V2DryRunGenerateConflict{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate conflict response
+func (o *V2DryRunGenerateConflict) WithPayload(payload *models.Error) *V2DryRunGenerateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate conflict response
+func (o *V2DryRunGenerateConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunGenerateInternalServerErrorCode is the HTTP code returned for type V2DryRunGenerateInternalServerError
+const V2DryRunGenerateInternalServerErrorCode int = 500
+
+/*
+V2DryRunGenerateInternalServerError Error.
+
+swagger:response v2DryRunGenerateInternalServerError
+*/
+type V2DryRunGenerateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunGenerateInternalServerError creates V2DryRunGenerateInternalServerError with default headers values
+func NewV2DryRunGenerateInternalServerError() *V2DryRunGenerateInternalServerError {
+
+	return &V2DryRunGenerateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 dry run generate internal server error response
+func (o *V2DryRunGenerateInternalServerError) WithPayload(payload *models.Error) *V2DryRunGenerateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run generate internal server error response
+func (o *V2DryRunGenerateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunGenerateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
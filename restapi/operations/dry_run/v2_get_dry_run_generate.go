@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetDryRunGenerateHandlerFunc turns a function with the right signature into a v2 get dry run generate handler
+type V2GetDryRunGenerateHandlerFunc func(V2GetDryRunGenerateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetDryRunGenerateHandlerFunc) Handle(params V2GetDryRunGenerateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetDryRunGenerateHandler interface for that can handle valid v2 get dry run generate params
+type V2GetDryRunGenerateHandler interface {
+	Handle(V2GetDryRunGenerateParams, interface{}) middleware.Responder
+}
+
+// NewV2GetDryRunGenerate creates a new http.Handler for the v2 get dry run generate operation
+func NewV2GetDryRunGenerate(ctx *middleware.Context, handler V2GetDryRunGenerateHandler) *V2GetDryRunGenerate {
+	return &V2GetDryRunGenerate{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetDryRunGenerate swagger:route GET /v2/clusters/{cluster_id}/dry-run-generate dry_run v2GetDryRunGenerate
+
+Retrieves the report of the last dry run of the cluster installation files generation.
+*/
+type V2GetDryRunGenerate struct {
+	Context *middleware.Context
+	Handler V2GetDryRunGenerateHandler
+}
+
+func (o *V2GetDryRunGenerate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetDryRunGenerateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
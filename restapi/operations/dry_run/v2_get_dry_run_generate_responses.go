@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dry_run
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetDryRunGenerateOKCode is the HTTP code returned for type V2GetDryRunGenerateOK
+const V2GetDryRunGenerateOKCode int = 200
+
+/*
+V2GetDryRunGenerateOK Success.
+
+swagger:response v2GetDryRunGenerateOK
+*/
+type V2GetDryRunGenerateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DryRunGenerateReport `json:"body,omitempty"`
+}
+
+// NewV2GetDryRunGenerateOK creates V2GetDryRunGenerateOK with default headers values
+func NewV2GetDryRunGenerateOK() *V2GetDryRunGenerateOK {
+
+	return &V2GetDryRunGenerateOK{}
+}
+
+// WithPayload adds the payload to the v2 get dry run generate o k response
+func (o *V2GetDryRunGenerateOK) WithPayload(payload *models.DryRunGenerateReport) *V2GetDryRunGenerateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get dry run generate o k response
+func (o *V2GetDryRunGenerateOK) SetPayload(payload *models.DryRunGenerateReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetDryRunGenerateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetDryRunGenerateUnauthorizedCode is the HTTP code returned for type V2GetDryRunGenerateUnauthorized
+const V2GetDryRunGenerateUnauthorizedCode int = 401
+
+/*
+V2GetDryRunGenerateUnauthorized Unauthorized.
+
+swagger:response v2GetDryRunGenerateUnauthorized
+*/
+type V2GetDryRunGenerateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetDryRunGenerateUnauthorized creates V2GetDryRunGenerateUnauthorized with default headers values
+func NewV2GetDryRunGenerateUnauthorized() *V2GetDryRunGenerateUnauthorized {
+
+	return &V2GetDryRunGenerateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get dry run generate unauthorized response
+func (o *V2GetDryRunGenerateUnauthorized) WithPayload(payload *models.InfraError) *V2GetDryRunGenerateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get dry run generate unauthorized response
+func (o *V2GetDryRunGenerateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetDryRunGenerateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetDryRunGenerateForbiddenCode is the HTTP code returned for type V2GetDryRunGenerateForbidden
+const V2GetDryRunGenerateForbiddenCode int = 403
+
+/*
+V2GetDryRunGenerateForbidden Forbidden.
+
+swagger:response v2GetDryRunGenerateForbidden
+*/
+type V2GetDryRunGenerateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetDryRunGenerateForbidden creates V2GetDryRunGenerateForbidden with default headers values
+func NewV2GetDryRunGenerateForbidden() *V2GetDryRunGenerateForbidden {
+
+	return &V2GetDryRunGenerateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get dry run generate forbidden response
+func (o *V2GetDryRunGenerateForbidden) WithPayload(payload *models.InfraError) *V2GetDryRunGenerateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get dry run generate forbidden response
+func (o *V2GetDryRunGenerateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetDryRunGenerateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetDryRunGenerateNotFoundCode is the HTTP code returned for type V2GetDryRunGenerateNotFound
+const V2GetDryRunGenerateNotFoundCode int = 404
+
+/*
+V2GetDryRunGenerateNotFound Error.
+
+swagger:response v2GetDryRunGenerateNotFound
+*/
+type V2GetDryRunGenerateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetDryRunGenerateNotFound creates V2GetDryRunGenerateNotFound with default headers values
+func NewV2GetDryRunGenerateNotFound() *V2GetDryRunGenerateNotFound {
+
+	return &V2GetDryRunGenerateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get dry run generate not found response
+func (o *V2GetDryRunGenerateNotFound) WithPayload(payload *models.Error) *V2GetDryRunGenerateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get dry run generate not found response
+func (o *V2GetDryRunGenerateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetDryRunGenerateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetDryRunGenerateInternalServerErrorCode is the HTTP code returned for type V2GetDryRunGenerateInternalServerError
+const V2GetDryRunGenerateInternalServerErrorCode int = 500
+
+/*
+V2GetDryRunGenerateInternalServerError Error.
+
+swagger:response v2GetDryRunGenerateInternalServerError
+*/
+type V2GetDryRunGenerateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetDryRunGenerateInternalServerError creates V2GetDryRunGenerateInternalServerError with default headers values
+func NewV2GetDryRunGenerateInternalServerError() *V2GetDryRunGenerateInternalServerError {
+
+	return &V2GetDryRunGenerateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get dry run generate internal server error response
+func (o *V2GetDryRunGenerateInternalServerError) WithPayload(payload *models.Error) *V2GetDryRunGenerateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get dry run generate internal server error response
+func (o *V2GetDryRunGenerateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetDryRunGenerateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ExportHostHardwareHandlerFunc turns a function with the right signature into a v2 export host hardware handler
+type V2ExportHostHardwareHandlerFunc func(V2ExportHostHardwareParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ExportHostHardwareHandlerFunc) Handle(params V2ExportHostHardwareParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ExportHostHardwareHandler interface for that can handle valid v2 export host hardware params
+type V2ExportHostHardwareHandler interface {
+	Handle(V2ExportHostHardwareParams, interface{}) middleware.Responder
+}
+
+// NewV2ExportHostHardware creates a new http.Handler for the v2 export host hardware operation
+func NewV2ExportHostHardware(ctx *middleware.Context, handler V2ExportHostHardwareHandler) *V2ExportHostHardware {
+	return &V2ExportHostHardware{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ExportHostHardware swagger:route GET /v2/hardware-inventory/export hardware_inventory v2ExportHostHardware
+
+Exports the hardware of all the hosts of the tenant matching the filters.
+*/
+type V2ExportHostHardware struct {
+	Context *middleware.Context
+	Handler V2ExportHostHardwareHandler
+}
+
+func (o *V2ExportHostHardware) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ExportHostHardwareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,792 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2ExportHostHardwareParams creates a new V2ExportHostHardwareParams object
+// with the default values initialized.
+func NewV2ExportHostHardwareParams() V2ExportHostHardwareParams {
+
+	var (
+		// initialize parameters with default values
+
+		formatDefault = string("csv")
+	)
+
+	return V2ExportHostHardwareParams{
+		Format: &formatDefault,
+	}
+}
+
+// V2ExportHostHardwareParams contains all the bound params for the v2 export host hardware operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ExportHostHardware
+type V2ExportHostHardwareParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only return the hosts bound to a cluster when true, or not bound to a cluster when false.
+	  In: query
+	*/
+	Bound *bool
+	/*Only return the hosts bound to this cluster.
+	  In: query
+	*/
+	ClusterID *strfmt.UUID
+	/*Only return the hosts of this CPU architecture.
+	  In: query
+	*/
+	CPUArchitecture *string
+	/*Only return the hosts with disks of all these types.
+	  In: query
+	*/
+	DiskTypes []string
+	/*The format of the exported file.
+	  In: query
+	  Default: "csv"
+	*/
+	Format *string
+	/*Only return the hosts of this infra-env.
+	  In: query
+	*/
+	InfraEnvID *strfmt.UUID
+	/*Only return the hosts with at most this number of CPU cores.
+	  Minimum: 0
+	  In: query
+	*/
+	MaxCPUCores *int64
+	/*Only return the hosts with at most this physical memory, in MiB.
+	  Minimum: 0
+	  In: query
+	*/
+	MaxMemoryMib *int64
+	/*Only return the hosts with at least this number of CPU cores.
+	  Minimum: 0
+	  In: query
+	*/
+	MinCPUCores *int64
+	/*Only return the hosts with at least this number of disks.
+	  Minimum: 0
+	  In: query
+	*/
+	MinDiskCount *int64
+	/*Only return the hosts with a disk of at least this size, in GB.
+	  Minimum: 0
+	  In: query
+	*/
+	MinDiskSizeGb *int64
+	/*Only return the hosts with at least this physical memory, in MiB.
+	  Minimum: 0
+	  In: query
+	*/
+	MinMemoryMib *int64
+	/*Only return the hosts with at least this number of network interfaces.
+	  Minimum: 0
+	  In: query
+	*/
+	MinNicCount *int64
+	/*Only return the hosts with a network interface of at least this speed, in Mbps.
+	  Minimum: 0
+	  In: query
+	*/
+	MinNicSpeedMbps *int64
+	/*Only return the hosts of this system product name, case-sensitive.
+	  In: query
+	*/
+	Product *string
+	/*Only return the hosts in one of these states.
+	  In: query
+	*/
+	Status []string
+	/*Only return the hosts of this system manufacturer, case-sensitive.
+	  In: query
+	*/
+	Vendor *string
+	/*Only return the virtual hosts when true, or the physical hosts when false.
+	  In: query
+	*/
+	Virtual *bool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ExportHostHardwareParams() beforehand.
+func (o *V2ExportHostHardwareParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qBound, qhkBound, _ := qs.GetOK("bound")
+	if err := o.bindBound(qBound, qhkBound, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qClusterID, qhkClusterID, _ := qs.GetOK("cluster_id")
+	if err := o.bindClusterID(qClusterID, qhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCPUArchitecture, qhkCPUArchitecture, _ := qs.GetOK("cpu_architecture")
+	if err := o.bindCPUArchitecture(qCPUArchitecture, qhkCPUArchitecture, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDiskTypes, qhkDiskTypes, _ := qs.GetOK("disk_types")
+	if err := o.bindDiskTypes(qDiskTypes, qhkDiskTypes, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInfraEnvID, qhkInfraEnvID, _ := qs.GetOK("infra_env_id")
+	if err := o.bindInfraEnvID(qInfraEnvID, qhkInfraEnvID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMaxCPUCores, qhkMaxCPUCores, _ := qs.GetOK("max_cpu_cores")
+	if err := o.bindMaxCPUCores(qMaxCPUCores, qhkMaxCPUCores, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMaxMemoryMib, qhkMaxMemoryMib, _ := qs.GetOK("max_memory_mib")
+	if err := o.bindMaxMemoryMib(qMaxMemoryMib, qhkMaxMemoryMib, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinCPUCores, qhkMinCPUCores, _ := qs.GetOK("min_cpu_cores")
+	if err := o.bindMinCPUCores(qMinCPUCores, qhkMinCPUCores, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinDiskCount, qhkMinDiskCount, _ := qs.GetOK("min_disk_count")
+	if err := o.bindMinDiskCount(qMinDiskCount, qhkMinDiskCount, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinDiskSizeGb, qhkMinDiskSizeGb, _ := qs.GetOK("min_disk_size_gb")
+	if err := o.bindMinDiskSizeGb(qMinDiskSizeGb, qhkMinDiskSizeGb, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinMemoryMib, qhkMinMemoryMib, _ := qs.GetOK("min_memory_mib")
+	if err := o.bindMinMemoryMib(qMinMemoryMib, qhkMinMemoryMib, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinNicCount, qhkMinNicCount, _ := qs.GetOK("min_nic_count")
+	if err := o.bindMinNicCount(qMinNicCount, qhkMinNicCount, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinNicSpeedMbps, qhkMinNicSpeedMbps, _ := qs.GetOK("min_nic_speed_mbps")
+	if err := o.bindMinNicSpeedMbps(qMinNicSpeedMbps, qhkMinNicSpeedMbps, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProduct, qhkProduct, _ := qs.GetOK("product")
+	if err := o.bindProduct(qProduct, qhkProduct, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVendor, qhkVendor, _ := qs.GetOK("vendor")
+	if err := o.bindVendor(qVendor, qhkVendor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVirtual, qhkVirtual, _ := qs.GetOK("virtual")
+	if err := o.bindVirtual(qVirtual, qhkVirtual, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBound binds and validates parameter Bound from query.
+func (o *V2ExportHostHardwareParams) bindBound(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("bound", "query", "bool", raw)
+	}
+	o.Bound = &value
+
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from query.
+func (o *V2ExportHostHardwareParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "query", "strfmt.UUID", raw)
+	}
+	o.ClusterID = (value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2ExportHostHardwareParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "query", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCPUArchitecture binds and validates parameter CPUArchitecture from query.
+func (o *V2ExportHostHardwareParams) bindCPUArchitecture(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.CPUArchitecture = &raw
+
+	return nil
+}
+
+// bindDiskTypes binds and validates array parameter DiskTypes from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2ExportHostHardwareParams) bindDiskTypes(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvDiskTypes string
+	if len(rawData) > 0 {
+		qvDiskTypes = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	diskTypesIC := swag.SplitByFormat(qvDiskTypes, "")
+	if len(diskTypesIC) == 0 {
+		return nil
+	}
+
+	var diskTypesIR []string
+	for i, diskTypesIV := range diskTypesIC {
+		diskTypesI := diskTypesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "disk_types", i), "query", diskTypesI, []interface{}{"nvme", "ssd", "hdd"}, true); err != nil {
+			return err
+		}
+
+		diskTypesIR = append(diskTypesIR, diskTypesI)
+	}
+
+	o.DiskTypes = diskTypesIR
+
+	return nil
+}
+
+// bindFormat binds and validates parameter Format from query.
+func (o *V2ExportHostHardwareParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ExportHostHardwareParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *V2ExportHostHardwareParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"csv", "json"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindInfraEnvID binds and validates parameter InfraEnvID from query.
+func (o *V2ExportHostHardwareParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("infra_env_id", "query", "strfmt.UUID", raw)
+	}
+	o.InfraEnvID = (value.(*strfmt.UUID))
+
+	if err := o.validateInfraEnvID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInfraEnvID carries on validations for parameter InfraEnvID
+func (o *V2ExportHostHardwareParams) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("infra_env_id", "query", "uuid", o.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindMaxCPUCores binds and validates parameter MaxCPUCores from query.
+func (o *V2ExportHostHardwareParams) bindMaxCPUCores(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("max_cpu_cores", "query", "int64", raw)
+	}
+	o.MaxCPUCores = &value
+
+	if err := o.validateMaxCPUCores(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMaxCPUCores carries on validations for parameter MaxCPUCores
+func (o *V2ExportHostHardwareParams) validateMaxCPUCores(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("max_cpu_cores", "query", *o.MaxCPUCores, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMaxMemoryMib binds and validates parameter MaxMemoryMib from query.
+func (o *V2ExportHostHardwareParams) bindMaxMemoryMib(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("max_memory_mib", "query", "int64", raw)
+	}
+	o.MaxMemoryMib = &value
+
+	if err := o.validateMaxMemoryMib(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMaxMemoryMib carries on validations for parameter MaxMemoryMib
+func (o *V2ExportHostHardwareParams) validateMaxMemoryMib(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("max_memory_mib", "query", *o.MaxMemoryMib, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinCPUCores binds and validates parameter MinCPUCores from query.
+func (o *V2ExportHostHardwareParams) bindMinCPUCores(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_cpu_cores", "query", "int64", raw)
+	}
+	o.MinCPUCores = &value
+
+	if err := o.validateMinCPUCores(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinCPUCores carries on validations for parameter MinCPUCores
+func (o *V2ExportHostHardwareParams) validateMinCPUCores(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_cpu_cores", "query", *o.MinCPUCores, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinDiskCount binds and validates parameter MinDiskCount from query.
+func (o *V2ExportHostHardwareParams) bindMinDiskCount(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_disk_count", "query", "int64", raw)
+	}
+	o.MinDiskCount = &value
+
+	if err := o.validateMinDiskCount(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinDiskCount carries on validations for parameter MinDiskCount
+func (o *V2ExportHostHardwareParams) validateMinDiskCount(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_disk_count", "query", *o.MinDiskCount, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinDiskSizeGb binds and validates parameter MinDiskSizeGb from query.
+func (o *V2ExportHostHardwareParams) bindMinDiskSizeGb(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_disk_size_gb", "query", "int64", raw)
+	}
+	o.MinDiskSizeGb = &value
+
+	if err := o.validateMinDiskSizeGb(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinDiskSizeGb carries on validations for parameter MinDiskSizeGb
+func (o *V2ExportHostHardwareParams) validateMinDiskSizeGb(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_disk_size_gb", "query", *o.MinDiskSizeGb, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinMemoryMib binds and validates parameter MinMemoryMib from query.
+func (o *V2ExportHostHardwareParams) bindMinMemoryMib(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_memory_mib", "query", "int64", raw)
+	}
+	o.MinMemoryMib = &value
+
+	if err := o.validateMinMemoryMib(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinMemoryMib carries on validations for parameter MinMemoryMib
+func (o *V2ExportHostHardwareParams) validateMinMemoryMib(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_memory_mib", "query", *o.MinMemoryMib, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinNicCount binds and validates parameter MinNicCount from query.
+func (o *V2ExportHostHardwareParams) bindMinNicCount(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_nic_count", "query", "int64", raw)
+	}
+	o.MinNicCount = &value
+
+	if err := o.validateMinNicCount(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinNicCount carries on validations for parameter MinNicCount
+func (o *V2ExportHostHardwareParams) validateMinNicCount(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_nic_count", "query", *o.MinNicCount, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMinNicSpeedMbps binds and validates parameter MinNicSpeedMbps from query.
+func (o *V2ExportHostHardwareParams) bindMinNicSpeedMbps(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("min_nic_speed_mbps", "query", "int64", raw)
+	}
+	o.MinNicSpeedMbps = &value
+
+	if err := o.validateMinNicSpeedMbps(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinNicSpeedMbps carries on validations for parameter MinNicSpeedMbps
+func (o *V2ExportHostHardwareParams) validateMinNicSpeedMbps(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("min_nic_speed_mbps", "query", *o.MinNicSpeedMbps, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindProduct binds and validates parameter Product from query.
+func (o *V2ExportHostHardwareParams) bindProduct(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Product = &raw
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2ExportHostHardwareParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvStatus string
+	if len(rawData) > 0 {
+		qvStatus = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	statusIC := swag.SplitByFormat(qvStatus, "")
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for _, statusIV := range statusIC {
+		statusI := statusIV
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
+
+	return nil
+}
+
+// bindVendor binds and validates parameter Vendor from query.
+func (o *V2ExportHostHardwareParams) bindVendor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Vendor = &raw
+
+	return nil
+}
+
+// bindVirtual binds and validates parameter Virtual from query.
+func (o *V2ExportHostHardwareParams) bindVirtual(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("virtual", "query", "bool", raw)
+	}
+	o.Virtual = &value
+
+	return nil
+}
@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ExportHostHardwareOKCode is the HTTP code returned for type V2ExportHostHardwareOK
+const V2ExportHostHardwareOKCode int = 200
+
+/*
+V2ExportHostHardwareOK Success.
+
+swagger:response v2ExportHostHardwareOK
+*/
+type V2ExportHostHardwareOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2ExportHostHardwareOK creates V2ExportHostHardwareOK with default headers values
+func NewV2ExportHostHardwareOK() *V2ExportHostHardwareOK {
+
+	return &V2ExportHostHardwareOK{}
+}
+
+// WithPayload adds the payload to the v2 export host hardware o k response
+func (o *V2ExportHostHardwareOK) WithPayload(payload io.ReadCloser) *V2ExportHostHardwareOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export host hardware o k response
+func (o *V2ExportHostHardwareOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportHostHardwareOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ExportHostHardwareBadRequestCode is the HTTP code returned for type V2ExportHostHardwareBadRequest
+const V2ExportHostHardwareBadRequestCode int = 400
+
+/*
+V2ExportHostHardwareBadRequest Error.
+
+swagger:response v2ExportHostHardwareBadRequest
+*/
+type V2ExportHostHardwareBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportHostHardwareBadRequest creates V2ExportHostHardwareBadRequest with default headers values
+func NewV2ExportHostHardwareBadRequest() *V2ExportHostHardwareBadRequest {
+
+	return &V2ExportHostHardwareBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 export host hardware bad request response
+func (o *V2ExportHostHardwareBadRequest) WithPayload(payload *models.Error) *V2ExportHostHardwareBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export host hardware bad request response
+func (o *V2ExportHostHardwareBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportHostHardwareBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportHostHardwareUnauthorizedCode is the HTTP code returned for type V2ExportHostHardwareUnauthorized
+const V2ExportHostHardwareUnauthorizedCode int = 401
+
+/*
+V2ExportHostHardwareUnauthorized Unauthorized.
+
+swagger:response v2ExportHostHardwareUnauthorized
+*/
+type V2ExportHostHardwareUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportHostHardwareUnauthorized creates V2ExportHostHardwareUnauthorized with default headers values
+func NewV2ExportHostHardwareUnauthorized() *V2ExportHostHardwareUnauthorized {
+
+	return &V2ExportHostHardwareUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 export host hardware unauthorized response
+func (o *V2ExportHostHardwareUnauthorized) WithPayload(payload *models.InfraError) *V2ExportHostHardwareUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export host hardware unauthorized response
+func (o *V2ExportHostHardwareUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportHostHardwareUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportHostHardwareForbiddenCode is the HTTP code returned for type V2ExportHostHardwareForbidden
+const V2ExportHostHardwareForbiddenCode int = 403
+
+/*
+V2ExportHostHardwareForbidden Forbidden.
+
+swagger:response v2ExportHostHardwareForbidden
+*/
+type V2ExportHostHardwareForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportHostHardwareForbidden creates V2ExportHostHardwareForbidden with default headers values
+func NewV2ExportHostHardwareForbidden() *V2ExportHostHardwareForbidden {
+
+	return &V2ExportHostHardwareForbidden{}
+}
+
+// WithPayload adds the payload to the v2 export host hardware forbidden response
+func (o *V2ExportHostHardwareForbidden) WithPayload(payload *models.InfraError) *V2ExportHostHardwareForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export host hardware forbidden response
+func (o *V2ExportHostHardwareForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportHostHardwareForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportHostHardwareInternalServerErrorCode is the HTTP code returned for type V2ExportHostHardwareInternalServerError
+const V2ExportHostHardwareInternalServerErrorCode int = 500
+
+/*
+V2ExportHostHardwareInternalServerError Error.
+
+swagger:response v2ExportHostHardwareInternalServerError
+*/
+type V2ExportHostHardwareInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportHostHardwareInternalServerError creates V2ExportHostHardwareInternalServerError with default headers values
+func NewV2ExportHostHardwareInternalServerError() *V2ExportHostHardwareInternalServerError {
+
+	return &V2ExportHostHardwareInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 export host hardware internal server error response
+func (o *V2ExportHostHardwareInternalServerError) WithPayload(payload *models.Error) *V2ExportHostHardwareInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export host hardware internal server error response
+func (o *V2ExportHostHardwareInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportHostHardwareInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
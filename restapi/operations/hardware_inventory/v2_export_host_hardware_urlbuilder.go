@@ -0,0 +1,277 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V2ExportHostHardwareURL generates an URL for the v2 export host hardware operation
+type V2ExportHostHardwareURL struct {
+	Bound           *bool
+	ClusterID       *strfmt.UUID
+	CPUArchitecture *string
+	DiskTypes       []string
+	Format          *string
+	InfraEnvID      *strfmt.UUID
+	MaxCPUCores     *int64
+	MaxMemoryMib    *int64
+	MinCPUCores     *int64
+	MinDiskCount    *int64
+	MinDiskSizeGb   *int64
+	MinMemoryMib    *int64
+	MinNicCount     *int64
+	MinNicSpeedMbps *int64
+	Product         *string
+	Status          []string
+	Vendor          *string
+	Virtual         *bool
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ExportHostHardwareURL) WithBasePath(bp string) *V2ExportHostHardwareURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ExportHostHardwareURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *V2ExportHostHardwareURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/v2/hardware-inventory/export"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var boundQ string
+	if o.Bound != nil {
+		boundQ = swag.FormatBool(*o.Bound)
+	}
+	if boundQ != "" {
+		qs.Set("bound", boundQ)
+	}
+
+	var clusterIDQ string
+	if o.ClusterID != nil {
+		clusterIDQ = o.ClusterID.String()
+	}
+	if clusterIDQ != "" {
+		qs.Set("cluster_id", clusterIDQ)
+	}
+
+	var cPUArchitectureQ string
+	if o.CPUArchitecture != nil {
+		cPUArchitectureQ = *o.CPUArchitecture
+	}
+	if cPUArchitectureQ != "" {
+		qs.Set("cpu_architecture", cPUArchitectureQ)
+	}
+
+	var diskTypesIR []string
+	for _, diskTypesI := range o.DiskTypes {
+		diskTypesIS := diskTypesI
+		if diskTypesIS != "" {
+			diskTypesIR = append(diskTypesIR, diskTypesIS)
+		}
+	}
+
+	diskTypes := swag.JoinByFormat(diskTypesIR, "")
+
+	if len(diskTypes) > 0 {
+		qsv := diskTypes[0]
+		if qsv != "" {
+			qs.Set("disk_types", qsv)
+		}
+	}
+
+	var formatQ string
+	if o.Format != nil {
+		formatQ = *o.Format
+	}
+	if formatQ != "" {
+		qs.Set("format", formatQ)
+	}
+
+	var infraEnvIDQ string
+	if o.InfraEnvID != nil {
+		infraEnvIDQ = o.InfraEnvID.String()
+	}
+	if infraEnvIDQ != "" {
+		qs.Set("infra_env_id", infraEnvIDQ)
+	}
+
+	var maxCPUCoresQ string
+	if o.MaxCPUCores != nil {
+		maxCPUCoresQ = swag.FormatInt64(*o.MaxCPUCores)
+	}
+	if maxCPUCoresQ != "" {
+		qs.Set("max_cpu_cores", maxCPUCoresQ)
+	}
+
+	var maxMemoryMibQ string
+	if o.MaxMemoryMib != nil {
+		maxMemoryMibQ = swag.FormatInt64(*o.MaxMemoryMib)
+	}
+	if maxMemoryMibQ != "" {
+		qs.Set("max_memory_mib", maxMemoryMibQ)
+	}
+
+	var minCPUCoresQ string
+	if o.MinCPUCores != nil {
+		minCPUCoresQ = swag.FormatInt64(*o.MinCPUCores)
+	}
+	if minCPUCoresQ != "" {
+		qs.Set("min_cpu_cores", minCPUCoresQ)
+	}
+
+	var minDiskCountQ string
+	if o.MinDiskCount != nil {
+		minDiskCountQ = swag.FormatInt64(*o.MinDiskCount)
+	}
+	if minDiskCountQ != "" {
+		qs.Set("min_disk_count", minDiskCountQ)
+	}
+
+	var minDiskSizeGbQ string
+	if o.MinDiskSizeGb != nil {
+		minDiskSizeGbQ = swag.FormatInt64(*o.MinDiskSizeGb)
+	}
+	if minDiskSizeGbQ != "" {
+		qs.Set("min_disk_size_gb", minDiskSizeGbQ)
+	}
+
+	var minMemoryMibQ string
+	if o.MinMemoryMib != nil {
+		minMemoryMibQ = swag.FormatInt64(*o.MinMemoryMib)
+	}
+	if minMemoryMibQ != "" {
+		qs.Set("min_memory_mib", minMemoryMibQ)
+	}
+
+	var minNicCountQ string
+	if o.MinNicCount != nil {
+		minNicCountQ = swag.FormatInt64(*o.MinNicCount)
+	}
+	if minNicCountQ != "" {
+		qs.Set("min_nic_count", minNicCountQ)
+	}
+
+	var minNicSpeedMbpsQ string
+	if o.MinNicSpeedMbps != nil {
+		minNicSpeedMbpsQ = swag.FormatInt64(*o.MinNicSpeedMbps)
+	}
+	if minNicSpeedMbpsQ != "" {
+		qs.Set("min_nic_speed_mbps", minNicSpeedMbpsQ)
+	}
+
+	var productQ string
+	if o.Product != nil {
+		productQ = *o.Product
+	}
+	if productQ != "" {
+		qs.Set("product", productQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
+	var vendorQ string
+	if o.Vendor != nil {
+		vendorQ = *o.Vendor
+	}
+	if vendorQ != "" {
+		qs.Set("vendor", vendorQ)
+	}
+
+	var virtualQ string
+	if o.Virtual != nil {
+		virtualQ = swag.FormatBool(*o.Virtual)
+	}
+	if virtualQ != "" {
+		qs.Set("virtual", virtualQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *V2ExportHostHardwareURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *V2ExportHostHardwareURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *V2ExportHostHardwareURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on V2ExportHostHardwareURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on V2ExportHostHardwareURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *V2ExportHostHardwareURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostHardwareHandlerFunc turns a function with the right signature into a v2 list host hardware handler
+type V2ListHostHardwareHandlerFunc func(V2ListHostHardwareParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostHardwareHandlerFunc) Handle(params V2ListHostHardwareParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostHardwareHandler interface for that can handle valid v2 list host hardware params
+type V2ListHostHardwareHandler interface {
+	Handle(V2ListHostHardwareParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostHardware creates a new http.Handler for the v2 list host hardware operation
+func NewV2ListHostHardware(ctx *middleware.Context, handler V2ListHostHardwareHandler) *V2ListHostHardware {
+	return &V2ListHostHardware{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListHostHardware swagger:route GET /v2/hardware-inventory hardware_inventory v2ListHostHardware
+
+Queries the hardware of the hosts of the tenant, as reported in their inventories.
+*/
+type V2ListHostHardware struct {
+	Context *middleware.Context
+	Handler V2ListHostHardwareHandler
+}
+
+func (o *V2ListHostHardware) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostHardwareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
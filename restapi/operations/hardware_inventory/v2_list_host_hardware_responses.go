@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package hardware_inventory
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostHardwareOKCode is the HTTP code returned for type V2ListHostHardwareOK
+const V2ListHostHardwareOKCode int = 200
+
+/*
+V2ListHostHardwareOK Success.
+
+swagger:response v2ListHostHardwareOK
+*/
+type V2ListHostHardwareOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostHardwareList `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareOK creates V2ListHostHardwareOK with default headers values
+func NewV2ListHostHardwareOK() *V2ListHostHardwareOK {
+
+	return &V2ListHostHardwareOK{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware o k response
+func (o *V2ListHostHardwareOK) WithPayload(payload *models.HostHardwareList) *V2ListHostHardwareOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware o k response
+func (o *V2ListHostHardwareOK) SetPayload(payload *models.HostHardwareList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareBadRequestCode is the HTTP code returned for type V2ListHostHardwareBadRequest
+const V2ListHostHardwareBadRequestCode int = 400
+
+/*
+V2ListHostHardwareBadRequest Error.
+
+swagger:response v2ListHostHardwareBadRequest
+*/
+type V2ListHostHardwareBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareBadRequest creates V2ListHostHardwareBadRequest with default headers values
+func NewV2ListHostHardwareBadRequest() *V2ListHostHardwareBadRequest {
+
+	return &V2ListHostHardwareBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware bad request response
+func (o *V2ListHostHardwareBadRequest) WithPayload(payload *models.Error) *V2ListHostHardwareBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware bad request response
+func (o *V2ListHostHardwareBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareUnauthorizedCode is the HTTP code returned for type V2ListHostHardwareUnauthorized
+const V2ListHostHardwareUnauthorizedCode int = 401
+
+/*
+V2ListHostHardwareUnauthorized Unauthorized.
+
+swagger:response v2ListHostHardwareUnauthorized
+*/
+type V2ListHostHardwareUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareUnauthorized creates V2ListHostHardwareUnauthorized with default headers values
+func NewV2ListHostHardwareUnauthorized() *V2ListHostHardwareUnauthorized {
+
+	return &V2ListHostHardwareUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware unauthorized response
+func (o *V2ListHostHardwareUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostHardwareUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware unauthorized response
+func (o *V2ListHostHardwareUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareForbiddenCode is the HTTP code returned for type V2ListHostHardwareForbidden
+const V2ListHostHardwareForbiddenCode int = 403
+
+/*
+V2ListHostHardwareForbidden Forbidden.
+
+swagger:response v2ListHostHardwareForbidden
+*/
+type V2ListHostHardwareForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareForbidden creates V2ListHostHardwareForbidden with default headers values
+func NewV2ListHostHardwareForbidden() *V2ListHostHardwareForbidden {
+
+	return &V2ListHostHardwareForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware forbidden response
+func (o *V2ListHostHardwareForbidden) WithPayload(payload *models.InfraError) *V2ListHostHardwareForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware forbidden response
+func (o *V2ListHostHardwareForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostHardwareInternalServerErrorCode is the HTTP code returned for type V2ListHostHardwareInternalServerError
+const V2ListHostHardwareInternalServerErrorCode int = 500
+
+/*
+V2ListHostHardwareInternalServerError Error.
+
+swagger:response v2ListHostHardwareInternalServerError
+*/
+type V2ListHostHardwareInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostHardwareInternalServerError creates V2ListHostHardwareInternalServerError with default headers values
+func NewV2ListHostHardwareInternalServerError() *V2ListHostHardwareInternalServerError {
+
+	return &V2ListHostHardwareInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host hardware internal server error response
+func (o *V2ListHostHardwareInternalServerError) WithPayload(payload *models.Error) *V2ListHostHardwareInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host hardware internal server error response
+func (o *V2ListHostHardwareInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostHardwareInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
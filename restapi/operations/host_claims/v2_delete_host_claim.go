@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeleteHostClaimHandlerFunc turns a function with the right signature into a v2 delete host claim handler
+type V2DeleteHostClaimHandlerFunc func(V2DeleteHostClaimParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeleteHostClaimHandlerFunc) Handle(params V2DeleteHostClaimParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeleteHostClaimHandler interface for that can handle valid v2 delete host claim params
+type V2DeleteHostClaimHandler interface {
+	Handle(V2DeleteHostClaimParams, interface{}) middleware.Responder
+}
+
+// NewV2DeleteHostClaim creates a new http.Handler for the v2 delete host claim operation
+func NewV2DeleteHostClaim(ctx *middleware.Context, handler V2DeleteHostClaimHandler) *V2DeleteHostClaim {
+	return &V2DeleteHostClaim{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DeleteHostClaim swagger:route DELETE /v2/clusters/{cluster_id}/host-claim host_claims v2DeleteHostClaim
+
+Deletes the host claim of the cluster. The hosts already bound by the claim stay bound to the cluster.
+*/
+type V2DeleteHostClaim struct {
+	Context *middleware.Context
+	Handler V2DeleteHostClaimHandler
+}
+
+func (o *V2DeleteHostClaim) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeleteHostClaimParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
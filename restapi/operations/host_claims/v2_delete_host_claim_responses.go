@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeleteHostClaimNoContentCode is the HTTP code returned for type V2DeleteHostClaimNoContent
+const V2DeleteHostClaimNoContentCode int = 204
+
+/*
+V2DeleteHostClaimNoContent Success.
+
+swagger:response v2DeleteHostClaimNoContent
+*/
+type V2DeleteHostClaimNoContent struct {
+}
+
+// NewV2DeleteHostClaimNoContent creates V2DeleteHostClaimNoContent with default headers values
+func NewV2DeleteHostClaimNoContent() *V2DeleteHostClaimNoContent {
+
+	return &V2DeleteHostClaimNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeleteHostClaimNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeleteHostClaimUnauthorizedCode is the HTTP code returned for type V2DeleteHostClaimUnauthorized
+const V2DeleteHostClaimUnauthorizedCode int = 401
+
+/*
+V2DeleteHostClaimUnauthorized Unauthorized.
+
+swagger:response v2DeleteHostClaimUnauthorized
+*/
+type V2DeleteHostClaimUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteHostClaimUnauthorized creates V2DeleteHostClaimUnauthorized with default headers values
+func NewV2DeleteHostClaimUnauthorized() *V2DeleteHostClaimUnauthorized {
+
+	return &V2DeleteHostClaimUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 delete host claim unauthorized response
+func (o *V2DeleteHostClaimUnauthorized) WithPayload(payload *models.InfraError) *V2DeleteHostClaimUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete host claim unauthorized response
+func (o *V2DeleteHostClaimUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteHostClaimUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteHostClaimForbiddenCode is the HTTP code returned for type V2DeleteHostClaimForbidden
+const V2DeleteHostClaimForbiddenCode int = 403
+
+/*
+V2DeleteHostClaimForbidden Forbidden.
+
+swagger:response v2DeleteHostClaimForbidden
+*/
+type V2DeleteHostClaimForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeleteHostClaimForbidden creates V2DeleteHostClaimForbidden with default headers values
+func NewV2DeleteHostClaimForbidden() *V2DeleteHostClaimForbidden {
+
+	return &V2DeleteHostClaimForbidden{}
+}
+
+// WithPayload adds the payload to the v2 delete host claim forbidden response
+func (o *V2DeleteHostClaimForbidden) WithPayload(payload *models.InfraError) *V2DeleteHostClaimForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete host claim forbidden response
+func (o *V2DeleteHostClaimForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteHostClaimForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteHostClaimNotFoundCode is the HTTP code returned for type V2DeleteHostClaimNotFound
+const V2DeleteHostClaimNotFoundCode int = 404
+
+/*
+V2DeleteHostClaimNotFound Error.
+
+swagger:response v2DeleteHostClaimNotFound
+*/
+type V2DeleteHostClaimNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteHostClaimNotFound creates V2DeleteHostClaimNotFound with default headers values
+func NewV2DeleteHostClaimNotFound() *V2DeleteHostClaimNotFound {
+
+	return &V2DeleteHostClaimNotFound{}
+}
+
+// WithPayload adds the payload to the v2 delete host claim not found response
+func (o *V2DeleteHostClaimNotFound) WithPayload(payload *models.Error) *V2DeleteHostClaimNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete host claim not found response
+func (o *V2DeleteHostClaimNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteHostClaimNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeleteHostClaimInternalServerErrorCode is the HTTP code returned for type V2DeleteHostClaimInternalServerError
+const V2DeleteHostClaimInternalServerErrorCode int = 500
+
+/*
+V2DeleteHostClaimInternalServerError Error.
+
+swagger:response v2DeleteHostClaimInternalServerError
+*/
+type V2DeleteHostClaimInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeleteHostClaimInternalServerError creates V2DeleteHostClaimInternalServerError with default headers values
+func NewV2DeleteHostClaimInternalServerError() *V2DeleteHostClaimInternalServerError {
+
+	return &V2DeleteHostClaimInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 delete host claim internal server error response
+func (o *V2DeleteHostClaimInternalServerError) WithPayload(payload *models.Error) *V2DeleteHostClaimInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 delete host claim internal server error response
+func (o *V2DeleteHostClaimInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeleteHostClaimInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetHostClaimHandlerFunc turns a function with the right signature into a v2 get host claim handler
+type V2GetHostClaimHandlerFunc func(V2GetHostClaimParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetHostClaimHandlerFunc) Handle(params V2GetHostClaimParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetHostClaimHandler interface for that can handle valid v2 get host claim params
+type V2GetHostClaimHandler interface {
+	Handle(V2GetHostClaimParams, interface{}) middleware.Responder
+}
+
+// NewV2GetHostClaim creates a new http.Handler for the v2 get host claim operation
+func NewV2GetHostClaim(ctx *middleware.Context, handler V2GetHostClaimHandler) *V2GetHostClaim {
+	return &V2GetHostClaim{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetHostClaim swagger:route GET /v2/clusters/{cluster_id}/host-claim host_claims v2GetHostClaim
+
+Retrieves the host claim of the cluster and the hosts bound by it.
+*/
+type V2GetHostClaim struct {
+	Context *middleware.Context
+	Handler V2GetHostClaimHandler
+}
+
+func (o *V2GetHostClaim) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetHostClaimParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostClaimOKCode is the HTTP code returned for type V2GetHostClaimOK
+const V2GetHostClaimOKCode int = 200
+
+/*
+V2GetHostClaimOK Success.
+
+swagger:response v2GetHostClaimOK
+*/
+type V2GetHostClaimOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostClaim `json:"body,omitempty"`
+}
+
+// NewV2GetHostClaimOK creates V2GetHostClaimOK with default headers values
+func NewV2GetHostClaimOK() *V2GetHostClaimOK {
+
+	return &V2GetHostClaimOK{}
+}
+
+// WithPayload adds the payload to the v2 get host claim o k response
+func (o *V2GetHostClaimOK) WithPayload(payload *models.HostClaim) *V2GetHostClaimOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host claim o k response
+func (o *V2GetHostClaimOK) SetPayload(payload *models.HostClaim) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostClaimOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostClaimUnauthorizedCode is the HTTP code returned for type V2GetHostClaimUnauthorized
+const V2GetHostClaimUnauthorizedCode int = 401
+
+/*
+V2GetHostClaimUnauthorized Unauthorized.
+
+swagger:response v2GetHostClaimUnauthorized
+*/
+type V2GetHostClaimUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostClaimUnauthorized creates V2GetHostClaimUnauthorized with default headers values
+func NewV2GetHostClaimUnauthorized() *V2GetHostClaimUnauthorized {
+
+	return &V2GetHostClaimUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get host claim unauthorized response
+func (o *V2GetHostClaimUnauthorized) WithPayload(payload *models.InfraError) *V2GetHostClaimUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host claim unauthorized response
+func (o *V2GetHostClaimUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostClaimUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostClaimForbiddenCode is the HTTP code returned for type V2GetHostClaimForbidden
+const V2GetHostClaimForbiddenCode int = 403
+
+/*
+V2GetHostClaimForbidden Forbidden.
+
+swagger:response v2GetHostClaimForbidden
+*/
+type V2GetHostClaimForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostClaimForbidden creates V2GetHostClaimForbidden with default headers values
+func NewV2GetHostClaimForbidden() *V2GetHostClaimForbidden {
+
+	return &V2GetHostClaimForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get host claim forbidden response
+func (o *V2GetHostClaimForbidden) WithPayload(payload *models.InfraError) *V2GetHostClaimForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host claim forbidden response
+func (o *V2GetHostClaimForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostClaimForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostClaimNotFoundCode is the HTTP code returned for type V2GetHostClaimNotFound
+const V2GetHostClaimNotFoundCode int = 404
+
+/*
+V2GetHostClaimNotFound Error.
+
+swagger:response v2GetHostClaimNotFound
+*/
+type V2GetHostClaimNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostClaimNotFound creates V2GetHostClaimNotFound with default headers values
+func NewV2GetHostClaimNotFound() *V2GetHostClaimNotFound {
+
+	return &V2GetHostClaimNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get host claim not found response
+func (o *V2GetHostClaimNotFound) WithPayload(payload *models.Error) *V2GetHostClaimNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host claim not found response
+func (o *V2GetHostClaimNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostClaimNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostClaimInternalServerErrorCode is the HTTP code returned for type V2GetHostClaimInternalServerError
+const V2GetHostClaimInternalServerErrorCode int = 500
+
+/*
+V2GetHostClaimInternalServerError Error.
+
+swagger:response v2GetHostClaimInternalServerError
+*/
+type V2GetHostClaimInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostClaimInternalServerError creates V2GetHostClaimInternalServerError with default headers values
+func NewV2GetHostClaimInternalServerError() *V2GetHostClaimInternalServerError {
+
+	return &V2GetHostClaimInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get host claim internal server error response
+func (o *V2GetHostClaimInternalServerError) WithPayload(payload *models.Error) *V2GetHostClaimInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host claim internal server error response
+func (o *V2GetHostClaimInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostClaimInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostClaimsHandlerFunc turns a function with the right signature into a v2 list host claims handler
+type V2ListHostClaimsHandlerFunc func(V2ListHostClaimsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostClaimsHandlerFunc) Handle(params V2ListHostClaimsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostClaimsHandler interface for that can handle valid v2 list host claims params
+type V2ListHostClaimsHandler interface {
+	Handle(V2ListHostClaimsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostClaims creates a new http.Handler for the v2 list host claims operation
+func NewV2ListHostClaims(ctx *middleware.Context, handler V2ListHostClaimsHandler) *V2ListHostClaims {
+	return &V2ListHostClaims{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListHostClaims swagger:route GET /v2/host-claims host_claims v2ListHostClaims
+
+Retrieves the host claims of the clusters of the tenant, in the order they are served by the scheduler.
+*/
+type V2ListHostClaims struct {
+	Context *middleware.Context
+	Handler V2ListHostClaimsHandler
+}
+
+func (o *V2ListHostClaims) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostClaimsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
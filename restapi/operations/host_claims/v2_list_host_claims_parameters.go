@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewV2ListHostClaimsParams creates a new V2ListHostClaimsParams object
+//
+// There are no default values defined in the spec.
+func NewV2ListHostClaimsParams() V2ListHostClaimsParams {
+
+	return V2ListHostClaimsParams{}
+}
+
+// V2ListHostClaimsParams contains all the bound params for the v2 list host claims operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListHostClaims
+type V2ListHostClaimsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListHostClaimsParams() beforehand.
+func (o *V2ListHostClaimsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
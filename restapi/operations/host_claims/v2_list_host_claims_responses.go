@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostClaimsOKCode is the HTTP code returned for type V2ListHostClaimsOK
+const V2ListHostClaimsOKCode int = 200
+
+/*
+V2ListHostClaimsOK Success.
+
+swagger:response v2ListHostClaimsOK
+*/
+type V2ListHostClaimsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HostClaimList `json:"body,omitempty"`
+}
+
+// NewV2ListHostClaimsOK creates V2ListHostClaimsOK with default headers values
+func NewV2ListHostClaimsOK() *V2ListHostClaimsOK {
+
+	return &V2ListHostClaimsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host claims o k response
+func (o *V2ListHostClaimsOK) WithPayload(payload models.HostClaimList) *V2ListHostClaimsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host claims o k response
+func (o *V2ListHostClaimsOK) SetPayload(payload models.HostClaimList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostClaimsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.HostClaimList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostClaimsUnauthorizedCode is the HTTP code returned for type V2ListHostClaimsUnauthorized
+const V2ListHostClaimsUnauthorizedCode int = 401
+
+/*
+V2ListHostClaimsUnauthorized Unauthorized.
+
+swagger:response v2ListHostClaimsUnauthorized
+*/
+type V2ListHostClaimsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostClaimsUnauthorized creates V2ListHostClaimsUnauthorized with default headers values
+func NewV2ListHostClaimsUnauthorized() *V2ListHostClaimsUnauthorized {
+
+	return &V2ListHostClaimsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host claims unauthorized response
+func (o *V2ListHostClaimsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostClaimsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host claims unauthorized response
+func (o *V2ListHostClaimsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostClaimsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostClaimsForbiddenCode is the HTTP code returned for type V2ListHostClaimsForbidden
+const V2ListHostClaimsForbiddenCode int = 403
+
+/*
+V2ListHostClaimsForbidden Forbidden.
+
+swagger:response v2ListHostClaimsForbidden
+*/
+type V2ListHostClaimsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostClaimsForbidden creates V2ListHostClaimsForbidden with default headers values
+func NewV2ListHostClaimsForbidden() *V2ListHostClaimsForbidden {
+
+	return &V2ListHostClaimsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host claims forbidden response
+func (o *V2ListHostClaimsForbidden) WithPayload(payload *models.InfraError) *V2ListHostClaimsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host claims forbidden response
+func (o *V2ListHostClaimsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostClaimsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostClaimsInternalServerErrorCode is the HTTP code returned for type V2ListHostClaimsInternalServerError
+const V2ListHostClaimsInternalServerErrorCode int = 500
+
+/*
+V2ListHostClaimsInternalServerError Error.
+
+swagger:response v2ListHostClaimsInternalServerError
+*/
+type V2ListHostClaimsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostClaimsInternalServerError creates V2ListHostClaimsInternalServerError with default headers values
+func NewV2ListHostClaimsInternalServerError() *V2ListHostClaimsInternalServerError {
+
+	return &V2ListHostClaimsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host claims internal server error response
+func (o *V2ListHostClaimsInternalServerError) WithPayload(payload *models.Error) *V2ListHostClaimsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host claims internal server error response
+func (o *V2ListHostClaimsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostClaimsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
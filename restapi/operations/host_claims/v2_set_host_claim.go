@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2SetHostClaimHandlerFunc turns a function with the right signature into a v2 set host claim handler
+type V2SetHostClaimHandlerFunc func(V2SetHostClaimParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2SetHostClaimHandlerFunc) Handle(params V2SetHostClaimParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2SetHostClaimHandler interface for that can handle valid v2 set host claim params
+type V2SetHostClaimHandler interface {
+	Handle(V2SetHostClaimParams, interface{}) middleware.Responder
+}
+
+// NewV2SetHostClaim creates a new http.Handler for the v2 set host claim operation
+func NewV2SetHostClaim(ctx *middleware.Context, handler V2SetHostClaimHandler) *V2SetHostClaim {
+	return &V2SetHostClaim{Context: ctx, Handler: handler}
+}
+
+/*
+	V2SetHostClaim swagger:route PUT /v2/clusters/{cluster_id}/host-claim host_claims v2SetHostClaim
+
+Creates or replaces the host claim of the cluster. The unbound hosts of the infra-envs of the claim matching its selectors are bound to the cluster automatically until the cluster has the requested number of masters and workers.
+*/
+type V2SetHostClaim struct {
+	Context *middleware.Context
+	Handler V2SetHostClaimHandler
+}
+
+func (o *V2SetHostClaim) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2SetHostClaimParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package host_claims
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SetHostClaimOKCode is the HTTP code returned for type V2SetHostClaimOK
+const V2SetHostClaimOKCode int = 200
+
+/*
+V2SetHostClaimOK Success.
+
+swagger:response v2SetHostClaimOK
+*/
+type V2SetHostClaimOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostClaim `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimOK creates V2SetHostClaimOK with default headers values
+func NewV2SetHostClaimOK() *V2SetHostClaimOK {
+
+	return &V2SetHostClaimOK{}
+}
+
+// WithPayload adds the payload to the v2 set host claim o k response
+func (o *V2SetHostClaimOK) WithPayload(payload *models.HostClaim) *V2SetHostClaimOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim o k response
+func (o *V2SetHostClaimOK) SetPayload(payload *models.HostClaim) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimBadRequestCode is the HTTP code returned for type V2SetHostClaimBadRequest
+const V2SetHostClaimBadRequestCode int = 400
+
+/*
+V2SetHostClaimBadRequest Error.
+
+swagger:response v2SetHostClaimBadRequest
+*/
+type V2SetHostClaimBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimBadRequest creates V2SetHostClaimBadRequest with default headers values
+func NewV2SetHostClaimBadRequest() *V2SetHostClaimBadRequest {
+
+	return &V2SetHostClaimBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 set host claim bad request response
+func (o *V2SetHostClaimBadRequest) WithPayload(payload *models.Error) *V2SetHostClaimBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim bad request response
+func (o *V2SetHostClaimBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimUnauthorizedCode is the HTTP code returned for type V2SetHostClaimUnauthorized
+const V2SetHostClaimUnauthorizedCode int = 401
+
+/*
+V2SetHostClaimUnauthorized Unauthorized.
+
+swagger:response v2SetHostClaimUnauthorized
+*/
+type V2SetHostClaimUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimUnauthorized creates V2SetHostClaimUnauthorized with default headers values
+func NewV2SetHostClaimUnauthorized() *V2SetHostClaimUnauthorized {
+
+	return &V2SetHostClaimUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 set host claim unauthorized response
+func (o *V2SetHostClaimUnauthorized) WithPayload(payload *models.InfraError) *V2SetHostClaimUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim unauthorized response
+func (o *V2SetHostClaimUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimForbiddenCode is the HTTP code returned for type V2SetHostClaimForbidden
+const V2SetHostClaimForbiddenCode int = 403
+
+/*
+V2SetHostClaimForbidden Forbidden.
+
+swagger:response v2SetHostClaimForbidden
+*/
+type V2SetHostClaimForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimForbidden creates V2SetHostClaimForbidden with default headers values
+func NewV2SetHostClaimForbidden() *V2SetHostClaimForbidden {
+
+	return &V2SetHostClaimForbidden{}
+}
+
+// WithPayload adds the payload to the v2 set host claim forbidden response
+func (o *V2SetHostClaimForbidden) WithPayload(payload *models.InfraError) *V2SetHostClaimForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim forbidden response
+func (o *V2SetHostClaimForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimNotFoundCode is the HTTP code returned for type V2SetHostClaimNotFound
+const V2SetHostClaimNotFoundCode int = 404
+
+/*
+V2SetHostClaimNotFound Error.
+
+swagger:response v2SetHostClaimNotFound
+*/
+type V2SetHostClaimNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimNotFound creates V2SetHostClaimNotFound with default headers values
+func NewV2SetHostClaimNotFound() *V2SetHostClaimNotFound {
+
+	return &V2SetHostClaimNotFound{}
+}
+
+// WithPayload adds the payload to the v2 set host claim not found response
+func (o *V2SetHostClaimNotFound) WithPayload(payload *models.Error) *V2SetHostClaimNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim not found response
+func (o *V2SetHostClaimNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimConflictCode is the HTTP code returned for type V2SetHostClaimConflict
+const V2SetHostClaimConflictCode int = 409
+
+/*
+V2SetHostClaimConflict Error.
+
+swagger:response v2SetHostClaimConflict
+*/
+type V2SetHostClaimConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimConflict creates V2SetHostClaimConflict with default headers values
+func NewV2SetHostClaimConflict() *V2SetHostClaimConflict {
+
+	return &V2SetHostClaimConflict{}
+}
+
+// WithPayload adds the payload to the v2 set host claim conflict response
+func (o *V2SetHostClaimConflict) WithPayload(payload *models.Error) *V2SetHostClaimConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim conflict response
+func (o *V2SetHostClaimConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SetHostClaimInternalServerErrorCode is the HTTP code returned for type V2SetHostClaimInternalServerError
+const V2SetHostClaimInternalServerErrorCode int = 500
+
+/*
+V2SetHostClaimInternalServerError Error.
+
+swagger:response v2SetHostClaimInternalServerError
+*/
+type V2SetHostClaimInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SetHostClaimInternalServerError creates V2SetHostClaimInternalServerError with default headers values
+func NewV2SetHostClaimInternalServerError() *V2SetHostClaimInternalServerError {
+
+	return &V2SetHostClaimInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 set host claim internal server error response
+func (o *V2SetHostClaimInternalServerError) WithPayload(payload *models.Error) *V2SetHostClaimInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 set host claim internal server error response
+func (o *V2SetHostClaimInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SetHostClaimInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
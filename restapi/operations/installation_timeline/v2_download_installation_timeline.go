@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DownloadInstallationTimelineHandlerFunc turns a function with the right signature into a v2 download installation timeline handler
+type V2DownloadInstallationTimelineHandlerFunc func(V2DownloadInstallationTimelineParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DownloadInstallationTimelineHandlerFunc) Handle(params V2DownloadInstallationTimelineParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DownloadInstallationTimelineHandler interface for that can handle valid v2 download installation timeline params
+type V2DownloadInstallationTimelineHandler interface {
+	Handle(V2DownloadInstallationTimelineParams, interface{}) middleware.Responder
+}
+
+// NewV2DownloadInstallationTimeline creates a new http.Handler for the v2 download installation timeline operation
+func NewV2DownloadInstallationTimeline(ctx *middleware.Context, handler V2DownloadInstallationTimelineHandler) *V2DownloadInstallationTimeline {
+	return &V2DownloadInstallationTimeline{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DownloadInstallationTimeline swagger:route GET /v2/clusters/{cluster_id}/installation-timeline/download installation_timeline v2DownloadInstallationTimeline
+
+Downloads the installation timeline of the cluster.
+*/
+type V2DownloadInstallationTimeline struct {
+	Context *middleware.Context
+	Handler V2DownloadInstallationTimelineHandler
+}
+
+func (o *V2DownloadInstallationTimeline) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DownloadInstallationTimelineParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
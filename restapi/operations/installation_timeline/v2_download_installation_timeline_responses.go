@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadInstallationTimelineOKCode is the HTTP code returned for type V2DownloadInstallationTimelineOK
+const V2DownloadInstallationTimelineOKCode int = 200
+
+/*
+V2DownloadInstallationTimelineOK Success.
+
+swagger:response v2DownloadInstallationTimelineOK
+*/
+type V2DownloadInstallationTimelineOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2DownloadInstallationTimelineOK creates V2DownloadInstallationTimelineOK with default headers values
+func NewV2DownloadInstallationTimelineOK() *V2DownloadInstallationTimelineOK {
+
+	return &V2DownloadInstallationTimelineOK{}
+}
+
+// WithPayload adds the payload to the v2 download installation timeline o k response
+func (o *V2DownloadInstallationTimelineOK) WithPayload(payload io.ReadCloser) *V2DownloadInstallationTimelineOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download installation timeline o k response
+func (o *V2DownloadInstallationTimelineOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInstallationTimelineOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2DownloadInstallationTimelineUnauthorizedCode is the HTTP code returned for type V2DownloadInstallationTimelineUnauthorized
+const V2DownloadInstallationTimelineUnauthorizedCode int = 401
+
+/*
+V2DownloadInstallationTimelineUnauthorized Unauthorized.
+
+swagger:response v2DownloadInstallationTimelineUnauthorized
+*/
+type V2DownloadInstallationTimelineUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadInstallationTimelineUnauthorized creates V2DownloadInstallationTimelineUnauthorized with default headers values
+func NewV2DownloadInstallationTimelineUnauthorized() *V2DownloadInstallationTimelineUnauthorized {
+
+	return &V2DownloadInstallationTimelineUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 download installation timeline unauthorized response
+func (o *V2DownloadInstallationTimelineUnauthorized) WithPayload(payload *models.InfraError) *V2DownloadInstallationTimelineUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download installation timeline unauthorized response
+func (o *V2DownloadInstallationTimelineUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInstallationTimelineUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInstallationTimelineForbiddenCode is the HTTP code returned for type V2DownloadInstallationTimelineForbidden
+const V2DownloadInstallationTimelineForbiddenCode int = 403
+
+/*
+V2DownloadInstallationTimelineForbidden Forbidden.
+
+swagger:response v2DownloadInstallationTimelineForbidden
+*/
+type V2DownloadInstallationTimelineForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadInstallationTimelineForbidden creates V2DownloadInstallationTimelineForbidden with default headers values
+func NewV2DownloadInstallationTimelineForbidden() *V2DownloadInstallationTimelineForbidden {
+
+	return &V2DownloadInstallationTimelineForbidden{}
+}
+
+// WithPayload adds the payload to the v2 download installation timeline forbidden response
+func (o *V2DownloadInstallationTimelineForbidden) WithPayload(payload *models.InfraError) *V2DownloadInstallationTimelineForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download installation timeline forbidden response
+func (o *V2DownloadInstallationTimelineForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInstallationTimelineForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInstallationTimelineNotFoundCode is the HTTP code returned for type V2DownloadInstallationTimelineNotFound
+const V2DownloadInstallationTimelineNotFoundCode int = 404
+
+/*
+V2DownloadInstallationTimelineNotFound Error.
+
+swagger:response v2DownloadInstallationTimelineNotFound
+*/
+type V2DownloadInstallationTimelineNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadInstallationTimelineNotFound creates V2DownloadInstallationTimelineNotFound with default headers values
+func NewV2DownloadInstallationTimelineNotFound() *V2DownloadInstallationTimelineNotFound {
+
+	return &V2DownloadInstallationTimelineNotFound{}
+}
+
+// WithPayload adds the payload to the v2 download installation timeline not found response
+func (o *V2DownloadInstallationTimelineNotFound) WithPayload(payload *models.Error) *V2DownloadInstallationTimelineNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download installation timeline not found response
+func (o *V2DownloadInstallationTimelineNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInstallationTimelineNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInstallationTimelineInternalServerErrorCode is the HTTP code returned for type V2DownloadInstallationTimelineInternalServerError
+const V2DownloadInstallationTimelineInternalServerErrorCode int = 500
+
+/*
+V2DownloadInstallationTimelineInternalServerError Error.
+
+swagger:response v2DownloadInstallationTimelineInternalServerError
+*/
+type V2DownloadInstallationTimelineInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadInstallationTimelineInternalServerError creates V2DownloadInstallationTimelineInternalServerError with default headers values
+func NewV2DownloadInstallationTimelineInternalServerError() *V2DownloadInstallationTimelineInternalServerError {
+
+	return &V2DownloadInstallationTimelineInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 download installation timeline internal server error response
+func (o *V2DownloadInstallationTimelineInternalServerError) WithPayload(payload *models.Error) *V2DownloadInstallationTimelineInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download installation timeline internal server error response
+func (o *V2DownloadInstallationTimelineInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInstallationTimelineInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetInstallationTimelineHandlerFunc turns a function with the right signature into a v2 get installation timeline handler
+type V2GetInstallationTimelineHandlerFunc func(V2GetInstallationTimelineParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetInstallationTimelineHandlerFunc) Handle(params V2GetInstallationTimelineParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetInstallationTimelineHandler interface for that can handle valid v2 get installation timeline params
+type V2GetInstallationTimelineHandler interface {
+	Handle(V2GetInstallationTimelineParams, interface{}) middleware.Responder
+}
+
+// NewV2GetInstallationTimeline creates a new http.Handler for the v2 get installation timeline operation
+func NewV2GetInstallationTimeline(ctx *middleware.Context, handler V2GetInstallationTimelineHandler) *V2GetInstallationTimeline {
+	return &V2GetInstallationTimeline{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetInstallationTimeline swagger:route GET /v2/clusters/{cluster_id}/installation-timeline installation_timeline v2GetInstallationTimeline
+
+Reports the duration of the installation stages of the hosts, of the finalizing stages and of the operators of the cluster, with the critical path of the installation.
+*/
+type V2GetInstallationTimeline struct {
+	Context *middleware.Context
+	Handler V2GetInstallationTimelineHandler
+}
+
+func (o *V2GetInstallationTimeline) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetInstallationTimelineParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
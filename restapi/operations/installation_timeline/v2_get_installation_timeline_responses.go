@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installation_timeline
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInstallationTimelineOKCode is the HTTP code returned for type V2GetInstallationTimelineOK
+const V2GetInstallationTimelineOKCode int = 200
+
+/*
+V2GetInstallationTimelineOK Success.
+
+swagger:response v2GetInstallationTimelineOK
+*/
+type V2GetInstallationTimelineOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallationTimeline `json:"body,omitempty"`
+}
+
+// NewV2GetInstallationTimelineOK creates V2GetInstallationTimelineOK with default headers values
+func NewV2GetInstallationTimelineOK() *V2GetInstallationTimelineOK {
+
+	return &V2GetInstallationTimelineOK{}
+}
+
+// WithPayload adds the payload to the v2 get installation timeline o k response
+func (o *V2GetInstallationTimelineOK) WithPayload(payload *models.InstallationTimeline) *V2GetInstallationTimelineOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get installation timeline o k response
+func (o *V2GetInstallationTimelineOK) SetPayload(payload *models.InstallationTimeline) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInstallationTimelineOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInstallationTimelineUnauthorizedCode is the HTTP code returned for type V2GetInstallationTimelineUnauthorized
+const V2GetInstallationTimelineUnauthorizedCode int = 401
+
+/*
+V2GetInstallationTimelineUnauthorized Unauthorized.
+
+swagger:response v2GetInstallationTimelineUnauthorized
+*/
+type V2GetInstallationTimelineUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInstallationTimelineUnauthorized creates V2GetInstallationTimelineUnauthorized with default headers values
+func NewV2GetInstallationTimelineUnauthorized() *V2GetInstallationTimelineUnauthorized {
+
+	return &V2GetInstallationTimelineUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get installation timeline unauthorized response
+func (o *V2GetInstallationTimelineUnauthorized) WithPayload(payload *models.InfraError) *V2GetInstallationTimelineUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get installation timeline unauthorized response
+func (o *V2GetInstallationTimelineUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInstallationTimelineUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInstallationTimelineForbiddenCode is the HTTP code returned for type V2GetInstallationTimelineForbidden
+const V2GetInstallationTimelineForbiddenCode int = 403
+
+/*
+V2GetInstallationTimelineForbidden Forbidden.
+
+swagger:response v2GetInstallationTimelineForbidden
+*/
+type V2GetInstallationTimelineForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInstallationTimelineForbidden creates V2GetInstallationTimelineForbidden with default headers values
+func NewV2GetInstallationTimelineForbidden() *V2GetInstallationTimelineForbidden {
+
+	return &V2GetInstallationTimelineForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get installation timeline forbidden response
+func (o *V2GetInstallationTimelineForbidden) WithPayload(payload *models.InfraError) *V2GetInstallationTimelineForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get installation timeline forbidden response
+func (o *V2GetInstallationTimelineForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInstallationTimelineForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInstallationTimelineNotFoundCode is the HTTP code returned for type V2GetInstallationTimelineNotFound
+const V2GetInstallationTimelineNotFoundCode int = 404
+
+/*
+V2GetInstallationTimelineNotFound Error.
+
+swagger:response v2GetInstallationTimelineNotFound
+*/
+type V2GetInstallationTimelineNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInstallationTimelineNotFound creates V2GetInstallationTimelineNotFound with default headers values
+func NewV2GetInstallationTimelineNotFound() *V2GetInstallationTimelineNotFound {
+
+	return &V2GetInstallationTimelineNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get installation timeline not found response
+func (o *V2GetInstallationTimelineNotFound) WithPayload(payload *models.Error) *V2GetInstallationTimelineNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get installation timeline not found response
+func (o *V2GetInstallationTimelineNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInstallationTimelineNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInstallationTimelineInternalServerErrorCode is the HTTP code returned for type V2GetInstallationTimelineInternalServerError
+const V2GetInstallationTimelineInternalServerErrorCode int = 500
+
+/*
+V2GetInstallationTimelineInternalServerError Error.
+
+swagger:response v2GetInstallationTimelineInternalServerError
+*/
+type V2GetInstallationTimelineInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInstallationTimelineInternalServerError creates V2GetInstallationTimelineInternalServerError with default headers values
+func NewV2GetInstallationTimelineInternalServerError() *V2GetInstallationTimelineInternalServerError {
+
+	return &V2GetInstallationTimelineInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get installation timeline internal server error response
+func (o *V2GetInstallationTimelineInternalServerError) WithPayload(payload *models.Error) *V2GetInstallationTimelineInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get installation timeline internal server error response
+func (o *V2GetInstallationTimelineInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInstallationTimelineInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
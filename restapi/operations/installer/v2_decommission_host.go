@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DecommissionHostHandlerFunc turns a function with the right signature into a v2 decommission host handler
+type V2DecommissionHostHandlerFunc func(V2DecommissionHostParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DecommissionHostHandlerFunc) Handle(params V2DecommissionHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DecommissionHostHandler interface for that can handle valid v2 decommission host params
+type V2DecommissionHostHandler interface {
+	Handle(V2DecommissionHostParams, interface{}) middleware.Responder
+}
+
+// NewV2DecommissionHost creates a new http.Handler for the v2 decommission host operation
+func NewV2DecommissionHost(ctx *middleware.Context, handler V2DecommissionHostHandler) *V2DecommissionHost {
+	return &V2DecommissionHost{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DecommissionHost swagger:route POST /v2/clusters/{cluster_id}/hosts/{host_id}/actions/decommission installer v2DecommissionHost
+
+Decommissions an installed host, draining its node and removing it from the cluster. The decommission continues in the background, its progress is reported in the decommission stage of the host and in the events.
+*/
+type V2DecommissionHost struct {
+	Context *middleware.Context
+	Handler V2DecommissionHostHandler
+}
+
+func (o *V2DecommissionHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DecommissionHostParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DecommissionHostAcceptedCode is the HTTP code returned for type V2DecommissionHostAccepted
+const V2DecommissionHostAcceptedCode int = 202
+
+/*
+V2DecommissionHostAccepted Success.
+
+swagger:response v2DecommissionHostAccepted
+*/
+type V2DecommissionHostAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Host `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostAccepted creates V2DecommissionHostAccepted with default headers values
+func NewV2DecommissionHostAccepted() *V2DecommissionHostAccepted {
+
+	return &V2DecommissionHostAccepted{}
+}
+
+// WithPayload adds the payload to the v2 decommission host accepted response
+func (o *V2DecommissionHostAccepted) WithPayload(payload *models.Host) *V2DecommissionHostAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host accepted response
+func (o *V2DecommissionHostAccepted) SetPayload(payload *models.Host) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostBadRequestCode is the HTTP code returned for type V2DecommissionHostBadRequest
+const V2DecommissionHostBadRequestCode int = 400
+
+/*
+V2DecommissionHostBadRequest Error.
+
+swagger:response v2DecommissionHostBadRequest
+*/
+type V2DecommissionHostBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostBadRequest creates V2DecommissionHostBadRequest with default headers values
+func NewV2DecommissionHostBadRequest() *V2DecommissionHostBadRequest {
+
+	return &V2DecommissionHostBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 decommission host bad request response
+func (o *V2DecommissionHostBadRequest) WithPayload(payload *models.Error) *V2DecommissionHostBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host bad request response
+func (o *V2DecommissionHostBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostUnauthorizedCode is the HTTP code returned for type V2DecommissionHostUnauthorized
+const V2DecommissionHostUnauthorizedCode int = 401
+
+/*
+V2DecommissionHostUnauthorized Unauthorized.
+
+swagger:response v2DecommissionHostUnauthorized
+*/
+type V2DecommissionHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostUnauthorized creates V2DecommissionHostUnauthorized with default headers values
+func NewV2DecommissionHostUnauthorized() *V2DecommissionHostUnauthorized {
+
+	return &V2DecommissionHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 decommission host unauthorized response
+func (o *V2DecommissionHostUnauthorized) WithPayload(payload *models.InfraError) *V2DecommissionHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host unauthorized response
+func (o *V2DecommissionHostUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostForbiddenCode is the HTTP code returned for type V2DecommissionHostForbidden
+const V2DecommissionHostForbiddenCode int = 403
+
+/*
+V2DecommissionHostForbidden Forbidden.
+
+swagger:response v2DecommissionHostForbidden
+*/
+type V2DecommissionHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostForbidden creates V2DecommissionHostForbidden with default headers values
+func NewV2DecommissionHostForbidden() *V2DecommissionHostForbidden {
+
+	return &V2DecommissionHostForbidden{}
+}
+
+// WithPayload adds the payload to the v2 decommission host forbidden response
+func (o *V2DecommissionHostForbidden) WithPayload(payload *models.InfraError) *V2DecommissionHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host forbidden response
+func (o *V2DecommissionHostForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostNotFoundCode is the HTTP code returned for type V2DecommissionHostNotFound
+const V2DecommissionHostNotFoundCode int = 404
+
+/*
+V2DecommissionHostNotFound Error.
+
+swagger:response v2DecommissionHostNotFound
+*/
+type V2DecommissionHostNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostNotFound creates V2DecommissionHostNotFound with default headers values
+func NewV2DecommissionHostNotFound() *V2DecommissionHostNotFound {
+
+	return &V2DecommissionHostNotFound{}
+}
+
+// WithPayload adds the payload to the v2 decommission host not found response
+func (o *V2DecommissionHostNotFound) WithPayload(payload *models.Error) *V2DecommissionHostNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host not found response
+func (o *V2DecommissionHostNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostConflictCode is the HTTP code returned for type V2DecommissionHostConflict
+const V2DecommissionHostConflictCode int = 409
+
+/*
+V2DecommissionHostConflict Error.
+
+swagger:response v2DecommissionHostConflict
+*/
+type V2DecommissionHostConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostConflict creates V2DecommissionHostConflict with default headers values
+func NewV2DecommissionHostConflict() *V2DecommissionHostConflict {
+
+	return &V2DecommissionHostConflict{}
+}
+
+// WithPayload adds the payload to the v2 decommission host conflict response
+func (o *V2DecommissionHostConflict) WithPayload(payload *models.Error) *V2DecommissionHostConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host conflict response
+func (o *V2DecommissionHostConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DecommissionHostInternalServerErrorCode is the HTTP code returned for type V2DecommissionHostInternalServerError
+const V2DecommissionHostInternalServerErrorCode int = 500
+
+/*
+V2DecommissionHostInternalServerError Error.
+
+swagger:response v2DecommissionHostInternalServerError
+*/
+type V2DecommissionHostInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DecommissionHostInternalServerError creates V2DecommissionHostInternalServerError with default headers values
+func NewV2DecommissionHostInternalServerError() *V2DecommissionHostInternalServerError {
+
+	return &V2DecommissionHostInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 decommission host internal server error response
+func (o *V2DecommissionHostInternalServerError) WithPayload(payload *models.Error) *V2DecommissionHostInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 decommission host internal server error response
+func (o *V2DecommissionHostInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DecommissionHostInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
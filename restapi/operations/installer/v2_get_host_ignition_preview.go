@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetHostIgnitionPreviewHandlerFunc turns a function with the right signature into a v2 get host ignition preview handler
+type V2GetHostIgnitionPreviewHandlerFunc func(V2GetHostIgnitionPreviewParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetHostIgnitionPreviewHandlerFunc) Handle(params V2GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetHostIgnitionPreviewHandler interface for that can handle valid v2 get host ignition preview params
+type V2GetHostIgnitionPreviewHandler interface {
+	Handle(V2GetHostIgnitionPreviewParams, interface{}) middleware.Responder
+}
+
+// NewV2GetHostIgnitionPreview creates a new http.Handler for the v2 get host ignition preview operation
+func NewV2GetHostIgnitionPreview(ctx *middleware.Context, handler V2GetHostIgnitionPreviewHandler) *V2GetHostIgnitionPreview {
+	return &V2GetHostIgnitionPreview{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetHostIgnitionPreview swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition/preview installer v2GetHostIgnitionPreview
+
+Renders the ignition of the host with the ignition override layers of its cluster and of the host applied, along with the layer which set every file and systemd unit.
+*/
+type V2GetHostIgnitionPreview struct {
+	Context *middleware.Context
+	Handler V2GetHostIgnitionPreviewHandler
+}
+
+func (o *V2GetHostIgnitionPreview) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetHostIgnitionPreviewParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
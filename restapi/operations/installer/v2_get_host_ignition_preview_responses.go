@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostIgnitionPreviewOKCode is the HTTP code returned for type V2GetHostIgnitionPreviewOK
+const V2GetHostIgnitionPreviewOKCode int = 200
+
+/*
+V2GetHostIgnitionPreviewOK Success.
+
+swagger:response v2GetHostIgnitionPreviewOK
+*/
+type V2GetHostIgnitionPreviewOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostIgnitionPreview `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewOK creates V2GetHostIgnitionPreviewOK with default headers values
+func NewV2GetHostIgnitionPreviewOK() *V2GetHostIgnitionPreviewOK {
+
+	return &V2GetHostIgnitionPreviewOK{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview o k response
+func (o *V2GetHostIgnitionPreviewOK) WithPayload(payload *models.HostIgnitionPreview) *V2GetHostIgnitionPreviewOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview o k response
+func (o *V2GetHostIgnitionPreviewOK) SetPayload(payload *models.HostIgnitionPreview) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewUnauthorizedCode is the HTTP code returned for type V2GetHostIgnitionPreviewUnauthorized
+const V2GetHostIgnitionPreviewUnauthorizedCode int = 401
+
+/*
+V2GetHostIgnitionPreviewUnauthorized Unauthorized.
+
+swagger:response v2GetHostIgnitionPreviewUnauthorized
+*/
+type V2GetHostIgnitionPreviewUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewUnauthorized creates V2GetHostIgnitionPreviewUnauthorized with default headers values
+func NewV2GetHostIgnitionPreviewUnauthorized() *V2GetHostIgnitionPreviewUnauthorized {
+
+	return &V2GetHostIgnitionPreviewUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview unauthorized response
+func (o *V2GetHostIgnitionPreviewUnauthorized) WithPayload(payload *models.InfraError) *V2GetHostIgnitionPreviewUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview unauthorized response
+func (o *V2GetHostIgnitionPreviewUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewForbiddenCode is the HTTP code returned for type V2GetHostIgnitionPreviewForbidden
+const V2GetHostIgnitionPreviewForbiddenCode int = 403
+
+/*
+V2GetHostIgnitionPreviewForbidden Forbidden.
+
+swagger:response v2GetHostIgnitionPreviewForbidden
+*/
+type V2GetHostIgnitionPreviewForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewForbidden creates V2GetHostIgnitionPreviewForbidden with default headers values
+func NewV2GetHostIgnitionPreviewForbidden() *V2GetHostIgnitionPreviewForbidden {
+
+	return &V2GetHostIgnitionPreviewForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview forbidden response
+func (o *V2GetHostIgnitionPreviewForbidden) WithPayload(payload *models.InfraError) *V2GetHostIgnitionPreviewForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview forbidden response
+func (o *V2GetHostIgnitionPreviewForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewNotFoundCode is the HTTP code returned for type V2GetHostIgnitionPreviewNotFound
+const V2GetHostIgnitionPreviewNotFoundCode int = 404
+
+/*
+V2GetHostIgnitionPreviewNotFound Error.
+
+swagger:response v2GetHostIgnitionPreviewNotFound
+*/
+type V2GetHostIgnitionPreviewNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewNotFound creates V2GetHostIgnitionPreviewNotFound with default headers values
+func NewV2GetHostIgnitionPreviewNotFound() *V2GetHostIgnitionPreviewNotFound {
+
+	return &V2GetHostIgnitionPreviewNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview not found response
+func (o *V2GetHostIgnitionPreviewNotFound) WithPayload(payload *models.Error) *V2GetHostIgnitionPreviewNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview not found response
+func (o *V2GetHostIgnitionPreviewNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewMethodNotAllowedCode is the HTTP code returned for type V2GetHostIgnitionPreviewMethodNotAllowed
+const V2GetHostIgnitionPreviewMethodNotAllowedCode int = 405
+
+/*
+V2GetHostIgnitionPreviewMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetHostIgnitionPreviewMethodNotAllowed
+*/
+type V2GetHostIgnitionPreviewMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewMethodNotAllowed creates V2GetHostIgnitionPreviewMethodNotAllowed with default headers values
+func NewV2GetHostIgnitionPreviewMethodNotAllowed() *V2GetHostIgnitionPreviewMethodNotAllowed {
+
+	return &V2GetHostIgnitionPreviewMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview method not allowed response
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) WithPayload(payload *models.Error) *V2GetHostIgnitionPreviewMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview method not allowed response
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewConflictCode is the HTTP code returned for type V2GetHostIgnitionPreviewConflict
+const V2GetHostIgnitionPreviewConflictCode int = 409
+
+/*
+V2GetHostIgnitionPreviewConflict Error.
+
+swagger:response v2GetHostIgnitionPreviewConflict
+*/
+type V2GetHostIgnitionPreviewConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewConflict creates V2GetHostIgnitionPreviewConflict with default headers values
+func NewV2GetHostIgnitionPreviewConflict() *V2GetHostIgnitionPreviewConflict {
+
+	return &V2GetHostIgnitionPreviewConflict{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview conflict response
+func (o *V2GetHostIgnitionPreviewConflict) WithPayload(payload *models.Error) *V2GetHostIgnitionPreviewConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview conflict response
+func (o *V2GetHostIgnitionPreviewConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostIgnitionPreviewInternalServerErrorCode is the HTTP code returned for type V2GetHostIgnitionPreviewInternalServerError
+const V2GetHostIgnitionPreviewInternalServerErrorCode int = 500
+
+/*
+V2GetHostIgnitionPreviewInternalServerError Error.
+
+swagger:response v2GetHostIgnitionPreviewInternalServerError
+*/
+type V2GetHostIgnitionPreviewInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostIgnitionPreviewInternalServerError creates V2GetHostIgnitionPreviewInternalServerError with default headers values
+func NewV2GetHostIgnitionPreviewInternalServerError() *V2GetHostIgnitionPreviewInternalServerError {
+
+	return &V2GetHostIgnitionPreviewInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get host ignition preview internal server error response
+func (o *V2GetHostIgnitionPreviewInternalServerError) WithPayload(payload *models.Error) *V2GetHostIgnitionPreviewInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host ignition preview internal server error response
+func (o *V2GetHostIgnitionPreviewInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostIgnitionPreviewInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
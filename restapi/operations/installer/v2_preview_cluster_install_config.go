@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2PreviewClusterInstallConfigHandlerFunc turns a function with the right signature into a v2 preview cluster install config handler
+type V2PreviewClusterInstallConfigHandlerFunc func(V2PreviewClusterInstallConfigParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2PreviewClusterInstallConfigHandlerFunc) Handle(params V2PreviewClusterInstallConfigParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2PreviewClusterInstallConfigHandler interface for that can handle valid v2 preview cluster install config params
+type V2PreviewClusterInstallConfigHandler interface {
+	Handle(V2PreviewClusterInstallConfigParams, interface{}) middleware.Responder
+}
+
+// NewV2PreviewClusterInstallConfig creates a new http.Handler for the v2 preview cluster install config operation
+func NewV2PreviewClusterInstallConfig(ctx *middleware.Context, handler V2PreviewClusterInstallConfigHandler) *V2PreviewClusterInstallConfig {
+	return &V2PreviewClusterInstallConfig{Context: ctx, Handler: handler}
+}
+
+/*
+	V2PreviewClusterInstallConfig swagger:route POST /v2/clusters/{cluster_id}/install-config/preview installer v2PreviewClusterInstallConfig
+
+Previews the install config resulting from install config overrides, without applying them.
+*/
+type V2PreviewClusterInstallConfig struct {
+	Context *middleware.Context
+	Handler V2PreviewClusterInstallConfigHandler
+}
+
+func (o *V2PreviewClusterInstallConfig) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2PreviewClusterInstallConfigParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2PreviewClusterInstallConfigOKCode is the HTTP code returned for type V2PreviewClusterInstallConfigOK
+const V2PreviewClusterInstallConfigOKCode int = 200
+
+/*
+V2PreviewClusterInstallConfigOK Success.
+
+swagger:response v2PreviewClusterInstallConfigOK
+*/
+type V2PreviewClusterInstallConfigOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallConfigPreview `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigOK creates V2PreviewClusterInstallConfigOK with default headers values
+func NewV2PreviewClusterInstallConfigOK() *V2PreviewClusterInstallConfigOK {
+
+	return &V2PreviewClusterInstallConfigOK{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config o k response
+func (o *V2PreviewClusterInstallConfigOK) WithPayload(payload *models.InstallConfigPreview) *V2PreviewClusterInstallConfigOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config o k response
+func (o *V2PreviewClusterInstallConfigOK) SetPayload(payload *models.InstallConfigPreview) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigBadRequestCode is the HTTP code returned for type V2PreviewClusterInstallConfigBadRequest
+const V2PreviewClusterInstallConfigBadRequestCode int = 400
+
+/*
+V2PreviewClusterInstallConfigBadRequest Error.
+
+swagger:response v2PreviewClusterInstallConfigBadRequest
+*/
+type V2PreviewClusterInstallConfigBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigBadRequest creates V2PreviewClusterInstallConfigBadRequest with default headers values
+func NewV2PreviewClusterInstallConfigBadRequest() *V2PreviewClusterInstallConfigBadRequest {
+
+	return &V2PreviewClusterInstallConfigBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config bad request response
+func (o *V2PreviewClusterInstallConfigBadRequest) WithPayload(payload *models.Error) *V2PreviewClusterInstallConfigBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config bad request response
+func (o *V2PreviewClusterInstallConfigBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigUnauthorizedCode is the HTTP code returned for type V2PreviewClusterInstallConfigUnauthorized
+const V2PreviewClusterInstallConfigUnauthorizedCode int = 401
+
+/*
+V2PreviewClusterInstallConfigUnauthorized Unauthorized.
+
+swagger:response v2PreviewClusterInstallConfigUnauthorized
+*/
+type V2PreviewClusterInstallConfigUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigUnauthorized creates V2PreviewClusterInstallConfigUnauthorized with default headers values
+func NewV2PreviewClusterInstallConfigUnauthorized() *V2PreviewClusterInstallConfigUnauthorized {
+
+	return &V2PreviewClusterInstallConfigUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config unauthorized response
+func (o *V2PreviewClusterInstallConfigUnauthorized) WithPayload(payload *models.InfraError) *V2PreviewClusterInstallConfigUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config unauthorized response
+func (o *V2PreviewClusterInstallConfigUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigForbiddenCode is the HTTP code returned for type V2PreviewClusterInstallConfigForbidden
+const V2PreviewClusterInstallConfigForbiddenCode int = 403
+
+/*
+V2PreviewClusterInstallConfigForbidden Forbidden.
+
+swagger:response v2PreviewClusterInstallConfigForbidden
+*/
+type V2PreviewClusterInstallConfigForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigForbidden creates V2PreviewClusterInstallConfigForbidden with default headers values
+func NewV2PreviewClusterInstallConfigForbidden() *V2PreviewClusterInstallConfigForbidden {
+
+	return &V2PreviewClusterInstallConfigForbidden{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config forbidden response
+func (o *V2PreviewClusterInstallConfigForbidden) WithPayload(payload *models.InfraError) *V2PreviewClusterInstallConfigForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config forbidden response
+func (o *V2PreviewClusterInstallConfigForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigNotFoundCode is the HTTP code returned for type V2PreviewClusterInstallConfigNotFound
+const V2PreviewClusterInstallConfigNotFoundCode int = 404
+
+/*
+V2PreviewClusterInstallConfigNotFound Error.
+
+swagger:response v2PreviewClusterInstallConfigNotFound
+*/
+type V2PreviewClusterInstallConfigNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigNotFound creates V2PreviewClusterInstallConfigNotFound with default headers values
+func NewV2PreviewClusterInstallConfigNotFound() *V2PreviewClusterInstallConfigNotFound {
+
+	return &V2PreviewClusterInstallConfigNotFound{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config not found response
+func (o *V2PreviewClusterInstallConfigNotFound) WithPayload(payload *models.Error) *V2PreviewClusterInstallConfigNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config not found response
+func (o *V2PreviewClusterInstallConfigNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigMethodNotAllowedCode is the HTTP code returned for type V2PreviewClusterInstallConfigMethodNotAllowed
+const V2PreviewClusterInstallConfigMethodNotAllowedCode int = 405
+
+/*
+V2PreviewClusterInstallConfigMethodNotAllowed Method Not Allowed.
+
+swagger:response v2PreviewClusterInstallConfigMethodNotAllowed
+*/
+type V2PreviewClusterInstallConfigMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigMethodNotAllowed creates V2PreviewClusterInstallConfigMethodNotAllowed with default headers values
+func NewV2PreviewClusterInstallConfigMethodNotAllowed() *V2PreviewClusterInstallConfigMethodNotAllowed {
+
+	return &V2PreviewClusterInstallConfigMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config method not allowed response
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) WithPayload(payload *models.Error) *V2PreviewClusterInstallConfigMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config method not allowed response
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2PreviewClusterInstallConfigInternalServerErrorCode is the HTTP code returned for type V2PreviewClusterInstallConfigInternalServerError
+const V2PreviewClusterInstallConfigInternalServerErrorCode int = 500
+
+/*
+V2PreviewClusterInstallConfigInternalServerError Error.
+
+swagger:response v2PreviewClusterInstallConfigInternalServerError
+*/
+type V2PreviewClusterInstallConfigInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2PreviewClusterInstallConfigInternalServerError creates V2PreviewClusterInstallConfigInternalServerError with default headers values
+func NewV2PreviewClusterInstallConfigInternalServerError() *V2PreviewClusterInstallConfigInternalServerError {
+
+	return &V2PreviewClusterInstallConfigInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 preview cluster install config internal server error response
+func (o *V2PreviewClusterInstallConfigInternalServerError) WithPayload(payload *models.Error) *V2PreviewClusterInstallConfigInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 preview cluster install config internal server error response
+func (o *V2PreviewClusterInstallConfigInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2PreviewClusterInstallConfigInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
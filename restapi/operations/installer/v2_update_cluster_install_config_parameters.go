@@ -13,15 +13,23 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewV2UpdateClusterInstallConfigParams creates a new V2UpdateClusterInstallConfigParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewV2UpdateClusterInstallConfigParams() V2UpdateClusterInstallConfigParams {
 
-	return V2UpdateClusterInstallConfigParams{}
+	var (
+		// initialize parameters with default values
+
+		allowServiceOwnedFieldsDefault = bool(false)
+	)
+
+	return V2UpdateClusterInstallConfigParams{
+		AllowServiceOwnedFields: &allowServiceOwnedFieldsDefault,
+	}
 }
 
 // V2UpdateClusterInstallConfigParams contains all the bound params for the v2 update cluster install config operation
@@ -33,6 +41,11 @@ type V2UpdateClusterInstallConfigParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Allow overriding the fields set by the service from the cluster, i.e. the networking and the platform, including the VIPs.
+	  In: query
+	  Default: false
+	*/
+	AllowServiceOwnedFields *bool
 	/*The cluster whose install config is being updated.
 	  Required: true
 	  In: path
@@ -54,6 +67,13 @@ func (o *V2UpdateClusterInstallConfigParams) BindRequest(r *http.Request, route
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qAllowServiceOwnedFields, qhkAllowServiceOwnedFields, _ := qs.GetOK("allow_service_owned_fields")
+	if err := o.bindAllowServiceOwnedFields(qAllowServiceOwnedFields, qhkAllowServiceOwnedFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -81,6 +101,30 @@ func (o *V2UpdateClusterInstallConfigParams) BindRequest(r *http.Request, route
 	return nil
 }
 
+// bindAllowServiceOwnedFields binds and validates parameter AllowServiceOwnedFields from query.
+func (o *V2UpdateClusterInstallConfigParams) bindAllowServiceOwnedFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2UpdateClusterInstallConfigParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("allow_service_owned_fields", "query", "bool", raw)
+	}
+	o.AllowServiceOwnedFields = &value
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *V2UpdateClusterInstallConfigParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
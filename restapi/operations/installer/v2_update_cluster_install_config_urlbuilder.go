@@ -12,12 +12,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2UpdateClusterInstallConfigURL generates an URL for the v2 update cluster install config operation
 type V2UpdateClusterInstallConfigURL struct {
 	ClusterID strfmt.UUID
 
+	AllowServiceOwnedFields *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +60,18 @@ func (o *V2UpdateClusterInstallConfigURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var allowServiceOwnedFieldsQ string
+	if o.AllowServiceOwnedFields != nil {
+		allowServiceOwnedFieldsQ = swag.FormatBool(*o.AllowServiceOwnedFields)
+	}
+	if allowServiceOwnedFieldsQ != "" {
+		qs.Set("allow_service_owned_fields", allowServiceOwnedFieldsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2SearchClusterLogsHandlerFunc turns a function with the right signature into a v2 search cluster logs handler
+type V2SearchClusterLogsHandlerFunc func(V2SearchClusterLogsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2SearchClusterLogsHandlerFunc) Handle(params V2SearchClusterLogsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2SearchClusterLogsHandler interface for that can handle valid v2 search cluster logs params
+type V2SearchClusterLogsHandler interface {
+	Handle(V2SearchClusterLogsParams, interface{}) middleware.Responder
+}
+
+// NewV2SearchClusterLogs creates a new http.Handler for the v2 search cluster logs operation
+func NewV2SearchClusterLogs(ctx *middleware.Context, handler V2SearchClusterLogsHandler) *V2SearchClusterLogs {
+	return &V2SearchClusterLogs{Context: ctx, Handler: handler}
+}
+
+/*
+	V2SearchClusterLogs swagger:route GET /v2/clusters/{cluster_id}/logs/search log_search v2SearchClusterLogs
+
+Searches the lines of the logs uploaded by the hosts and the controller of the cluster. The uploaded logs are indexed when log search is enabled in the service.
+*/
+type V2SearchClusterLogs struct {
+	Context *middleware.Context
+	Handler V2SearchClusterLogsHandler
+}
+
+func (o *V2SearchClusterLogs) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2SearchClusterLogsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,471 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2SearchClusterLogsParams creates a new V2SearchClusterLogsParams object
+// with the default values initialized.
+func NewV2SearchClusterLogsParams() V2SearchClusterLogsParams {
+
+	var (
+		// initialize parameters with default values
+
+		contextLinesDefault = int64(0)
+
+		limitDefault = int64(100)
+	)
+
+	return V2SearchClusterLogsParams{
+		ContextLines: &contextLinesDefault,
+
+		Limit: &limitDefault,
+	}
+}
+
+// V2SearchClusterLogsParams contains all the bound params for the v2 search cluster logs operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2SearchClusterLogs
+type V2SearchClusterLogsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster whose logs are searched.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*The number of lines of the file returned before and after each matching line.
+	  Maximum: 10
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	ContextLines *int64
+	/*Only search the files whose path contains this text.
+	  In: query
+	*/
+	File *string
+	/*Only search the logs of this host.
+	  In: query
+	*/
+	HostID *strfmt.UUID
+	/*The maximal number of matching lines returned.
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*Only search the logs of this type.
+	  In: query
+	*/
+	LogsType *string
+	/*The text searched in the lines of the logs, case-insensitive.
+	  Required: true
+	  Max Length: 1024
+	  Min Length: 2
+	  In: query
+	*/
+	Q string
+	/*Only return the lines whose time is at or after this time.
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Only return the lines whose time is at or before this time.
+	  In: query
+	*/
+	Until *strfmt.DateTime
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2SearchClusterLogsParams() beforehand.
+func (o *V2SearchClusterLogsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qContextLines, qhkContextLines, _ := qs.GetOK("context_lines")
+	if err := o.bindContextLines(qContextLines, qhkContextLines, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFile, qhkFile, _ := qs.GetOK("file")
+	if err := o.bindFile(qFile, qhkFile, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHostID, qhkHostID, _ := qs.GetOK("host_id")
+	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLogsType, qhkLogsType, _ := qs.GetOK("logs_type")
+	if err := o.bindLogsType(qLogsType, qhkLogsType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *V2SearchClusterLogsParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2SearchClusterLogsParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindContextLines binds and validates parameter ContextLines from query.
+func (o *V2SearchClusterLogsParams) bindContextLines(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2SearchClusterLogsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("context_lines", "query", "int64", raw)
+	}
+	o.ContextLines = &value
+
+	if err := o.validateContextLines(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateContextLines carries on validations for parameter ContextLines
+func (o *V2SearchClusterLogsParams) validateContextLines(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("context_lines", "query", *o.ContextLines, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("context_lines", "query", *o.ContextLines, 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindFile binds and validates parameter File from query.
+func (o *V2SearchClusterLogsParams) bindFile(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.File = &raw
+
+	return nil
+}
+
+// bindHostID binds and validates parameter HostID from query.
+func (o *V2SearchClusterLogsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("host_id", "query", "strfmt.UUID", raw)
+	}
+	o.HostID = (value.(*strfmt.UUID))
+
+	if err := o.validateHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHostID carries on validations for parameter HostID
+func (o *V2SearchClusterLogsParams) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("host_id", "query", "uuid", o.HostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2SearchClusterLogsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2SearchClusterLogsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2SearchClusterLogsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindLogsType binds and validates parameter LogsType from query.
+func (o *V2SearchClusterLogsParams) bindLogsType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.LogsType = &raw
+
+	if err := o.validateLogsType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLogsType carries on validations for parameter LogsType
+func (o *V2SearchClusterLogsParams) validateLogsType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("logs_type", "query", *o.LogsType, []interface{}{"host", "controller"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindQ binds and validates parameter Q from query.
+func (o *V2SearchClusterLogsParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("q", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("q", "query", raw); err != nil {
+		return err
+	}
+	o.Q = raw
+
+	if err := o.validateQ(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQ carries on validations for parameter Q
+func (o *V2SearchClusterLogsParams) validateQ(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("q", "query", o.Q, 2); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("q", "query", o.Q, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *V2SearchClusterLogsParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *V2SearchClusterLogsParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *V2SearchClusterLogsParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *V2SearchClusterLogsParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
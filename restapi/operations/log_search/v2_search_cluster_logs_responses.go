@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SearchClusterLogsOKCode is the HTTP code returned for type V2SearchClusterLogsOK
+const V2SearchClusterLogsOKCode int = 200
+
+/*
+V2SearchClusterLogsOK Success.
+
+swagger:response v2SearchClusterLogsOK
+*/
+type V2SearchClusterLogsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LogSearchResult `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsOK creates V2SearchClusterLogsOK with default headers values
+func NewV2SearchClusterLogsOK() *V2SearchClusterLogsOK {
+
+	return &V2SearchClusterLogsOK{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs o k response
+func (o *V2SearchClusterLogsOK) WithPayload(payload *models.LogSearchResult) *V2SearchClusterLogsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs o k response
+func (o *V2SearchClusterLogsOK) SetPayload(payload *models.LogSearchResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SearchClusterLogsBadRequestCode is the HTTP code returned for type V2SearchClusterLogsBadRequest
+const V2SearchClusterLogsBadRequestCode int = 400
+
+/*
+V2SearchClusterLogsBadRequest Error.
+
+swagger:response v2SearchClusterLogsBadRequest
+*/
+type V2SearchClusterLogsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsBadRequest creates V2SearchClusterLogsBadRequest with default headers values
+func NewV2SearchClusterLogsBadRequest() *V2SearchClusterLogsBadRequest {
+
+	return &V2SearchClusterLogsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs bad request response
+func (o *V2SearchClusterLogsBadRequest) WithPayload(payload *models.Error) *V2SearchClusterLogsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs bad request response
+func (o *V2SearchClusterLogsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SearchClusterLogsUnauthorizedCode is the HTTP code returned for type V2SearchClusterLogsUnauthorized
+const V2SearchClusterLogsUnauthorizedCode int = 401
+
+/*
+V2SearchClusterLogsUnauthorized Unauthorized.
+
+swagger:response v2SearchClusterLogsUnauthorized
+*/
+type V2SearchClusterLogsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsUnauthorized creates V2SearchClusterLogsUnauthorized with default headers values
+func NewV2SearchClusterLogsUnauthorized() *V2SearchClusterLogsUnauthorized {
+
+	return &V2SearchClusterLogsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs unauthorized response
+func (o *V2SearchClusterLogsUnauthorized) WithPayload(payload *models.InfraError) *V2SearchClusterLogsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs unauthorized response
+func (o *V2SearchClusterLogsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SearchClusterLogsForbiddenCode is the HTTP code returned for type V2SearchClusterLogsForbidden
+const V2SearchClusterLogsForbiddenCode int = 403
+
+/*
+V2SearchClusterLogsForbidden Forbidden.
+
+swagger:response v2SearchClusterLogsForbidden
+*/
+type V2SearchClusterLogsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsForbidden creates V2SearchClusterLogsForbidden with default headers values
+func NewV2SearchClusterLogsForbidden() *V2SearchClusterLogsForbidden {
+
+	return &V2SearchClusterLogsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs forbidden response
+func (o *V2SearchClusterLogsForbidden) WithPayload(payload *models.InfraError) *V2SearchClusterLogsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs forbidden response
+func (o *V2SearchClusterLogsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SearchClusterLogsNotFoundCode is the HTTP code returned for type V2SearchClusterLogsNotFound
+const V2SearchClusterLogsNotFoundCode int = 404
+
+/*
+V2SearchClusterLogsNotFound Error.
+
+swagger:response v2SearchClusterLogsNotFound
+*/
+type V2SearchClusterLogsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsNotFound creates V2SearchClusterLogsNotFound with default headers values
+func NewV2SearchClusterLogsNotFound() *V2SearchClusterLogsNotFound {
+
+	return &V2SearchClusterLogsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs not found response
+func (o *V2SearchClusterLogsNotFound) WithPayload(payload *models.Error) *V2SearchClusterLogsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs not found response
+func (o *V2SearchClusterLogsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SearchClusterLogsInternalServerErrorCode is the HTTP code returned for type V2SearchClusterLogsInternalServerError
+const V2SearchClusterLogsInternalServerErrorCode int = 500
+
+/*
+V2SearchClusterLogsInternalServerError Error.
+
+swagger:response v2SearchClusterLogsInternalServerError
+*/
+type V2SearchClusterLogsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SearchClusterLogsInternalServerError creates V2SearchClusterLogsInternalServerError with default headers values
+func NewV2SearchClusterLogsInternalServerError() *V2SearchClusterLogsInternalServerError {
+
+	return &V2SearchClusterLogsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 search cluster logs internal server error response
+func (o *V2SearchClusterLogsInternalServerError) WithPayload(payload *models.Error) *V2SearchClusterLogsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 search cluster logs internal server error response
+func (o *V2SearchClusterLogsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SearchClusterLogsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
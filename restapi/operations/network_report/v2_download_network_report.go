@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DownloadNetworkReportHandlerFunc turns a function with the right signature into a v2 download network report handler
+type V2DownloadNetworkReportHandlerFunc func(V2DownloadNetworkReportParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DownloadNetworkReportHandlerFunc) Handle(params V2DownloadNetworkReportParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DownloadNetworkReportHandler interface for that can handle valid v2 download network report params
+type V2DownloadNetworkReportHandler interface {
+	Handle(V2DownloadNetworkReportParams, interface{}) middleware.Responder
+}
+
+// NewV2DownloadNetworkReport creates a new http.Handler for the v2 download network report operation
+func NewV2DownloadNetworkReport(ctx *middleware.Context, handler V2DownloadNetworkReportHandler) *V2DownloadNetworkReport {
+	return &V2DownloadNetworkReport{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DownloadNetworkReport swagger:route GET /v2/clusters/{cluster_id}/network-report/download network_report v2DownloadNetworkReport
+
+Downloads the network report of the cluster, to be attached to support cases.
+*/
+type V2DownloadNetworkReport struct {
+	Context *middleware.Context
+	Handler V2DownloadNetworkReportHandler
+}
+
+func (o *V2DownloadNetworkReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DownloadNetworkReportParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
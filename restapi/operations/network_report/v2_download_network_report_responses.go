@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadNetworkReportOKCode is the HTTP code returned for type V2DownloadNetworkReportOK
+const V2DownloadNetworkReportOKCode int = 200
+
+/*
+V2DownloadNetworkReportOK Success.
+
+swagger:response v2DownloadNetworkReportOK
+*/
+type V2DownloadNetworkReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2DownloadNetworkReportOK creates V2DownloadNetworkReportOK with default headers values
+func NewV2DownloadNetworkReportOK() *V2DownloadNetworkReportOK {
+
+	return &V2DownloadNetworkReportOK{}
+}
+
+// WithPayload adds the payload to the v2 download network report o k response
+func (o *V2DownloadNetworkReportOK) WithPayload(payload io.ReadCloser) *V2DownloadNetworkReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download network report o k response
+func (o *V2DownloadNetworkReportOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadNetworkReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2DownloadNetworkReportUnauthorizedCode is the HTTP code returned for type V2DownloadNetworkReportUnauthorized
+const V2DownloadNetworkReportUnauthorizedCode int = 401
+
+/*
+V2DownloadNetworkReportUnauthorized Unauthorized.
+
+swagger:response v2DownloadNetworkReportUnauthorized
+*/
+type V2DownloadNetworkReportUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadNetworkReportUnauthorized creates V2DownloadNetworkReportUnauthorized with default headers values
+func NewV2DownloadNetworkReportUnauthorized() *V2DownloadNetworkReportUnauthorized {
+
+	return &V2DownloadNetworkReportUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 download network report unauthorized response
+func (o *V2DownloadNetworkReportUnauthorized) WithPayload(payload *models.InfraError) *V2DownloadNetworkReportUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download network report unauthorized response
+func (o *V2DownloadNetworkReportUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadNetworkReportUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadNetworkReportForbiddenCode is the HTTP code returned for type V2DownloadNetworkReportForbidden
+const V2DownloadNetworkReportForbiddenCode int = 403
+
+/*
+V2DownloadNetworkReportForbidden Forbidden.
+
+swagger:response v2DownloadNetworkReportForbidden
+*/
+type V2DownloadNetworkReportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadNetworkReportForbidden creates V2DownloadNetworkReportForbidden with default headers values
+func NewV2DownloadNetworkReportForbidden() *V2DownloadNetworkReportForbidden {
+
+	return &V2DownloadNetworkReportForbidden{}
+}
+
+// WithPayload adds the payload to the v2 download network report forbidden response
+func (o *V2DownloadNetworkReportForbidden) WithPayload(payload *models.InfraError) *V2DownloadNetworkReportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download network report forbidden response
+func (o *V2DownloadNetworkReportForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadNetworkReportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadNetworkReportNotFoundCode is the HTTP code returned for type V2DownloadNetworkReportNotFound
+const V2DownloadNetworkReportNotFoundCode int = 404
+
+/*
+V2DownloadNetworkReportNotFound Error.
+
+swagger:response v2DownloadNetworkReportNotFound
+*/
+type V2DownloadNetworkReportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadNetworkReportNotFound creates V2DownloadNetworkReportNotFound with default headers values
+func NewV2DownloadNetworkReportNotFound() *V2DownloadNetworkReportNotFound {
+
+	return &V2DownloadNetworkReportNotFound{}
+}
+
+// WithPayload adds the payload to the v2 download network report not found response
+func (o *V2DownloadNetworkReportNotFound) WithPayload(payload *models.Error) *V2DownloadNetworkReportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download network report not found response
+func (o *V2DownloadNetworkReportNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadNetworkReportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadNetworkReportInternalServerErrorCode is the HTTP code returned for type V2DownloadNetworkReportInternalServerError
+const V2DownloadNetworkReportInternalServerErrorCode int = 500
+
+/*
+V2DownloadNetworkReportInternalServerError Error.
+
+swagger:response v2DownloadNetworkReportInternalServerError
+*/
+type V2DownloadNetworkReportInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadNetworkReportInternalServerError creates V2DownloadNetworkReportInternalServerError with default headers values
+func NewV2DownloadNetworkReportInternalServerError() *V2DownloadNetworkReportInternalServerError {
+
+	return &V2DownloadNetworkReportInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 download network report internal server error response
+func (o *V2DownloadNetworkReportInternalServerError) WithPayload(payload *models.Error) *V2DownloadNetworkReportInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download network report internal server error response
+func (o *V2DownloadNetworkReportInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadNetworkReportInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetNetworkReportHandlerFunc turns a function with the right signature into a v2 get network report handler
+type V2GetNetworkReportHandlerFunc func(V2GetNetworkReportParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetNetworkReportHandlerFunc) Handle(params V2GetNetworkReportParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetNetworkReportHandler interface for that can handle valid v2 get network report params
+type V2GetNetworkReportHandler interface {
+	Handle(V2GetNetworkReportParams, interface{}) middleware.Responder
+}
+
+// NewV2GetNetworkReport creates a new http.Handler for the v2 get network report operation
+func NewV2GetNetworkReport(ctx *middleware.Context, handler V2GetNetworkReportHandler) *V2GetNetworkReport {
+	return &V2GetNetworkReport{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetNetworkReport swagger:route GET /v2/clusters/{cluster_id}/network-report network_report v2GetNetworkReport
+
+Reports the network of the hosts of the cluster, from the connectivity checks of the hosts, before the installation.
+*/
+type V2GetNetworkReport struct {
+	Context *middleware.Context
+	Handler V2GetNetworkReportHandler
+}
+
+func (o *V2GetNetworkReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetNetworkReportParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network_report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetNetworkReportOKCode is the HTTP code returned for type V2GetNetworkReportOK
+const V2GetNetworkReportOKCode int = 200
+
+/*
+V2GetNetworkReportOK Success.
+
+swagger:response v2GetNetworkReportOK
+*/
+type V2GetNetworkReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NetworkReport `json:"body,omitempty"`
+}
+
+// NewV2GetNetworkReportOK creates V2GetNetworkReportOK with default headers values
+func NewV2GetNetworkReportOK() *V2GetNetworkReportOK {
+
+	return &V2GetNetworkReportOK{}
+}
+
+// WithPayload adds the payload to the v2 get network report o k response
+func (o *V2GetNetworkReportOK) WithPayload(payload *models.NetworkReport) *V2GetNetworkReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get network report o k response
+func (o *V2GetNetworkReportOK) SetPayload(payload *models.NetworkReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetNetworkReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetNetworkReportUnauthorizedCode is the HTTP code returned for type V2GetNetworkReportUnauthorized
+const V2GetNetworkReportUnauthorizedCode int = 401
+
+/*
+V2GetNetworkReportUnauthorized Unauthorized.
+
+swagger:response v2GetNetworkReportUnauthorized
+*/
+type V2GetNetworkReportUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetNetworkReportUnauthorized creates V2GetNetworkReportUnauthorized with default headers values
+func NewV2GetNetworkReportUnauthorized() *V2GetNetworkReportUnauthorized {
+
+	return &V2GetNetworkReportUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get network report unauthorized response
+func (o *V2GetNetworkReportUnauthorized) WithPayload(payload *models.InfraError) *V2GetNetworkReportUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get network report unauthorized response
+func (o *V2GetNetworkReportUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetNetworkReportUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetNetworkReportForbiddenCode is the HTTP code returned for type V2GetNetworkReportForbidden
+const V2GetNetworkReportForbiddenCode int = 403
+
+/*
+V2GetNetworkReportForbidden Forbidden.
+
+swagger:response v2GetNetworkReportForbidden
+*/
+type V2GetNetworkReportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetNetworkReportForbidden creates V2GetNetworkReportForbidden with default headers values
+func NewV2GetNetworkReportForbidden() *V2GetNetworkReportForbidden {
+
+	return &V2GetNetworkReportForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get network report forbidden response
+func (o *V2GetNetworkReportForbidden) WithPayload(payload *models.InfraError) *V2GetNetworkReportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get network report forbidden response
+func (o *V2GetNetworkReportForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetNetworkReportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetNetworkReportNotFoundCode is the HTTP code returned for type V2GetNetworkReportNotFound
+const V2GetNetworkReportNotFoundCode int = 404
+
+/*
+V2GetNetworkReportNotFound Error.
+
+swagger:response v2GetNetworkReportNotFound
+*/
+type V2GetNetworkReportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetNetworkReportNotFound creates V2GetNetworkReportNotFound with default headers values
+func NewV2GetNetworkReportNotFound() *V2GetNetworkReportNotFound {
+
+	return &V2GetNetworkReportNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get network report not found response
+func (o *V2GetNetworkReportNotFound) WithPayload(payload *models.Error) *V2GetNetworkReportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get network report not found response
+func (o *V2GetNetworkReportNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetNetworkReportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetNetworkReportInternalServerErrorCode is the HTTP code returned for type V2GetNetworkReportInternalServerError
+const V2GetNetworkReportInternalServerErrorCode int = 500
+
+/*
+V2GetNetworkReportInternalServerError Error.
+
+swagger:response v2GetNetworkReportInternalServerError
+*/
+type V2GetNetworkReportInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetNetworkReportInternalServerError creates V2GetNetworkReportInternalServerError with default headers values
+func NewV2GetNetworkReportInternalServerError() *V2GetNetworkReportInternalServerError {
+
+	return &V2GetNetworkReportInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get network report internal server error response
+func (o *V2GetNetworkReportInternalServerError) WithPayload(payload *models.Error) *V2GetNetworkReportInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get network report internal server error response
+func (o *V2GetNetworkReportInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetNetworkReportInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
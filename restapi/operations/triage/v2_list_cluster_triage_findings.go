@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package triage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterTriageFindingsHandlerFunc turns a function with the right signature into a v2 list cluster triage findings handler
+type V2ListClusterTriageFindingsHandlerFunc func(V2ListClusterTriageFindingsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterTriageFindingsHandlerFunc) Handle(params V2ListClusterTriageFindingsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterTriageFindingsHandler interface for that can handle valid v2 list cluster triage findings params
+type V2ListClusterTriageFindingsHandler interface {
+	Handle(V2ListClusterTriageFindingsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterTriageFindings creates a new http.Handler for the v2 list cluster triage findings operation
+func NewV2ListClusterTriageFindings(ctx *middleware.Context, handler V2ListClusterTriageFindingsHandler) *V2ListClusterTriageFindings {
+	return &V2ListClusterTriageFindings{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListClusterTriageFindings swagger:route GET /v2/clusters/{cluster_id}/triage triage v2ListClusterTriageFindings
+
+Lists the known failures found by the automatic triage of the logs uploaded for the cluster.
+*/
+type V2ListClusterTriageFindings struct {
+	Context *middleware.Context
+	Handler V2ListClusterTriageFindingsHandler
+}
+
+func (o *V2ListClusterTriageFindings) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterTriageFindingsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package triage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterTriageFindingsOKCode is the HTTP code returned for type V2ListClusterTriageFindingsOK
+const V2ListClusterTriageFindingsOKCode int = 200
+
+/*
+V2ListClusterTriageFindingsOK Success.
+
+swagger:response v2ListClusterTriageFindingsOK
+*/
+type V2ListClusterTriageFindingsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.TriageFinding `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTriageFindingsOK creates V2ListClusterTriageFindingsOK with default headers values
+func NewV2ListClusterTriageFindingsOK() *V2ListClusterTriageFindingsOK {
+
+	return &V2ListClusterTriageFindingsOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster triage findings o k response
+func (o *V2ListClusterTriageFindingsOK) WithPayload(payload []*models.TriageFinding) *V2ListClusterTriageFindingsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster triage findings o k response
+func (o *V2ListClusterTriageFindingsOK) SetPayload(payload []*models.TriageFinding) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTriageFindingsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.TriageFinding, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterTriageFindingsUnauthorizedCode is the HTTP code returned for type V2ListClusterTriageFindingsUnauthorized
+const V2ListClusterTriageFindingsUnauthorizedCode int = 401
+
+/*
+V2ListClusterTriageFindingsUnauthorized Unauthorized.
+
+swagger:response v2ListClusterTriageFindingsUnauthorized
+*/
+type V2ListClusterTriageFindingsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTriageFindingsUnauthorized creates V2ListClusterTriageFindingsUnauthorized with default headers values
+func NewV2ListClusterTriageFindingsUnauthorized() *V2ListClusterTriageFindingsUnauthorized {
+
+	return &V2ListClusterTriageFindingsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster triage findings unauthorized response
+func (o *V2ListClusterTriageFindingsUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterTriageFindingsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster triage findings unauthorized response
+func (o *V2ListClusterTriageFindingsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTriageFindingsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterTriageFindingsForbiddenCode is the HTTP code returned for type V2ListClusterTriageFindingsForbidden
+const V2ListClusterTriageFindingsForbiddenCode int = 403
+
+/*
+V2ListClusterTriageFindingsForbidden Forbidden.
+
+swagger:response v2ListClusterTriageFindingsForbidden
+*/
+type V2ListClusterTriageFindingsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTriageFindingsForbidden creates V2ListClusterTriageFindingsForbidden with default headers values
+func NewV2ListClusterTriageFindingsForbidden() *V2ListClusterTriageFindingsForbidden {
+
+	return &V2ListClusterTriageFindingsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster triage findings forbidden response
+func (o *V2ListClusterTriageFindingsForbidden) WithPayload(payload *models.InfraError) *V2ListClusterTriageFindingsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster triage findings forbidden response
+func (o *V2ListClusterTriageFindingsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTriageFindingsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterTriageFindingsNotFoundCode is the HTTP code returned for type V2ListClusterTriageFindingsNotFound
+const V2ListClusterTriageFindingsNotFoundCode int = 404
+
+/*
+V2ListClusterTriageFindingsNotFound Error.
+
+swagger:response v2ListClusterTriageFindingsNotFound
+*/
+type V2ListClusterTriageFindingsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTriageFindingsNotFound creates V2ListClusterTriageFindingsNotFound with default headers values
+func NewV2ListClusterTriageFindingsNotFound() *V2ListClusterTriageFindingsNotFound {
+
+	return &V2ListClusterTriageFindingsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list cluster triage findings not found response
+func (o *V2ListClusterTriageFindingsNotFound) WithPayload(payload *models.Error) *V2ListClusterTriageFindingsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster triage findings not found response
+func (o *V2ListClusterTriageFindingsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTriageFindingsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterTriageFindingsInternalServerErrorCode is the HTTP code returned for type V2ListClusterTriageFindingsInternalServerError
+const V2ListClusterTriageFindingsInternalServerErrorCode int = 500
+
+/*
+V2ListClusterTriageFindingsInternalServerError Error.
+
+swagger:response v2ListClusterTriageFindingsInternalServerError
+*/
+type V2ListClusterTriageFindingsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterTriageFindingsInternalServerError creates V2ListClusterTriageFindingsInternalServerError with default headers values
+func NewV2ListClusterTriageFindingsInternalServerError() *V2ListClusterTriageFindingsInternalServerError {
+
+	return &V2ListClusterTriageFindingsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster triage findings internal server error response
+func (o *V2ListClusterTriageFindingsInternalServerError) WithPayload(payload *models.Error) *V2ListClusterTriageFindingsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster triage findings internal server error response
+func (o *V2ListClusterTriageFindingsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterTriageFindingsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2WatchClusterHandlerFunc turns a function with the right signature into a v2 watch cluster handler
+type V2WatchClusterHandlerFunc func(V2WatchClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2WatchClusterHandlerFunc) Handle(params V2WatchClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2WatchClusterHandler interface for that can handle valid v2 watch cluster params
+type V2WatchClusterHandler interface {
+	Handle(V2WatchClusterParams, interface{}) middleware.Responder
+}
+
+// NewV2WatchCluster creates a new http.Handler for the v2 watch cluster operation
+func NewV2WatchCluster(ctx *middleware.Context, handler V2WatchClusterHandler) *V2WatchCluster {
+	return &V2WatchCluster{Context: ctx, Handler: handler}
+}
+
+/*
+	V2WatchCluster swagger:route GET /v2/clusters/{cluster_id}/watch watch v2WatchCluster
+
+Streams the changes of the cluster, its hosts and its events as server-sent events.
+*/
+type V2WatchCluster struct {
+	Context *middleware.Context
+	Handler V2WatchClusterHandler
+}
+
+func (o *V2WatchCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2WatchClusterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,282 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchClusterOKCode is the HTTP code returned for type V2WatchClusterOK
+const V2WatchClusterOKCode int = 200
+
+/*
+V2WatchClusterOK Success.
+
+swagger:response v2WatchClusterOK
+*/
+type V2WatchClusterOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterOK creates V2WatchClusterOK with default headers values
+func NewV2WatchClusterOK() *V2WatchClusterOK {
+
+	return &V2WatchClusterOK{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster o k response
+func (o *V2WatchClusterOK) WithPayload(payload string) *V2WatchClusterOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster o k response
+func (o *V2WatchClusterOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2WatchClusterBadRequestCode is the HTTP code returned for type V2WatchClusterBadRequest
+const V2WatchClusterBadRequestCode int = 400
+
+/*
+V2WatchClusterBadRequest Error.
+
+swagger:response v2WatchClusterBadRequest
+*/
+type V2WatchClusterBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterBadRequest creates V2WatchClusterBadRequest with default headers values
+func NewV2WatchClusterBadRequest() *V2WatchClusterBadRequest {
+
+	return &V2WatchClusterBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster bad request response
+func (o *V2WatchClusterBadRequest) WithPayload(payload *models.Error) *V2WatchClusterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster bad request response
+func (o *V2WatchClusterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchClusterUnauthorizedCode is the HTTP code returned for type V2WatchClusterUnauthorized
+const V2WatchClusterUnauthorizedCode int = 401
+
+/*
+V2WatchClusterUnauthorized Unauthorized.
+
+swagger:response v2WatchClusterUnauthorized
+*/
+type V2WatchClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterUnauthorized creates V2WatchClusterUnauthorized with default headers values
+func NewV2WatchClusterUnauthorized() *V2WatchClusterUnauthorized {
+
+	return &V2WatchClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster unauthorized response
+func (o *V2WatchClusterUnauthorized) WithPayload(payload *models.InfraError) *V2WatchClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster unauthorized response
+func (o *V2WatchClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchClusterForbiddenCode is the HTTP code returned for type V2WatchClusterForbidden
+const V2WatchClusterForbiddenCode int = 403
+
+/*
+V2WatchClusterForbidden Forbidden.
+
+swagger:response v2WatchClusterForbidden
+*/
+type V2WatchClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterForbidden creates V2WatchClusterForbidden with default headers values
+func NewV2WatchClusterForbidden() *V2WatchClusterForbidden {
+
+	return &V2WatchClusterForbidden{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster forbidden response
+func (o *V2WatchClusterForbidden) WithPayload(payload *models.InfraError) *V2WatchClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster forbidden response
+func (o *V2WatchClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchClusterNotFoundCode is the HTTP code returned for type V2WatchClusterNotFound
+const V2WatchClusterNotFoundCode int = 404
+
+/*
+V2WatchClusterNotFound Error.
+
+swagger:response v2WatchClusterNotFound
+*/
+type V2WatchClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterNotFound creates V2WatchClusterNotFound with default headers values
+func NewV2WatchClusterNotFound() *V2WatchClusterNotFound {
+
+	return &V2WatchClusterNotFound{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster not found response
+func (o *V2WatchClusterNotFound) WithPayload(payload *models.Error) *V2WatchClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster not found response
+func (o *V2WatchClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchClusterInternalServerErrorCode is the HTTP code returned for type V2WatchClusterInternalServerError
+const V2WatchClusterInternalServerErrorCode int = 500
+
+/*
+V2WatchClusterInternalServerError Error.
+
+swagger:response v2WatchClusterInternalServerError
+*/
+type V2WatchClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchClusterInternalServerError creates V2WatchClusterInternalServerError with default headers values
+func NewV2WatchClusterInternalServerError() *V2WatchClusterInternalServerError {
+
+	return &V2WatchClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 watch cluster internal server error response
+func (o *V2WatchClusterInternalServerError) WithPayload(payload *models.Error) *V2WatchClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch cluster internal server error response
+func (o *V2WatchClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
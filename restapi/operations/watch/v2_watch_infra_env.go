@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2WatchInfraEnvHandlerFunc turns a function with the right signature into a v2 watch infra env handler
+type V2WatchInfraEnvHandlerFunc func(V2WatchInfraEnvParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2WatchInfraEnvHandlerFunc) Handle(params V2WatchInfraEnvParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2WatchInfraEnvHandler interface for that can handle valid v2 watch infra env params
+type V2WatchInfraEnvHandler interface {
+	Handle(V2WatchInfraEnvParams, interface{}) middleware.Responder
+}
+
+// NewV2WatchInfraEnv creates a new http.Handler for the v2 watch infra env operation
+func NewV2WatchInfraEnv(ctx *middleware.Context, handler V2WatchInfraEnvHandler) *V2WatchInfraEnv {
+	return &V2WatchInfraEnv{Context: ctx, Handler: handler}
+}
+
+/*
+	V2WatchInfraEnv swagger:route GET /v2/infra-envs/{infra_env_id}/watch watch v2WatchInfraEnv
+
+Streams the changes of the infra-env, its hosts and its events as server-sent events.
+*/
+type V2WatchInfraEnv struct {
+	Context *middleware.Context
+	Handler V2WatchInfraEnvHandler
+}
+
+func (o *V2WatchInfraEnv) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2WatchInfraEnvParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,282 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2WatchInfraEnvOKCode is the HTTP code returned for type V2WatchInfraEnvOK
+const V2WatchInfraEnvOKCode int = 200
+
+/*
+V2WatchInfraEnvOK Success.
+
+swagger:response v2WatchInfraEnvOK
+*/
+type V2WatchInfraEnvOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvOK creates V2WatchInfraEnvOK with default headers values
+func NewV2WatchInfraEnvOK() *V2WatchInfraEnvOK {
+
+	return &V2WatchInfraEnvOK{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env o k response
+func (o *V2WatchInfraEnvOK) WithPayload(payload string) *V2WatchInfraEnvOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env o k response
+func (o *V2WatchInfraEnvOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2WatchInfraEnvBadRequestCode is the HTTP code returned for type V2WatchInfraEnvBadRequest
+const V2WatchInfraEnvBadRequestCode int = 400
+
+/*
+V2WatchInfraEnvBadRequest Error.
+
+swagger:response v2WatchInfraEnvBadRequest
+*/
+type V2WatchInfraEnvBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvBadRequest creates V2WatchInfraEnvBadRequest with default headers values
+func NewV2WatchInfraEnvBadRequest() *V2WatchInfraEnvBadRequest {
+
+	return &V2WatchInfraEnvBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env bad request response
+func (o *V2WatchInfraEnvBadRequest) WithPayload(payload *models.Error) *V2WatchInfraEnvBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env bad request response
+func (o *V2WatchInfraEnvBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchInfraEnvUnauthorizedCode is the HTTP code returned for type V2WatchInfraEnvUnauthorized
+const V2WatchInfraEnvUnauthorizedCode int = 401
+
+/*
+V2WatchInfraEnvUnauthorized Unauthorized.
+
+swagger:response v2WatchInfraEnvUnauthorized
+*/
+type V2WatchInfraEnvUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvUnauthorized creates V2WatchInfraEnvUnauthorized with default headers values
+func NewV2WatchInfraEnvUnauthorized() *V2WatchInfraEnvUnauthorized {
+
+	return &V2WatchInfraEnvUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env unauthorized response
+func (o *V2WatchInfraEnvUnauthorized) WithPayload(payload *models.InfraError) *V2WatchInfraEnvUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env unauthorized response
+func (o *V2WatchInfraEnvUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchInfraEnvForbiddenCode is the HTTP code returned for type V2WatchInfraEnvForbidden
+const V2WatchInfraEnvForbiddenCode int = 403
+
+/*
+V2WatchInfraEnvForbidden Forbidden.
+
+swagger:response v2WatchInfraEnvForbidden
+*/
+type V2WatchInfraEnvForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvForbidden creates V2WatchInfraEnvForbidden with default headers values
+func NewV2WatchInfraEnvForbidden() *V2WatchInfraEnvForbidden {
+
+	return &V2WatchInfraEnvForbidden{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env forbidden response
+func (o *V2WatchInfraEnvForbidden) WithPayload(payload *models.InfraError) *V2WatchInfraEnvForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env forbidden response
+func (o *V2WatchInfraEnvForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchInfraEnvNotFoundCode is the HTTP code returned for type V2WatchInfraEnvNotFound
+const V2WatchInfraEnvNotFoundCode int = 404
+
+/*
+V2WatchInfraEnvNotFound Error.
+
+swagger:response v2WatchInfraEnvNotFound
+*/
+type V2WatchInfraEnvNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvNotFound creates V2WatchInfraEnvNotFound with default headers values
+func NewV2WatchInfraEnvNotFound() *V2WatchInfraEnvNotFound {
+
+	return &V2WatchInfraEnvNotFound{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env not found response
+func (o *V2WatchInfraEnvNotFound) WithPayload(payload *models.Error) *V2WatchInfraEnvNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env not found response
+func (o *V2WatchInfraEnvNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2WatchInfraEnvInternalServerErrorCode is the HTTP code returned for type V2WatchInfraEnvInternalServerError
+const V2WatchInfraEnvInternalServerErrorCode int = 500
+
+/*
+V2WatchInfraEnvInternalServerError Error.
+
+swagger:response v2WatchInfraEnvInternalServerError
+*/
+type V2WatchInfraEnvInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2WatchInfraEnvInternalServerError creates V2WatchInfraEnvInternalServerError with default headers values
+func NewV2WatchInfraEnvInternalServerError() *V2WatchInfraEnvInternalServerError {
+
+	return &V2WatchInfraEnvInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 watch infra env internal server error response
+func (o *V2WatchInfraEnvInternalServerError) WithPayload(payload *models.Error) *V2WatchInfraEnvInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 watch infra env internal server error response
+func (o *V2WatchInfraEnvInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2WatchInfraEnvInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
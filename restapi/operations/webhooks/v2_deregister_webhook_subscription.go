@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeregisterWebhookSubscriptionHandlerFunc turns a function with the right signature into a v2 deregister webhook subscription handler
+type V2DeregisterWebhookSubscriptionHandlerFunc func(V2DeregisterWebhookSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeregisterWebhookSubscriptionHandlerFunc) Handle(params V2DeregisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeregisterWebhookSubscriptionHandler interface for that can handle valid v2 deregister webhook subscription params
+type V2DeregisterWebhookSubscriptionHandler interface {
+	Handle(V2DeregisterWebhookSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2DeregisterWebhookSubscription creates a new http.Handler for the v2 deregister webhook subscription operation
+func NewV2DeregisterWebhookSubscription(ctx *middleware.Context, handler V2DeregisterWebhookSubscriptionHandler) *V2DeregisterWebhookSubscription {
+	return &V2DeregisterWebhookSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DeregisterWebhookSubscription swagger:route DELETE /v2/webhook-subscriptions/{subscription_id} webhooks v2DeregisterWebhookSubscription
+
+Deletes a webhook subscription together with its pending deliveries.
+*/
+type V2DeregisterWebhookSubscription struct {
+	Context *middleware.Context
+	Handler V2DeregisterWebhookSubscriptionHandler
+}
+
+func (o *V2DeregisterWebhookSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeregisterWebhookSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
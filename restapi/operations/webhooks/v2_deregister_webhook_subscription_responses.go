@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterWebhookSubscriptionNoContentCode is the HTTP code returned for type V2DeregisterWebhookSubscriptionNoContent
+const V2DeregisterWebhookSubscriptionNoContentCode int = 204
+
+/*
+V2DeregisterWebhookSubscriptionNoContent Success.
+
+swagger:response v2DeregisterWebhookSubscriptionNoContent
+*/
+type V2DeregisterWebhookSubscriptionNoContent struct {
+}
+
+// NewV2DeregisterWebhookSubscriptionNoContent creates V2DeregisterWebhookSubscriptionNoContent with default headers values
+func NewV2DeregisterWebhookSubscriptionNoContent() *V2DeregisterWebhookSubscriptionNoContent {
+
+	return &V2DeregisterWebhookSubscriptionNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterWebhookSubscriptionNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeregisterWebhookSubscriptionUnauthorizedCode is the HTTP code returned for type V2DeregisterWebhookSubscriptionUnauthorized
+const V2DeregisterWebhookSubscriptionUnauthorizedCode int = 401
+
+/*
+V2DeregisterWebhookSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2DeregisterWebhookSubscriptionUnauthorized
+*/
+type V2DeregisterWebhookSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterWebhookSubscriptionUnauthorized creates V2DeregisterWebhookSubscriptionUnauthorized with default headers values
+func NewV2DeregisterWebhookSubscriptionUnauthorized() *V2DeregisterWebhookSubscriptionUnauthorized {
+
+	return &V2DeregisterWebhookSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 deregister webhook subscription unauthorized response
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2DeregisterWebhookSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister webhook subscription unauthorized response
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterWebhookSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterWebhookSubscriptionForbiddenCode is the HTTP code returned for type V2DeregisterWebhookSubscriptionForbidden
+const V2DeregisterWebhookSubscriptionForbiddenCode int = 403
+
+/*
+V2DeregisterWebhookSubscriptionForbidden Forbidden.
+
+swagger:response v2DeregisterWebhookSubscriptionForbidden
+*/
+type V2DeregisterWebhookSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterWebhookSubscriptionForbidden creates V2DeregisterWebhookSubscriptionForbidden with default headers values
+func NewV2DeregisterWebhookSubscriptionForbidden() *V2DeregisterWebhookSubscriptionForbidden {
+
+	return &V2DeregisterWebhookSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 deregister webhook subscription forbidden response
+func (o *V2DeregisterWebhookSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2DeregisterWebhookSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister webhook subscription forbidden response
+func (o *V2DeregisterWebhookSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterWebhookSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterWebhookSubscriptionNotFoundCode is the HTTP code returned for type V2DeregisterWebhookSubscriptionNotFound
+const V2DeregisterWebhookSubscriptionNotFoundCode int = 404
+
+/*
+V2DeregisterWebhookSubscriptionNotFound Error.
+
+swagger:response v2DeregisterWebhookSubscriptionNotFound
+*/
+type V2DeregisterWebhookSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterWebhookSubscriptionNotFound creates V2DeregisterWebhookSubscriptionNotFound with default headers values
+func NewV2DeregisterWebhookSubscriptionNotFound() *V2DeregisterWebhookSubscriptionNotFound {
+
+	return &V2DeregisterWebhookSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 deregister webhook subscription not found response
+func (o *V2DeregisterWebhookSubscriptionNotFound) WithPayload(payload *models.Error) *V2DeregisterWebhookSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister webhook subscription not found response
+func (o *V2DeregisterWebhookSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterWebhookSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterWebhookSubscriptionInternalServerErrorCode is the HTTP code returned for type V2DeregisterWebhookSubscriptionInternalServerError
+const V2DeregisterWebhookSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2DeregisterWebhookSubscriptionInternalServerError Error.
+
+swagger:response v2DeregisterWebhookSubscriptionInternalServerError
+*/
+type V2DeregisterWebhookSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterWebhookSubscriptionInternalServerError creates V2DeregisterWebhookSubscriptionInternalServerError with default headers values
+func NewV2DeregisterWebhookSubscriptionInternalServerError() *V2DeregisterWebhookSubscriptionInternalServerError {
+
+	return &V2DeregisterWebhookSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 deregister webhook subscription internal server error response
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2DeregisterWebhookSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister webhook subscription internal server error response
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterWebhookSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
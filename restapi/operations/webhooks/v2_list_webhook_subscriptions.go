@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListWebhookSubscriptionsHandlerFunc turns a function with the right signature into a v2 list webhook subscriptions handler
+type V2ListWebhookSubscriptionsHandlerFunc func(V2ListWebhookSubscriptionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListWebhookSubscriptionsHandlerFunc) Handle(params V2ListWebhookSubscriptionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListWebhookSubscriptionsHandler interface for that can handle valid v2 list webhook subscriptions params
+type V2ListWebhookSubscriptionsHandler interface {
+	Handle(V2ListWebhookSubscriptionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListWebhookSubscriptions creates a new http.Handler for the v2 list webhook subscriptions operation
+func NewV2ListWebhookSubscriptions(ctx *middleware.Context, handler V2ListWebhookSubscriptionsHandler) *V2ListWebhookSubscriptions {
+	return &V2ListWebhookSubscriptions{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListWebhookSubscriptions swagger:route GET /v2/webhook-subscriptions webhooks v2ListWebhookSubscriptions
+
+Lists the webhook subscriptions of the tenant.
+*/
+type V2ListWebhookSubscriptions struct {
+	Context *middleware.Context
+	Handler V2ListWebhookSubscriptionsHandler
+}
+
+func (o *V2ListWebhookSubscriptions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListWebhookSubscriptionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
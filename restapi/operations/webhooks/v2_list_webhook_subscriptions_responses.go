@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListWebhookSubscriptionsOKCode is the HTTP code returned for type V2ListWebhookSubscriptionsOK
+const V2ListWebhookSubscriptionsOKCode int = 200
+
+/*
+V2ListWebhookSubscriptionsOK Success.
+
+swagger:response v2ListWebhookSubscriptionsOK
+*/
+type V2ListWebhookSubscriptionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.WebhookSubscriptionList `json:"body,omitempty"`
+}
+
+// NewV2ListWebhookSubscriptionsOK creates V2ListWebhookSubscriptionsOK with default headers values
+func NewV2ListWebhookSubscriptionsOK() *V2ListWebhookSubscriptionsOK {
+
+	return &V2ListWebhookSubscriptionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list webhook subscriptions o k response
+func (o *V2ListWebhookSubscriptionsOK) WithPayload(payload models.WebhookSubscriptionList) *V2ListWebhookSubscriptionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list webhook subscriptions o k response
+func (o *V2ListWebhookSubscriptionsOK) SetPayload(payload models.WebhookSubscriptionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListWebhookSubscriptionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.WebhookSubscriptionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListWebhookSubscriptionsUnauthorizedCode is the HTTP code returned for type V2ListWebhookSubscriptionsUnauthorized
+const V2ListWebhookSubscriptionsUnauthorizedCode int = 401
+
+/*
+V2ListWebhookSubscriptionsUnauthorized Unauthorized.
+
+swagger:response v2ListWebhookSubscriptionsUnauthorized
+*/
+type V2ListWebhookSubscriptionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListWebhookSubscriptionsUnauthorized creates V2ListWebhookSubscriptionsUnauthorized with default headers values
+func NewV2ListWebhookSubscriptionsUnauthorized() *V2ListWebhookSubscriptionsUnauthorized {
+
+	return &V2ListWebhookSubscriptionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list webhook subscriptions unauthorized response
+func (o *V2ListWebhookSubscriptionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListWebhookSubscriptionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list webhook subscriptions unauthorized response
+func (o *V2ListWebhookSubscriptionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListWebhookSubscriptionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListWebhookSubscriptionsForbiddenCode is the HTTP code returned for type V2ListWebhookSubscriptionsForbidden
+const V2ListWebhookSubscriptionsForbiddenCode int = 403
+
+/*
+V2ListWebhookSubscriptionsForbidden Forbidden.
+
+swagger:response v2ListWebhookSubscriptionsForbidden
+*/
+type V2ListWebhookSubscriptionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListWebhookSubscriptionsForbidden creates V2ListWebhookSubscriptionsForbidden with default headers values
+func NewV2ListWebhookSubscriptionsForbidden() *V2ListWebhookSubscriptionsForbidden {
+
+	return &V2ListWebhookSubscriptionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list webhook subscriptions forbidden response
+func (o *V2ListWebhookSubscriptionsForbidden) WithPayload(payload *models.InfraError) *V2ListWebhookSubscriptionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list webhook subscriptions forbidden response
+func (o *V2ListWebhookSubscriptionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListWebhookSubscriptionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListWebhookSubscriptionsInternalServerErrorCode is the HTTP code returned for type V2ListWebhookSubscriptionsInternalServerError
+const V2ListWebhookSubscriptionsInternalServerErrorCode int = 500
+
+/*
+V2ListWebhookSubscriptionsInternalServerError Error.
+
+swagger:response v2ListWebhookSubscriptionsInternalServerError
+*/
+type V2ListWebhookSubscriptionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListWebhookSubscriptionsInternalServerError creates V2ListWebhookSubscriptionsInternalServerError with default headers values
+func NewV2ListWebhookSubscriptionsInternalServerError() *V2ListWebhookSubscriptionsInternalServerError {
+
+	return &V2ListWebhookSubscriptionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list webhook subscriptions internal server error response
+func (o *V2ListWebhookSubscriptionsInternalServerError) WithPayload(payload *models.Error) *V2ListWebhookSubscriptionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list webhook subscriptions internal server error response
+func (o *V2ListWebhookSubscriptionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListWebhookSubscriptionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
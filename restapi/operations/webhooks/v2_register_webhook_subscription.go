@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RegisterWebhookSubscriptionHandlerFunc turns a function with the right signature into a v2 register webhook subscription handler
+type V2RegisterWebhookSubscriptionHandlerFunc func(V2RegisterWebhookSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RegisterWebhookSubscriptionHandlerFunc) Handle(params V2RegisterWebhookSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RegisterWebhookSubscriptionHandler interface for that can handle valid v2 register webhook subscription params
+type V2RegisterWebhookSubscriptionHandler interface {
+	Handle(V2RegisterWebhookSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2RegisterWebhookSubscription creates a new http.Handler for the v2 register webhook subscription operation
+func NewV2RegisterWebhookSubscription(ctx *middleware.Context, handler V2RegisterWebhookSubscriptionHandler) *V2RegisterWebhookSubscription {
+	return &V2RegisterWebhookSubscription{Context: ctx, Handler: handler}
+}
+
+/*
+	V2RegisterWebhookSubscription swagger:route POST /v2/webhook-subscriptions webhooks v2RegisterWebhookSubscription
+
+Registers a webhook that receives the change notifications of the clusters, hosts and infra-envs of the tenant.
+*/
+type V2RegisterWebhookSubscription struct {
+	Context *middleware.Context
+	Handler V2RegisterWebhookSubscriptionHandler
+}
+
+func (o *V2RegisterWebhookSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RegisterWebhookSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterWebhookSubscriptionCreatedCode is the HTTP code returned for type V2RegisterWebhookSubscriptionCreated
+const V2RegisterWebhookSubscriptionCreatedCode int = 201
+
+/*
+V2RegisterWebhookSubscriptionCreated Success.
+
+swagger:response v2RegisterWebhookSubscriptionCreated
+*/
+type V2RegisterWebhookSubscriptionCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.WebhookSubscription `json:"body,omitempty"`
+}
+
+// NewV2RegisterWebhookSubscriptionCreated creates V2RegisterWebhookSubscriptionCreated with default headers values
+func NewV2RegisterWebhookSubscriptionCreated() *V2RegisterWebhookSubscriptionCreated {
+
+	return &V2RegisterWebhookSubscriptionCreated{}
+}
+
+// WithPayload adds the payload to the v2 register webhook subscription created response
+func (o *V2RegisterWebhookSubscriptionCreated) WithPayload(payload *models.WebhookSubscription) *V2RegisterWebhookSubscriptionCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register webhook subscription created response
+func (o *V2RegisterWebhookSubscriptionCreated) SetPayload(payload *models.WebhookSubscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterWebhookSubscriptionCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterWebhookSubscriptionBadRequestCode is the HTTP code returned for type V2RegisterWebhookSubscriptionBadRequest
+const V2RegisterWebhookSubscriptionBadRequestCode int = 400
+
+/*
+V2RegisterWebhookSubscriptionBadRequest Error.
+
+swagger:response v2RegisterWebhookSubscriptionBadRequest
+*/
+type V2RegisterWebhookSubscriptionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterWebhookSubscriptionBadRequest creates V2RegisterWebhookSubscriptionBadRequest with default headers values
+func NewV2RegisterWebhookSubscriptionBadRequest() *V2RegisterWebhookSubscriptionBadRequest {
+
+	return &V2RegisterWebhookSubscriptionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 register webhook subscription bad request response
+func (o *V2RegisterWebhookSubscriptionBadRequest) WithPayload(payload *models.Error) *V2RegisterWebhookSubscriptionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register webhook subscription bad request response
+func (o *V2RegisterWebhookSubscriptionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterWebhookSubscriptionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterWebhookSubscriptionUnauthorizedCode is the HTTP code returned for type V2RegisterWebhookSubscriptionUnauthorized
+const V2RegisterWebhookSubscriptionUnauthorizedCode int = 401
+
+/*
+V2RegisterWebhookSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2RegisterWebhookSubscriptionUnauthorized
+*/
+type V2RegisterWebhookSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterWebhookSubscriptionUnauthorized creates V2RegisterWebhookSubscriptionUnauthorized with default headers values
+func NewV2RegisterWebhookSubscriptionUnauthorized() *V2RegisterWebhookSubscriptionUnauthorized {
+
+	return &V2RegisterWebhookSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 register webhook subscription unauthorized response
+func (o *V2RegisterWebhookSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2RegisterWebhookSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register webhook subscription unauthorized response
+func (o *V2RegisterWebhookSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterWebhookSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterWebhookSubscriptionForbiddenCode is the HTTP code returned for type V2RegisterWebhookSubscriptionForbidden
+const V2RegisterWebhookSubscriptionForbiddenCode int = 403
+
+/*
+V2RegisterWebhookSubscriptionForbidden Forbidden.
+
+swagger:response v2RegisterWebhookSubscriptionForbidden
+*/
+type V2RegisterWebhookSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterWebhookSubscriptionForbidden creates V2RegisterWebhookSubscriptionForbidden with default headers values
+func NewV2RegisterWebhookSubscriptionForbidden() *V2RegisterWebhookSubscriptionForbidden {
+
+	return &V2RegisterWebhookSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 register webhook subscription forbidden response
+func (o *V2RegisterWebhookSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2RegisterWebhookSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register webhook subscription forbidden response
+func (o *V2RegisterWebhookSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterWebhookSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterWebhookSubscriptionInternalServerErrorCode is the HTTP code returned for type V2RegisterWebhookSubscriptionInternalServerError
+const V2RegisterWebhookSubscriptionInternalServerErrorCode int = 500
+
+/*
+V2RegisterWebhookSubscriptionInternalServerError Error.
+
+swagger:response v2RegisterWebhookSubscriptionInternalServerError
+*/
+type V2RegisterWebhookSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterWebhookSubscriptionInternalServerError creates V2RegisterWebhookSubscriptionInternalServerError with default headers values
+func NewV2RegisterWebhookSubscriptionInternalServerError() *V2RegisterWebhookSubscriptionInternalServerError {
+
+	return &V2RegisterWebhookSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 register webhook subscription internal server error response
+func (o *V2RegisterWebhookSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2RegisterWebhookSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register webhook subscription internal server error response
+func (o *V2RegisterWebhookSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterWebhookSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4618,6 +4618,38 @@ func generateFullMeshConnectivity(ctx context.Context, startCIDR string, hosts .
 	for _, h := range hosts {
 		generateConnectivityPostStepReply(ctx, h, &connectivityReport)
 	}
+
+	// The paths between the hosts carry jumbo frames, which satisfies the path MTU validations
+	for _, h := range hosts {
+		var mtuPathReport models.MtuPathCheckResponse
+		for id, addr := range hostToAddr {
+			if id == *h.ID {
+				continue
+			}
+			mtuPathReport.Results = append(mtuPathReport.Results, &models.MtuPathResult{
+				RemoteHostID:    id,
+				RemoteIPAddress: addr,
+				PathMtu:         9000,
+			})
+		}
+		generateMtuPathPostStepReply(ctx, h, &mtuPathReport)
+	}
+}
+
+func generateMtuPathPostStepReply(ctx context.Context, h *models.Host, mtuPathReport *models.MtuPathCheckResponse) {
+	fa, err := json.Marshal(mtuPathReport)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = agentBMClient.Installer.V2PostStepReply(ctx, &installer.V2PostStepReplyParams{
+		InfraEnvID: h.InfraEnvID,
+		HostID:     *h.ID,
+		Reply: &models.StepReply{
+			ExitCode: 0,
+			Output:   string(fa),
+			StepID:   string(models.StepTypeMtuPathCheck),
+			StepType: models.StepTypeMtuPathCheck,
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
 }
 
 func expectProgressToBe(c *models.Cluster, preparingForInstallationStagePercentage, installingStagePercentage, finalizingStagePercentage int) {
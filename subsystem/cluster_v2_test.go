@@ -397,6 +397,24 @@ var _ = Describe("[V2ClusterTests]", func() {
 
 		By("verifying the feature usage for install config overrides was not changed")
 		verifyUsageSet(c.FeatureUsage, overrideUsage)
+
+		By("failing when overriding service owned fields without allowing it")
+		params.InstallConfigParams = `{"networking": {"networkType": "OVNKubernetes"}}`
+		_, err = userBMClient.Installer.V2UpdateClusterInstallConfig(ctx, &params)
+		Expect(err).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterInstallConfigBadRequest()))
+
+		By("previewing overrides without applying them")
+		preview, err := userBMClient.Installer.V2PreviewClusterInstallConfig(ctx, &installer.V2PreviewClusterInstallConfigParams{
+			ClusterID: clusterID,
+			PreviewParams: &models.InstallConfigPreviewParams{
+				InstallConfigParams: swag.String(`{"controlPlane": {"hyperthreading": "Disabled"}}`),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*preview.Payload.InstallConfig).To(ContainSubstring("hyperthreading: Disabled"))
+		Expect(preview.Payload.ChangedFields).To(ContainElement("controlPlane.hyperthreading"))
+		c = getCluster(clusterID)
+		Expect(c.InstallConfigOverrides).To(Equal(originalOverride))
 	})
 })
 